@@ -64,6 +64,35 @@ ${PROTOCCOMPILER} -I. -Ibackend/api/${API_VERSION} \
     backend/api/${API_VERSION}/*.proto
 # Move *.swagger.json files into swagger folder.
 cp -a ${TMP_OUTPUT}/backend/api/${API_VERSION}/*.swagger.json ./backend/api/${API_VERSION}/swagger
+if [[ "${API_VERSION}" == "v2beta1" ]]; then
+    # The gateway marshals the Struct and Value well-known types as plain JSON,
+    # so render them as free-form objects, like the newer swagger generators.
+    for f in backend/api/${API_VERSION}/swagger/*.swagger.json; do
+        jq 'def walk(f): . as $in | if type == "object" then reduce keys_unsorted[] as $key ({}; . + {($key): ($in[$key] | walk(f))}) | f elif type == "array" then map(walk(f)) | f else f end;
+            walk(if type == "object" and (."$ref" | type) == "string" and (."$ref" | test("^#/definitions/protobuf(Struct|Value|ListValue)$")) then del(."$ref") + {"type": "object"} else . end)
+            | if .definitions then .definitions |= del(.protobufStruct, .protobufValue, .protobufListValue, .protobufNullValue) else . end' \
+            "$f" > "$f.tmp" && mv "$f.tmp" "$f"
+    done
+    jq -s 'reduce .[] as $item ({}; . * $item) | .info.title = "Kubeflow Pipelines on Tekton API" | .info.description = "This file contains REST API specification for Kubeflow Pipelines on Tekton. The file is autogenerated from the swagger definition." | .info.version = "'$KFP_VERSION'" | .info.contact = { "name": "kfp-tekton", "email": "youremail", "url": "https://github.com/kubeflow/kfp-tekton" } | .info.license = { "name": "Apache 2.0", "url": "https://raw.githubusercontent.com/kubeflow/kfp-tekton/master/LICENSE" }' \
+        backend/api/${API_VERSION}/swagger/run.swagger.json \
+        backend/api/${API_VERSION}/swagger/recurring_run.swagger.json \
+        backend/api/${API_VERSION}/swagger/pipeline.swagger.json \
+        > "backend/api/${API_VERSION}/swagger/kfp_api_single_file.swagger.json"
+    for app in run recurring_run pipeline; do
+        swagger generate client \
+            -f backend/api/${API_VERSION}/swagger/${app}.swagger.json \
+            -A ${app} \
+            --principal models.Principal \
+            -c ${app}_client \
+            -m ${app}_model \
+            -t backend/api/${API_VERSION}/go_http_client
+    done
+    # Hack to fix an issue with go-swagger, like for the v1 jobs below.
+    sed -i -- 's/MaxConcurrency string `json:"max_concurrency,omitempty"`/MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`/g' backend/api/${API_VERSION}/go_http_client/recurring_run_model/${API_VERSION}_recurring_run.go
+    sed -i -- 's/IntervalSecond string `json:"interval_second,omitempty"`/IntervalSecond int64 `json:"interval_second,omitempty,string"`/g' backend/api/${API_VERSION}/go_http_client/recurring_run_model/${API_VERSION}_periodic_schedule.go
+    exit 0
+fi
+# The rest generates the v1 clients.
 # Generate a single swagger json file from the swagger json files of all models.
 # Note: use backend/backend/api/${API_VERSION}/swagger/{run,job,experiment,pipeline,pipeline.upload,healthz}.swagger.json when apt-get can install jq-1.6
 jq -s 'reduce .[] as $item ({}; . * $item) | .info.title = "Kubeflow Pipelines API" | .info.description = "This file contains REST API specification for Kubeflow Pipelines. The file is autogenerated from the swagger definition." | .info.version = "'$KFP_VERSION'" | .info.contact = { "name": "google", "email": "kubeflow-pipelines@google.com", "url": "https://www.google.com" } | .info.license = { "name": "Apache 2.0", "url": "https://raw.githubusercontent.com/kubeflow/pipelines/master/LICENSE" }' \
@@ -124,6 +153,48 @@ swagger generate client \
     -c healthz_client \
     -m healthz_model \
     -t backend/api/${API_VERSION}/go_http_client
+swagger generate client \
+    -f backend/api/${API_VERSION}/swagger/audit.swagger.json \
+    -A audit \
+    --principal models.Principal \
+    -c audit_client \
+    -m audit_model \
+    -t backend/api/${API_VERSION}/go_http_client
+swagger generate client \
+    -f backend/api/${API_VERSION}/swagger/artifact.swagger.json \
+    -A artifact \
+    --principal models.Principal \
+    -c artifact_client \
+    -m artifact_model \
+    -t backend/api/${API_VERSION}/go_http_client
+swagger generate client \
+    -f backend/api/${API_VERSION}/swagger/lineage.swagger.json \
+    -A lineage \
+    --principal models.Principal \
+    -c lineage_client \
+    -m lineage_model \
+    -t backend/api/${API_VERSION}/go_http_client
+swagger generate client \
+    -f backend/api/${API_VERSION}/swagger/upload.swagger.json \
+    -A upload \
+    --principal models.Principal \
+    -c upload_client \
+    -m upload_model \
+    -t backend/api/${API_VERSION}/go_http_client
+swagger generate client \
+    -f backend/api/${API_VERSION}/swagger/webhook.swagger.json \
+    -A webhook \
+    --principal models.Principal \
+    -c webhook_client \
+    -m webhook_model \
+    -t backend/api/${API_VERSION}/go_http_client
+swagger generate client \
+    -f backend/api/${API_VERSION}/swagger/server_info.swagger.json \
+    -A server_info \
+    --principal models.Principal \
+    -c server_info_client \
+    -m server_info_model \
+    -t backend/api/${API_VERSION}/go_http_client
 # Hack to fix an issue with go-swagger
 # See https://github.com/go-swagger/go-swagger/issues/1381 for details.
 sed -i -- 's/MaxConcurrency int64 `json:"max_concurrency,omitempty"`/MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`/g' backend/api/${API_VERSION}/go_http_client/job_model/${API_VERSION}_job.go
@@ -13,6 +13,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -67,6 +68,18 @@ type ArchiveExperimentParams struct {
 	*/
 	ID string
 
+	/*KeepJobsEnabled
+	  Whether to leave the experiment's jobs enabled instead of pausing them.
+
+	*/
+	KeepJobsEnabled *bool
+
+	/*KeepRunsAvailable
+	  Whether to leave the experiment's runs available instead of archiving them.
+
+	*/
+	KeepRunsAvailable *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -116,6 +129,28 @@ func (o *ArchiveExperimentParams) SetID(id string) {
 	o.ID = id
 }
 
+// WithKeepJobsEnabled adds the keepJobsEnabled to the archive experiment params
+func (o *ArchiveExperimentParams) WithKeepJobsEnabled(keepJobsEnabled *bool) *ArchiveExperimentParams {
+	o.SetKeepJobsEnabled(keepJobsEnabled)
+	return o
+}
+
+// SetKeepJobsEnabled adds the keepJobsEnabled to the archive experiment params
+func (o *ArchiveExperimentParams) SetKeepJobsEnabled(keepJobsEnabled *bool) {
+	o.KeepJobsEnabled = keepJobsEnabled
+}
+
+// WithKeepRunsAvailable adds the keepRunsAvailable to the archive experiment params
+func (o *ArchiveExperimentParams) WithKeepRunsAvailable(keepRunsAvailable *bool) *ArchiveExperimentParams {
+	o.SetKeepRunsAvailable(keepRunsAvailable)
+	return o
+}
+
+// SetKeepRunsAvailable adds the keepRunsAvailable to the archive experiment params
+func (o *ArchiveExperimentParams) SetKeepRunsAvailable(keepRunsAvailable *bool) {
+	o.KeepRunsAvailable = keepRunsAvailable
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ArchiveExperimentParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -129,6 +164,38 @@ func (o *ArchiveExperimentParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 
+	if o.KeepJobsEnabled != nil {
+
+		// query param keep_jobs_enabled
+		var qrKeepJobsEnabled bool
+		if o.KeepJobsEnabled != nil {
+			qrKeepJobsEnabled = *o.KeepJobsEnabled
+		}
+		qKeepJobsEnabled := swag.FormatBool(qrKeepJobsEnabled)
+		if qKeepJobsEnabled != "" {
+			if err := r.SetQueryParam("keep_jobs_enabled", qKeepJobsEnabled); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.KeepRunsAvailable != nil {
+
+		// query param keep_runs_available
+		var qrKeepRunsAvailable bool
+		if o.KeepRunsAvailable != nil {
+			qrKeepRunsAvailable = *o.KeepRunsAvailable
+		}
+		qKeepRunsAvailable := swag.FormatBool(qrKeepRunsAvailable)
+		if qKeepRunsAvailable != "" {
+			if err := r.SetQueryParam("keep_runs_available", qKeepRunsAvailable); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -54,7 +54,7 @@ func NewArchiveExperimentOK() *ArchiveExperimentOK {
 A successful response.
 */
 type ArchiveExperimentOK struct {
-	Payload interface{}
+	Payload *experiment_model.V1ArchiveExperimentResponse
 }
 
 func (o *ArchiveExperimentOK) Error() string {
@@ -63,8 +63,10 @@ func (o *ArchiveExperimentOK) Error() string {
 
 func (o *ArchiveExperimentOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(experiment_model.V1ArchiveExperimentResponse)
+
 	// response payload
-	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
 	}
 
@@ -111,6 +111,35 @@ func (a *Client) DeleteExperiment(params *DeleteExperimentParams, authInfo runti
 
 }
 
+/*
+GetDefaultExperiment finds the default experiment of a namespace which groups the runs and jobs created in the namespace without an experiment the default experiment is created if the namespace doesn t have one yet
+*/
+func (a *Client) GetDefaultExperiment(params *GetDefaultExperimentParams, authInfo runtime.ClientAuthInfoWriter) (*GetDefaultExperimentOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDefaultExperimentParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetDefaultExperiment",
+		Method:             "GET",
+		PathPattern:        "/apis/v1/namespaces/{namespace}/default_experiment",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetDefaultExperimentReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetDefaultExperimentOK), nil
+
+}
+
 /*
 GetExperiment finds a specific experiment by ID
 */
@@ -140,6 +169,64 @@ func (a *Client) GetExperiment(params *GetExperimentParams, authInfo runtime.Cli
 
 }
 
+/*
+GetExperimentBudget finds the budget of an experiment along with the runs and task hours it used the budget is unlimited until it s set
+*/
+func (a *Client) GetExperimentBudget(params *GetExperimentBudgetParams, authInfo runtime.ClientAuthInfoWriter) (*GetExperimentBudgetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetExperimentBudgetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetExperimentBudget",
+		Method:             "GET",
+		PathPattern:        "/apis/v1/experiments/{experiment_id}/budget",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetExperimentBudgetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetExperimentBudgetOK), nil
+
+}
+
+/*
+GetExperimentDefaults finds the defaults merged into the runs and jobs of an experiment
+*/
+func (a *Client) GetExperimentDefaults(params *GetExperimentDefaultsParams, authInfo runtime.ClientAuthInfoWriter) (*GetExperimentDefaultsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetExperimentDefaultsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetExperimentDefaults",
+		Method:             "GET",
+		PathPattern:        "/apis/v1/experiments/{experiment_id}/defaults",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetExperimentDefaultsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetExperimentDefaultsOK), nil
+
+}
+
 /*
 ListExperiment finds all experiments supports pagination and sorting on certain fields
 */
@@ -169,6 +256,93 @@ func (a *Client) ListExperiment(params *ListExperimentParams, authInfo runtime.C
 
 }
 
+/*
+SetDefaultExperiment makes an experiment of a namespace its default experiment
+*/
+func (a *Client) SetDefaultExperiment(params *SetDefaultExperimentParams, authInfo runtime.ClientAuthInfoWriter) (*SetDefaultExperimentOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetDefaultExperimentParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SetDefaultExperiment",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1/namespaces/{namespace}/default_experiment",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SetDefaultExperimentReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SetDefaultExperimentOK), nil
+
+}
+
+/*
+SetExperimentBudget replaces the budget of an experiment
+*/
+func (a *Client) SetExperimentBudget(params *SetExperimentBudgetParams, authInfo runtime.ClientAuthInfoWriter) (*SetExperimentBudgetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetExperimentBudgetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SetExperimentBudget",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1/experiments/{experiment_id}/budget",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SetExperimentBudgetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SetExperimentBudgetOK), nil
+
+}
+
+/*
+SetExperimentDefaults replaces the defaults of the runs and jobs of an experiment they apply to the runs and jobs created afterwards
+*/
+func (a *Client) SetExperimentDefaults(params *SetExperimentDefaultsParams, authInfo runtime.ClientAuthInfoWriter) (*SetExperimentDefaultsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetExperimentDefaultsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SetExperimentDefaults",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1/experiments/{experiment_id}/defaults",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SetExperimentDefaultsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SetExperimentDefaultsOK), nil
+
+}
+
 /*
 UnarchiveExperiment restores an archived experiment the experiment s archived runs and jobs will stay archived
 */
@@ -198,6 +372,35 @@ func (a *Client) UnarchiveExperiment(params *UnarchiveExperimentParams, authInfo
 
 }
 
+/*
+UndeleteExperiment restores a experiment deleted within the soft delete purge window only the cluster admins can restore it in multi user mode
+*/
+func (a *Client) UndeleteExperiment(params *UndeleteExperimentParams, authInfo runtime.ClientAuthInfoWriter) (*UndeleteExperimentOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeleteExperimentParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UndeleteExperiment",
+		Method:             "POST",
+		PathPattern:        "/apis/v1/experiments/{id}:undelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UndeleteExperimentReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UndeleteExperimentOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -13,6 +13,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -61,12 +62,24 @@ for the unarchive experiment operation typically these are written to a http.Req
 */
 type UnarchiveExperimentParams struct {
 
+	/*EnableJobs
+	  Whether to also enable the experiment's paused jobs.
+
+	*/
+	EnableJobs *bool
+
 	/*ID
 	  The ID of the experiment to be restored.
 
 	*/
 	ID string
 
+	/*RestoreRuns
+	  Whether to also restore the experiment's archived runs.
+
+	*/
+	RestoreRuns *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -105,6 +118,17 @@ func (o *UnarchiveExperimentParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithEnableJobs adds the enableJobs to the unarchive experiment params
+func (o *UnarchiveExperimentParams) WithEnableJobs(enableJobs *bool) *UnarchiveExperimentParams {
+	o.SetEnableJobs(enableJobs)
+	return o
+}
+
+// SetEnableJobs adds the enableJobs to the unarchive experiment params
+func (o *UnarchiveExperimentParams) SetEnableJobs(enableJobs *bool) {
+	o.EnableJobs = enableJobs
+}
+
 // WithID adds the id to the unarchive experiment params
 func (o *UnarchiveExperimentParams) WithID(id string) *UnarchiveExperimentParams {
 	o.SetID(id)
@@ -116,6 +140,17 @@ func (o *UnarchiveExperimentParams) SetID(id string) {
 	o.ID = id
 }
 
+// WithRestoreRuns adds the restoreRuns to the unarchive experiment params
+func (o *UnarchiveExperimentParams) WithRestoreRuns(restoreRuns *bool) *UnarchiveExperimentParams {
+	o.SetRestoreRuns(restoreRuns)
+	return o
+}
+
+// SetRestoreRuns adds the restoreRuns to the unarchive experiment params
+func (o *UnarchiveExperimentParams) SetRestoreRuns(restoreRuns *bool) {
+	o.RestoreRuns = restoreRuns
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *UnarchiveExperimentParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -124,11 +159,43 @@ func (o *UnarchiveExperimentParams) WriteToRequest(r runtime.ClientRequest, reg
 	}
 	var res []error
 
+	if o.EnableJobs != nil {
+
+		// query param enable_jobs
+		var qrEnableJobs bool
+		if o.EnableJobs != nil {
+			qrEnableJobs = *o.EnableJobs
+		}
+		qEnableJobs := swag.FormatBool(qrEnableJobs)
+		if qEnableJobs != "" {
+			if err := r.SetQueryParam("enable_jobs", qEnableJobs); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID); err != nil {
 		return err
 	}
 
+	if o.RestoreRuns != nil {
+
+		// query param restore_runs
+		var qrRestoreRuns bool
+		if o.RestoreRuns != nil {
+			qrRestoreRuns = *o.RestoreRuns
+		}
+		qRestoreRuns := swag.FormatBool(qrRestoreRuns)
+		if qRestoreRuns != "" {
+			if err := r.SetQueryParam("restore_runs", qRestoreRuns); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -54,7 +54,7 @@ func NewUnarchiveExperimentOK() *UnarchiveExperimentOK {
 A successful response.
 */
 type UnarchiveExperimentOK struct {
-	Payload interface{}
+	Payload *experiment_model.V1UnarchiveExperimentResponse
 }
 
 func (o *UnarchiveExperimentOK) Error() string {
@@ -63,8 +63,10 @@ func (o *UnarchiveExperimentOK) Error() string {
 
 func (o *UnarchiveExperimentOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(experiment_model.V1UnarchiveExperimentResponse)
+
 	// response payload
-	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
 	}
 
@@ -198,6 +198,35 @@ func (a *Client) ListJobs(params *ListJobsParams, authInfo runtime.ClientAuthInf
 
 }
 
+/*
+UndeleteJob restores a job deleted within the soft delete purge window only the cluster admins can restore it in multi user mode
+*/
+func (a *Client) UndeleteJob(params *UndeleteJobParams, authInfo runtime.ClientAuthInfoWriter) (*UndeleteJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeleteJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UndeleteJob",
+		Method:             "POST",
+		PathPattern:        "/apis/v1/jobs/{id}:undelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UndeleteJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UndeleteJobOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -314,6 +314,35 @@ func (a *Client) ListPipelines(params *ListPipelinesParams, authInfo runtime.Cli
 
 }
 
+/*
+UndeletePipeline restores a pipeline deleted within the soft delete purge window only the cluster admins can restore it in multi user mode
+*/
+func (a *Client) UndeletePipeline(params *UndeletePipelineParams, authInfo runtime.ClientAuthInfoWriter) (*UndeletePipelineOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeletePipelineParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UndeletePipeline",
+		Method:             "POST",
+		PathPattern:        "/apis/v1/pipelines/{id}:undelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UndeletePipelineReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UndeletePipelineOK), nil
+
+}
+
 /*
 UpdatePipelineDefaultVersion updates the default pipeline version of a specific pipeline
 */
@@ -15,6 +15,7 @@ import (
 	cr "github.com/go-openapi/runtime/client"
 
 	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	run_model "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
 )
@@ -66,6 +67,13 @@ type CreateRunParams struct {
 	/*Body*/
 	Body *run_model.V1Run
 
+	/*DryRun
+	  Whether to only validate the run and generate its PipelineRun, returned in
+	the pipeline runtime of the response, without storing or submitting it.
+
+	*/
+	DryRun *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -115,6 +123,17 @@ func (o *CreateRunParams) SetBody(body *run_model.V1Run) {
 	o.Body = body
 }
 
+// WithDryRun adds the dryRun to the create run params
+func (o *CreateRunParams) WithDryRun(dryRun *bool) *CreateRunParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the create run params
+func (o *CreateRunParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *CreateRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -129,6 +148,22 @@ func (o *CreateRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
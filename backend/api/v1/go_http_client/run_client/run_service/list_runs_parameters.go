@@ -80,6 +80,13 @@ type ListRunsParams struct {
 
 	*/
 	Filter *string
+	/*IncludeRunArchive
+	  Whether to also list the runs moved to the run archive, which holds the
+	finished runs of the months older than RUN_ARCHIVE_AGE. The runs moved there
+	are only listed when set, but are always returned by GetRun.
+
+	*/
+	IncludeRunArchive *bool
 	/*PageSize
 	  The number of runs to be listed per page. If there are more runs than this
 	number, the response message will contain a nextPageToken field you can use
@@ -160,6 +167,17 @@ func (o *ListRunsParams) SetFilter(filter *string) {
 	o.Filter = filter
 }
 
+// WithIncludeRunArchive adds the includeRunArchive to the list runs params
+func (o *ListRunsParams) WithIncludeRunArchive(includeRunArchive *bool) *ListRunsParams {
+	o.SetIncludeRunArchive(includeRunArchive)
+	return o
+}
+
+// SetIncludeRunArchive adds the includeRunArchive to the list runs params
+func (o *ListRunsParams) SetIncludeRunArchive(includeRunArchive *bool) {
+	o.IncludeRunArchive = includeRunArchive
+}
+
 // WithPageSize adds the pageSize to the list runs params
 func (o *ListRunsParams) WithPageSize(pageSize *int32) *ListRunsParams {
 	o.SetPageSize(pageSize)
@@ -239,6 +257,22 @@ func (o *ListRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regi
 
 	}
 
+	if o.IncludeRunArchive != nil {
+
+		// query param include_run_archive
+		var qrIncludeRunArchive bool
+		if o.IncludeRunArchive != nil {
+			qrIncludeRunArchive = *o.IncludeRunArchive
+		}
+		qIncludeRunArchive := swag.FormatBool(qrIncludeRunArchive)
+		if qIncludeRunArchive != "" {
+			if err := r.SetQueryParam("include_run_archive", qIncludeRunArchive); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.PageSize != nil {
 
 		// query param page_size
@@ -169,6 +169,64 @@ func (a *Client) ListRuns(params *ListRunsParams, authInfo runtime.ClientAuthInf
 
 }
 
+/*
+MoveExperimentRuns moves the runs of an experiment matching a filter to another experiment at once either all of the runs are moved or none is
+*/
+func (a *Client) MoveExperimentRuns(params *MoveExperimentRunsParams, authInfo runtime.ClientAuthInfoWriter) (*MoveExperimentRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMoveExperimentRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "MoveExperimentRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1/experiments/{source_experiment_id}/runs/move",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &MoveExperimentRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*MoveExperimentRunsOK), nil
+
+}
+
+/*
+MoveRun moves a misfiled run to another experiment
+*/
+func (a *Client) MoveRun(params *MoveRunParams, authInfo runtime.ClientAuthInfoWriter) (*MoveRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMoveRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "MoveRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v1/runs/{run_id}/move",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &MoveRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*MoveRunOK), nil
+
+}
+
 /*
 ReadArtifact finds a run s artifact data
 */
@@ -314,6 +372,35 @@ func (a *Client) UnarchiveRun(params *UnarchiveRunParams, authInfo runtime.Clien
 
 }
 
+/*
+UndeleteRun restores a run deleted within the soft delete purge window only the cluster admins can restore it in multi user mode
+*/
+func (a *Client) UndeleteRun(params *UndeleteRunParams, authInfo runtime.ClientAuthInfoWriter) (*UndeleteRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeleteRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UndeleteRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v1/runs/{id}:undelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UndeleteRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UndeleteRunOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -26,6 +26,10 @@ type V1Run struct {
 	// Optional input field. Describing the purpose of the run
 	Description string `json:"description,omitempty"`
 
+	// Optional input field. Whether to run all the steps of the run, instead of
+	// reusing the results of the executions cached.
+	DisableCache bool `json:"disable_cache,omitempty"`
+
 	// In case any error happens retrieving a run field, only run ID
 	// and the error message is returned. Client has the flexibility of choosing
 	// how to handle error. This is especially useful during listing call.
@@ -0,0 +1,100 @@
+# Copyright 2023 The Kubeflow Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Registers an external artifact in ML Metadata for the importer tasks of the v2
+# pipelines compiled to Tekton, and writes its URI to the result of the task so
+# the downstream tasks can consume it.
+
+import argparse
+import json
+import os
+
+from metadata_helpers import *
+
+IMPORTER_EXECUTION_TYPE_NAME = 'system.ImporterExecution'
+
+
+def metadata_value(value) -> metadata_store_pb2.Value:
+    if isinstance(value, (dict, list, bool)):
+        return metadata_store_pb2.Value(string_value=json.dumps(value, sort_keys=True))
+    return value_to_mlmd_value(value)
+
+
+def main():
+    parser = argparse.ArgumentParser(description='Imports an artifact into ML Metadata.')
+    parser.add_argument('--uri', required=True)
+    parser.add_argument('--type-name', required=True)
+    parser.add_argument('--metadata', default='{}')
+    parser.add_argument('--run-id', required=True)
+    parser.add_argument('--output-name', required=True)
+    parser.add_argument('--uri-path', required=True)
+    parser.add_argument('--reimport', action='store_true')
+    args = parser.parse_args()
+
+    store = connect_to_mlmd()
+    run_context = get_or_create_run_context(store=store, run_id=args.run_id)
+    execution = create_new_execution_in_existing_run_context(
+        store=store,
+        execution_type_name=IMPORTER_EXECUTION_TYPE_NAME,
+        context_id=run_context.id,
+        pod_name=os.environ.get('POD_NAME', ''),
+        pipeline_name=args.run_id,
+        run_id=args.run_id,
+        instance_id=args.output_name,
+    )
+    artifact_name_path = metadata_store_pb2.Event.Path(
+        steps=[metadata_store_pb2.Event.Path.Step(key=args.output_name)],
+    )
+
+    artifact = None
+    if not args.reimport:
+        artifact_type = get_or_create_artifact_type(store, args.type_name)
+        artifacts = [a for a in store.get_artifacts_by_uri(args.uri) if a.type_id == artifact_type.id]
+        if artifacts:
+            artifact = artifacts[-1]
+            print('Reusing artifact {} with URI={}.'.format(artifact.id, args.uri))
+            store.put_events([metadata_store_pb2.Event(
+                execution_id=execution.id,
+                artifact_id=artifact.id,
+                type=metadata_store_pb2.Event.OUTPUT,
+                path=artifact_name_path,
+            )])
+            store.put_attributions_and_associations(
+                [metadata_store_pb2.Attribution(context_id=run_context.id, artifact_id=artifact.id)], [])
+
+    if artifact is None:
+        custom_properties = {
+            name: metadata_value(value) for name, value in json.loads(args.metadata).items()
+        }
+        custom_properties[ARTIFACT_IO_NAME_PROPERTY_NAME] = metadata_store_pb2.Value(string_value=args.output_name)
+        custom_properties[ARTIFACT_RUN_ID_PROPERTY_NAME] = metadata_store_pb2.Value(string_value=args.run_id)
+        artifact = create_new_artifact_event_and_attribution(
+            store=store,
+            execution_id=execution.id,
+            context_id=run_context.id,
+            uri=args.uri,
+            type_name=args.type_name,
+            event_type=metadata_store_pb2.Event.OUTPUT,
+            custom_properties=custom_properties,
+            artifact_name_path=artifact_name_path,
+        )
+        print('Imported artifact {} with URI={}.'.format(artifact.id, args.uri))
+
+    os.makedirs(os.path.dirname(args.uri_path), exist_ok=True)
+    with open(args.uri_path, 'w') as f:
+        f.write(args.uri)
+
+
+if __name__ == '__main__':
+    main()
@@ -15,23 +15,29 @@
 package client
 
 import (
+	"bytes"
 	"database/sql"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
-	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/minio/minio-go/v6"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
 )
 
 const (
@@ -51,6 +57,10 @@ const (
 	archiveLogFileName       = "ARCHIVE_LOG_FILE_NAME"
 	archiveLogPathPrefix     = "ARCHIVE_LOG_PATH_PREFIX"
 	dbConMaxLifeTime         = "DBConfig.ConMaxLifeTime"
+	encryptionKeyFile        = "EncryptionConfig.KeyFile"
+	encryptionKMSKeyName     = "EncryptionConfig.KMSKeyName"
+	encryptionKMSEndpoint    = "EncryptionConfig.KMSEndpoint"
+	encryptionCredentials    = "EncryptionConfig.CredentialsFile"
 
 	visualizationServiceHost = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_HOST"
 	visualizationServicePort = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_PORT"
@@ -72,6 +82,15 @@ type ClientManager struct {
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
+	auditStore                storage.AuditStoreInterface
+	eventPublisher            eventbus.PublisherInterface
+	notifier                  notification.NotifierInterface
+	leaseStore                storage.LeaseStoreInterface
+	artifactBlobStore         storage.ArtifactBlobStoreInterface
+	uploadSessionStore        storage.UploadSessionStoreInterface
+	webhookStore              storage.WebhookStoreInterface
+	backupStore               storage.BackupStoreInterface
+	artifactMetadataStore     storage.ArtifactMetadataStoreInterface
 	objectStore               storage.ObjectStoreInterface
 	swfClient                 client.SwfClientInterface
 	k8sCoreClient             client.KubernetesCoreInterface
@@ -116,10 +135,59 @@ func (c *ClientManager) DefaultExperimentStore() storage.DefaultExperimentStoreI
 	return c.defaultExperimentStore
 }
 
+func (c *ClientManager) AuditStore() storage.AuditStoreInterface {
+	return c.auditStore
+}
+
+func (c *ClientManager) LeaseStore() storage.LeaseStoreInterface {
+	return c.leaseStore
+}
+
+func (c *ClientManager) ArtifactBlobStore() storage.ArtifactBlobStoreInterface {
+	return c.artifactBlobStore
+}
+
+func (c *ClientManager) UploadSessionStore() storage.UploadSessionStoreInterface {
+	return c.uploadSessionStore
+}
+
+func (c *ClientManager) WebhookStore() storage.WebhookStoreInterface {
+	return c.webhookStore
+}
+
+func (c *ClientManager) BackupStore() storage.BackupStoreInterface {
+	return c.backupStore
+}
+
+func (c *ClientManager) ArtifactMetadataStore() storage.ArtifactMetadataStoreInterface {
+	return c.artifactMetadataStore
+}
+
+// AuditSink returns nil, calls made by the persistence agent aren't audited.
+func (c *ClientManager) AuditSink() audit.SinkInterface {
+	return nil
+}
+
+// EventPublisher returns the publisher of the events of the runs the
+// persistence agent reports, e.g. when they finish.
+func (c *ClientManager) EventPublisher() eventbus.PublisherInterface {
+	return c.eventPublisher
+}
+
+// Notifier returns the notifier of the runs the persistence agent reports as
+// finished.
+func (c *ClientManager) Notifier() notification.NotifierInterface {
+	return c.notifier
+}
+
 func (c *ClientManager) ObjectStore() storage.ObjectStoreInterface {
 	return c.objectStore
 }
 
+func (c *ClientManager) Encryptor() *storage.Encryptor {
+	return c.db.Encryptor()
+}
+
 func (c *ClientManager) TektonClient() client.TektonClientInterface {
 	return c.tektonClient
 }
@@ -140,6 +208,22 @@ func (c *ClientManager) TokenReviewClient() client.TokenReviewInterface {
 	return c.tokenReviewClient
 }
 
+// MetadataClient returns nil, the persistence agent doesn't query lineage.
+func (c *ClientManager) MetadataClient() client.MetadataClientInterface {
+	return nil
+}
+
+// OCIRegistryClient returns nil, the persistence agent doesn't push artifacts.
+func (c *ClientManager) OCIRegistryClient() client.OCIRegistryClientInterface {
+	return nil
+}
+
+// RunEventRecorder returns nil, the persistence agent records the Events of the
+// runs it persists itself.
+func (c *ClientManager) RunEventRecorder() record.EventRecorder {
+	return nil
+}
+
 func (c *ClientManager) LogArchive() archive.LogArchiveInterface {
 	return c.logArchive
 }
@@ -157,9 +241,10 @@ func (c *ClientManager) Authenticators() []auth.Authenticator {
 }
 
 func (c *ClientManager) init() {
-	glog.Info("Initializing client manager")
+	log.Info("Initializing client manager")
 	db := initDBClient(common.GetDurationConfig(initConnectionTimeout))
 	db.SetConnMaxLifetime(common.GetDurationConfig(dbConMaxLifeTime))
+	initEncryption(db, common.GetDurationConfig(initConnectionTimeout))
 
 	// time
 	c.time = util.NewRealTime()
@@ -175,6 +260,13 @@ func (c *ClientManager) init() {
 	c.resourceReferenceStore = storage.NewResourceReferenceStore(db)
 	c.dBStatusStore = storage.NewDBStatusStore(db)
 	c.defaultExperimentStore = storage.NewDefaultExperimentStore(db)
+	c.auditStore = storage.NewAuditStore(db)
+	c.leaseStore = storage.NewLeaseStore(db, c.time)
+	c.artifactBlobStore = storage.NewArtifactBlobStore(db)
+	c.uploadSessionStore = storage.NewUploadSessionStore(db)
+	c.webhookStore = storage.NewWebhookStore(db)
+	c.backupStore = storage.NewBackupStore(db)
+	c.artifactMetadataStore = storage.NewArtifactMetadataStore(db)
 	c.objectStore = initObjectStoreClient(common.GetDurationConfig(initConnectionTimeout))
 
 	// Use default value of client QPS (5) & burst (10) defined in
@@ -195,18 +287,84 @@ func (c *ClientManager) init() {
 	// Log archive
 	c.logArchive = initLogArchive()
 
+	eventPublisher, err := eventbus.NewPublisher(eventbus.PublisherOptions{
+		Type:        common.GetEventPublisher(),
+		URL:         common.GetEventPublisherURL(),
+		TopicPrefix: common.GetEventTopicPrefix(),
+		Timeout:     common.GetEventPublisherTimeout(),
+		QueueSize:   common.GetEventPublisherQueueSize(),
+		Filter:      eventbus.NewEventFilter(common.GetEventTypes(), common.GetEventNamespaces()),
+	})
+	if err != nil {
+		log.Fatalf("Failed to create the event publisher. Error: %v", err)
+	}
+	c.eventPublisher = eventPublisher
+
+	notifier, err := notification.NewNotifier(common.GetNotificationPolicy(), c.time)
+	if err != nil {
+		log.Fatalf("Failed to create the notifier of the runs. Error: %v", err)
+	}
+	if notifier != nil {
+		c.notifier = notifier
+	}
+
 	if common.IsMultiUserMode() {
 		c.subjectAccessReviewClient = client.CreateSubjectAccessReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.tokenReviewClient = client.CreateTokenReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.authenticators = auth.GetAuthenticators(c.tokenReviewClient)
 	}
-	glog.Infof("Client manager initialized successfully")
+	log.Infof("Client manager initialized successfully")
 }
 
 func (c *ClientManager) Close() {
+	if c.eventPublisher != nil {
+		c.eventPublisher.Close()
+	}
+	if c.notifier != nil {
+		c.notifier.Close()
+	}
 	c.db.Close()
 }
 
+// initEncryption makes the stores encrypt the pipeline manifests and the run and
+// job parameters, if a key is configured: a Google Cloud KMS key, or a local key
+// read from a file holding 32 bytes, base64 encoded or not.
+func initEncryption(db *storage.DB, initConnectionTimeout time.Duration) {
+	keyFile := common.GetStringConfigWithDefault(encryptionKeyFile, "")
+	kmsKeyName := common.GetStringConfigWithDefault(encryptionKMSKeyName, "")
+	var kek storage.KeyEncryptionKey
+	switch {
+	case keyFile != "" && kmsKeyName != "":
+		log.Fatalf("Only one of %s and %s can be set", encryptionKeyFile, encryptionKMSKeyName)
+	case kmsKeyName != "":
+		kek = &storage.CloudKMSKeyEncryptionKey{
+			Client:   client.CreateCloudKMSClientOrFatal(common.GetStringConfigWithDefault(encryptionCredentials, ""), initConnectionTimeout),
+			Endpoint: common.GetStringConfigWithDefault(encryptionKMSEndpoint, storage.CloudKMSEndpoint),
+			KeyName:  kmsKeyName,
+		}
+	case keyFile != "":
+		content, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			log.Fatalf("Failed to read the encryption key file %s. Error: %v", keyFile, err)
+		}
+		key := bytes.TrimSpace(content)
+		if decoded, err := base64.StdEncoding.DecodeString(string(key)); err == nil {
+			key = decoded
+		}
+		kek, err = storage.NewLocalKeyEncryptionKey(key)
+		if err != nil {
+			log.Fatalf("Invalid encryption key file %s. Error: %v", keyFile, err)
+		}
+	default:
+		return
+	}
+	encryptor, err := storage.NewEncryptor(kek)
+	if err != nil {
+		log.Fatalf("Failed to initialize the encryption. Error: %v", err)
+	}
+	db.SetEncryptor(encryptor)
+}
+
 func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	driverName := common.GetStringConfig("DBConfig.DriverName")
 	var arg string
@@ -214,8 +372,11 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	switch driverName {
 	case "mysql":
 		arg = initMysql(driverName, initConnectionTimeout)
+	case "postgres":
+		// The data backfills below only upgrade MySQL databases of old releases.
+		return initPostgres(initConnectionTimeout)
 	default:
-		glog.Fatalf("Driver %v is not supported", driverName)
+		log.Fatalf("Driver %v is not supported", driverName)
 	}
 
 	// db is safe for concurrent use by multiple goroutines
@@ -235,68 +396,7 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 		}
 	}
 
-	// Create table
-	response := db.AutoMigrate(
-		&model.Experiment{},
-		&model.Job{},
-		&model.Pipeline{},
-		&model.PipelineVersion{},
-		&model.ResourceReference{},
-		&model.RunDetail{},
-		&model.RunMetric{},
-		&model.Task{},
-		&model.DBStatus{},
-		&model.DefaultExperiment{})
-
-	if response.Error != nil {
-		glog.Fatalf("Failed to initialize the databases.")
-	}
-
-	response = db.Model(&model.Experiment{}).RemoveIndex("Name")
-	if response.Error != nil {
-		glog.Fatalf("Failed to drop unique key on experiment name. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.Pipeline{}).RemoveIndex("Name")
-	if response.Error != nil {
-		glog.Fatalf("Failed to drop unique key on pipeline name. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.ResourceReference{}).ModifyColumn("Payload", "longtext not null")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update the resource reference payload type. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.RunDetail{}).AddIndex("experimentuuid_createatinsec", "ExperimentUUID", "CreatedAtInSec")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index experimentuuid_createatinsec on run_details. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.RunDetail{}).AddIndex("experimentuuid_conditions_finishedatinsec", "ExperimentUUID", "Conditions", "FinishedAtInSec")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index experimentuuid_conditions_finishedatinsec on run_details. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.Pipeline{}).AddUniqueIndex("name_namespace_index", "Name", "Namespace")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index name_namespace_index on run_details. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.RunMetric{}).
-		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for RunID in run_metrics table. Error: %s", response.Error)
-	}
-	response = db.Model(&model.PipelineVersion{}).
-		AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for PipelineId in pipeline_versions table. Error: %s", response.Error)
-	}
-	response = db.Model(&model.Task{}).
-		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for RunUUID in task table. Error: %s", response.Error)
-	}
+	migrateDB(storage.NewDB(db.DB(), storage.NewMySQLDialect()))
 
 	// Data backfill for pipeline_versions if this is the first time for
 	// pipeline_versions to enter mlpipeline DB.
@@ -305,25 +405,59 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	}
 	err = backfillExperimentIDToRunTable(db)
 	if err != nil {
-		glog.Fatalf("Failed to backfill experiment UUID in run_details table: %s", err)
+		log.Fatalf("Failed to backfill experiment UUID in run_details table: %s", err)
 	}
 
-	response = db.Model(&model.Pipeline{}).ModifyColumn("Description", "longtext not null")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update pipeline description type. Error: %s", response.Error)
-	}
+	return storage.NewDB(db.DB(), storage.NewMySQLDialect())
+}
 
-	// If the old unique index idx_pipeline_version_uuid_name on pipeline_versions exists, remove it.
-	rows, err := db.Raw(`show index from pipeline_versions where Key_name='idx_pipeline_version_uuid_name'`).Rows()
-	if err != nil {
-		glog.Fatalf("Failed to query pipeline_version table's indices. Error: %s", err)
+// migrateDB checks the migrations applied to the database, then applies the ones
+// missing. Reverting migrations is left to the API server.
+func migrateDB(db *storage.DB) {
+	migrator := storage.NewMigrator(db, storage.Migrations, util.NewRealTime())
+	if err := migrator.MigrateToLatest(); err != nil {
+		log.Fatalf("Failed to migrate the database schema. Error: %v", err)
 	}
-	if rows.Next() {
-		db.Exec(`drop index idx_pipeline_version_uuid_name on pipeline_versions`)
+}
+
+// Initialize the connection to a PostgreSQL database, creating the database and
+// its tables if they don't exist.
+func initPostgres(initConnectionTimeout time.Duration) *storage.DB {
+	user := common.GetStringConfigWithDefault(mysqlUser, "postgres")
+	password := common.GetStringConfigWithDefault(mysqlPassword, "")
+	host := common.GetStringConfigWithDefault(mysqlServiceHost, "postgres")
+	port := common.GetStringConfigWithDefault(mysqlServicePort, "5432")
+	extraParams := common.GetMapConfig(mysqlExtraParams)
+	dbName := common.GetStringConfig(mysqlDBName)
+
+	// Connect to the default database to create the database of the API server.
+	db, err := sql.Open(storage.PostgreSQLDriverName,
+		client.CreatePostgreSQLDSN(user, password, host, port, "postgres", extraParams))
+	util.TerminateIfError(err)
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.RetryNotify(db.Ping, b, func(e error, duration time.Duration) {
+		log.Errorf("%v", e)
+	})
+	util.TerminateIfError(err)
+
+	var exists bool
+	err = db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = ?)", dbName).Scan(&exists)
+	util.TerminateIfError(err)
+	if !exists {
+		_, err = db.Exec(fmt.Sprintf("CREATE DATABASE %s", dbName))
+		util.TerminateIfError(err)
 	}
-	rows.Close()
+	db.Close()
 
-	return storage.NewDB(db.DB(), storage.NewMySQLDialect())
+	// db is safe for concurrent use by multiple goroutines
+	// and maintains its own pool of idle connections.
+	db, err = sql.Open(storage.PostgreSQLDriverName,
+		client.CreatePostgreSQLDSN(user, password, host, port, dbName, extraParams))
+	util.TerminateIfError(err)
+	postgresDB := storage.NewDB(db, storage.NewPostgreSQLDialect())
+	migrateDB(postgresDB)
+	return postgresDB
 }
 
 // Initialize the connection string for connecting to Mysql database
@@ -352,7 +486,7 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 	b.MaxElapsedTime = initConnectionTimeout
 	//err = backoff.Retry(operation, b)
 	backoff.RetryNotify(operation, b, func(e error, duration time.Duration) {
-		glog.Errorf("%v", e)
+		log.Errorf("%v", e)
 	})
 
 	defer db.Close()
@@ -382,6 +516,73 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 }
 
 func initObjectStoreClient(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	bucketName := common.GetStringConfigWithDefault("ObjectStoreConfig.BucketName", os.Getenv(pipelineBucketName))
+	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
+
+	switch objectStoreType := common.GetStringConfigWithDefault("ObjectStoreConfig.Type", "minio"); objectStoreType {
+	case "minio":
+		return initMinioObjectStore(bucketName, pipelinePath, initConnectionTimeout)
+	case "gcs":
+		return initGCSObjectStore(bucketName, pipelinePath, initConnectionTimeout)
+	case "azure":
+		return initAzureBlobObjectStore(bucketName, pipelinePath, initConnectionTimeout)
+	default:
+		log.Fatalf("Unsupported object store type: %s", objectStoreType)
+		return nil
+	}
+}
+
+// initGCSObjectStore creates an object store backed by a Google Cloud Storage
+// bucket, which has to exist. Without a credentials file, the service account
+// of the pod is used through workload identity.
+func initGCSObjectStore(bucketName string, pipelinePath string, initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	credentialsFile := common.GetStringConfigWithDefault("ObjectStoreConfig.GCS.CredentialsFile", "")
+	endpoint := common.GetStringConfigWithDefault("ObjectStoreConfig.GCS.Endpoint", storage.GCSEndpoint)
+	gcsClient := &storage.GCSClient{Client: client.CreateGCSClientOrFatal(credentialsFile, initConnectionTimeout), Endpoint: endpoint}
+	if credentialsFile != "" {
+		signer, err := client.NewGCSURLSigner(credentialsFile, endpoint)
+		if err != nil {
+			log.Fatalf("Failed to create the GCS URL signer. Error: %v", err)
+		}
+		gcsClient.Signer = signer
+	}
+	return storage.NewGCSObjectStore(gcsClient, bucketName, pipelinePath)
+}
+
+// initAzureBlobObjectStore creates an object store backed by an Azure Blob
+// Storage container, named after the bucket, which has to exist. Without a
+// connection string, the managed identity is used.
+func initAzureBlobObjectStore(containerName string, pipelinePath string, initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	connectionString := common.GetStringConfigWithDefault("ObjectStoreConfig.Azure.ConnectionString", "")
+	accountName := common.GetStringConfigWithDefault("ObjectStoreConfig.Azure.AccountName", "")
+	clientID := common.GetStringConfigWithDefault("ObjectStoreConfig.Azure.ClientID", "")
+	azureClient, endpoint := client.CreateAzureBlobClientOrFatal(connectionString, accountName, clientID, initConnectionTimeout)
+	blobClient := &storage.AzureBlobClient{Client: azureClient, Endpoint: endpoint}
+	if connectionString != "" {
+		signer, err := client.NewAzureURLSigner(connectionString)
+		if err != nil {
+			log.Fatalf("Failed to create the Azure URL signer. Error: %v", err)
+		}
+		blobClient.Signer = signer
+	}
+	return storage.NewAzureBlobObjectStore(blobClient, containerName, pipelinePath)
+}
+
+func getObjectStoreResilience() *storage.Resilience {
+	resilience := &storage.Resilience{
+		Timeout:          common.GetDurationConfigWithDefault("ObjectStoreConfig.Resilience.Timeout", 30*time.Second),
+		MaxRetries:       common.GetIntConfigWithDefault("ObjectStoreConfig.Resilience.MaxRetries", 3),
+		RetryInterval:    common.GetDurationConfigWithDefault("ObjectStoreConfig.Resilience.RetryInterval", 200*time.Millisecond),
+		FailureThreshold: common.GetIntConfigWithDefault("ObjectStoreConfig.Resilience.FailureThreshold", 5),
+		OpenDuration:     common.GetDurationConfigWithDefault("ObjectStoreConfig.Resilience.OpenDuration", 30*time.Second),
+	}
+	if err := resilience.Validate(); err != nil {
+		log.Fatalf("Invalid object store resilience. Error: %v", err)
+	}
+	return resilience
+}
+
+func initMinioObjectStore(bucketName string, pipelinePath string, initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
 	// Create client.
 	objectStoreServiceHost := common.GetStringConfigWithDefault(
 		"ObjectStoreConfig.Host", os.Getenv(objectStoreServiceHost))
@@ -393,33 +594,47 @@ func initObjectStoreClient(initConnectionTimeout time.Duration) storage.ObjectSt
 		"ObjectStoreConfig.Secure", common.GetBoolFromStringWithDefault(os.Getenv(objectStoreServiceSecure), false))
 	accessKey := common.GetStringConfigWithDefault("ObjectStoreConfig.AccessKey", "")
 	secretKey := common.GetStringConfigWithDefault("ObjectStoreConfig.SecretAccessKey", "")
-	bucketName := common.GetStringConfigWithDefault("ObjectStoreConfig.BucketName", os.Getenv(pipelineBucketName))
-	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
 	disableMultipart := common.GetBoolConfigWithDefault("ObjectStoreConfig.Multipart.Disable", true)
 
 	client := client.CreateObjectStoreClientOrFatal(objectStoreServiceHost, objectStoreServicePort, accessKey,
 		secretKey, objectStoreServiceSecure, objectStoreServiceRegion, initConnectionTimeout)
 	createBucket(client, bucketName, objectStoreServiceRegion)
 
-	return storage.NewMinioObjectStore(&storage.MinioClient{Client: client}, bucketName, pipelinePath, disableMultipart)
+	objectStore := storage.NewMinioObjectStore(
+		storage.NewResilientMinioClient(&storage.MinioClient{Client: client}, getObjectStoreResilience()),
+		bucketName, pipelinePath, disableMultipart)
+
+	encryption := &storage.ServerSideEncryption{
+		Type:               common.GetStringConfigWithDefault("ObjectStoreConfig.ServerSideEncryption.Type", ""),
+		KMSKeyID:           common.GetStringConfigWithDefault("ObjectStoreConfig.ServerSideEncryption.KMSKeyID", ""),
+		NamespaceKMSKeyIDs: common.GetMapConfig("ObjectStoreConfig.ServerSideEncryption.NamespaceKMSKeyIDs"),
+	}
+	if err := encryption.Validate(); err != nil {
+		log.Fatalf("Invalid object store server side encryption. Error: %v", err)
+	}
+	objectStore.WithServerSideEncryption(encryption)
+	if err := objectStore.VerifyServerSideEncryption(); err != nil {
+		log.Fatalf("Failed to verify the object store server side encryption. Error: %v", err)
+	}
+	return objectStore
 }
 
 func createBucket(client *minio.Client, bucketName, region string) {
 	// Check to see if we already own this bucket.
 	exists, err := client.BucketExists(bucketName)
 	if err != nil {
-		glog.Fatalf("Failed to check if Minio bucket exists. Error: %v", err)
+		log.Fatalf("Failed to check if Minio bucket exists. Error: %v", err)
 	}
 	if exists {
-		glog.Infof("We already own %s\n", bucketName)
+		log.Infof("We already own %s\n", bucketName)
 		return
 	}
 	// Create bucket if it does not exist
 	err = client.MakeBucket(bucketName, region)
 	if err != nil {
-		glog.Fatalf("Failed to create Minio bucket. Error: %v", err)
+		log.Fatalf("Failed to create Minio bucket. Error: %v", err)
 	}
-	glog.Infof("Successfully created bucket %s\n", bucketName)
+	log.Infof("Successfully created bucket %s\n", bucketName)
 }
 
 func initLogArchive() (logArchive archive.LogArchiveInterface) {
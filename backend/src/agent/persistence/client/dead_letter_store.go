@@ -0,0 +1,159 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// Dead letters keep the error of the last attempt, truncated so that a
+// ConfigMap can hold many of them.
+const maxDeadLetterErrorLength = 1024
+
+// DeadLetter is a resource that could not be reported after repeated
+// attempts.
+type DeadLetter struct {
+	// Worker is the name of the persistence worker that gave up on the resource.
+	Worker string `json:"worker"`
+	// Key is the namespace/name key of the resource.
+	Key          string    `json:"key"`
+	Attempts     int       `json:"attempts"`
+	LastError    string    `json:"lastError"`
+	DeadLetterAt time.Time `json:"deadLetterAt"`
+}
+
+func NewDeadLetter(worker string, key string, attempts int, err error, now time.Time) *DeadLetter {
+	message := err.Error()
+	if len(message) > maxDeadLetterErrorLength {
+		message = message[:maxDeadLetterErrorLength]
+	}
+	return &DeadLetter{
+		Worker:       worker,
+		Key:          key,
+		Attempts:     attempts,
+		LastError:    message,
+		DeadLetterAt: now.UTC(),
+	}
+}
+
+type DeadLetterStoreInterface interface {
+	List() ([]*DeadLetter, error)
+	Put(deadLetter *DeadLetter) error
+	Delete(worker string, key string) error
+}
+
+// DeadLetterStore keeps dead letters in a ConfigMap, so that they survive a
+// restart of the persistence agent.
+type DeadLetterStore struct {
+	configMaps    kubernetes.Interface
+	namespace     string
+	configMapName string
+}
+
+// NewDeadLetterStore creates a DeadLetterStore backed by the given ConfigMap,
+// which is created on the first write.
+func NewDeadLetterStore(clientset kubernetes.Interface, namespace string, configMapName string) *DeadLetterStore {
+	return &DeadLetterStore{
+		configMaps:    clientset,
+		namespace:     namespace,
+		configMapName: configMapName,
+	}
+}
+
+func (s *DeadLetterStore) List() ([]*DeadLetter, error) {
+	configMap, err := s.configMaps.CoreV1().ConfigMaps(s.namespace).Get(context.Background(), s.configMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the dead letter ConfigMap %s/%s", s.namespace, s.configMapName)
+	}
+	deadLetters := make([]*DeadLetter, 0, len(configMap.Data))
+	for entry, value := range configMap.Data {
+		deadLetter := &DeadLetter{}
+		if err := json.Unmarshal([]byte(value), deadLetter); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse the dead letter %s", entry)
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
+
+func (s *DeadLetterStore) Put(deadLetter *DeadLetter) error {
+	value, err := json.Marshal(deadLetter)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal the dead letter for %s", deadLetter.Key)
+	}
+	return s.update(func(data map[string]string) {
+		data[deadLetterEntry(deadLetter.Worker, deadLetter.Key)] = string(value)
+	})
+}
+
+func (s *DeadLetterStore) Delete(worker string, key string) error {
+	return s.update(func(data map[string]string) {
+		delete(data, deadLetterEntry(worker, key))
+	})
+}
+
+// update applies mutate to the data of the ConfigMap, creating the ConfigMap if
+// needed and retrying on conflicting writes.
+func (s *DeadLetterStore) update(mutate func(data map[string]string)) error {
+	configMaps := s.configMaps.CoreV1().ConfigMaps(s.namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(context.Background(), s.configMapName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: s.configMapName, Namespace: s.namespace},
+				Data:       map[string]string{},
+			}
+			mutate(configMap.Data)
+			_, err = configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				return apierrors.NewConflict(corev1.Resource("configmaps"), s.configMapName, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		mutate(configMap.Data)
+		_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to update the dead letter ConfigMap %s/%s", s.namespace, s.configMapName)
+	}
+	return nil
+}
+
+// deadLetterEntry returns the ConfigMap key of a dead letter. ConfigMap keys
+// cannot contain slashes; worker names and namespaces cannot contain dots, so
+// the entry stays unique.
+func deadLetterEntry(worker string, key string) string {
+	return worker + "." + strings.ReplaceAll(key, "/", ".")
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+type DeadLetterStoreFake struct {
+	deadLetters map[string]*DeadLetter
+	err         error
+}
+
+func NewDeadLetterStoreFake() *DeadLetterStoreFake {
+	return &DeadLetterStoreFake{
+		deadLetters: make(map[string]*DeadLetter),
+	}
+}
+
+func (s *DeadLetterStoreFake) List() ([]*DeadLetter, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	deadLetters := make([]*DeadLetter, 0, len(s.deadLetters))
+	for _, deadLetter := range s.deadLetters {
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
+
+func (s *DeadLetterStoreFake) Put(deadLetter *DeadLetter) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.deadLetters[deadLetterEntry(deadLetter.Worker, deadLetter.Key)] = deadLetter
+	return nil
+}
+
+func (s *DeadLetterStoreFake) Delete(worker string, key string) error {
+	if s.err != nil {
+		return s.err
+	}
+	delete(s.deadLetters, deadLetterEntry(worker, key))
+	return nil
+}
+
+func (s *DeadLetterStoreFake) SetError(err error) {
+	s.err = err
+}
@@ -0,0 +1,29 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metric variables. Please prefix the metric names with persistence_agent_.
+var (
+	reportThrottleDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "persistence_agent_report_throttle_seconds",
+		Help:    "The time a report waited for the client side rate limit before being sent to the API server",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 16),
+	}, []string{"resource"})
+)
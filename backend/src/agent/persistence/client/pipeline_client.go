@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/client-go/util/flowcontrol"
 )
 
 const (
@@ -37,6 +38,7 @@ type PipelineClientInterface interface {
 	ReportScheduledWorkflow(swf *util.ScheduledWorkflow) error
 	ReadArtifact(request *api.ReadArtifactRequest) (*api.ReadArtifactResponse, error)
 	ReportRunMetrics(request *api.ReportRunMetricsRequest) (*api.ReportRunMetricsResponse, error)
+	ArchiveLog(workflow *util.Workflow, podName string) (string, error)
 }
 
 type PipelineClient struct {
@@ -46,6 +48,9 @@ type PipelineClient struct {
 	runServiceClient    api.RunServiceClient
 	resourceManager     *kfpResource.ResourceManager
 	legacyStatusUpdate  bool
+	// reportLimiter, when set, limits the rate of the reports sent to the API
+	// server.
+	reportLimiter flowcontrol.RateLimiter
 }
 
 func NewPipelineClient(
@@ -84,16 +89,50 @@ func NewPipelineClient(
 	}, nil
 }
 
+// WithReportRateLimit limits the reports sent to the API server to qps per
+// second, allowing bursts of burst reports.
+func (p *PipelineClient) WithReportRateLimit(qps float32, burst int) *PipelineClient {
+	p.reportLimiter = flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+	return p
+}
+
+// waitForReportSlot blocks until the rate limit allows one more report.
+func (p *PipelineClient) waitForReportSlot(ctx context.Context, resource string) error {
+	if p.reportLimiter == nil {
+		return nil
+	}
+	start := time.Now()
+	err := p.reportLimiter.Wait(ctx)
+	reportThrottleDuration.WithLabelValues(resource).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
+			"Rate limit of the reports to the API server exceeded: %v", err)
+	}
+	return nil
+}
+
 func (p *PipelineClient) ReportWorkflow(workflow *util.Workflow) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
+	if err := p.waitForReportSlot(ctx, "workflow"); err != nil {
+		return err
+	}
+
 	if p.legacyStatusUpdate {
 		_, err := p.reportServiceClient.ReportWorkflow(ctx, &api.ReportWorkflowRequest{
 			Workflow: workflow.ToStringForStore(),
 		})
 		if err != nil {
 			statusCode, _ := status.FromError(err)
+			if statusCode.Code() == codes.FailedPrecondition {
+				// The API server cannot apply a status delta and needs the full status.
+				return util.NewCustomError(err, util.CUSTOM_CODE_OUT_OF_SYNC,
+					"Error while reporting workflow resource (code: %v, message: %v): %v",
+					statusCode.Code(),
+					statusCode.Message(),
+					err.Error())
+			}
 			if statusCode.Code() == codes.InvalidArgument || statusCode.Code() == codes.NotFound {
 				// Do not retry if either:
 				// * there is something wrong with the workflow
@@ -117,6 +156,9 @@ func (p *PipelineClient) ReportWorkflow(workflow *util.Workflow) error {
 	} else {
 		err := p.resourceManager.ReportWorkflowResource(ctx, workflow)
 
+		if util.IsUserErrorCodeMatch(err, codes.FailedPrecondition) {
+			return util.NewCustomError(err, util.CUSTOM_CODE_OUT_OF_SYNC, "Report workflow failed.")
+		}
 		if err != nil {
 			return util.Wrap(err, "Report workflow failed.")
 		}
@@ -129,6 +171,10 @@ func (p *PipelineClient) ReportScheduledWorkflow(swf *util.ScheduledWorkflow) er
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
+	if err := p.waitForReportSlot(ctx, "scheduledworkflow"); err != nil {
+		return err
+	}
+
 	if p.legacyStatusUpdate {
 		_, err := p.reportServiceClient.ReportScheduledWorkflow(ctx,
 			&api.ReportScheduledWorkflowRequest{
@@ -191,6 +237,26 @@ func (p *PipelineClient) ReadArtifact(request *api.ReadArtifactRequest) (*api.Re
 	return response, nil
 }
 
+// ArchiveLog copies the log of a completed pod of the workflow to the log
+// archive and returns the object store key it was written to.
+func (p *PipelineClient) ArchiveLog(workflow *util.Workflow, podName string) (string, error) {
+	if p.legacyStatusUpdate {
+		return "", util.NewCustomErrorf(util.CUSTOM_CODE_PERMANENT,
+			"Archiving the log of pod %v requires the non-legacy status update mode", podName)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	key, err := p.resourceManager.ArchiveTaskRunLog(ctx, workflow, podName)
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) || util.IsUserErrorCodeMatch(err, codes.FailedPrecondition) {
+		return "", util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT, "Archive log failed.")
+	}
+	if err != nil {
+		return "", util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT, "Archive log failed.")
+	}
+	return key, nil
+}
+
 // ReportRunMetrics reports run metrics to run service.
 func (p *PipelineClient) ReportRunMetrics(request *api.ReportRunMetricsRequest) (*api.ReportRunMetricsResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
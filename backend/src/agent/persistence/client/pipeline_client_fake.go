@@ -15,6 +15,8 @@
 package client
 
 import (
+	"fmt"
+
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -24,11 +26,14 @@ type PipelineClientFake struct {
 	workflows                 map[string]*util.Workflow
 	scheduledWorkflows        map[string]*util.ScheduledWorkflow
 	err                       error
+	errOnce                   error
 	artifacts                 map[string]*api.ReadArtifactResponse
 	readArtifactRequest       *api.ReadArtifactRequest
 	reportedMetricsRequest    *api.ReportRunMetricsRequest
 	reportMetricsResponseStub *api.ReportRunMetricsResponse
 	reportMetricsErrorStub    error
+	archivedLogs              map[string]string
+	archiveLogErr             error
 }
 
 func NewPipelineClientFake() *PipelineClientFake {
@@ -38,10 +43,15 @@ func NewPipelineClientFake() *PipelineClientFake {
 		err:                       nil,
 		artifacts:                 make(map[string]*api.ReadArtifactResponse),
 		reportMetricsResponseStub: &api.ReportRunMetricsResponse{},
+		archivedLogs:              make(map[string]string),
 	}
 }
 
 func (p *PipelineClientFake) ReportWorkflow(workflow *util.Workflow) error {
+	if err := p.errOnce; err != nil {
+		p.errOnce = nil
+		return err
+	}
 	if p.err != nil {
 		return p.err
 	}
@@ -67,10 +77,34 @@ func (p *PipelineClientFake) ReportRunMetrics(request *api.ReportRunMetricsReque
 	return p.reportMetricsResponseStub, p.reportMetricsErrorStub
 }
 
+func (p *PipelineClientFake) ArchiveLog(workflow *util.Workflow, podName string) (string, error) {
+	if p.archiveLogErr != nil {
+		return "", p.archiveLogErr
+	}
+	key := fmt.Sprintf("logs/%s/%s/%s.log", workflow.Namespace, workflow.Name, podName)
+	p.archivedLogs[podName] = key
+	return key, nil
+}
+
+// SetArchiveLogError makes ArchiveLog calls fail with err.
+func (p *PipelineClientFake) SetArchiveLogError(err error) {
+	p.archiveLogErr = err
+}
+
+// GetArchivedLogs returns the object store keys of the archived logs, keyed by pod name.
+func (p *PipelineClientFake) GetArchivedLogs() map[string]string {
+	return p.archivedLogs
+}
+
 func (p *PipelineClientFake) SetError(err error) {
 	p.err = err
 }
 
+// SetErrorOnce makes the next ReportWorkflow call fail with err.
+func (p *PipelineClientFake) SetErrorOnce(err error) {
+	p.errOnce = err
+}
+
 func (p *PipelineClientFake) GetWorkflow(namespace string, name string) *util.Workflow {
 	return p.workflows[getKey(namespace, name)]
 }
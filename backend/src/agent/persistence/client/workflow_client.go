@@ -15,6 +15,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -30,7 +31,9 @@ import (
 	wfclientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	tektonV1 "github.com/tektoncd/pipeline/pkg/client/informers/externalversions/pipeline/v1"
 	tektonV1Beta1 "github.com/tektoncd/pipeline/pkg/client/informers/externalversions/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/cache"
 )
@@ -70,6 +73,8 @@ func init() {
 
 type WorkflowClientInterface interface {
 	Get(namespace string, name string) (wf *util.Workflow, err error)
+	Delete(namespace string, name string) error
+	AddLabel(namespace string, name string, key string, value string) error
 }
 
 type Informers struct {
@@ -103,9 +108,9 @@ func (c *WorkflowClient) AddEventHandler(funcs *cache.ResourceEventHandlerFuncs)
 func (c *WorkflowClient) HasSynced() func() bool {
 	return func() bool {
 		log.Infof("cache sync: PR: %t, TR: %t, CR: %t", c.informers.PRInformer.Informer().HasSynced(),
-			c.informers.TRInformer.Informer().HasSynced(), c.informers.TRInformer.Informer().HasSynced())
+			c.informers.TRInformer.Informer().HasSynced(), c.informers.CRInformer.Informer().HasSynced())
 		return c.informers.PRInformer.Informer().HasSynced() &&
-			c.informers.TRInformer.Informer().HasSynced() && c.informers.TRInformer.Informer().HasSynced()
+			c.informers.TRInformer.Informer().HasSynced() && c.informers.CRInformer.Informer().HasSynced()
 	}
 }
 
@@ -168,7 +173,7 @@ func (c *WorkflowClient) getStatusFromChildReferences(namespace string, selector
 			}
 			// TODO: maybe change it to another struct to have backward compatibility
 			taskrunStatusesMarshal, err := json.Marshal(taskrunStatuses)
-			workflow.Annotations["taskrunStatuses"] = string(taskrunStatusesMarshal)
+			workflow.Annotations[util.AnnotationKeyTaskRunStatuses] = string(taskrunStatusesMarshal)
 		}
 		if hasCustomRun {
 			customRuns, err := c.informers.CRInformer.Lister().CustomRuns(namespace).List(selector)
@@ -188,12 +193,49 @@ func (c *WorkflowClient) getStatusFromChildReferences(namespace string, selector
 			}
 			// TODO: maybe change it to another struct to have backward compatibility
 			customRunStatusesMarshal, err := json.Marshal(customRunStatuses)
-			workflow.Annotations["customRunStatuses"] = string(customRunStatusesMarshal)
+			workflow.Annotations[util.AnnotationKeyCustomRunStatuses] = string(customRunStatusesMarshal)
 		}
 	}
 	return nil
 }
 
+// Delete deletes a Workflow, given a namespace and name.
+func (c *WorkflowClient) Delete(namespace string, name string) error {
+	err := c.clientset.TektonV1().PipelineRuns(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return workflowClientError(err, "Error deleting workflow (%v) in namespace (%v)", name, namespace)
+	}
+	return nil
+}
+
+// AddLabel sets a label on a Workflow, given a namespace and name.
+func (c *WorkflowClient) AddLabel(namespace string, name string, key string, value string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{
+				key: value,
+			},
+		},
+	})
+	if err != nil {
+		return util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT, "Error marshalling label patch: %v", err)
+	}
+	_, err = c.clientset.TektonV1().PipelineRuns(namespace).Patch(
+		context.Background(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return workflowClientError(err, "Error labelling workflow (%v) in namespace (%v)", name, namespace)
+	}
+	return nil
+}
+
+func workflowClientError(err error, messageFormat string, a ...interface{}) error {
+	code := util.CUSTOM_CODE_GENERIC
+	if util.IsNotFound(err) {
+		code = util.CUSTOM_CODE_NOT_FOUND
+	}
+	return util.NewCustomError(err, code, "%s: %v", fmt.Sprintf(messageFormat, a...), err)
+}
+
 // handle nested status case for specific types of Run
 func (c *WorkflowClient) handleNestedStatus(run *v1alpha1.Run, runStatus *v1alpha1.RunStatus, namespace string) {
 	var kind string
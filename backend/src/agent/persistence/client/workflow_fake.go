@@ -45,6 +45,28 @@ func (p *WorkflowClientFake) Get(namespace string, name string) (
 	return workflow, nil
 }
 
+func (p *WorkflowClientFake) Delete(namespace string, name string) error {
+	if _, ok := p.workflows[getKey(namespace, name)]; !ok {
+		return util.NewCustomError(fmt.Errorf("Error"),
+			util.CUSTOM_CODE_NOT_FOUND, "Workflow not found: %s/%s", namespace, name)
+	}
+	delete(p.workflows, getKey(namespace, name))
+	return nil
+}
+
+func (p *WorkflowClientFake) AddLabel(namespace string, name string, key string, value string) error {
+	workflow, ok := p.workflows[getKey(namespace, name)]
+	if !ok {
+		return util.NewCustomError(fmt.Errorf("Error"),
+			util.CUSTOM_CODE_NOT_FOUND, "Workflow not found: %s/%s", namespace, name)
+	}
+	if workflow.Labels == nil {
+		workflow.Labels = make(map[string]string)
+	}
+	workflow.Labels[key] = value
+	return nil
+}
+
 func (p *WorkflowClientFake) Put(namespace string, name string, wf *util.Workflow) {
 	p.workflows[getKey(namespace, name)] = wf
 }
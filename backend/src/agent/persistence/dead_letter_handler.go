@@ -0,0 +1,73 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// registerDeadLetterHandlers adds the admin endpoints for the dead letters, served
+// on the --adminAddress listener since they aren't authenticated:
+//
+//	GET  /deadletters                                   lists the dead letters
+//	POST /deadletters/requeue?worker=<w>&key=<ns/name>  requeues one of them
+//	POST /deadletters/requeue?worker=<w>                requeues all of a worker
+func registerDeadLetterHandlers(mux *http.ServeMux, agent *PersistenceAgent) {
+	mux.HandleFunc("/deadletters", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		deadLetters, err := agent.DeadLetters()
+		if err != nil {
+			writeDeadLetterError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(deadLetters); err != nil {
+			log.Errorf("Failed to write the dead letters: %v", err)
+		}
+	})
+	mux.HandleFunc("/deadletters/requeue", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := r.URL.Query()
+		if err := agent.Requeue(query.Get("worker"), query.Get("key")); err != nil {
+			writeDeadLetterError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func writeDeadLetterError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if userError, ok := err.(*util.UserError); ok {
+		switch userError.ExternalStatusCode() {
+		case codes.InvalidArgument:
+			status = http.StatusBadRequest
+		case codes.NotFound:
+			status = http.StatusNotFound
+		}
+	}
+	http.Error(w, err.Error(), status)
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/worker"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// leaderElectionNamespace returns the namespace of the leases, which is the
+// namespace of the persistence agent.
+func leaderElectionNamespace() (string, error) {
+	namespace := os.Getenv(podNamespaceEnvVar)
+	if namespace == "" {
+		return "", fmt.Errorf("the %s environment variable must be set to hold the lease in the namespace of the persistence agent", podNamespaceEnvVar)
+	}
+	return namespace, nil
+}
+
+// runAsLeader blocks until this replica holds the lease of its shard, then
+// calls run. Standby replicas keep their informer caches warm, so that a new
+// leader takes over without a full resync. Losing the lease exits the process,
+// since reports may already be in flight.
+func runAsLeader(cfg *rest.Config, namespace string, shard worker.ShardOptions, stopCh <-chan struct{}, run func(stopCh <-chan struct{})) error {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	identity, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	leaseName := leaderElectionLeaseName
+	if shard.Count > 1 {
+		leaseName = fmt.Sprintf("%s-%d", leaseName, shard.Index)
+	}
+	runWithLease(clientset, namespace, leaseName, identity, stopCh, run)
+	return nil
+}
+
+// runWithLease calls run while holding the lease, until stopCh is closed. The
+// lease is then released.
+func runWithLease(clientset kubernetes.Interface, namespace string, leaseName string, identity string,
+	stopCh <-chan struct{}, run func(stopCh <-chan struct{})) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      leaseName,
+			Namespace: namespace,
+		},
+		Client:     clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   leaderElectionLeaseDuration,
+		RenewDeadline:   leaderElectionRenewDeadline,
+		RetryPeriod:     leaderElectionRetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infof("Acquired the lease %s as %s", leaseName, identity)
+				run(ctx.Done())
+			},
+			OnStoppedLeading: func() {
+				select {
+				case <-stopCh:
+					log.Infof("Released the lease %s", leaseName)
+				default:
+					log.Fatalf("Lost the lease %s", leaseName)
+				}
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					log.Infof("Waiting for the lease %s, held by %s", leaseName, leader)
+				}
+			},
+		},
+	})
+}
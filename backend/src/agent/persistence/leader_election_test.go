@@ -0,0 +1,77 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLeaderElectionNamespace(t *testing.T) {
+	defer os.Unsetenv(podNamespaceEnvVar)
+
+	os.Unsetenv(podNamespaceEnvVar)
+	_, err := leaderElectionNamespace()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), podNamespaceEnvVar)
+
+	os.Setenv(podNamespaceEnvVar, "kubeflow")
+	namespace, err := leaderElectionNamespace()
+	assert.Nil(t, err)
+	assert.Equal(t, "kubeflow", namespace)
+}
+
+func TestRunWithLease(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	stopCh := make(chan struct{})
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		runWithLease(clientset, "kubeflow", "persistenceagent", "replica-1", stopCh, func(runStopCh <-chan struct{}) {
+			close(started)
+			<-runStopCh
+			close(stopped)
+		})
+		close(done)
+	}()
+	waitFor := func(ch <-chan struct{}, what string) {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Timed out waiting for the %s", what)
+		}
+	}
+
+	// The run is started once the lease is held.
+	waitFor(started, "run to start")
+	lease, err := clientset.CoordinationV1().Leases("kubeflow").Get(context.Background(), "persistenceagent", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "replica-1", *lease.Spec.HolderIdentity)
+
+	// Stopping stops the run and releases the lease.
+	close(stopCh)
+	waitFor(stopped, "run to stop")
+	waitFor(done, "lease to be released")
+	lease, err = clientset.CoordinationV1().Leases("kubeflow").Get(context.Background(), "persistenceagent", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "", *lease.Spec.HolderIdentity)
+}
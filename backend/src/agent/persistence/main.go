@@ -15,22 +15,37 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
-	"github.com/golang/glog"
+	// Registers the glog flags the image passes, e.g. --logtostderr.
+	_ "github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/worker"
+	"github.com/kubeflow/pipelines/backend/src/common/logging"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swfclientset "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	swfinformers "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/informers/externalversions"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/signals"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	workflowclientSet "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	workflowinformers "github.com/tektoncd/pipeline/pkg/client/informers/externalversions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
@@ -45,11 +60,35 @@ var (
 	mlPipelineServiceHttpPort     string
 	mlPipelineServiceGRPCPort     string
 	namespace                     string
+	namespaceAllowList            string
+	namespaceDenyList             string
 	ttlSecondsAfterWorkflowFinish int64
+	workflowGCPolicy              string
 	numWorker                     int
 	clientQPS                     float64
 	clientBurst                   int
 	legacyStatusUpdate            bool
+	metricsAddress                string
+	adminAddress                  string
+	shardIndex                    int
+	shardCount                    int
+	shardBy                       string
+	maxReportRetries              int
+	deadLetterConfigMap           string
+	statusDeltaReporting          bool
+	archiveLogs                   bool
+	recordRunEvents               bool
+	reportQPS                     float64
+	reportBurst                   int
+	reportBatchSize               int
+	reportBatchInterval           time.Duration
+	leaderElect                   bool
+	leaderElectionLeaseName       string
+	leaderElectionLeaseDuration   time.Duration
+	leaderElectionRenewDeadline   time.Duration
+	leaderElectionRetryPeriod     time.Duration
+	tektonNamespace               string
+	tektonCompatibilityCheck      string
 	configPath                    = flag.String("config", "", "Path to JSON file containing config")
 )
 
@@ -63,27 +102,62 @@ const (
 	mlPipelineAPIServerHttpPortFlagName   = "mlPipelineServiceHttpPort"
 	mlPipelineAPIServerGRPCPortFlagName   = "mlPipelineServiceGRPCPort"
 	namespaceFlagName                     = "namespace"
+	namespaceAllowListFlagName            = "namespaceAllowList"
+	namespaceDenyListFlagName             = "namespaceDenyList"
 	ttlSecondsAfterWorkflowFinishFlagName = "ttlSecondsAfterWorkflowFinish"
+	workflowGCPolicyFlagName              = "workflowGCPolicy"
 	numWorkerName                         = "numWorker"
 	clientQPSFlagName                     = "clientQPS"
 	clientBurstFlagName                   = "clientBurst"
 	legacyStatusUpdateName                = "legacyStatusUpdate"
+	metricsAddressFlagName                = "metricsAddress"
+	adminAddressFlagName                  = "adminAddress"
+	podNamespaceEnvVar                    = "POD_NAMESPACE"
+	shardIndexFlagName                    = "shardIndex"
+	shardCountFlagName                    = "shardCount"
+	shardByFlagName                       = "shardBy"
+	maxReportRetriesFlagName              = "maxReportRetries"
+	deadLetterConfigMapFlagName           = "deadLetterConfigMap"
+	statusDeltaReportingFlagName          = "statusDeltaReporting"
+	archiveLogsFlagName                   = "archiveLogs"
+	recordRunEventsFlagName               = "recordRunEvents"
+	reportQPSFlagName                     = "reportQPS"
+	reportBurstFlagName                   = "reportBurst"
+	reportBatchSizeFlagName               = "reportBatchSize"
+	reportBatchIntervalFlagName           = "reportBatchInterval"
+	leaderElectFlagName                   = "leaderElect"
+	leaderElectionLeaseNameFlagName       = "leaderElectionLeaseName"
+	leaderElectionLeaseDurationFlagName   = "leaderElectionLeaseDuration"
+	leaderElectionRenewDeadlineFlagName   = "leaderElectionRenewDeadline"
+	leaderElectionRetryPeriodFlagName     = "leaderElectionRetryPeriod"
+	tektonNamespaceFlagName               = "tektonNamespace"
+	tektonCompatibilityCheckFlagName      = "tektonCompatibilityCheck"
 )
 
 func main() {
 	flag.Parse()
+	if err := logging.Init("ml-pipeline-persistenceagent"); err != nil {
+		log.Fatalf("Error initializing logging: %s", err.Error())
+	}
 	if !legacyStatusUpdate {
 		initConfig()
 	}
 	// set up signals so we handle the first shutdown signal gracefully
 	stopCh := signals.SetupSignalHandler()
 
+	shutdownTracing, err := tracing.Init(context.Background(), "ml-pipeline-persistenceagent")
+	if err != nil {
+		log.Fatalf("Error initializing tracing: %s", err.Error())
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg, err := clientcmd.BuildConfigFromFlags(masterURL, kubeconfig)
 	if err != nil {
 		log.Fatalf("Error building kubeconfig: %s", err.Error())
 	}
 	cfg.QPS = float32(clientQPS)
 	cfg.Burst = clientBurst
+	cfg.Wrap(tracing.WrapTransport)
 
 	swfClient, err := swfclientset.NewForConfig(cfg)
 	if err != nil {
@@ -95,16 +169,44 @@ func main() {
 		log.Fatalf("Error building workflow clientset: %s", err.Error())
 	}
 
-	var swfInformerFactory swfinformers.SharedInformerFactory
-	var workflowInformerFactory workflowinformers.SharedInformerFactory
-	if namespace == "" {
-		swfInformerFactory = swfinformers.NewSharedInformerFactory(swfClient, time.Second*30)
-		workflowInformerFactory = workflowinformers.NewSharedInformerFactory(workflowClient, time.Second*30)
-	} else {
-		swfInformerFactory = swfinformers.NewFilteredSharedInformerFactory(swfClient, time.Second*30, namespace, nil)
-		workflowInformerFactory = workflowinformers.NewFilteredSharedInformerFactory(workflowClient, time.Second*30, namespace, nil)
+	if err = checkTektonCompatibility(cfg); err != nil {
+		log.Fatalf("Error checking the Tekton installation: %s", err.Error())
 	}
 
+	switch workflowGCPolicy {
+	case worker.WorkflowGCPolicyReport, worker.WorkflowGCPolicyDelete, worker.WorkflowGCPolicyLabel:
+	default:
+		log.Fatalf("Invalid --%s: %q", workflowGCPolicyFlagName, workflowGCPolicy)
+	}
+	if archiveLogs && legacyStatusUpdate {
+		log.Fatalf("--%s cannot be used with --%s", archiveLogsFlagName, legacyStatusUpdateName)
+	}
+
+	shard, err := shardOptions()
+	if err != nil {
+		log.Fatalf("Error configuring sharding: %s", err.Error())
+	}
+	var leaseNamespace string
+	if leaderElect {
+		if leaseNamespace, err = leaderElectionNamespace(); err != nil {
+			log.Fatalf("Error configuring leader election: %s", err.Error())
+		}
+	}
+
+	allowedNamespaces, informerNamespace, tweakListOptions, err := informerScope()
+	if err != nil {
+		log.Fatalf("Error configuring informer namespaces: %s", err.Error())
+	}
+	// Only PipelineRuns created by KFP carry the run ID label. The runs nested
+	// by custom tasks such as PipelineLoop drop that label, so TaskRuns and
+	// CustomRuns are only narrowed down to the ones owned by a PipelineRun.
+	swfInformerFactory := swfinformers.NewFilteredSharedInformerFactory(
+		swfClient, time.Second*30, informerNamespace, tweakListOptions)
+	workflowInformerFactory := workflowinformers.NewFilteredSharedInformerFactory(
+		workflowClient, time.Second*30, informerNamespace, withLabelSelector(tweakListOptions, util.LabelKeyWorkflowRunId))
+	childRunInformerFactory := workflowinformers.NewFilteredSharedInformerFactory(
+		workflowClient, time.Second*30, informerNamespace, withLabelSelector(tweakListOptions, pipeline.PipelineRunLabelKey))
+
 	pipelineClient, err := client.NewPipelineClient(
 		initializeTimeout,
 		timeout,
@@ -116,19 +218,61 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error creating ML pipeline API Server client: %v", err)
 	}
+	if reportQPS > 0 {
+		pipelineClient.WithReportRateLimit(float32(reportQPS), reportBurst)
+	}
+
+	var deadLetters *worker.DeadLetterQueue
+	if maxReportRetries > 0 {
+		deadLetters, err = newDeadLetterQueue(cfg, shard)
+		if err != nil {
+			log.Fatalf("Error loading the dead letters: %s", err.Error())
+		}
+	}
+
+	var runEvents *worker.RunEventRecorder
+	if recordRunEvents {
+		runEvents, err = newRunEventRecorder(cfg)
+		if err != nil {
+			log.Fatalf("Error creating the run event recorder: %s", err.Error())
+		}
+	}
 
 	controller := NewPersistenceAgent(
 		swfInformerFactory,
 		workflowInformerFactory,
+		childRunInformerFactory,
 		workflowClient,
 		pipelineClient,
+		allowedNamespaces,
+		shard,
+		deadLetters,
+		maxReportRetries,
+		runEvents,
 		util.NewRealTime())
 
+	if metricsAddress != "" {
+		go serveMetrics(metricsAddress)
+	}
+	if adminAddress != "" {
+		go serveAdmin(adminAddress, controller)
+	}
+
 	go swfInformerFactory.Start(stopCh)
 	go workflowInformerFactory.Start(stopCh)
+	go childRunInformerFactory.Start(stopCh)
 
-	if err = controller.Run(numWorker, stopCh); err != nil {
-		log.Fatalf("Error running controller: %s", err.Error())
+	run := func(stopCh <-chan struct{}) {
+		if err := controller.Run(numWorker, stopCh); err != nil {
+			log.Fatalf("Error running controller: %s", err.Error())
+		}
+	}
+	if !leaderElect {
+		run(stopCh)
+		return
+	}
+	if err = runAsLeader(cfg, leaseNamespace, shard, stopCh, run); err != nil {
+		log.Fatalf("Error running leader election: %s", err.Error())
 	}
 }
 
@@ -143,13 +287,198 @@ func init() {
 	flag.StringVar(&mlPipelineAPIServerBasePath, mlPipelineAPIServerBasePathFlagName,
 		"/apis/v1", "The base path for the ML pipeline API server.")
 	flag.StringVar(&namespace, namespaceFlagName, "", "The namespace name used for Kubernetes informers to obtain the listers.")
+	flag.StringVar(&namespaceAllowList, namespaceAllowListFlagName, "", "Comma-separated list of namespaces to persist runs from. Cannot be combined with --namespace or --namespaceDenyList.")
+	flag.StringVar(&namespaceDenyList, namespaceDenyListFlagName, "", "Comma-separated list of namespaces to ignore. Cannot be combined with --namespace or --namespaceAllowList.")
 	flag.Int64Var(&ttlSecondsAfterWorkflowFinish, ttlSecondsAfterWorkflowFinishFlagName, 604800 /* 7 days */, "The TTL for Argo workflow to persist after workflow finish.")
+	flag.StringVar(&workflowGCPolicy, workflowGCPolicyFlagName, worker.WorkflowGCPolicyReport,
+		"What to do with a workflow once its final state is persisted and its TTL passed. "+
+			"'report' lets the API server delete it, 'delete' deletes it from the persistence agent and "+
+			"'label' only sets the "+util.LabelKeyWorkflowReadyForGC+" label so that an external process can delete it.")
 	flag.IntVar(&numWorker, numWorkerName, 2, "Number of worker for sync job.")
 	// Use default value of client QPS (5) & burst (10) defined in
 	// k8s.io/client-go/rest/config.go#RESTClientFor
 	flag.Float64Var(&clientQPS, clientQPSFlagName, 5, "The maximum QPS to the master from this client.")
 	flag.IntVar(&clientBurst, clientBurstFlagName, 10, "Maximum burst for throttle from this client.")
 	flag.BoolVar(&legacyStatusUpdate, legacyStatusUpdateName, false, "Use legacy status update method to pass update via apiserver")
+	flag.IntVar(&shardCount, shardCountFlagName, 1, "Number of persistence agent replicas that split the runs between them. "+
+		"Every replica must use the same value.")
+	flag.IntVar(&shardIndex, shardIndexFlagName, -1, "Index of the shard owned by this replica, in [0, shardCount). "+
+		"Defaults to the ordinal at the end of the host name, as set for StatefulSet pods.")
+	flag.StringVar(&shardBy, shardByFlagName, worker.ShardByNamespace, "How runs are split between shards: "+
+		"'namespace' keeps all the runs of a namespace on one replica, 'run' spreads them individually.")
+	flag.IntVar(&maxReportRetries, maxReportRetriesFlagName, 0, "Number of consecutive transient failures after which a run is "+
+		"no longer reported and is moved to the dead letters. 0 retries forever.")
+	flag.StringVar(&deadLetterConfigMap, deadLetterConfigMapFlagName, "ml-pipeline-persistenceagent-dead-letters",
+		"Name of the ConfigMap, in the namespace of the persistence agent, that keeps the dead letters.")
+	flag.BoolVar(&statusDeltaReporting, statusDeltaReportingFlagName, false, "Report only the TaskRun and CustomRun statuses "+
+		"that changed since the previous report of a run. Requires an API server that merges status deltas.")
+	flag.BoolVar(&archiveLogs, archiveLogsFlagName, false, "Copy the logs of completed TaskRun pods to the log archive "+
+		"of the artifact bucket, so that run logs can be served after the pods are garbage collected.")
+	flag.BoolVar(&recordRunEvents, recordRunEventsFlagName, true, "Record Events on the PipelineRuns whose final state is persisted, "+
+		"e.g. the tasks reused from the cache, so that they show in kubectl describe pipelinerun.")
+	flag.Float64Var(&reportQPS, reportQPSFlagName, 0, "Maximum number of reports per second sent to the API server. 0 disables the limit.")
+	flag.IntVar(&reportBurst, reportBurstFlagName, 10, "Number of reports that can be sent at once above the --"+reportQPSFlagName+" limit.")
+	flag.IntVar(&reportBatchSize, reportBatchSizeFlagName, 0, "Send the workflow reports of all the workers one batch of up to this size "+
+		"at a time, so that bursts of completing runs do not overload the API server. 0 or 1 disables batching.")
+	flag.DurationVar(&reportBatchInterval, reportBatchIntervalFlagName, 100*time.Millisecond,
+		"Maximum time a workflow report waits for its batch to fill up before being sent.")
+	flag.BoolVar(&leaderElect, leaderElectFlagName, false, "Only report runs while holding a lease, so that several replicas "+
+		"can run for fast failover. With sharding, each shard has its own lease.")
+	flag.StringVar(&leaderElectionLeaseName, leaderElectionLeaseNameFlagName, "ml-pipeline-persistenceagent",
+		"Name of the Lease, in the namespace of the persistence agent, used for leader election.")
+	flag.DurationVar(&leaderElectionLeaseDuration, leaderElectionLeaseDurationFlagName, 15*time.Second,
+		"Duration that standby replicas wait before taking over a lease that is not renewed.")
+	flag.DurationVar(&leaderElectionRenewDeadline, leaderElectionRenewDeadlineFlagName, 10*time.Second,
+		"Duration that the leader retries renewing its lease before giving it up.")
+	flag.DurationVar(&leaderElectionRetryPeriod, leaderElectionRetryPeriodFlagName, 2*time.Second,
+		"Duration between attempts to acquire or renew the lease.")
+	flag.StringVar(&tektonNamespace, tektonNamespaceFlagName, util.DefaultTektonNamespace, "The namespace Tekton Pipelines is installed in.")
+	flag.StringVar(&tektonCompatibilityCheck, tektonCompatibilityCheckFlagName, util.TektonCompatibilityCheckFail,
+		"Whether to fail, only warn or skip the check when the Tekton installation lacks a required feature: fail, warn or off.")
+	flag.StringVar(&metricsAddress, metricsAddressFlagName, ":9090", "The address to serve Prometheus metrics on. Empty disables them.")
+	flag.StringVar(&adminAddress, adminAddressFlagName, "127.0.0.1:9091", "The address to serve the dead letter admin endpoints on. "+
+		"They aren't authenticated, so the default only accepts connections from the pod, e.g. through kubectl port-forward. Empty disables them.")
+}
+
+func serveMetrics(address string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	log.Infof("Serving metrics on %s", address)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		log.Fatalf("Error serving metrics: %s", err.Error())
+	}
+}
+
+// serveAdmin serves the endpoints changing the state of the agent, apart from
+// the metrics so that they aren't reachable wherever the metrics are scraped from.
+func serveAdmin(address string, agent *PersistenceAgent) {
+	mux := http.NewServeMux()
+	registerDeadLetterHandlers(mux, agent)
+	log.Infof("Serving admin endpoints on %s", address)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		log.Fatalf("Error serving admin endpoints: %s", err.Error())
+	}
+}
+
+// checkTektonCompatibility checks that the installed Tekton Pipelines has the
+// features the runs need before reporting them.
+func checkTektonCompatibility(cfg *rest.Config) error {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	return util.CheckTektonCompatibility(context.Background(), clientset.CoreV1().ConfigMaps(tektonNamespace), tektonCompatibilityCheck)
+}
+
+// newDeadLetterQueue loads the dead letters from a ConfigMap in the namespace
+// of the persistence agent. Each shard keeps its own ConfigMap, since only the
+// replica that owns a resource can requeue it.
+func newDeadLetterQueue(cfg *rest.Config, shard worker.ShardOptions) (*worker.DeadLetterQueue, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	configMapName := deadLetterConfigMap
+	if shard.Count > 1 {
+		configMapName = fmt.Sprintf("%s-%d", configMapName, shard.Index)
+	}
+	store := client.NewDeadLetterStore(clientset, os.Getenv(podNamespaceEnvVar), configMapName)
+	return worker.NewDeadLetterQueue(store)
+}
+
+// newRunEventRecorder creates the recorder of the Events of the persisted runs.
+func newRunEventRecorder(cfg *rest.Config) (*worker.RunEventRecorder, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	recorder, err := util.NewRunEventRecorder(clientset, "ml-pipeline-persistenceagent")
+	if err != nil {
+		return nil, err
+	}
+	return worker.NewRunEventRecorder(recorder, clientset.CoreV1()), nil
+}
+
+// shardOptions resolves the sharding flags. When the shard index is not set,
+// it is taken from the host name of a StatefulSet pod, e.g. 2 for
+// ml-pipeline-persistenceagent-2.
+func shardOptions() (worker.ShardOptions, error) {
+	options := worker.ShardOptions{Index: shardIndex, Count: shardCount, By: shardBy}
+	if options.By != worker.ShardByNamespace && options.By != worker.ShardByRun {
+		return options, fmt.Errorf("invalid --%s: %q", shardByFlagName, options.By)
+	}
+	if options.Count <= 1 {
+		options.Index = 0
+		return options, nil
+	}
+	if options.Index < 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return options, err
+		}
+		ordinal := hostname[strings.LastIndex(hostname, "-")+1:]
+		if options.Index, err = strconv.Atoi(ordinal); err != nil {
+			return options, fmt.Errorf("--%s is not set and host name %q has no ordinal", shardIndexFlagName, hostname)
+		}
+	}
+	if options.Index >= options.Count {
+		return options, fmt.Errorf("shard index %d is out of range for %d shards", options.Index, options.Count)
+	}
+	log.Infof("Persisting the runs of shard %d out of %d, sharded by %s", options.Index, options.Count, options.By)
+	return options, nil
+}
+
+// informerScope resolves the namespace flags into the namespaces the workers
+// accept, the namespace the informers watch and a tweak for their list and
+// watch calls. A single allowed namespace is watched directly; several allowed
+// namespaces need a cluster-wide watch that is filtered in the workers, while
+// denied namespaces are excluded by the API server through a field selector.
+func informerScope() ([]string, string, func(*metav1.ListOptions), error) {
+	allowed := splitNamespaces(namespaceAllowList)
+	denied := splitNamespaces(namespaceDenyList)
+	if namespace != "" {
+		if len(allowed) > 0 || len(denied) > 0 {
+			return nil, "", nil, fmt.Errorf("--%s cannot be combined with --%s or --%s",
+				namespaceFlagName, namespaceAllowListFlagName, namespaceDenyListFlagName)
+		}
+		allowed = []string{namespace}
+	}
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, "", nil, fmt.Errorf("--%s and --%s are mutually exclusive",
+			namespaceAllowListFlagName, namespaceDenyListFlagName)
+	}
+
+	informerNamespace := metav1.NamespaceAll
+	if len(allowed) == 1 {
+		informerNamespace = allowed[0]
+	}
+	selectors := make([]fields.Selector, 0, len(denied))
+	for _, ns := range denied {
+		selectors = append(selectors, fields.OneTermNotEqualSelector("metadata.namespace", ns))
+	}
+	tweakListOptions := func(options *metav1.ListOptions) {
+		if len(selectors) > 0 {
+			options.FieldSelector = fields.AndSelectors(selectors...).String()
+		}
+	}
+	return allowed, informerNamespace, tweakListOptions, nil
+}
+
+func withLabelSelector(tweak func(*metav1.ListOptions), selector string) func(*metav1.ListOptions) {
+	return func(options *metav1.ListOptions) {
+		tweak(options)
+		options.LabelSelector = selector
+	}
+}
+
+func splitNamespaces(list string) []string {
+	var namespaces []string
+	for _, ns := range strings.Split(list, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
 }
 
 func initConfig() {
@@ -165,7 +494,7 @@ func initConfig() {
 	viper.AddConfigPath(*configPath)
 	err := viper.ReadInConfig()
 	if err != nil {
-		glog.Fatalf("Fatal error config file: %s", err)
+		log.Fatalf("Fatal error config file: %s", err)
 	}
 
 	// Watch for configuration change
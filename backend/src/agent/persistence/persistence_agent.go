@@ -41,20 +41,34 @@ type PersistenceAgent struct {
 	workflowClient *client.WorkflowClient
 	swfWorker      *worker.PersistenceWorker
 	workflowWorker *worker.PersistenceWorker
+	deadLetters    *worker.DeadLetterQueue
+	reportBatcher  *worker.ReportBatcher
 }
 
-// NewPersistenceAgent returns a new persistence agent.
+// NewPersistenceAgent returns a new persistence agent. PipelineRuns are watched
+// through workflowInformerFactory and their TaskRuns and CustomRuns through
+// childRunInformerFactory, so the two can be scoped differently. When
+// namespaces is not empty, only objects from those namespaces are persisted,
+// and of those only the ones owned by shard. When deadLetters is not nil, a
+// resource that fails to be reported maxReportRetries times in a row is moved
+// there instead of being retried.
 func NewPersistenceAgent(
 	swfInformerFactory swfinformers.SharedInformerFactory,
 	workflowInformerFactory workflowinformers.SharedInformerFactory,
+	childRunInformerFactory workflowinformers.SharedInformerFactory,
 	clientset *wfclientset.Clientset,
 	pipelineClient *client.PipelineClient,
+	namespaces []string,
+	shard worker.ShardOptions,
+	deadLetters *worker.DeadLetterQueue,
+	maxReportRetries int,
+	runEvents *worker.RunEventRecorder,
 	time util.TimeInterface) *PersistenceAgent {
 	// obtain references to shared informers
 	swfInformer := swfInformerFactory.Scheduledworkflow().V1beta1().ScheduledWorkflows()
 	prInformer := workflowInformerFactory.Tekton().V1().PipelineRuns()
-	trInformer := workflowInformerFactory.Tekton().V1().TaskRuns()
-	crInformer := workflowInformerFactory.Tekton().V1beta1().CustomRuns()
+	trInformer := childRunInformerFactory.Tekton().V1().TaskRuns()
+	crInformer := childRunInformerFactory.Tekton().V1beta1().CustomRuns()
 
 	// Add controller types to the default Kubernetes Scheme so Events can be
 	// logged for controller types.
@@ -67,22 +81,36 @@ func NewPersistenceAgent(
 		CRInformer: crInformer,
 	}, clientset)
 
-	swfWorker := worker.NewPersistenceWorker(time, swfregister.Kind, swfInformer.Informer(), true,
+	swfWorker := worker.NewPersistenceWorker(time, swfregister.Kind,
+		worker.NewShardFilter(worker.NewNamespaceFilter(swfInformer.Informer(), namespaces), shard), true,
 		worker.NewScheduledWorkflowSaver(swfClient, pipelineClient))
 
+	var reportBatcher *worker.ReportBatcher
+	if reportBatchSize > 1 {
+		reportBatcher = worker.NewReportBatcher(pipelineClient, reportBatchSize, reportBatchInterval)
+	}
+
 	workflowWorker := worker.NewPersistenceWorker(time, workflowregister.PipelineRunControllerName,
-		prInformer.Informer(), true,
-		worker.NewWorkflowSaver(workflowClient, pipelineClient, ttlSecondsAfterWorkflowFinish))
+		worker.NewShardFilter(worker.NewNamespaceFilter(
+			worker.NewChildRunEventHandler(prInformer.Informer(), crInformer.Informer()), namespaces), shard), true,
+		newWorkflowSaver(workflowClient, pipelineClient, reportBatcher, runEvents))
+
+	if deadLetters != nil {
+		swfWorker.WithDeadLetterQueue(deadLetters, maxReportRetries)
+		workflowWorker.WithDeadLetterQueue(deadLetters, maxReportRetries)
+	}
 
-	// register TaskRun and CustomRun Informers
+	// register the TaskRun Informer, the CustomRun one is registered by the
+	// workflow worker
 	trInformer.Informer()
-	crInformer.Informer()
 
 	agent := &PersistenceAgent{
 		swfClient:      swfClient,
 		workflowClient: workflowClient,
 		swfWorker:      swfWorker,
 		workflowWorker: workflowWorker,
+		deadLetters:    deadLetters,
+		reportBatcher:  reportBatcher,
 	}
 
 	log.Info("Setting up event handlers")
@@ -111,6 +139,10 @@ func (p *PersistenceAgent) Run(threadiness int, stopCh <-chan struct{}) error {
 		return fmt.Errorf("Failed to wait for caches to sync")
 	}
 
+	if p.reportBatcher != nil {
+		go p.reportBatcher.Run(stopCh)
+	}
+
 	// Launch multiple workers to process ScheduledWorkflows
 	log.Info("Starting workers")
 	for i := 0; i < threadiness; i++ {
@@ -125,3 +157,55 @@ func (p *PersistenceAgent) Run(threadiness int, stopCh <-chan struct{}) error {
 
 	return nil
 }
+
+func newWorkflowSaver(workflowClient *client.WorkflowClient, pipelineClient *client.PipelineClient,
+	reportBatcher *worker.ReportBatcher, runEvents *worker.RunEventRecorder) *worker.WorkflowSaver {
+	saver := worker.NewWorkflowSaver(workflowClient, pipelineClient, ttlSecondsAfterWorkflowFinish, workflowGCPolicy)
+	if statusDeltaReporting {
+		saver.WithStatusDeltas(worker.NewStatusDeltaTracker())
+	}
+	if reportBatcher != nil {
+		saver.WithReportBatcher(reportBatcher)
+	}
+	if archiveLogs {
+		saver.WithLogArchiver(worker.NewLogArchiver(pipelineClient))
+	}
+	if runEvents != nil {
+		saver.WithRunEvents(runEvents)
+	}
+	return saver
+}
+
+// DeadLetters returns the resources the workers gave up on.
+func (p *PersistenceAgent) DeadLetters() ([]*client.DeadLetter, error) {
+	if p.deadLetters == nil {
+		return nil, nil
+	}
+	return p.deadLetters.List()
+}
+
+// Requeue schedules a resource the named worker gave up on to be reported
+// again. When key is empty, every resource of that worker is requeued.
+func (p *PersistenceAgent) Requeue(workerName string, key string) error {
+	var w *worker.PersistenceWorker
+	switch workerName {
+	case p.swfWorker.Name():
+		w = p.swfWorker
+	case p.workflowWorker.Name():
+		w = p.workflowWorker
+	default:
+		return util.NewInvalidInputError("Unknown worker %q", workerName)
+	}
+	if key != "" {
+		return w.Requeue(key)
+	}
+	if p.deadLetters == nil {
+		return nil
+	}
+	for _, key := range p.deadLetters.Keys(workerName) {
+		if err := w.Requeue(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
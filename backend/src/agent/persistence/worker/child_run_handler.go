@@ -0,0 +1,83 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ChildRunEventHandler wraps the EventHandler of PipelineRuns so that the
+// registered handlers also see a change of the PipelineRun when one of its
+// child runs changes. This way the status of a CustomRun, such as a loop
+// iteration, is persisted even if the PipelineRun itself did not change before
+// the CustomRun is garbage collected.
+type ChildRunEventHandler struct {
+	eventHandler EventHandler
+	childRuns    []EventHandler
+}
+
+func NewChildRunEventHandler(eventHandler EventHandler, childRuns ...EventHandler) *ChildRunEventHandler {
+	return &ChildRunEventHandler{
+		eventHandler: eventHandler,
+		childRuns:    childRuns,
+	}
+}
+
+func (h *ChildRunEventHandler) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	registration, err := h.eventHandler.AddEventHandler(handler)
+	if err != nil {
+		return nil, err
+	}
+	notifyParent := func(obj interface{}) {
+		if key, ok := parentRunKey(obj); ok {
+			handler.OnUpdate(key, key)
+		}
+	}
+	for _, childRuns := range h.childRuns {
+		_, err := childRuns.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: notifyParent,
+			UpdateFunc: func(old, new interface{}) {
+				notifyParent(new)
+			},
+			DeleteFunc: notifyParent,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return registration, nil
+}
+
+// parentRunKey returns the key of the PipelineRun of a KFP run that created a
+// child run. Child runs of nested PipelineRuns, which are not KFP runs, have
+// no parent key.
+func parentRunKey(obj interface{}) (cache.ExplicitKey, bool) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	object, err := meta.Accessor(obj)
+	if err != nil {
+		return "", false
+	}
+	labels := object.GetLabels()
+	parent := labels[pipeline.PipelineRunLabelKey]
+	if parent == "" || labels[util.LabelKeyWorkflowRunId] == "" {
+		return "", false
+	}
+	return cache.ExplicitKey(object.GetNamespace() + "/" + parent), true
+}
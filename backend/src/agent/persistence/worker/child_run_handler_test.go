@@ -0,0 +1,79 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"testing"
+
+	client "github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newCustomRun(name string, labels map[string]string) *v1beta1.CustomRun {
+	return &v1beta1.CustomRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "MY_NAMESPACE",
+			Name:      name,
+			Labels:    labels,
+		},
+	}
+}
+
+func TestChildRunEventHandler(t *testing.T) {
+	eventHandler := NewFakeEventHandler()
+	customRunHandler := NewFakeEventHandler()
+	saver := NewWorkflowSaver(client.NewWorkflowClientFake(), client.NewPipelineClientFake(), 100, WorkflowGCPolicyReport)
+	worker := NewPersistenceWorker(
+		util.NewFakeTimeForEpoch(),
+		"PERSISTENCE_WORKER",
+		NewChildRunEventHandler(eventHandler, customRunHandler),
+		false,
+		saver)
+
+	// A CustomRun of a KFP run enqueues its PipelineRun.
+	customRunHandler.handler.OnUpdate(nil, newCustomRun("MY_LOOP", map[string]string{
+		pipeline.PipelineRunLabelKey: "MY_NAME",
+		util.LabelKeyWorkflowRunId:   "MY_UUID",
+	}))
+	assert.Equal(t, 1, worker.Len())
+	key, _ := worker.workqueue.Get()
+	assert.Equal(t, "MY_NAMESPACE/MY_NAME", key)
+	worker.workqueue.Done(key)
+
+	// A CustomRun of a nested PipelineRun does not.
+	customRunHandler.handler.OnAdd(newCustomRun("MY_NESTED_LOOP", map[string]string{
+		pipeline.PipelineRunLabelKey: "MY_ITERATION",
+	}), false)
+	assert.Equal(t, 0, worker.Len())
+
+	// Garbage collected CustomRuns still enqueue their PipelineRun.
+	customRunHandler.handler.OnDelete(cache.DeletedFinalStateUnknown{
+		Key: "MY_NAMESPACE/MY_LOOP",
+		Obj: newCustomRun("MY_LOOP", map[string]string{
+			pipeline.PipelineRunLabelKey: "MY_NAME",
+			util.LabelKeyWorkflowRunId:   "MY_UUID",
+		}),
+	})
+	assert.Equal(t, 1, worker.Len())
+
+	// PipelineRun events still go through.
+	eventHandler.handler.OnAdd(newNamespacedWorkflow("NS_A"), false)
+	assert.Equal(t, 2, worker.Len())
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+)
+
+// DeadLetterQueue records the resources that persistence workers gave up on,
+// so that they are no longer retried until an operator requeues them.
+type DeadLetterQueue struct {
+	store client.DeadLetterStoreInterface
+
+	mutex sync.Mutex
+	// keys holds the dead letters per worker name, mirroring the store so that
+	// every enqueue does not have to read it.
+	keys map[string]map[string]bool
+}
+
+// NewDeadLetterQueue creates a DeadLetterQueue, loading the dead letters left
+// in the store by a previous run.
+func NewDeadLetterQueue(store client.DeadLetterStoreInterface) (*DeadLetterQueue, error) {
+	deadLetters, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	q := &DeadLetterQueue{
+		store: store,
+		keys:  make(map[string]map[string]bool),
+	}
+	for _, deadLetter := range deadLetters {
+		q.setKey(deadLetter.Worker, deadLetter.Key, true)
+	}
+	return q, nil
+}
+
+// Contains returns whether the worker gave up on the resource with this key.
+func (q *DeadLetterQueue) Contains(worker string, key string) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.keys[worker][key]
+}
+
+// Add records that the worker gave up on the resource with this key.
+func (q *DeadLetterQueue) Add(worker string, key string, attempts int, err error) error {
+	if storeErr := q.store.Put(client.NewDeadLetter(worker, key, attempts, err, time.Now())); storeErr != nil {
+		return storeErr
+	}
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.setKey(worker, key, true)
+	return nil
+}
+
+// Remove drops the dead letter of the resource with this key.
+func (q *DeadLetterQueue) Remove(worker string, key string) error {
+	if err := q.store.Delete(worker, key); err != nil {
+		return err
+	}
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.setKey(worker, key, false)
+	return nil
+}
+
+// Keys returns the keys of the resources the worker gave up on.
+func (q *DeadLetterQueue) Keys(worker string) []string {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	keys := make([]string, 0, len(q.keys[worker]))
+	for key := range q.keys[worker] {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// List returns the dead letters with the error of their last attempt.
+func (q *DeadLetterQueue) List() ([]*client.DeadLetter, error) {
+	return q.store.List()
+}
+
+func (q *DeadLetterQueue) setKey(worker string, key string, dead bool) {
+	if dead {
+		if q.keys[worker] == nil {
+			q.keys[worker] = make(map[string]bool)
+		}
+		q.keys[worker][key] = true
+	} else {
+		delete(q.keys[worker], key)
+	}
+	deadLetters.WithLabelValues(worker).Set(float64(len(q.keys[worker])))
+}
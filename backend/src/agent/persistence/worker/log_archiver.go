@@ -0,0 +1,98 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	wfapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// LogArchiver copies the logs of completed TaskRun pods to the log archive and
+// records where they were written, so that the run logs can still be served
+// once the pods are garbage collected.
+type LogArchiver struct {
+	pipelineClient client.PipelineClientInterface
+	mutex          sync.Mutex
+	// archived holds, per workflow key, the object store key of the log of
+	// each pod archived so far.
+	archived map[string]map[string]string
+}
+
+func NewLogArchiver(pipelineClient client.PipelineClientInterface) *LogArchiver {
+	return &LogArchiver{
+		pipelineClient: pipelineClient,
+		archived:       make(map[string]map[string]string),
+	}
+}
+
+// Archive archives the logs of the TaskRuns of the workflow that completed
+// since the previous call and records the locations of all the archived logs
+// in the workflow annotations. A log that fails to be archived is retried on
+// the next call.
+func (a *LogArchiver) Archive(key string, wf *util.Workflow) {
+	statusesJSON, ok := wf.Annotations[util.AnnotationKeyTaskRunStatuses]
+	if !ok {
+		return
+	}
+	statuses := make(map[string]*wfapi.PipelineRunTaskRunStatus)
+	if err := json.Unmarshal([]byte(statusesJSON), &statuses); err != nil {
+		log.Errorf("Archiving logs of Workflow (%v): failed to parse the TaskRun statuses: %v", key, err)
+		return
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	archived, ok := a.archived[key]
+	if !ok {
+		archived = make(map[string]string)
+		a.archived[key] = archived
+	}
+	for _, status := range statuses {
+		if status == nil || status.Status == nil || status.Status.CompletionTime == nil {
+			continue
+		}
+		podName := status.Status.PodName
+		if podName == "" || archived[podName] != "" {
+			continue
+		}
+		objectKey, err := a.pipelineClient.ArchiveLog(wf, podName)
+		if err != nil {
+			log.Errorf("Archiving logs of Workflow (%v): failed to archive the log of pod %v: %v", key, podName, err)
+			continue
+		}
+		archived[podName] = objectKey
+	}
+	if len(archived) == 0 {
+		return
+	}
+	archivedJSON, err := json.Marshal(archived)
+	if err != nil {
+		log.Errorf("Archiving logs of Workflow (%v): failed to record the archived logs: %v", key, err)
+		return
+	}
+	wf.SetAnnotations(util.AnnotationKeyArchivedLogs, string(archivedJSON))
+}
+
+// Forget drops what is known about the archived logs of a workflow.
+func (a *LogArchiver) Forget(key string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.archived, key)
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogArchiver_Archive(t *testing.T) {
+	pipelineFake := client.NewPipelineClientFake()
+	archiver := NewLogArchiver(pipelineFake)
+
+	wf := newWorkflowWithTaskRuns(`{
+		"task-a":{"status":{"podName":"pod-a","completionTime":"2023-01-01T00:00:00Z"}},
+		"task-b":{"status":{"podName":"pod-b"}}}`)
+	archiver.Archive("MY_NAMESPACE/MY_NAME", wf)
+
+	archived := map[string]string{}
+	assert.Nil(t, json.Unmarshal([]byte(wf.Annotations[util.AnnotationKeyArchivedLogs]), &archived))
+	assert.Equal(t, map[string]string{"pod-a": "logs/MY_NAMESPACE/MY_NAME/pod-a.log"}, archived)
+
+	// A failed archival is retried on the next call, completed ones are not.
+	pipelineFake.SetArchiveLogError(fmt.Errorf("object store unavailable"))
+	wf = newWorkflowWithTaskRuns(`{
+		"task-a":{"status":{"podName":"pod-a","completionTime":"2023-01-01T00:00:00Z"}},
+		"task-b":{"status":{"podName":"pod-b","completionTime":"2023-01-01T00:01:00Z"}}}`)
+	archiver.Archive("MY_NAMESPACE/MY_NAME", wf)
+	assert.Nil(t, json.Unmarshal([]byte(wf.Annotations[util.AnnotationKeyArchivedLogs]), &archived))
+	assert.Equal(t, map[string]string{"pod-a": "logs/MY_NAMESPACE/MY_NAME/pod-a.log"}, archived)
+
+	pipelineFake.SetArchiveLogError(nil)
+	archiver.Archive("MY_NAMESPACE/MY_NAME", wf)
+	assert.Nil(t, json.Unmarshal([]byte(wf.Annotations[util.AnnotationKeyArchivedLogs]), &archived))
+	assert.Equal(t, map[string]string{
+		"pod-a": "logs/MY_NAMESPACE/MY_NAME/pod-a.log",
+		"pod-b": "logs/MY_NAMESPACE/MY_NAME/pod-b.log",
+	}, archived)
+}
+
+func TestLogArchiver_NoCompletedTaskRuns(t *testing.T) {
+	archiver := NewLogArchiver(client.NewPipelineClientFake())
+
+	wf := newWorkflowWithTaskRuns(`{"task-a":{"status":{"podName":"pod-a"}}}`)
+	archiver.Archive("MY_NAMESPACE/MY_NAME", wf)
+
+	_, ok := wf.Annotations[util.AnnotationKeyArchivedLogs]
+	assert.False(t, ok)
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metric variables. Please prefix the metric names with persistence_agent_.
+// The worker metrics are labelled with the name of the worker, i.e. the kind
+// of resource it persists.
+var (
+	reportDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "persistence_agent_report_duration_seconds",
+		Help:    "The time taken to persist a resource, by outcome of the attempt",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
+	}, []string{"worker", "result"})
+
+	reportRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "persistence_agent_report_retries",
+		Help: "The number of resources requeued after a transient failure to persist them",
+	}, []string{"worker"})
+
+	queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "persistence_agent_queue_depth",
+		Help: "The number of resources ready to be picked up from the work queue",
+	}, []string{"worker"})
+
+	pendingResources = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "persistence_agent_pending_resources",
+		Help: "The number of resources that changed in the cluster and are not persisted yet, including those waiting for a retry",
+	}, []string{"worker"})
+
+	deadLetters = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "persistence_agent_dead_letters",
+		Help: "The number of resources that are no longer retried after repeated failures to persist them",
+	}, []string{"worker"})
+
+	reportBatchSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "persistence_agent_report_batch_size",
+		Help:    "The number of workflow reports sent to the API server in one batch",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 8),
+	})
+
+	reportBatchPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "persistence_agent_report_batch_pending",
+		Help: "The number of workflow reports waiting for the next batch",
+	})
+)
+
+const (
+	resultSuccess   = "success"
+	resultTransient = "transient_failure"
+	resultPermanent = "permanent_failure"
+)
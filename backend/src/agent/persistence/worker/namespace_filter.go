@@ -0,0 +1,63 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"k8s.io/client-go/tools/cache"
+)
+
+// NamespaceFilter wraps an EventHandler so that the registered handlers only
+// see objects from an allowed set of namespaces. It is used when the
+// persistence agent watches several namespaces through a cluster-wide
+// informer, since the API server cannot filter on a set of namespaces.
+type NamespaceFilter struct {
+	eventHandler EventHandler
+	namespaces   map[string]bool
+}
+
+// NewNamespaceFilter returns a NamespaceFilter that lets through objects from
+// the given namespaces. An empty list lets through every namespace.
+func NewNamespaceFilter(eventHandler EventHandler, namespaces []string) *NamespaceFilter {
+	allowed := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		allowed[namespace] = true
+	}
+	return &NamespaceFilter{
+		eventHandler: eventHandler,
+		namespaces:   allowed,
+	}
+}
+
+func (f *NamespaceFilter) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	return f.eventHandler.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: f.allowed,
+		Handler:    handler,
+	})
+}
+
+func (f *NamespaceFilter) allowed(obj interface{}) bool {
+	if len(f.namespaces) == 0 {
+		return true
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return false
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return false
+	}
+	return f.namespaces[namespace]
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"testing"
+
+	client "github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newNamespacedWorkflow(namespace string) *util.Workflow {
+	return util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "MY_NAME",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "MY_UUID"},
+		},
+	})
+}
+
+func TestNamespaceFilter_AllowedNamespaces(t *testing.T) {
+	eventHandler := NewFakeEventHandler()
+	saver := NewWorkflowSaver(client.NewWorkflowClientFake(), client.NewPipelineClientFake(), 100, WorkflowGCPolicyReport)
+	worker := NewPersistenceWorker(
+		util.NewFakeTimeForEpoch(),
+		"PERSISTENCE_WORKER",
+		NewNamespaceFilter(eventHandler, []string{"NS_A", "NS_B"}),
+		false,
+		saver)
+
+	eventHandler.handler.OnAdd(newNamespacedWorkflow("NS_A"), false)
+	eventHandler.handler.OnUpdate(nil, newNamespacedWorkflow("NS_B"))
+	eventHandler.handler.OnAdd(newNamespacedWorkflow("NS_C"), false)
+	eventHandler.handler.OnDelete(cache.DeletedFinalStateUnknown{
+		Key: "NS_D/MY_NAME",
+		Obj: newNamespacedWorkflow("NS_D"),
+	})
+	assert.Equal(t, 2, worker.Len())
+}
+
+func TestNamespaceFilter_EmptyListAllowsAll(t *testing.T) {
+	eventHandler := NewFakeEventHandler()
+	saver := NewWorkflowSaver(client.NewWorkflowClientFake(), client.NewPipelineClientFake(), 100, WorkflowGCPolicyReport)
+	worker := NewPersistenceWorker(
+		util.NewFakeTimeForEpoch(),
+		"PERSISTENCE_WORKER",
+		NewNamespaceFilter(eventHandler, nil),
+		false,
+		saver)
+
+	eventHandler.handler.OnAdd(newNamespacedWorkflow("NS_A"), false)
+	eventHandler.handler.OnAdd(newNamespacedWorkflow("NS_C"), false)
+	assert.Equal(t, 2, worker.Len())
+}
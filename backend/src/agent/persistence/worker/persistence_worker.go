@@ -16,6 +16,7 @@ package worker
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -54,6 +55,21 @@ type PersistenceWorker struct {
 	time                 util.TimeInterface
 	enforceRequeueDelays bool
 	saver                Saver
+
+	// name labels the metrics of this worker.
+	name string
+	// pending tracks the keys that were enqueued and are not persisted yet.
+	// The value is bumped on every enqueue, so that a key that changes again
+	// while it is being processed stays pending.
+	pendingMutex sync.Mutex
+	pending      map[string]uint64
+	// failures counts the consecutive transient failures per key.
+	failures map[string]int
+
+	// deadLetters receives the keys that failed maxRetries times in a row.
+	// When nil, transient failures are retried forever.
+	deadLetters *DeadLetterQueue
+	maxRetries  int
 }
 
 // NewPersistenceWorker returns a new PersistenceWorker
@@ -69,6 +85,9 @@ func NewPersistenceWorker(
 		time:                 time,
 		enforceRequeueDelays: enforceRequeueDelays,
 		saver:                saver,
+		name:                 name,
+		pending:              make(map[string]uint64),
+		failures:             make(map[string]int),
 	}
 
 	log.Info("Setting up event handlers")
@@ -85,6 +104,37 @@ func NewPersistenceWorker(
 	return worker
 }
 
+// WithDeadLetterQueue makes the worker give up on a resource after maxRetries
+// consecutive transient failures, and record it in deadLetters instead.
+func (p *PersistenceWorker) WithDeadLetterQueue(deadLetters *DeadLetterQueue, maxRetries int) *PersistenceWorker {
+	p.deadLetters = deadLetters
+	p.maxRetries = maxRetries
+	return p
+}
+
+// Name returns the name of the worker.
+func (p *PersistenceWorker) Name() string {
+	return p.name
+}
+
+// Requeue removes the resource with this key from the dead letters and
+// schedules it to be persisted again.
+func (p *PersistenceWorker) Requeue(key string) error {
+	if p.deadLetters == nil {
+		return util.NewInvalidInputError("Worker %s has no dead letter queue", p.name)
+	}
+	if !p.deadLetters.Contains(p.name, key) {
+		return util.NewResourceNotFoundError("DeadLetter", key)
+	}
+	if err := p.deadLetters.Remove(p.name, key); err != nil {
+		return err
+	}
+	p.markPending(key)
+	p.workqueue.Add(key)
+	queueDepth.WithLabelValues(p.name).Set(float64(p.workqueue.Len()))
+	return nil
+}
+
 func (p *PersistenceWorker) Shutdown() {
 	p.workqueue.ShutDown()
 }
@@ -110,20 +160,74 @@ func (p *PersistenceWorker) enqueue(obj interface{}) {
 		runtime.HandleError(fmt.Errorf("Equeuing object: error: %v: %+v", err, obj))
 		return
 	}
+	if p.isDeadLetter(key) {
+		return
+	}
+	p.markPending(key)
 	if p.enforceRequeueDelays {
 		p.workqueue.AddRateLimited(key) // Exponential backoff.
 	} else {
 		p.workqueue.Add(key) // For testing.
 	}
+	queueDepth.WithLabelValues(p.name).Set(float64(p.workqueue.Len()))
 }
 
 func (p *PersistenceWorker) enqueueForDelete(obj interface{}) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
-	if err == nil {
+	if err == nil && !p.isDeadLetter(key) {
+		p.markPending(key)
 		p.workqueue.Add(key)
+		queueDepth.WithLabelValues(p.name).Set(float64(p.workqueue.Len()))
 	}
 }
 
+func (p *PersistenceWorker) isDeadLetter(key string) bool {
+	return p.deadLetters != nil && p.deadLetters.Contains(p.name, key)
+}
+
+// recordFailure returns the number of consecutive transient failures of key,
+// including this one.
+func (p *PersistenceWorker) recordFailure(key string) int {
+	p.pendingMutex.Lock()
+	defer p.pendingMutex.Unlock()
+	p.failures[key]++
+	return p.failures[key]
+}
+
+// markPending records that key has changes that are not persisted yet.
+func (p *PersistenceWorker) markPending(key string) {
+	p.pendingMutex.Lock()
+	defer p.pendingMutex.Unlock()
+	p.pending[key]++
+	pendingResources.WithLabelValues(p.name).Set(float64(len(p.pending)))
+}
+
+// pendingVersion returns the number of times key was enqueued so far.
+func (p *PersistenceWorker) pendingVersion(key string) uint64 {
+	p.pendingMutex.Lock()
+	defer p.pendingMutex.Unlock()
+	return p.pending[key]
+}
+
+// clearPending drops key from the pending resources, unless it was enqueued
+// again since version was read.
+func (p *PersistenceWorker) clearPending(key string, version uint64) {
+	p.pendingMutex.Lock()
+	defer p.pendingMutex.Unlock()
+	if p.pending[key] == version {
+		delete(p.pending, key)
+	}
+	delete(p.failures, key)
+	pendingResources.WithLabelValues(p.name).Set(float64(len(p.pending)))
+}
+
+// Pending returns the number of resources that are not persisted yet.
+func (p *PersistenceWorker) Pending() int {
+	p.pendingMutex.Lock()
+	defer p.pendingMutex.Unlock()
+	return len(p.pending)
+}
+
 // processNextWorkItem will read a single work item off the workqueue and
 // attempt to process it, by calling the syncHandler.
 func (p *PersistenceWorker) processNextWorkItem() bool {
@@ -132,6 +236,7 @@ func (p *PersistenceWorker) processNextWorkItem() bool {
 	if shutdown {
 		return false
 	}
+	queueDepth.WithLabelValues(p.name).Set(float64(p.workqueue.Len()))
 
 	// We wrap this block in a func so we can defer p.workqueue.Done.
 	return func(obj interface{}) bool {
@@ -185,11 +290,27 @@ func (p *PersistenceWorker) processNextWorkItem() bool {
 
 		// Run the syncHandler, passing it the namespace/name string of the
 		// resource to be synced.
+		version := p.pendingVersion(key)
+		start := time.Now()
 		err := p.syncHandler(key)
+		elapsed := time.Since(start).Seconds()
 		retryOnError := errorutil.HasCustomCode(err, errorutil.CUSTOM_CODE_TRANSIENT)
 		if err != nil && retryOnError {
 			// Transient failure. We will retry.
 			log.Errorf("Transient failure while syncing resource (%v): %+v", key, err)
+			reportDuration.WithLabelValues(p.name, resultTransient).Observe(elapsed)
+			if failures := p.recordFailure(key); p.deadLetters != nil && failures >= p.maxRetries {
+				// Give up until an operator requeues the resource.
+				if dlqErr := p.deadLetters.Add(p.name, key, failures, err); dlqErr == nil {
+					log.Errorf("Giving up on resource (%v) after %d failures, moved it to the dead letters", key, failures)
+					p.clearPending(key, p.pendingVersion(key))
+					p.workqueue.Forget(obj)
+					return true
+				} else {
+					log.Errorf("Failed to move resource (%v) to the dead letters: %+v", key, dlqErr)
+				}
+			}
+			reportRetries.WithLabelValues(p.name).Inc()
 			if p.enforceRequeueDelays {
 				p.workqueue.AddRateLimited(obj) // Exponential backoff.
 			} else {
@@ -200,12 +321,16 @@ func (p *PersistenceWorker) processNextWorkItem() bool {
 			// Permanent failure. We won't retry.
 			// Will resync after the SharedInformerFactory defaultResync delay.
 			log.Errorf("Permanent failure while syncing resource (%v): %+v", key, err)
+			reportDuration.WithLabelValues(p.name, resultPermanent).Observe(elapsed)
+			p.clearPending(key, version)
 			p.workqueue.Forget(obj)
 			return true
 		} else {
 			// Success.
 			// Will resync after the SharedInformerFactory defaultResync delay.
 			log.Infof("Success while syncing resource (%v)", key)
+			reportDuration.WithLabelValues(p.name, resultSuccess).Observe(elapsed)
+			p.clearPending(key, version)
 			p.workqueue.Forget(obj)
 			return true
 		}
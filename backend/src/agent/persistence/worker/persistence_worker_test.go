@@ -20,8 +20,10 @@ import (
 
 	client "github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/cache"
@@ -56,7 +58,7 @@ func TestPersistenceWorker_Success(t *testing.T) {
 	pipelineClient := client.NewPipelineClientFake()
 
 	// Set up peristence worker
-	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100)
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100, WorkflowGCPolicyReport)
 	eventHandler := NewFakeEventHandler()
 	worker := NewPersistenceWorker(
 		util.NewFakeTimeForEpoch(),
@@ -70,6 +72,7 @@ func TestPersistenceWorker_Success(t *testing.T) {
 	worker.processNextWorkItem()
 	assert.Equal(t, workflow, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
 	assert.Equal(t, 0, worker.Len())
+	assert.Equal(t, 0, worker.Pending())
 }
 
 func TestPersistenceWorker_NotFoundError(t *testing.T) {
@@ -86,7 +89,7 @@ func TestPersistenceWorker_NotFoundError(t *testing.T) {
 	pipelineClient := client.NewPipelineClientFake()
 
 	// Set up peristence worker
-	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100)
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100, WorkflowGCPolicyReport)
 	eventHandler := NewFakeEventHandler()
 	worker := NewPersistenceWorker(
 		util.NewFakeTimeForEpoch(),
@@ -100,6 +103,7 @@ func TestPersistenceWorker_NotFoundError(t *testing.T) {
 	worker.processNextWorkItem()
 	assert.Nil(t, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
 	assert.Equal(t, 0, worker.Len())
+	assert.Equal(t, 0, worker.Pending())
 }
 
 func TestPersistenceWorker_GetWorklowError(t *testing.T) {
@@ -117,7 +121,7 @@ func TestPersistenceWorker_GetWorklowError(t *testing.T) {
 	pipelineClient := client.NewPipelineClientFake()
 
 	// Set up peristence worker
-	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100)
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100, WorkflowGCPolicyReport)
 	eventHandler := NewFakeEventHandler()
 	worker := NewPersistenceWorker(
 		util.NewFakeTimeForEpoch(),
@@ -131,6 +135,7 @@ func TestPersistenceWorker_GetWorklowError(t *testing.T) {
 	worker.processNextWorkItem()
 	assert.Nil(t, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
 	assert.Equal(t, 1, worker.Len())
+	assert.Equal(t, 1, worker.Pending())
 }
 
 func TestPersistenceWorker_ReportWorkflowRetryableError(t *testing.T) {
@@ -151,7 +156,7 @@ func TestPersistenceWorker_ReportWorkflowRetryableError(t *testing.T) {
 		"My Retriable Error"))
 
 	// Set up peristence worker
-	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100)
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100, WorkflowGCPolicyReport)
 	eventHandler := NewFakeEventHandler()
 	worker := NewPersistenceWorker(
 		util.NewFakeTimeForEpoch(),
@@ -165,6 +170,7 @@ func TestPersistenceWorker_ReportWorkflowRetryableError(t *testing.T) {
 	worker.processNextWorkItem()
 	assert.Nil(t, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
 	assert.Equal(t, 1, worker.Len())
+	assert.Equal(t, 1, worker.Pending())
 }
 
 func TestPersistenceWorker_ReportWorkflowNonRetryableError(t *testing.T) {
@@ -184,7 +190,7 @@ func TestPersistenceWorker_ReportWorkflowNonRetryableError(t *testing.T) {
 		"My Permanent Error"))
 
 	// Set up peristence worker
-	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100)
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100, WorkflowGCPolicyReport)
 	eventHandler := NewFakeEventHandler()
 	worker := NewPersistenceWorker(
 		util.NewFakeTimeForEpoch(),
@@ -198,4 +204,115 @@ func TestPersistenceWorker_ReportWorkflowNonRetryableError(t *testing.T) {
 	worker.processNextWorkItem()
 	assert.Nil(t, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
 	assert.Equal(t, 0, worker.Len())
+	assert.Equal(t, 0, worker.Pending())
+}
+
+func TestPersistenceWorker_Metrics(t *testing.T) {
+	// Set up workflow client
+	workflow := util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "MY_NAMESPACE",
+			Name:      "MY_NAME",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "MY_UUID"},
+		},
+	})
+	workflowClient := client.NewWorkflowClientFake()
+	workflowClient.Put("MY_NAMESPACE", "MY_NAME", workflow)
+
+	// Set up pipeline client
+	pipelineClient := client.NewPipelineClientFake()
+	pipelineClient.SetError(util.NewCustomError(fmt.Errorf("Error"), util.CUSTOM_CODE_TRANSIENT,
+		"My Retriable Error"))
+
+	// Set up peristence worker
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100, WorkflowGCPolicyReport)
+	eventHandler := NewFakeEventHandler()
+	worker := NewPersistenceWorker(
+		util.NewFakeTimeForEpoch(),
+		"METRICS_WORKER",
+		eventHandler,
+		false,
+		saver)
+
+	// Test
+	eventHandler.handler.OnAdd(workflow, false)
+	assert.Equal(t, float64(1), testutil.ToFloat64(queueDepth.WithLabelValues("METRICS_WORKER")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(pendingResources.WithLabelValues("METRICS_WORKER")))
+
+	worker.processNextWorkItem()
+	assert.Equal(t, float64(1), testutil.ToFloat64(reportRetries.WithLabelValues("METRICS_WORKER")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(pendingResources.WithLabelValues("METRICS_WORKER")))
+
+	pipelineClient.SetError(nil)
+	worker.processNextWorkItem()
+	assert.Equal(t, workflow, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
+	assert.Equal(t, float64(1), testutil.ToFloat64(reportRetries.WithLabelValues("METRICS_WORKER")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(queueDepth.WithLabelValues("METRICS_WORKER")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(pendingResources.WithLabelValues("METRICS_WORKER")))
+}
+
+func TestPersistenceWorker_DeadLetter(t *testing.T) {
+	// Set up workflow client
+	workflow := util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "MY_NAMESPACE",
+			Name:      "MY_NAME",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "MY_UUID"},
+		},
+	})
+	workflowClient := client.NewWorkflowClientFake()
+	workflowClient.Put("MY_NAMESPACE", "MY_NAME", workflow)
+
+	// Set up pipeline client
+	pipelineClient := client.NewPipelineClientFake()
+	pipelineClient.SetError(util.NewCustomError(fmt.Errorf("Error"), util.CUSTOM_CODE_TRANSIENT,
+		"My Retriable Error"))
+
+	// Set up peristence worker
+	deadLetters, err := NewDeadLetterQueue(client.NewDeadLetterStoreFake())
+	assert.Nil(t, err)
+	saver := NewWorkflowSaver(workflowClient, pipelineClient, 100, WorkflowGCPolicyReport)
+	eventHandler := NewFakeEventHandler()
+	worker := NewPersistenceWorker(
+		util.NewFakeTimeForEpoch(),
+		"PERSISTENCE_WORKER",
+		eventHandler,
+		false,
+		saver).WithDeadLetterQueue(deadLetters, 2)
+
+	// Test
+	eventHandler.handler.OnAdd(workflow, false)
+	worker.processNextWorkItem()
+	assert.Equal(t, 1, worker.Len())
+	worker.processNextWorkItem()
+	assert.Equal(t, 0, worker.Len())
+	assert.Equal(t, 0, worker.Pending())
+	assert.True(t, deadLetters.Contains("PERSISTENCE_WORKER", "MY_NAMESPACE/MY_NAME"))
+
+	// A resync does not retry the dead letter.
+	eventHandler.handler.OnUpdate(workflow, workflow)
+	assert.Equal(t, 0, worker.Len())
+
+	// A requeue does.
+	pipelineClient.SetError(nil)
+	assert.Nil(t, worker.Requeue("MY_NAMESPACE/MY_NAME"))
+	assert.False(t, deadLetters.Contains("PERSISTENCE_WORKER", "MY_NAMESPACE/MY_NAME"))
+	worker.processNextWorkItem()
+	assert.Equal(t, workflow, pipelineClient.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
+	assert.Equal(t, 0, worker.Pending())
+}
+
+func TestPersistenceWorker_RequeueUnknownDeadLetter(t *testing.T) {
+	deadLetters, err := NewDeadLetterQueue(client.NewDeadLetterStoreFake())
+	assert.Nil(t, err)
+	worker := NewPersistenceWorker(
+		util.NewFakeTimeForEpoch(),
+		"PERSISTENCE_WORKER",
+		NewFakeEventHandler(),
+		false,
+		NewWorkflowSaver(client.NewWorkflowClientFake(), client.NewPipelineClientFake(), 100, WorkflowGCPolicyReport),
+	).WithDeadLetterQueue(deadLetters, 2)
+
+	err = worker.Requeue("MY_NAMESPACE/MY_NAME")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
 }
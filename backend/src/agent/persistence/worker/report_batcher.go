@@ -0,0 +1,131 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// ReportBatcher groups the workflow reports of all the workers and sends them
+// to the API server one batch at a time, so that a burst of completing runs
+// results in a bounded number of concurrent report calls. A batch is sent once
+// it holds batchSize reports or once its first report waited for interval.
+type ReportBatcher struct {
+	pipelineClient client.PipelineClientInterface
+	batchSize      int
+	interval       time.Duration
+
+	mutex   sync.Mutex
+	pending []*batchedReport
+	stopped bool
+	full    chan struct{}
+}
+
+type batchedReport struct {
+	workflow *util.Workflow
+	err      error
+	done     chan struct{}
+}
+
+func NewReportBatcher(pipelineClient client.PipelineClientInterface, batchSize int, interval time.Duration) *ReportBatcher {
+	return &ReportBatcher{
+		pipelineClient: pipelineClient,
+		batchSize:      batchSize,
+		interval:       interval,
+		full:           make(chan struct{}, 1),
+	}
+}
+
+// ReportWorkflow queues the report of a workflow for the next batch and waits
+// until it was sent.
+func (b *ReportBatcher) ReportWorkflow(workflow *util.Workflow) error {
+	report := &batchedReport{workflow: workflow, done: make(chan struct{})}
+	b.mutex.Lock()
+	if b.stopped {
+		b.mutex.Unlock()
+		return util.NewCustomErrorf(util.CUSTOM_CODE_TRANSIENT,
+			"Report of Workflow (%v) not sent: the report batcher is stopped", workflow.Name)
+	}
+	b.pending = append(b.pending, report)
+	reportBatchPending.Set(float64(len(b.pending)))
+	if len(b.pending) >= b.batchSize {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+	b.mutex.Unlock()
+
+	<-report.done
+	return report.err
+}
+
+// Run sends the queued reports until stopCh is closed. The reports still
+// queued at that point fail with a transient error, so that they are retried.
+func (b *ReportBatcher) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			b.stop()
+			return
+		case <-ticker.C:
+		case <-b.full:
+		}
+		b.flush()
+	}
+}
+
+func (b *ReportBatcher) takeBatch() []*batchedReport {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	batch := b.pending
+	if len(batch) > b.batchSize {
+		batch = batch[:b.batchSize]
+	}
+	b.pending = b.pending[len(batch):]
+	reportBatchPending.Set(float64(len(b.pending)))
+	return batch
+}
+
+func (b *ReportBatcher) flush() {
+	batch := b.takeBatch()
+	if len(batch) == 0 {
+		return
+	}
+	reportBatchSize.Observe(float64(len(batch)))
+	for _, report := range batch {
+		report.err = b.pipelineClient.ReportWorkflow(report.workflow)
+		close(report.done)
+	}
+}
+
+func (b *ReportBatcher) stop() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.stopped = true
+	for _, report := range b.pending {
+		report.err = util.NewCustomErrorf(util.CUSTOM_CODE_TRANSIENT,
+			"Report of Workflow (%v) not sent: the report batcher is stopped", report.workflow.Name)
+		close(report.done)
+	}
+	b.pending = nil
+	reportBatchPending.Set(0)
+}
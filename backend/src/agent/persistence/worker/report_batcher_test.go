@@ -0,0 +1,82 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReportBatcher_ReportWorkflow(t *testing.T) {
+	pipelineFake := client.NewPipelineClientFake()
+	batcher := NewReportBatcher(pipelineFake, 2, time.Hour)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go batcher.Run(stopCh)
+
+	// The batch is sent as soon as it is full, long before the interval.
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = batcher.ReportWorkflow(util.NewWorkflow(&workflowapi.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "MY_NAMESPACE", Name: fmt.Sprintf("MY_NAME_%d", i)},
+			}))
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	assert.NotNil(t, pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME_0"))
+	assert.NotNil(t, pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME_1"))
+}
+
+func TestReportBatcher_Interval(t *testing.T) {
+	pipelineFake := client.NewPipelineClientFake()
+	batcher := NewReportBatcher(pipelineFake, 10, 10*time.Millisecond)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go batcher.Run(stopCh)
+
+	err := batcher.ReportWorkflow(util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "MY_NAMESPACE", Name: "MY_NAME"},
+	}))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME"))
+}
+
+func TestReportBatcher_Stopped(t *testing.T) {
+	batcher := NewReportBatcher(client.NewPipelineClientFake(), 10, time.Hour)
+	stopCh := make(chan struct{})
+	close(stopCh)
+	batcher.Run(stopCh)
+
+	err := batcher.ReportWorkflow(util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "MY_NAMESPACE", Name: "MY_NAME"},
+	}))
+
+	assert.True(t, util.HasCustomCode(err, util.CUSTOM_CODE_TRANSIENT))
+}
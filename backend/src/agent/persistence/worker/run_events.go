@@ -0,0 +1,78 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// RunEventRecorder records the Events of the runs whose final state is
+// persisted on their PipelineRuns, so that kubectl describe pipelinerun shows
+// which tasks were reused from the cache and that the run was persisted.
+type RunEventRecorder struct {
+	recorder record.EventRecorder
+	pods     corev1client.PodsGetter
+}
+
+func NewRunEventRecorder(recorder record.EventRecorder, pods corev1client.PodsGetter) *RunEventRecorder {
+	return &RunEventRecorder{recorder: recorder, pods: pods}
+}
+
+// RecordPersisted records the TasksCached Event when tasks of the workflow
+// were reused from the cache, then the RunPersisted Event. The workflow must
+// come from the informer, so that the Events refer to its UID.
+func (r *RunEventRecorder) RecordPersisted(wf *util.Workflow) {
+	cachedTasks, err := r.cachedTasks(wf)
+	if err != nil {
+		log.Warningf("Recording the events of Workflow (%v): failed to list the cached tasks: %v", wf.Name, err)
+	} else if len(cachedTasks) > 0 {
+		r.recorder.Eventf(wf.PipelineRun, corev1.EventTypeNormal, util.EventReasonTasksCached,
+			"Tasks reused from the cache: %s", strings.Join(cachedTasks, ", "))
+	}
+	r.recorder.Eventf(wf.PipelineRun, corev1.EventTypeNormal, util.EventReasonRunPersisted,
+		"Final status %s of run %s persisted by Kubeflow Pipelines", wf.Condition(), wf.Labels[util.LabelKeyWorkflowRunId])
+}
+
+// cachedTasks returns the sorted names of the tasks of the workflow whose pods
+// were labelled as reused from the cache by the cache webhook.
+func (r *RunEventRecorder) cachedTasks(wf *util.Workflow) ([]string, error) {
+	pods, err := r.pods.Pods(wf.Namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=true", pipeline.PipelineRunLabelKey, wf.Name, util.LabelKeyReusedFromCache),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var tasks []string
+	for _, pod := range pods.Items {
+		task := pod.Labels[pipeline.PipelineTaskLabelKey]
+		if task == "" {
+			task = pod.Name
+		}
+		tasks = append(tasks, task)
+	}
+	sort.Strings(tasks)
+	return tasks, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+)
+
+func newTaskPod(name string, task string, cached bool) *corev1.Pod {
+	labels := map[string]string{
+		pipeline.PipelineRunLabelKey:  "MY_NAME",
+		pipeline.PipelineTaskLabelKey: task,
+	}
+	if cached {
+		labels[util.LabelKeyReusedFromCache] = "true"
+	}
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "MY_NAMESPACE", Labels: labels}}
+}
+
+func TestRunEventRecorder_RecordPersisted(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	pods := fake.NewSimpleClientset(
+		newTaskPod("pod-b", "task-b", true),
+		newTaskPod("pod-a", "task-a", true),
+		newTaskPod("pod-c", "task-c", false),
+	).CoreV1()
+	runEvents := NewRunEventRecorder(recorder, pods)
+
+	var pipelineRun workflowapi.PipelineRun
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"metadata":{"name":"MY_NAME","namespace":"MY_NAMESPACE","labels":{"`+util.LabelKeyWorkflowRunId+`":"MY_UUID"}},
+		"status":{"conditions":[{"type":"Succeeded","status":"True","reason":"Succeeded"}]}}`), &pipelineRun))
+	runEvents.RecordPersisted(util.NewWorkflow(&pipelineRun))
+
+	assert.Len(t, recorder.Events, 2)
+	assert.Equal(t, "Normal TasksCached Tasks reused from the cache: task-a, task-b", <-recorder.Events)
+	assert.Equal(t, "Normal RunPersisted Final status Succeeded of run MY_UUID persisted by Kubeflow Pipelines", <-recorder.Events)
+}
+
+func TestRunEventRecorder_NoCachedTasks(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	runEvents := NewRunEventRecorder(recorder, fake.NewSimpleClientset(newTaskPod("pod-a", "task-a", false)).CoreV1())
+
+	wf := util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "MY_NAME",
+			Namespace: "MY_NAMESPACE",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "MY_UUID"},
+		},
+	})
+	runEvents.RecordPersisted(wf)
+
+	assert.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal RunPersisted Final status  of run MY_UUID persisted by Kubeflow Pipelines", <-recorder.Events)
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"hash/fnv"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// ShardByNamespace assigns every object of a namespace to the same shard.
+	ShardByNamespace = "namespace"
+	// ShardByRun assigns each object to a shard on its own, which spreads the
+	// load evenly even when a few namespaces hold most of the runs.
+	ShardByRun = "run"
+)
+
+// ShardOptions describes the subset of objects owned by one persistence agent
+// replica. Each object is owned by exactly one of Count shards.
+type ShardOptions struct {
+	Index int
+	Count int
+	By    string
+}
+
+// ShardFilter wraps an EventHandler so that the registered handlers only see
+// the objects owned by one shard.
+type ShardFilter struct {
+	eventHandler EventHandler
+	options      ShardOptions
+}
+
+// NewShardFilter returns a ShardFilter for the given shard. A Count of one or
+// less lets through every object.
+func NewShardFilter(eventHandler EventHandler, options ShardOptions) *ShardFilter {
+	return &ShardFilter{
+		eventHandler: eventHandler,
+		options:      options,
+	}
+}
+
+func (f *ShardFilter) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	return f.eventHandler.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: f.owned,
+		Handler:    handler,
+	})
+}
+
+func (f *ShardFilter) owned(obj interface{}) bool {
+	if f.options.Count <= 1 {
+		return true
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return false
+	}
+	return ShardOf(key, f.options) == f.options.Index
+}
+
+// ShardOf returns the shard that owns the object with the given namespace/name
+// key.
+func ShardOf(key string, options ShardOptions) int {
+	if options.Count <= 1 {
+		return 0
+	}
+	if options.By != ShardByRun {
+		if namespace, _, err := cache.SplitMetaNamespaceKey(key); err == nil {
+			key = namespace
+		}
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(options.Count))
+}
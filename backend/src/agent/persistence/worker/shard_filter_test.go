@@ -0,0 +1,78 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"testing"
+
+	client "github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestShardOf_ByNamespace(t *testing.T) {
+	options := ShardOptions{Count: 4, By: ShardByNamespace}
+	shard := ShardOf("NS/RUN_1", options)
+	for i := 2; i < 20; i++ {
+		assert.Equal(t, shard, ShardOf(fmt.Sprintf("NS/RUN_%d", i), options))
+	}
+}
+
+func TestShardOf_ByRun(t *testing.T) {
+	options := ShardOptions{Count: 4, By: ShardByRun}
+	shards := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		shard := ShardOf(fmt.Sprintf("NS/RUN_%d", i), options)
+		assert.True(t, shard >= 0 && shard < 4)
+		shards[shard] = true
+	}
+	assert.Equal(t, 4, len(shards))
+}
+
+func TestShardFilter_EachObjectOwnedByOneShard(t *testing.T) {
+	const count = 3
+	eventHandlers := make([]*FakeEventHandler, count)
+	workers := make([]*PersistenceWorker, count)
+	for i := 0; i < count; i++ {
+		eventHandlers[i] = NewFakeEventHandler()
+		workers[i] = NewPersistenceWorker(
+			util.NewFakeTimeForEpoch(),
+			"PERSISTENCE_WORKER",
+			NewShardFilter(eventHandlers[i], ShardOptions{Index: i, Count: count, By: ShardByRun}),
+			false,
+			NewWorkflowSaver(client.NewWorkflowClientFake(), client.NewPipelineClientFake(), 100, WorkflowGCPolicyReport))
+	}
+
+	for i := 0; i < 30; i++ {
+		workflow := util.NewWorkflow(&workflowapi.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "MY_NAMESPACE",
+				Name:      fmt.Sprintf("MY_NAME_%d", i),
+			},
+		})
+		for _, eventHandler := range eventHandlers {
+			eventHandler.handler.OnAdd(workflow, false)
+		}
+	}
+
+	total := 0
+	for _, worker := range workers {
+		total += worker.Len()
+	}
+	assert.Equal(t, 30, total)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+var childStatusAnnotationKeys = []string{util.AnnotationKeyTaskRunStatuses, util.AnnotationKeyCustomRunStatuses}
+
+// StatusDeltaTracker remembers which TaskRun and CustomRun statuses of each run
+// were reported, so that the next report of the run only carries the ones that
+// changed. Pipelines with hundreds of tasks otherwise resend every status on
+// each update.
+type StatusDeltaTracker struct {
+	mutex  sync.Mutex
+	states map[string]*statusDeltaState
+}
+
+type statusDeltaState struct {
+	sequence int64
+	// hashes holds a hash of each reported child status, keyed by status
+	// annotation and child name.
+	hashes map[string][sha256.Size]byte
+}
+
+func NewStatusDeltaTracker() *StatusDeltaTracker {
+	return &StatusDeltaTracker{
+		states: make(map[string]*statusDeltaState),
+	}
+}
+
+// Prepare returns the workflow to report for the run with this key, and a
+// function to call once the report succeeded. The first report of a run, and
+// the report of a run in its final state, carry the full status; the others a
+// delta against the previous report.
+func (t *StatusDeltaTracker) Prepare(key string, wf *util.Workflow) (*util.Workflow, func(), error) {
+	t.mutex.Lock()
+	previous := t.states[key]
+	t.mutex.Unlock()
+
+	report := util.NewWorkflow(wf.PipelineRun.DeepCopy())
+	next := &statusDeltaState{hashes: make(map[string][sha256.Size]byte)}
+	if previous != nil {
+		next.sequence = previous.sequence
+	}
+	next.sequence++
+	delta := previous != nil && !wf.IsInFinalState()
+
+	for _, annotationKey := range childStatusAnnotationKeys {
+		statuses, err := report.ChildStatuses(annotationKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		changed := make(map[string]json.RawMessage)
+		for name, status := range statuses {
+			hashKey := annotationKey + "/" + name
+			hash := sha256.Sum256(status)
+			next.hashes[hashKey] = hash
+			if previous == nil || previous.hashes[hashKey] != hash {
+				changed[name] = status
+			}
+		}
+		if delta && len(statuses) > 0 {
+			if err := report.SetChildStatuses(annotationKey, changed); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	report.SetAnnotations(util.AnnotationKeyStatusSequence, strconv.FormatInt(next.sequence, 10))
+	if delta {
+		report.SetAnnotations(util.AnnotationKeyStatusDelta, "true")
+	}
+
+	commit := func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		if wf.IsInFinalState() {
+			// No more deltas are expected, later reports send the full status.
+			delete(t.states, key)
+		} else {
+			t.states[key] = next
+		}
+	}
+	return report, commit, nil
+}
+
+// Reset forgets what was reported for the run with this key, so that its next
+// report carries the full status.
+func (t *StatusDeltaTracker) Reset(key string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.states, key)
+}
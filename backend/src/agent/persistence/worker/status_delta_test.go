@@ -0,0 +1,103 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newWorkflowWithTaskRuns(taskRunStatuses string) *util.Workflow {
+	return util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "MY_NAMESPACE",
+			Name:        "MY_NAME",
+			Labels:      map[string]string{util.LabelKeyWorkflowRunId: "MY_UUID"},
+			Annotations: map[string]string{util.AnnotationKeyTaskRunStatuses: taskRunStatuses},
+		},
+	})
+}
+
+func TestStatusDeltaTracker_Prepare(t *testing.T) {
+	tracker := NewStatusDeltaTracker()
+
+	first := newWorkflowWithTaskRuns(`{"task-a":{"status":"running"},"task-b":{"status":"running"}}`)
+	report, commit, err := tracker.Prepare("MY_NAMESPACE/MY_NAME", first)
+	assert.Nil(t, err)
+	assert.False(t, report.IsStatusDelta())
+	assert.Equal(t, int64(1), report.StatusSequence())
+	assert.Equal(t, first.Annotations[util.AnnotationKeyTaskRunStatuses], report.Annotations[util.AnnotationKeyTaskRunStatuses])
+	commit()
+
+	second := newWorkflowWithTaskRuns(`{"task-a":{"status":"running"},"task-b":{"status":"done"}}`)
+	report, commit, err = tracker.Prepare("MY_NAMESPACE/MY_NAME", second)
+	assert.Nil(t, err)
+	assert.True(t, report.IsStatusDelta())
+	assert.Equal(t, int64(2), report.StatusSequence())
+	assert.JSONEq(t, `{"task-b":{"status":"done"}}`, report.Annotations[util.AnnotationKeyTaskRunStatuses])
+	// The workflow from the informer cache is left untouched.
+	assert.False(t, second.IsStatusDelta())
+	commit()
+
+	tracker.Reset("MY_NAMESPACE/MY_NAME")
+	report, _, err = tracker.Prepare("MY_NAMESPACE/MY_NAME", second)
+	assert.Nil(t, err)
+	assert.False(t, report.IsStatusDelta())
+	assert.Equal(t, int64(1), report.StatusSequence())
+}
+
+func TestStatusDeltaTracker_UncommittedReportIsResent(t *testing.T) {
+	tracker := NewStatusDeltaTracker()
+
+	_, commit, _ := tracker.Prepare("MY_NAMESPACE/MY_NAME", newWorkflowWithTaskRuns(`{"task-a":{"status":"running"}}`))
+	commit()
+	// A failed report is not committed.
+	tracker.Prepare("MY_NAMESPACE/MY_NAME", newWorkflowWithTaskRuns(`{"task-a":{"status":"done"}}`))
+
+	report, _, err := tracker.Prepare("MY_NAMESPACE/MY_NAME", newWorkflowWithTaskRuns(`{"task-a":{"status":"done"}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), report.StatusSequence())
+	assert.JSONEq(t, `{"task-a":{"status":"done"}}`, report.Annotations[util.AnnotationKeyTaskRunStatuses])
+}
+
+func TestWorkflow_Save_StatusDeltaOutOfSync(t *testing.T) {
+	workflowFake := client.NewWorkflowClientFake()
+	pipelineFake := client.NewPipelineClientFake()
+
+	tracker := NewStatusDeltaTracker()
+	_, commit, _ := tracker.Prepare("MY_KEY", newWorkflowWithTaskRuns(`{"task-a":{"status":"running"}}`))
+	commit()
+
+	workflow := newWorkflowWithTaskRuns(`{"task-a":{"status":"done"}}`)
+	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
+	// The API server rejects the first, incremental, report.
+	pipelineFake.SetErrorOnce(util.NewCustomError(fmt.Errorf("Error"), util.CUSTOM_CODE_OUT_OF_SYNC,
+		"My Out Of Sync Error"))
+
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport).WithStatusDeltas(tracker)
+
+	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
+
+	assert.Nil(t, err)
+	reported := pipelineFake.GetWorkflow("MY_NAMESPACE", "MY_NAME")
+	assert.False(t, reported.IsStatusDelta())
+	assert.Equal(t, int64(1), reported.StatusSequence())
+}
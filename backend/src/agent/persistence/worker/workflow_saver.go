@@ -23,24 +23,78 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 )
 
+const (
+	// WorkflowGCPolicyReport reports a persisted workflow again once its TTL
+	// passed, which makes the API server delete it.
+	WorkflowGCPolicyReport = "report"
+	// WorkflowGCPolicyDelete makes the persistence agent delete a persisted
+	// workflow once its TTL passed.
+	WorkflowGCPolicyDelete = "delete"
+	// WorkflowGCPolicyLabel only labels a persisted workflow once its TTL
+	// passed, leaving its deletion to an external process.
+	WorkflowGCPolicyLabel = "label"
+)
+
 // WorkflowSaver provides a function to persist a workflow to a database.
 type WorkflowSaver struct {
 	client                        client.WorkflowClientInterface
 	pipelineClient                client.PipelineClientInterface
+	reporter                      workflowReporter
 	metricsReporter               *MetricsReporter
 	ttlSecondsAfterWorkflowFinish int64
+	gcPolicy                      string
+	// statusDeltas, when set, makes the saver report only the TaskRun and
+	// CustomRun statuses that changed since the previous report of a run.
+	statusDeltas *StatusDeltaTracker
+	// logArchiver, when set, archives the logs of completed TaskRuns before
+	// each report.
+	logArchiver *LogArchiver
+	// runEvents, when set, records the Events of the runs whose final state
+	// is persisted.
+	runEvents *RunEventRecorder
 }
 
 func NewWorkflowSaver(client client.WorkflowClientInterface,
-	pipelineClient client.PipelineClientInterface, ttlSecondsAfterWorkflowFinish int64) *WorkflowSaver {
+	pipelineClient client.PipelineClientInterface, ttlSecondsAfterWorkflowFinish int64, gcPolicy string) *WorkflowSaver {
 	return &WorkflowSaver{
 		client:                        client,
 		pipelineClient:                pipelineClient,
+		reporter:                      pipelineClient,
 		metricsReporter:               NewMetricsReporter(pipelineClient),
 		ttlSecondsAfterWorkflowFinish: ttlSecondsAfterWorkflowFinish,
+		gcPolicy:                      gcPolicy,
 	}
 }
 
+// workflowReporter sends workflow reports to the API server.
+type workflowReporter interface {
+	ReportWorkflow(workflow *util.Workflow) error
+}
+
+// WithReportBatcher makes the saver send its reports through a batcher.
+func (s *WorkflowSaver) WithReportBatcher(reportBatcher *ReportBatcher) *WorkflowSaver {
+	s.reporter = reportBatcher
+	return s
+}
+
+// WithStatusDeltas enables incremental status reporting.
+func (s *WorkflowSaver) WithStatusDeltas(statusDeltas *StatusDeltaTracker) *WorkflowSaver {
+	s.statusDeltas = statusDeltas
+	return s
+}
+
+// WithLogArchiver enables the archival of completed TaskRun logs.
+func (s *WorkflowSaver) WithLogArchiver(logArchiver *LogArchiver) *WorkflowSaver {
+	s.logArchiver = logArchiver
+	return s
+}
+
+// WithRunEvents enables the Events recorded on the persisted PipelineRuns.
+func (s *WorkflowSaver) WithRunEvents(runEvents *RunEventRecorder) *WorkflowSaver {
+	s.runEvents = runEvents
+	return s
+}
+
 func (s *WorkflowSaver) Save(key string, namespace string, name string, nowEpoch int64) error {
 	// Get the Workflow with this namespace/name
 	wf, err := s.client.Get(namespace, name)
@@ -48,6 +102,12 @@ func (s *WorkflowSaver) Save(key string, namespace string, name string, nowEpoch
 	if err != nil && isNotFound {
 		// Permanent failure.
 		// The Workflow may no longer exist, we stop processing and do not retry.
+		if s.statusDeltas != nil {
+			s.statusDeltas.Reset(key)
+		}
+		if s.logArchiver != nil {
+			s.logArchiver.Forget(key)
+		}
 		return util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT,
 			"Workflow (%s) in work queue no longer exists: %v", key, err)
 	}
@@ -67,8 +127,21 @@ func (s *WorkflowSaver) Save(key string, namespace string, name string, nowEpoch
 		log.Infof("Skip syncing Workflow (%v): workflow marked as persisted.", name)
 		return nil
 	}
+	if wf.PersistedFinalState() {
+		switch s.gcPolicy {
+		case WorkflowGCPolicyDelete:
+			return s.deleteWorkflow(wf)
+		case WorkflowGCPolicyLabel:
+			return s.labelWorkflowForGC(wf)
+		}
+	}
+	if s.logArchiver != nil {
+		// Do not modify the Workflow from the informer cache.
+		wf = util.NewWorkflow(wf.PipelineRun.DeepCopy())
+		s.logArchiver.Archive(key, wf)
+	}
 	// Save this Workflow to the database.
-	err = s.pipelineClient.ReportWorkflow(wf)
+	err = s.reportWorkflow(key, wf)
 	retry := util.HasCustomCode(err, util.CUSTOM_CODE_TRANSIENT)
 
 	// Failure
@@ -83,8 +156,67 @@ func (s *WorkflowSaver) Save(key string, namespace string, name string, nowEpoch
 	}
 
 	// Success
+	if s.logArchiver != nil && wf.IsInFinalState() {
+		s.logArchiver.Forget(key)
+	}
+	if s.runEvents != nil && wf.IsInFinalState() {
+		s.runEvents.RecordPersisted(wf)
+	}
 	log.WithFields(log.Fields{
 		"Workflow": name,
 	}).Infof("Syncing Workflow (%v): success, processing complete.", name)
 	return s.metricsReporter.ReportMetrics(wf)
 }
+
+// deleteWorkflow deletes a workflow whose final state is persisted and whose
+// TTL passed. The run stays in the database.
+func (s *WorkflowSaver) deleteWorkflow(wf *util.Workflow) error {
+	err := s.client.Delete(wf.Namespace, wf.Name)
+	if err != nil && !util.HasCustomCode(err, util.CUSTOM_CODE_NOT_FOUND) {
+		return util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
+			"Deleting Workflow (%v): transient failure: %v", wf.Name, err)
+	}
+	log.Infof("Deleted Workflow (%v): TTL after its final state was persisted has passed.", wf.Name)
+	return nil
+}
+
+// labelWorkflowForGC marks a workflow whose final state is persisted and whose
+// TTL passed as ready to be garbage collected.
+func (s *WorkflowSaver) labelWorkflowForGC(wf *util.Workflow) error {
+	if _, ok := wf.Labels[util.LabelKeyWorkflowReadyForGC]; ok {
+		return nil
+	}
+	err := s.client.AddLabel(wf.Namespace, wf.Name, util.LabelKeyWorkflowReadyForGC, "true")
+	if err != nil && !util.HasCustomCode(err, util.CUSTOM_CODE_NOT_FOUND) {
+		return util.NewCustomError(err, util.CUSTOM_CODE_TRANSIENT,
+			"Labelling Workflow (%v) for garbage collection: transient failure: %v", wf.Name, err)
+	}
+	return nil
+}
+
+// reportWorkflow reports a workflow, as a status delta when enabled. If the API
+// server cannot apply the delta, the full status is reported right away.
+func (s *WorkflowSaver) reportWorkflow(key string, wf *util.Workflow) error {
+	if s.statusDeltas == nil {
+		return s.reporter.ReportWorkflow(wf)
+	}
+	report, commit, err := s.statusDeltas.Prepare(key, wf)
+	if err != nil {
+		return util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT,
+			"Syncing Workflow (%v): failed to compute the status delta: %v", wf.Name, err)
+	}
+	err = s.reporter.ReportWorkflow(report)
+	if util.HasCustomCode(err, util.CUSTOM_CODE_OUT_OF_SYNC) {
+		log.Infof("Syncing Workflow (%v): status delta rejected, reporting the full status.", wf.Name)
+		s.statusDeltas.Reset(key)
+		if report, commit, err = s.statusDeltas.Prepare(key, wf); err != nil {
+			return util.NewCustomError(err, util.CUSTOM_CODE_PERMANENT,
+				"Syncing Workflow (%v): failed to compute the status: %v", wf.Name, err)
+		}
+		err = s.reporter.ReportWorkflow(report)
+	}
+	if err == nil {
+		commit()
+	}
+	return err
+}
@@ -41,7 +41,7 @@ func TestWorkflow_Save_Success(t *testing.T) {
 
 	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport)
 
 	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
 
@@ -53,7 +53,7 @@ func TestWorkflow_Save_NotFoundDuringGet(t *testing.T) {
 	workflowFake := client.NewWorkflowClientFake()
 	pipelineFake := client.NewPipelineClientFake()
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport)
 
 	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
 
@@ -68,7 +68,7 @@ func TestWorkflow_Save_ErrorDuringGet(t *testing.T) {
 
 	workflowFake.Put("MY_NAMESPACE", "MY_NAME", nil)
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport)
 
 	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
 
@@ -94,7 +94,7 @@ func TestWorkflow_Save_PermanentFailureWhileReporting(t *testing.T) {
 
 	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport)
 
 	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
 
@@ -120,7 +120,7 @@ func TestWorkflow_Save_TransientFailureWhileReporting(t *testing.T) {
 
 	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport)
 
 	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
 
@@ -153,7 +153,7 @@ func TestWorkflow_Save_SkippedDueToFinalStatue(t *testing.T) {
 
 	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport)
 
 	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
 
@@ -189,7 +189,7 @@ func TestWorkflow_Save_FinalStatueNotSkippedDueToExceedTTL(t *testing.T) {
 
 	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 1)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 1, WorkflowGCPolicyReport)
 
 	// Sleep 2 seconds to make sure workflow passed TTL
 	time.Sleep(2 * time.Second)
@@ -218,10 +218,68 @@ func TestWorkflow_Save_SkippedDDueToMissingRunID(t *testing.T) {
 
 	workflowFake.Put("MY_NAMESPACE", "MY_NAME", workflow)
 
-	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100)
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 100, WorkflowGCPolicyReport)
 
 	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
 
 	assert.Equal(t, false, util.HasCustomCode(err, util.CUSTOM_CODE_TRANSIENT))
 	assert.Equal(t, nil, err)
 }
+
+func newPersistedWorkflowPastTTL() *util.Workflow {
+	completionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	return util.NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "MY_NAMESPACE",
+			Name:      "MY_NAME",
+			Labels: map[string]string{
+				util.LabelKeyWorkflowRunId:               "MY_UUID",
+				util.LabelKeyWorkflowPersistedFinalState: "true",
+			},
+		},
+		Status: workflowapi.PipelineRunStatus{
+			PipelineRunStatusFields: workflowapi.PipelineRunStatusFields{
+				CompletionTime: &completionTime,
+			},
+		},
+	})
+}
+
+func TestWorkflow_Save_GCPolicyDelete(t *testing.T) {
+	workflowFake := client.NewWorkflowClientFake()
+	pipelineFake := client.NewPipelineClientFake()
+
+	// Add this will result in failure unless reporting is skipped
+	pipelineFake.SetError(util.NewCustomError(fmt.Errorf("Error"), util.CUSTOM_CODE_PERMANENT,
+		"My Permanent Error"))
+
+	workflowFake.Put("MY_NAMESPACE", "MY_NAME", newPersistedWorkflowPastTTL())
+
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 60, WorkflowGCPolicyDelete)
+
+	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
+
+	assert.Nil(t, err)
+	_, err = workflowFake.Get("MY_NAMESPACE", "MY_NAME")
+	assert.True(t, util.HasCustomCode(err, util.CUSTOM_CODE_NOT_FOUND))
+}
+
+func TestWorkflow_Save_GCPolicyLabel(t *testing.T) {
+	workflowFake := client.NewWorkflowClientFake()
+	pipelineFake := client.NewPipelineClientFake()
+
+	// Add this will result in failure unless reporting is skipped
+	pipelineFake.SetError(util.NewCustomError(fmt.Errorf("Error"), util.CUSTOM_CODE_PERMANENT,
+		"My Permanent Error"))
+
+	workflowFake.Put("MY_NAMESPACE", "MY_NAME", newPersistedWorkflowPastTTL())
+
+	saver := NewWorkflowSaver(workflowFake, pipelineFake, 60, WorkflowGCPolicyLabel)
+
+	err := saver.Save("MY_KEY", "MY_NAMESPACE", "MY_NAME", 20)
+
+	assert.Nil(t, err)
+	workflow, err := workflowFake.Get("MY_NAMESPACE", "MY_NAME")
+	assert.Nil(t, err)
+	assert.Equal(t, "true", workflow.Labels[util.LabelKeyWorkflowReadyForGC])
+}
@@ -43,6 +43,8 @@ type ExtractLogOptions struct {
 
 type LogArchiveInterface interface {
 	GetLogObjectKey(workflow *util.Workflow, nodeId string) (string, error)
+	// GetLogObjectPrefix returns the prefix of the archived logs of a workflow.
+	GetLogObjectPrefix(workflowName string) (string, error)
 	CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error
 }
 
@@ -76,6 +78,15 @@ func (a *LogArchive) GetLogObjectKey(workflow *util.Workflow, nodeID string) (ke
 	return
 }
 
+func (a *LogArchive) GetLogObjectPrefix(workflowName string) (prefix string, err error) {
+	if a.logPathPrefix == "" || workflowName == "" {
+		err = fmt.Errorf("invalid log archive configuration: %v", a)
+	} else {
+		prefix = strings.Join([]string{a.logPathPrefix, workflowName}, "/") + "/"
+	}
+	return
+}
+
 // CopyLogFromArchive copies a task run archived log into expected format.
 func (a *LogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error {
 	reader, err := decompressLogArchive(logContent)
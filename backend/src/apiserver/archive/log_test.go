@@ -80,6 +80,13 @@ func TestGetLogObjectKey_InvalidConfig(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestGetLogObjectPrefix(t *testing.T) {
+	logArchive := initLogArchive()
+	prefix, err := logArchive.GetLogObjectPrefix("MY_NAME")
+	assert.Nil(t, err)
+	assert.Equal(t, "/logs/MY_NAME/", prefix)
+}
+
 func TestCopyLogFromArchive_FromJsonToJson(t *testing.T) {
 	logArchive := initLogArchive()
 	opts := ExtractLogOptions{LogFormat: LogFormatJSON}
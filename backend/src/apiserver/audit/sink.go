@@ -0,0 +1,122 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// Supported values of the AUDIT_SINK config.
+const (
+	SinkTypeFile    = "file"
+	SinkTypeDB      = "db"
+	SinkTypeWebhook = "webhook"
+)
+
+type SinkInterface interface {
+	Write(event *model.AuditEvent) error
+}
+
+// FileSink appends events to a file as JSON lines.
+type FileSink struct {
+	path  string
+	mutex sync.Mutex
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Write(event *model.AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal audit event")
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to open audit log file %s", s.path)
+	}
+	defer file.Close()
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		return util.NewInternalServerError(err, "Failed to write audit log file %s", s.path)
+	}
+	return nil
+}
+
+// DBSink stores events in the audit_events table, which is the only sink the
+// audit events can be queried from.
+type DBSink struct {
+	store storage.AuditStoreInterface
+}
+
+func NewDBSink(store storage.AuditStoreInterface) *DBSink {
+	return &DBSink{store: store}
+}
+
+func (s *DBSink) Write(event *model.AuditEvent) error {
+	return s.store.CreateAuditEvent(event)
+}
+
+// WebhookSink posts every event as JSON to an external endpoint.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (s *WebhookSink) Write(event *model.AuditEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal audit event")
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to send audit event to %s", s.url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return util.NewInternalServerError(
+			fmt.Errorf("unexpected status code %d", resp.StatusCode), "Failed to send audit event to %s", s.url)
+	}
+	return nil
+}
+
+// State returns the JSON representation of the state of a resource, or an empty
+// string for nil values.
+func State(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(bytes)
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var event = &model.AuditEvent{
+	UUID:           "123e4567-e89b-12d3-a456-426655440000",
+	CreatedAtInSec: 1,
+	Actor:          "user@google.com",
+	Namespace:      "ns1",
+	Method:         "/api.RunService/CreateRun",
+	ResourceId:     "run1",
+	Result:         model.AuditResultSucceeded,
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink := NewFileSink(path)
+	assert.Nil(t, sink.Write(event))
+	assert.Nil(t, sink.Write(event))
+
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	lines := 0
+	for scanner.Scan() {
+		var written model.AuditEvent
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &written))
+		assert.Equal(t, *event, written)
+		lines++
+	}
+	assert.Equal(t, 2, lines)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received model.AuditEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	assert.Nil(t, NewWebhookSink(server.URL, time.Second).Write(event))
+	assert.Equal(t, *event, received)
+}
+
+func TestWebhookSink_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL, time.Second).Write(event)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestState(t *testing.T) {
+	assert.Empty(t, State(nil))
+	assert.Equal(t, State(event), State(*event))
+	assert.Contains(t, State(event), `"id":"`+event.UUID+`"`)
+}
@@ -33,13 +33,26 @@ var IdentityHeaderMissingError = util.NewUnauthenticatedError(
 )
 
 func GetAuthenticators(tokenReviewClient client.TokenReviewInterface) []Authenticator {
-	return []Authenticator{
+	authenticators := []Authenticator{
 		NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()),
-		NewTokenReviewAuthenticator(
+	}
+	// OIDC tokens are tried first, bearer tokens that aren't issued by the OIDC
+	// issuer, e.g. service account tokens, fall back to the token review.
+	if issuer := common.GetOIDCIssuer(); issuer != "" {
+		authenticators = append(authenticators, NewOIDCAuthenticator(
 			common.AuthorizationBearerTokenHeader,
 			common.AuthorizationBearerTokenPrefix,
-			[]string{common.GetTokenReviewAudience()},
-			tokenReviewClient,
-		),
+			issuer,
+			common.GetOIDCAudience(),
+			common.GetOIDCUsernameClaim(),
+			common.GetOIDCJWKSURL(),
+			common.GetOIDCJWKSCacheTTL(),
+		))
 	}
+	return append(authenticators, NewTokenReviewAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		[]string{common.GetTokenReviewAudience()},
+		tokenReviewClient,
+	))
 }
@@ -0,0 +1,219 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// Tolerated clock difference between the apiserver and the issuer.
+const clockSkewLeeway = time.Minute
+
+var signatureHashes = map[string]crypto.Hash{
+	"256": crypto.SHA256,
+	"384": crypto.SHA384,
+	"512": crypto.SHA512,
+}
+
+// OIDCAuthenticator validates the OIDC ID tokens (JWTs) sent as bearer tokens, so
+// the user identity doesn't depend on a header injected by an upstream proxy.
+type OIDCAuthenticator struct {
+	// tokenHeader in which the authenticator expects to find the JWT
+	tokenHeader string
+	// tokenPrefix is the prefix encountered before the token
+	tokenPrefix string
+	// issuer which must have issued the tokens
+	issuer string
+	// audience that must be part of the token audiences, usually the client id
+	audience string
+	// usernameClaim is the claim used as the user identity
+	usernameClaim string
+	// keySet caching the signing keys of the issuer
+	keySet *remoteKeySet
+}
+
+// NewOIDCAuthenticator creates an authenticator for tokens of the given issuer. The
+// signing keys are discovered from the issuer unless jwksURL is set.
+func NewOIDCAuthenticator(tokenHeader, tokenPrefix, issuer, audience, usernameClaim, jwksURL string, jwksCacheTTL time.Duration) *OIDCAuthenticator {
+	return &OIDCAuthenticator{
+		tokenHeader:   tokenHeader,
+		tokenPrefix:   tokenPrefix,
+		issuer:        issuer,
+		audience:      audience,
+		usernameClaim: usernameClaim,
+		keySet:        newRemoteKeySet(issuer, jwksURL, jwksCacheTTL),
+	}
+}
+
+func (oa *OIDCAuthenticator) GetUserIdentity(ctx context.Context) (string, error) {
+	token, err := singlePrefixedHeaderFromMetadata(ctx, oa.tokenHeader, oa.tokenPrefix)
+	if err != nil {
+		return "", err
+	}
+	claims, err := oa.verify(ctx, token)
+	if err != nil {
+		return "", util.NewUnauthenticatedError(err, "Authentication failure: invalid OIDC token")
+	}
+	userIdentity, err := oa.userIdentity(claims)
+	if err != nil {
+		return "", util.NewUnauthenticatedError(err, "Authentication failure: invalid OIDC token")
+	}
+	return userIdentity, nil
+}
+
+// verify checks the signature, issuer, audience and validity period of the token
+// and returns its claims.
+func (oa *OIDCAuthenticator) verify(ctx context.Context, token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("token is not a JWT")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the token header")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the token signature")
+	}
+	key, err := oa.keySet.getKey(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the token claims")
+	}
+	if issuer, _ := claims["iss"].(string); issuer != oa.issuer {
+		return nil, fmt.Errorf("token issued by %q, expected %q", issuer, oa.issuer)
+	}
+	if !hasAudience(claims["aud"], oa.audience) {
+		return nil, fmt.Errorf("token audience %v doesn't contain %q", claims["aud"], oa.audience)
+	}
+	now := oa.keySet.now()
+	expiry, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, errors.New("token has no expiry")
+	}
+	if now.After(time.Unix(int64(expiry), 0).Add(clockSkewLeeway)) {
+		return nil, errors.New("token is expired")
+	}
+	if notBefore, ok := claims["nbf"].(float64); ok && now.Add(clockSkewLeeway).Before(time.Unix(int64(notBefore), 0)) {
+		return nil, errors.New("token is not valid yet")
+	}
+	return claims, nil
+}
+
+func (oa *OIDCAuthenticator) userIdentity(claims map[string]interface{}) (string, error) {
+	userIdentity, _ := claims[oa.usernameClaim].(string)
+	if userIdentity == "" {
+		return "", fmt.Errorf("token has no %q claim", oa.usernameClaim)
+	}
+	// Like Kubernetes, don't trust unverified email addresses.
+	if oa.usernameClaim == "email" {
+		if verified, ok := claims["email_verified"].(bool); ok && !verified {
+			return "", fmt.Errorf("email %q isn't verified", userIdentity)
+		}
+	}
+	return userIdentity, nil
+}
+
+func decodeSegment(segment string, value interface{}) error {
+	bytes, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, value)
+}
+
+// hasAudience checks the aud claim, which is either a string or a list of strings.
+func hasAudience(claim interface{}, audience string) bool {
+	switch aud := claim.(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifySignature supports the RSA and ECDSA signature algorithms. Unsigned and
+// HMAC signed tokens are rejected, since the issuer keys are public.
+func verifySignature(alg string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+	hash, ok := signatureHashes[alg[2:]]
+	if !ok {
+		return fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+	hasher := hash.New()
+	hasher.Write([]byte(signingInput))
+	digest := hasher.Sum(nil)
+
+	switch alg[:2] {
+	case "RS", "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key doesn't match signing algorithm %q", alg)
+		}
+		if alg[:2] == "RS" {
+			return rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature)
+		}
+		return rsa.VerifyPSS(rsaKey, hash, digest, signature, nil)
+	case "ES":
+		ecdsaKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key doesn't match signing algorithm %q", alg)
+		}
+		size := (ecdsaKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid ECDSA signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecdsaKey, digest, r, s) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+}
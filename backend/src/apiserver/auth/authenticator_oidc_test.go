@@ -0,0 +1,294 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+const oidcAudience = "kubeflow-pipelines"
+
+type fakeIssuer struct {
+	server *httptest.Server
+	keys   []map[string]string
+	// The key requests block until release is closed, if it's set.
+	release      chan struct{}
+	mutex        sync.Mutex
+	keysRequests int
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	issuer := &fakeIssuer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.mutex.Lock()
+		issuer.keysRequests++
+		release := issuer.release
+		issuer.mutex.Unlock()
+		if release != nil {
+			<-release
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": issuer.keys})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *fakeIssuer) requests() int {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.keysRequests
+}
+
+func (i *fakeIssuer) addRSAKey(kid string, key *rsa.PublicKey) {
+	i.keys = append(i.keys, map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	})
+}
+
+func (i *fakeIssuer) addECKey(kid string, key *ecdsa.PublicKey) {
+	i.keys = append(i.keys, map[string]string{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	})
+}
+
+func (i *fakeIssuer) claims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":            i.server.URL,
+		"aud":            oidcAudience,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"email":          "user@example.com",
+		"email_verified": true,
+	}
+}
+
+func signingInput(t *testing.T, alg, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	assert.Nil(t, err)
+	payload, err := json.Marshal(claims)
+	assert.Nil(t, err)
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	input := signingInput(t, "RS256", kid, claims)
+	digest := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.Nil(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	input := signingInput(t, "ES256", kid, claims)
+	digest := sha256.Sum256([]byte(input))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	assert.Nil(t, err)
+	signature := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func bearerContext(token string) context.Context {
+	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + token})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func newTestOIDCAuthenticator(issuer *fakeIssuer) *OIDCAuthenticator {
+	return NewOIDCAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		issuer.server.URL,
+		oidcAudience,
+		common.DefaultOIDCUsernameClaim,
+		"",
+		time.Hour,
+	)
+}
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	return key
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	key := generateRSAKey(t)
+	issuer.addRSAKey("key1", &key.PublicKey)
+	authenticator := newTestOIDCAuthenticator(issuer)
+
+	userIdentity, err := authenticator.GetUserIdentity(bearerContext(signRS256(t, key, "key1", issuer.claims())))
+	assert.Nil(t, err)
+	assert.Equal(t, "user@example.com", userIdentity)
+
+	// The signing keys are cached.
+	_, err = authenticator.GetUserIdentity(bearerContext(signRS256(t, key, "key1", issuer.claims())))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, issuer.requests())
+}
+
+func TestOIDCAuthenticator_ECDSA(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	issuer.addECKey("key1", &key.PublicKey)
+	authenticator := newTestOIDCAuthenticator(issuer)
+
+	userIdentity, err := authenticator.GetUserIdentity(bearerContext(signES256(t, key, "key1", issuer.claims())))
+	assert.Nil(t, err)
+	assert.Equal(t, "user@example.com", userIdentity)
+}
+
+func TestOIDCAuthenticator_KeyRotation(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	oldKey := generateRSAKey(t)
+	issuer.addRSAKey("key1", &oldKey.PublicKey)
+	authenticator := newTestOIDCAuthenticator(issuer)
+	_, err := authenticator.GetUserIdentity(bearerContext(signRS256(t, oldKey, "key1", issuer.claims())))
+	assert.Nil(t, err)
+
+	newKey := generateRSAKey(t)
+	issuer.addRSAKey("key2", &newKey.PublicKey)
+	authenticator.keySet.now = func() time.Time { return time.Now().Add(minKeySetRefreshInterval + time.Second) }
+
+	userIdentity, err := authenticator.GetUserIdentity(bearerContext(signRS256(t, newKey, "key2", issuer.claims())))
+	assert.Nil(t, err)
+	assert.Equal(t, "user@example.com", userIdentity)
+	assert.Equal(t, 2, issuer.requests())
+}
+
+func TestOIDCAuthenticator_ConcurrentKeyRefresh(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	oldKey := generateRSAKey(t)
+	issuer.addRSAKey("key1", &oldKey.PublicKey)
+	authenticator := newTestOIDCAuthenticator(issuer)
+	_, err := authenticator.GetUserIdentity(bearerContext(signRS256(t, oldKey, "key1", issuer.claims())))
+	assert.Nil(t, err)
+
+	newKey := generateRSAKey(t)
+	issuer.mutex.Lock()
+	issuer.addRSAKey("key2", &newKey.PublicKey)
+	issuer.release = make(chan struct{})
+	issuer.mutex.Unlock()
+	authenticator.keySet.now = func() time.Time { return time.Now().Add(minKeySetRefreshInterval + time.Second) }
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := authenticator.GetUserIdentity(bearerContext(signRS256(t, newKey, "key2", issuer.claims())))
+			errs <- err
+		}()
+	}
+	assert.Eventually(t, func() bool { return issuer.requests() == 2 }, 10*time.Second, 10*time.Millisecond)
+
+	// The tokens signed with the cached keys don't wait for the keys being fetched.
+	_, err = authenticator.GetUserIdentity(bearerContext(signRS256(t, oldKey, "key1", issuer.claims())))
+	assert.Nil(t, err)
+
+	close(issuer.release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, issuer.requests())
+}
+
+func TestOIDCAuthenticator_InvalidTokens(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	key := generateRSAKey(t)
+	issuer.addRSAKey("key1", &key.PublicKey)
+	otherKey := generateRSAKey(t)
+
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := issuer.claims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"wrong signature", signRS256(t, otherKey, "key1", issuer.claims())},
+		{"unknown key", signRS256(t, key, "key2", issuer.claims())},
+		{"unsigned", signingInput(t, "none", "key1", issuer.claims()) + "."},
+		{"not a JWT", "token"},
+		{"wrong issuer", signRS256(t, key, "key1", withClaim("iss", "https://other-issuer"))},
+		{"wrong audience", signRS256(t, key, "key1", withClaim("aud", []string{"other-client"}))},
+		{"expired", signRS256(t, key, "key1", withClaim("exp", time.Now().Add(-time.Hour).Unix()))},
+		{"no expiry", signRS256(t, key, "key1", withClaim("exp", nil))},
+		{"not valid yet", signRS256(t, key, "key1", withClaim("nbf", time.Now().Add(time.Hour).Unix()))},
+		{"no username", signRS256(t, key, "key1", withClaim("email", nil))},
+		{"unverified email", signRS256(t, key, "key1", withClaim("email_verified", false))},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newTestOIDCAuthenticator(issuer).GetUserIdentity(bearerContext(test.token))
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "invalid OIDC token")
+		})
+	}
+}
+
+func TestOIDCAuthenticator_AudienceList(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	key := generateRSAKey(t)
+	issuer.addRSAKey("key1", &key.PublicKey)
+	claims := issuer.claims()
+	claims["aud"] = []string{"other-client", oidcAudience}
+
+	_, err := newTestOIDCAuthenticator(issuer).GetUserIdentity(bearerContext(signRS256(t, key, "key1", claims)))
+	assert.Nil(t, err)
+}
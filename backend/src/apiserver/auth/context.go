@@ -0,0 +1,54 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+)
+
+type authenticationKey struct{}
+
+// Authentication is the result of the authentication of a request.
+type Authentication struct {
+	UserIdentity string
+	Err          error
+}
+
+// WithAuthentication returns a copy of the context with the result of the
+// authentication of its request, so that the request is only authenticated once.
+func WithAuthentication(ctx context.Context, authentication *Authentication) context.Context {
+	return context.WithValue(ctx, authenticationKey{}, authentication)
+}
+
+// AuthenticationFromContext returns the result of the authentication stored in
+// the context, or nil if the request wasn't authenticated yet.
+func AuthenticationFromContext(ctx context.Context) *Authentication {
+	authentication, _ := ctx.Value(authenticationKey{}).(*Authentication)
+	return authentication
+}
+
+// NewIncomingContext returns the context of an HTTP request with its headers as
+// incoming gRPC metadata, so that the requests to the endpoints only served over
+// HTTP are authenticated like the gRPC calls.
+func NewIncomingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md.Append(key, values...)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticationFromContext(t *testing.T) {
+	assert.Nil(t, AuthenticationFromContext(context.Background()))
+
+	ctx := WithAuthentication(context.Background(), &Authentication{UserIdentity: "user@google.com"})
+	assert.Equal(t, &Authentication{UserIdentity: "user@google.com"}, AuthenticationFromContext(ctx))
+
+	err := errors.New("invalid token")
+	ctx = WithAuthentication(context.Background(), &Authentication{Err: err})
+	assert.Equal(t, err, AuthenticationFromContext(ctx).Err)
+}
+
+func TestNewIncomingContext(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/apis/v1/audit_events", nil)
+	req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"user@google.com")
+
+	authenticator := NewHTTPHeaderAuthenticator(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix)
+	userIdentity, err := authenticator.GetUserIdentity(NewIncomingContext(req))
+	assert.Nil(t, err)
+	assert.Equal(t, "user@google.com", userIdentity)
+}
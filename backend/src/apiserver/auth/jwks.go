@@ -0,0 +1,235 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+)
+
+// The key set isn't fetched more often than this when tokens reference unknown
+// keys, so invalid tokens can't be used to flood the issuer.
+const minKeySetRefreshInterval = 10 * time.Second
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// remoteKeySet caches the signing keys published by an OIDC issuer. The keys are
+// refreshed once the cache expires or when a token is signed with an unknown key,
+// which happens after the issuer rotates its keys.
+type remoteKeySet struct {
+	issuer  string
+	jwksURL string
+	ttl     time.Duration
+	client  *http.Client
+	now     func() time.Time
+
+	// The keys are fetched outside of the mutex, once for all the concurrent
+	// lookups, so a slow issuer doesn't block the tokens signed with cached keys.
+	refresh   singleflight.Group
+	mutex     sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func newRemoteKeySet(issuer, jwksURL string, ttl time.Duration) *remoteKeySet {
+	return &remoteKeySet{
+		issuer:  issuer,
+		jwksURL: jwksURL,
+		ttl:     ttl,
+		client:  &http.Client{Timeout: 30 * time.Second},
+		now:     time.Now,
+	}
+}
+
+func (s *remoteKeySet) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, found, stale := s.cachedKey(kid)
+	if stale {
+		select {
+		case result := <-s.refresh.DoChan("keys", s.refreshKeys):
+			if result.Err != nil {
+				return nil, result.Err
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		key, found, _ = s.cachedKey(kid)
+	}
+	if !found {
+		return nil, fmt.Errorf("no signing key with id %q found for issuer %s", kid, s.issuer)
+	}
+	return key, nil
+}
+
+// cachedKey returns the cached key with the given id, and whether the keys should
+// be fetched again, because the cache expired or because the key is unknown and
+// the keys weren't fetched recently.
+func (s *remoteKeySet) cachedKey(kid string) (crypto.PublicKey, bool, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	if s.keys == nil || now.After(s.fetchedAt.Add(s.ttl)) {
+		return nil, false, true
+	}
+	key, found := s.keys[kid]
+	return key, found, !found && now.After(s.fetchedAt.Add(minKeySetRefreshInterval))
+}
+
+// refreshKeys fetches the keys and caches them. It doesn't use the context of the
+// lookup which triggered it, since the other lookups wait for it too.
+func (s *remoteKeySet) refreshKeys() (interface{}, error) {
+	keys, err := s.fetchKeys(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.keys = keys
+	s.fetchedAt = s.now()
+	return nil, nil
+}
+
+func (s *remoteKeySet) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	if s.jwksURL == "" {
+		jwksURL, err := s.discoverJWKSURL(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.jwksURL = jwksURL
+	}
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := s.getJSON(ctx, s.jwksURL, &keySet); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch the signing keys")
+	}
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Skip the keys we don't support instead of failing all tokens.
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// discoverJWKSURL reads the jwks_uri from the OIDC discovery document of the issuer.
+func (s *remoteKeySet) discoverJWKSURL(ctx context.Context) (string, error) {
+	var config struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	discoveryURL := strings.TrimSuffix(s.issuer, "/") + "/.well-known/openid-configuration"
+	if err := s.getJSON(ctx, discoveryURL, &config); err != nil {
+		return "", errors.Wrap(err, "failed to discover the OIDC configuration")
+	}
+	if config.Issuer != s.issuer {
+		return "", fmt.Errorf("OIDC discovery returned issuer %q, expected %q", config.Issuer, s.issuer)
+	}
+	if config.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery of issuer %s returned no jwks_uri", s.issuer)
+	}
+	return config.JWKSURI, nil
+}
+
+func (s *remoteKeySet) getJSON(ctx context.Context, url string, value interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned status code %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(value)
+}
+
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
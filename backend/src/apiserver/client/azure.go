@@ -0,0 +1,304 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AzureStorageAPIVersion is the version of the Azure Storage REST API used
+	// by the object store.
+	AzureStorageAPIVersion = "2021-08-06"
+	azureStorageResource   = "https://storage.azure.com/"
+	azureIMDSTokenURL      = "http://169.254.169.254/metadata/identity/oauth2/token"
+)
+
+// azureManagedIdentityTokenSource fetches the access tokens of the managed
+// identity of the node or pod from the Azure instance metadata service.
+type azureManagedIdentityTokenSource struct {
+	client   *http.Client
+	clientID string
+}
+
+func (s *azureManagedIdentityTokenSource) token() (*accessToken, error) {
+	query := url.Values{
+		"api-version": {"2018-02-01"},
+		"resource":    {azureStorageResource},
+	}
+	if s.clientID != "" {
+		// Selects one of several user assigned identities.
+		query.Set("client_id", s.clientID)
+	}
+	request, err := http.NewRequest(http.MethodGet, azureIMDSTokenURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Metadata", "true")
+	body, err := fetchAccessToken(s.client, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to fetch an Azure Storage access token")
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   string `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, errors.Wrap(err, "Failed to parse the Azure Storage access token")
+	}
+	expiresIn, err := strconv.ParseInt(token.ExpiresIn, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to parse the expiry of the Azure Storage access token")
+	}
+	return newAccessToken(token.AccessToken, expiresIn), nil
+}
+
+// azureSharedKeyTransport signs requests with the key of a storage account.
+// See https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+type azureSharedKeyTransport struct {
+	base        http.RoundTripper
+	accountName string
+	accountKey  []byte
+}
+
+func (t *azureSharedKeyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	signed := request.Clone(request.Context())
+	signed.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	mac := hmac.New(sha256.New, t.accountKey)
+	mac.Write([]byte(azureStringToSign(signed, t.accountName)))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	signed.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", t.accountName, signature))
+	return t.base.RoundTrip(signed)
+}
+
+func azureStringToSign(request *http.Request, accountName string) string {
+	contentLength := ""
+	if request.ContentLength > 0 {
+		contentLength = strconv.FormatInt(request.ContentLength, 10)
+	}
+	header := request.Header
+	lines := []string{
+		request.Method,
+		header.Get("Content-Encoding"),
+		header.Get("Content-Language"),
+		contentLength,
+		header.Get("Content-MD5"),
+		header.Get("Content-Type"),
+		header.Get("Date"),
+		header.Get("If-Modified-Since"),
+		header.Get("If-Match"),
+		header.Get("If-None-Match"),
+		header.Get("If-Unmodified-Since"),
+		header.Get("Range"),
+	}
+
+	var msHeaders []string
+	for name, values := range header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-ms-") {
+			msHeaders = append(msHeaders, name+":"+strings.Join(values, ","))
+		}
+	}
+	sort.Strings(msHeaders)
+	lines = append(lines, msHeaders...)
+
+	resource := "/" + accountName + request.URL.EscapedPath()
+	query := request.URL.Query()
+	var names []string
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values := query[name]
+		sort.Strings(values)
+		resource += "\n" + strings.ToLower(name) + ":" + strings.Join(values, ",")
+	}
+	return strings.Join(append(lines, resource), "\n")
+}
+
+// azureVersionTransport sets the version of the Azure Storage REST API on the
+// requests.
+type azureVersionTransport struct {
+	base http.RoundTripper
+}
+
+func (t *azureVersionTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	versioned := request.Clone(request.Context())
+	versioned.Header.Set("x-ms-version", AzureStorageAPIVersion)
+	return t.base.RoundTrip(versioned)
+}
+
+// parseAzureConnectionString returns the blob endpoint, the account name and
+// the account key of a storage account connection string.
+func parseAzureConnectionString(connectionString string) (endpoint string, accountName string, accountKey []byte, err error) {
+	settings := map[string]string{}
+	for _, setting := range strings.Split(connectionString, ";") {
+		if parts := strings.SplitN(setting, "=", 2); len(parts) == 2 {
+			settings[parts[0]] = parts[1]
+		}
+	}
+	accountName = settings["AccountName"]
+	if accountName == "" || settings["AccountKey"] == "" {
+		return "", "", nil, errors.New("The Azure Storage connection string misses the AccountName or the AccountKey")
+	}
+	accountKey, err = base64.StdEncoding.DecodeString(settings["AccountKey"])
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "Failed to decode the AccountKey of the Azure Storage connection string")
+	}
+	endpoint = settings["BlobEndpoint"]
+	if endpoint == "" {
+		protocol := settings["DefaultEndpointsProtocol"]
+		if protocol == "" {
+			protocol = "https"
+		}
+		suffix := settings["EndpointSuffix"]
+		if suffix == "" {
+			suffix = "core.windows.net"
+		}
+		endpoint = fmt.Sprintf("%s://%s.blob.%s", protocol, accountName, suffix)
+	}
+	return strings.TrimSuffix(endpoint, "/"), accountName, accountKey, nil
+}
+
+// AzureURLSigner signs service SAS URLs of blobs with the key of a storage
+// account.
+// See https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+type AzureURLSigner struct {
+	endpoint    string
+	accountName string
+	accountKey  []byte
+}
+
+// NewAzureURLSigner returns a signer using the account key of
+// connectionString. Managed identities can't sign URLs with the account key.
+func NewAzureURLSigner(connectionString string) (*AzureURLSigner, error) {
+	endpoint, accountName, accountKey, err := parseAzureConnectionString(connectionString)
+	if err != nil {
+		return nil, err
+	}
+	return &AzureURLSigner{endpoint: endpoint, accountName: accountName, accountKey: accountKey}, nil
+}
+
+func (s *AzureURLSigner) SignURL(method, containerName, blobName string, expiry time.Duration) (*storage.SignedURL, error) {
+	permissions := "r"
+	var headers map[string]string
+	if method == http.MethodPut {
+		permissions = "cw"
+		headers = map[string]string{"x-ms-blob-type": "BlockBlob"}
+	}
+	expiresAt := time.Now().UTC().Add(expiry).Format("2006-01-02T15:04:05Z")
+	mac := hmac.New(sha256.New, s.accountKey)
+	mac.Write([]byte(azureSASStringToSign(permissions, expiresAt, s.accountName, containerName, blobName)))
+	query := url.Values{
+		"sv":  {AzureStorageAPIVersion},
+		"sr":  {"b"},
+		"sp":  {permissions},
+		"se":  {expiresAt},
+		"sig": {base64.StdEncoding.EncodeToString(mac.Sum(nil))},
+	}
+	segments := strings.Split(blobName, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	signedURL := fmt.Sprintf("%s/%s/%s?%s", s.endpoint, url.PathEscape(containerName), strings.Join(segments, "/"), query.Encode())
+	return &storage.SignedURL{URL: signedURL, Headers: headers}, nil
+}
+
+// azureSASStringToSign returns the string to sign of a blob service SAS, in the
+// format of the versions since 2020-12-06.
+func azureSASStringToSign(permissions, expiresAt, accountName, containerName, blobName string) string {
+	return strings.Join([]string{
+		permissions,
+		"", // signedStart
+		expiresAt,
+		"/blob/" + accountName + "/" + containerName + "/" + blobName,
+		"", // signedIdentifier
+		"", // signedIP
+		"", // signedProtocol
+		AzureStorageAPIVersion,
+		"b", // signedResource
+		"",  // signedSnapshotTime
+		"",  // signedEncryptionScope
+		"",  // rscc
+		"",  // rscd
+		"",  // rsce
+		"",  // rscl
+		"",  // rsct
+	}, "\n")
+}
+
+// CreateAzureBlobClient returns an HTTP client authenticated for Azure Blob
+// Storage, along with the blob endpoint of the storage account. It uses the
+// account key of connectionString when set, and the managed identity with
+// clientID, or the only managed identity when empty, otherwise.
+func CreateAzureBlobClient(connectionString string, accountName string, clientID string) (*http.Client, string, error) {
+	if connectionString != "" {
+		endpoint, name, key, err := parseAzureConnectionString(connectionString)
+		if err != nil {
+			return nil, "", err
+		}
+		transport := &azureVersionTransport{
+			base: &azureSharedKeyTransport{base: http.DefaultTransport, accountName: name, accountKey: key},
+		}
+		return &http.Client{Transport: transport}, endpoint, nil
+	}
+	if accountName == "" {
+		return nil, "", errors.New("Either an Azure Storage connection string or an account name is required")
+	}
+	tokenTransport := &bearerTokenTransport{
+		base:   http.DefaultTransport,
+		source: &azureManagedIdentityTokenSource{client: http.DefaultClient, clientID: clientID},
+	}
+	// Fail early if the managed identity does not work.
+	if _, err := tokenTransport.accessToken(); err != nil {
+		return nil, "", err
+	}
+	endpoint := fmt.Sprintf("https://%s.blob.core.windows.net", accountName)
+	return &http.Client{Transport: &azureVersionTransport{base: tokenTransport}}, endpoint, nil
+}
+
+func CreateAzureBlobClientOrFatal(connectionString string, accountName string, clientID string,
+	initConnectionTimeout time.Duration) (*http.Client, string) {
+	var azureClient *http.Client
+	var endpoint string
+	var err error
+	var operation = func() error {
+		azureClient, endpoint, err = CreateAzureBlobClient(connectionString, accountName, clientID)
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+	if err != nil {
+		log.Fatalf("Failed to create Azure Blob Storage client. Error: %v", err)
+	}
+	return azureClient, endpoint
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureSASStringToSign(t *testing.T) {
+	assert.Equal(t,
+		"r\n\n2023-01-02T03:04:05Z\n/blob/account/container/artifacts/a.tgz\n\n\n\n"+AzureStorageAPIVersion+"\nb\n\n\n\n\n\n\n",
+		azureSASStringToSign("r", "2023-01-02T03:04:05Z", "account", "container", "artifacts/a.tgz"))
+}
+
+func TestAzureURLSigner(t *testing.T) {
+	signer, err := NewAzureURLSigner("AccountName=account;AccountKey=a2V5;EndpointSuffix=core.windows.net")
+	assert.Nil(t, err)
+
+	signedURL, err := signer.SignURL(http.MethodPut, "container", "artifacts/a.tgz", time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"x-ms-blob-type": "BlockBlob"}, signedURL.Headers)
+	parsed, err := url.Parse(signedURL.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "account.blob.core.windows.net", parsed.Host)
+	assert.Equal(t, "/container/artifacts/a.tgz", parsed.Path)
+	query := parsed.Query()
+	assert.Equal(t, "cw", query.Get("sp"))
+	assert.Equal(t, "b", query.Get("sr"))
+	assert.Equal(t, AzureStorageAPIVersion, query.Get("sv"))
+	assert.NotEmpty(t, query.Get("sig"))
+
+	signedURL, err = signer.SignURL(http.MethodGet, "container", "artifacts/a.tgz", time.Hour)
+	assert.Nil(t, err)
+	assert.Nil(t, signedURL.Headers)
+}
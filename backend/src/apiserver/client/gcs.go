@@ -0,0 +1,323 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	gcsScope            = "https://www.googleapis.com/auth/devstorage.read_write"
+	cloudKMSScope       = "https://www.googleapis.com/auth/cloudkms"
+	gcsMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	gcsDefaultTokenURL  = "https://oauth2.googleapis.com/token"
+	gcsSigningAlgorithm = "GOOG4-RSA-SHA256"
+)
+
+// gcsMetadataTokenSource fetches the access tokens of the service account of the
+// pod from the GKE metadata server, which is how workload identity works.
+type gcsMetadataTokenSource struct {
+	client *http.Client
+}
+
+func (s *gcsMetadataTokenSource) token() (*accessToken, error) {
+	request, err := http.NewRequest(http.MethodGet, gcsMetadataTokenURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Metadata-Flavor", "Google")
+	return fetchGCSToken(s.client, request)
+}
+
+// gcsServiceAccountTokenSource exchanges a JWT signed with the key of a service
+// account for access tokens.
+type gcsServiceAccountTokenSource struct {
+	client     *http.Client
+	email      string
+	privateKey *rsa.PrivateKey
+	tokenURL   string
+	scope      string
+}
+
+func newGCSServiceAccountTokenSource(client *http.Client, credentialsFile string, scope string) (*gcsServiceAccountTokenSource, error) {
+	key, err := readGCSServiceAccountKey(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+	tokenURL := key.TokenURI
+	if tokenURL == "" {
+		tokenURL = gcsDefaultTokenURL
+	}
+	return &gcsServiceAccountTokenSource{
+		client:     client,
+		email:      key.ClientEmail,
+		privateKey: key.privateKey,
+		tokenURL:   tokenURL,
+		scope:      scope,
+	}, nil
+}
+
+type gcsServiceAccountKey struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+	privateKey  *rsa.PrivateKey
+}
+
+func readGCSServiceAccountKey(credentialsFile string) (*gcsServiceAccountKey, error) {
+	content, err := ioutil.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read the GCS credentials file %s", credentialsFile)
+	}
+	var key gcsServiceAccountKey
+	if err := json.Unmarshal(content, &key); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the GCS credentials file %s", credentialsFile)
+	}
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return nil, errors.Errorf("No private key found in the GCS credentials file %s", credentialsFile)
+	}
+	parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		parsedKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the private key of the GCS credentials file %s", credentialsFile)
+	}
+	privateKey, ok := parsedKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("The private key of the GCS credentials file %s is not an RSA key", credentialsFile)
+	}
+	key.privateKey = privateKey
+	return &key, nil
+}
+
+func (s *gcsServiceAccountTokenSource) token() (*accessToken, error) {
+	now := time.Now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return nil, err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   s.email,
+		"scope": s.scope,
+		"aud":   s.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to sign the GCS token request")
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)},
+	}
+	request, err := http.NewRequest(http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return fetchGCSToken(s.client, request)
+}
+
+func fetchGCSToken(client *http.Client, request *http.Request) (*accessToken, error) {
+	body, err := fetchAccessToken(client, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to fetch a GCS access token")
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, errors.Wrap(err, "Failed to parse the GCS access token")
+	}
+	return newAccessToken(token.AccessToken, token.ExpiresIn), nil
+}
+
+// GCSURLSigner signs V4 URLs of Google Cloud Storage objects with the key of a
+// service account.
+// See https://cloud.google.com/storage/docs/access-control/signing-urls-manually
+type GCSURLSigner struct {
+	email      string
+	privateKey *rsa.PrivateKey
+	endpoint   *url.URL
+}
+
+// NewGCSURLSigner returns a signer using the service account key in
+// credentialsFile. Workload identity can't sign URLs without calling the IAM API.
+func NewGCSURLSigner(credentialsFile string, endpoint string) (*GCSURLSigner, error) {
+	key, err := readGCSServiceAccountKey(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid GCS endpoint %s", endpoint)
+	}
+	return &GCSURLSigner{email: key.ClientEmail, privateKey: key.privateKey, endpoint: endpointURL}, nil
+}
+
+func (s *GCSURLSigner) SignURL(method, bucketName, objectName string, expiry time.Duration) (*storage.SignedURL, error) {
+	now := time.Now().UTC()
+	datetime := now.Format("20060102T150405Z")
+	scope := now.Format("20060102") + "/auto/storage/goog4_request"
+	segments := strings.Split(objectName, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	resourcePath := "/" + uriEncode(bucketName) + "/" + strings.Join(segments, "/")
+	query := canonicalQuery(url.Values{
+		"X-Goog-Algorithm":     {gcsSigningAlgorithm},
+		"X-Goog-Credential":    {s.email + "/" + scope},
+		"X-Goog-Date":          {datetime},
+		"X-Goog-Expires":       {strconv.FormatInt(int64(expiry/time.Second), 10)},
+		"X-Goog-SignedHeaders": {"host"},
+	})
+	canonicalRequest := strings.Join([]string{
+		method,
+		resourcePath,
+		query,
+		"host:" + s.endpoint.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	requestDigest := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{gcsSigningAlgorithm, datetime, scope, hex.EncodeToString(requestDigest[:])}, "\n")
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to sign the GCS URL")
+	}
+	signedURL := fmt.Sprintf("%s://%s%s?%s&X-Goog-Signature=%s",
+		s.endpoint.Scheme, s.endpoint.Host, resourcePath, query, hex.EncodeToString(signature))
+	return &storage.SignedURL{URL: signedURL}, nil
+}
+
+// canonicalQuery sorts and encodes the query parameters of a URL to sign.
+func canonicalQuery(query url.Values) string {
+	var names []string
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var parameters []string
+	for _, name := range names {
+		for _, value := range query[name] {
+			parameters = append(parameters, uriEncode(name)+"="+uriEncode(value))
+		}
+	}
+	return strings.Join(parameters, "&")
+}
+
+// uriEncode percent-encodes every byte of s but the unreserved characters of
+// RFC 3986.
+func uriEncode(s string) string {
+	var encoded strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			encoded.WriteByte(c)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", c)
+		}
+	}
+	return encoded.String()
+}
+
+// CreateGCSClient returns an HTTP client authenticated for Google Cloud
+// Storage. It uses the service account key in credentialsFile when set, and the
+// service account of the pod, through workload identity, otherwise.
+func CreateGCSClient(credentialsFile string) (*http.Client, error) {
+	return createGoogleClient(credentialsFile, gcsScope)
+}
+
+func createGoogleClient(credentialsFile string, scope string) (*http.Client, error) {
+	var source accessTokenSource = &gcsMetadataTokenSource{client: http.DefaultClient}
+	if credentialsFile != "" {
+		serviceAccount, err := newGCSServiceAccountTokenSource(http.DefaultClient, credentialsFile, scope)
+		if err != nil {
+			return nil, err
+		}
+		source = serviceAccount
+	}
+	transport := &bearerTokenTransport{base: http.DefaultTransport, source: source}
+	// Fail early if the credentials do not work.
+	if _, err := transport.accessToken(); err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+func CreateGCSClientOrFatal(credentialsFile string, initConnectionTimeout time.Duration) *http.Client {
+	var gcsClient *http.Client
+	var err error
+	var operation = func() error {
+		gcsClient, err = CreateGCSClient(credentialsFile)
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+	if err != nil {
+		log.Fatalf("Failed to create GCS client. Error: %v", err)
+	}
+	return gcsClient
+}
+
+// CreateCloudKMSClientOrFatal returns an HTTP client authenticated for Google
+// Cloud KMS, with the same credentials as CreateGCSClient.
+func CreateCloudKMSClientOrFatal(credentialsFile string, initConnectionTimeout time.Duration) *http.Client {
+	var kmsClient *http.Client
+	var err error
+	var operation = func() error {
+		kmsClient, err = createGoogleClient(credentialsFile, cloudKMSScope)
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+	if err != nil {
+		log.Fatalf("Failed to create Cloud KMS client. Error: %v", err)
+	}
+	return kmsClient
+}
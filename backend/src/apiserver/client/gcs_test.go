@@ -0,0 +1,62 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGCSURLSigner(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	endpoint, _ := url.Parse("https://storage.googleapis.com")
+	signer := &GCSURLSigner{email: "sa@project.iam.gserviceaccount.com", privateKey: privateKey, endpoint: endpoint}
+
+	signedURL, err := signer.SignURL("GET", "bucket", "artifacts/run 1/node/a.tgz", time.Hour)
+	assert.Nil(t, err)
+	assert.Nil(t, signedURL.Headers)
+	parsed, err := url.Parse(signedURL.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "/bucket/artifacts/run%201/node/a.tgz", parsed.EscapedPath())
+	query := parsed.Query()
+	assert.Equal(t, "3600", query.Get("X-Goog-Expires"))
+	assert.Equal(t, "host", query.Get("X-Goog-SignedHeaders"))
+
+	// Verify the signature like the storage service does.
+	signature, err := hex.DecodeString(query.Get("X-Goog-Signature"))
+	assert.Nil(t, err)
+	query.Del("X-Goog-Signature")
+	canonicalRequest := strings.Join([]string{"GET", parsed.EscapedPath(), canonicalQuery(query),
+		"host:storage.googleapis.com\n", "host", "UNSIGNED-PAYLOAD"}, "\n")
+	requestDigest := sha256.Sum256([]byte(canonicalRequest))
+	scope := strings.TrimPrefix(query.Get("X-Goog-Credential"), "sa@project.iam.gserviceaccount.com/")
+	stringToSign := strings.Join([]string{"GOOG4-RSA-SHA256", query.Get("X-Goog-Date"), scope, hex.EncodeToString(requestDigest[:])}, "\n")
+	digest := sha256.Sum256([]byte(stringToSign))
+	assert.Nil(t, rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256, digest[:], signature))
+}
+
+func TestURIEncode(t *testing.T) {
+	assert.Equal(t, "a-b.c_d~e%2Ff%20g%40h", uriEncode("a-b.c_d~e/f g@h"))
+}
@@ -4,13 +4,15 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 type KubernetesCoreInterface interface {
 	PodClient(namespace string) v1.PodInterface
+	SecretClient(namespace string) v1.SecretInterface
+	ConfigMapClient(namespace string) v1.ConfigMapInterface
 }
 
 type KubernetesCore struct {
@@ -21,6 +23,14 @@ func (c *KubernetesCore) PodClient(namespace string) v1.PodInterface {
 	return c.coreV1Client.Pods(namespace)
 }
 
+func (c *KubernetesCore) SecretClient(namespace string) v1.SecretInterface {
+	return c.coreV1Client.Secrets(namespace)
+}
+
+func (c *KubernetesCore) ConfigMapClient(namespace string) v1.ConfigMapInterface {
+	return c.coreV1Client.ConfigMaps(namespace)
+}
+
 func createKubernetesCore(clientParams util.ClientParameters) (KubernetesCoreInterface, error) {
 	clientSet, err := getKubernetesClientset(clientParams)
 	if err != nil {
@@ -42,7 +52,7 @@ func CreateKubernetesCoreOrFatal(initConnectionTimeout time.Duration, clientPara
 	err = backoff.Retry(operation, b)
 
 	if err != nil {
-		glog.Fatalf("Failed to create pod client. Error: %v", err)
+		log.Fatalf("Failed to create pod client. Error: %v", err)
 	}
 	return client
 }
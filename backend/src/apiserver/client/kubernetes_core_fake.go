@@ -35,6 +35,14 @@ func (c *FakeKuberneteCoreClient) PodClient(namespace string) v1.PodInterface {
 	// return c.podClientFake
 }
 
+func (c *FakeKuberneteCoreClient) SecretClient(namespace string) v1.SecretInterface {
+	return nil
+}
+
+func (c *FakeKuberneteCoreClient) ConfigMapClient(namespace string) v1.ConfigMapInterface {
+	return nil
+}
+
 func NewFakeKuberneteCoresClient() *FakeKuberneteCoreClient {
 	return &FakeKuberneteCoreClient{&FakePodClient{}}
 }
@@ -52,6 +60,14 @@ func (c *FakeKubernetesCoreClientWithBadPodClient) PodClient(namespace string) v
 	// return c.podClientFake
 }
 
+func (c *FakeKubernetesCoreClientWithBadPodClient) SecretClient(namespace string) v1.SecretInterface {
+	return nil
+}
+
+func (c *FakeKubernetesCoreClientWithBadPodClient) ConfigMapClient(namespace string) v1.ConfigMapInterface {
+	return nil
+}
+
 func (c *FakePodClient) EvictV1(context.Context, *policyv1.Eviction) error {
 	return nil
 }
@@ -20,10 +20,10 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
 	minio "github.com/minio/minio-go/v6"
 	credentials "github.com/minio/minio-go/v6/pkg/credentials"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
 // createCredentialProvidersChain creates a chained providers credential for a minio client
@@ -73,7 +73,7 @@ func CreateObjectStoreClientOrFatal(minioServiceHost string, minioServicePort st
 	b.MaxElapsedTime = initConnectionTimeout
 	err = backoff.Retry(operation, b)
 	if err != nil {
-		glog.Fatalf("Failed to create Minio client. Error: %v", err)
+		log.Fatalf("Failed to create Minio client. Error: %v", err)
 	}
 	return minioClient
 }
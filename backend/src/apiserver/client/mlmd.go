@@ -0,0 +1,482 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const metadataStoreService = "/ml_metadata.MetadataStoreService/"
+
+// The names of the MLMD Event.Type values.
+var metadataEventTypes = []string{
+	"UNKNOWN", "DECLARED_OUTPUT", "DECLARED_INPUT", "INPUT", "OUTPUT", "INTERNAL_INPUT", "INTERNAL_OUTPUT", "PENDING_OUTPUT",
+}
+
+// The names of the MLMD Artifact.State and Execution.State values.
+var (
+	metadataArtifactStates  = []string{"UNKNOWN", "PENDING", "LIVE", "MARKED_FOR_DELETION", "DELETED", "ABANDONED", "REFERENCE"}
+	metadataExecutionStates = []string{"UNKNOWN", "NEW", "RUNNING", "COMPLETE", "FAILED", "CACHED", "CANCELED"}
+)
+
+// MetadataArtifact is an artifact recorded in ML Metadata (MLMD). Properties
+// holds both the properties and the custom properties of the artifact.
+type MetadataArtifact struct {
+	ID         int64
+	TypeID     int64
+	Name       string
+	URI        string
+	State      string
+	Properties map[string]string
+}
+
+// MetadataExecution is an execution recorded in ML Metadata, which consumes and
+// produces artifacts.
+type MetadataExecution struct {
+	ID         int64
+	TypeID     int64
+	Name       string
+	State      string
+	Properties map[string]string
+}
+
+// MetadataEvent links an artifact to the execution that consumed or produced it.
+type MetadataEvent struct {
+	ArtifactID  int64
+	ExecutionID int64
+	Type        string
+}
+
+// IsInput returns whether the execution of the event consumed the artifact.
+func (e *MetadataEvent) IsInput() bool {
+	return e.Type == "INPUT" || e.Type == "DECLARED_INPUT" || e.Type == "INTERNAL_INPUT"
+}
+
+// IsOutput returns whether the execution of the event produced the artifact.
+func (e *MetadataEvent) IsOutput() bool {
+	return e.Type == "OUTPUT" || e.Type == "DECLARED_OUTPUT" || e.Type == "INTERNAL_OUTPUT" || e.Type == "PENDING_OUTPUT"
+}
+
+// MetadataContext groups executions, e.g. the ones of a run.
+type MetadataContext struct {
+	ID     int64
+	TypeID int64
+	Name   string
+}
+
+// MetadataClientInterface queries the lineage recorded in ML Metadata.
+type MetadataClientInterface interface {
+	GetArtifactsByID(ctx context.Context, ids []int64) ([]*MetadataArtifact, error)
+	GetExecutionsByID(ctx context.Context, ids []int64) ([]*MetadataExecution, error)
+	GetEventsByArtifactIDs(ctx context.Context, ids []int64) ([]*MetadataEvent, error)
+	GetEventsByExecutionIDs(ctx context.Context, ids []int64) ([]*MetadataEvent, error)
+	// GetContextByTypeAndName returns nil if there is no such context.
+	GetContextByTypeAndName(ctx context.Context, typeName string, name string) (*MetadataContext, error)
+	GetExecutionsByContext(ctx context.Context, contextID int64) ([]*MetadataExecution, error)
+	// GetArtifactTypesByID and GetExecutionTypesByID return the type names by ID.
+	GetArtifactTypesByID(ctx context.Context, ids []int64) (map[int64]string, error)
+	GetExecutionTypesByID(ctx context.Context, ids []int64) (map[int64]string, error)
+}
+
+// MetadataClient calls the MLMD gRPC service. The ML Metadata protos aren't a
+// dependency of the backend, so the few messages it needs are encoded by hand.
+type MetadataClient struct {
+	conn *grpc.ClientConn
+}
+
+func NewMetadataClient(address string) (*MetadataClient, error) {
+	conn, err := util.GetRpcConnection(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to connect to ML Metadata at %s", address)
+	}
+	return &MetadataClient{conn: conn}, nil
+}
+
+func (c *MetadataClient) GetArtifactsByID(ctx context.Context, ids []int64) ([]*MetadataArtifact, error) {
+	messages, err := c.list(ctx, "GetArtifactsByID", appendInt64s(nil, 1, ids))
+	if err != nil {
+		return nil, err
+	}
+	artifacts := make([]*MetadataArtifact, 0, len(messages))
+	for _, message := range messages {
+		artifact, err := decodeMetadataArtifact(message)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts, nil
+}
+
+func (c *MetadataClient) GetExecutionsByID(ctx context.Context, ids []int64) ([]*MetadataExecution, error) {
+	messages, err := c.list(ctx, "GetExecutionsByID", appendInt64s(nil, 1, ids))
+	if err != nil {
+		return nil, err
+	}
+	return decodeMetadataExecutions(messages)
+}
+
+func (c *MetadataClient) GetEventsByArtifactIDs(ctx context.Context, ids []int64) ([]*MetadataEvent, error) {
+	messages, err := c.list(ctx, "GetEventsByArtifactIDs", appendInt64s(nil, 1, ids))
+	if err != nil {
+		return nil, err
+	}
+	return decodeMetadataEvents(messages)
+}
+
+func (c *MetadataClient) GetEventsByExecutionIDs(ctx context.Context, ids []int64) ([]*MetadataEvent, error) {
+	messages, err := c.list(ctx, "GetEventsByExecutionIDs", appendInt64s(nil, 1, ids))
+	if err != nil {
+		return nil, err
+	}
+	return decodeMetadataEvents(messages)
+}
+
+func (c *MetadataClient) GetContextByTypeAndName(ctx context.Context, typeName string, name string) (*MetadataContext, error) {
+	request := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), typeName)
+	request = protowire.AppendString(protowire.AppendTag(request, 2, protowire.BytesType), name)
+	messages, err := c.list(ctx, "GetContextByTypeAndName", request)
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+	fields, err := parseProtoMessage(messages[0])
+	if err != nil {
+		return nil, err
+	}
+	metadataContext := &MetadataContext{}
+	for _, field := range fields {
+		switch field.num {
+		case 1:
+			metadataContext.ID = int64(field.varint)
+		case 2:
+			metadataContext.TypeID = int64(field.varint)
+		case 3:
+			metadataContext.Name = string(field.bytes)
+		}
+	}
+	return metadataContext, nil
+}
+
+func (c *MetadataClient) GetExecutionsByContext(ctx context.Context, contextID int64) ([]*MetadataExecution, error) {
+	var executions []*MetadataExecution
+	pageToken := ""
+	for {
+		request := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), uint64(contextID))
+		if pageToken != "" {
+			options := protowire.AppendString(protowire.AppendTag(nil, 3, protowire.BytesType), pageToken)
+			request = protowire.AppendBytes(protowire.AppendTag(request, 2, protowire.BytesType), options)
+		}
+		response, err := c.invoke(ctx, "GetExecutionsByContext", request)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := parseProtoMessage(response)
+		if err != nil {
+			return nil, err
+		}
+		pageToken = ""
+		for _, field := range fields {
+			switch field.num {
+			case 1:
+				execution, err := decodeMetadataExecution(field.bytes)
+				if err != nil {
+					return nil, err
+				}
+				executions = append(executions, execution)
+			case 2:
+				pageToken = string(field.bytes)
+			}
+		}
+		if pageToken == "" {
+			return executions, nil
+		}
+	}
+}
+
+func (c *MetadataClient) GetArtifactTypesByID(ctx context.Context, ids []int64) (map[int64]string, error) {
+	return c.getTypesByID(ctx, "GetArtifactTypesByID", ids)
+}
+
+func (c *MetadataClient) GetExecutionTypesByID(ctx context.Context, ids []int64) (map[int64]string, error) {
+	return c.getTypesByID(ctx, "GetExecutionTypesByID", ids)
+}
+
+func (c *MetadataClient) getTypesByID(ctx context.Context, method string, ids []int64) (map[int64]string, error) {
+	messages, err := c.list(ctx, method, appendInt64s(nil, 1, ids))
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[int64]string, len(messages))
+	for _, message := range messages {
+		fields, err := parseProtoMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		var id int64
+		var name string
+		for _, field := range fields {
+			switch field.num {
+			case 1:
+				id = int64(field.varint)
+			case 2:
+				name = string(field.bytes)
+			}
+		}
+		types[id] = name
+	}
+	return types, nil
+}
+
+// list calls a method whose response holds the results in its first field.
+func (c *MetadataClient) list(ctx context.Context, method string, request []byte) ([][]byte, error) {
+	response, err := c.invoke(ctx, method, request)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := parseProtoMessage(response)
+	if err != nil {
+		return nil, err
+	}
+	var messages [][]byte
+	for _, field := range fields {
+		if field.num == 1 && field.typ == protowire.BytesType {
+			messages = append(messages, field.bytes)
+		}
+	}
+	return messages, nil
+}
+
+func (c *MetadataClient) invoke(ctx context.Context, method string, request []byte) ([]byte, error) {
+	var response []byte
+	err := c.conn.Invoke(ctx, metadataStoreService+method, request, &response, grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to call %s on ML Metadata", method)
+	}
+	return response, nil
+}
+
+// rawCodec sends and receives messages that are already encoded.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	message, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return message, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	message, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	*message = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// The ML Metadata protos use proto2, where repeated scalars aren't packed.
+func appendInt64s(b []byte, num protowire.Number, values []int64) []byte {
+	for _, value := range values {
+		b = protowire.AppendVarint(protowire.AppendTag(b, num, protowire.VarintType), uint64(value))
+	}
+	return b
+}
+
+type protoField struct {
+	num    protowire.Number
+	typ    protowire.Type
+	varint uint64
+	bytes  []byte
+}
+
+// parseProtoMessage returns the fields of an encoded message. Fixed size
+// values are returned in varint, and packed repeated fields as bytes.
+func parseProtoMessage(data []byte) ([]protoField, error) {
+	var fields []protoField
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		field := protoField{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			field.varint, n = protowire.ConsumeVarint(data)
+		case protowire.Fixed64Type:
+			field.varint, n = protowire.ConsumeFixed64(data)
+		case protowire.Fixed32Type:
+			var value uint32
+			value, n = protowire.ConsumeFixed32(data)
+			field.varint = uint64(value)
+		case protowire.BytesType:
+			field.bytes, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func decodeMetadataArtifact(data []byte) (*MetadataArtifact, error) {
+	fields, err := parseProtoMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	artifact := &MetadataArtifact{Properties: map[string]string{}}
+	for _, field := range fields {
+		switch field.num {
+		case 1:
+			artifact.ID = int64(field.varint)
+		case 2:
+			artifact.TypeID = int64(field.varint)
+		case 3:
+			artifact.URI = string(field.bytes)
+		case 4, 5:
+			if err := decodeMetadataProperty(field.bytes, artifact.Properties); err != nil {
+				return nil, err
+			}
+		case 6:
+			artifact.State = enumName(metadataArtifactStates, field.varint)
+		case 7:
+			artifact.Name = string(field.bytes)
+		}
+	}
+	return artifact, nil
+}
+
+func decodeMetadataExecutions(messages [][]byte) ([]*MetadataExecution, error) {
+	executions := make([]*MetadataExecution, 0, len(messages))
+	for _, message := range messages {
+		execution, err := decodeMetadataExecution(message)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+	return executions, nil
+}
+
+func decodeMetadataExecution(data []byte) (*MetadataExecution, error) {
+	fields, err := parseProtoMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	execution := &MetadataExecution{Properties: map[string]string{}}
+	for _, field := range fields {
+		switch field.num {
+		case 1:
+			execution.ID = int64(field.varint)
+		case 2:
+			execution.TypeID = int64(field.varint)
+		case 3:
+			execution.State = enumName(metadataExecutionStates, field.varint)
+		case 4, 5:
+			if err := decodeMetadataProperty(field.bytes, execution.Properties); err != nil {
+				return nil, err
+			}
+		case 6:
+			execution.Name = string(field.bytes)
+		}
+	}
+	return execution, nil
+}
+
+func decodeMetadataEvents(messages [][]byte) ([]*MetadataEvent, error) {
+	events := make([]*MetadataEvent, 0, len(messages))
+	for _, message := range messages {
+		fields, err := parseProtoMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		event := &MetadataEvent{Type: metadataEventTypes[0]}
+		for _, field := range fields {
+			switch field.num {
+			case 1:
+				event.ArtifactID = int64(field.varint)
+			case 2:
+				event.ExecutionID = int64(field.varint)
+			case 4:
+				event.Type = enumName(metadataEventTypes, field.varint)
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// decodeMetadataProperty decodes an entry of a map<string, Value> into the
+// properties. Struct and proto values are skipped.
+func decodeMetadataProperty(data []byte, properties map[string]string) error {
+	fields, err := parseProtoMessage(data)
+	if err != nil {
+		return err
+	}
+	var key string
+	var value []protoField
+	hasValue := false
+	for _, field := range fields {
+		switch field.num {
+		case 1:
+			key = string(field.bytes)
+		case 2:
+			if value, err = parseProtoMessage(field.bytes); err != nil {
+				return err
+			}
+			hasValue = true
+		}
+	}
+	if !hasValue {
+		return nil
+	}
+	for _, field := range value {
+		switch field.num {
+		case 1:
+			properties[key] = strconv.FormatInt(int64(field.varint), 10)
+		case 2:
+			properties[key] = strconv.FormatFloat(math.Float64frombits(field.varint), 'g', -1, 64)
+		case 3:
+			properties[key] = string(field.bytes)
+		case 6:
+			properties[key] = strconv.FormatBool(field.varint != 0)
+		}
+	}
+	return nil
+}
+
+func enumName(names []string, value uint64) string {
+	if value < uint64(len(names)) {
+		return names[value]
+	}
+	return strconv.FormatUint(value, 10)
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+)
+
+// FakeMetadataClient serves the lineage recorded with the Add methods.
+type FakeMetadataClient struct {
+	artifacts         map[int64]*MetadataArtifact
+	executions        map[int64]*MetadataExecution
+	events            []*MetadataEvent
+	contexts          []*MetadataContext
+	contextTypes      map[int64]string
+	contextExecutions map[int64][]int64
+	artifactTypes     map[int64]string
+	executionTypes    map[int64]string
+}
+
+func NewFakeMetadataClient() *FakeMetadataClient {
+	return &FakeMetadataClient{
+		artifacts:         map[int64]*MetadataArtifact{},
+		executions:        map[int64]*MetadataExecution{},
+		contextTypes:      map[int64]string{},
+		contextExecutions: map[int64][]int64{},
+		artifactTypes:     map[int64]string{},
+		executionTypes:    map[int64]string{},
+	}
+}
+
+func (c *FakeMetadataClient) AddArtifact(artifact *MetadataArtifact, typeName string) {
+	c.artifacts[artifact.ID] = artifact
+	c.artifactTypes[artifact.TypeID] = typeName
+}
+
+// AddExecution records an execution, which consumes and produces the artifacts
+// with the given IDs, in a context.
+func (c *FakeMetadataClient) AddExecution(execution *MetadataExecution, typeName string, contextID int64,
+	inputs []int64, outputs []int64) {
+	c.executions[execution.ID] = execution
+	c.executionTypes[execution.TypeID] = typeName
+	c.contextExecutions[contextID] = append(c.contextExecutions[contextID], execution.ID)
+	for _, id := range inputs {
+		c.events = append(c.events, &MetadataEvent{ArtifactID: id, ExecutionID: execution.ID, Type: "INPUT"})
+	}
+	for _, id := range outputs {
+		c.events = append(c.events, &MetadataEvent{ArtifactID: id, ExecutionID: execution.ID, Type: "OUTPUT"})
+	}
+}
+
+func (c *FakeMetadataClient) AddContext(context *MetadataContext, typeName string) {
+	c.contexts = append(c.contexts, context)
+	c.contextTypes[context.ID] = typeName
+}
+
+func (c *FakeMetadataClient) GetArtifactsByID(ctx context.Context, ids []int64) ([]*MetadataArtifact, error) {
+	var artifacts []*MetadataArtifact
+	for _, id := range ids {
+		if artifact, ok := c.artifacts[id]; ok {
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	return artifacts, nil
+}
+
+func (c *FakeMetadataClient) GetExecutionsByID(ctx context.Context, ids []int64) ([]*MetadataExecution, error) {
+	var executions []*MetadataExecution
+	for _, id := range ids {
+		if execution, ok := c.executions[id]; ok {
+			executions = append(executions, execution)
+		}
+	}
+	return executions, nil
+}
+
+func (c *FakeMetadataClient) GetEventsByArtifactIDs(ctx context.Context, ids []int64) ([]*MetadataEvent, error) {
+	return c.filterEvents(ids, func(event *MetadataEvent) int64 { return event.ArtifactID }), nil
+}
+
+func (c *FakeMetadataClient) GetEventsByExecutionIDs(ctx context.Context, ids []int64) ([]*MetadataEvent, error) {
+	return c.filterEvents(ids, func(event *MetadataEvent) int64 { return event.ExecutionID }), nil
+}
+
+func (c *FakeMetadataClient) filterEvents(ids []int64, key func(event *MetadataEvent) int64) []*MetadataEvent {
+	var events []*MetadataEvent
+	for _, event := range c.events {
+		for _, id := range ids {
+			if key(event) == id {
+				events = append(events, event)
+				break
+			}
+		}
+	}
+	return events
+}
+
+func (c *FakeMetadataClient) GetContextByTypeAndName(ctx context.Context, typeName string, name string) (*MetadataContext, error) {
+	for _, context := range c.contexts {
+		if c.contextTypes[context.ID] == typeName && context.Name == name {
+			return context, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *FakeMetadataClient) GetExecutionsByContext(ctx context.Context, contextID int64) ([]*MetadataExecution, error) {
+	return c.GetExecutionsByID(ctx, c.contextExecutions[contextID])
+}
+
+func (c *FakeMetadataClient) GetArtifactTypesByID(ctx context.Context, ids []int64) (map[int64]string, error) {
+	return filterTypes(c.artifactTypes, ids), nil
+}
+
+func (c *FakeMetadataClient) GetExecutionTypesByID(ctx context.Context, ids []int64) (map[int64]string, error) {
+	return filterTypes(c.executionTypes, ids), nil
+}
+
+func filterTypes(types map[int64]string, ids []int64) map[int64]string {
+	filtered := map[int64]string{}
+	for _, id := range ids {
+		if name, ok := types[id]; ok {
+			filtered[id] = name
+		}
+	}
+	return filtered
+}
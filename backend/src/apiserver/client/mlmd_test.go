@@ -0,0 +1,126 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func appendMessage(b []byte, num protowire.Number, message []byte) []byte {
+	return protowire.AppendBytes(protowire.AppendTag(b, num, protowire.BytesType), message)
+}
+
+func appendVarint(b []byte, num protowire.Number, value uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(b, num, protowire.VarintType), value)
+}
+
+func appendString(b []byte, num protowire.Number, value string) []byte {
+	return protowire.AppendString(protowire.AppendTag(b, num, protowire.BytesType), value)
+}
+
+func appendProperty(b []byte, num protowire.Number, key string, value []byte) []byte {
+	return appendMessage(b, num, appendMessage(appendString(nil, 1, key), 2, value))
+}
+
+func TestAppendInt64s(t *testing.T) {
+	assert.Equal(t, []byte{0x08, 0x01, 0x08, 0xac, 0x02}, appendInt64s(nil, 1, []int64{1, 300}))
+}
+
+func TestDecodeMetadataArtifact(t *testing.T) {
+	var artifact []byte
+	artifact = appendVarint(artifact, 1, 7)
+	artifact = appendVarint(artifact, 2, 3)
+	artifact = appendString(artifact, 3, "minio://mlpipeline/artifacts/model.tgz")
+	artifact = appendProperty(artifact, 4, "name", appendString(nil, 3, "model"))
+	artifact = appendProperty(artifact, 5, "epochs", appendVarint(nil, 1, 10))
+	artifact = appendProperty(artifact, 5, "accuracy",
+		protowire.AppendFixed64(protowire.AppendTag(nil, 2, protowire.Fixed64Type), math.Float64bits(0.5)))
+	artifact = appendProperty(artifact, 5, "pushed", appendVarint(nil, 6, 1))
+	artifact = appendProperty(artifact, 5, "schema", appendMessage(nil, 4, nil))
+	artifact = appendVarint(artifact, 6, 2)
+	artifact = appendString(artifact, 7, "model-1")
+	artifact = appendVarint(artifact, 9, 1000)
+
+	decoded, err := decodeMetadataArtifact(artifact)
+	assert.Nil(t, err)
+	assert.Equal(t, &MetadataArtifact{
+		ID:     7,
+		TypeID: 3,
+		Name:   "model-1",
+		URI:    "minio://mlpipeline/artifacts/model.tgz",
+		State:  "LIVE",
+		Properties: map[string]string{
+			"name":     "model",
+			"epochs":   "10",
+			"accuracy": "0.5",
+			"pushed":   "true",
+		},
+	}, decoded)
+}
+
+func TestDecodeMetadataExecution(t *testing.T) {
+	var execution []byte
+	execution = appendVarint(execution, 1, 2)
+	execution = appendVarint(execution, 2, 5)
+	execution = appendVarint(execution, 3, 3)
+	execution = appendProperty(execution, 4, "component_id", appendString(nil, 3, "train"))
+	execution = appendString(execution, 6, "train-1")
+
+	decoded, err := decodeMetadataExecution(execution)
+	assert.Nil(t, err)
+	assert.Equal(t, &MetadataExecution{
+		ID:         2,
+		TypeID:     5,
+		Name:       "train-1",
+		State:      "COMPLETE",
+		Properties: map[string]string{"component_id": "train"},
+	}, decoded)
+}
+
+func TestDecodeMetadataEvents(t *testing.T) {
+	input := appendVarint(appendVarint(appendVarint(nil, 1, 7), 2, 2), 4, 3)
+	output := appendVarint(appendVarint(appendVarint(nil, 1, 8), 2, 2), 4, 4)
+
+	events, err := decodeMetadataEvents([][]byte{input, output})
+	assert.Nil(t, err)
+	assert.Equal(t, []*MetadataEvent{
+		{ArtifactID: 7, ExecutionID: 2, Type: "INPUT"},
+		{ArtifactID: 8, ExecutionID: 2, Type: "OUTPUT"},
+	}, events)
+	assert.True(t, events[0].IsInput())
+	assert.False(t, events[0].IsOutput())
+	assert.True(t, events[1].IsOutput())
+}
+
+func TestParseProtoMessage_Corrupt(t *testing.T) {
+	_, err := parseProtoMessage([]byte{0x0a, 0x05, 0x01})
+	assert.NotNil(t, err)
+}
+
+func TestRawCodec(t *testing.T) {
+	codec := rawCodec{}
+	data, err := codec.Marshal([]byte{1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, data)
+	var message []byte
+	assert.Nil(t, codec.Unmarshal(data, &message))
+	assert.Equal(t, []byte{1, 2}, message)
+	_, err = codec.Marshal("message")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,401 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	// OCIArtifactConfigMediaType identifies the OCI artifacts pushed by KFP, the
+	// config of the manifest holds the annotations of the artifact.
+	OCIArtifactConfigMediaType = "application/vnd.kubeflow.pipelines.artifact.config.v1+json"
+	// OCIArtifactLayerMediaType is the media type of the artifact content, which
+	// KFP stores as a gzipped tarball.
+	OCIArtifactLayerMediaType = "application/vnd.kubeflow.pipelines.artifact.layer.v1.tar+gzip"
+	ociTitleAnnotation        = "org.opencontainers.image.title"
+)
+
+// OCIArtifact is a file pushed to a repository of an OCI registry as a single
+// layer artifact. Open is called for each read of the content, which is read
+// once to compute its digest and once to upload it.
+type OCIArtifact struct {
+	Repository  string
+	Tags        []string
+	FileName    string
+	Size        int64
+	Open        func() (io.ReadCloser, error)
+	Annotations map[string]string
+}
+
+type OCIRegistryClientInterface interface {
+	// Push uploads an artifact and tags its manifest, and returns the reference
+	// of the manifest by digest.
+	Push(ctx context.Context, artifact *OCIArtifact) (string, error)
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	Config        ociDescriptor     `json:"config"`
+	Layers        []ociDescriptor   `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// OCIRegistryClient pushes artifacts with the OCI distribution API. It
+// authenticates with basic credentials, exchanged for a bearer token when the
+// registry asks for one.
+type OCIRegistryClient struct {
+	httpClient *http.Client
+	scheme     string
+	host       string
+	prefix     string
+	username   string
+	password   string
+
+	mutex  sync.Mutex
+	tokens map[string]string
+}
+
+// NewOCIRegistryClient creates a client for a registry such as
+// "registry.example.com/kubeflow", whose path is prepended to the repositories.
+// Insecure registries are accessed over plain HTTP.
+func NewOCIRegistryClient(registry string, username string, password string, insecure bool, timeout time.Duration) (*OCIRegistryClient, error) {
+	parts := strings.SplitN(strings.TrimSuffix(registry, "/"), "/", 2)
+	if parts[0] == "" {
+		return nil, errors.Errorf("invalid OCI registry %q", registry)
+	}
+	client := &OCIRegistryClient{
+		httpClient: &http.Client{Timeout: timeout},
+		scheme:     "https",
+		host:       parts[0],
+		username:   username,
+		password:   password,
+		tokens:     map[string]string{},
+	}
+	if len(parts) == 2 {
+		client.prefix = parts[1]
+	}
+	if insecure {
+		client.scheme = "http"
+	}
+	return client, nil
+}
+
+func (c *OCIRegistryClient) Push(ctx context.Context, artifact *OCIArtifact) (string, error) {
+	repository := artifact.Repository
+	if c.prefix != "" {
+		repository = c.prefix + "/" + repository
+	}
+	layerDigest, err := digestContent(artifact.Open)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read artifact %v", artifact.FileName)
+	}
+	if err := c.uploadBlob(ctx, repository, layerDigest, artifact.Size, artifact.Open); err != nil {
+		return "", err
+	}
+
+	annotations := artifact.Annotations
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	config, err := json.Marshal(annotations)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal the artifact config")
+	}
+	openConfig := func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader(config)), nil }
+	configDigest := digestBytes(config)
+	if err := c.uploadBlob(ctx, repository, configDigest, int64(len(config)), openConfig); err != nil {
+		return "", err
+	}
+
+	manifest, err := json.Marshal(&ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociManifestMediaType,
+		Config:        ociDescriptor{MediaType: OCIArtifactConfigMediaType, Digest: configDigest, Size: int64(len(config))},
+		Layers: []ociDescriptor{{
+			MediaType:   OCIArtifactLayerMediaType,
+			Digest:      layerDigest,
+			Size:        artifact.Size,
+			Annotations: map[string]string{ociTitleAnnotation: artifact.FileName},
+		}},
+		Annotations: artifact.Annotations,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal the artifact manifest")
+	}
+	manifestDigest := digestBytes(manifest)
+	references := append([]string{manifestDigest}, artifact.Tags...)
+	for _, reference := range references {
+		resp, err := c.do(ctx, repository, func() (*http.Request, error) {
+			req, err := http.NewRequest(http.MethodPut, c.url(repository, "manifests/"+reference), bytes.NewReader(manifest))
+			if err == nil {
+				req.Header.Set("Content-Type", ociManifestMediaType)
+			}
+			return req, err
+		})
+		if err != nil {
+			return "", err
+		}
+		if err := expectStatus(resp, http.StatusCreated); err != nil {
+			return "", errors.Wrapf(err, "failed to push the manifest %v of %v", reference, repository)
+		}
+	}
+	return fmt.Sprintf("%s/%s@%s", c.host, repository, manifestDigest), nil
+}
+
+// uploadBlob uploads a blob in a single request, unless the registry has it.
+func (c *OCIRegistryClient) uploadBlob(ctx context.Context, repository string, digest string, size int64,
+	open func() (io.ReadCloser, error)) error {
+	resp, err := c.do(ctx, repository, func() (*http.Request, error) {
+		return http.NewRequest(http.MethodHead, c.url(repository, "blobs/"+digest), nil)
+	})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	resp, err = c.do(ctx, repository, func() (*http.Request, error) {
+		return http.NewRequest(http.MethodPost, c.url(repository, "blobs/uploads/"), nil)
+	})
+	if err != nil {
+		return err
+	}
+	if err := expectStatus(resp, http.StatusAccepted); err != nil {
+		return errors.Wrapf(err, "failed to start the upload of blob %v to %v", digest, repository)
+	}
+	location, err := c.resolve(resp.Header.Get("Location"), digest)
+	if err != nil {
+		return err
+	}
+
+	// The upload is authorized by then, so the content is only read once.
+	resp, err = c.do(ctx, repository, func() (*http.Request, error) {
+		content, err := open()
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequest(http.MethodPut, location, content)
+		if err != nil {
+			content.Close()
+			return nil, err
+		}
+		req.ContentLength = size
+		req.Header.Set("Content-Type", "application/octet-stream")
+		return req, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := expectStatus(resp, http.StatusCreated); err != nil {
+		return errors.Wrapf(err, "failed to upload blob %v to %v", digest, repository)
+	}
+	return nil
+}
+
+// resolve returns the URL that completes an upload at the location the registry
+// returned, which may be relative.
+func (c *OCIRegistryClient) resolve(location string, digest string) (string, error) {
+	base, err := url.Parse(fmt.Sprintf("%s://%s/", c.scheme, c.host))
+	if err != nil {
+		return "", err
+	}
+	uploadURL, err := base.Parse(location)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid upload location %q", location)
+	}
+	query := uploadURL.Query()
+	query.Set("digest", digest)
+	uploadURL.RawQuery = query.Encode()
+	return uploadURL.String(), nil
+}
+
+func (c *OCIRegistryClient) url(repository string, path string) string {
+	return fmt.Sprintf("%s://%s/v2/%s/%s", c.scheme, c.host, repository, path)
+}
+
+// do sends a request built by newRequest, authenticating and sending it again
+// if the registry asks for credentials.
+func (c *OCIRegistryClient) do(ctx context.Context, repository string, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	send := func() (*http.Response, error) {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		c.authorize(req, repository)
+		return c.httpClient.Do(req.WithContext(ctx))
+	}
+	resp, err := send()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send the request to %v", c.host)
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+	resp.Body.Close()
+	if err := c.authenticate(ctx, repository, resp.Header.Get("WWW-Authenticate")); err != nil {
+		return nil, err
+	}
+	resp, err = send()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send the request to %v", c.host)
+	}
+	return resp, nil
+}
+
+func (c *OCIRegistryClient) authorize(req *http.Request, repository string) {
+	c.mutex.Lock()
+	token, ok := c.tokens[repository]
+	c.mutex.Unlock()
+	switch {
+	case ok && token != "":
+		req.Header.Set("Authorization", "Bearer "+token)
+	case ok || c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+}
+
+// authenticate answers the challenge of the registry. A basic challenge is
+// answered with the credentials, a bearer challenge with a token for pushing
+// to the repository.
+func (c *OCIRegistryClient) authenticate(ctx context.Context, repository string, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	if scheme == "basic" {
+		if c.username == "" {
+			return errors.Errorf("registry %v requires credentials", c.host)
+		}
+		c.setToken(repository, "")
+		return nil
+	}
+	if scheme != "bearer" || params["realm"] == "" {
+		return errors.Errorf("unsupported authentication challenge %q from registry %v", challenge, c.host)
+	}
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil {
+		return errors.Wrapf(err, "invalid token realm %q", params["realm"])
+	}
+	query := tokenURL.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull,push", repository))
+	tokenURL.RawQuery = query.Encode()
+	req, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "failed to get a token from %v", tokenURL.Host)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrapf(expectStatus(resp, http.StatusOK), "failed to get a token from %v", tokenURL.Host)
+	}
+	defer resp.Body.Close()
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return errors.Wrapf(err, "failed to parse the token from %v", tokenURL.Host)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	if token.Token == "" {
+		return errors.Errorf("no token from %v", tokenURL.Host)
+	}
+	c.setToken(repository, token.Token)
+	return nil
+}
+
+func (c *OCIRegistryClient) setToken(repository string, token string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.tokens[repository] = token
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	parts := strings.SplitN(strings.TrimSpace(challenge), " ", 2)
+	params := map[string]string{}
+	if len(parts) == 2 {
+		for _, param := range strings.Split(parts[1], ",") {
+			keyValue := strings.SplitN(strings.TrimSpace(param), "=", 2)
+			if len(keyValue) == 2 {
+				params[strings.ToLower(keyValue[0])] = strings.Trim(keyValue[1], `"`)
+			}
+		}
+	}
+	return strings.ToLower(parts[0]), params
+}
+
+// expectStatus closes the response, returning an error with its body if it
+// doesn't have the expected status.
+func expectStatus(resp *http.Response, code int) error {
+	defer resp.Body.Close()
+	if resp.StatusCode == code {
+		return nil
+	}
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	return errors.Errorf("unexpected status %v: %s", resp.Status, strings.TrimSpace(string(body)))
+}
+
+func digestContent(open func() (io.ReadCloser, error)) (string, error) {
+	content, err := open()
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, content); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func digestBytes(content []byte) string {
+	digest := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+)
+
+// FakeOCIRegistryClient records the pushed artifacts and their content.
+type FakeOCIRegistryClient struct {
+	Artifacts []*OCIArtifact
+	Contents  [][]byte
+}
+
+func NewFakeOCIRegistryClient() *FakeOCIRegistryClient {
+	return &FakeOCIRegistryClient{}
+}
+
+func (c *FakeOCIRegistryClient) Push(ctx context.Context, artifact *OCIArtifact) (string, error) {
+	content, err := artifact.Open()
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+	c.Artifacts = append(c.Artifacts, artifact)
+	c.Contents = append(c.Contents, data)
+	return fmt.Sprintf("registry.example.com/%s@%s", artifact.Repository, digestBytes(data)), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRegistry serves the push endpoints of the OCI distribution API, behind a
+// token server that requires basic credentials.
+type fakeRegistry struct {
+	server    *httptest.Server
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	scopes    []string
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	registry := &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}}
+	registry.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "user" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			registry.scopes = append(registry.scopes, r.URL.Query().Get("scope"))
+			json.NewEncoder(w).Encode(map[string]string{"token": "token"})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+registry.server.URL+`/token",service="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/v2/kubeflow/model/")
+		switch {
+		case r.Method == http.MethodHead && strings.HasPrefix(path, "blobs/"):
+			if _, ok := registry.blobs[strings.TrimPrefix(path, "blobs/")]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case r.Method == http.MethodPost && path == "blobs/uploads/":
+			w.Header().Set("Location", "/v2/kubeflow/model/blobs/uploads/1?state=abc")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPut && path == "blobs/uploads/1":
+			assert.Equal(t, "abc", r.URL.Query().Get("state"))
+			content, _ := ioutil.ReadAll(r.Body)
+			registry.blobs[r.URL.Query().Get("digest")] = content
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut && strings.HasPrefix(path, "manifests/"):
+			assert.Equal(t, ociManifestMediaType, r.Header.Get("Content-Type"))
+			content, _ := ioutil.ReadAll(r.Body)
+			registry.manifests[strings.TrimPrefix(path, "manifests/")] = content
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return registry
+}
+
+func TestOCIRegistryClient_Push(t *testing.T) {
+	registry := newFakeRegistry(t)
+	defer registry.server.Close()
+	host := strings.TrimPrefix(registry.server.URL, "http://")
+	client, err := NewOCIRegistryClient(host+"/kubeflow", "user", "secret", true, time.Minute)
+	assert.Nil(t, err)
+
+	content := []byte("model content")
+	reference, err := client.Push(context.Background(), &OCIArtifact{
+		Repository:  "model",
+		Tags:        []string{"run-1"},
+		FileName:    "model.tgz",
+		Size:        int64(len(content)),
+		Open:        func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader(content)), nil },
+		Annotations: map[string]string{"run_id": "1"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"repository:kubeflow/model:pull,push"}, registry.scopes)
+	assert.Equal(t, content, registry.blobs[digestBytes(content)])
+	assert.Equal(t, []byte(`{"run_id":"1"}`), registry.blobs[digestBytes([]byte(`{"run_id":"1"}`))])
+	manifest := registry.manifests["run-1"]
+	assert.Equal(t, host+"/kubeflow/model@"+digestBytes(manifest), reference)
+	assert.Equal(t, manifest, registry.manifests[digestBytes(manifest)])
+	var parsed ociManifest
+	assert.Nil(t, json.Unmarshal(manifest, &parsed))
+	assert.Equal(t, OCIArtifactConfigMediaType, parsed.Config.MediaType)
+	assert.Equal(t, []ociDescriptor{{
+		MediaType:   OCIArtifactLayerMediaType,
+		Digest:      digestBytes(content),
+		Size:        int64(len(content)),
+		Annotations: map[string]string{ociTitleAnnotation: "model.tgz"},
+	}}, parsed.Layers)
+
+	// The blobs the registry has aren't uploaded again.
+	delete(registry.manifests, "run-1")
+	registry.blobs[digestBytes(content)] = []byte("existing")
+	_, err = client.Push(context.Background(), &OCIArtifact{
+		Repository: "model",
+		Tags:       []string{"run-1"},
+		FileName:   "model.tgz",
+		Size:       int64(len(content)),
+		Open:       func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader(content)), nil },
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("existing"), registry.blobs[digestBytes(content)])
+	assert.NotEmpty(t, registry.manifests["run-1"])
+}
+
+func TestOCIRegistryClient_Unauthorized(t *testing.T) {
+	registry := newFakeRegistry(t)
+	defer registry.server.Close()
+	client, err := NewOCIRegistryClient(strings.TrimPrefix(registry.server.URL, "http://")+"/kubeflow", "user", "wrong", true, time.Minute)
+	assert.Nil(t, err)
+
+	_, err = client.Push(context.Background(), &OCIArtifact{
+		Repository: "model",
+		FileName:   "model.tgz",
+		Size:       1,
+		Open:       func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader([]byte("a"))), nil },
+	})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to get a token")
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com"`)
+	assert.Equal(t, "bearer", scheme)
+	assert.Equal(t, map[string]string{"realm": "https://auth.example.com/token", "service": "registry.example.com"}, params)
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
+)
+
+// The component the API server records the Events of the runs as.
+const runEventComponent = "ml-pipeline"
+
+// CreateRunEventRecorderOrFatal creates the recorder of the Events of the runs
+// created and terminated through the API server.
+func CreateRunEventRecorderOrFatal(clientParams util.ClientParameters) record.EventRecorder {
+	clientSet, err := getKubernetesClientset(clientParams)
+	if err != nil {
+		log.Fatalf("Failed to create the client of the run events. Error: %v", err)
+	}
+	recorder, err := util.NewRunEventRecorder(clientSet, runEventComponent)
+	if err != nil {
+		log.Fatalf("Failed to create the recorder of the run events. Error: %v", err)
+	}
+	return recorder
+}
@@ -18,8 +18,8 @@ import (
 	"context"
 	"errors"
 
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	log "github.com/sirupsen/logrus"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -68,22 +68,22 @@ func (c *FakeScheduledWorkflowClient) Get(ctx context.Context, name string, opti
 }
 
 func (c *FakeScheduledWorkflowClient) Update(ctx context.Context, workflow *v1beta1.ScheduledWorkflow, options v1.UpdateOptions) (*v1beta1.ScheduledWorkflow, error) {
-	glog.Error("This fake method is not yet implemented.")
+	log.Error("This fake method is not yet implemented.")
 	return nil, nil
 }
 
 func (c *FakeScheduledWorkflowClient) DeleteCollection(ctx context.Context, options v1.DeleteOptions, listOptions v1.ListOptions) error {
-	glog.Error("This fake method is not yet implemented.")
+	log.Error("This fake method is not yet implemented.")
 	return nil
 }
 
 func (c *FakeScheduledWorkflowClient) List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ScheduledWorkflowList, error) {
-	glog.Error("This fake method is not yet implemented.")
+	log.Error("This fake method is not yet implemented.")
 	return nil, nil
 }
 
 func (c *FakeScheduledWorkflowClient) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	glog.Error("This fake method is not yet implemented.")
+	log.Error("This fake method is not yet implemented.")
 	return nil, nil
 }
 
@@ -16,6 +16,7 @@ package client
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/go-sql-driver/mysql"
 )
@@ -44,3 +45,23 @@ func CreateMySQLConfig(user, password string, mysqlServiceHost string,
 		AllowNativePasswords: true,
 	}
 }
+
+// CreatePostgreSQLDSN builds the connection URL of a PostgreSQL database. The
+// connection isn't encrypted unless `sslmode` is set in the extra parameters.
+func CreatePostgreSQLDSN(user, password string, postgresServiceHost string,
+	postgresServicePort string, dbName string, postgresExtraParams map[string]string) string {
+
+	params := url.Values{"sslmode": []string{"disable"}}
+	for k, v := range postgresExtraParams {
+		params.Set(k, v)
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(user, password),
+		Host:     fmt.Sprintf("%s:%s", postgresServiceHost, postgresServicePort),
+		Path:     "/" + dbName,
+		RawQuery: params.Encode(),
+	}
+	return dsn.String()
+}
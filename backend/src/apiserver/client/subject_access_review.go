@@ -19,8 +19,8 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 	authzv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -50,7 +50,7 @@ func CreateSubjectAccessReviewClientOrFatal(initConnectionTimeout time.Duration,
 	err = backoff.Retry(operation, b)
 
 	if err != nil {
-		glog.Fatalf("Failed to create SubjectAccessReview client. Error: %v", err)
+		log.Fatalf("Failed to create SubjectAccessReview client. Error: %v", err)
 	}
 	return client
 }
@@ -20,11 +20,12 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swfclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
@@ -52,6 +53,7 @@ func NewScheduledWorkflowClientOrFatal(initConnectionTimeout time.Duration, clie
 		}
 		restConfig.QPS = float32(clientParams.QPS)
 		restConfig.Burst = clientParams.Burst
+		restConfig.Wrap(tracing.WrapTransport)
 		swfClientSet := swfclient.NewForConfigOrDie(restConfig)
 		swfClient = swfClientSet.ScheduledworkflowV1beta1()
 		return nil
@@ -61,14 +63,14 @@ func NewScheduledWorkflowClientOrFatal(initConnectionTimeout time.Duration, clie
 	if err == nil {
 		return swfClientInstance
 	}
-	glog.Infof("(Expected when in cluster) Failed to create scheduled workflow client by out of cluster kubeconfig. Error: %v", err)
+	log.Infof("(Expected when in cluster) Failed to create scheduled workflow client by out of cluster kubeconfig. Error: %v", err)
 
-	glog.Infof("Starting to create scheduled workflow client by in cluster config.")
+	log.Infof("Starting to create scheduled workflow client by in cluster config.")
 	b := backoff.NewExponentialBackOff()
 	b.MaxElapsedTime = initConnectionTimeout
 	if err := backoff.Retry(operation, b); err != nil {
 		// all failed
-		glog.Fatalf("Failed to create scheduled workflow client. Error: %v", err)
+		log.Fatalf("Failed to create scheduled workflow client. Error: %v", err)
 	}
 
 	return &SwfClient{swfClient}
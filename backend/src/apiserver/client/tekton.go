@@ -18,9 +18,10 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	tektonclient "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1"
 	"k8s.io/client-go/rest"
@@ -47,6 +48,7 @@ func NewTektonClientOrFatal(initConnectionTimeout time.Duration, clientParams ut
 		}
 		restConfig.QPS = float32(clientParams.QPS)
 		restConfig.Burst = clientParams.Burst
+		restConfig.Wrap(tracing.WrapTransport)
 		tektonClient = tektonclient.NewForConfigOrDie(restConfig).TektonV1()
 		return nil
 	}
@@ -56,7 +58,7 @@ func NewTektonClientOrFatal(initConnectionTimeout time.Duration, clientParams ut
 	err := backoff.Retry(operation, b)
 
 	if err != nil {
-		glog.Fatalf("Failed to create TektonClient. Error: %v", err)
+		log.Fatalf("Failed to create TektonClient. Error: %v", err)
 	}
 	return &TektonClient{tektonClient}
 }
@@ -23,7 +23,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
+	log "github.com/sirupsen/logrus"
 	authv1 "k8s.io/api/authentication/v1"
 )
 
@@ -52,7 +52,7 @@ func CreateTokenReviewClientOrFatal(initConnectionTimeout time.Duration, clientP
 	err = backoff.Retry(operation, b)
 
 	if err != nil {
-		glog.Fatalf("Failed to create TokenReview client. Error: %v", err)
+		log.Fatalf("Failed to create TokenReview client. Error: %v", err)
 	}
 	return client
 }
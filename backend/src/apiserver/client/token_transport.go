@@ -0,0 +1,95 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// accessTokenExpiryDelta is how long before its expiry an access token is
+// refreshed.
+const accessTokenExpiryDelta = time.Minute
+
+// accessToken is an OAuth2 access token of a cloud object store.
+type accessToken struct {
+	value  string
+	expiry time.Time
+}
+
+func newAccessToken(value string, expiresInSeconds int64) *accessToken {
+	return &accessToken{
+		value:  value,
+		expiry: time.Now().Add(time.Duration(expiresInSeconds) * time.Second),
+	}
+}
+
+// accessTokenSource fetches access tokens.
+type accessTokenSource interface {
+	token() (*accessToken, error)
+}
+
+// fetchAccessToken sends a token request and returns the body of the response.
+func fetchAccessToken(client *http.Client, request *http.Request) ([]byte, error) {
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("%s: %s", response.Status, body)
+	}
+	return body, nil
+}
+
+// bearerTokenTransport authenticates requests with an access token, refreshing
+// it before it expires.
+type bearerTokenTransport struct {
+	base   http.RoundTripper
+	source accessTokenSource
+	mutex  sync.Mutex
+	cached *accessToken
+}
+
+func (t *bearerTokenTransport) accessToken() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.cached == nil || time.Now().Add(accessTokenExpiryDelta).After(t.cached.expiry) {
+		token, err := t.source.token()
+		if err != nil {
+			return "", err
+		}
+		t.cached = token
+	}
+	return t.cached.value, nil
+}
+
+func (t *bearerTokenTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	token, err := t.accessToken()
+	if err != nil {
+		return nil, err
+	}
+	authorized := request.Clone(request.Context())
+	authorized.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(authorized)
+}
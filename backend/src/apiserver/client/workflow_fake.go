@@ -21,11 +21,12 @@ import (
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 
-	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	k8schema "k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
@@ -66,12 +67,21 @@ func (c *FakeWorkflowClient) UpdateStatus(ctx context.Context, workflow *tektonV
 }
 
 func (c *FakeWorkflowClient) List(ctx context.Context, opts v1.ListOptions) (*tektonV1.PipelineRunList, error) {
-	glog.Error("This fake method is not yet implemented.")
-	return nil, nil
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	list := &tektonV1.PipelineRunList{}
+	for _, workflow := range c.workflows {
+		if selector.Matches(labels.Set(workflow.Labels)) {
+			list.Items = append(list.Items, *workflow)
+		}
+	}
+	return list, nil
 }
 
 func (c *FakeWorkflowClient) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	glog.Error("This fake method is not yet implemented.")
+	log.Error("This fake method is not yet implemented.")
 	return nil, nil
 }
 
@@ -94,7 +104,7 @@ func (c *FakeWorkflowClient) Delete(ctx context.Context, name string, options v1
 
 func (c *FakeWorkflowClient) DeleteCollection(ctx context.Context, options v1.DeleteOptions,
 	listOptions v1.ListOptions) error {
-	glog.Error("This fake method is not yet implemented.")
+	log.Error("This fake method is not yet implemented.")
 	return nil
 }
 
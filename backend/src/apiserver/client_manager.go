@@ -15,23 +15,34 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
-	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/minio/minio-go/v6"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 const (
@@ -51,10 +62,23 @@ const (
 	archiveLogFileName       = "ARCHIVE_LOG_FILE_NAME"
 	archiveLogPathPrefix     = "ARCHIVE_LOG_PATH_PREFIX"
 	dbConMaxLifeTime         = "DBConfig.ConMaxLifeTime"
+	dbReadReplicaDSNs        = "DBConfig.ReadReplicaDSNs"
+	dbMaxReplicaLag          = "DBConfig.MaxReplicaLag"
+	dbMaxOpenConns           = "DBConfig.MaxOpenConns"
+	dbMaxIdleConns           = "DBConfig.MaxIdleConns"
+	dbSlowQueryThreshold     = "DBConfig.SlowQueryThreshold"
+	sqlitePath               = "DBConfig.SQLitePath"
+	encryptionKeyFile        = "EncryptionConfig.KeyFile"
+	encryptionKMSKeyName     = "EncryptionConfig.KMSKeyName"
+	encryptionKMSEndpoint    = "EncryptionConfig.KMSEndpoint"
+	encryptionCredentials    = "EncryptionConfig.CredentialsFile"
 
 	visualizationServiceHost = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_HOST"
 	visualizationServicePort = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_PORT"
 
+	metadataServiceHost = "METADATA_GRPC_SERVICE_SERVICE_HOST"
+	metadataServicePort = "METADATA_GRPC_SERVICE_SERVICE_PORT"
+
 	initConnectionTimeout = "InitConnectionTimeout"
 
 	clientQPS   = "ClientQPS"
@@ -72,12 +96,25 @@ type ClientManager struct {
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
+	auditStore                storage.AuditStoreInterface
+	leaseStore                storage.LeaseStoreInterface
+	artifactBlobStore         storage.ArtifactBlobStoreInterface
+	uploadSessionStore        storage.UploadSessionStoreInterface
+	webhookStore              storage.WebhookStoreInterface
+	backupStore               storage.BackupStoreInterface
+	artifactMetadataStore     storage.ArtifactMetadataStoreInterface
+	auditSink                 audit.SinkInterface
+	eventPublisher            eventbus.PublisherInterface
+	notifier                  notification.NotifierInterface
 	objectStore               storage.ObjectStoreInterface
 	swfClient                 client.SwfClientInterface
 	k8sCoreClient             client.KubernetesCoreInterface
 	subjectAccessReviewClient client.SubjectAccessReviewInterface
 	tokenReviewClient         client.TokenReviewInterface
+	metadataClient            client.MetadataClientInterface
+	ociRegistryClient         client.OCIRegistryClientInterface
 	logArchive                archive.LogArchiveInterface
+	runEventRecorder          record.EventRecorder
 	time                      util.TimeInterface
 	uuid                      util.UUIDGeneratorInterface
 	authenticators            []auth.Authenticator
@@ -116,10 +153,54 @@ func (c *ClientManager) DefaultExperimentStore() storage.DefaultExperimentStoreI
 	return c.defaultExperimentStore
 }
 
+func (c *ClientManager) AuditStore() storage.AuditStoreInterface {
+	return c.auditStore
+}
+
+func (c *ClientManager) LeaseStore() storage.LeaseStoreInterface {
+	return c.leaseStore
+}
+
+func (c *ClientManager) ArtifactBlobStore() storage.ArtifactBlobStoreInterface {
+	return c.artifactBlobStore
+}
+
+func (c *ClientManager) UploadSessionStore() storage.UploadSessionStoreInterface {
+	return c.uploadSessionStore
+}
+
+func (c *ClientManager) WebhookStore() storage.WebhookStoreInterface {
+	return c.webhookStore
+}
+
+func (c *ClientManager) BackupStore() storage.BackupStoreInterface {
+	return c.backupStore
+}
+
+func (c *ClientManager) ArtifactMetadataStore() storage.ArtifactMetadataStoreInterface {
+	return c.artifactMetadataStore
+}
+
+func (c *ClientManager) AuditSink() audit.SinkInterface {
+	return c.auditSink
+}
+
+func (c *ClientManager) EventPublisher() eventbus.PublisherInterface {
+	return c.eventPublisher
+}
+
+func (c *ClientManager) Notifier() notification.NotifierInterface {
+	return c.notifier
+}
+
 func (c *ClientManager) ObjectStore() storage.ObjectStoreInterface {
 	return c.objectStore
 }
 
+func (c *ClientManager) Encryptor() *storage.Encryptor {
+	return c.db.Encryptor()
+}
+
 func (c *ClientManager) TektonClient() client.TektonClientInterface {
 	return c.tektonClient
 }
@@ -140,10 +221,22 @@ func (c *ClientManager) TokenReviewClient() client.TokenReviewInterface {
 	return c.tokenReviewClient
 }
 
+func (c *ClientManager) MetadataClient() client.MetadataClientInterface {
+	return c.metadataClient
+}
+
+func (c *ClientManager) OCIRegistryClient() client.OCIRegistryClientInterface {
+	return c.ociRegistryClient
+}
+
 func (c *ClientManager) LogArchive() archive.LogArchiveInterface {
 	return c.logArchive
 }
 
+func (c *ClientManager) RunEventRecorder() record.EventRecorder {
+	return c.runEventRecorder
+}
+
 func (c *ClientManager) Time() util.TimeInterface {
 	return c.time
 }
@@ -157,9 +250,12 @@ func (c *ClientManager) Authenticators() []auth.Authenticator {
 }
 
 func (c *ClientManager) init() {
-	glog.Info("Initializing client manager")
+	log.Info("Initializing client manager")
+	storage.SetSlowQueryThreshold(common.GetDurationConfigWithDefault(dbSlowQueryThreshold, common.DefaultSlowQueryThreshold))
 	db := initDBClient(common.GetDurationConfig(initConnectionTimeout))
-	db.SetConnMaxLifetime(common.GetDurationConfig(dbConMaxLifeTime))
+	configureDBPool(db.DB, common.GetStringConfig(mysqlDBName))
+	initReadReplicas(db)
+	initEncryption(db, common.GetDurationConfig(initConnectionTimeout))
 
 	// time
 	c.time = util.NewRealTime()
@@ -175,6 +271,16 @@ func (c *ClientManager) init() {
 	c.resourceReferenceStore = storage.NewResourceReferenceStore(db)
 	c.dBStatusStore = storage.NewDBStatusStore(db)
 	c.defaultExperimentStore = storage.NewDefaultExperimentStore(db)
+	c.auditStore = storage.NewAuditStore(db)
+	c.auditSink = initAuditSink(c.auditStore)
+	c.eventPublisher = initEventPublisher()
+	c.notifier = initNotifier(c.time)
+	c.leaseStore = storage.NewLeaseStore(db, c.time)
+	c.artifactBlobStore = storage.NewArtifactBlobStore(db)
+	c.uploadSessionStore = storage.NewUploadSessionStore(db)
+	c.webhookStore = storage.NewWebhookStore(db)
+	c.backupStore = storage.NewBackupStore(db)
+	c.artifactMetadataStore = storage.NewArtifactMetadataStore(db)
 	c.objectStore = initObjectStoreClient(common.GetDurationConfig(initConnectionTimeout))
 
 	// Use default value of client QPS (5) & burst (10) defined in
@@ -188,6 +294,8 @@ func (c *ClientManager) init() {
 	c.swfClient = client.NewScheduledWorkflowClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 
 	c.k8sCoreClient = client.CreateKubernetesCoreOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
+	initObjectStoreNamespaces(c.objectStore, c.k8sCoreClient)
+	c.runEventRecorder = client.CreateRunEventRecorderOrFatal(clientParams)
 
 	runStore := storage.NewRunStore(db, c.time)
 	c.runStore = runStore
@@ -195,15 +303,27 @@ func (c *ClientManager) init() {
 	// Log archive
 	c.logArchive = initLogArchive()
 
+	// Lineage is queried from ML Metadata
+	c.metadataClient = initMetadataClient()
+
+	// Artifacts are pushed to an OCI registry
+	c.ociRegistryClient = initOCIRegistryClient()
+
 	if common.IsMultiUserMode() {
 		c.subjectAccessReviewClient = client.CreateSubjectAccessReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.tokenReviewClient = client.CreateTokenReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.authenticators = auth.GetAuthenticators(c.tokenReviewClient)
 	}
-	glog.Infof("Client manager initialized successfully")
+	log.Infof("Client manager initialized successfully")
 }
 
 func (c *ClientManager) Close() {
+	if c.eventPublisher != nil {
+		c.eventPublisher.Close()
+	}
+	if c.notifier != nil {
+		c.notifier.Close()
+	}
 	c.db.Close()
 }
 
@@ -214,13 +334,20 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	switch driverName {
 	case "mysql":
 		arg = initMysql(driverName, initConnectionTimeout)
+	case "postgres":
+		// The data backfills below only upgrade MySQL databases of old releases.
+		return initPostgres(initConnectionTimeout)
+	case "sqlite":
+		return initSQLite()
 	default:
-		glog.Fatalf("Driver %v is not supported", driverName)
+		log.Fatalf("Driver %v is not supported", driverName)
 	}
 
 	// db is safe for concurrent use by multiple goroutines
 	// and maintains its own pool of idle connections.
-	db, err := gorm.Open(driverName, arg)
+	sqlDB, err := sql.Open(storage.MySQLDriverName, arg)
+	util.TerminateIfError(err)
+	db, err := gorm.Open(driverName, sqlDB)
 	util.TerminateIfError(err)
 
 	// If pipeline_versions table is introduced into DB for the first time,
@@ -235,95 +362,171 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 		}
 	}
 
-	// Create table
-	response := db.AutoMigrate(
-		&model.Experiment{},
-		&model.Job{},
-		&model.Pipeline{},
-		&model.PipelineVersion{},
-		&model.ResourceReference{},
-		&model.RunDetail{},
-		&model.RunMetric{},
-		&model.Task{},
-		&model.DBStatus{},
-		&model.DefaultExperiment{})
+	migrateDB(storage.NewDB(db.DB(), storage.NewMySQLDialect()))
 
-	if response.Error != nil {
-		glog.Fatalf("Failed to initialize the databases.")
+	// Data backfill for pipeline_versions if this is the first time for
+	// pipeline_versions to enter mlpipeline DB.
+	if initializePipelineVersions {
+		initPipelineVersionsFromPipelines(db)
 	}
-
-	response = db.Model(&model.Experiment{}).RemoveIndex("Name")
-	if response.Error != nil {
-		glog.Fatalf("Failed to drop unique key on experiment name. Error: %s", response.Error)
+	err = backfillExperimentIDToRunTable(db)
+	if err != nil {
+		log.Fatalf("Failed to backfill experiment UUID in run_details table: %s", err)
 	}
 
-	response = db.Model(&model.Pipeline{}).RemoveIndex("Name")
-	if response.Error != nil {
-		glog.Fatalf("Failed to drop unique key on pipeline name. Error: %s", response.Error)
-	}
+	return storage.NewDB(db.DB(), storage.NewMySQLDialect())
+}
 
-	response = db.Model(&model.ResourceReference{}).ModifyColumn("Payload", "longtext not null")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update the resource reference payload type. Error: %s", response.Error)
-	}
+// configureDBPool sizes the pool of connections to the database, and exports its
+// usage, such as the number of connections in use and the time spent waiting for
+// one, as Prometheus metrics.
+func configureDBPool(db *sql.DB, dbName string) {
+	db.SetConnMaxLifetime(common.GetDurationConfig(dbConMaxLifeTime))
+	// Zero is unlimited.
+	db.SetMaxOpenConns(common.GetIntConfigWithDefault(dbMaxOpenConns, 0))
+	// Same default as database/sql.
+	db.SetMaxIdleConns(common.GetIntConfigWithDefault(dbMaxIdleConns, 2))
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
 
-	response = db.Model(&model.RunDetail{}).AddIndex("experimentuuid_createatinsec", "ExperimentUUID", "CreatedAtInSec")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index experimentuuid_createatinsec on run_details. Error: %s", response.Error)
+// initReadReplicas routes the list queries to the read replicas, if any are
+// configured. The replicas are reached with complete DSNs, since they may not
+// share the settings of the primary.
+func initReadReplicas(db *storage.DB) {
+	dsns := common.GetStringSliceConfig(dbReadReplicaDSNs)
+	if len(dsns) == 0 {
+		return
 	}
-
-	response = db.Model(&model.RunDetail{}).AddIndex("experimentuuid_conditions_finishedatinsec", "ExperimentUUID", "Conditions", "FinishedAtInSec")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index experimentuuid_conditions_finishedatinsec on run_details. Error: %s", response.Error)
+	driverName := storage.MySQLDriverName
+	if common.GetStringConfig("DBConfig.DriverName") == "postgres" {
+		driverName = storage.PostgreSQLDriverName
 	}
-
-	response = db.Model(&model.Pipeline{}).AddUniqueIndex("name_namespace_index", "Name", "Namespace")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index name_namespace_index on run_details. Error: %s", response.Error)
+	var replicas []*sql.DB
+	for i, dsn := range dsns {
+		replica, err := sql.Open(driverName, dsn)
+		util.TerminateIfError(err)
+		configureDBPool(replica, fmt.Sprintf("replica-%d", i))
+		replicas = append(replicas, replica)
 	}
+	db.SetReadReplicas(replicas, common.GetDurationConfigWithDefault(dbMaxReplicaLag, common.DefaultMaxReplicaLag),
+		common.ReplicaLagCheckInterval)
+	log.Infof("Routing the list queries to %d read replicas", len(replicas))
+}
 
-	response = db.Model(&model.RunMetric{}).
-		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for RunID in run_metrics table. Error: %s", response.Error)
-	}
-	response = db.Model(&model.PipelineVersion{}).
-		AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for PipelineId in pipeline_versions table. Error: %s", response.Error)
+// initEncryption makes the stores encrypt the pipeline manifests and the run and
+// job parameters, if a key is configured: a Google Cloud KMS key, or a local key
+// read from a file holding 32 bytes, base64 encoded or not.
+func initEncryption(db *storage.DB, initConnectionTimeout time.Duration) {
+	keyFile := common.GetStringConfigWithDefault(encryptionKeyFile, "")
+	kmsKeyName := common.GetStringConfigWithDefault(encryptionKMSKeyName, "")
+	var kek storage.KeyEncryptionKey
+	switch {
+	case keyFile != "" && kmsKeyName != "":
+		log.Fatalf("Only one of %s and %s can be set", encryptionKeyFile, encryptionKMSKeyName)
+	case kmsKeyName != "":
+		kek = &storage.CloudKMSKeyEncryptionKey{
+			Client:   client.CreateCloudKMSClientOrFatal(common.GetStringConfigWithDefault(encryptionCredentials, ""), initConnectionTimeout),
+			Endpoint: common.GetStringConfigWithDefault(encryptionKMSEndpoint, storage.CloudKMSEndpoint),
+			KeyName:  kmsKeyName,
+		}
+	case keyFile != "":
+		content, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			log.Fatalf("Failed to read the encryption key file %s. Error: %v", keyFile, err)
+		}
+		key := bytes.TrimSpace(content)
+		if decoded, err := base64.StdEncoding.DecodeString(string(key)); err == nil {
+			key = decoded
+		}
+		kek, err = storage.NewLocalKeyEncryptionKey(key)
+		if err != nil {
+			log.Fatalf("Invalid encryption key file %s. Error: %v", keyFile, err)
+		}
+	default:
+		return
 	}
-	response = db.Model(&model.Task{}).
-		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for RunUUID in task table. Error: %s", response.Error)
+	encryptor, err := storage.NewEncryptor(kek)
+	if err != nil {
+		log.Fatalf("Failed to initialize the encryption. Error: %v", err)
 	}
+	db.SetEncryptor(encryptor)
+	log.Info("Encrypting the pipeline manifests and the run and job parameters")
+}
 
-	// Data backfill for pipeline_versions if this is the first time for
-	// pipeline_versions to enter mlpipeline DB.
-	if initializePipelineVersions {
-		initPipelineVersionsFromPipelines(db)
+// migrateDB checks the migrations applied to the database, then migrates its schema
+// to the configured version. The server exits after reverting migrations, since
+// it can't work with an older schema.
+func migrateDB(db *storage.DB) {
+	migrator := storage.NewMigrator(db, storage.Migrations, util.NewRealTime())
+	if err := migrator.Preflight(); err != nil {
+		log.Fatalf("Failed the preflight check of the database migrations. Error: %v", err)
 	}
-	err = backfillExperimentIDToRunTable(db)
-	if err != nil {
-		glog.Fatalf("Failed to backfill experiment UUID in run_details table: %s", err)
+	version := common.GetDBSchemaVersion()
+	if version < 0 {
+		version = migrator.LatestVersion()
 	}
-
-	response = db.Model(&model.Pipeline{}).ModifyColumn("Description", "longtext not null")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update pipeline description type. Error: %s", response.Error)
+	if err := migrator.MigrateTo(version); err != nil {
+		log.Fatalf("Failed to migrate the database schema to version %v. Error: %v", version, err)
 	}
-
-	// If the old unique index idx_pipeline_version_uuid_name on pipeline_versions exists, remove it.
-	rows, err := db.Raw(`show index from pipeline_versions where Key_name='idx_pipeline_version_uuid_name'`).Rows()
-	if err != nil {
-		glog.Fatalf("Failed to query pipeline_version table's indices. Error: %s", err)
+	if version < migrator.LatestVersion() {
+		log.Infof("Reverted the database schema to version %v, exiting", version)
+		os.Exit(0)
 	}
-	if rows.Next() {
-		db.Exec(`drop index idx_pipeline_version_uuid_name on pipeline_versions`)
+}
+
+// Initialize the connection to a PostgreSQL database, creating the database and
+// its tables if they don't exist.
+func initPostgres(initConnectionTimeout time.Duration) *storage.DB {
+	user := common.GetStringConfigWithDefault(mysqlUser, "postgres")
+	password := common.GetStringConfigWithDefault(mysqlPassword, "")
+	host := common.GetStringConfigWithDefault(mysqlServiceHost, "postgres")
+	port := common.GetStringConfigWithDefault(mysqlServicePort, "5432")
+	extraParams := common.GetMapConfig(mysqlExtraParams)
+	dbName := common.GetStringConfig(mysqlDBName)
+
+	// Connect to the default database to create the database of the API server.
+	db, err := sql.Open(storage.PostgreSQLDriverName,
+		client.CreatePostgreSQLDSN(user, password, host, port, "postgres", extraParams))
+	util.TerminateIfError(err)
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.RetryNotify(db.Ping, b, func(e error, duration time.Duration) {
+		log.Errorf("%v", e)
+	})
+	util.TerminateIfError(err)
+
+	var exists bool
+	err = db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = ?)", dbName).Scan(&exists)
+	util.TerminateIfError(err)
+	if !exists {
+		_, err = db.Exec(fmt.Sprintf("CREATE DATABASE %s", dbName))
+		util.TerminateIfError(err)
 	}
-	rows.Close()
+	db.Close()
 
-	return storage.NewDB(db.DB(), storage.NewMySQLDialect())
+	// db is safe for concurrent use by multiple goroutines
+	// and maintains its own pool of idle connections.
+	db, err = sql.Open(storage.PostgreSQLDriverName,
+		client.CreatePostgreSQLDSN(user, password, host, port, dbName, extraParams))
+	util.TerminateIfError(err)
+	postgresDB := storage.NewDB(db, storage.NewPostgreSQLDialect())
+	migrateDB(postgresDB)
+	return postgresDB
+}
+
+// initSQLite opens the SQLite database file of the standalone mode, creating it
+// if needed, so that the API server runs without a database deployment.
+func initSQLite() *storage.DB {
+	path := common.GetStringConfigWithDefault(sqlitePath, "kfp.db")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		log.Fatalf("Failed to create the directory of the SQLite database %s. Error: %v", path, err)
+	}
+	db, err := sql.Open(storage.SQLiteDriverName, storage.SQLiteDSN(path))
+	util.TerminateIfError(err)
+	sqliteDB := storage.NewDB(db, storage.NewSQLiteDialect())
+	migrateDB(sqliteDB)
+	log.Infof("Using the SQLite database %s", path)
+	return sqliteDB
 }
 
 // Initialize the connection string for connecting to Mysql database
@@ -352,7 +555,7 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 	b.MaxElapsedTime = initConnectionTimeout
 	//err = backoff.Retry(operation, b)
 	backoff.RetryNotify(operation, b, func(e error, duration time.Duration) {
-		glog.Errorf("%v", e)
+		log.Errorf("%v", e)
 	})
 
 	defer db.Close()
@@ -382,44 +585,181 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 }
 
 func initObjectStoreClient(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	bucketName := common.GetStringConfigWithDefault("ObjectStoreConfig.BucketName", os.Getenv(pipelineBucketName))
+	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
+
+	switch objectStoreType := common.GetStringConfigWithDefault("ObjectStoreConfig.Type", "minio"); objectStoreType {
+	case "minio":
+		return initMinioObjectStore(bucketName, pipelinePath, initConnectionTimeout)
+	case "gcs":
+		return initGCSObjectStore(bucketName, pipelinePath, initConnectionTimeout)
+	case "azure":
+		return initAzureBlobObjectStore(bucketName, pipelinePath, initConnectionTimeout)
+	case "filesystem":
+		// The bucket is a directory of the root directory.
+		rootDir := common.GetStringConfigWithDefault("ObjectStoreConfig.Filesystem.RootDir", "objects")
+		return storage.NewFilesystemObjectStore(filepath.Join(rootDir, bucketName), pipelinePath)
+	default:
+		log.Fatalf("Unsupported object store type: %s", objectStoreType)
+		return nil
+	}
+}
+
+// initGCSObjectStore creates an object store backed by a Google Cloud Storage
+// bucket, which has to exist. Without a credentials file, the service account
+// of the pod is used through workload identity.
+func initGCSObjectStore(bucketName string, pipelinePath string, initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	credentialsFile := common.GetStringConfigWithDefault("ObjectStoreConfig.GCS.CredentialsFile", "")
+	endpoint := common.GetStringConfigWithDefault("ObjectStoreConfig.GCS.Endpoint", storage.GCSEndpoint)
+	gcsClient := &storage.GCSClient{Client: client.CreateGCSClientOrFatal(credentialsFile, initConnectionTimeout), Endpoint: endpoint}
+	if credentialsFile != "" {
+		signer, err := client.NewGCSURLSigner(credentialsFile, endpoint)
+		if err != nil {
+			log.Fatalf("Failed to create the GCS URL signer. Error: %v", err)
+		}
+		gcsClient.Signer = signer
+	}
+	return storage.NewGCSObjectStore(gcsClient, bucketName, pipelinePath)
+}
+
+// initAzureBlobObjectStore creates an object store backed by an Azure Blob
+// Storage container, named after the bucket, which has to exist. Without a
+// connection string, the managed identity is used.
+func initAzureBlobObjectStore(containerName string, pipelinePath string, initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	connectionString := common.GetStringConfigWithDefault("ObjectStoreConfig.Azure.ConnectionString", "")
+	accountName := common.GetStringConfigWithDefault("ObjectStoreConfig.Azure.AccountName", "")
+	clientID := common.GetStringConfigWithDefault("ObjectStoreConfig.Azure.ClientID", "")
+	azureClient, endpoint := client.CreateAzureBlobClientOrFatal(connectionString, accountName, clientID, initConnectionTimeout)
+	blobClient := &storage.AzureBlobClient{Client: azureClient, Endpoint: endpoint}
+	if connectionString != "" {
+		signer, err := client.NewAzureURLSigner(connectionString)
+		if err != nil {
+			log.Fatalf("Failed to create the Azure URL signer. Error: %v", err)
+		}
+		blobClient.Signer = signer
+	}
+	return storage.NewAzureBlobObjectStore(blobClient, containerName, pipelinePath)
+}
+
+// minioEndpoint is where the minio object store is served.
+type minioEndpoint struct {
+	host   string
+	port   string
+	region string
+	secure bool
+}
+
+func getMinioEndpoint() minioEndpoint {
+	return minioEndpoint{
+		host:   common.GetStringConfigWithDefault("ObjectStoreConfig.Host", os.Getenv(objectStoreServiceHost)),
+		port:   common.GetStringConfigWithDefault("ObjectStoreConfig.Port", os.Getenv(objectStoreServicePort)),
+		region: common.GetStringConfigWithDefault("ObjectStoreConfig.Region", os.Getenv(objectStoreServiceRegion)),
+		secure: common.GetBoolConfigWithDefault(
+			"ObjectStoreConfig.Secure", common.GetBoolFromStringWithDefault(os.Getenv(objectStoreServiceSecure), false)),
+	}
+}
+
+// getObjectStoreResilience returns how the minio clients cope with an object
+// store that is slow or failing.
+func getObjectStoreResilience() *storage.Resilience {
+	resilience := &storage.Resilience{
+		Timeout:          common.GetDurationConfigWithDefault("ObjectStoreConfig.Resilience.Timeout", 30*time.Second),
+		MaxRetries:       common.GetIntConfigWithDefault("ObjectStoreConfig.Resilience.MaxRetries", 3),
+		RetryInterval:    common.GetDurationConfigWithDefault("ObjectStoreConfig.Resilience.RetryInterval", 200*time.Millisecond),
+		FailureThreshold: common.GetIntConfigWithDefault("ObjectStoreConfig.Resilience.FailureThreshold", 5),
+		OpenDuration:     common.GetDurationConfigWithDefault("ObjectStoreConfig.Resilience.OpenDuration", 30*time.Second),
+	}
+	if err := resilience.Validate(); err != nil {
+		log.Fatalf("Invalid object store resilience. Error: %v", err)
+	}
+	return resilience
+}
+
+func initMinioObjectStore(bucketName string, pipelinePath string, initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
 	// Create client.
-	objectStoreServiceHost := common.GetStringConfigWithDefault(
-		"ObjectStoreConfig.Host", os.Getenv(objectStoreServiceHost))
-	objectStoreServicePort := common.GetStringConfigWithDefault(
-		"ObjectStoreConfig.Port", os.Getenv(objectStoreServicePort))
-	objectStoreServiceRegion := common.GetStringConfigWithDefault(
-		"ObjectStoreConfig.Region", os.Getenv(objectStoreServiceRegion))
-	objectStoreServiceSecure := common.GetBoolConfigWithDefault(
-		"ObjectStoreConfig.Secure", common.GetBoolFromStringWithDefault(os.Getenv(objectStoreServiceSecure), false))
+	endpoint := getMinioEndpoint()
 	accessKey := common.GetStringConfigWithDefault("ObjectStoreConfig.AccessKey", "")
 	secretKey := common.GetStringConfigWithDefault("ObjectStoreConfig.SecretAccessKey", "")
-	bucketName := common.GetStringConfigWithDefault("ObjectStoreConfig.BucketName", os.Getenv(pipelineBucketName))
-	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
 	disableMultipart := common.GetBoolConfigWithDefault("ObjectStoreConfig.Multipart.Disable", true)
 
-	client := client.CreateObjectStoreClientOrFatal(objectStoreServiceHost, objectStoreServicePort, accessKey,
-		secretKey, objectStoreServiceSecure, objectStoreServiceRegion, initConnectionTimeout)
-	createBucket(client, bucketName, objectStoreServiceRegion)
+	client := client.CreateObjectStoreClientOrFatal(endpoint.host, endpoint.port, accessKey,
+		secretKey, endpoint.secure, endpoint.region, initConnectionTimeout)
+	createBucket(client, bucketName, endpoint.region)
 
-	return storage.NewMinioObjectStore(&storage.MinioClient{Client: client}, bucketName, pipelinePath, disableMultipart)
+	resilience := getObjectStoreResilience()
+	objectStore := storage.NewMinioObjectStore(
+		storage.NewResilientMinioClient(&storage.MinioClient{Client: client}, resilience), bucketName, pipelinePath, disableMultipart)
+
+	encryption := &storage.ServerSideEncryption{
+		Type:               common.GetStringConfigWithDefault("ObjectStoreConfig.ServerSideEncryption.Type", ""),
+		KMSKeyID:           common.GetStringConfigWithDefault("ObjectStoreConfig.ServerSideEncryption.KMSKeyID", ""),
+		NamespaceKMSKeyIDs: common.GetMapConfig("ObjectStoreConfig.ServerSideEncryption.NamespaceKMSKeyIDs"),
+	}
+	if err := encryption.Validate(); err != nil {
+		log.Fatalf("Invalid object store server side encryption. Error: %v", err)
+	}
+	objectStore.WithServerSideEncryption(encryption)
+	if err := objectStore.VerifyServerSideEncryption(); err != nil {
+		log.Fatalf("Failed to verify the object store server side encryption. Error: %v", err)
+	}
+	return objectStore
+}
+
+// initObjectStoreNamespaces isolates the objects of the configured namespaces in
+// the minio object store. The clients of a namespace use the credentials of the
+// secret in the namespace, which the pipeline steps push the artifacts with.
+func initObjectStoreNamespaces(objectStore storage.ObjectStoreInterface, k8sCoreClient client.KubernetesCoreInterface) {
+	namespaces := common.GetObjectStoreNamespaces()
+	if len(namespaces) == 0 {
+		return
+	}
+	minioObjectStore, ok := objectStore.(*storage.MinioObjectStore)
+	if !ok {
+		log.Fatalf("'%s' is only supported by the minio object store", common.ObjectStoreNamespacesConfig)
+	}
+	endpoint := getMinioEndpoint()
+	resilience := getObjectStoreResilience()
+	isolation := &storage.NamespaceIsolation{
+		Namespaces: namespaces,
+		NewClient: func(namespace string, credentialsSecret string) (storage.MinioClientInterface, error) {
+			secret, err := k8sCoreClient.SecretClient(namespace).Get(context.Background(), credentialsSecret, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			accessKey, secretKey := string(secret.Data["accesskey"]), string(secret.Data["secretkey"])
+			if accessKey == "" || secretKey == "" {
+				return nil, fmt.Errorf("secret %s/%s has no accesskey or secretkey", namespace, credentialsSecret)
+			}
+			minioClient, err := client.CreateMinioClient(endpoint.host, endpoint.port, accessKey, secretKey,
+				endpoint.secure, endpoint.region)
+			if err != nil {
+				return nil, err
+			}
+			return storage.NewResilientMinioClient(&storage.MinioClient{Client: minioClient}, resilience), nil
+		},
+	}
+	if err := isolation.Validate(); err != nil {
+		log.Fatalf("Invalid '%s'. Error: %v", common.ObjectStoreNamespacesConfig, err)
+	}
+	minioObjectStore.WithNamespaceIsolation(isolation)
 }
 
 func createBucket(client *minio.Client, bucketName, region string) {
 	// Check to see if we already own this bucket.
 	exists, err := client.BucketExists(bucketName)
 	if err != nil {
-		glog.Fatalf("Failed to check if Minio bucket exists. Error: %v", err)
+		log.Fatalf("Failed to check if Minio bucket exists. Error: %v", err)
 	}
 	if exists {
-		glog.Infof("We already own %s\n", bucketName)
+		log.Infof("We already own %s\n", bucketName)
 		return
 	}
 	// Create bucket if it does not exist
 	err = client.MakeBucket(bucketName, region)
 	if err != nil {
-		glog.Fatalf("Failed to create Minio bucket. Error: %v", err)
+		log.Fatalf("Failed to create Minio bucket. Error: %v", err)
 	}
-	glog.Infof("Successfully created bucket %s\n", bucketName)
+	log.Infof("Successfully created bucket %s\n", bucketName)
 }
 
 func initLogArchive() (logArchive archive.LogArchiveInterface) {
@@ -433,6 +773,78 @@ func initLogArchive() (logArchive archive.LogArchiveInterface) {
 	return
 }
 
+// initMetadataClient returns nil when ML Metadata isn't deployed, in which case
+// lineage can't be queried.
+func initMetadataClient() client.MetadataClientInterface {
+	host := common.GetStringConfigWithDefault(metadataServiceHost, "")
+	if host == "" {
+		return nil
+	}
+	port := common.GetStringConfigWithDefault(metadataServicePort, "8080")
+	metadataClient, err := client.NewMetadataClient(fmt.Sprintf("%s:%s", host, port))
+	if err != nil {
+		log.Fatalf("Failed to create the ML Metadata client. Error: %v", err)
+	}
+	return metadataClient
+}
+
+// initOCIRegistryClient returns nil when no registry is configured, in which
+// case artifacts can't be pushed.
+func initOCIRegistryClient() client.OCIRegistryClientInterface {
+	registry := common.GetOCIRegistry()
+	if registry == "" {
+		return nil
+	}
+	ociRegistryClient, err := client.NewOCIRegistryClient(registry, common.GetOCIRegistryUsername(),
+		common.GetOCIRegistryPassword(), common.IsOCIRegistryInsecure(), common.GetOCIRegistryTimeout())
+	if err != nil {
+		log.Fatalf("Failed to create the OCI registry client. Error: %v", err)
+	}
+	return ociRegistryClient
+}
+
+func initAuditSink(auditStore storage.AuditStoreInterface) audit.SinkInterface {
+	switch sinkType := common.GetAuditSink(); sinkType {
+	case "":
+		return nil
+	case audit.SinkTypeFile:
+		return audit.NewFileSink(common.GetAuditFilePath())
+	case audit.SinkTypeDB:
+		return audit.NewDBSink(auditStore)
+	case audit.SinkTypeWebhook:
+		return audit.NewWebhookSink(common.GetAuditWebhookURL(), common.GetAuditWebhookTimeout())
+	default:
+		log.Fatalf("Audit sink %v is not supported", sinkType)
+		return nil
+	}
+}
+
+func initEventPublisher() eventbus.PublisherInterface {
+	publisher, err := eventbus.NewPublisher(eventbus.PublisherOptions{
+		Type:        common.GetEventPublisher(),
+		URL:         common.GetEventPublisherURL(),
+		TopicPrefix: common.GetEventTopicPrefix(),
+		Timeout:     common.GetEventPublisherTimeout(),
+		QueueSize:   common.GetEventPublisherQueueSize(),
+		Filter:      eventbus.NewEventFilter(common.GetEventTypes(), common.GetEventNamespaces()),
+	})
+	if err != nil {
+		log.Fatalf("Failed to create the event publisher. Error: %v", err)
+	}
+	return publisher
+}
+
+func initNotifier(time util.TimeInterface) notification.NotifierInterface {
+	notifier, err := notification.NewNotifier(common.GetNotificationPolicy(), time)
+	if err != nil {
+		log.Fatalf("Failed to create the notifier of the runs. Error: %v", err)
+	}
+	if notifier == nil {
+		return nil
+	}
+	return notifier
+}
+
 // newClientManager creates and Init a new instance of ClientManager
 func newClientManager() ClientManager {
 	clientManager := ClientManager{}
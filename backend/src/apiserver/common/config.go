@@ -15,12 +15,16 @@
 package common
 
 import (
+	"path"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
 const (
@@ -47,18 +51,93 @@ const (
 	ApplyTektonCustomResource               string = "APPLY_TEKTON_CUSTOM_RESOURCE"
 	TerminateStatus                         string = "TERMINATE_STATUS"
 	MoveResultsImage                        string = "MOVERESULTS_IMAGE"
+	ImporterImage                           string = "IMPORTER_IMAGE"
+	ImporterMetadataHost                    string = "IMPORTER_METADATA_HOST"
+	ImporterMetadataPort                    string = "IMPORTER_METADATA_PORT"
 	Path4InternalResults                    string = "PATH_FOR_INTERNAL_RESULTS"
 	ObjectStoreAccessKey                    string = "OBJECTSTORECONFIG_ACCESSKEY"
 	ObjectStoreSecretKey                    string = "OBJECTSTORECONFIG_SECRETKEY"
+	InjectionPolicyConfig                   string = "INJECTION_POLICY"
+	TemplateCacheSize                       string = "TEMPLATE_CACHE_SIZE"
+	ArtifactCacheDir                        string = "ARTIFACT_CACHE_DIR"
+	ArtifactCacheSize                       string = "ARTIFACT_CACHE_SIZE"
+	MaxManifestSize                         string = "MAX_MANIFEST_SIZE"
+	ManifestCompressionThreshold            string = "MANIFEST_COMPRESSION_THRESHOLD"
+	AuditSink                               string = "AUDIT_SINK"
+	AuditFilePath                           string = "AUDIT_FILE_PATH"
+	AuditWebhookURL                         string = "AUDIT_WEBHOOK_URL"
+	AuditWebhookTimeout                     string = "AUDIT_WEBHOOK_TIMEOUT"
+	OIDCIssuer                              string = "OIDC_ISSUER"
+	OIDCAudience                            string = "OIDC_AUDIENCE"
+	OIDCUsernameClaim                       string = "OIDC_USERNAME_CLAIM"
+	OIDCJWKSURL                             string = "OIDC_JWKS_URL"
+	OIDCJWKSCacheTTL                        string = "OIDC_JWKS_CACHE_TTL"
+	RateLimitUserQPS                        string = "RATE_LIMIT_USER_QPS"
+	RateLimitUserBurst                      string = "RATE_LIMIT_USER_BURST"
+	RateLimitNamespaceQPS                   string = "RATE_LIMIT_NAMESPACE_QPS"
+	RateLimitNamespaceBurst                 string = "RATE_LIMIT_NAMESPACE_BURST"
+	RateLimitTrustedProxies                 string = "RATE_LIMIT_TRUSTED_PROXIES"
+	MaxConcurrentRunsPerNamespace           string = "MAX_CONCURRENT_RUNS_PER_NAMESPACE"
+	ShutdownTimeout                         string = "SHUTDOWN_TIMEOUT"
+	RPCListenAddress                        string = "RPC_LISTEN_ADDRESS"
+	HTTPListenAddress                       string = "HTTP_LISTEN_ADDRESS"
+	HTTPTLSCertFile                         string = "HTTP_TLS_CERT_FILE"
+	HTTPTLSKeyFile                          string = "HTTP_TLS_KEY_FILE"
+	CORSAllowedOrigins                      string = "CORS_ALLOWED_ORIGINS"
+	ArtifactRetentionPolicyConfig           string = "ARTIFACT_RETENTION_POLICY"
+	ArtifactGCInterval                      string = "ARTIFACT_GC_INTERVAL"
+	ArtifactDedupInterval                   string = "ARTIFACT_DEDUP_INTERVAL"
+	OCIRegistry                             string = "OCI_REGISTRY"
+	OCIRegistryUsername                     string = "OCI_REGISTRY_USERNAME"
+	OCIRegistryPassword                     string = "OCI_REGISTRY_PASSWORD"
+	OCIRegistryInsecure                     string = "OCI_REGISTRY_INSECURE"
+	OCIRegistryTimeout                      string = "OCI_REGISTRY_TIMEOUT"
+	OCIPushArtifactTypes                    string = "OCI_PUSH_ARTIFACT_TYPES"
+	OCIPushInterval                         string = "OCI_PUSH_INTERVAL"
+	DBSchemaVersion                         string = "DB_SCHEMA_VERSION"
+	RunArchiveAge                           string = "RUN_ARCHIVE_AGE"
+	RunArchiveInterval                      string = "RUN_ARCHIVE_INTERVAL"
+	SoftDeletePurgeWindow                   string = "SOFT_DELETE_PURGE_WINDOW"
+	ReadinessCheckTimeout                   string = "READINESS_CHECK_TIMEOUT"
+	EventPublisher                          string = "EVENT_PUBLISHER"
+	EventPublisherURL                       string = "EVENT_PUBLISHER_URL"
+	EventPublisherTimeout                   string = "EVENT_PUBLISHER_TIMEOUT"
+	EventPublisherQueueSize                 string = "EVENT_PUBLISHER_QUEUE_SIZE"
+	EventTopicPrefix                        string = "EVENT_TOPIC_PREFIX"
+	EventTypes                              string = "EVENT_TYPES"
+	EventNamespaces                         string = "EVENT_NAMESPACES"
+	WebhookTimeout                          string = "WEBHOOK_TIMEOUT"
+	WebhookMaxAttempts                      string = "WEBHOOK_MAX_ATTEMPTS"
+	WebhookDeliveryRetention                string = "WEBHOOK_DELIVERY_RETENTION"
+	WebhookAllowedHosts                     string = "WEBHOOK_ALLOWED_HOSTS"
+	WebhookDeniedHosts                      string = "WEBHOOK_DENIED_HOSTS"
+	TektonNamespace                         string = "TEKTON_NAMESPACE"
+	TektonCompatibilityCheck                string = "TEKTON_COMPATIBILITY_CHECK"
+	DefaultExperimentName                   string = "DEFAULT_EXPERIMENT_NAME"
+	DefaultExperimentDescription            string = "DEFAULT_EXPERIMENT_DESCRIPTION"
+	StalledRunTimeout                       string = "STALLED_RUN_TIMEOUT"
+	StalledRunResubmit                      string = "STALLED_RUN_RESUBMIT"
+	ObjectStoreNamespacesConfig             string = "ObjectStoreConfig.Namespaces"
+	FeaturesConfig                          string = "Features"
+	NotificationPolicyConfig                string = "NotificationConfig"
 )
 
+// InjectionPolicy holds the settings injected into the step containers of every
+// compiled task, so cluster-wide settings such as proxies don't require
+// recompiling pipelines.
+type InjectionPolicy struct {
+	Env              []corev1.EnvVar
+	EnvFrom          []corev1.EnvFromSource
+	ImagePullSecrets []corev1.LocalObjectReference
+}
+
 func IsPipelineVersionUpdatedByDefault() bool {
 	return GetBoolConfigWithDefault(UpdatePipelineVersionByDefault, true)
 }
 
 func GetStringConfig(configName string) string {
 	if !viper.IsSet(configName) {
-		glog.Fatalf("Please specify flag %s", configName)
+		log.Fatalf("Please specify flag %s", configName)
 	}
 	return viper.GetString(configName)
 }
@@ -72,19 +151,26 @@ func GetStringConfigWithDefault(configName, value string) string {
 
 func GetMapConfig(configName string) map[string]string {
 	if !viper.IsSet(configName) {
-		glog.Infof("Config %s not specified, skipping", configName)
+		log.Infof("Config %s not specified, skipping", configName)
 		return nil
 	}
 	return viper.GetStringMapString(configName)
 }
 
+func GetStringSliceConfig(configName string) []string {
+	if !viper.IsSet(configName) {
+		return nil
+	}
+	return viper.GetStringSlice(configName)
+}
+
 func GetBoolConfigWithDefault(configName string, value bool) bool {
 	if !viper.IsSet(configName) {
 		return value
 	}
 	value, err := strconv.ParseBool(viper.GetString(configName))
 	if err != nil {
-		glog.Fatalf("Failed converting string to bool %s", viper.GetString(configName))
+		log.Fatalf("Failed converting string to bool %s", viper.GetString(configName))
 	}
 	return value
 }
@@ -105,7 +191,14 @@ func GetIntConfigWithDefault(configName string, value int) int {
 
 func GetDurationConfig(configName string) time.Duration {
 	if !viper.IsSet(configName) {
-		glog.Fatalf("Please specify flag %s", configName)
+		log.Fatalf("Please specify flag %s", configName)
+	}
+	return viper.GetDuration(configName)
+}
+
+func GetDurationConfigWithDefault(configName string, value time.Duration) time.Duration {
+	if !viper.IsSet(configName) {
+		return value
 	}
 	return viper.GetDuration(configName)
 }
@@ -150,14 +243,246 @@ func GetMoveResultsImage() string {
 	return GetStringConfigWithDefault(MoveResultsImage, DefaultMoveResultImage)
 }
 
+// GetImporterImage returns the image of the steps registering the artifacts of v2
+// importers in ML Metadata.
+func GetImporterImage() string {
+	return GetStringConfigWithDefault(ImporterImage, DefaultImporterImage)
+}
+
+// GetImporterMetadataHost returns the host of the ML Metadata service as seen from
+// the namespaces of the runs.
+func GetImporterMetadataHost() string {
+	return GetStringConfigWithDefault(ImporterMetadataHost, DefaultImporterMetadataHost)
+}
+
+func GetImporterMetadataPort() string {
+	return GetStringConfigWithDefault(ImporterMetadataPort, DefaultImporterMetadataPort)
+}
+
 func GetCopyStepTemplate() *workflowapi.Step {
 	var tpl workflowapi.Step
 	if err := viper.UnmarshalKey(ArtifactCopyStepTemplate, &tpl); err != nil {
-		glog.Fatalf("Invalid '%s', %v", ArtifactCopyStepTemplate, err)
+		log.Fatalf("Invalid '%s', %v", ArtifactCopyStepTemplate, err)
 	}
 	return &tpl
 }
 
+func GetInjectionPolicy() *InjectionPolicy {
+	var policy InjectionPolicy
+	if err := viper.UnmarshalKey(InjectionPolicyConfig, &policy); err != nil {
+		log.Fatalf("Invalid '%s', %v", InjectionPolicyConfig, err)
+	}
+	return &policy
+}
+
+// ArtifactRetentionRule limits how long the artifacts of the finished runs in
+// its scope are kept, and how much storage they can use in total. Zero values
+// don't limit.
+type ArtifactRetentionRule struct {
+	Namespace    string
+	ExperimentID string
+	MaxAge       time.Duration
+	MaxTotalSize int64
+}
+
+func (r ArtifactRetentionRule) IsEmpty() bool {
+	return r.MaxAge <= 0 && r.MaxTotalSize <= 0
+}
+
+// ArtifactRetentionPolicy holds the retention rules of the run artifacts. The
+// rule of an experiment takes precedence over the rule of its namespace, which
+// takes precedence over the default rule.
+type ArtifactRetentionPolicy struct {
+	Default ArtifactRetentionRule
+	Rules   []ArtifactRetentionRule
+}
+
+// RuleFor returns the retention rule of the runs of an experiment.
+func (p *ArtifactRetentionPolicy) RuleFor(namespace string, experimentID string) ArtifactRetentionRule {
+	var namespaceRule *ArtifactRetentionRule
+	for i, rule := range p.Rules {
+		if rule.ExperimentID != "" && rule.ExperimentID == experimentID {
+			return rule
+		}
+		if rule.ExperimentID == "" && rule.Namespace != "" && rule.Namespace == namespace && namespaceRule == nil {
+			namespaceRule = &p.Rules[i]
+		}
+	}
+	if namespaceRule != nil {
+		return *namespaceRule
+	}
+	return p.Default
+}
+
+func GetArtifactRetentionPolicy() *ArtifactRetentionPolicy {
+	var policy ArtifactRetentionPolicy
+	if err := viper.UnmarshalKey(ArtifactRetentionPolicyConfig, &policy); err != nil {
+		log.Fatalf("Invalid '%s', %v", ArtifactRetentionPolicyConfig, err)
+	}
+	return &policy
+}
+
+// GetArtifactGCInterval returns how often the expired artifacts are deleted.
+// Zero disables the garbage collection.
+func GetArtifactGCInterval() time.Duration {
+	if !viper.IsSet(ArtifactGCInterval) {
+		return 0
+	}
+	return viper.GetDuration(ArtifactGCInterval)
+}
+
+// GetArtifactDedupInterval returns how often the artifacts of the finished runs
+// are deduplicated. Zero disables the deduplication.
+func GetArtifactDedupInterval() time.Duration {
+	if !viper.IsSet(ArtifactDedupInterval) {
+		return 0
+	}
+	return viper.GetDuration(ArtifactDedupInterval)
+}
+
+// GetOCIRegistry returns the registry, and the repository prefix if any, that
+// run artifacts are pushed to as OCI artifacts. Pushing is disabled when no
+// registry is configured.
+func GetOCIRegistry() string {
+	return GetStringConfigWithDefault(OCIRegistry, "")
+}
+
+func GetOCIRegistryUsername() string {
+	return GetStringConfigWithDefault(OCIRegistryUsername, "")
+}
+
+func GetOCIRegistryPassword() string {
+	return GetStringConfigWithDefault(OCIRegistryPassword, "")
+}
+
+// IsOCIRegistryInsecure returns whether the registry is reached over plain HTTP.
+func IsOCIRegistryInsecure() bool {
+	return GetBoolConfigWithDefault(OCIRegistryInsecure, false)
+}
+
+func GetOCIRegistryTimeout() time.Duration {
+	return GetDurationConfigWithDefault(OCIRegistryTimeout, DefaultOCIRegistryTimeout)
+}
+
+// GetOCIPushArtifactTypes returns the types of the artifacts pushed to the
+// registry once their run finishes, configured as a list or a comma separated
+// string.
+func GetOCIPushArtifactTypes() []string {
+	var types []string
+	for _, value := range viper.GetStringSlice(OCIPushArtifactTypes) {
+		for _, artifactType := range strings.Split(value, ",") {
+			if artifactType = strings.TrimSpace(artifactType); artifactType != "" {
+				types = append(types, artifactType)
+			}
+		}
+	}
+	return types
+}
+
+// GetOCIPushInterval returns how often the artifacts of the configured types
+// are pushed to the registry. Zero disables the periodic push, leaving only the
+// push on demand.
+func GetOCIPushInterval() time.Duration {
+	if !viper.IsSet(OCIPushInterval) {
+		return 0
+	}
+	return viper.GetDuration(OCIPushInterval)
+}
+
+// GetDBSchemaVersion returns the version the database schema is migrated to on
+// startup. A negative version, the default, stands for the latest one. A lower
+// version reverts the migrations of a release before rolling it back.
+func GetDBSchemaVersion() int64 {
+	if !viper.IsSet(DBSchemaVersion) {
+		return -1
+	}
+	return viper.GetInt64(DBSchemaVersion)
+}
+
+// GetRunArchiveAge returns the age of the months whose finished runs are moved
+// to the run archive. Zero disables the archival.
+func GetRunArchiveAge() time.Duration {
+	if !viper.IsSet(RunArchiveAge) {
+		return 0
+	}
+	return viper.GetDuration(RunArchiveAge)
+}
+
+// GetRunArchiveInterval returns how often the old runs are moved to the run
+// archive.
+func GetRunArchiveInterval() time.Duration {
+	return GetDurationConfigWithDefault(RunArchiveInterval, 24*time.Hour)
+}
+
+// GetSoftDeletePurgeWindow returns how long the deleted pipelines, experiments,
+// jobs and runs are kept before they are purged. Zero deletes them right away.
+func GetSoftDeletePurgeWindow() time.Duration {
+	if !viper.IsSet(SoftDeletePurgeWindow) {
+		return 0
+	}
+	return viper.GetDuration(SoftDeletePurgeWindow)
+}
+
+// GetStalledRunTimeout returns how long the status of an unfinished run can stay
+// the same before the run is considered stalled. Zero disables the check.
+func GetStalledRunTimeout() time.Duration {
+	if !viper.IsSet(StalledRunTimeout) {
+		return 0
+	}
+	return viper.GetDuration(StalledRunTimeout)
+}
+
+// IsStalledRunResubmit returns whether the PipelineRuns of the stalled runs are
+// resubmitted, rather than only marked stalled.
+func IsStalledRunResubmit() bool {
+	return GetBoolConfigWithDefault(StalledRunResubmit, false)
+}
+
+func GetTemplateCacheSize() int {
+	return GetIntConfigWithDefault(TemplateCacheSize, DefaultTemplateCacheSize)
+}
+
+// GetArtifactCacheDir returns the directory caching the artifacts read by the
+// visualizations and previews. Caching is disabled when no directory is
+// configured.
+func GetArtifactCacheDir() string {
+	return GetStringConfigWithDefault(ArtifactCacheDir, "")
+}
+
+// GetArtifactCacheSize returns the size in bytes the artifact cache is limited to.
+func GetArtifactCacheSize() int64 {
+	return int64(GetIntConfigWithDefault(ArtifactCacheSize, DefaultArtifactCacheSize))
+}
+
+func GetMaxManifestSize() int {
+	return GetIntConfigWithDefault(MaxManifestSize, DefaultMaxManifestSize)
+}
+
+func GetManifestCompressionThreshold() int {
+	return GetIntConfigWithDefault(ManifestCompressionThreshold, DefaultManifestCompressionThreshold)
+}
+
+// GetAuditSink returns the sink audit events are written to. Auditing is
+// disabled when no sink is configured.
+func GetAuditSink() string {
+	return GetStringConfigWithDefault(AuditSink, "")
+}
+
+func GetAuditFilePath() string {
+	return GetStringConfigWithDefault(AuditFilePath, DefaultAuditFilePath)
+}
+
+func GetAuditWebhookURL() string {
+	return GetStringConfig(AuditWebhookURL)
+}
+
+func GetAuditWebhookTimeout() time.Duration {
+	if !viper.IsSet(AuditWebhookTimeout) {
+		return DefaultAuditWebhookTimeout
+	}
+	return viper.GetDuration(AuditWebhookTimeout)
+}
+
 func GetBoolFromStringWithDefault(value string, defaultValue bool) bool {
 	boolVal, err := strconv.ParseBool(value)
 	if err != nil {
@@ -186,10 +511,316 @@ func GetTokenReviewAudience() string {
 	return GetStringConfigWithDefault(TokenReviewAudience, DefaultTokenReviewAudience)
 }
 
+// GetOIDCIssuer returns the issuer of the OIDC tokens validated by the apiserver.
+// OIDC token validation is disabled when no issuer is configured.
+func GetOIDCIssuer() string {
+	return GetStringConfigWithDefault(OIDCIssuer, "")
+}
+
+func GetOIDCAudience() string {
+	return GetStringConfig(OIDCAudience)
+}
+
+func GetOIDCUsernameClaim() string {
+	return GetStringConfigWithDefault(OIDCUsernameClaim, DefaultOIDCUsernameClaim)
+}
+
+// GetOIDCJWKSURL returns the URL of the issuer signing keys. When empty, the URL
+// is discovered from the issuer.
+func GetOIDCJWKSURL() string {
+	return GetStringConfigWithDefault(OIDCJWKSURL, "")
+}
+
+func GetOIDCJWKSCacheTTL() time.Duration {
+	if !viper.IsSet(OIDCJWKSCacheTTL) {
+		return DefaultOIDCJWKSCacheTTL
+	}
+	return viper.GetDuration(OIDCJWKSCacheTTL)
+}
+
+// GetRateLimitUserQPS returns the requests per second allowed per user. Zero
+// disables the limit.
+func GetRateLimitUserQPS() float64 {
+	return GetFloat64ConfigWithDefault(RateLimitUserQPS, 0)
+}
+
+func GetRateLimitUserBurst() int {
+	return GetIntConfigWithDefault(RateLimitUserBurst, DefaultRateLimitBurst)
+}
+
+// GetRateLimitNamespaceQPS returns the requests per second allowed per namespace.
+// Zero disables the limit.
+func GetRateLimitNamespaceQPS() float64 {
+	return GetFloat64ConfigWithDefault(RateLimitNamespaceQPS, 0)
+}
+
+func GetRateLimitNamespaceBurst() int {
+	return GetIntConfigWithDefault(RateLimitNamespaceBurst, DefaultRateLimitBurst)
+}
+
+// GetRateLimitTrustedProxies returns the CIDRs of the proxies whose X-Forwarded-For
+// headers identify the clients without a user identity.
+func GetRateLimitTrustedProxies() []string {
+	return getStringListConfig(RateLimitTrustedProxies)
+}
+
+// GetMaxConcurrentRunsPerNamespace returns the number of runs allowed to be active at
+// the same time in a namespace. Zero means unlimited.
+func GetMaxConcurrentRunsPerNamespace() int {
+	return GetIntConfigWithDefault(MaxConcurrentRunsPerNamespace, 0)
+}
+
+// GetShutdownTimeout returns how long the API server waits for the requests in
+// flight when it's terminated.
+func GetShutdownTimeout() time.Duration {
+	if !viper.IsSet(ShutdownTimeout) {
+		return DefaultShutdownTimeout
+	}
+	return viper.GetDuration(ShutdownTimeout)
+}
+
+// GetReadinessCheckTimeout returns how long the readiness probe waits for each
+// dependency of the API server.
+func GetReadinessCheckTimeout() time.Duration {
+	return GetDurationConfigWithDefault(ReadinessCheckTimeout, DefaultReadinessCheckTimeout)
+}
+
+// GetEventPublisher returns the message bus the lifecycle events of the runs,
+// jobs and pipelines are published to. No event is published when no
+// publisher is configured.
+func GetEventPublisher() string {
+	return GetStringConfigWithDefault(EventPublisher, "")
+}
+
+// GetEventPublisherURL returns the URL of the Kafka REST proxy, of the NATS
+// server, or of the CloudEvents sink the events are published to.
+func GetEventPublisherURL() string {
+	return GetStringConfig(EventPublisherURL)
+}
+
+func GetEventPublisherTimeout() time.Duration {
+	return GetDurationConfigWithDefault(EventPublisherTimeout, DefaultEventPublisherTimeout)
+}
+
+// GetEventPublisherQueueSize returns how many events wait to be published
+// before the next ones are dropped.
+func GetEventPublisherQueueSize() int {
+	return GetIntConfigWithDefault(EventPublisherQueueSize, DefaultEventPublisherQueueSize)
+}
+
+// GetEventTopicPrefix returns the prefix of the Kafka topics and of the NATS
+// subjects the events are published to.
+func GetEventTopicPrefix() string {
+	return GetStringConfigWithDefault(EventTopicPrefix, DefaultEventTopicPrefix)
+}
+
+// GetEventTypes returns the types of the events to publish, e.g. run.finished,
+// or run.* for all the events of the runs. All the events are published when
+// no type is configured.
+func GetEventTypes() []string {
+	return getStringListConfig(EventTypes)
+}
+
+// GetEventNamespaces returns the namespaces whose events are published. The
+// events of all the namespaces are published when no namespace is configured.
+func GetEventNamespaces() []string {
+	return getStringListConfig(EventNamespaces)
+}
+
+// GetWebhookTimeout returns the timeout of a webhook delivery attempt.
+func GetWebhookTimeout() time.Duration {
+	return GetDurationConfigWithDefault(WebhookTimeout, DefaultWebhookTimeout)
+}
+
+// GetWebhookMaxAttempts returns how many times a webhook delivery is attempted
+// before it's failed.
+func GetWebhookMaxAttempts() int {
+	return GetIntConfigWithDefault(WebhookMaxAttempts, DefaultWebhookMaxAttempts)
+}
+
+// GetWebhookDeliveryRetention returns how long the finished webhook deliveries
+// are kept in the delivery log.
+func GetWebhookDeliveryRetention() time.Duration {
+	return GetDurationConfigWithDefault(WebhookDeliveryRetention, DefaultWebhookDeliveryRetention)
+}
+
+// GetWebhookAllowedHosts returns the only hosts the webhooks may target, which
+// may be in the cluster network. Any host resolving to public addresses may be
+// targeted when no host is configured.
+func GetWebhookAllowedHosts() []string {
+	return getStringListConfig(WebhookAllowedHosts)
+}
+
+// GetWebhookDeniedHosts returns the hosts the webhooks may never target.
+func GetWebhookDeniedHosts() []string {
+	return getStringListConfig(WebhookDeniedHosts)
+}
+
+// GetTektonNamespace returns the namespace Tekton Pipelines is installed in.
+func GetTektonNamespace() string {
+	return GetStringConfigWithDefault(TektonNamespace, util.DefaultTektonNamespace)
+}
+
+// GetTektonCompatibilityCheck returns whether the API server fails, only warns
+// or doesn't check when the Tekton installation lacks a required feature.
+func GetTektonCompatibilityCheck() string {
+	return GetStringConfigWithDefault(TektonCompatibilityCheck, util.TektonCompatibilityCheckFail)
+}
+
+// GetDefaultExperimentName returns the name of the default experiment of a
+// namespace, the empty namespace being the default experiment of single-user
+// mode. The {namespace} placeholder of the configured name is replaced with the
+// namespace.
+func GetDefaultExperimentName(namespace string) string {
+	name := GetStringConfigWithDefault(DefaultExperimentName, DefaultDefaultExperimentName)
+	return strings.TrimSpace(strings.ReplaceAll(name, "{namespace}", namespace))
+}
+
+// GetDefaultExperimentDescription returns the description of the default
+// experiment of a namespace, with the {namespace} placeholder replaced too.
+func GetDefaultExperimentDescription(namespace string) string {
+	description := GetStringConfigWithDefault(DefaultExperimentDescription, DefaultDefaultExperimentDescription)
+	return strings.ReplaceAll(description, "{namespace}", namespace)
+}
+
+// NotificationRoute sends the notifications of the runs in its scope to a Slack
+// or Teams channel, or to a generic webhook. An empty namespace or experiment
+// matches all of them.
+type NotificationRoute struct {
+	Name         string
+	Namespace    string
+	ExperimentID string
+	// Events are succeeded, failed or long_running, all of them when empty.
+	Events []string
+	// Channel is slack, teams or webhook.
+	Channel string
+	URL     string
+	// Template is the text/template of the messages, the default message of
+	// the event when empty.
+	Template string
+}
+
+// NotificationPolicy holds the routes of the notifications of the runs, and how
+// often they may be sent. Every route matching a run gets its notifications.
+type NotificationPolicy struct {
+	Routes []NotificationRoute
+	// UIBaseURL is the URL of the Kubeflow Pipelines UI the messages link the
+	// runs to, e.g. https://kubeflow.example.com/pipeline. No link when empty.
+	UIBaseURL string
+	// LongRunningAfter is how long a run runs before the long_running
+	// notification. Zero disables it.
+	LongRunningAfter time.Duration
+	// DedupWindow is how long the same notification of a run isn't sent again.
+	DedupWindow time.Duration
+	// RateLimitPerMinute is how many messages a route sends per minute, the
+	// next ones are dropped.
+	RateLimitPerMinute int
+	Timeout            time.Duration
+	QueueSize          int
+}
+
+// GetNotificationPolicy returns the routes of the notifications of the runs.
+// No notification is sent when no route is configured.
+func GetNotificationPolicy() *NotificationPolicy {
+	var policy NotificationPolicy
+	if err := viper.UnmarshalKey(NotificationPolicyConfig, &policy); err != nil {
+		log.Fatalf("Invalid '%s', %v", NotificationPolicyConfig, err)
+	}
+	if policy.DedupWindow <= 0 {
+		policy.DedupWindow = DefaultNotificationDedupWindow
+	}
+	if policy.RateLimitPerMinute <= 0 {
+		policy.RateLimitPerMinute = DefaultNotificationRateLimitPerMinute
+	}
+	if policy.Timeout <= 0 {
+		policy.Timeout = DefaultNotificationTimeout
+	}
+	if policy.QueueSize <= 0 {
+		policy.QueueSize = DefaultNotificationQueueSize
+	}
+	return &policy
+}
+
+// GetHTTPTLSCertFile returns the certificate the HTTP gateway is served with. The
+// gateway is served in plaintext when no certificate is configured.
+func GetHTTPTLSCertFile() string {
+	return GetStringConfigWithDefault(HTTPTLSCertFile, "")
+}
+
+func GetHTTPTLSKeyFile() string {
+	return GetStringConfigWithDefault(HTTPTLSKeyFile, "")
+}
+
+// GetCORSAllowedOrigins returns the origins browsers may call the HTTP gateway
+// from, configured as a list or a comma separated string.
+func GetCORSAllowedOrigins() []string {
+	return getStringListConfig(CORSAllowedOrigins)
+}
+
+// getStringListConfig returns a config set as a list or a comma separated string.
+func getStringListConfig(configName string) []string {
+	var values []string
+	for _, value := range viper.GetStringSlice(configName) {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
+
 func GetArtifactBucket() string {
 	return GetStringConfigWithDefault(ArtifactBucket, DefaultArtifactBucket)
 }
 
+// ObjectStoreNamespace isolates the objects of a namespace in a bucket of their
+// own, or a prefix of the bucket, which the credentials in the secret of the
+// namespace are scoped to.
+type ObjectStoreNamespace struct {
+	BucketName        string
+	Prefix            string
+	CredentialsSecret string
+}
+
+// GetObjectStoreNamespaces returns the isolation of the namespaces that have one.
+func GetObjectStoreNamespaces() map[string]ObjectStoreNamespace {
+	var namespaces map[string]ObjectStoreNamespace
+	if err := viper.UnmarshalKey(ObjectStoreNamespacesConfig, &namespaces); err != nil {
+		log.Fatalf("Invalid '%s', %v", ObjectStoreNamespacesConfig, err)
+	}
+	for namespace, isolation := range namespaces {
+		if isolation.CredentialsSecret == "" {
+			isolation.CredentialsSecret = DefaultArtifactCredentialsSecret
+			namespaces[namespace] = isolation
+		}
+	}
+	return namespaces
+}
+
+// GetArtifactBucketForNamespace returns where the steps of the runs of a
+// namespace push their artifacts, the bucket followed by the prefix if any.
+func GetArtifactBucketForNamespace(namespace string) string {
+	bucket := GetArtifactBucket()
+	isolation, ok := GetObjectStoreNamespaces()[namespace]
+	if !ok {
+		return bucket
+	}
+	if isolation.BucketName != "" {
+		bucket = isolation.BucketName
+	}
+	return path.Join(bucket, isolation.Prefix)
+}
+
+// GetArtifactCredentialsSecretForNamespace returns the secret holding the
+// credentials that the steps of the runs of a namespace push artifacts with.
+func GetArtifactCredentialsSecretForNamespace(namespace string) string {
+	if isolation, ok := GetObjectStoreNamespaces()[namespace]; ok {
+		return isolation.CredentialsSecret
+	}
+	return DefaultArtifactCredentialsSecret
+}
+
 func GetArtifactEndpoint() string {
 	return GetStringConfigWithDefault(ArtifactEndpoint, DefaultArtifactEndpoint)
 }
@@ -0,0 +1,71 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactRetentionPolicy_RuleFor(t *testing.T) {
+	policy := &ArtifactRetentionPolicy{
+		Default: ArtifactRetentionRule{MaxAge: 30 * 24 * time.Hour},
+		Rules: []ArtifactRetentionRule{
+			{Namespace: "ns1", MaxAge: time.Hour},
+			{Namespace: "ns1", ExperimentID: "exp1", MaxTotalSize: 100},
+		},
+	}
+	assert.Equal(t, policy.Rules[1], policy.RuleFor("ns1", "exp1"))
+	assert.Equal(t, policy.Rules[0], policy.RuleFor("ns1", "exp2"))
+	assert.Equal(t, policy.Default, policy.RuleFor("ns2", "exp2"))
+	assert.True(t, (&ArtifactRetentionPolicy{}).RuleFor("ns1", "exp1").IsEmpty())
+}
+
+func TestGetArtifactRetentionPolicy(t *testing.T) {
+	viper.Set(ArtifactRetentionPolicyConfig, map[string]interface{}{
+		"Default": map[string]interface{}{"MaxAge": "720h"},
+		"Rules": []map[string]interface{}{
+			{"Namespace": "ns1", "MaxTotalSize": 1024},
+		},
+	})
+	defer viper.Set(ArtifactRetentionPolicyConfig, nil)
+
+	policy := GetArtifactRetentionPolicy()
+	assert.Equal(t, 720*time.Hour, policy.Default.MaxAge)
+	assert.Equal(t, []ArtifactRetentionRule{{Namespace: "ns1", MaxTotalSize: 1024}}, policy.Rules)
+}
+
+func TestGetObjectStoreNamespaces(t *testing.T) {
+	viper.Set(ArtifactBucket, "mlpipeline")
+	viper.Set(ObjectStoreNamespacesConfig, map[string]interface{}{
+		"ns1": map[string]interface{}{"BucketName": "ns1-artifacts", "CredentialsSecret": "ns1-s3"},
+		"ns2": map[string]interface{}{"Prefix": "tenants/ns2"},
+	})
+	defer viper.Set(ArtifactBucket, nil)
+	defer viper.Set(ObjectStoreNamespacesConfig, nil)
+
+	assert.Equal(t, map[string]ObjectStoreNamespace{
+		"ns1": {BucketName: "ns1-artifacts", CredentialsSecret: "ns1-s3"},
+		"ns2": {Prefix: "tenants/ns2", CredentialsSecret: DefaultArtifactCredentialsSecret},
+	}, GetObjectStoreNamespaces())
+	assert.Equal(t, "ns1-artifacts", GetArtifactBucketForNamespace("ns1"))
+	assert.Equal(t, "mlpipeline/tenants/ns2", GetArtifactBucketForNamespace("ns2"))
+	assert.Equal(t, "mlpipeline", GetArtifactBucketForNamespace("ns3"))
+	assert.Equal(t, "ns1-s3", GetArtifactCredentialsSecretForNamespace("ns1"))
+	assert.Equal(t, DefaultArtifactCredentialsSecret, GetArtifactCredentialsSecretForNamespace("ns3"))
+}
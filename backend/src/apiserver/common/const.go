@@ -15,6 +15,8 @@
 package common
 
 import (
+	"time"
+
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -40,18 +42,32 @@ const (
 	RbacResourceTypeJobs           = "jobs"
 	RbacResourceTypeViewers        = "viewers"
 	RbacResourceTypeVisualizations = "visualizations"
+	RbacResourceTypeAuditEvents    = "auditevents"
+	RbacResourceTypeArtifacts      = "artifacts"
+	RbacResourceTypeWebhooks       = "webhooks"
+	RbacResourceTypeBackups        = "backups"
+	RbacResourceTypeNamespaces     = "namespaces"
+
+	RbacSubresourceVersions = "versions"
 
-	RbacResourceVerbArchive   = "archive"
-	RbacResourceVerbUpdate    = "update"
-	RbacResourceVerbCreate    = "create"
-	RbacResourceVerbDelete    = "delete"
-	RbacResourceVerbDisable   = "disable"
-	RbacResourceVerbEnable    = "enable"
-	RbacResourceVerbGet       = "get"
-	RbacResourceVerbList      = "list"
-	RbacResourceVerbRetry     = "retry"
-	RbacResourceVerbTerminate = "terminate"
-	RbacResourceVerbUnarchive = "unarchive"
+	RbacResourceVerbArchive       = "archive"
+	RbacResourceVerbUpdate        = "update"
+	RbacResourceVerbCreate        = "create"
+	RbacResourceVerbDelete        = "delete"
+	RbacResourceVerbDisable       = "disable"
+	RbacResourceVerbEnable        = "enable"
+	RbacResourceVerbGet           = "get"
+	RbacResourceVerbList          = "list"
+	RbacResourceVerbRetry         = "retry"
+	RbacResourceVerbTerminate     = "terminate"
+	RbacResourceVerbUnarchive     = "unarchive"
+	RbacResourceVerbUndelete      = "undelete"
+	RbacResourceVerbReadArtifact  = "readArtifact"
+	RbacResourceVerbWriteArtifact = "writeArtifact"
+	RbacResourceVerbReadLog       = "readLog"
+	RbacResourceVerbRestore       = "restore"
+	RbacResourceVerbExport        = "export"
+	RbacResourceVerbImport        = "import"
 )
 
 const (
@@ -68,6 +84,194 @@ const (
 
 const DefaultTokenReviewAudience string = "pipelines.kubeflow.org"
 
+const (
+	DefaultOIDCUsernameClaim string        = "email"
+	DefaultOIDCJWKSCacheTTL  time.Duration = time.Hour
+)
+
+const DefaultTemplateCacheSize int = 100
+
+const DefaultArtifactCacheSize int = 1 << 30 // 1Gb
+
+// The startup lease serializes the initialization of the database, like loading
+// the samples, between apiserver replicas.
+const (
+	StartupLeaseName string        = "startup"
+	StartupLeaseTTL  time.Duration = 10 * time.Minute
+)
+
+// The artifact GC lease makes a single apiserver replica delete the expired
+// artifacts.
+const ArtifactGCLeaseName string = "artifact-gc"
+
+// The artifact dedup lease makes a single apiserver replica deduplicate the
+// artifacts.
+const ArtifactDedupLeaseName string = "artifact-dedup"
+
+// The upload GC lease makes a single apiserver replica delete the expired
+// uploads, every UploadGCInterval.
+const (
+	UploadGCLeaseName string        = "upload-gc"
+	UploadGCInterval  time.Duration = time.Hour
+)
+
+// The OCI push lease makes a single apiserver replica push the artifacts to the
+// registry.
+const OCIPushLeaseName string = "oci-push"
+
+// The list queries are read from the read replicas lagging at most
+// DefaultMaxReplicaLag behind the primary, checked every ReplicaLagCheckInterval.
+const (
+	DefaultMaxReplicaLag    time.Duration = 10 * time.Second
+	ReplicaLagCheckInterval time.Duration = 5 * time.Second
+)
+
+// The database queries slower than DefaultSlowQueryThreshold are logged.
+const DefaultSlowQueryThreshold time.Duration = time.Second
+
+const DefaultOCIRegistryTimeout time.Duration = 5 * time.Minute
+
+// The run archive lease makes a single apiserver replica move the old runs to
+// the run archive, RunArchiveBatchSize runs per transaction.
+const (
+	RunArchiveLeaseName string = "run-archive"
+	RunArchiveBatchSize int    = 500
+)
+
+// The run submitter lease makes a single apiserver replica create the PipelineRuns
+// of the stored runs, when they're created and every RunSubmitterInterval,
+// RunSubmissionBatchSize runs at a time, until RunSubmissionMaxAttempts failures.
+const (
+	RunSubmitterLeaseName    string        = "run-submitter"
+	RunSubmitterInterval     time.Duration = 10 * time.Second
+	RunSubmissionBatchSize   int           = 100
+	RunSubmissionMaxAttempts int64         = 20
+)
+
+// The soft delete purge lease makes a single apiserver replica purge the
+// resources deleted longer than the purge window ago.
+const (
+	SoftDeletePurgeLeaseName string        = "soft-delete-purge"
+	SoftDeletePurgeInterval  time.Duration = time.Hour
+)
+
+const DefaultRateLimitBurst int = 20
+
+// The runs of a namespace are created one at a time under the lease
+// ConcurrentRunsLeasePrefix followed by the namespace when the number of its
+// active runs is limited, so concurrent requests can't exceed the limit.
+const (
+	ConcurrentRunsLeasePrefix string        = "concurrent-runs-"
+	ConcurrentRunsLeaseTTL    time.Duration = time.Minute
+)
+
+// The default experiments group the runs and jobs created without an
+// experiment. In multi-user mode each namespace has its own, created under the
+// lease DefaultExperimentLeasePrefix followed by the namespace.
+const (
+	DefaultDefaultExperimentName        string        = "Default"
+	DefaultDefaultExperimentDescription string        = "All runs created without specifying an experiment will be grouped here."
+	DefaultExperimentLeasePrefix        string        = "default-experiment-"
+	DefaultExperimentLeaseTTL           time.Duration = time.Minute
+)
+
+const (
+	DefaultMaxManifestSize              int = 32 << 20 // 32Mb
+	DefaultManifestCompressionThreshold int = 1 << 20  // 1Mb
+)
+
+const (
+	DefaultAuditFilePath       string        = "/var/log/kubeflow-pipelines/audit.log"
+	DefaultAuditWebhookTimeout time.Duration = 10 * time.Second
+)
+
+// DefaultSignedURLExpiry is how long the signed URLs of artifacts are valid when
+// the request doesn't specify it.
+const DefaultSignedURLExpiry time.Duration = 15 * time.Minute
+
+// Resumable uploads are sent in chunks of at least MinUploadChunkSize bytes,
+// which the object store can compose, except for the last one, and at most
+// MaxUploadChunkSize bytes. The uploads that aren't complete after UploadTTL are
+// deleted.
+const (
+	MinUploadChunkSize int64         = 5 << 20  // 5Mb
+	MaxUploadChunkSize int64         = 64 << 20 // 64Mb
+	UploadTTL          time.Duration = 24 * time.Hour
+)
+
+// Artifact previews return DefaultArtifactPreviewSize bytes from the head of a
+// file, unless the request asks for more, up to MaxArtifactPreviewSize bytes.
+const (
+	DefaultArtifactPreviewSize int = 4 << 10 // 4Kb
+	MaxArtifactPreviewSize     int = 1 << 20 // 1Mb
+)
+
+// The lineage of an artifact spans DefaultLineageDepth executions upstream and
+// downstream of it, unless the request asks for up to MaxLineageDepth.
+const (
+	DefaultLineageDepth int = 3
+	MaxLineageDepth     int = 10
+)
+
+// DefaultShutdownTimeout fits in the default termination grace period of pods.
+const DefaultShutdownTimeout time.Duration = 25 * time.Second
+
+// DefaultReadinessCheckTimeout lets the readiness probe answer within the timeout
+// of the probe of the deployment.
+const DefaultReadinessCheckTimeout time.Duration = time.Second
+
+// The gRPC health status of the API services is updated from the readiness
+// checks every HealthCheckInterval.
+const HealthCheckInterval time.Duration = 10 * time.Second
+
+const (
+	DefaultEventPublisherTimeout   time.Duration = 10 * time.Second
+	DefaultEventPublisherQueueSize int           = 1000
+	DefaultEventTopicPrefix        string        = "kfp"
+)
+
+const (
+	DefaultWebhookTimeout           time.Duration = 10 * time.Second
+	DefaultWebhookMaxAttempts       int           = 8
+	DefaultWebhookDeliveryRetention time.Duration = 7 * 24 * time.Hour
+)
+
+// The webhook deliveries are sent by a single replica at a time, in batches. A
+// failed attempt is retried after a backoff, doubled after every attempt.
+const (
+	WebhookDeliveryLeaseName         string        = "webhook-delivery"
+	WebhookDeliveryInterval          time.Duration = 10 * time.Second
+	WebhookDeliveryBatchSize         int           = 100
+	WebhookDeliveryInitialBackoff    time.Duration = 30 * time.Second
+	WebhookDeliveryMaxBackoff        time.Duration = time.Hour
+	WebhookSubscriptionCacheDuration time.Duration = 10 * time.Second
+	DefaultWebhookDeliveryListSize   int           = 50
+	MaxWebhookDeliveryListSize       int           = 200
+)
+
+const (
+	DefaultNotificationDedupWindow        time.Duration = time.Hour
+	DefaultNotificationRateLimitPerMinute int           = 20
+	DefaultNotificationTimeout            time.Duration = 10 * time.Second
+	DefaultNotificationQueueSize          int           = 1000
+)
+
+// The long running runs are looked for by a single replica at a time, and
+// notified once they run longer than the threshold of the notification policy.
+const (
+	LongRunningNotificationLeaseName string        = "long-running-notification"
+	LongRunningNotificationInterval  time.Duration = 5 * time.Minute
+)
+
+// The stalled runs are looked for by a single replica at a time. Their
+// PipelineRuns are resubmitted up to StalledRunMaxResubmissions times, then
+// the runs are marked stalled.
+const (
+	StalledRunCheckLeaseName   string        = "stalled-run-check"
+	StalledRunCheckInterval    time.Duration = 5 * time.Minute
+	StalledRunMaxResubmissions int           = 3
+)
+
 const (
 	DefaultArtifactBucket         string = "mlpipeline"
 	DefaultArtifactEndpoint       string = "minio-service.kubeflow:9000"
@@ -76,6 +280,18 @@ const (
 	DefaultMoveResultImage        string = "busybox"
 )
 
+// The importer steps of v2 pipelines register artifacts with a script of the
+// metadata writer image.
+const (
+	DefaultImporterImage        string = "gcr.io/ml-pipeline/metadata-writer"
+	DefaultImporterMetadataHost string = "metadata-grpc-service.kubeflow"
+	DefaultImporterMetadataPort string = "8080"
+)
+
+// The secret of a namespace holding the credentials of the object store under
+// the accesskey and secretkey keys.
+const DefaultArtifactCredentialsSecret string = "mlpipeline-minio-artifact"
+
 const (
 	ArtifactItemsAnnotation          string = "tekton.dev/artifact_items"
 	ArtifactBucketAnnotation         string = "tekton.dev/artifact_bucket"
@@ -83,8 +299,13 @@ const (
 	ArtifactEndpointSchemeAnnotation string = "tekton.dev/artifact_endpoint_scheme"
 	TrackArtifactAnnotation          string = "tekton.dev/track_artifact"
 	TrackStepArtifactAnnotation      string = "tekton.dev/track_step_artifact"
+	ResourceTemplatesAnnotation      string = "tekton.dev/resource_templates"
 )
 
+// Setting this annotation to "true" on a PipelineRun opts the pipeline out of the
+// server-configured injection policy.
+const DisableInjectionPolicyAnnotation string = "pipelines.kubeflow.org/disable_injection_policy"
+
 // For backward compatibility. Remove after 0.3 release
 const DefaultArtifactScript string = "push_artifact() {\n" +
 	"    tar -cvzf $1.tgz $2\n" +
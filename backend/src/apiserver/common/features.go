@@ -0,0 +1,91 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Feature is an optional behavior of the API server, turned on or off with the
+// Features section of the config file, e.g. {"Features": {"caching": false}},
+// or its environment variable override, e.g. FEATURES_CACHING=false.
+type Feature string
+
+const (
+	// FeatureV2Pipelines accepts the pipelines compiled to the KFP v2 pipeline spec.
+	FeatureV2Pipelines Feature = "v2_pipelines"
+	// FeatureCaching reuses the outputs of the steps that already ran with the
+	// same inputs.
+	FeatureCaching Feature = "caching"
+	// FeatureArtifactProxy serves the artifacts of the runs through the API
+	// server. When it's off, they're only downloaded with signed URLs.
+	FeatureArtifactProxy Feature = "artifact_proxy"
+)
+
+type featureDefinition struct {
+	description string
+	// defaultValue is used when the feature isn't configured, so the features
+	// that had their own config keep honoring it.
+	defaultValue func() bool
+}
+
+var features = map[Feature]featureDefinition{
+	FeatureV2Pipelines: {
+		description:  "Pipelines compiled to the KFP v2 pipeline spec are accepted.",
+		defaultValue: func() bool { return true },
+	},
+	FeatureCaching: {
+		description:  "Steps that already ran with the same inputs reuse their outputs.",
+		defaultValue: func() bool { return strings.ToLower(IsCacheEnabled()) == "true" },
+	},
+	FeatureArtifactProxy: {
+		description:  "Artifacts are read and previewed through the API server, in addition to signed URLs.",
+		defaultValue: func() bool { return true },
+	},
+}
+
+// FeatureInfo is the state of a feature, as reported to the UI and the SDK.
+type FeatureInfo struct {
+	Name        Feature `json:"name"`
+	Enabled     bool    `json:"enabled"`
+	Description string  `json:"description"`
+}
+
+// IsFeatureEnabled returns whether a feature is on. The config is read on every
+// call, so the changes to the config file apply without restarting.
+func IsFeatureEnabled(feature Feature) bool {
+	definition, ok := features[feature]
+	if !ok {
+		log.Fatalf("Unknown feature %s", feature)
+	}
+	return GetBoolConfigWithDefault(FeaturesConfig+"."+string(feature), definition.defaultValue())
+}
+
+// ListFeatures returns the state of every feature, sorted by name.
+func ListFeatures() []FeatureInfo {
+	infos := make([]FeatureInfo, 0, len(features))
+	for feature, definition := range features {
+		infos = append(infos, FeatureInfo{
+			Name:        feature,
+			Enabled:     IsFeatureEnabled(feature),
+			Description: definition.description,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFeatureEnabled(t *testing.T) {
+	assert.True(t, IsFeatureEnabled(FeatureCaching))
+
+	// The feature falls back on its former config.
+	viper.Set(CacheEnabled, "false")
+	defer viper.Set(CacheEnabled, nil)
+	assert.False(t, IsFeatureEnabled(FeatureCaching))
+
+	viper.Set(FeaturesConfig, map[string]interface{}{"caching": "true", "artifact_proxy": false})
+	defer viper.Set(FeaturesConfig, nil)
+	assert.True(t, IsFeatureEnabled(FeatureCaching))
+	assert.False(t, IsFeatureEnabled(FeatureArtifactProxy))
+	assert.True(t, IsFeatureEnabled(FeatureV2Pipelines))
+}
+
+func TestListFeatures(t *testing.T) {
+	viper.Set(FeaturesConfig+"."+string(FeatureV2Pipelines), "false")
+	defer viper.Set(FeaturesConfig+"."+string(FeatureV2Pipelines), nil)
+
+	features := ListFeatures()
+	assert.Len(t, features, 3)
+	assert.Equal(t, FeatureArtifactProxy, features[0].Name)
+	assert.True(t, features[0].Enabled)
+	assert.Equal(t, FeatureCaching, features[1].Name)
+	assert.Equal(t, FeatureInfo{
+		Name:        FeatureV2Pipelines,
+		Enabled:     false,
+		Description: "Pipelines compiled to the KFP v2 pipeline spec are accepted.",
+	}, features[2])
+}
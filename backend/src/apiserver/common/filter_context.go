@@ -24,4 +24,6 @@ type ReferenceKey struct {
 type FilterContext struct {
 	// Filter by a specific reference key
 	*ReferenceKey
+	// Whether to also list the runs moved to the run archive
+	IncludeRunArchive bool
 }
@@ -0,0 +1,71 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// CloudEventsPublisher sends the events to a CloudEvents sink over HTTP, e.g. a
+// Knative Eventing broker or an Argo Events webhook event source, in the binary
+// content mode, which every CloudEvents HTTP receiver supports: the attributes
+// of the event are in ce- headers and the body is its data.
+type CloudEventsPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func NewCloudEventsPublisher(url string, timeout time.Duration) *CloudEventsPublisher {
+	return &CloudEventsPublisher{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (p *CloudEventsPublisher) Publish(event *Event) error {
+	body, err := json.Marshal(event.Data)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal event %s", event.Type)
+	}
+	request, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create the request of event %s", event.Type)
+	}
+	request.Header.Set("Content-Type", event.DataContentType)
+	request.Header.Set("Ce-Specversion", event.SpecVersion)
+	request.Header.Set("Ce-Id", event.ID)
+	request.Header.Set("Ce-Source", event.Source)
+	request.Header.Set("Ce-Type", event.Type)
+	request.Header.Set("Ce-Subject", event.Subject)
+	request.Header.Set("Ce-Time", event.Time.Format(time.RFC3339Nano))
+	resp, err := p.client.Do(request)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to send event %s to %s", event.Type, p.url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return util.NewInternalServerError(
+			fmt.Errorf("unexpected status code %d", resp.StatusCode), "Failed to send event %s to %s", event.Type, p.url)
+	}
+	return nil
+}
+
+func (p *CloudEventsPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
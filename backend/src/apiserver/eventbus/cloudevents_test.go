@@ -0,0 +1,57 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudEventsPublisher(t *testing.T) {
+	var headers http.Header
+	var data EventData
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&data))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	assert.Nil(t, NewCloudEventsPublisher(server.URL, time.Second).Publish(event))
+	assert.Equal(t, "application/json", headers.Get("Content-Type"))
+	assert.Equal(t, "1.0", headers.Get("Ce-Specversion"))
+	assert.Equal(t, event.ID, headers.Get("Ce-Id"))
+	assert.Equal(t, "ml-pipeline", headers.Get("Ce-Source"))
+	assert.Equal(t, "org.kubeflow.pipelines.run.created", headers.Get("Ce-Type"))
+	assert.Equal(t, "run1", headers.Get("Ce-Subject"))
+	assert.Equal(t, "1970-01-01T00:00:01Z", headers.Get("Ce-Time"))
+	assert.Equal(t, event.Data, data)
+}
+
+func TestCloudEventsPublisher_SinkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewCloudEventsPublisher(server.URL, time.Second).Publish(event)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unexpected status code 503")
+}
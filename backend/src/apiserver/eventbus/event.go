@@ -0,0 +1,135 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"strings"
+	"time"
+)
+
+// The resources whose lifecycle events are published. Each of them has its
+// own Kafka topic and NATS subjects.
+const (
+	ResourceTypeRun             = "run"
+	ResourceTypeJob             = "job"
+	ResourceTypePipeline        = "pipeline"
+	ResourceTypePipelineVersion = "pipeline_version"
+	ResourceTypeExperiment      = "experiment"
+)
+
+// EventTypePrefix is the prefix of the types of the events, followed by the
+// resource type and the action, e.g. org.kubeflow.pipelines.run.created.
+const EventTypePrefix = "org.kubeflow.pipelines."
+
+// The types of the published events.
+const (
+	EventTypeRunCreated             = EventTypePrefix + ResourceTypeRun + ".created"
+	EventTypeRunPhaseChanged        = EventTypePrefix + ResourceTypeRun + ".phase_changed"
+	EventTypeRunFinished            = EventTypePrefix + ResourceTypeRun + ".finished"
+	EventTypeRunTerminated          = EventTypePrefix + ResourceTypeRun + ".terminated"
+	EventTypeRunRetried             = EventTypePrefix + ResourceTypeRun + ".retried"
+	EventTypeRunArchived            = EventTypePrefix + ResourceTypeRun + ".archived"
+	EventTypeRunUnarchived          = EventTypePrefix + ResourceTypeRun + ".unarchived"
+	EventTypeRunDeleted             = EventTypePrefix + ResourceTypeRun + ".deleted"
+	EventTypeJobCreated             = EventTypePrefix + ResourceTypeJob + ".created"
+	EventTypeJobEnabled             = EventTypePrefix + ResourceTypeJob + ".enabled"
+	EventTypeJobDisabled            = EventTypePrefix + ResourceTypeJob + ".disabled"
+	EventTypeJobTriggered           = EventTypePrefix + ResourceTypeJob + ".triggered"
+	EventTypeJobDeleted             = EventTypePrefix + ResourceTypeJob + ".deleted"
+	EventTypePipelineCreated        = EventTypePrefix + ResourceTypePipeline + ".created"
+	EventTypePipelineDeleted        = EventTypePrefix + ResourceTypePipeline + ".deleted"
+	EventTypePipelineVersionCreated = EventTypePrefix + ResourceTypePipelineVersion + ".created"
+	EventTypePipelineVersionDeleted = EventTypePrefix + ResourceTypePipelineVersion + ".deleted"
+	EventTypeExperimentDeleted      = EventTypePrefix + ResourceTypeExperiment + ".deleted"
+)
+
+// EventTypes are the types of all the published events.
+var EventTypes = []string{
+	EventTypeRunCreated, EventTypeRunPhaseChanged, EventTypeRunFinished, EventTypeRunTerminated, EventTypeRunRetried,
+	EventTypeRunArchived, EventTypeRunUnarchived, EventTypeRunDeleted,
+	EventTypeJobCreated, EventTypeJobEnabled, EventTypeJobDisabled, EventTypeJobTriggered, EventTypeJobDeleted,
+	EventTypePipelineCreated, EventTypePipelineDeleted, EventTypePipelineVersionCreated, EventTypePipelineVersionDeleted,
+	EventTypeExperimentDeleted,
+}
+
+const (
+	eventSpecVersion = "1.0"
+	eventSource      = "ml-pipeline"
+)
+
+// Event is a lifecycle event, serialized as a CloudEvent in the structured JSON
+// format, so that the consumers can use any CloudEvents SDK.
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            EventData `json:"data"`
+}
+
+// EventData describes the resource of an event. Only the fields which apply to
+// the resource are set.
+type EventData struct {
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	Name         string `json:"name,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	// The experiment of a run or a job.
+	ExperimentID string `json:"experiment_id,omitempty"`
+	// The job which created a run.
+	JobID string `json:"job_id,omitempty"`
+	// The run a job triggered.
+	RunID string `json:"run_id,omitempty"`
+	// The pipeline of a pipeline version.
+	PipelineID string `json:"pipeline_id,omitempty"`
+	// The state of a run, e.g. Running, or the final state of a finished run,
+	// e.g. Succeeded, and the state it changed from.
+	State         string `json:"state,omitempty"`
+	PreviousState string `json:"previous_state,omitempty"`
+}
+
+// NewEvent returns an event of the type about the resource of the data. The
+// subject of the event is the ID of the resource.
+func NewEvent(id string, eventType string, eventTime time.Time, data EventData) *Event {
+	return &Event{
+		SpecVersion:     eventSpecVersion,
+		ID:              id,
+		Source:          eventSource,
+		Type:            eventType,
+		Subject:         data.ResourceID,
+		Time:            eventTime.UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// Topic returns the Kafka topic of the event, e.g. kfp.run.
+func (e *Event) Topic(prefix string) string {
+	return prefix + "." + e.Data.ResourceType
+}
+
+// ShortType returns the type of the event without the prefix, e.g. run.created.
+func (e *Event) ShortType() string {
+	return strings.TrimPrefix(e.Type, EventTypePrefix)
+}
+
+// NATSSubject returns the NATS subject of the event, e.g. kfp.run.created, so that
+// the consumers can subscribe to all the events of a resource with kfp.run.>.
+func (e *Event) NATSSubject(prefix string) string {
+	return prefix + "." + e.ShortType()
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"strings"
+)
+
+// EventFilter selects the events to publish by their types and namespaces.
+type EventFilter struct {
+	types      []string
+	namespaces map[string]bool
+}
+
+// NewEventFilter returns a filter of the events of the types, without the
+// prefix, e.g. run.finished, or ending with a * wildcard, e.g. run.*, and of
+// the namespaces. An empty list doesn't filter. When namespaces are set, the
+// events of the resources without a namespace, e.g. shared pipelines, are
+// filtered out.
+func NewEventFilter(types []string, namespaces []string) *EventFilter {
+	filter := &EventFilter{types: types}
+	if len(namespaces) > 0 {
+		filter.namespaces = make(map[string]bool, len(namespaces))
+		for _, namespace := range namespaces {
+			filter.namespaces[namespace] = true
+		}
+	}
+	return filter
+}
+
+func (f *EventFilter) Matches(event *Event) bool {
+	if f.namespaces != nil && !f.namespaces[event.Data.Namespace] {
+		return false
+	}
+	if len(f.types) == 0 {
+		return true
+	}
+	shortType := event.ShortType()
+	for _, eventType := range f.types {
+		if eventType == shortType || eventType == "*" ||
+			(strings.HasSuffix(eventType, "*") && strings.HasPrefix(shortType, strings.TrimSuffix(eventType, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// filteredPublisher only publishes the events matching its filter.
+type filteredPublisher struct {
+	PublisherInterface
+	filter *EventFilter
+}
+
+func (p *filteredPublisher) Publish(event *Event) error {
+	if !p.filter.Matches(event) {
+		return nil
+	}
+	return p.PublisherInterface.Publish(event)
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEvent(eventType string, namespace string) *Event {
+	return NewEvent("id", eventType, time.Unix(1, 0), EventData{ResourceID: "id", Namespace: namespace})
+}
+
+func TestEventFilter_Types(t *testing.T) {
+	filter := NewEventFilter([]string{"run.*", "pipeline.created"}, nil)
+	assert.True(t, filter.Matches(newTestEvent(EventTypeRunCreated, "ns1")))
+	assert.True(t, filter.Matches(newTestEvent(EventTypeRunPhaseChanged, "ns1")))
+	assert.True(t, filter.Matches(newTestEvent(EventTypePipelineCreated, "")))
+	assert.False(t, filter.Matches(newTestEvent(EventTypePipelineDeleted, "")))
+	assert.False(t, filter.Matches(newTestEvent(EventTypeJobTriggered, "ns1")))
+}
+
+func TestEventFilter_Namespaces(t *testing.T) {
+	filter := NewEventFilter(nil, []string{"ns1"})
+	assert.True(t, filter.Matches(newTestEvent(EventTypeJobTriggered, "ns1")))
+	assert.False(t, filter.Matches(newTestEvent(EventTypeJobTriggered, "ns2")))
+	assert.False(t, filter.Matches(newTestEvent(EventTypePipelineCreated, "")))
+}
+
+func TestEventFilter_Empty(t *testing.T) {
+	filter := NewEventFilter(nil, nil)
+	assert.True(t, filter.Matches(newTestEvent(EventTypeJobTriggered, "ns2")))
+	assert.True(t, filter.Matches(newTestEvent(EventTypePipelineCreated, "")))
+}
+
+func TestNewPublisher_FiltersEvents(t *testing.T) {
+	publisher, err := NewPublisher(PublisherOptions{
+		Type:      PublisherTypeCloudEvents,
+		URL:       "http://127.0.0.1:1",
+		QueueSize: 1,
+		Filter:    NewEventFilter([]string{"run.finished"}, nil),
+	})
+	assert.Nil(t, err)
+	filtered, ok := publisher.(*filteredPublisher)
+	assert.True(t, ok)
+	filtered.PublisherInterface.Close()
+	// A filtered out event isn't queued, so it doesn't fail once the
+	// publisher is closed.
+	assert.Nil(t, publisher.Publish(newTestEvent(EventTypeRunCreated, "ns1")))
+	assert.NotNil(t, publisher.Publish(newTestEvent(EventTypeRunFinished, "ns1")))
+
+	publisher, err = NewPublisher(PublisherOptions{})
+	assert.Nil(t, err)
+	assert.Nil(t, publisher)
+}
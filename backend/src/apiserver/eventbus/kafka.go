@@ -0,0 +1,107 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	kafkaRecordsContentType = "application/vnd.kafka.json.v2+json"
+	kafkaResponseAccept     = "application/vnd.kafka.v2+json"
+)
+
+// KafkaPublisher produces the events to Kafka through a Kafka REST proxy, with
+// the v2 API of the Confluent REST proxy, so that no Kafka client needs to be
+// embedded in the API server. The events of a resource are keyed by its ID, so
+// that they land on the same partition, in order.
+type KafkaPublisher struct {
+	url         string
+	topicPrefix string
+	client      *http.Client
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value *Event `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func NewKafkaPublisher(url string, topicPrefix string, timeout time.Duration) *KafkaPublisher {
+	return &KafkaPublisher{
+		url:         strings.TrimSuffix(url, "/"),
+		topicPrefix: topicPrefix,
+		client:      &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *KafkaPublisher) Publish(event *Event) error {
+	body, err := json.Marshal(kafkaProduceRequest{Records: []kafkaRecord{{Key: event.Subject, Value: event}}})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal event %s", event.Type)
+	}
+	topic := event.Topic(p.topicPrefix)
+	request, err := http.NewRequest(http.MethodPost, p.url+"/topics/"+url.PathEscape(topic), bytes.NewReader(body))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create the request of event %s", event.Type)
+	}
+	request.Header.Set("Content-Type", kafkaRecordsContentType)
+	request.Header.Set("Accept", kafkaResponseAccept)
+	resp, err := p.client.Do(request)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to produce event %s to topic %s", event.Type, topic)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return util.NewInternalServerError(
+			fmt.Errorf("unexpected status code %d", resp.StatusCode), "Failed to produce event %s to topic %s", event.Type, topic)
+	}
+	var response kafkaProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return util.NewInternalServerError(err, "Failed to parse the response of the Kafka REST proxy")
+	}
+	for _, offset := range response.Offsets {
+		if offset.ErrorCode != nil {
+			return util.NewInternalServerError(
+				fmt.Errorf("error code %d: %s", *offset.ErrorCode, offset.Error), "Failed to produce event %s to topic %s", event.Type, topic)
+		}
+	}
+	return nil
+}
+
+func (p *KafkaPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var event = NewEvent("123e4567-e89b-12d3-a456-426655440000", EventTypeRunCreated, time.Unix(1, 0), EventData{
+	ResourceType: ResourceTypeRun,
+	ResourceID:   "run1",
+	Name:         "my run",
+	Namespace:    "ns1",
+	ExperimentID: "exp1",
+})
+
+func TestKafkaPublisher(t *testing.T) {
+	var path, contentType string
+	var request struct {
+		Records []struct {
+			Key   string `json:"key"`
+			Value Event  `json:"value"`
+		} `json:"records"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		contentType = r.Header.Get("Content-Type")
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&request))
+		w.Write([]byte(`{"offsets":[{"partition":0,"offset":42}]}`))
+	}))
+	defer server.Close()
+
+	publisher := NewKafkaPublisher(server.URL+"/", "kfp", time.Second)
+	assert.Nil(t, publisher.Publish(event))
+	assert.Equal(t, "/topics/kfp.run", path)
+	assert.Equal(t, kafkaRecordsContentType, contentType)
+	assert.Len(t, request.Records, 1)
+	assert.Equal(t, "run1", request.Records[0].Key)
+	assert.Equal(t, *event, request.Records[0].Value)
+}
+
+func TestKafkaPublisher_RecordError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"offsets":[{"partition":null,"offset":null,"error_code":40403,"error":"Topic not found."}]}`))
+	}))
+	defer server.Close()
+
+	err := NewKafkaPublisher(server.URL, "kfp", time.Second).Publish(event)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Topic not found.")
+}
+
+func TestKafkaPublisher_ProxyError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := NewKafkaPublisher(server.URL, "kfp", time.Second).Publish(event)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unexpected status code 500")
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+// Supported values of the EVENT_PUBLISHER config.
+const (
+	PublisherTypeKafka       = "kafka"
+	PublisherTypeNATS        = "nats"
+	PublisherTypeCloudEvents = "cloudevents"
+)
+
+var publishedEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "eventbus_published_events",
+	Help: "The number of lifecycle events published to the message bus, by result",
+}, []string{"result"})
+
+type PublisherInterface interface {
+	// Publish publishes the event. An event may be published more than once,
+	// e.g. when the acknowledgement of the message bus is lost.
+	Publish(event *Event) error
+	// Close releases the connections of the publisher.
+	Close() error
+}
+
+// PublisherOptions configures the publisher returned by NewPublisher.
+type PublisherOptions struct {
+	// Type is one of the PublisherType constants. No publisher is created
+	// when it's empty.
+	Type string
+	// URL is the URL of the Kafka REST proxy, of the NATS server or of the
+	// CloudEvents sink.
+	URL string
+	// TopicPrefix is the prefix of the Kafka topics and of the NATS subjects.
+	TopicPrefix string
+	Timeout     time.Duration
+	QueueSize   int
+	// Filter selects the events to publish, all of them when nil.
+	Filter *EventFilter
+}
+
+// NewPublisher returns the asynchronous publisher of the options, or nil if
+// their type is empty.
+func NewPublisher(options PublisherOptions) (PublisherInterface, error) {
+	var publisher PublisherInterface
+	switch options.Type {
+	case "":
+		return nil, nil
+	case PublisherTypeKafka:
+		publisher = NewKafkaPublisher(options.URL, options.TopicPrefix, options.Timeout)
+	case PublisherTypeNATS:
+		natsPublisher, err := NewNATSPublisher(options.URL, options.TopicPrefix, options.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		publisher = natsPublisher
+	case PublisherTypeCloudEvents:
+		publisher = NewCloudEventsPublisher(options.URL, options.Timeout)
+	default:
+		return nil, util.NewInvalidInputError("Event publisher %v is not supported", options.Type)
+	}
+	publisher = NewAsyncPublisher(publisher, options.QueueSize)
+	if options.Filter != nil {
+		publisher = &filteredPublisher{PublisherInterface: publisher, filter: options.Filter}
+	}
+	return publisher, nil
+}
+
+// AsyncPublisher publishes the events from a queue, so that a slow or
+// unavailable message bus doesn't delay the API requests. The events are
+// dropped once the queue is full.
+type AsyncPublisher struct {
+	publisher PublisherInterface
+	queue     chan *Event
+	mutex     sync.RWMutex
+	closed    bool
+	done      chan struct{}
+}
+
+func NewAsyncPublisher(publisher PublisherInterface, queueSize int) *AsyncPublisher {
+	p := &AsyncPublisher{
+		publisher: publisher,
+		queue:     make(chan *Event, queueSize),
+		done:      make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+func (p *AsyncPublisher) run() {
+	defer close(p.done)
+	for event := range p.queue {
+		if err := p.publisher.Publish(event); err != nil {
+			publishedEvents.WithLabelValues("failed").Inc()
+			log.Warnf("Failed to publish event %s of %s %s: %v", event.Type, event.Data.ResourceType, event.Subject, err)
+			continue
+		}
+		publishedEvents.WithLabelValues("published").Inc()
+	}
+}
+
+// Publish queues the event, and fails if the queue is full.
+func (p *AsyncPublisher) Publish(event *Event) error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	if p.closed {
+		return util.NewUnavailableError(errors.New("publisher is closed"), "Failed to publish event %s", event.Type)
+	}
+	select {
+	case p.queue <- event:
+		return nil
+	default:
+		publishedEvents.WithLabelValues("dropped").Inc()
+		return util.NewResourceExhaustedError(errors.New("event queue is full"), "Failed to publish event %s", event.Type)
+	}
+}
+
+// Close publishes the queued events, then closes the underlying publisher.
+func (p *AsyncPublisher) Close() error {
+	p.mutex.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mutex.Unlock()
+	<-p.done
+	return p.publisher.Close()
+}
+
+// FakePublisher records the published events.
+type FakePublisher struct {
+	mutex  sync.Mutex
+	events []*Event
+}
+
+func NewFakePublisher() *FakePublisher {
+	return &FakePublisher{}
+}
+
+func (p *FakePublisher) Publish(event *Event) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakePublisher) Close() error {
+	return nil
+}
+
+// Events returns the published events, in order.
+func (p *FakePublisher) Events() []*Event {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return append([]*Event(nil), p.events...)
+}
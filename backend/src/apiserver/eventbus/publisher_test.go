@@ -0,0 +1,60 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingPublisher publishes the events once released.
+type blockingPublisher struct {
+	FakePublisher
+	release chan struct{}
+}
+
+func (p *blockingPublisher) Publish(event *Event) error {
+	<-p.release
+	return p.FakePublisher.Publish(event)
+}
+
+func TestAsyncPublisher_PublishesQueuedEventsOnClose(t *testing.T) {
+	fake := NewFakePublisher()
+	publisher := NewAsyncPublisher(fake, 10)
+	assert.Nil(t, publisher.Publish(event))
+	assert.Nil(t, publisher.Publish(event))
+	assert.Nil(t, publisher.Close())
+
+	assert.Len(t, fake.Events(), 2)
+	assert.NotNil(t, publisher.Publish(event))
+}
+
+func TestAsyncPublisher_DropsEventsWhenQueueIsFull(t *testing.T) {
+	blocking := &blockingPublisher{release: make(chan struct{})}
+	publisher := NewAsyncPublisher(blocking, 1)
+
+	// The first event is being published, the second is queued.
+	assert.Nil(t, publisher.Publish(event))
+	var err error
+	for err == nil {
+		err = publisher.Publish(event)
+	}
+	assert.Contains(t, err.Error(), "event queue is full")
+
+	close(blocking.release)
+	assert.Nil(t, publisher.Close())
+	assert.True(t, len(blocking.Events()) >= 1)
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func serve(handler http.Handler, method string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/apis/v1/runs", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestCORSHandler(t *testing.T) {
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := CORSHandler([]string{"https://ui.example.com/"}, api)
+
+	response := serve(handler, http.MethodGet, map[string]string{"Origin": "https://ui.example.com"})
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "https://ui.example.com", response.Header().Get("Access-Control-Allow-Origin"))
+
+	response = serve(handler, http.MethodGet, map[string]string{"Origin": "https://evil.example.com"})
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Empty(t, response.Header().Get("Access-Control-Allow-Origin"))
+
+	response = serve(handler, http.MethodOptions, map[string]string{
+		"Origin":                         "https://ui.example.com",
+		"Access-Control-Request-Method":  http.MethodPost,
+		"Access-Control-Request-Headers": "authorization, content-type",
+	})
+	assert.Equal(t, http.StatusNoContent, response.Code)
+	assert.Equal(t, corsAllowedMethods, response.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "authorization, content-type", response.Header().Get("Access-Control-Allow-Headers"))
+}
+
+func TestCORSHandler_AnyOrigin(t *testing.T) {
+	handler := CORSHandler([]string{"*"}, http.NotFoundHandler())
+
+	response := serve(handler, http.MethodGet, map[string]string{"Origin": "https://any.example.com"})
+	assert.Equal(t, "https://any.example.com", response.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORSHandler_Disabled(t *testing.T) {
+	api := http.NotFoundHandler()
+	handler := CORSHandler(nil, api)
+
+	response := serve(handler, http.MethodGet, map[string]string{"Origin": "https://ui.example.com"})
+	assert.Empty(t, response.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestDialAddress(t *testing.T) {
+	for listen, want := range map[string]string{
+		":8887":          "localhost:8887",
+		"0.0.0.0:8887":   "localhost:8887",
+		"[::]:8887":      "localhost:8887",
+		"10.0.0.1:8887":  "10.0.0.1:8887",
+		"localhost:8887": "localhost:8887",
+	} {
+		got, err := DialAddress(listen)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got, listen)
+	}
+
+	_, err := DialAddress("8887")
+	assert.NotNil(t, err)
+}
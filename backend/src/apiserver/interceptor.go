@@ -15,25 +15,523 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/ratelimit"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/server"
+	"github.com/kubeflow/pipelines/backend/src/common/logging"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
+// Metric variables. Please prefix the metric names with apiserver_.
+var (
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "apiserver_grpc_request_duration_seconds",
+		Help:    "The duration of the gRPC calls, by method and status code",
+		Buckets: prometheus.ExponentialBuckets(0.005, 3, 10),
+	}, []string{"method", "code"})
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "apiserver_http_request_duration_seconds",
+		Help:    "The duration of the HTTP requests, by route, method and status code",
+		Buckets: prometheus.ExponentialBuckets(0.005, 3, 10),
+	}, []string{"route", "method", "code"})
+)
+
+// API methods starting with one of these verbs change state and are audited. The
+// calls of the persistence agent, e.g. ReportWorkflow, only mirror the state of the
+// cluster and aren't.
+var mutatingMethodPrefixes = []string{
+	"Archive", "Create", "Delete", "Disable", "Enable", "Move", "Push", "Retry", "Set", "Terminate", "Unarchive", "Undelete", "Update",
+}
+
 // apiServerInterceptor implements UnaryServerInterceptor that provides the common wrapping logic
 // to be executed before and after all API handler calls, e.g. Logging, error handling.
 // For more details, see https://github.com/grpc/grpc-go/blob/master/interceptor.go
 func apiServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-	glog.Infof("%v handler starting", info.FullMethod)
+	start := time.Now()
+	ctx = logging.WithFields(ctx, log.Fields{logging.FieldMethod: info.FullMethod})
 	resp, err = handler(ctx, req)
 	if err != nil {
 		util.LogError(util.Wrapf(err, "%s call failed", info.FullMethod))
 		// Convert error to gRPC errors
 		err = util.ToGRPCError(err)
-		return
 	}
-	glog.Infof("%v handler finished", info.FullMethod)
+	logRequest(ctx, info.FullMethod, req, resp, err, start)
 	return
 }
+
+// logRequest logs a gRPC call with its request ID, the caller, the namespace and
+// the resource it operates on, its status code and its latency, so the logs of
+// the calls can be searched and correlated. The health probes are only logged at
+// the debug level.
+func logRequest(ctx context.Context, fullMethod string, req interface{}, resp interface{}, err error, start time.Time) {
+	entry := logging.FromContext(ctx).WithFields(log.Fields{
+		logging.FieldCode:    status.Code(err).String(),
+		logging.FieldLatency: time.Since(start).Milliseconds(),
+	})
+	for field, value := range map[string]string{
+		logging.FieldUser:      requestUser(ctx),
+		logging.FieldNamespace: auditNamespace(req, resp),
+		logging.FieldResource:  auditResourceId(req, resp),
+	} {
+		if value != "" {
+			entry = entry.WithField(field, value)
+		}
+	}
+	switch {
+	case err != nil:
+		entry.Warnf("%s call failed: %v", fullMethod, err)
+	case isHealthCheck(fullMethod):
+		entry.Debugf("%s call finished", fullMethod)
+	default:
+		entry.Infof("%s call finished", fullMethod)
+	}
+}
+
+// requestUser returns the user of a call from its user identity header, without
+// authenticating it, for the logs. It's only set in multi-user mode.
+func requestUser(ctx context.Context) string {
+	if !common.IsMultiUserMode() {
+		return ""
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(common.GetKubeflowUserIDHeader()); len(values) > 0 {
+		return strings.TrimPrefix(values[0], common.GetKubeflowUserIDPrefix())
+	}
+	return ""
+}
+
+// metricsInterceptor measures the duration of the gRPC calls, labeled with the
+// code of the gRPC status they return, so their latency and error rate can be
+// monitored per method.
+func metricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	grpcRequestDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+// auditInterceptor returns a UnaryServerInterceptor recording an audit event for every
+// mutating API call. Failing to record the event is logged but doesn't fail the call.
+func auditInterceptor(resourceManager *resource.ResourceManager) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		before := auditResourceState(resourceManager, info.FullMethod, auditResourceId(req, nil))
+		resp, err := handler(ctx, req)
+		resourceId := auditResourceId(req, resp)
+		event := &model.AuditEvent{
+			Actor:          auditActor(ctx, resourceManager),
+			Namespace:      auditNamespace(req, resp),
+			Method:         info.FullMethod,
+			ResourceId:     resourceId,
+			ResourceBefore: before,
+			ResourceAfter:  auditResourceState(resourceManager, info.FullMethod, resourceId),
+			Result:         model.AuditResultSucceeded,
+		}
+		if err != nil {
+			event.Result = model.AuditResultFailed
+			event.Error = err.Error()
+		}
+		if auditErr := resourceManager.RecordAuditEvent(event); auditErr != nil {
+			util.LogError(util.Wrapf(auditErr, "Failed to record the audit event of %s", info.FullMethod))
+		}
+		return resp, err
+	}
+}
+
+// authenticationInterceptor returns a UnaryServerInterceptor authenticating the calls
+// once in multi-user mode. The result is passed in the context to the rate limiter,
+// the audit and the servers, which authorize the calls with it.
+func authenticationInterceptor(resourceManager *resource.ResourceManager) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if common.IsMultiUserMode() && !isHealthCheck(info.FullMethod) {
+			userIdentity, err := resourceManager.AuthenticateRequest(ctx)
+			ctx = auth.WithAuthentication(ctx, &auth.Authentication{UserIdentity: userIdentity, Err: err})
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitInterceptor returns a UnaryServerInterceptor rejecting the calls over the per
+// user or per namespace limits with RESOURCE_EXHAUSTED, which the HTTP gateway returns
+// as 429 Too Many Requests.
+func rateLimitInterceptor(limiter *ratelimit.RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// Health probes aren't limited, so busy clients don't get the server marked unhealthy.
+		if isHealthCheck(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		client := ""
+		if authentication := auth.AuthenticationFromContext(ctx); authentication != nil {
+			client = authentication.UserIdentity
+		}
+		if client == "" {
+			client = grpcClientAddress(ctx, limiter)
+		}
+		if allowed, scope := limiter.Allow(client, requestNamespace(req)); !allowed {
+			return nil, util.NewResourceExhaustedError(
+				errors.New("Rate limit exceeded"), "Too many requests for this %s, please retry later", scope).
+				WithReason(util.ReasonRateLimited)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// grpcClientAddress identifies the clients without a user identity. Calls through the
+// HTTP gateway come from the loopback address, with the address of their HTTP client
+// forwarded by the gateway.
+func grpcClientAddress(ctx context.Context, limiter *ratelimit.RateLimiter) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return limiter.ClientAddress(p.Addr.String(), md.Get("x-forwarded-for"))
+}
+
+func isHealthCheck(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// Routes served to unauthenticated clients in multi-user mode.
+var publicRoutes = map[string]bool{
+	"/apis/v1/healthz": true,
+	"/apis/v1/readyz":  true,
+	"/metrics":         true,
+}
+
+// authenticationHandler authenticates the requests to the endpoints only served
+// over HTTP, once, with the authenticators of the gRPC servers. The handlers
+// receive the headers as incoming gRPC metadata and the result of the
+// authentication in the request context, so they authorize the requests like the
+// gRPC servers. In multi-user mode, the requests failing to authenticate are
+// rejected, except for the public routes. The calls through the HTTP gateway are
+// authenticated by the gRPC server.
+func authenticationHandler(resourceManager *resource.ResourceManager) mux.MiddlewareFunc {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := routeTemplate(r)
+			if route == gatewayPathPrefix {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			ctx := auth.NewIncomingContext(r)
+			if common.IsMultiUserMode() && !publicRoutes[route] {
+				userIdentity, err := resourceManager.AuthenticateRequest(ctx)
+				if err != nil {
+					err = util.NewUnauthenticatedError(err, "Failed to authenticate the request")
+					logging.FromContext(ctx).Warnf("%s %s request failed: %v", r.Method, r.URL.Path, err)
+					w.WriteHeader(http.StatusUnauthorized)
+					errBytes, _ := json.Marshal(util.ToAPIError(err))
+					w.Write(errBytes)
+					return
+				}
+				ctx = auth.WithAuthentication(ctx, &auth.Authentication{UserIdentity: userIdentity})
+			}
+			handler.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// httpRequestUser returns the user identity an HTTP request was authenticated
+// with by authenticationHandler. It's only set in multi-user mode.
+func httpRequestUser(r *http.Request) string {
+	if authentication := auth.AuthenticationFromContext(r.Context()); authentication != nil {
+		return authentication.UserIdentity
+	}
+	return ""
+}
+
+// routeTemplate returns the path template of the route of an HTTP request, which
+// unlike its path is bounded.
+func routeTemplate(r *http.Request) string {
+	if current := mux.CurrentRoute(r); current != nil {
+		if template, err := current.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return "unknown"
+}
+
+// rateLimitHandler applies the rate limits to the endpoints only served over HTTP.
+func rateLimitHandler(limiter *ratelimit.RateLimiter, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		client := httpRequestUser(r)
+		if client == "" {
+			client = limiter.ClientAddress(r.RemoteAddr, r.Header["X-Forwarded-For"])
+		}
+		if allowed, scope := limiter.Allow(client, r.URL.Query().Get(server.NamespaceStringQuery)); !allowed {
+			err := util.NewResourceExhaustedError(
+				errors.New("Rate limit exceeded"), "Too many requests for this %s, please retry later", scope).
+				WithReason(util.ReasonRateLimited)
+			w.WriteHeader(http.StatusTooManyRequests)
+			errBytes, _ := json.Marshal(util.ToAPIError(err))
+			w.Write(errBytes)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// statusRecorder keeps the status code written by an HTTP handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// metricsHandler measures the duration of the HTTP requests, labeled with the
+// path template of their route rather than their path, so that the label values
+// are bounded. The calls through the HTTP gateway are measured per method by
+// metricsInterceptor too.
+func metricsHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(recorder, r)
+		httpRequestDuration.WithLabelValues(routeTemplate(r), r.Method, strconv.Itoa(recorder.status)).Observe(time.Since(start).Seconds())
+	})
+}
+
+// requestLogHandler logs the HTTP requests like logRequest logs the gRPC calls,
+// with the path template of their route. The calls through the HTTP gateway are
+// only logged by the gRPC server.
+func requestLogHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(recorder, r)
+		route := routeTemplate(r)
+		if route == gatewayPathPrefix {
+			return
+		}
+		entry := logging.FromContext(r.Context()).WithFields(log.Fields{
+			logging.FieldMethod:  r.Method + " " + route,
+			logging.FieldCode:    recorder.status,
+			logging.FieldLatency: time.Since(start).Milliseconds(),
+		})
+		if user := httpRequestUser(r); user != "" {
+			entry = entry.WithField(logging.FieldUser, user)
+		}
+		if namespace := r.URL.Query().Get(server.NamespaceStringQuery); namespace != "" {
+			entry = entry.WithField(logging.FieldNamespace, namespace)
+		}
+		switch {
+		case recorder.status >= http.StatusInternalServerError:
+			entry.Warnf("%s %s request failed", r.Method, r.URL.Path)
+		case route == "/metrics" || route == "/apis/v1/healthz" || route == "/apis/v1/readyz":
+			entry.Debugf("%s %s request finished", r.Method, r.URL.Path)
+		default:
+			entry.Infof("%s %s request finished", r.Method, r.URL.Path)
+		}
+	})
+}
+
+// auditHandler records an audit event for the mutating endpoints only served over
+// HTTP, e.g. the pipeline uploads. Their responses are the resources they create,
+// which are recorded as the state after the request. The namespace is the
+// namespace query parameter, or path variable.
+func auditHandler(resourceManager *resource.ResourceManager, method string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		recorder := &bodyRecorder{statusRecorder: statusRecorder{ResponseWriter: w, status: http.StatusOK}}
+		handler(recorder, r)
+		namespace := r.URL.Query().Get(server.NamespaceStringQuery)
+		if namespace == "" {
+			namespace = mux.Vars(r)[server.NamespaceStringQuery]
+		}
+		event := &model.AuditEvent{
+			Namespace:  namespace,
+			Method:     method,
+			ResourceId: r.URL.Query().Get(server.PipelineKey),
+			Result:     model.AuditResultSucceeded,
+		}
+		event.Actor = httpRequestUser(r)
+		if recorder.status >= http.StatusBadRequest {
+			event.Result = model.AuditResultFailed
+			event.Error = http.StatusText(recorder.status)
+		} else {
+			event.ResourceAfter = recorder.body.String()
+		}
+		if auditErr := resourceManager.RecordAuditEvent(event); auditErr != nil {
+			util.LogError(util.Wrapf(auditErr, "Failed to record the audit event of %s", method))
+		}
+	}
+}
+
+// bodyRecorder keeps the status code and the body written by an HTTP handler.
+type bodyRecorder struct {
+	statusRecorder
+	body bytes.Buffer
+}
+
+func (b *bodyRecorder) Write(data []byte) (int, error) {
+	b.body.Write(data)
+	return b.statusRecorder.Write(data)
+}
+
+func isMutatingMethod(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	for _, prefix := range mutatingMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// auditResourceState returns the state of the resource a call operates on as JSON,
+// without its manifests. It's empty when the resource doesn't exist, e.g. before
+// it's created, or isn't a run, a job, an experiment, a pipeline or a pipeline
+// version.
+func auditResourceState(resourceManager *resource.ResourceManager, fullMethod string, resourceId string) string {
+	if resourceId == "" {
+		return ""
+	}
+	var state interface{}
+	switch service := strings.TrimPrefix(path.Dir(fullMethod), "/"); {
+	case service == "v1.RunService" || service == "v2beta1.RunService":
+		if runDetail, err := resourceManager.GetRun(resourceId); err == nil {
+			run := runDetail.Run
+			run.PipelineSpecManifest, run.WorkflowSpecManifest = "", ""
+			state = run
+		}
+	case service == "v1.JobService" || service == "v2beta1.RecurringRunService":
+		if job, err := resourceManager.GetJob(resourceId); err == nil {
+			job.PipelineSpecManifest, job.WorkflowSpecManifest = "", ""
+			state = job
+		}
+	case service == "v1.ExperimentService":
+		if experiment, err := resourceManager.GetExperiment(resourceId); err == nil {
+			state = experiment
+		}
+	case (service == "v1.PipelineService" || service == "v2beta1.PipelineService") &&
+		strings.Contains(path.Base(fullMethod), "PipelineVersion"):
+		if version, err := resourceManager.GetPipelineVersion(resourceId); err == nil {
+			state = version
+		}
+	case service == "v1.PipelineService" || service == "v2beta1.PipelineService":
+		if pipeline, err := resourceManager.GetPipeline(resourceId); err == nil {
+			state = pipeline
+		}
+	}
+	return audit.State(state)
+}
+
+// auditActor returns the user identity of the caller. It's only known in multi-user mode.
+func auditActor(ctx context.Context, resourceManager *resource.ResourceManager) string {
+	if !common.IsMultiUserMode() {
+		return ""
+	}
+	userIdentity, err := resourceManager.AuthenticateRequest(ctx)
+	if err != nil {
+		return ""
+	}
+	return userIdentity
+}
+
+func auditNamespace(req interface{}, resp interface{}) string {
+	if namespace := requestNamespace(req); namespace != "" {
+		return namespace
+	}
+	if runDetail, ok := resp.(*api.RunDetail); ok {
+		return common.GetNamespaceFromAPIResourceReferences(runDetail.GetRun().GetResourceReferences())
+	}
+	return ""
+}
+
+// requestNamespace returns the namespace of the resources created or listed by a
+// request, if any.
+func requestNamespace(req interface{}) string {
+	var references []*api.ResourceReference
+	switch r := req.(type) {
+	case *api.CreateRunRequest:
+		references = r.GetRun().GetResourceReferences()
+	case *api.CreateJobRequest:
+		references = r.GetJob().GetResourceReferences()
+	case *api.CreateExperimentRequest:
+		references = r.GetExperiment().GetResourceReferences()
+	case *api.CreatePipelineRequest:
+		references = r.GetPipeline().GetResourceReferences()
+	case *api.CreatePipelineVersionRequest:
+		references = r.GetVersion().GetResourceReferences()
+	case *api.CreateWebhookRequest:
+		return r.GetWebhook().GetNamespace()
+	case *apiv2beta1.CreateRecurringRunRequest:
+		return r.GetRecurringRun().GetNamespace()
+	case *apiv2beta1.CreatePipelineAndVersionRequest:
+		return r.GetPipeline().GetNamespace()
+	case interface{ GetResourceReferenceKey() *api.ResourceKey }:
+		if key := r.GetResourceReferenceKey(); key.GetType() == api.ResourceType_NAMESPACE {
+			return key.GetId()
+		}
+	case interface{ GetNamespace() string }:
+		return r.GetNamespace()
+	}
+	return common.GetNamespaceFromAPIResourceReferences(references)
+}
+
+// auditResourceId returns the id of the resource the call operates on, which is
+// part of the request for existing resources and of the response for created ones.
+func auditResourceId(req interface{}, resp interface{}) string {
+	switch r := req.(type) {
+	case *apiv2beta1.CreatePipelineVersionRequest:
+		// The id in the request is the pipeline's, the version's is in the response.
+	case interface{ GetId() string }:
+		return r.GetId()
+	case interface{ GetRunId() string }:
+		return r.GetRunId()
+	case interface{ GetRecurringRunId() string }:
+		return r.GetRecurringRunId()
+	case interface{ GetPipelineVersionId() string }:
+		return r.GetPipelineVersionId()
+	case interface{ GetPipelineId() string }:
+		return r.GetPipelineId()
+	case interface{ GetVersionId() string }:
+		return r.GetVersionId()
+	}
+	switch r := resp.(type) {
+	case *api.RunDetail:
+		return r.GetRun().GetId()
+	case interface{ GetId() string }:
+		return r.GetId()
+	case interface{ GetRunId() string }:
+		return r.GetRunId()
+	case interface{ GetRecurringRunId() string }:
+		return r.GetRecurringRunId()
+	case interface{ GetPipelineVersionId() string }:
+		return r.GetPipelineVersionId()
+	case interface{ GetPipelineId() string }:
+		return r.GetPipelineId()
+	}
+	return ""
+}
@@ -37,23 +37,27 @@ import (
 // represent a query for an initial set of results, in which page
 // SortByFieldValue and KeyFieldValue are nil. If the latter fields are not nil,
 // then token represents a query for a subsequent set of results (i.e., the next
-// page of results), with the two values pointing to the first record in the
-// next set of results.
+// page of results), with the two values forming a keyset cursor that points to
+// the last record of the previous set of results.
 type token struct {
 	// SortByFieldName is the field name to use when sorting.
 	SortByFieldName string
-	// SortByFieldValue is the value of the sorted field of the next row to be
+	// SortByFieldValue is the value of the sorted field of the last row
 	// returned.
 	SortByFieldValue  interface{}
 	SortByFieldPrefix string
 
 	// KeyFieldName is the name of the primary key for the model being queried.
 	KeyFieldName string
-	// KeyFieldValue is the value of the sorted field of the next row to be
-	// returned.
+	// KeyFieldValue is the value of the key field of the last row returned.
 	KeyFieldValue  interface{}
 	KeyFieldPrefix string
 
+	// Exclusive is true if the cursor points at the last row returned instead
+	// of the next row to be returned, which is how tokens were built before.
+	// Rows inserted between two pages are then not skipped.
+	Exclusive bool
+
 	// IsDesc is true if the sorting order should be descending.
 	IsDesc bool
 
@@ -188,8 +192,24 @@ func (o *Options) AddPaginationToSelect(sqlBuilder sq.SelectBuilder) sq.SelectBu
 // AddSortingToSelect adds Order By clause.
 func (o *Options) AddSortingToSelect(sqlBuilder sq.SelectBuilder) sq.SelectBuilder {
 	// When sorting by a direct field in the listable model (i.e., name in Run or uuid in Pipeline), a sortByFieldPrefix can be specified; when sorting by a field in an array-typed dictionary (i.e., a run metric inside the metrics in Run), a sortByFieldPrefix is not needed.
-	// If next row's value is specified, set those values in the clause.
-	if o.SortByFieldValue != nil && o.KeyFieldValue != nil {
+	// If the cursor is specified, set its values in the clause.
+	sortByField := o.SortByFieldPrefix + o.SortByFieldName
+	keyField := o.KeyFieldPrefix + o.KeyFieldName
+	if o.SortByFieldValue != nil && o.KeyFieldValue != nil && o.Exclusive {
+		// The leading range on the sorted field lets the database seek on an
+		// index instead of scanning every row before the cursor.
+		if o.IsDesc {
+			sqlBuilder = sqlBuilder.
+				Where(sq.And{sq.LtOrEq{sortByField: o.SortByFieldValue},
+					sq.Or{sq.Lt{sortByField: o.SortByFieldValue},
+						sq.Lt{keyField: o.KeyFieldValue}}})
+		} else {
+			sqlBuilder = sqlBuilder.
+				Where(sq.And{sq.GtOrEq{sortByField: o.SortByFieldValue},
+					sq.Or{sq.Gt{sortByField: o.SortByFieldValue},
+						sq.Gt{keyField: o.KeyFieldValue}}})
+		}
+	} else if o.SortByFieldValue != nil && o.KeyFieldValue != nil {
 		if o.IsDesc {
 			sqlBuilder = sqlBuilder.
 				Where(sq.Or{sq.Lt{o.SortByFieldPrefix + o.SortByFieldName: o.SortByFieldValue},
@@ -322,8 +342,8 @@ type Listable interface {
 }
 
 // NextPageToken returns a string that can be used to fetch the subsequent set
-// of results using the same listing options in o, starting with listable as the
-// first record.
+// of results using the same listing options in o, starting after listable,
+// which is the last record of the current set of results.
 func (o *Options) NextPageToken(listable Listable) (string, error) {
 	t, err := o.nextPageToken(listable)
 	if err != nil {
@@ -353,6 +373,7 @@ func (o *Options) nextPageToken(listable Listable) (*token, error) {
 		KeyFieldName:      listable.PrimaryKeyColumnName(),
 		KeyFieldValue:     keyField.Interface(),
 		KeyFieldPrefix:    listable.GetKeyFieldPrefix(),
+		Exclusive:         true,
 		IsDesc:            o.IsDesc,
 		Filter:            o.Filter,
 		ModelName:         o.ModelName,
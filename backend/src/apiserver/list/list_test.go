@@ -126,6 +126,7 @@ func TestNextPageToken_ValidTokens(t *testing.T) {
 				KeyFieldName:      "PrimaryKey",
 				KeyFieldValue:     "uuid123",
 				KeyFieldPrefix:    "",
+				Exclusive:         true,
 				IsDesc:            true,
 			},
 		},
@@ -140,6 +141,7 @@ func TestNextPageToken_ValidTokens(t *testing.T) {
 				KeyFieldName:      "PrimaryKey",
 				KeyFieldValue:     "uuid123",
 				KeyFieldPrefix:    "",
+				Exclusive:         true,
 				IsDesc:            true,
 			},
 		},
@@ -154,6 +156,7 @@ func TestNextPageToken_ValidTokens(t *testing.T) {
 				KeyFieldName:      "PrimaryKey",
 				KeyFieldValue:     "uuid123",
 				KeyFieldPrefix:    "",
+				Exclusive:         true,
 				IsDesc:            false,
 			},
 		},
@@ -172,6 +175,7 @@ func TestNextPageToken_ValidTokens(t *testing.T) {
 				KeyFieldName:      "PrimaryKey",
 				KeyFieldValue:     "uuid123",
 				KeyFieldPrefix:    "",
+				Exclusive:         true,
 				IsDesc:            false,
 				Filter:            testFilter,
 			},
@@ -190,6 +194,7 @@ func TestNextPageToken_ValidTokens(t *testing.T) {
 				KeyFieldName:      "PrimaryKey",
 				KeyFieldValue:     "uuid123",
 				KeyFieldPrefix:    "",
+				Exclusive:         true,
 				IsDesc:            false,
 			},
 		},
@@ -549,6 +554,41 @@ func TestAddPaginationAndFilterToSelect(t *testing.T) {
 			wantSQL:  "SELECT * FROM MyTable WHERE (SortField > ? OR (SortField = ? AND KeyField >= ?)) ORDER BY SortField ASC, KeyField ASC LIMIT 124",
 			wantArgs: []interface{}{"value", "value", 1111},
 		},
+		{
+			in: &Options{
+				PageSize: 123,
+				token: &token{
+					SortByFieldName:   "SortField",
+					SortByFieldValue:  "value",
+					SortByFieldPrefix: "",
+					KeyFieldName:      "KeyField",
+					KeyFieldValue:     1111,
+					KeyFieldPrefix:    "",
+					Exclusive:         true,
+					IsDesc:            true,
+				},
+			},
+			wantSQL:  "SELECT * FROM MyTable WHERE (SortField <= ? AND (SortField < ? OR KeyField < ?)) ORDER BY SortField DESC, KeyField DESC LIMIT 124",
+			wantArgs: []interface{}{"value", "value", 1111},
+		},
+		{
+			in: &Options{
+				PageSize: 123,
+				token: &token{
+					SortByFieldName:   "SortField",
+					SortByFieldValue:  "value",
+					SortByFieldPrefix: "",
+					KeyFieldName:      "KeyField",
+					KeyFieldValue:     1111,
+					KeyFieldPrefix:    "",
+					Exclusive:         true,
+					IsDesc:            false,
+					Filter:            f,
+				},
+			},
+			wantSQL:  "SELECT * FROM MyTable WHERE (SortField >= ? AND (SortField > ? OR KeyField > ?)) AND Name = ? ORDER BY SortField ASC, KeyField ASC LIMIT 124",
+			wantArgs: []interface{}{"value", "value", 1111, "SomeName"},
+		},
 		{
 			in: &Options{
 				PageSize: 123,
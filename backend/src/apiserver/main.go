@@ -16,6 +16,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -25,24 +26,41 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
-	"github.com/golang/glog"
+	// The logs are written with logrus, but the deployments may still pass the
+	// glog flags, e.g. -logtostderr, which must stay registered.
+	_ "github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/gateway"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/ratelimit"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/server"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/logging"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+// The gRPC services are served over HTTP by the gateway under this path.
+const gatewayPathPrefix = "/apis/"
+
 var (
 	rpcPortFlag      = flag.String("rpcPortFlag", ":8887", "RPC Port")
 	httpPortFlag     = flag.String("httpPortFlag", ":8888", "Http Proxy Port")
@@ -50,48 +68,381 @@ var (
 	sampleConfigPath = flag.String("sampleconfig", "", "Path to samples")
 
 	collectMetricsFlag = flag.Bool("collectMetricsFlag", true, "Whether to collect Prometheus metrics in API server.")
+
+	migrateTemplatesFlag = flag.Bool("migrateTemplates", false, "Whether to upgrade stored tekton.dev/v1beta1 pipeline templates to tekton.dev/v1 on startup.")
 )
 
 type RegisterHttpHandlerFromEndpoint func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
 func main() {
 	flag.Parse()
+	if err := logging.Init("ml-pipeline"); err != nil {
+		log.Fatalf("Failed to initialize logging. Err: %v", err)
+	}
 
 	initConfig()
+	shutdownTracing, err := tracing.Init(context.Background(), "ml-pipeline")
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing. Err: %v", err)
+	}
 	clientManager := newClientManager()
+	for _, feature := range common.ListFeatures() {
+		log.Infof("Feature %s enabled: %v", feature.Name, feature.Enabled)
+	}
+	err = util.CheckTektonCompatibility(context.Background(),
+		clientManager.KubernetesCoreClient().ConfigMapClient(common.GetTektonNamespace()), common.GetTektonCompatibilityCheck())
+	if err != nil {
+		log.Fatalf("Failed to check the Tekton installation. Err: %v", err)
+	}
 	resourceManager := resource.NewResourceManager(&clientManager)
-	err := loadSamples(resourceManager)
+	// Replicas starting together initialize the database one at a time, so the
+	// samples and the default experiment are only created once.
+	err = resourceManager.RunWithLease(common.StartupLeaseName, common.StartupLeaseTTL, func() error {
+		return initDatabase(resourceManager)
+	})
 	if err != nil {
-		glog.Fatalf("Failed to load samples. Err: %v", err)
+		log.Fatalf("Failed to initialize the database. Err: %v", err)
 	}
 
-	_, err = resourceManager.CreateDefaultExperiment()
+	rateLimiter, err := ratelimit.NewRateLimiter(
+		common.GetRateLimitUserQPS(),
+		common.GetRateLimitUserBurst(),
+		common.GetRateLimitNamespaceQPS(),
+		common.GetRateLimitNamespaceBurst(),
+		common.GetRateLimitTrustedProxies())
 	if err != nil {
-		glog.Fatalf("Failed to create default experiment. Err: %v", err)
+		log.Fatalf("Failed to initialize the rate limiter. Err: %v", err)
 	}
 
-	go startRpcServer(resourceManager)
-	startHttpProxy(resourceManager)
+	// the readiness probe checks the dependencies, the liveness probe only the process.
+	readinessServer := server.NewReadinessServer(resourceManager, common.GetReadinessCheckTimeout())
+	rpcServer, healthServer := startRpcServer(resourceManager, readinessServer, rateLimiter)
+	httpServer := startHttpProxy(resourceManager, readinessServer, rateLimiter)
+	if interval := common.GetArtifactGCInterval(); interval > 0 {
+		startArtifactGC(resourceManager, common.GetArtifactRetentionPolicy(), interval)
+	}
+	if interval := common.GetArtifactDedupInterval(); interval > 0 {
+		startArtifactDedup(resourceManager, interval)
+	}
+	startUploadGC(resourceManager)
+	if interval := common.GetOCIPushInterval(); interval > 0 && common.GetOCIRegistry() != "" {
+		startOCIPush(resourceManager, common.GetOCIPushArtifactTypes(), interval)
+	}
+	if age := common.GetRunArchiveAge(); age > 0 {
+		startRunArchive(resourceManager, age, common.GetRunArchiveInterval())
+	}
+	startRunSubmitter(resourceManager, common.RunSubmitterInterval)
+	if common.GetSoftDeletePurgeWindow() > 0 {
+		startSoftDeletePurge(resourceManager, common.SoftDeletePurgeInterval)
+	}
+	if threshold := common.GetNotificationPolicy().LongRunningAfter; threshold > 0 {
+		startLongRunningNotification(resourceManager, threshold, common.LongRunningNotificationInterval)
+	}
+	startWebhookDelivery(resourceManager, common.WebhookDeliveryInterval)
+	if timeout := common.GetStalledRunTimeout(); timeout > 0 {
+		startStalledRunCheck(resourceManager, timeout, common.IsStalledRunResubmit(), common.StalledRunCheckInterval)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
+	<-stop
+	shutdown(resourceManager, rpcServer, healthServer, httpServer)
 
 	clientManager.Close()
+	shutdownTracing(context.Background())
+}
+
+// shutdown stops admitting new requests and waits for the ones in flight to
+// finish, so the connections to the database can be closed afterwards.
+func shutdown(resourceManager *resource.ResourceManager, rpcServer *grpc.Server, healthServer *health.Server, httpServer *http.Server) {
+	log.Info("Shutting down, draining the requests in flight")
+	ctx, cancel := context.WithTimeout(context.Background(), common.GetShutdownTimeout())
+	defer cancel()
+
+	healthServer.Shutdown()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Errorf("Failed to drain the HTTP requests: %v", err)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		rpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Errorf("Timed out draining the RPCs, stopping the RPC server")
+		rpcServer.Stop()
+	}
+
+	// The run creations aren't canceled with their requests, wait for them even
+	// if the RPC server was stopped.
+	if err := resourceManager.Drain(ctx); err != nil {
+		log.Errorf("Failed to drain the run creations: %v", err)
+	}
+	log.Info("Shutdown complete")
 }
 
 // A custom http request header matcher to pass on the user identity
 // Reference: https://github.com/grpc-ecosystem/grpc-gateway/blob/master/docs/_docs/customizingyourgateway.md#mapping-from-http-request-headers-to-grpc-client-metadata
+// startArtifactGC periodically deletes the artifacts expired by the retention
+// policy. A single replica collects them at a time, the lease expires with the
+// interval so a replica that died doesn't block the next collection.
+func startArtifactGC(resourceManager *resource.ResourceManager, policy *common.ArtifactRetentionPolicy, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.ArtifactGCLeaseName, interval, func() error {
+				report, err := resourceManager.CollectExpiredArtifacts(policy, false)
+				if report != nil {
+					log.Infof("Deleted %d expired artifacts, %d bytes", len(report.Artifacts), report.TotalSize)
+				}
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to delete the expired artifacts: %v", err)
+			} else if !ran {
+				log.Infof("Artifacts are collected by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startArtifactDedup periodically stores the identical artifacts of the finished
+// runs once. Like the artifact GC, a single replica deduplicates them at a time.
+func startArtifactDedup(resourceManager *resource.ResourceManager, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.ArtifactDedupLeaseName, interval, func() error {
+				report, err := resourceManager.DeduplicateArtifacts()
+				if report != nil {
+					log.Infof("Deduplicated %d artifacts, %d of which were duplicates, saving %d bytes",
+						report.Artifacts, report.DuplicateArtifacts, report.SavedSize)
+				}
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to deduplicate the artifacts: %v", err)
+			} else if !ran {
+				log.Infof("Artifacts are deduplicated by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startUploadGC periodically deletes the uploads abandoned by their clients.
+func startUploadGC(resourceManager *resource.ResourceManager) {
+	go func() {
+		ticker := time.NewTicker(common.UploadGCInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.UploadGCLeaseName, common.UploadGCInterval, func() error {
+				deleted, err := resourceManager.CollectExpiredUploads()
+				log.Infof("Deleted %d expired uploads", deleted)
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to delete the expired uploads: %v", err)
+			} else if !ran {
+				log.Infof("Uploads are collected by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startOCIPush periodically pushes the artifacts of the types to the OCI
+// registry. Like the artifact GC, a single replica pushes them at a time.
+func startOCIPush(resourceManager *resource.ResourceManager, types []string, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.OCIPushLeaseName, interval, func() error {
+				pushed, err := resourceManager.PushArtifactsToRegistry(types)
+				log.Infof("Pushed %d artifacts to the OCI registry", pushed)
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to push the artifacts to the OCI registry: %v", err)
+			} else if !ran {
+				log.Infof("Artifacts are pushed by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startRunArchive periodically moves the runs older than the age to the run
+// archive. Like the artifact GC, a single replica moves them at a time.
+func startRunArchive(resourceManager *resource.ResourceManager, age time.Duration, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.RunArchiveLeaseName, interval, func() error {
+				moved, err := resourceManager.MoveOldRunsToArchive(age)
+				log.Infof("Moved %d runs to the run archive", moved)
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to move the old runs to the run archive: %v", err)
+			} else if !ran {
+				log.Infof("Runs are archived by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startRunSubmitter creates the PipelineRuns of the runs stored but not submitted
+// when runs are created and periodically, a single replica at a time.
+func startRunSubmitter(resourceManager *resource.ResourceManager, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-resourceManager.RunSubmissions():
+			}
+			ran, err := resourceManager.TryWithLease(common.RunSubmitterLeaseName, interval, func() error {
+				submitted, err := resourceManager.SubmitPendingRuns(context.Background())
+				if submitted > 0 {
+					log.Infof("Submitted %d pending runs", submitted)
+				}
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to submit the pending runs: %v", err)
+			} else if !ran {
+				log.Debugf("Pending runs are submitted by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startSoftDeletePurge periodically purges the resources soft deleted longer
+// than the purge window ago, a single replica at a time.
+func startSoftDeletePurge(resourceManager *resource.ResourceManager, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.SoftDeletePurgeLeaseName, interval, func() error {
+				purged, err := resourceManager.PurgeDeletedResources(context.Background())
+				log.Infof("Purged %d soft deleted resources", purged)
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to purge the soft deleted resources: %v", err)
+			} else if !ran {
+				log.Infof("Soft deleted resources are purged by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startLongRunningNotification periodically notifies the runs which ran longer
+// than the threshold, a single replica at a time.
+func startLongRunningNotification(resourceManager *resource.ResourceManager, threshold time.Duration, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.LongRunningNotificationLeaseName, interval, func() error {
+				notified, err := resourceManager.NotifyLongRunningRuns(threshold, interval)
+				if notified > 0 {
+					log.Infof("Notified %d long running runs", notified)
+				}
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to notify the long running runs: %v", err)
+			} else if !ran {
+				log.Infof("Long running runs are notified by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startStalledRunCheck periodically marks stalled, or resubmits, the runs whose
+// PipelineRuns didn't progress for longer than the timeout, a single replica at
+// a time.
+func startStalledRunCheck(resourceManager *resource.ResourceManager, timeout time.Duration, resubmit bool, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ran, err := resourceManager.TryWithLease(common.StalledRunCheckLeaseName, interval, func() error {
+				stalled, err := resourceManager.CheckStalledRuns(context.Background(), timeout, resubmit)
+				if stalled > 0 {
+					log.Infof("Found %d stalled runs", stalled)
+				}
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to check the stalled runs: %v", err)
+			} else if !ran {
+				log.Infof("Stalled runs are checked by another replica, skipping")
+			}
+		}
+	}()
+}
+
+// startWebhookDelivery periodically sends the pending webhook deliveries which
+// are due, a single replica at a time. The lease outlives the slowest batch, so
+// that no delivery is sent twice.
+func startWebhookDelivery(resourceManager *resource.ResourceManager, interval time.Duration) {
+	maxAttempts, retention := common.GetWebhookMaxAttempts(), common.GetWebhookDeliveryRetention()
+	ttl := interval + common.GetWebhookTimeout()*time.Duration(common.WebhookDeliveryBatchSize)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			_, err := resourceManager.TryWithLease(common.WebhookDeliveryLeaseName, ttl, func() error {
+				delivered, err := resourceManager.DeliverWebhooks(maxAttempts, retention)
+				if delivered > 0 {
+					log.Infof("Delivered %d webhook events", delivered)
+				}
+				return err
+			})
+			if err != nil {
+				log.Errorf("Failed to deliver the webhook events: %v", err)
+			}
+		}
+	}()
+}
+
 func grpcCustomMatcher(key string) (string, bool) {
 	if strings.EqualFold(key, common.GetKubeflowUserIDHeader()) {
 		return strings.ToLower(key), true
 	}
+	if strings.EqualFold(key, logging.RequestIDHeader) {
+		return logging.RequestIDMetadataKey, true
+	}
 	return strings.ToLower(key), false
 }
 
-func startRpcServer(resourceManager *resource.ResourceManager) {
-	glog.Info("Starting RPC server")
-	listener, err := net.Listen("tcp", *rpcPortFlag)
+func startRpcServer(resourceManager *resource.ResourceManager, readinessServer *server.ReadinessServer,
+	rateLimiter *ratelimit.RateLimiter) (*grpc.Server, *health.Server) {
+	log.Info("Starting RPC server")
+	listener, err := net.Listen("tcp", rpcListenAddress())
 	if err != nil {
-		glog.Fatalf("Failed to start RPC server: %v", err)
+		log.Fatalf("Failed to start RPC server: %v", err)
+	}
+	interceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor(), logging.UnaryServerInterceptor()}
+	if *collectMetricsFlag {
+		// Outside apiServerInterceptor, so that the errors are converted to gRPC statuses.
+		interceptors = append(interceptors, metricsInterceptor)
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
+	interceptors = append(interceptors, apiServerInterceptor, authenticationInterceptor(resourceManager))
+	if rateLimiter.Enabled() {
+		interceptors = append(interceptors, rateLimitInterceptor(rateLimiter))
+	}
+	interceptors = append(interceptors, auditInterceptor(resourceManager))
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...), grpc.MaxRecvMsgSize(math.MaxInt32))
 	api.RegisterPipelineServiceServer(s, server.NewPipelineServer(resourceManager, &server.PipelineServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterExperimentServiceServer(s, server.NewExperimentServer(resourceManager, &server.ExperimentServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterRunServiceServer(s, server.NewRunServer(resourceManager, &server.RunServerOptions{CollectMetrics: *collectMetricsFlag}))
@@ -106,21 +457,54 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 			common.GetStringConfig(visualizationServicePort),
 		))
 	api.RegisterAuthServiceServer(s, server.NewAuthServer(resourceManager))
+	api.RegisterAuditServiceServer(s, server.NewAuditServer(resourceManager))
+	api.RegisterArtifactServiceServer(s, server.NewArtifactServer(resourceManager, common.GetArtifactRetentionPolicy()))
+	api.RegisterLineageServiceServer(s, server.NewLineageServer(resourceManager))
+	api.RegisterUploadServiceServer(s, server.NewUploadServer(resourceManager))
+	api.RegisterWebhookServiceServer(s, server.NewWebhookServer(resourceManager))
+	api.RegisterServerInfoServiceServer(s, server.NewServerInfoServer())
+
+	// The v2beta1 API of the KFP v2 SDK.
+	apiv2beta1.RegisterPipelineServiceServer(s, server.NewPipelineServerV2beta1(resourceManager, &server.PipelineServerOptions{CollectMetrics: *collectMetricsFlag}))
+	apiv2beta1.RegisterRunServiceServer(s, server.NewRunServerV2beta1(resourceManager, &server.RunServerOptions{CollectMetrics: *collectMetricsFlag}))
+	apiv2beta1.RegisterRecurringRunServiceServer(s, server.NewRecurringRunServer(resourceManager, &server.JobServerOptions{CollectMetrics: *collectMetricsFlag}))
+
+	// Register the standard health service, so load balancers and meshes can probe
+	// the API services. They're served while the dependencies pass the readiness
+	// checks, until the server shuts down.
+	healthServer := health.NewServer()
+	var services []string
+	for serviceName := range s.GetServiceInfo() {
+		services = append(services, serviceName)
+	}
+	readinessServer.UpdateHealth(context.Background(), healthServer, services)
+	go func() {
+		ticker := time.NewTicker(common.HealthCheckInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			readinessServer.UpdateHealth(context.Background(), healthServer, services)
+		}
+	}()
+	healthpb.RegisterHealthServer(s, healthServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
-	if err := s.Serve(listener); err != nil {
-		glog.Fatalf("Failed to serve rpc listener: %v", err)
-	}
-	glog.Info("RPC server started")
+	go func() {
+		if err := s.Serve(listener); err != nil {
+			log.Fatalf("Failed to serve rpc listener: %v", err)
+		}
+	}()
+	log.Info("RPC server started")
+	return s, healthServer
 }
 
-func startHttpProxy(resourceManager *resource.ResourceManager) {
-	glog.Info("Starting Http Proxy")
+func startHttpProxy(resourceManager *resource.ResourceManager, readinessServer *server.ReadinessServer,
+	rateLimiter *ratelimit.RateLimiter) *http.Server {
+	log.Info("Starting Http Proxy")
 
+	// The connections to the RPC server stay open until the process exits, so the
+	// requests in flight can be drained.
 	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
 
 	// Create gRPC HTTP MUX and register services.
 	runtimeMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(grpcCustomMatcher))
@@ -132,42 +516,148 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	registerHttpHandlerFromEndpoint(api.RegisterReportServiceHandlerFromEndpoint, "ReportService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterVisualizationServiceHandlerFromEndpoint, "Visualization", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterAuthServiceHandlerFromEndpoint, "AuthService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterAuditServiceHandlerFromEndpoint, "AuditService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterArtifactServiceHandlerFromEndpoint, "ArtifactService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterLineageServiceHandlerFromEndpoint, "LineageService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterUploadServiceHandlerFromEndpoint, "UploadService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterWebhookServiceHandlerFromEndpoint, "WebhookService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterServerInfoServiceHandlerFromEndpoint, "ServerInfoService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterPipelineServiceHandlerFromEndpoint, "v2beta1 PipelineService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterRunServiceHandlerFromEndpoint, "v2beta1 RunService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(apiv2beta1.RegisterRecurringRunServiceHandlerFromEndpoint, "v2beta1 RecurringRunService", ctx, runtimeMux)
 
 	// Create a top level mux to include both pipeline upload server and gRPC servers.
 	topMux := mux.NewRouter()
 
+	// The gRPC services are rate limited by their interceptor, the HTTP only endpoints
+	// are wrapped.
+	rateLimited := func(handler http.HandlerFunc) http.HandlerFunc {
+		if !rateLimiter.Enabled() {
+			return handler
+		}
+		return rateLimitHandler(rateLimiter, handler)
+	}
+
 	// multipart upload is only supported in HTTP. In long term, we should have gRPC endpoints that
 	// accept pipeline url for importing.
 	// https://github.com/grpc-ecosystem/grpc-gateway/issues/410
 	pipelineUploadServer := server.NewPipelineUploadServer(resourceManager, &server.PipelineUploadServerOptions{CollectMetrics: *collectMetricsFlag})
-	topMux.HandleFunc("/apis/v1/pipelines/upload", pipelineUploadServer.UploadPipeline)
-	topMux.HandleFunc("/apis/v1/pipelines/upload_version", pipelineUploadServer.UploadPipelineVersion)
+	topMux.HandleFunc("/apis/v1/pipelines/upload", rateLimited(auditHandler(resourceManager, "UploadPipeline", pipelineUploadServer.UploadPipeline)))
+	topMux.HandleFunc("/apis/v1/pipelines/upload_version", rateLimited(auditHandler(resourceManager, "UploadPipelineVersion", pipelineUploadServer.UploadPipelineVersion)))
+	topMux.HandleFunc("/apis/v1/pipelines/compile", rateLimited(pipelineUploadServer.CompilePipeline))
 	topMux.HandleFunc("/apis/v1/healthz", func(w http.ResponseWriter, r *http.Request) {
 		io.WriteString(w, `{"commit_sha":"`+common.GetStringConfigWithDefault("COMMIT_SHA", "unknown")+`", "tag_name":"`+common.GetStringConfigWithDefault("TAG_NAME", "unknown")+`", "multi_user":`+strconv.FormatBool(common.IsMultiUserMode())+`}`)
 	})
+	topMux.HandleFunc("/apis/v1/readyz", readinessServer.Readyz).Methods(http.MethodGet)
 
 	// log streaming is provided via HTTP.
 	runLogServer := server.NewRunLogServer(resourceManager)
-	topMux.HandleFunc("/apis/v1/runs/{run_id}/nodes/{node_id}/log", runLogServer.ReadRunLog)
+	topMux.HandleFunc("/apis/v1/runs/{run_id}/nodes/{node_id}/log", rateLimited(runLogServer.ReadRunLog))
+
+	// the chunks of the resumable uploads are raw bytes, which are only supported in HTTP.
+	uploadServer := server.NewUploadServer(resourceManager)
+	topMux.HandleFunc("/apis/v1/uploads/{upload_id}",
+		rateLimited(auditHandler(resourceManager, "UploadChunk", uploadServer.UploadChunk))).Methods(http.MethodPut)
 
-	topMux.PathPrefix("/apis/").Handler(runtimeMux)
+	// the control plane is backed up and restored by admins via HTTP.
+	backupServer := server.NewBackupServer(resourceManager)
+	topMux.HandleFunc("/apis/v1/backup", rateLimited(backupServer.Backup)).Methods(http.MethodGet)
+	topMux.HandleFunc("/apis/v1/restore", rateLimited(backupServer.Restore)).Methods(http.MethodPost)
+
+	// the namespace exports are gzipped streams, which are only supported in HTTP.
+	namespaceExportServer := server.NewNamespaceExportServer(resourceManager)
+	topMux.HandleFunc("/apis/v1/namespaces/{namespace}/export", rateLimited(namespaceExportServer.ExportNamespace)).Methods(http.MethodGet)
+	topMux.HandleFunc("/apis/v1/namespaces/{namespace}/import",
+		rateLimited(auditHandler(resourceManager, "ImportNamespace", namespaceExportServer.ImportNamespace))).Methods(http.MethodPost)
+
+	topMux.PathPrefix(gatewayPathPrefix).Handler(runtimeMux)
 
 	// Register a handler for Prometheus to poll.
 	topMux.Handle("/metrics", promhttp.Handler())
+	if *collectMetricsFlag {
+		topMux.Use(metricsHandler)
+	}
+	// The requests are authenticated once, before they're logged and handled.
+	topMux.Use(authenticationHandler(resourceManager))
+	topMux.Use(requestLogHandler)
 
-	http.ListenAndServe(*httpPortFlag, topMux)
-	glog.Info("Http Proxy started")
+	handler := gateway.CORSHandler(common.GetCORSAllowedOrigins(), topMux)
+	httpServer := &http.Server{Addr: httpListenAddress(), Handler: tracing.HTTPHandler(logging.HTTPHandler(handler), "ml-pipeline-http")}
+	if certFile := common.GetHTTPTLSCertFile(); certFile != "" {
+		certReloader, err := gateway.NewCertReloader(certFile, common.GetHTTPTLSKeyFile())
+		if err != nil {
+			log.Fatalf("Failed to load the http proxy certificate: %v", err)
+		}
+		httpServer.TLSConfig = &tls.Config{
+			GetCertificate: certReloader.GetCertificate,
+			MinVersion:     tls.VersionTLS12,
+		}
+	}
+	go func() {
+		var err error
+		if httpServer.TLSConfig != nil {
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to serve http proxy: %v", err)
+		}
+	}()
+	log.Info("Http Proxy started")
+	return httpServer
 }
 
 func registerHttpHandlerFromEndpoint(handler RegisterHttpHandlerFromEndpoint, serviceName string, ctx context.Context, mux *runtime.ServeMux) {
-	endpoint := "localhost" + *rpcPortFlag
-	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32))}
+	endpoint, err := gateway.DialAddress(rpcListenAddress())
+	if err != nil {
+		log.Fatalf("Invalid RPC listen address: %v", err)
+	}
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
+		// Continue the traces of the HTTP requests in the gRPC server.
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
+	}
 
 	if err := handler(ctx, mux, endpoint, opts); err != nil {
-		glog.Fatalf("Failed to register %v handler: %v", serviceName, err)
+		log.Fatalf("Failed to register %v handler: %v", serviceName, err)
 	}
 }
 
+// rpcListenAddress returns the address the RPC server binds to. The config takes
+// precedence over the flag.
+func rpcListenAddress() string {
+	return common.GetStringConfigWithDefault(common.RPCListenAddress, *rpcPortFlag)
+}
+
+// httpListenAddress returns the address the http proxy binds to. The config takes
+// precedence over the flag.
+func httpListenAddress() string {
+	return common.GetStringConfigWithDefault(common.HTTPListenAddress, *httpPortFlag)
+}
+
+func initDatabase(resourceManager *resource.ResourceManager) error {
+	err := loadSamples(resourceManager)
+	if err != nil {
+		return fmt.Errorf("Failed to load samples. Err: %v", err)
+	}
+
+	_, err = resourceManager.CreateDefaultExperiment()
+	if err != nil {
+		return fmt.Errorf("Failed to create default experiment. Err: %v", err)
+	}
+
+	if *migrateTemplatesFlag {
+		migrated, err := resourceManager.MigratePipelineVersionTemplates()
+		if err != nil {
+			return fmt.Errorf("Failed to migrate pipeline templates. Migrated %d templates before the failure. Err: %v", migrated, err)
+		}
+		log.Infof("Migrated %d pipeline templates to tekton.dev/v1", migrated)
+	}
+	return nil
+}
+
 // Preload a bunch of pipeline samples
 // Samples are only loaded once when the pipeline system is initially installed.
 // They won't be loaded when upgrade or pod restart, to prevent them reappear if user explicitly
@@ -179,7 +669,7 @@ func loadSamples(resourceManager *resource.ResourceManager) error {
 		return err
 	}
 	if haveSamplesLoaded {
-		glog.Infof("Samples already loaded in the past. Skip loading.")
+		log.Infof("Samples already loaded in the past. Skip loading.")
 		return nil
 	}
 	configBytes, err := ioutil.ReadFile(*sampleConfigPath)
@@ -200,7 +690,7 @@ func loadSamples(resourceManager *resource.ResourceManager) error {
 		if configErr != nil {
 			return fmt.Errorf("Failed to load sample %s. Error: %v", config.Name, configErr)
 		}
-		pipelineFile, configErr := server.ReadPipelineFile(config.File, reader, server.MaxFileLength)
+		pipelineFile, configErr := template.ReadPipelineFile(config.File, reader, template.MaxFileLength)
 		if configErr != nil {
 			return fmt.Errorf("Failed to decompress the file %s. Error: %v", config.Name, configErr)
 		}
@@ -208,7 +698,7 @@ func loadSamples(resourceManager *resource.ResourceManager) error {
 		if configErr != nil {
 			// Log the error but not fail. The API Server pod can restart and it could potentially cause name collision.
 			// In the future, we might consider loading samples during deployment, instead of when API server starts.
-			glog.Warningf(fmt.Sprintf("Failed to create pipeline for %s. Error: %v", config.Name, configErr))
+			log.Warningf(fmt.Sprintf("Failed to create pipeline for %s. Error: %v", config.Name, configErr))
 			continue
 		}
 
@@ -221,7 +711,7 @@ func loadSamples(resourceManager *resource.ResourceManager) error {
 	if err != nil {
 		return err
 	}
-	glog.Info("All samples are loaded.")
+	log.Info("All samples are loaded.")
 	return nil
 }
 
@@ -238,7 +728,7 @@ func initConfig() {
 	viper.AddConfigPath(*configPath)
 	err := viper.ReadInConfig()
 	if err != nil {
-		glog.Fatalf("Fatal error config file: %s", err)
+		log.Fatalf("Fatal error config file: %s", err)
 	}
 
 	// Watch for configuration change
@@ -0,0 +1,33 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// ArtifactBlob is the content of identical artifacts, stored once under a key
+// derived from its digest. RefCount counts the artifacts that refer to it.
+type ArtifactBlob struct {
+	BlobKey  string `gorm:"column:BlobKey; not null; primary_key"`
+	Size     int64  `gorm:"column:Size; not null"`
+	RefCount int64  `gorm:"column:RefCount; not null"`
+}
+
+// ArtifactReference maps the key a run stored an artifact under to the blob
+// holding its content.
+type ArtifactReference struct {
+	ObjectKey      string `gorm:"column:ObjectKey; not null; primary_key"`
+	BlobKey        string `gorm:"column:BlobKey; not null; index"`
+	RunUUID        string `gorm:"column:RunUUID; not null; index"`
+	Size           int64  `gorm:"column:Size; not null"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// ArtifactMetadata indexes an output artifact of a run when the run is
+// persisted, so that the artifacts can be searched without listing the object
+// store. CustomProperties holds the properties of the artifact as JSON, and
+// OCIReference the digest reference of the artifact once pushed to the OCI
+// registry.
+type ArtifactMetadata struct {
+	UUID             string `gorm:"column:UUID; not null; primary_key" json:"id"`
+	Namespace        string `gorm:"column:Namespace; not null; index" json:"namespace"`
+	RunUUID          string `gorm:"column:RunUUID; not null; index" json:"run_id"`
+	TaskName         string `gorm:"column:TaskName; not null" json:"producing_task"`
+	Name             string `gorm:"column:Name; not null; index" json:"name"`
+	Type             string `gorm:"column:Type; not null; index" json:"type"`
+	Size             int64  `gorm:"column:Size; not null; index" json:"size"`
+	ObjectKey        string `gorm:"column:ObjectKey; not null" json:"object_key"`
+	CustomProperties string `gorm:"column:CustomProperties; type:text" json:"custom_properties,omitempty"`
+	CreatedAtInSec   int64  `gorm:"column:CreatedAtInSec; not null; index" json:"created_at"`
+	OCIReference     string `gorm:"column:OCIReference; type:text" json:"oci_reference,omitempty"`
+}
+
+// TableName keeps gorm from pluralizing the table name.
+func (ArtifactMetadata) TableName() string {
+	return "artifact_metadata"
+}
+
+func (a ArtifactMetadata) GetValueOfPrimaryKey() string {
+	return a.UUID
+}
+
+// PrimaryKeyColumnName returns the primary key for model ArtifactMetadata.
+func (a *ArtifactMetadata) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model ArtifactMetadata.
+func (a *ArtifactMetadata) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+// The custom properties can be filtered with the IS_SUBSTRING operation, on the
+// JSON of a property such as "framework":"tensorflow".
+var artifactMetadataAPIToModelFieldMap = map[string]string{
+	"id":                "UUID",
+	"namespace":         "Namespace",
+	"run_id":            "RunUUID",
+	"producing_task":    "TaskName",
+	"name":              "Name",
+	"type":              "Type",
+	"size":              "Size",
+	"custom_properties": "CustomProperties",
+	"created_at":        "CreatedAtInSec",
+	"oci_reference":     "OCIReference",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// ArtifactMetadata.
+func (a *ArtifactMetadata) APIToModelFieldMap() map[string]string {
+	return artifactMetadataAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix
+func (a *ArtifactMetadata) GetModelName() string {
+	return "artifact_metadata"
+}
+
+func (a *ArtifactMetadata) GetField(name string) (string, bool) {
+	if field, ok := artifactMetadataAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (a *ArtifactMetadata) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return a.UUID
+	case "Namespace":
+		return a.Namespace
+	case "RunUUID":
+		return a.RunUUID
+	case "TaskName":
+		return a.TaskName
+	case "Name":
+		return a.Name
+	case "Type":
+		return a.Type
+	case "Size":
+		return a.Size
+	case "CustomProperties":
+		return a.CustomProperties
+	case "CreatedAtInSec":
+		return a.CreatedAtInSec
+	case "OCIReference":
+		return a.OCIReference
+	default:
+		return nil
+	}
+}
+
+func (a *ArtifactMetadata) GetSortByFieldPrefix(name string) string {
+	return "artifact_metadata."
+}
+
+func (a *ArtifactMetadata) GetKeyFieldPrefix() string {
+	return "artifact_metadata."
+}
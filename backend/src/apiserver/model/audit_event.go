@@ -0,0 +1,107 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+const (
+	AuditResultSucceeded = "Succeeded"
+	AuditResultFailed    = "Failed"
+)
+
+// AuditEvent records a single mutating API call, with the state of the resource
+// it operates on before and after the call as JSON. The manifests are left out of
+// the states, so the audit trail doesn't duplicate the pipelines.
+type AuditEvent struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key" json:"id"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null; index" json:"created_at"`
+	Actor          string `gorm:"column:Actor; not null" json:"actor"`
+	Namespace      string `gorm:"column:Namespace; not null" json:"namespace"`
+	Method         string `gorm:"column:Method; not null" json:"method"`
+	ResourceId     string `gorm:"column:ResourceId; not null" json:"resource_id"`
+	ResourceBefore string `gorm:"column:ResourceBefore; size:65535" json:"resource_before,omitempty"`
+	ResourceAfter  string `gorm:"column:ResourceAfter; size:65535" json:"resource_after,omitempty"`
+	Result         string `gorm:"column:Result; not null" json:"result"`
+	Error          string `gorm:"column:Error; type:text" json:"error,omitempty"`
+}
+
+func (e AuditEvent) GetValueOfPrimaryKey() string {
+	return e.UUID
+}
+
+// PrimaryKeyColumnName returns the primary key for model AuditEvent.
+func (e *AuditEvent) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model AuditEvent.
+func (e *AuditEvent) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+var auditEventAPIToModelFieldMap = map[string]string{
+	"id":          "UUID",
+	"created_at":  "CreatedAtInSec",
+	"actor":       "Actor",
+	"namespace":   "Namespace",
+	"method":      "Method",
+	"resource_id": "ResourceId",
+	"result":      "Result",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// AuditEvent.
+func (e *AuditEvent) APIToModelFieldMap() map[string]string {
+	return auditEventAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix
+func (e *AuditEvent) GetModelName() string {
+	return "audit_events"
+}
+
+func (e *AuditEvent) GetField(name string) (string, bool) {
+	if field, ok := auditEventAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (e *AuditEvent) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return e.UUID
+	case "CreatedAtInSec":
+		return e.CreatedAtInSec
+	case "Actor":
+		return e.Actor
+	case "Namespace":
+		return e.Namespace
+	case "Method":
+		return e.Method
+	case "ResourceId":
+		return e.ResourceId
+	case "Result":
+		return e.Result
+	default:
+		return nil
+	}
+}
+
+func (e *AuditEvent) GetSortByFieldPrefix(name string) string {
+	return "audit_events."
+}
+
+func (e *AuditEvent) GetKeyFieldPrefix() string {
+	return "audit_events."
+}
@@ -17,3 +17,10 @@ package model
 type DefaultExperiment struct {
 	DefaultExperimentId string `gorm:"column:DefaultExperimentId; not null; primary_key"`
 }
+
+// NamespaceDefaultExperiment is the experiment the runs and jobs of a namespace are
+// grouped in when they're created without one, in multi-user mode.
+type NamespaceDefaultExperiment struct {
+	Namespace    string `gorm:"column:Namespace; not null; primary_key"`
+	ExperimentId string `gorm:"column:ExperimentId; not null; index"`
+}
@@ -7,6 +7,7 @@ type Experiment struct {
 	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
 	Namespace      string `gorm:"column:Namespace; not null; unique_index:idx_name_namespace"`
 	StorageState   string `gorm:"column:StorageState; not null;"`
+	DeletedAtInSec int64  `gorm:"column:DeletedAtInSec; not null; default:0; unique_index:idx_name_namespace"` /* Zero unless soft deleted */
 }
 
 func (e Experiment) GetValueOfPrimaryKey() string {
@@ -80,3 +81,60 @@ func (e *Experiment) GetSortByFieldPrefix(name string) string {
 func (e *Experiment) GetKeyFieldPrefix() string {
 	return "experiments."
 }
+
+// ExperimentArchivePolicy chooses the resources of an experiment that are
+// archived or restored with it.
+type ExperimentArchivePolicy struct {
+	// Runs archives the runs of the experiment with it, or restores them.
+	Runs bool
+	// Jobs pauses the jobs of the experiment with it, or enables them.
+	Jobs bool
+}
+
+// ExperimentArchiveResult reports the resources of an experiment that were
+// archived or restored with it.
+type ExperimentArchiveResult struct {
+	RunCount int64
+	JobIDs   []string
+}
+
+// ExperimentDefaults are merged into the runs and jobs created in an experiment,
+// so the runs of a team share their environment: the default parameters and
+// service account apply when the run doesn't set them, and the default pod
+// template settings when its pipeline doesn't set them.
+type ExperimentDefaults struct {
+	ExperimentUUID string `gorm:"column:ExperimentUUID; not null; primary_key"`
+	// Parameters are the JSON list of the default parameters, e.g.
+	// [{"name": "learning_rate", "value": "0.1"}].
+	Parameters     string `gorm:"column:Parameters; not null; size:65535"`
+	ServiceAccount string `gorm:"column:ServiceAccount; not null"`
+	// PodTemplate is the JSON of the default Tekton pod template.
+	PodTemplate    string `gorm:"column:PodTemplate; not null; size:65535"`
+	UpdatedAtInSec int64  `gorm:"column:UpdatedAtInSec; not null"`
+}
+
+// The enforcements of the experiment budgets.
+const (
+	// ExperimentBudgetBlock rejects the new runs and jobs once the budget is used up.
+	ExperimentBudgetBlock = "block"
+	// ExperimentBudgetWarn creates them, flagging the runs as exceeding the budget.
+	ExperimentBudgetWarn = "warn"
+)
+
+// ExperimentBudget limits the runs of an experiment, e.g. for coursework or a
+// team's compute allowance. A zero limit is unlimited.
+type ExperimentBudget struct {
+	ExperimentUUID string `gorm:"column:ExperimentUUID; not null; primary_key"`
+	MaxRuns        int64  `gorm:"column:MaxRuns; not null"`
+	// MaxTaskSeconds limits the cumulative duration of the tasks of the runs.
+	MaxTaskSeconds int64  `gorm:"column:MaxTaskSeconds; not null"`
+	Enforcement    string `gorm:"column:Enforcement; not null"`
+	UpdatedAtInSec int64  `gorm:"column:UpdatedAtInSec; not null"`
+}
+
+// ExperimentUsage is what the runs of an experiment consumed, counting the
+// archived and the soft deleted runs too.
+type ExperimentUsage struct {
+	RunCount    int64
+	TaskSeconds int64
+}
@@ -28,6 +28,7 @@ type Job struct {
 	CreatedAtInSec     int64  `gorm:"column:CreatedAtInSec; not null"` /* The time this record is stored in DB*/
 	UpdatedAtInSec     int64  `gorm:"column:UpdatedAtInSec; not null"`
 	Enabled            bool   `gorm:"column:Enabled; not null"`
+	DeletedAtInSec     int64  `gorm:"column:DeletedAtInSec; not null; default:0"` /* Zero unless soft deleted */
 	ResourceReferences []*ResourceReference
 	Trigger
 	PipelineSpec
@@ -93,6 +94,9 @@ var jobAPIToModelFieldMap = map[string]string{
 	"name":       "DisplayName",
 	"created_at": "CreatedAtInSec",
 	"package_id": "PipelineId",
+	// The names of the v2beta1 API.
+	"recurring_run_id": "UUID",
+	"display_name":     "DisplayName",
 }
 
 // APIToModelFieldMap returns a map from API names to field names for model Job.
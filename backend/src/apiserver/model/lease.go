@@ -0,0 +1,23 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// Lease is a lock held in the database by one apiserver replica, so work that must
+// not run concurrently, like loading the samples, is coordinated between replicas.
+type Lease struct {
+	Name           string `gorm:"column:Name; not null; primary_key"`
+	Holder         string `gorm:"column:Holder; not null"`
+	ExpiresAtInSec int64  `gorm:"column:ExpiresAtInSec; not null"`
+}
@@ -40,6 +40,7 @@ type Pipeline struct {
 	DefaultVersionId string           `gorm:"column:DefaultVersionId;"`
 	DefaultVersion   *PipelineVersion `gorm:"-"`
 	Namespace        string           `gorm:"column:Namespace; size:63; default:''"`
+	DeletedAtInSec   int64            `gorm:"column:DeletedAtInSec; not null; default:0"` /* Zero unless soft deleted */
 }
 
 func (p Pipeline) GetValueOfPrimaryKey() string {
@@ -66,6 +67,9 @@ var pipelineAPIToModelFieldMap = map[string]string{
 	"created_at":  "CreatedAtInSec",
 	"description": "Description",
 	"namespace":   "Namespace",
+	// The names of the v2beta1 API.
+	"pipeline_id":  "UUID",
+	"display_name": "Name",
 	// TODO(jingzhang36): uncomment this field when we expose it to API
 	// "default_version_id": "DefaultVersionId",
 }
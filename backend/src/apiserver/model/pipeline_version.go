@@ -43,6 +43,8 @@ type PipelineVersion struct {
 	// Code source url links to the pipeline version's definition in repo.
 	CodeSourceUrl string `gorm:"column:CodeSourceUrl;"`
 	Description   string `gorm:"column:Description; not null; size:65535"` // Set size to large number so it will be stored as longtext
+	// Warnings found by static analysis of the pipeline template, JSON encoded.
+	Warnings string `gorm:"column:Warnings; not null; size:65535"`
 }
 
 func (p PipelineVersion) GetValueOfPrimaryKey() string {
@@ -67,6 +69,9 @@ func (p *PipelineVersion) APIToModelFieldMap() map[string]string {
 		"name":       "Name",
 		"created_at": "CreatedAtInSec",
 		"status":     "Status",
+		// The names of the v2beta1 API.
+		"pipeline_version_id": "UUID",
+		"display_name":        "Name",
 	}
 }
 
@@ -35,6 +35,7 @@ type Run struct {
 	ScheduledAtInSec   int64  `gorm:"column:ScheduledAtInSec; default:0;"`
 	FinishedAtInSec    int64  `gorm:"column:FinishedAtInSec; default:0;"`
 	Conditions         string `gorm:"column:Conditions; not null"`
+	DeletedAtInSec     int64  `gorm:"column:DeletedAtInSec; not null; default:0"` /* Zero unless soft deleted */
 	Metrics            []*RunMetric
 	ResourceReferences []*ResourceReference
 	PipelineSpec
@@ -60,6 +61,25 @@ type RunMetric struct {
 	Payload     string  `gorm:"column:Payload; not null; size:65535"`
 }
 
+// ArchivedRunDetail is a run moved to the run archive, which keeps the run_details
+// table small. It's unrelated to the archived storage state of a run.
+type ArchivedRunDetail struct {
+	RunDetail
+}
+
+func (ArchivedRunDetail) TableName() string {
+	return "run_details_archive"
+}
+
+// ArchivedRunMetric is a metric of a run moved to the run archive.
+type ArchivedRunMetric struct {
+	RunMetric
+}
+
+func (ArchivedRunMetric) TableName() string {
+	return "run_metrics_archive"
+}
+
 func (r Run) GetValueOfPrimaryKey() string {
 	return r.UUID
 }
@@ -86,6 +106,9 @@ var runAPIToModelFieldMap = map[string]string{
 	"scheduled_at":  "ScheduledAtInSec",
 	"storage_state": "StorageState",
 	"status":        "Conditions",
+	// The names of the v2beta1 API.
+	"run_id":       "UUID",
+	"display_name": "DisplayName",
 }
 
 // APIToModelFieldMap returns a map from API names to field names for model Run.
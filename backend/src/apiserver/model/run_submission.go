@@ -0,0 +1,27 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// RunSubmission is the outbox record of a run whose PipelineRun may not have been
+// created yet. It's stored along with the run, and deleted once the PipelineRun
+// is created, so that a crash in between is recovered by submitting it again.
+type RunSubmission struct {
+	RunUUID        string `gorm:"column:RunUUID; not null; primary_key"`
+	Namespace      string `gorm:"column:Namespace; not null"`
+	Workflow       string `gorm:"column:Workflow; not null; size:65535"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null; index:runsubmissions_createdatinsec"`
+	Attempts       int64  `gorm:"column:Attempts; not null; default:0"`
+	LastError      string `gorm:"column:LastError; not null; size:65535"`
+}
@@ -0,0 +1,33 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// UploadSession is a resumable upload of a file, which is sent in chunks stored
+// in the object store until the upload is complete. An upload is either a
+// pipeline package of a namespace, or an artifact of a run.
+type UploadSession struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key"`
+	Namespace      string `gorm:"column:Namespace; not null"`
+	RunUUID        string `gorm:"column:RunUUID; not null"`
+	FileName       string `gorm:"column:FileName; not null"`
+	Size           int64  `gorm:"column:Size; not null"`
+	UploadedSize   int64  `gorm:"column:UploadedSize; not null"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null; index"`
+}
+
+// IsComplete reports whether every byte of the file was uploaded.
+func (s *UploadSession) IsComplete() bool {
+	return s.UploadedSize == s.Size
+}
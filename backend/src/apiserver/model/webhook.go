@@ -0,0 +1,78 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strings"
+)
+
+// The statuses of the webhook deliveries.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookSubscription subscribes an external endpoint to the lifecycle events of
+// the resources of a namespace, or of all of them when the namespace is empty.
+// The events are signed with the secret, which is encrypted like the other
+// sensitive values.
+type WebhookSubscription struct {
+	UUID      string `gorm:"column:UUID; not null; primary_key"`
+	Name      string `gorm:"column:Name; not null"`
+	Namespace string `gorm:"column:Namespace; not null; index:webhooksubscriptions_namespace"`
+	URL       string `gorm:"column:URL; not null; size:2048"`
+	Secret    string `gorm:"column:Secret; not null; size:65535"`
+	// EventTypes are the comma separated types of the events, without the
+	// org.kubeflow.pipelines. prefix, e.g. run.finished or run.*. All of them
+	// when empty.
+	EventTypes string `gorm:"column:EventTypes; not null; size:65535"`
+	// ExperimentID filters the events of the runs and jobs of an experiment.
+	ExperimentID   string `gorm:"column:ExperimentID; not null"`
+	Enabled        bool   `gorm:"column:Enabled; not null"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
+	UpdatedAtInSec int64  `gorm:"column:UpdatedAtInSec; not null"`
+}
+
+// EventTypeList returns the event types of the subscription.
+func (s *WebhookSubscription) EventTypeList() []string {
+	var types []string
+	for _, eventType := range strings.Split(s.EventTypes, ",") {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			types = append(types, eventType)
+		}
+	}
+	return types
+}
+
+// WebhookDelivery is the delivery of an event to a subscription. It's stored when
+// the event happens, then sent, and retried until it succeeds or runs out of
+// attempts, so that the deliveries survive the restarts of the API server.
+type WebhookDelivery struct {
+	UUID             string `gorm:"column:UUID; not null; primary_key"`
+	SubscriptionUUID string `gorm:"column:SubscriptionUUID; not null; index:webhookdeliveries_subscriptionuuid"`
+	EventID          string `gorm:"column:EventID; not null"`
+	EventType        string `gorm:"column:EventType; not null"`
+	// Payload is the event, as a CloudEvent in the structured JSON format.
+	Payload      string `gorm:"column:Payload; not null; size:65535"`
+	Status       string `gorm:"column:Status; not null"`
+	Attempts     int64  `gorm:"column:Attempts; not null; default:0"`
+	ResponseCode int64  `gorm:"column:ResponseCode; not null; default:0"`
+	LastError    string `gorm:"column:LastError; not null; size:65535"`
+	// NextAttemptAtInSec is when the pending delivery is sent next.
+	NextAttemptAtInSec int64 `gorm:"column:NextAttemptAtInSec; not null; index:webhookdeliveries_nextattemptatinsec"`
+	CreatedAtInSec     int64 `gorm:"column:CreatedAtInSec; not null; index:webhookdeliveries_createdatinsec"`
+	DeliveredAtInSec   int64 `gorm:"column:DeliveredAtInSec; not null; default:0"`
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The events of the runs notified to the routes.
+const (
+	EventSucceeded   = "succeeded"
+	EventFailed      = "failed"
+	EventLongRunning = "long_running"
+)
+
+// Supported values of the channel of a route.
+const (
+	ChannelSlack   = "slack"
+	ChannelTeams   = "teams"
+	ChannelWebhook = "webhook"
+)
+
+// The default messages of the events, when a route has no template.
+var defaultTemplates = map[string]*template.Template{
+	EventSucceeded: template.Must(template.New(EventSucceeded).Parse(
+		`Run "{{.RunName}}" in {{.Namespace}} succeeded after {{.Duration}}.{{if .RunURL}} {{.RunURL}}{{end}}`)),
+	EventFailed: template.Must(template.New(EventFailed).Parse(
+		`Run "{{.RunName}}" in {{.Namespace}} failed with state {{.State}} after {{.Duration}}.{{if .RunURL}} {{.RunURL}}{{end}}`)),
+	EventLongRunning: template.Must(template.New(EventLongRunning).Parse(
+		`Run "{{.RunName}}" in {{.Namespace}} has been running for {{.Duration}}.{{if .RunURL}} {{.RunURL}}{{end}}`)),
+}
+
+// The colors of the Teams cards of the events.
+var teamsThemeColors = map[string]string{
+	EventSucceeded:   "2EB886",
+	EventFailed:      "D00000",
+	EventLongRunning: "FFA500",
+}
+
+// Notification is the event of a run to notify. It's also the data of the
+// templates of the messages.
+type Notification struct {
+	Event        string
+	RunID        string
+	RunName      string
+	Namespace    string
+	ExperimentID string
+	State        string
+	// Duration is how long the run ran, until it finished or until now.
+	Duration time.Duration
+	// RunURL links the run in the UI. It's set by the notifier when the UI
+	// base URL is configured.
+	RunURL string
+}
+
+// EventOfState returns the event of a run which finished in the state, or an
+// empty string if it isn't notified, e.g. when the run was cancelled.
+func EventOfState(state string) string {
+	switch state {
+	case "Succeeded", "Completed":
+		return EventSucceeded
+	case "Failed", "PipelineRunTimeout", "PipelineRunCouldntCancel", "InvalidTaskResultReference":
+		return EventFailed
+	default:
+		return ""
+	}
+}
+
+// webhookPayload is the body posted to the generic webhooks.
+type webhookPayload struct {
+	Event           string `json:"event"`
+	RunID           string `json:"run_id"`
+	RunName         string `json:"run_name"`
+	Namespace       string `json:"namespace,omitempty"`
+	ExperimentID    string `json:"experiment_id,omitempty"`
+	State           string `json:"state,omitempty"`
+	DurationSeconds int64  `json:"duration_seconds"`
+	RunURL          string `json:"run_url,omitempty"`
+	Message         string `json:"message"`
+}
+
+// teamsMessageCard is the legacy actionable message card, which the incoming
+// webhooks of Teams accept.
+type teamsMessageCard struct {
+	Type       string `json:"@type"`
+	Context    string `json:"@context"`
+	Summary    string `json:"summary"`
+	ThemeColor string `json:"themeColor"`
+	Text       string `json:"text"`
+}
+
+// renderMessage returns the body of the message of the notification to the
+// channel, with the text of the template.
+func renderMessage(channel string, tmpl *template.Template, notification *Notification) ([]byte, error) {
+	if tmpl == nil {
+		tmpl = defaultTemplates[notification.Event]
+	}
+	var text bytes.Buffer
+	if err := tmpl.Execute(&text, notification); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to render the message of %s run %s", notification.Event, notification.RunID)
+	}
+	var payload interface{}
+	switch channel {
+	case ChannelSlack:
+		payload = map[string]string{"text": text.String()}
+	case ChannelTeams:
+		payload = teamsMessageCard{
+			Type:       "MessageCard",
+			Context:    "https://schema.org/extensions",
+			Summary:    text.String(),
+			ThemeColor: teamsThemeColors[notification.Event],
+			Text:       text.String(),
+		}
+	default:
+		payload = webhookPayload{
+			Event:           notification.Event,
+			RunID:           notification.RunID,
+			RunName:         notification.RunName,
+			Namespace:       notification.Namespace,
+			ExperimentID:    notification.ExperimentID,
+			State:           notification.State,
+			DurationSeconds: int64(notification.Duration.Seconds()),
+			RunURL:          notification.RunURL,
+			Message:         text.String(),
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to marshal the message of %s run %s", notification.Event, notification.RunID)
+	}
+	return body, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+var sentNotifications = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "run_notifications",
+	Help: "The number of notifications of the runs, by channel and result",
+}, []string{"channel", "result"})
+
+type NotifierInterface interface {
+	// Notify sends the notification to the routes matching it, in the
+	// background.
+	Notify(notification *Notification) error
+	// Close sends the queued notifications.
+	Close() error
+}
+
+// route is a route of the policy, with its parsed template and its rate limiter.
+type route struct {
+	common.NotificationRoute
+	events   map[string]bool
+	template *template.Template
+	limiter  *rate.Limiter
+}
+
+func (r *route) matches(notification *Notification) bool {
+	return (r.Namespace == "" || r.Namespace == notification.Namespace) &&
+		(r.ExperimentID == "" || r.ExperimentID == notification.ExperimentID) &&
+		(len(r.events) == 0 || r.events[notification.Event])
+}
+
+type message struct {
+	route *route
+	body  []byte
+	event string
+	runID string
+}
+
+// Notifier sends the notifications of the runs to the routes of the policy.
+// The same notification of a run is sent once per dedup window, and each route
+// sends at most the messages per minute of the policy, the next ones are
+// dropped, so that a burst of failures doesn't flood a channel.
+type Notifier struct {
+	routes      []*route
+	uiBaseURL   string
+	dedupWindow time.Duration
+	client      *http.Client
+	time        util.TimeInterface
+	// sent holds when each notification was sent, by route, run and event.
+	sent   map[string]time.Time
+	queue  chan *message
+	mutex  sync.Mutex
+	closed bool
+	done   chan struct{}
+}
+
+// NewNotifier returns the notifier of the routes of the policy, or nil if the
+// policy has no route.
+func NewNotifier(policy *common.NotificationPolicy, clock util.TimeInterface) (*Notifier, error) {
+	if len(policy.Routes) == 0 {
+		return nil, nil
+	}
+	n := &Notifier{
+		uiBaseURL:   strings.TrimSuffix(policy.UIBaseURL, "/"),
+		dedupWindow: policy.DedupWindow,
+		client:      &http.Client{Timeout: policy.Timeout},
+		time:        clock,
+		sent:        make(map[string]time.Time),
+		queue:       make(chan *message, policy.QueueSize),
+		done:        make(chan struct{}),
+	}
+	for i, r := range policy.Routes {
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("route-%d", i)
+		}
+		if r.Channel != ChannelSlack && r.Channel != ChannelTeams && r.Channel != ChannelWebhook {
+			return nil, util.NewInvalidInputError("Channel %q of notification route %s is not supported", r.Channel, r.Name)
+		}
+		if r.URL == "" {
+			return nil, util.NewInvalidInputError("Notification route %s has no URL", r.Name)
+		}
+		compiled := &route{
+			NotificationRoute: r,
+			events:            make(map[string]bool, len(r.Events)),
+			limiter:           rate.NewLimiter(rate.Limit(float64(policy.RateLimitPerMinute)/60), policy.RateLimitPerMinute),
+		}
+		for _, event := range r.Events {
+			if _, ok := defaultTemplates[event]; !ok {
+				return nil, util.NewInvalidInputError("Event %q of notification route %s is not supported", event, r.Name)
+			}
+			compiled.events[event] = true
+		}
+		if r.Template != "" {
+			tmpl, err := template.New(r.Name).Parse(r.Template)
+			if err != nil {
+				return nil, util.NewInvalidInputError("Invalid template of notification route %s: %v", r.Name, err)
+			}
+			compiled.template = tmpl
+		}
+		n.routes = append(n.routes, compiled)
+	}
+	go n.run()
+	return n, nil
+}
+
+func (n *Notifier) run() {
+	defer close(n.done)
+	for m := range n.queue {
+		if err := n.send(m); err != nil {
+			sentNotifications.WithLabelValues(m.route.Channel, "failed").Inc()
+			log.Warnf("Failed to send the %s notification of run %s to route %s: %v", m.event, m.runID, m.route.Name, err)
+			continue
+		}
+		sentNotifications.WithLabelValues(m.route.Channel, "sent").Inc()
+	}
+}
+
+func (n *Notifier) send(m *message) error {
+	resp, err := n.client.Post(m.route.URL, "application/json", bytes.NewReader(m.body))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to send the notification to route %s", m.route.Name)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return util.NewInternalServerError(
+			fmt.Errorf("unexpected status code %d", resp.StatusCode), "Failed to send the notification to route %s", m.route.Name)
+	}
+	return nil
+}
+
+// Notify queues the messages of the notification to the routes matching it,
+// unless already sent within the dedup window or over the rate limit of the
+// route. It fails if the queue is full.
+func (n *Notifier) Notify(notification *Notification) error {
+	if n.uiBaseURL != "" {
+		notification.RunURL = n.uiBaseURL + "/#/runs/details/" + notification.RunID
+	}
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.closed {
+		return util.NewUnavailableError(errors.New("notifier is closed"), "Failed to notify run %s", notification.RunID)
+	}
+	now := n.time.Now()
+	for key, sentAt := range n.sent {
+		if now.Sub(sentAt) >= n.dedupWindow {
+			delete(n.sent, key)
+		}
+	}
+	var err error
+	for _, r := range n.routes {
+		if !r.matches(notification) {
+			continue
+		}
+		key := r.Name + "/" + notification.RunID + "/" + notification.Event
+		if _, ok := n.sent[key]; ok {
+			sentNotifications.WithLabelValues(r.Channel, "deduplicated").Inc()
+			continue
+		}
+		if !r.limiter.AllowN(now, 1) {
+			sentNotifications.WithLabelValues(r.Channel, "rate_limited").Inc()
+			log.Warnf("Dropped the %s notification of run %s to route %s over its rate limit", notification.Event, notification.RunID, r.Name)
+			continue
+		}
+		body, renderErr := renderMessage(r.Channel, r.template, notification)
+		if renderErr != nil {
+			err = renderErr
+			continue
+		}
+		select {
+		case n.queue <- &message{route: r, body: body, event: notification.Event, runID: notification.RunID}:
+			n.sent[key] = now
+		default:
+			sentNotifications.WithLabelValues(r.Channel, "dropped").Inc()
+			err = util.NewResourceExhaustedError(errors.New("notification queue is full"), "Failed to notify run %s", notification.RunID)
+		}
+	}
+	return err
+}
+
+// Close sends the queued messages.
+func (n *Notifier) Close() error {
+	n.mutex.Lock()
+	if !n.closed {
+		n.closed = true
+		close(n.queue)
+	}
+	n.mutex.Unlock()
+	<-n.done
+	n.client.CloseIdleConnections()
+	return nil
+}
+
+// FakeNotifier records the notifications.
+type FakeNotifier struct {
+	mutex         sync.Mutex
+	notifications []*Notification
+}
+
+func NewFakeNotifier() *FakeNotifier {
+	return &FakeNotifier{}
+}
+
+func (n *FakeNotifier) Notify(notification *Notification) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func (n *FakeNotifier) Close() error {
+	return nil
+}
+
+// Notifications returns the notifications, in order.
+func (n *FakeNotifier) Notifications() []*Notification {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return append([]*Notification(nil), n.notifications...)
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// messageServer records the bodies of the messages posted to it, by path.
+type messageServer struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	messages map[string][]map[string]interface{}
+}
+
+func newMessageServer(t *testing.T) *messageServer {
+	s := &messageServer{messages: make(map[string][]map[string]interface{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		s.mutex.Lock()
+		s.messages[r.URL.Path] = append(s.messages[r.URL.Path], body)
+		s.mutex.Unlock()
+	}))
+	return s
+}
+
+func (s *messageServer) Messages(path string) []map[string]interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.messages[path]
+}
+
+func newPolicy(routes ...common.NotificationRoute) *common.NotificationPolicy {
+	return &common.NotificationPolicy{
+		Routes:             routes,
+		UIBaseURL:          "https://kubeflow.example.com/pipeline/",
+		DedupWindow:        time.Hour,
+		RateLimitPerMinute: 2,
+		Timeout:            time.Second,
+		QueueSize:          10,
+	}
+}
+
+func TestNotifier(t *testing.T) {
+	server := newMessageServer(t)
+	defer server.Close()
+	notifier, err := NewNotifier(newPolicy(
+		common.NotificationRoute{Namespace: "ns1", Events: []string{EventFailed}, Channel: ChannelSlack, URL: server.URL + "/slack"},
+		common.NotificationRoute{ExperimentID: "exp1", Channel: ChannelTeams, URL: server.URL + "/teams"},
+		common.NotificationRoute{Channel: ChannelWebhook, URL: server.URL + "/webhook",
+			Template: `{{.RunName}} is {{.Event}}`},
+	), util.NewFakeTimeForEpoch())
+	assert.Nil(t, err)
+
+	assert.Nil(t, notifier.Notify(&Notification{Event: EventFailed, RunID: "run1", RunName: "my run",
+		Namespace: "ns1", ExperimentID: "exp1", State: "Failed", Duration: 90 * time.Second}))
+	assert.Nil(t, notifier.Notify(&Notification{Event: EventSucceeded, RunID: "run2", RunName: "other run",
+		Namespace: "ns2", ExperimentID: "exp2", State: "Succeeded", Duration: time.Minute}))
+	assert.Nil(t, notifier.Close())
+
+	assert.Equal(t, []map[string]interface{}{
+		{"text": `Run "my run" in ns1 failed with state Failed after 1m30s. https://kubeflow.example.com/pipeline/#/runs/details/run1`},
+	}, server.Messages("/slack"))
+	assert.Equal(t, []map[string]interface{}{{
+		"@type":      "MessageCard",
+		"@context":   "https://schema.org/extensions",
+		"summary":    `Run "my run" in ns1 failed with state Failed after 1m30s. https://kubeflow.example.com/pipeline/#/runs/details/run1`,
+		"themeColor": "D00000",
+		"text":       `Run "my run" in ns1 failed with state Failed after 1m30s. https://kubeflow.example.com/pipeline/#/runs/details/run1`,
+	}}, server.Messages("/teams"))
+	webhookMessages := server.Messages("/webhook")
+	assert.Equal(t, 2, len(webhookMessages))
+	assert.Equal(t, map[string]interface{}{
+		"event":            "succeeded",
+		"run_id":           "run2",
+		"run_name":         "other run",
+		"namespace":        "ns2",
+		"experiment_id":    "exp2",
+		"state":            "Succeeded",
+		"duration_seconds": float64(60),
+		"run_url":          "https://kubeflow.example.com/pipeline/#/runs/details/run2",
+		"message":          "other run is succeeded",
+	}, webhookMessages[1])
+}
+
+func TestNotifier_DedupAndRateLimit(t *testing.T) {
+	server := newMessageServer(t)
+	defer server.Close()
+	notifier, err := NewNotifier(newPolicy(
+		common.NotificationRoute{Channel: ChannelSlack, URL: server.URL + "/slack"},
+	), util.NewFakeTimeForEpoch())
+	assert.Nil(t, err)
+
+	// The same notification of a run is sent once.
+	assert.Nil(t, notifier.Notify(&Notification{Event: EventLongRunning, RunID: "run1"}))
+	assert.Nil(t, notifier.Notify(&Notification{Event: EventLongRunning, RunID: "run1"}))
+	assert.Nil(t, notifier.Notify(&Notification{Event: EventFailed, RunID: "run1"}))
+	// The burst of the route is spent.
+	assert.Nil(t, notifier.Notify(&Notification{Event: EventFailed, RunID: "run2"}))
+	assert.Nil(t, notifier.Close())
+
+	assert.Equal(t, 2, len(server.Messages("/slack")))
+}
+
+func TestNewNotifier_InvalidRoutes(t *testing.T) {
+	notifier, err := NewNotifier(newPolicy(), util.NewFakeTimeForEpoch())
+	assert.Nil(t, err)
+	assert.Nil(t, notifier)
+
+	_, err = NewNotifier(newPolicy(common.NotificationRoute{Channel: "email", URL: "http://mail"}), util.NewFakeTimeForEpoch())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `Channel "email" of notification route route-0 is not supported`)
+
+	_, err = NewNotifier(newPolicy(common.NotificationRoute{Name: "ops", Channel: ChannelSlack}), util.NewFakeTimeForEpoch())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Notification route ops has no URL")
+
+	_, err = NewNotifier(newPolicy(common.NotificationRoute{Name: "ops", Channel: ChannelSlack, URL: "http://slack",
+		Events: []string{"created"}}), util.NewFakeTimeForEpoch())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `Event "created" of notification route ops is not supported`)
+
+	_, err = NewNotifier(newPolicy(common.NotificationRoute{Name: "ops", Channel: ChannelSlack, URL: "http://slack",
+		Template: "{{.RunName"}), util.NewFakeTimeForEpoch())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Invalid template of notification route ops")
+}
+
+func TestEventOfState(t *testing.T) {
+	assert.Equal(t, EventSucceeded, EventOfState("Succeeded"))
+	assert.Equal(t, EventSucceeded, EventOfState("Completed"))
+	assert.Equal(t, EventFailed, EventOfState("Failed"))
+	assert.Equal(t, EventFailed, EventOfState("PipelineRunTimeout"))
+	assert.Equal(t, "", EventOfState("Cancelled"))
+	assert.Equal(t, "", EventOfState("Running"))
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+)
+
+const (
+	ScopeUser      = "user"
+	ScopeNamespace = "namespace"
+)
+
+// Buckets of clients idle for longer than this are dropped, so the number of
+// buckets doesn't grow with every client ever seen.
+const idleTimeout = 10 * time.Minute
+
+// Metric variables. Please prefix the metric names with rate_limiter_.
+var (
+	allowedRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rate_limiter_allowed_requests",
+		Help: "The number of requests allowed by the rate limiter",
+	})
+
+	rejectedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rate_limiter_rejected_requests",
+		Help: "The number of requests rejected by the rate limiter, by the scope of the exhausted limit",
+	}, []string{"scope"})
+)
+
+// keyedLimiter keeps a token bucket per key.
+type keyedLimiter struct {
+	limit rate.Limit
+	burst int
+	now   func() time.Time
+
+	mutex     sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newKeyedLimiter(qps float64, burst int, now func() time.Time) *keyedLimiter {
+	return &keyedLimiter{
+		limit:     rate.Limit(qps),
+		burst:     burst,
+		now:       now,
+		buckets:   make(map[string]*bucket),
+		lastSweep: now(),
+	}
+}
+
+func (l *keyedLimiter) allow(key string) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.now()
+	if now.Sub(l.lastSweep) > idleTimeout {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > idleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter.AllowN(now, 1)
+}
+
+// RateLimiter limits the requests per user and per namespace with token buckets.
+// A zero QPS disables the corresponding limit.
+type RateLimiter struct {
+	user      *keyedLimiter
+	namespace *keyedLimiter
+	// The networks of the proxies whose X-Forwarded-For entries are trusted.
+	trustedProxies []*net.IPNet
+}
+
+// NewRateLimiter returns a rate limiter trusting the X-Forwarded-For entries added
+// by the proxies in the trustedProxies CIDRs.
+func NewRateLimiter(userQPS float64, userBurst int, namespaceQPS float64, namespaceBurst int, trustedProxies []string) (*RateLimiter, error) {
+	limiter := newRateLimiter(userQPS, userBurst, namespaceQPS, namespaceBurst, time.Now)
+	for _, cidr := range trustedProxies {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted proxy CIDR %q", cidr)
+		}
+		limiter.trustedProxies = append(limiter.trustedProxies, network)
+	}
+	return limiter, nil
+}
+
+func newRateLimiter(userQPS float64, userBurst int, namespaceQPS float64, namespaceBurst int, now func() time.Time) *RateLimiter {
+	limiter := &RateLimiter{}
+	if userQPS > 0 {
+		limiter.user = newKeyedLimiter(userQPS, userBurst, now)
+	}
+	if namespaceQPS > 0 {
+		limiter.namespace = newKeyedLimiter(namespaceQPS, namespaceBurst, now)
+	}
+	return limiter
+}
+
+// Allow reports whether a request of the user in the namespace is allowed, and if
+// not, the scope of the exhausted limit. Requests without a namespace are only
+// limited per user.
+func (l *RateLimiter) Allow(user string, namespace string) (bool, string) {
+	if l.user != nil && !l.user.allow(user) {
+		rejectedRequests.WithLabelValues(ScopeUser).Inc()
+		return false, ScopeUser
+	}
+	if l.namespace != nil && namespace != "" && !l.namespace.allow(namespace) {
+		rejectedRequests.WithLabelValues(ScopeNamespace).Inc()
+		return false, ScopeNamespace
+	}
+	allowedRequests.Inc()
+	return true, ""
+}
+
+// Enabled reports whether any limit is configured.
+func (l *RateLimiter) Enabled() bool {
+	return l.user != nil || l.namespace != nil
+}
+
+// ClientAddress returns the address of the client of a request received from
+// remoteAddr, which identifies the clients without a user identity. The
+// X-Forwarded-For entries are read from the last one, and only trusted as long as
+// the hop which added them is a trusted proxy, so that clients can't pick their
+// bucket by sending the header. The loopback addresses are always trusted, since
+// the HTTP gateway and the sidecar proxies forward the requests from them.
+func (l *RateLimiter) ClientAddress(remoteAddr string, forwardedFor []string) string {
+	client := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		client = host
+	}
+	var hops []string
+	for _, header := range forwardedFor {
+		for _, hop := range strings.Split(header, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+	for i := len(hops) - 1; i >= 0 && l.isTrustedProxy(client); i-- {
+		client = hops[i]
+	}
+	return client
+}
+
+func (l *RateLimiter) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, network := range l.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestRateLimiter_User(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	limiter := newRateLimiter(1, 2, 0, 0, clock.Now)
+
+	for i := 0; i < 2; i++ {
+		allowed, _ := limiter.Allow("user1", "ns1")
+		assert.True(t, allowed)
+	}
+	allowed, scope := limiter.Allow("user1", "ns1")
+	assert.False(t, allowed)
+	assert.Equal(t, ScopeUser, scope)
+
+	// Other users have their own bucket.
+	allowed, _ = limiter.Allow("user2", "ns1")
+	assert.True(t, allowed)
+
+	// Tokens are refilled over time.
+	clock.now = clock.now.Add(time.Second)
+	allowed, _ = limiter.Allow("user1", "ns1")
+	assert.True(t, allowed)
+}
+
+func TestRateLimiter_Namespace(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	limiter := newRateLimiter(100, 100, 1, 1, clock.Now)
+
+	allowed, _ := limiter.Allow("user1", "ns1")
+	assert.True(t, allowed)
+	allowed, scope := limiter.Allow("user2", "ns1")
+	assert.False(t, allowed)
+	assert.Equal(t, ScopeNamespace, scope)
+
+	allowed, _ = limiter.Allow("user2", "ns2")
+	assert.True(t, allowed)
+	// Requests without a namespace are only limited per user.
+	allowed, _ = limiter.Allow("user2", "")
+	assert.True(t, allowed)
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	limiter, err := NewRateLimiter(0, 0, 0, 0, nil)
+	assert.Nil(t, err)
+	assert.False(t, limiter.Enabled())
+	for i := 0; i < 100; i++ {
+		allowed, _ := limiter.Allow("user1", "ns1")
+		assert.True(t, allowed)
+	}
+}
+
+func TestRateLimiter_EvictsIdleBuckets(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	limiter := newRateLimiter(1, 1, 0, 0, clock.Now)
+	limiter.Allow("user1", "")
+	limiter.Allow("user2", "")
+	assert.Len(t, limiter.user.buckets, 2)
+
+	clock.now = clock.now.Add(idleTimeout + time.Second)
+	limiter.Allow("user1", "")
+	assert.Len(t, limiter.user.buckets, 1)
+}
+
+func TestRateLimiter_ClientAddress(t *testing.T) {
+	limiter, err := NewRateLimiter(1, 1, 0, 0, []string{"10.0.0.0/8"})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		expected     string
+	}{
+		{"direct client", "192.168.1.1:5000", nil, "192.168.1.1"},
+		{"untrusted client forging the header", "192.168.1.1:5000", []string{"1.2.3.4"}, "192.168.1.1"},
+		{"through the gateway", "127.0.0.1:5000", []string{"192.168.1.1"}, "192.168.1.1"},
+		{"through the gateway and a trusted proxy", "127.0.0.1:5000", []string{"1.2.3.4, 192.168.1.1, 10.0.0.1"}, "192.168.1.1"},
+		{"through a trusted proxy in several headers", "10.0.0.2:5000", []string{"1.2.3.4", "192.168.1.1"}, "192.168.1.1"},
+		{"trusted proxies only", "127.0.0.1:5000", []string{"10.0.0.1"}, "10.0.0.1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, limiter.ClientAddress(test.remoteAddr, test.forwardedFor))
+		})
+	}
+
+	_, err = NewRateLimiter(1, 1, 0, 0, []string{"10.0.0.1"})
+	assert.NotNil(t, err)
+}
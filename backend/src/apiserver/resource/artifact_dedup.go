@@ -0,0 +1,224 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"path"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The deduplicated artifacts are stored under the digest of their content, in
+// a folder per namespace so that the namespace encryption keys still apply. The
+// folder name isn't a valid Kubernetes name, so it can't clash with the
+// artifacts of a run.
+const artifactBlobPrefix = "artifacts/.sha256"
+
+type ArtifactDedupReport struct {
+	// The number of artifacts moved to the content addressed storage.
+	Artifacts int `json:"artifacts"`
+	// The number of those artifacts whose content was already stored.
+	DuplicateArtifacts int   `json:"duplicate_artifacts"`
+	SavedSize          int64 `json:"saved_size"`
+}
+
+func artifactBlobKey(namespace string, digest string) string {
+	return path.Join(artifactBlobPrefix, namespace, digest)
+}
+
+// DeduplicateArtifacts moves the artifacts of the finished runs to the content
+// addressed storage, so that identical outputs across runs are stored once. The
+// original objects are replaced by references in the database, which count the
+// users of each stored content.
+func (r *ResourceManager) DeduplicateArtifacts() (*ArtifactDedupReport, error) {
+	report := &ArtifactDedupReport{}
+	opts, err := list.NewOptions(&model.Run{}, artifactGCPageSize, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		runs, _, nextPageToken, err := r.runStore.ListRuns(&common.FilterContext{IncludeRunArchive: true}, opts)
+		if err != nil {
+			return report, util.Wrap(err, "Failed to list the runs to deduplicate the artifacts")
+		}
+		for _, run := range runs {
+			if run.FinishedAtInSec == 0 || run.Name == "" {
+				continue
+			}
+			objectStore, err := r.objectStore.ForNamespace(run.Namespace)
+			if err != nil {
+				return report, err
+			}
+			objects, err := objectStore.ListFiles("artifacts/" + run.Name + "/")
+			if err != nil {
+				return report, util.Wrapf(err, "Failed to list the artifacts of run %v", run.UUID)
+			}
+			for _, object := range objects {
+				duplicate, err := r.deduplicateArtifact(objectStore, run, object)
+				if err != nil {
+					return report, util.Wrapf(err, "Failed to deduplicate the artifact %v of run %v", object.Key, run.UUID)
+				}
+				report.Artifacts++
+				if duplicate {
+					report.DuplicateArtifacts++
+					report.SavedSize += object.Size
+				}
+				artifactDedupCounter.Inc()
+			}
+		}
+		if nextPageToken == "" {
+			return report, nil
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, artifactGCPageSize)
+		if err != nil {
+			return report, err
+		}
+	}
+}
+
+// deduplicateArtifact replaces an artifact by a reference to its content, and
+// returns whether the content was already stored.
+func (r *ResourceManager) deduplicateArtifact(objectStore storage.ObjectStoreInterface, run *model.Run,
+	object storage.ObjectInfo) (bool, error) {
+	digest, err := digestArtifact(objectStore, object.Key)
+	if err != nil {
+		return false, err
+	}
+	blobKey := artifactBlobKey(run.Namespace, digest)
+	currentBlobKey, err := r.artifactBlobStore.GetBlobKey(object.Key)
+	if err != nil {
+		return false, err
+	}
+	if currentBlobKey == blobKey {
+		// A previous deduplication stopped before deleting the original.
+		return false, objectStore.DeleteFile(object.Key)
+	}
+	if currentBlobKey != "" {
+		// The artifact was written again since it was deduplicated.
+		if _, err := r.dropArtifactReference(objectStore, object.Key); err != nil {
+			return false, err
+		}
+	}
+
+	found, err := r.artifactBlobStore.HasBlob(blobKey)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		if err := objectStore.CopyFile(object.Key, blobKey, run.Namespace); err != nil {
+			return false, err
+		}
+	}
+	err = r.artifactBlobStore.AddReference(&model.ArtifactReference{
+		ObjectKey:      object.Key,
+		BlobKey:        blobKey,
+		RunUUID:        run.UUID,
+		Size:           object.Size,
+		CreatedAtInSec: object.LastModified.Unix(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return found, objectStore.DeleteFile(object.Key)
+}
+
+func digestArtifact(objectStore storage.ObjectStoreInterface, objectKey string) (string, error) {
+	reader, err := objectStore.OpenFile(objectKey)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", util.NewInternalServerError(err, "Failed to read the artifact %v", objectKey)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// resolveArtifactKey returns the key under which the content of an artifact is
+// stored, which differs from the artifact key once it's deduplicated.
+func (r *ResourceManager) resolveArtifactKey(objectKey string) (string, error) {
+	blobKey, err := r.artifactBlobStore.GetBlobKey(objectKey)
+	if err != nil {
+		return "", err
+	}
+	if blobKey == "" {
+		return objectKey, nil
+	}
+	return blobKey, nil
+}
+
+// restoreArtifact copies the content of a deduplicated artifact back to its own
+// key and drops its reference, so that it can be overwritten without changing
+// the artifacts that share its content.
+func (r *ResourceManager) restoreArtifact(objectStore storage.ObjectStoreInterface, objectKey string, namespace string) error {
+	blobKey, err := r.artifactBlobStore.GetBlobKey(objectKey)
+	if err != nil || blobKey == "" {
+		return err
+	}
+	if err := objectStore.CopyFile(blobKey, objectKey, namespace); err != nil {
+		return err
+	}
+	_, err = r.dropArtifactReference(objectStore, objectKey)
+	return err
+}
+
+// deleteArtifact deletes an artifact, and its content if no other artifact
+// shares it.
+func (r *ResourceManager) deleteArtifact(objectStore storage.ObjectStoreInterface, objectKey string) error {
+	dropped, err := r.dropArtifactReference(objectStore, objectKey)
+	if err != nil || dropped {
+		return err
+	}
+	return objectStore.DeleteFile(objectKey)
+}
+
+// releaseRunArtifacts drops the references of the deduplicated artifacts of a
+// run, deleting the contents that aren't shared with other runs.
+func (r *ResourceManager) releaseRunArtifacts(objectStore storage.ObjectStoreInterface, runID string) error {
+	references, err := r.artifactBlobStore.ListRunReferences(runID)
+	if err != nil {
+		return err
+	}
+	for _, reference := range references {
+		if _, err := r.dropArtifactReference(objectStore, reference.ObjectKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropArtifactReference drops the reference of a deduplicated artifact, deleting
+// its content once it was the last reference. It returns whether the artifact
+// was deduplicated.
+func (r *ResourceManager) dropArtifactReference(objectStore storage.ObjectStoreInterface, objectKey string) (bool, error) {
+	blob, err := r.artifactBlobStore.DeleteReference(objectKey)
+	if err != nil || blob == nil {
+		return false, err
+	}
+	if blob.RefCount <= 0 {
+		if err := objectStore.DeleteFile(blob.BlobKey); err != nil {
+			return true, util.Wrapf(err, "Failed to delete the unreferenced artifact content %v", blob.BlobKey)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// initWithDuplicateArtifacts creates the finished runs run-1 and run-2 which
+// both output "abc", and the unfinished run-3 which outputs it too.
+func initWithDuplicateArtifacts(t *testing.T) (*FakeClientManager, *ResourceManager, *storage.FakeMinioClient) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	minioClient := storage.NewFakeMinioClient()
+	store.objectStore = storage.NewMinioObjectStore(minioClient, "", "pipelines", false)
+	manager := NewResourceManager(store)
+	for _, run := range []model.Run{
+		{UUID: "run1", Name: "run-1", Namespace: "ns1", FinishedAtInSec: 1},
+		{UUID: "run2", Name: "run-2", Namespace: "ns1", FinishedAtInSec: 1},
+		{UUID: "run3", Name: "run-3", Namespace: "ns1"},
+	} {
+		workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+			ObjectMeta: v1.ObjectMeta{Name: run.Name, Namespace: run.Namespace},
+			Status: tektonV1.PipelineRunStatus{PipelineRunStatusFields: tektonV1.PipelineRunStatusFields{
+				ChildReferences: []tektonV1.ChildStatusReference{{Name: run.Name + "-node", PipelineTaskName: "node"}},
+			}},
+		})
+		run.StorageState = "STORAGESTATE_AVAILABLE"
+		_, err := store.RunStore().CreateRun(&model.RunDetail{
+			Run:             run,
+			PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: workflow.ToStringForStore()},
+		})
+		assert.Nil(t, err)
+	}
+	for key, content := range map[string]string{
+		"artifacts/run-1/node/a.tgz": "abc",
+		"artifacts/run-2/node/a.tgz": "abc",
+		"artifacts/run-2/node/b.tgz": "de",
+		"artifacts/run-3/node/a.tgz": "abc",
+	} {
+		assert.Nil(t, store.objectStore.AddFile([]byte(content), key))
+	}
+	return store, manager, minioClient
+}
+
+func testArtifactBlobKey(content string) string {
+	digest := sha256.Sum256([]byte(content))
+	return artifactBlobKey("ns1", hex.EncodeToString(digest[:]))
+}
+
+func TestDeduplicateArtifacts(t *testing.T) {
+	store, manager, minioClient := initWithDuplicateArtifacts(t)
+	defer store.Close()
+
+	report, err := manager.DeduplicateArtifacts()
+	assert.Nil(t, err)
+	assert.Equal(t, &ArtifactDedupReport{Artifacts: 3, DuplicateArtifacts: 1, SavedSize: 3}, report)
+	assert.Equal(t, 3, minioClient.GetObjectCount())
+	assert.True(t, minioClient.ExistObject(testArtifactBlobKey("abc")))
+	assert.True(t, minioClient.ExistObject(testArtifactBlobKey("de")))
+	assert.True(t, minioClient.ExistObject("artifacts/run-3/node/a.tgz"))
+
+	// The artifacts are read from their content.
+	content, err := manager.ReadArtifact("run1", "node", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), content)
+
+	report, err = manager.DeduplicateArtifacts()
+	assert.Nil(t, err)
+	assert.Equal(t, &ArtifactDedupReport{}, report)
+
+	// The content is deleted with the last run that refers to it.
+	assert.Nil(t, manager.DeleteRun(context.Background(), "run1"))
+	assert.True(t, minioClient.ExistObject(testArtifactBlobKey("abc")))
+	assert.Nil(t, manager.DeleteRun(context.Background(), "run2"))
+	assert.False(t, minioClient.ExistObject(testArtifactBlobKey("abc")))
+	assert.False(t, minioClient.ExistObject(testArtifactBlobKey("de")))
+}
+
+func TestDeduplicateArtifacts_Upload(t *testing.T) {
+	store, manager, minioClient := initWithDuplicateArtifacts(t)
+	defer store.Close()
+	_, err := manager.DeduplicateArtifacts()
+	assert.Nil(t, err)
+
+	// Uploading an artifact restores it, without changing the other artifacts.
+	signedURL, err := manager.GetArtifactSignedURL("run1", "node", "a", http.MethodPut, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://minio//artifacts/run-1/node/a.tgz?expiry=3600", signedURL.URL)
+	assert.True(t, minioClient.ExistObject("artifacts/run-1/node/a.tgz"))
+	assert.Nil(t, store.objectStore.AddFile([]byte("xyz"), "artifacts/run-1/node/a.tgz"))
+	content, err := manager.ReadArtifact("run1", "node", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("xyz"), content)
+	content, err = manager.ReadArtifact("run2", "node", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), content)
+}
+
+func TestCollectExpiredArtifacts_Deduplicated(t *testing.T) {
+	store, manager, minioClient := initWithDuplicateArtifacts(t)
+	defer store.Close()
+	_, err := manager.DeduplicateArtifacts()
+	assert.Nil(t, err)
+
+	policy := &common.ArtifactRetentionPolicy{Default: common.ArtifactRetentionRule{MaxTotalSize: 1}}
+	report, err := manager.CollectExpiredArtifacts(policy, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(report.Artifacts))
+	assert.Equal(t, int64(8), report.TotalSize)
+	assert.Equal(t, 1, minioClient.GetObjectCount())
+	assert.True(t, minioClient.ExistObject("artifacts/run-3/node/a.tgz"))
+}
@@ -0,0 +1,92 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+)
+
+// SearchArtifacts lists the indexed artifacts matching the filter of the options.
+func (r *ResourceManager) SearchArtifacts(filterContext *common.FilterContext, opts *list.Options) ([]*model.ArtifactMetadata, int, string, error) {
+	return r.artifactMetadataStore.SearchArtifacts(filterContext, opts)
+}
+
+// indexRunArtifacts records the metadata of the output artifacts of a finished
+// run. The artifacts missing from the object store, such as those of the skipped
+// tasks, aren't indexed. The ids are derived from the object keys, so indexing a
+// run again keeps them.
+func (r *ResourceManager) indexRunArtifacts(runID string, workflow *util.Workflow) error {
+	outputs, err := workflow.OutputArtifacts()
+	if err != nil {
+		// Reporting the run again wouldn't fix the annotation.
+		log.Warningf("Skipping the artifact index of run %v: %v", runID, err)
+		return nil
+	}
+	if len(outputs) == 0 {
+		return nil
+	}
+	objectStore, err := r.objectStore.ForNamespace(workflow.Namespace)
+	if err != nil {
+		return err
+	}
+	objects, err := objectStore.ListFiles("artifacts/" + workflow.Name + "/")
+	if err != nil {
+		return util.Wrapf(err, "Failed to list the artifacts of run %v", runID)
+	}
+	sizes := map[string]int64{}
+	for _, object := range objects {
+		sizes[object.Key] = object.Size
+	}
+	createdAtInSec := workflow.FinishedAt()
+	if createdAtInSec == 0 {
+		createdAtInSec = r.time.Now().Unix()
+	}
+
+	var artifacts []*model.ArtifactMetadata
+	for _, output := range outputs {
+		size, ok := sizes[output.Key]
+		if !ok {
+			continue
+		}
+		var customProperties string
+		if len(output.Properties) > 0 {
+			properties, err := json.Marshal(output.Properties)
+			if err != nil {
+				return util.NewInternalServerError(err, "Failed to marshal the properties of artifact %v", output.Key)
+			}
+			customProperties = string(properties)
+		}
+		artifacts = append(artifacts, &model.ArtifactMetadata{
+			UUID:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(runID+"/"+output.Key)).String(),
+			Namespace:        workflow.Namespace,
+			RunUUID:          runID,
+			TaskName:         output.TaskName,
+			Name:             output.Name,
+			Type:             output.Type,
+			Size:             size,
+			ObjectKey:        output.Key,
+			CustomProperties: customProperties,
+			CreatedAtInSec:   createdAtInSec,
+		})
+	}
+	return r.artifactMetadataStore.IndexRunArtifacts(runID, artifacts)
+}
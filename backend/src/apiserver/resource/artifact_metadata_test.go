@@ -0,0 +1,103 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func searchAllArtifacts(t *testing.T, manager *ResourceManager) []*model.ArtifactMetadata {
+	opts, err := list.NewOptions(&model.ArtifactMetadata{}, 10, "name", nil)
+	assert.Nil(t, err)
+	artifacts, _, _, err := manager.SearchArtifacts(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	return artifacts
+}
+
+func TestIndexRunArtifacts(t *testing.T) {
+	store, manager, _ := initWithDuplicateArtifacts(t)
+	defer store.Close()
+	workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+		ObjectMeta: v1.ObjectMeta{Name: "run-2", Namespace: "ns1", Annotations: map[string]string{
+			util.AnnotationKeyOutputArtifacts: `{"node": [
+				{"key": "artifacts/$PIPELINERUN/node/a.tgz", "type": "Model", "properties": {"framework": "tensorflow"}},
+				{"key": "artifacts/$PIPELINERUN/node/b.tgz"},
+				{"key": "artifacts/$PIPELINERUN/node/skipped.tgz"}]}`,
+		}},
+	})
+
+	assert.Nil(t, manager.indexRunArtifacts("run2", workflow))
+
+	artifacts := searchAllArtifacts(t, manager)
+	assert.Len(t, artifacts, 2)
+	assert.Equal(t, &model.ArtifactMetadata{
+		UUID:             artifacts[0].UUID,
+		Namespace:        "ns1",
+		RunUUID:          "run2",
+		TaskName:         "node",
+		Name:             "a",
+		Type:             "Model",
+		Size:             3,
+		ObjectKey:        "artifacts/run-2/node/a.tgz",
+		CustomProperties: `{"framework":"tensorflow"}`,
+		CreatedAtInSec:   manager.time.Now().Unix(),
+	}, artifacts[0])
+	assert.Equal(t, "b", artifacts[1].Name)
+	assert.Equal(t, int64(2), artifacts[1].Size)
+
+	// Indexing the run again keeps the ids.
+	assert.Nil(t, manager.indexRunArtifacts("run2", workflow))
+	assert.Equal(t, artifacts, searchAllArtifacts(t, manager))
+
+	filterProto := &api.Filter{Predicates: []*api.Predicate{{
+		Key:   "size",
+		Op:    api.Predicate_GREATER_THAN,
+		Value: &api.Predicate_LongValue{LongValue: 2},
+	}}}
+	opts, err := list.NewOptions(&model.ArtifactMetadata{}, 10, "", filterProto)
+	assert.Nil(t, err)
+	found, totalSize, _, err := manager.SearchArtifacts(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns1"}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, "a", found[0].Name)
+
+	assert.Nil(t, manager.DeleteRun(context.Background(), "run2"))
+	assert.Empty(t, searchAllArtifacts(t, manager))
+}
+
+func TestIndexRunArtifacts_InvalidAnnotation(t *testing.T) {
+	store, manager, _ := initWithDuplicateArtifacts(t)
+	defer store.Close()
+	workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+		ObjectMeta: v1.ObjectMeta{Name: "run-2", Namespace: "ns1", Annotations: map[string]string{
+			util.AnnotationKeyOutputArtifacts: `{"node": "a"}`,
+		}},
+	})
+
+	// A run with an invalid annotation is persisted without indexing its artifacts.
+	assert.Nil(t, manager.indexRunArtifacts("run2", workflow))
+	assert.Empty(t, searchAllArtifacts(t, manager))
+}
@@ -0,0 +1,591 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	ArtifactContentTypeCSV     = "text/csv"
+	ArtifactContentTypeJSON    = "application/json"
+	ArtifactContentTypeParquet = "application/vnd.apache.parquet"
+
+	// The parquet schema is stored in the footer of the file, so up to this many
+	// trailing bytes are kept in memory for parquet artifacts.
+	maxParquetFooterSize = 1 << 20
+	previewChunkSize     = 32 * 1024
+)
+
+var parquetMagic = []byte("PAR1")
+
+// ParquetColumn is a leaf column of a parquet schema. Nested columns are named
+// by their dotted path.
+type ParquetColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ArtifactPreview holds the first and last bytes of an artifact together with
+// what could be inferred about its content, so that it can be shown without
+// downloading the whole file.
+type ArtifactPreview struct {
+	FileName string `json:"file_name,omitempty"`
+	// Size of the file in bytes, or -1 if it is unknown.
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+	Head        []byte `json:"head"`
+	Tail        []byte `json:"tail,omitempty"`
+	// Truncated is set when head and tail do not cover the whole file.
+	Truncated bool            `json:"truncated"`
+	Columns   []string        `json:"columns,omitempty"`
+	Keys      []string        `json:"keys,omitempty"`
+	Schema    []ParquetColumn `json:"schema,omitempty"`
+}
+
+// previewArtifact reads the first headSize and last tailSize bytes of an
+// artifact. Artifacts are archived as gzipped tarballs, in which case the first
+// regular file of the archive is previewed; any other content is previewed as is.
+// The stream is only read to the end when a tail is requested or the footer of a
+// parquet file is needed.
+func previewArtifact(reader io.Reader, headSize int, tailSize int) (*ArtifactPreview, error) {
+	name, size, content, err := openArtifactContent(reader)
+	if err != nil {
+		return nil, err
+	}
+	head := make([]byte, headSize)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, util.NewInternalServerError(err, "failed to read the artifact content")
+	}
+	head = head[:n]
+	preview := &ArtifactPreview{FileName: name, Size: size, Head: head}
+
+	isParquet := bytes.HasPrefix(head, parquetMagic)
+	keep := tailSize
+	if isParquet && keep < maxParquetFooterSize {
+		keep = maxParquetFooterSize
+	}
+	// remaining holds the trailing bytes of the file that follow the head, and
+	// skipped is how many bytes were dropped between the two.
+	var remaining []byte
+	var skipped int64
+	complete := n < headSize || int64(n) == size
+	if !complete && keep > 0 {
+		remaining, skipped, err = readTail(content, keep)
+		if err != nil {
+			return nil, err
+		}
+		complete = true
+	}
+	if complete && preview.Size < 0 {
+		preview.Size = int64(len(head)) + skipped + int64(len(remaining))
+	}
+	preview.Tail = lastBytes(remaining, tailSize)
+	preview.Truncated = !complete || skipped+int64(len(remaining)-len(preview.Tail)) > 0
+
+	switch {
+	case isParquet:
+		preview.ContentType = ArtifactContentTypeParquet
+		footer := remaining
+		if skipped == 0 {
+			footer = append(append([]byte{}, head...), remaining...)
+		}
+		// The schema is optional in a preview, so a footer that cannot be
+		// parsed still yields the raw bytes.
+		preview.Schema, _ = parseParquetSchema(footer)
+	case looksLikeJSON(head):
+		preview.ContentType = ArtifactContentTypeJSON
+		preview.Keys = sniffJSONKeys(head)
+	default:
+		if columns := sniffCSVColumns(name, head); columns != nil {
+			preview.ContentType = ArtifactContentTypeCSV
+			preview.Columns = columns
+		} else {
+			preview.ContentType = http.DetectContentType(head)
+		}
+	}
+	return preview, nil
+}
+
+// openArtifactContent returns the name, size and content of the file to preview.
+func openArtifactContent(reader io.Reader) (string, int64, io.Reader, error) {
+	buffered := bufio.NewReader(reader)
+	magic, _ := buffered.Peek(2)
+	if !bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		return "", -1, buffered, nil
+	}
+	gzipReader, err := gzip.NewReader(buffered)
+	if err != nil {
+		return "", 0, nil, util.NewInternalServerError(err, "failed to decompress the artifact")
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			// A gzipped file that is not a tarball.
+			return "", 0, nil, util.NewInvalidInputError("the artifact archive contains no file")
+		}
+		if err != nil {
+			return "", 0, nil, util.NewInternalServerError(err, "failed to read the artifact archive")
+		}
+		if header.Typeflag == tar.TypeReg || header.Typeflag == tar.TypeRegA {
+			return path.Base(header.Name), header.Size, tarReader, nil
+		}
+	}
+}
+
+// readTail reads the reader to the end and returns its last size bytes along
+// with the number of bytes that were dropped before them.
+func readTail(reader io.Reader, size int) ([]byte, int64, error) {
+	var skipped int64
+	buffer := make([]byte, 0, size+previewChunkSize)
+	chunk := make([]byte, previewChunkSize)
+	for {
+		n, err := reader.Read(chunk)
+		buffer = append(buffer, chunk[:n]...)
+		if len(buffer) > size+previewChunkSize {
+			drop := len(buffer) - size
+			skipped += int64(drop)
+			buffer = buffer[:copy(buffer, buffer[drop:])]
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, util.NewInternalServerError(err, "failed to read the artifact content")
+		}
+	}
+	if len(buffer) > size {
+		drop := len(buffer) - size
+		skipped += int64(drop)
+		buffer = buffer[drop:]
+	}
+	return buffer, skipped, nil
+}
+
+func lastBytes(data []byte, size int) []byte {
+	if len(data) <= size {
+		return data
+	}
+	return data[len(data)-size:]
+}
+
+func looksLikeJSON(head []byte) bool {
+	trimmed := bytes.TrimLeft(head, " \t\r\n")
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+// sniffJSONKeys returns the top level keys of a JSON object, or of the first
+// element of a JSON array. Keys are collected until the head is exhausted.
+func sniffJSONKeys(head []byte) []string {
+	decoder := json.NewDecoder(bytes.NewReader(head))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil
+	}
+	if token == json.Delim('[') {
+		if token, err = decoder.Token(); err != nil {
+			return nil
+		}
+	}
+	if token != json.Delim('{') {
+		return nil
+	}
+	var keys []string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		key, ok := token.(string)
+		if !ok {
+			break
+		}
+		keys = append(keys, key)
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			break
+		}
+	}
+	return keys
+}
+
+// sniffCSVColumns returns the header of a CSV or TSV file. Files without a
+// .csv or .tsv extension are only treated as such when their first two records
+// have the same number of fields.
+func sniffCSVColumns(name string, head []byte) []string {
+	ext := strings.ToLower(path.Ext(name))
+	firstLine := head
+	if i := bytes.IndexByte(head, '\n'); i >= 0 {
+		firstLine = head[:i]
+	}
+	reader := csv.NewReader(bytes.NewReader(head))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	if ext == ".tsv" || (ext != ".csv" && bytes.IndexByte(firstLine, '\t') >= 0 && bytes.IndexByte(firstLine, ',') < 0) {
+		reader.Comma = '\t'
+	}
+	header, err := reader.Read()
+	if err != nil {
+		return nil
+	}
+	if ext == ".csv" || ext == ".tsv" {
+		return header
+	}
+	record, err := reader.Read()
+	if err != nil || len(header) < 2 || len(record) != len(header) {
+		return nil
+	}
+	return header
+}
+
+var parquetTypes = []string{
+	"BOOLEAN", "INT32", "INT64", "INT96", "FLOAT", "DOUBLE", "BYTE_ARRAY", "FIXED_LEN_BYTE_ARRAY",
+}
+
+type parquetSchemaElement struct {
+	name        string
+	typ         string
+	numChildren int
+}
+
+// parseParquetSchema reads the leaf columns from the footer of a parquet file,
+// which ends with the thrift encoded FileMetaData, its length and the magic.
+func parseParquetSchema(data []byte) ([]ParquetColumn, error) {
+	if len(data) < 8 || !bytes.Equal(data[len(data)-4:], parquetMagic) {
+		return nil, util.NewInvalidInputError("the parquet footer is incomplete")
+	}
+	length := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	if length > len(data)-8 {
+		return nil, util.NewInvalidInputError("the parquet footer is incomplete")
+	}
+	reader := &thriftCompactReader{data: data[len(data)-8-length : len(data)-8]}
+	elements, err := reader.readParquetSchema()
+	if err != nil {
+		return nil, err
+	}
+	var columns []ParquetColumn
+	// The first element is the root of the schema tree, which is flattened
+	// depth first.
+	var walk func(index int, prefix string) int
+	walk = func(index int, prefix string) int {
+		element := elements[index]
+		name := element.name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		index++
+		if element.numChildren == 0 {
+			columns = append(columns, ParquetColumn{Name: name, Type: element.typ})
+			return index
+		}
+		for i := 0; i < element.numChildren && index < len(elements); i++ {
+			index = walk(index, name)
+		}
+		return index
+	}
+	if len(elements) > 0 {
+		index := 1
+		for i := 0; i < elements[0].numChildren && index < len(elements); i++ {
+			index = walk(index, "")
+		}
+	}
+	return columns, nil
+}
+
+// thriftCompactReader decodes just enough of the thrift compact protocol to
+// read the schema of a parquet FileMetaData struct.
+type thriftCompactReader struct {
+	data []byte
+	pos  int
+}
+
+const (
+	thriftStop      = 0
+	thriftTrue      = 1
+	thriftFalse     = 2
+	thriftByte      = 3
+	thriftI16       = 4
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftDouble    = 7
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftSet       = 10
+	thriftMap       = 11
+	thriftStruct    = 12
+	maxThriftDepth  = 64
+	fileMetaSchema  = 2
+	schemaType      = 1
+	schemaName      = 4
+	schemaNumFields = 5
+)
+
+var errThriftCorrupt = util.NewInvalidInputError("the parquet footer is corrupt")
+
+func (r *thriftCompactReader) readParquetSchema() ([]parquetSchemaElement, error) {
+	var lastID int16
+	for {
+		id, typ, err := r.readFieldHeader(lastID)
+		if err != nil {
+			return nil, err
+		}
+		if typ == thriftStop {
+			return nil, util.NewInvalidInputError("the parquet footer has no schema")
+		}
+		lastID = id
+		if id != fileMetaSchema || typ != thriftList {
+			if err := r.skip(typ, 0); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		size, elementType, err := r.readListHeader()
+		if err != nil {
+			return nil, err
+		}
+		if elementType != thriftStruct {
+			return nil, errThriftCorrupt
+		}
+		elements := make([]parquetSchemaElement, 0, size)
+		for i := 0; i < size; i++ {
+			element, err := r.readSchemaElement()
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, element)
+		}
+		return elements, nil
+	}
+}
+
+func (r *thriftCompactReader) readSchemaElement() (parquetSchemaElement, error) {
+	var element parquetSchemaElement
+	var lastID int16
+	for {
+		id, typ, err := r.readFieldHeader(lastID)
+		if err != nil {
+			return element, err
+		}
+		if typ == thriftStop {
+			return element, nil
+		}
+		lastID = id
+		switch {
+		case id == schemaType && typ == thriftI32:
+			value, err := r.readZigzag()
+			if err != nil {
+				return element, err
+			}
+			if value >= 0 && int(value) < len(parquetTypes) {
+				element.typ = parquetTypes[value]
+			}
+		case id == schemaName && typ == thriftBinary:
+			value, err := r.readBinary()
+			if err != nil {
+				return element, err
+			}
+			element.name = string(value)
+		case id == schemaNumFields && typ == thriftI32:
+			value, err := r.readZigzag()
+			if err != nil {
+				return element, err
+			}
+			if value < 0 || value > math.MaxInt32 {
+				return element, errThriftCorrupt
+			}
+			element.numChildren = int(value)
+		default:
+			if err := r.skip(typ, 0); err != nil {
+				return element, err
+			}
+		}
+	}
+}
+
+func (r *thriftCompactReader) readByte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, errThriftCorrupt
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftCompactReader) readVarint() (uint64, error) {
+	var value uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		b, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		value |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return value, nil
+		}
+	}
+	return 0, errThriftCorrupt
+}
+
+func (r *thriftCompactReader) readZigzag() (int64, error) {
+	value, err := r.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(value>>1) ^ -int64(value&1), nil
+}
+
+func (r *thriftCompactReader) readBinary() ([]byte, error) {
+	length, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	if length > uint64(len(r.data)-r.pos) {
+		return nil, errThriftCorrupt
+	}
+	value := r.data[r.pos : r.pos+int(length)]
+	r.pos += int(length)
+	return value, nil
+}
+
+func (r *thriftCompactReader) readFieldHeader(lastID int16) (int16, byte, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	typ := b & 0x0f
+	if typ == thriftStop {
+		return 0, thriftStop, nil
+	}
+	if delta := int16(b >> 4); delta != 0 {
+		return lastID + delta, typ, nil
+	}
+	id, err := r.readZigzag()
+	if err != nil {
+		return 0, 0, err
+	}
+	return int16(id), typ, nil
+}
+
+func (r *thriftCompactReader) readListHeader() (int, byte, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	size := uint64(b >> 4)
+	if size == 15 {
+		if size, err = r.readVarint(); err != nil {
+			return 0, 0, err
+		}
+	}
+	// Every element takes at least one byte.
+	if size > uint64(len(r.data)-r.pos) {
+		return 0, 0, errThriftCorrupt
+	}
+	return int(size), b & 0x0f, nil
+}
+
+func (r *thriftCompactReader) skip(typ byte, depth int) error {
+	if depth > maxThriftDepth {
+		return errThriftCorrupt
+	}
+	var err error
+	switch typ {
+	case thriftTrue, thriftFalse:
+	case thriftByte:
+		_, err = r.readByte()
+	case thriftI16, thriftI32, thriftI64:
+		_, err = r.readVarint()
+	case thriftDouble:
+		if len(r.data)-r.pos < 8 {
+			return errThriftCorrupt
+		}
+		r.pos += 8
+	case thriftBinary:
+		_, err = r.readBinary()
+	case thriftList, thriftSet:
+		size, elementType, err := r.readListHeader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < size; i++ {
+			if err := r.skipElement(elementType, depth+1); err != nil {
+				return err
+			}
+		}
+	case thriftMap:
+		size, err := r.readVarint()
+		if err != nil || size == 0 {
+			return err
+		}
+		types, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		if size > uint64(len(r.data)-r.pos) {
+			return errThriftCorrupt
+		}
+		for i := uint64(0); i < size; i++ {
+			if err := r.skipElement(types>>4, depth+1); err != nil {
+				return err
+			}
+			if err := r.skipElement(types&0x0f, depth+1); err != nil {
+				return err
+			}
+		}
+	case thriftStruct:
+		var lastID int16
+		for {
+			id, fieldType, err := r.readFieldHeader(lastID)
+			if err != nil {
+				return err
+			}
+			if fieldType == thriftStop {
+				return nil
+			}
+			lastID = id
+			if err := r.skip(fieldType, depth+1); err != nil {
+				return err
+			}
+		}
+	default:
+		return errThriftCorrupt
+	}
+	return err
+}
+
+// skipElement skips an element of a list, set or map, where booleans are
+// encoded as a byte rather than in the type.
+func (r *thriftCompactReader) skipElement(typ byte, depth int) error {
+	if typ == thriftTrue || typ == thriftFalse {
+		_, err := r.readByte()
+		return err
+	}
+	return r.skip(typ, depth)
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func tarGzip(t *testing.T, name string, content string) []byte {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	assert.Nil(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}))
+	_, err := tarWriter.Write([]byte(content))
+	assert.Nil(t, err)
+	assert.Nil(t, tarWriter.Close())
+	assert.Nil(t, gzipWriter.Close())
+	return buffer.Bytes()
+}
+
+// parquetFile builds a parquet file whose footer holds the schema
+// message schema { required int64 id; optional group info { optional binary name; } }.
+func parquetFile() []byte {
+	name := func(value string) []byte {
+		return append([]byte{byte(len(value))}, value...)
+	}
+	var footer []byte
+	// FileMetaData.version = 1, followed by the list header of 4 schema elements.
+	footer = append(footer, 0x15, 0x02, 0x19, 0x4c)
+	// Root: name = "schema", num_children = 2.
+	footer = append(append(append(footer, 0x48), name("schema")...), 0x15, 0x04, 0x00)
+	// Leaf: type = INT64, repetition_type = REQUIRED, name = "id".
+	footer = append(append(append(footer, 0x15, 0x04, 0x25, 0x00, 0x18), name("id")...), 0x00)
+	// Group: name = "info", num_children = 1, logicalType = {} is skipped.
+	footer = append(append(append(footer, 0x48), name("info")...), 0x15, 0x02, 0x5c, 0x00, 0x00)
+	// Leaf: type = BYTE_ARRAY, repetition_type = OPTIONAL, name = "name".
+	footer = append(append(append(footer, 0x15, 0x0c, 0x25, 0x02, 0x18), name("name")...), 0x00)
+	// FileMetaData.num_rows = 0, key_value_metadata = [{}] and stop.
+	footer = append(footer, 0x16, 0x00, 0x59, 0x1c, 0x00, 0x00)
+
+	file := append([]byte("PAR1"), bytes.Repeat([]byte{0xff}, 64)...)
+	file = append(file, footer...)
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(len(footer)))
+	return append(append(file, length...), "PAR1"...)
+}
+
+func TestPreviewArtifact_CSV(t *testing.T) {
+	content := "a,b,c\n" + strings.Repeat("1,2,3\n", 100)
+	preview, err := previewArtifact(bytes.NewReader(tarGzip(t, "dir/data.csv", content)), 8, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, &ArtifactPreview{
+		FileName:    "data.csv",
+		Size:        int64(len(content)),
+		ContentType: ArtifactContentTypeCSV,
+		Head:        []byte("a,b,c\n1,"),
+		Tail:        []byte("1,2,3\n"),
+		Truncated:   true,
+		Columns:     []string{"a", "b", "c"},
+	}, preview)
+}
+
+func TestPreviewArtifact_TSVWithoutExtension(t *testing.T) {
+	preview, err := previewArtifact(bytes.NewReader(tarGzip(t, "data", "x\ty\n1\t2\n")), 1024, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, ArtifactContentTypeCSV, preview.ContentType)
+	assert.Equal(t, []string{"x", "y"}, preview.Columns)
+	assert.False(t, preview.Truncated)
+}
+
+func TestPreviewArtifact_JSON(t *testing.T) {
+	content := `[{"x": 1, "y": [1, 2], "z": {"a": 1}}, {"x": 2}]`
+	preview, err := previewArtifact(strings.NewReader(content), 1024, 1024)
+	assert.Nil(t, err)
+	assert.Equal(t, ArtifactContentTypeJSON, preview.ContentType)
+	assert.Equal(t, []string{"x", "y", "z"}, preview.Keys)
+	assert.Equal(t, int64(len(content)), preview.Size)
+	assert.Equal(t, []byte(content), preview.Head)
+	assert.Empty(t, preview.Tail)
+	assert.False(t, preview.Truncated)
+}
+
+func TestPreviewArtifact_TruncatedJSON(t *testing.T) {
+	preview, err := previewArtifact(strings.NewReader(`{"x": 1, "y": "a long value"}`), 16, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, ArtifactContentTypeJSON, preview.ContentType)
+	assert.Equal(t, []string{"x", "y"}, preview.Keys)
+	assert.Equal(t, int64(-1), preview.Size)
+	assert.True(t, preview.Truncated)
+}
+
+func TestPreviewArtifact_Parquet(t *testing.T) {
+	content := parquetFile()
+	preview, err := previewArtifact(bytes.NewReader(tarGzip(t, "data.parquet", string(content))), 4, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, ArtifactContentTypeParquet, preview.ContentType)
+	assert.Equal(t, []ParquetColumn{{Name: "id", Type: "INT64"}, {Name: "info.name", Type: "BYTE_ARRAY"}}, preview.Schema)
+	assert.Equal(t, []byte("PAR1"), preview.Head)
+	assert.Empty(t, preview.Tail)
+	assert.True(t, preview.Truncated)
+}
+
+func TestPreviewArtifact_Text(t *testing.T) {
+	preview, err := previewArtifact(bytes.NewReader(tarGzip(t, "log.txt", "hello world")), 5, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "text/plain; charset=utf-8", preview.ContentType)
+	assert.Equal(t, []byte("hello"), preview.Head)
+	assert.Equal(t, []byte("rld"), preview.Tail)
+	assert.Equal(t, int64(11), preview.Size)
+	assert.True(t, preview.Truncated)
+}
+
+func TestParseParquetSchema_CorruptFooter(t *testing.T) {
+	content := parquetFile()
+	_, err := parseParquetSchema(content[len(content)-20:])
+	assert.NotNil(t, err)
+	_, err = parseParquetSchema([]byte("PAR1"))
+	assert.NotNil(t, err)
+}
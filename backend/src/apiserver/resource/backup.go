@@ -0,0 +1,241 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// The version of the format of the backups.
+const backupFormatVersion = 1
+
+// The kinds of the records of a backup.
+const (
+	BackupRecordHeader = "header"
+	BackupRecordRow    = "row"
+	// BackupRecordTemplate is a pipeline template, stored in the object store.
+	BackupRecordTemplate = "template"
+	// BackupRecordObject is the key of an artifact in the object store, which is
+	// copied along with the backup, e.g. by mirroring the bucket.
+	BackupRecordObject = "object"
+	// BackupRecordEnd marks the end of a complete backup.
+	BackupRecordEnd = "end"
+)
+
+// BackupRecord is a line of a backup, which is a sequence of JSON records: a
+// header, the rows of the tables, the templates, the keys of the artifacts and
+// an end marker, so that a truncated backup isn't restored.
+type BackupRecord struct {
+	Kind string `json:"kind"`
+	// The header.
+	FormatVersion int        `json:"format_version,omitempty"`
+	SchemaVersion int64      `json:"schema_version,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	// A row of a table.
+	Table string                 `json:"table,omitempty"`
+	Row   map[string]interface{} `json:"row,omitempty"`
+	// A template of a pipeline or pipeline version, and the namespace of the
+	// pipeline, or the key of an artifact.
+	ID        string `json:"id,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Content   []byte `json:"content,omitempty"`
+	Key       string `json:"key,omitempty"`
+}
+
+// BackupSummary counts what a backup holds, or what was restored.
+type BackupSummary struct {
+	SchemaVersion int64          `json:"schema_version"`
+	Rows          map[string]int `json:"rows"`
+	Templates     int            `json:"templates"`
+	Objects       int            `json:"objects"`
+}
+
+// Backup writes a consistent backup of the pipelines, runs, jobs and experiments
+// to the writer: the rows of their tables read from a snapshot of the database,
+// the templates of the pipelines, and the keys of the artifacts of the runs. The
+// artifacts themselves are copied with the tools of the object store.
+func (r *ResourceManager) Backup(ctx context.Context, w io.Writer) (*BackupSummary, error) {
+	schemaVersion, err := r.backupStore.SchemaVersion()
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to back up")
+	}
+	encoder := json.NewEncoder(w)
+	now := r.time.Now().UTC()
+	header := &BackupRecord{Kind: BackupRecordHeader, FormatVersion: backupFormatVersion, SchemaVersion: schemaVersion,
+		CreatedAt: &now}
+	if err := encoder.Encode(header); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to write the backup")
+	}
+
+	summary := &BackupSummary{SchemaVersion: schemaVersion, Rows: map[string]int{}}
+	var templateIDs, objectKeys []string
+	pipelineNamespaces := map[string]string{}
+	versionPipelines := map[string]string{}
+	err = r.backupStore.Backup(ctx, func(table string, row map[string]interface{}) error {
+		switch table {
+		case "pipelines":
+			id := backupRowString(row, "UUID")
+			pipelineNamespaces[id] = backupRowString(row, "Namespace")
+			templateIDs = append(templateIDs, id)
+		case "pipeline_versions":
+			id := backupRowString(row, "UUID")
+			versionPipelines[id] = backupRowString(row, "PipelineId")
+			templateIDs = append(templateIDs, id)
+		case "artifact_metadata":
+			objectKeys = append(objectKeys, backupRowString(row, "ObjectKey"))
+		case "artifact_blobs":
+			objectKeys = append(objectKeys, backupRowString(row, "BlobKey"))
+		}
+		summary.Rows[table]++
+		if err := encoder.Encode(&BackupRecord{Kind: BackupRecordRow, Table: table, Row: row}); err != nil {
+			return util.NewInternalServerError(err, "Failed to write the backup")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to back up")
+	}
+
+	// The default versions of the pipelines share their IDs, and their template.
+	written := map[string]bool{}
+	for _, id := range templateIDs {
+		if written[id] {
+			continue
+		}
+		written[id] = true
+		template, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(id))
+		if err != nil {
+			if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+				// The pipeline, or its version, was deleted after the snapshot.
+				log.Warnf("The template of %s isn't backed up: %v", id, err)
+				continue
+			}
+			return nil, util.Wrapf(err, "Failed to back up the template of %s", id)
+		}
+		namespace, ok := pipelineNamespaces[id]
+		if !ok {
+			namespace = pipelineNamespaces[versionPipelines[id]]
+		}
+		record := &BackupRecord{Kind: BackupRecordTemplate, ID: id, Namespace: namespace, Content: template}
+		if err := encoder.Encode(record); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to write the backup")
+		}
+		summary.Templates++
+	}
+	for _, key := range objectKeys {
+		if err := encoder.Encode(&BackupRecord{Kind: BackupRecordObject, Key: key}); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to write the backup")
+		}
+		summary.Objects++
+	}
+	if err := encoder.Encode(&BackupRecord{Kind: BackupRecordEnd}); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to write the backup")
+	}
+	return summary, nil
+}
+
+// Restore restores a backup into a fresh installation, keeping the IDs of the
+// resources. The backup replaces the sample pipelines and the default
+// experiment of the installation, and it's restored entirely or not at all.
+// The database schema has to be at the version of the backup, and the
+// installation has to use the same encryption key, if any.
+func (r *ResourceManager) Restore(ctx context.Context, reader io.Reader) (*BackupSummary, error) {
+	decoder := json.NewDecoder(reader)
+	// The numbers are restored as is, without a conversion to float64.
+	decoder.UseNumber()
+	var header BackupRecord
+	if err := decoder.Decode(&header); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to read the header of the backup")
+	}
+	if header.Kind != BackupRecordHeader || header.FormatVersion != backupFormatVersion {
+		return nil, util.NewInvalidInputError("Unsupported backup format %q version %v", header.Kind, header.FormatVersion)
+	}
+	schemaVersion, err := r.backupStore.SchemaVersion()
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to restore")
+	}
+	if header.SchemaVersion != schemaVersion {
+		return nil, util.NewFailedPreconditionError(errors.New("schema version mismatch"),
+			"The backup is of schema version %v, the database is at version %v. Restore it with the same release",
+			header.SchemaVersion, schemaVersion)
+	}
+
+	summary := &BackupSummary{SchemaVersion: schemaVersion}
+	complete := false
+	rows, err := r.backupStore.Restore(ctx, func() (string, map[string]interface{}, error) {
+		for {
+			var record BackupRecord
+			if err := decoder.Decode(&record); err != nil {
+				if err == io.EOF && !complete {
+					return "", nil, util.NewInvalidInputError("The backup is incomplete")
+				}
+				if err == io.EOF {
+					return "", nil, io.EOF
+				}
+				return "", nil, util.NewInvalidInputErrorWithDetails(err, "Failed to read the backup")
+			}
+			if complete {
+				return "", nil, util.NewInvalidInputError("Unexpected %q record after the end of the backup", record.Kind)
+			}
+			switch record.Kind {
+			case BackupRecordRow:
+				return record.Table, record.Row, nil
+			case BackupRecordTemplate:
+				// The templates are stored before the rows are committed, and
+				// overwritten when a failed restore is retried.
+				if record.ID == "" || strings.ContainsAny(record.ID, "/\\") {
+					return "", nil, util.NewInvalidInputError("Invalid template ID %q in the backup", record.ID)
+				}
+				err := r.objectStore.AddFileInNamespace(record.Content, r.objectStore.GetPipelineKey(record.ID), record.Namespace)
+				if err != nil {
+					return "", nil, util.Wrapf(err, "Failed to restore the template of %s", record.ID)
+				}
+				summary.Templates++
+			case BackupRecordObject:
+				summary.Objects++
+			case BackupRecordEnd:
+				complete = true
+			default:
+				return "", nil, util.NewInvalidInputError("Unknown record %q in the backup", record.Kind)
+			}
+		}
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to restore")
+	}
+	summary.Rows = rows
+	return summary, nil
+}
+
+// backupRowString returns the value of a column of a backed up row as a string.
+// PostgreSQL returns the names of the columns in lower case.
+func backupRowString(row map[string]interface{}, column string) string {
+	for name, value := range row {
+		if strings.EqualFold(name, column) && value != nil {
+			return fmt.Sprint(value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	store, manager, experiment, pipeline, runDetail := initWithExperimentAndPipelineAndRun(t)
+	defer store.Close()
+	template, err := manager.GetPipelineVersionTemplate(FakeUUIDOne)
+	assert.Nil(t, err)
+
+	var backup bytes.Buffer
+	summary, err := manager.Backup(context.Background(), &backup)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, summary.Rows["experiments"])
+	assert.Equal(t, 1, summary.Rows["pipelines"])
+	assert.Equal(t, 1, summary.Rows["run_details"])
+	assert.True(t, summary.Templates > 0)
+
+	restoreStore := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer restoreStore.Close()
+	restoreManager := NewResourceManager(restoreStore)
+	restored, err := restoreManager.Restore(context.Background(), bytes.NewReader(backup.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, summary.Rows, restored.Rows)
+	assert.Equal(t, summary.Templates, restored.Templates)
+
+	// The resources keep their IDs.
+	restoredExperiment, err := restoreManager.GetExperiment(experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, experiment.Name, restoredExperiment.Name)
+	restoredPipeline, err := restoreManager.GetPipeline(pipeline.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, pipeline.Name, restoredPipeline.Name)
+	restoredRun, err := restoreManager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, runDetail.DisplayName, restoredRun.DisplayName)
+	assert.Equal(t, runDetail.ExperimentUUID, restoredRun.ExperimentUUID)
+	restoredTemplate, err := restoreManager.GetPipelineVersionTemplate(FakeUUIDOne)
+	assert.Nil(t, err)
+	assert.Equal(t, template, restoredTemplate)
+
+	// A backup is only restored to a fresh installation.
+	_, err = restoreManager.Restore(context.Background(), bytes.NewReader(backup.Bytes()))
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestRestore_IncompleteBackup(t *testing.T) {
+	store, manager, _, _, runDetail := initWithExperimentAndPipelineAndRun(t)
+	defer store.Close()
+	var backup bytes.Buffer
+	_, err := manager.Backup(context.Background(), &backup)
+	assert.Nil(t, err)
+	lines := strings.SplitAfter(backup.String(), "\n")
+
+	restoreStore := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer restoreStore.Close()
+	restoreManager := NewResourceManager(restoreStore)
+	// The end record is missing.
+	truncated := strings.Join(lines[:len(lines)-2], "")
+	_, err = restoreManager.Restore(context.Background(), strings.NewReader(truncated))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "The backup is incomplete")
+
+	// Nothing was restored.
+	_, err = restoreManager.GetRun(runDetail.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	_, err = restoreManager.Restore(context.Background(), strings.NewReader(`{"kind": "header", "format_version": 2}`))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	_, err = restoreManager.Restore(context.Background(), strings.NewReader(`{"kind": "header", "format_version": 1, "schema_version": 1}`))
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+}
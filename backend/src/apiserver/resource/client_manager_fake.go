@@ -15,12 +15,16 @@
 package resource
 
 import (
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
 )
 
 // Converted argo v1alpha1.workflow to tekton v1beta1.pipelinerun
@@ -43,12 +47,25 @@ type FakeClientManager struct {
 	resourceReferenceStore        storage.ResourceReferenceStoreInterface
 	dBStatusStore                 storage.DBStatusStoreInterface
 	defaultExperimentStore        storage.DefaultExperimentStoreInterface
+	auditStore                    storage.AuditStoreInterface
+	AuditSinkFake                 audit.SinkInterface
+	EventPublisherFake            *eventbus.FakePublisher
+	NotifierFake                  *notification.FakeNotifier
+	leaseStore                    storage.LeaseStoreInterface
+	artifactBlobStore             storage.ArtifactBlobStoreInterface
+	uploadSessionStore            storage.UploadSessionStoreInterface
+	webhookStore                  storage.WebhookStoreInterface
+	backupStore                   storage.BackupStoreInterface
+	artifactMetadataStore         storage.ArtifactMetadataStoreInterface
 	objectStore                   storage.ObjectStoreInterface
 	swfClientFake                 *client.FakeSwfClient
 	k8sCoreClientFake             *client.FakeKuberneteCoreClient
 	SubjectAccessReviewClientFake client.SubjectAccessReviewInterface
 	tokenReviewClientFake         client.TokenReviewInterface
+	MetadataClientFake            *client.FakeMetadataClient
+	OCIRegistryClientFake         *client.FakeOCIRegistryClient
 	logArchive                    archive.LogArchiveInterface
+	RunEventRecorderFake          *record.FakeRecorder
 	time                          util.TimeInterface
 	uuid                          util.UUIDGeneratorInterface
 	AuthenticatorsFake            []auth.Authenticator
@@ -58,11 +75,11 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 	*FakeClientManager, error) {
 
 	if time == nil {
-		glog.Fatalf("The time parameter must not be null.") // Must never happen
+		log.Fatalf("The time parameter must not be null.") // Must never happen
 	}
 
 	if uuid == nil {
-		glog.Fatalf("The UUID generator must not be null.") // Must never happen
+		log.Fatalf("The UUID generator must not be null.") // Must never happen
 	}
 
 	// Initialize GORM
@@ -71,6 +88,8 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		return nil, err
 	}
 
+	auditStore := storage.NewAuditStore(db)
+
 	// TODO(neuromage): Pass in metadata.Store instance for tests as well.
 	return &FakeClientManager{
 		TektonClientFake:              client.NewFakeTektonClient(),
@@ -83,12 +102,25 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		resourceReferenceStore:        storage.NewResourceReferenceStore(db),
 		dBStatusStore:                 storage.NewDBStatusStore(db),
 		defaultExperimentStore:        storage.NewDefaultExperimentStore(db),
+		auditStore:                    auditStore,
+		AuditSinkFake:                 audit.NewDBSink(auditStore),
+		EventPublisherFake:            eventbus.NewFakePublisher(),
+		NotifierFake:                  notification.NewFakeNotifier(),
+		leaseStore:                    storage.NewLeaseStore(db, time),
+		artifactBlobStore:             storage.NewArtifactBlobStore(db),
+		uploadSessionStore:            storage.NewUploadSessionStore(db),
+		webhookStore:                  storage.NewWebhookStore(db),
+		backupStore:                   storage.NewBackupStore(db),
+		artifactMetadataStore:         storage.NewArtifactMetadataStore(db),
 		objectStore:                   storage.NewFakeObjectStore(),
 		swfClientFake:                 client.NewFakeSwfClient(),
 		k8sCoreClientFake:             client.NewFakeKuberneteCoresClient(),
 		SubjectAccessReviewClientFake: client.NewFakeSubjectAccessReviewClient(),
 		tokenReviewClientFake:         client.NewFakeTokenReviewClient(),
+		MetadataClientFake:            client.NewFakeMetadataClient(),
+		OCIRegistryClientFake:         client.NewFakeOCIRegistryClient(),
 		logArchive:                    archive.NewLogArchive("/logs", "main.log"),
+		RunEventRecorderFake:          record.NewFakeRecorder(100),
 		time:                          time,
 		uuid:                          uuid,
 		AuthenticatorsFake:            auth.GetAuthenticators(client.NewFakeTokenReviewClient()),
@@ -99,7 +131,7 @@ func NewFakeClientManagerOrFatal(time util.TimeInterface) *FakeClientManager {
 	uuid := util.NewFakeUUIDGeneratorOrFatal(DefaultFakeUUID, nil)
 	fakeStore, err := NewFakeClientManager(time, uuid)
 	if err != nil {
-		glog.Fatalf("The fake store doesn't create successfully. Fail fast.")
+		log.Fatalf("The fake store doesn't create successfully. Fail fast.")
 	}
 	return fakeStore
 }
@@ -113,7 +145,7 @@ func NewFakeClientManagerOrFatalV2() *FakeClientManager {
 	time := util.NewFakeTimeForEpoch()
 	fakeStore, err := NewFakeClientManager(time, uuid)
 	if err != nil {
-		glog.Fatalf("The fake store doesn't create successfully. Fail fast.")
+		log.Fatalf("The fake store doesn't create successfully. Fail fast.")
 	}
 	return fakeStore
 }
@@ -134,6 +166,10 @@ func (f *FakeClientManager) LogArchive() archive.LogArchiveInterface {
 	return f.logArchive
 }
 
+func (f *FakeClientManager) RunEventRecorder() record.EventRecorder {
+	return f.RunEventRecorderFake
+}
+
 func (f *FakeClientManager) Time() util.TimeInterface {
 	return f.time
 }
@@ -146,6 +182,10 @@ func (f *FakeClientManager) DB() *storage.DB {
 	return f.db
 }
 
+func (f *FakeClientManager) Encryptor() *storage.Encryptor {
+	return f.db.Encryptor()
+}
+
 func (f *FakeClientManager) TektonClient() client.TektonClientInterface {
 	return f.TektonClientFake
 }
@@ -174,6 +214,46 @@ func (f *FakeClientManager) DefaultExperimentStore() storage.DefaultExperimentSt
 	return f.defaultExperimentStore
 }
 
+func (f *FakeClientManager) AuditStore() storage.AuditStoreInterface {
+	return f.auditStore
+}
+
+func (f *FakeClientManager) LeaseStore() storage.LeaseStoreInterface {
+	return f.leaseStore
+}
+
+func (f *FakeClientManager) ArtifactBlobStore() storage.ArtifactBlobStoreInterface {
+	return f.artifactBlobStore
+}
+
+func (f *FakeClientManager) UploadSessionStore() storage.UploadSessionStoreInterface {
+	return f.uploadSessionStore
+}
+
+func (f *FakeClientManager) WebhookStore() storage.WebhookStoreInterface {
+	return f.webhookStore
+}
+
+func (f *FakeClientManager) BackupStore() storage.BackupStoreInterface {
+	return f.backupStore
+}
+
+func (f *FakeClientManager) ArtifactMetadataStore() storage.ArtifactMetadataStoreInterface {
+	return f.artifactMetadataStore
+}
+
+func (f *FakeClientManager) AuditSink() audit.SinkInterface {
+	return f.AuditSinkFake
+}
+
+func (f *FakeClientManager) EventPublisher() eventbus.PublisherInterface {
+	return f.EventPublisherFake
+}
+
+func (f *FakeClientManager) Notifier() notification.NotifierInterface {
+	return f.NotifierFake
+}
+
 func (f *FakeClientManager) SwfClient() client.SwfClientInterface {
 	return f.swfClientFake
 }
@@ -190,6 +270,20 @@ func (f *FakeClientManager) TokenReviewClient() client.TokenReviewInterface {
 	return f.tokenReviewClientFake
 }
 
+func (f *FakeClientManager) MetadataClient() client.MetadataClientInterface {
+	if f.MetadataClientFake == nil {
+		return nil
+	}
+	return f.MetadataClientFake
+}
+
+func (f *FakeClientManager) OCIRegistryClient() client.OCIRegistryClientInterface {
+	if f.OCIRegistryClientFake == nil {
+		return nil
+	}
+	return f.OCIRegistryClientFake
+}
+
 func (f *FakeClientManager) Authenticators() []auth.Authenticator {
 	return f.AuthenticatorsFake
 }
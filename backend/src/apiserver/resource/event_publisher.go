@@ -0,0 +1,147 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// publishEvent publishes a lifecycle event about the resource of the data, and
+// stores its deliveries to the webhook subscriptions it matches. The event is
+// published asynchronously, and a failure doesn't fail the request. It's a
+// no-op when no event publisher nor webhook subscription is configured.
+func (r *ResourceManager) publishEvent(eventType string, data eventbus.EventData) {
+	if !r.eventsEnabled() {
+		return
+	}
+	id, err := r.uuid.NewRandom()
+	if err != nil {
+		log.Warnf("Failed to create the id of event %s of %s %s: %v", eventType, data.ResourceType, data.ResourceID, err)
+		return
+	}
+	event := eventbus.NewEvent(id.String(), eventType, r.time.Now(), data)
+	if r.eventPublisher != nil {
+		if err := r.eventPublisher.Publish(event); err != nil {
+			log.Warnf("Failed to publish event %s of %s %s: %v", eventType, data.ResourceType, data.ResourceID, err)
+		}
+	}
+	r.enqueueWebhookDeliveries(event)
+}
+
+// eventsEnabled reports whether the events are published or delivered to any
+// webhook subscription.
+func (r *ResourceManager) eventsEnabled() bool {
+	return r.eventPublisher != nil || len(r.enabledWebhookSubscriptions()) > 0
+}
+
+// publishRunEvent publishes an event about the stored run, e.g. with the state
+// it finished in.
+func (r *ResourceManager) publishRunEvent(eventType string, runID string, state string, previousState string) {
+	if !r.eventsEnabled() {
+		return
+	}
+	run, err := r.runStore.GetRun(runID)
+	if err != nil {
+		log.Warnf("Failed to get run %s to publish event %s: %v", runID, eventType, err)
+		return
+	}
+	data := runEventData(&run.Run)
+	data.State = state
+	data.PreviousState = previousState
+	r.publishEvent(eventType, data)
+}
+
+// storedRunCondition returns the condition of the stored run, and whether the
+// run is stored, so that the changes reported by the persistence agent can be
+// published. The run is only read when events are published.
+func (r *ResourceManager) storedRunCondition(runID string) (string, bool) {
+	if !r.eventsEnabled() {
+		return "", true
+	}
+	run, err := r.runStore.GetRun(runID)
+	if err != nil {
+		return "", !util.IsUserErrorCodeMatch(err, codes.NotFound)
+	}
+	return run.Conditions, true
+}
+
+func runEventData(run *model.Run) eventbus.EventData {
+	data := eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeRun,
+		ResourceID:   run.UUID,
+		Name:         run.DisplayName,
+		Namespace:    run.Namespace,
+		ExperimentID: run.ExperimentUUID,
+	}
+	for _, ref := range run.ResourceReferences {
+		if ref.ReferenceType == common.Job {
+			data.JobID = ref.ReferenceUUID
+		}
+	}
+	return data
+}
+
+func jobEventData(job *model.Job) eventbus.EventData {
+	data := eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeJob,
+		ResourceID:   job.UUID,
+		Name:         job.DisplayName,
+		Namespace:    job.Namespace,
+	}
+	for _, ref := range job.ResourceReferences {
+		if ref.ReferenceType == common.Experiment {
+			data.ExperimentID = ref.ReferenceUUID
+		}
+	}
+	return data
+}
+
+func pipelineEventData(pipeline *model.Pipeline) eventbus.EventData {
+	return eventbus.EventData{
+		ResourceType: eventbus.ResourceTypePipeline,
+		ResourceID:   pipeline.UUID,
+		Name:         pipeline.Name,
+		Namespace:    pipeline.Namespace,
+	}
+}
+
+func experimentEventData(experiment *model.Experiment) eventbus.EventData {
+	return eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeExperiment,
+		ResourceID:   experiment.UUID,
+		Name:         experiment.Name,
+		Namespace:    experiment.Namespace,
+	}
+}
+
+// pipelineVersionEventData describes the version, and the namespace of its
+// pipeline when known.
+func pipelineVersionEventData(version *model.PipelineVersion, pipeline *model.Pipeline) eventbus.EventData {
+	data := eventbus.EventData{
+		ResourceType: eventbus.ResourceTypePipelineVersion,
+		ResourceID:   version.UUID,
+		Name:         version.Name,
+		PipelineID:   version.PipelineId,
+	}
+	if pipeline != nil {
+		data.Namespace = pipeline.Namespace
+	}
+	return data
+}
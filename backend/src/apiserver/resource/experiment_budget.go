@@ -0,0 +1,160 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+	"math"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// ExperimentBudget limits the runs created in an experiment. A zero limit is
+// unlimited.
+type ExperimentBudget struct {
+	MaxRuns int64 `json:"max_runs"`
+	// MaxTaskHours limits the cumulative duration of the finished tasks of the runs.
+	MaxTaskHours float64 `json:"max_task_hours"`
+	// Enforcement is either "block", which rejects the new runs and jobs once the
+	// budget is used up, or "warn", which creates them with a warning.
+	Enforcement string `json:"enforcement"`
+}
+
+// ExperimentBudgetStatus is the budget of an experiment along with what its runs
+// consumed.
+type ExperimentBudgetStatus struct {
+	Budget    *ExperimentBudget `json:"budget"`
+	RunCount  int64             `json:"run_count"`
+	TaskHours float64           `json:"task_hours"`
+	Exceeded  bool              `json:"exceeded"`
+}
+
+// GetExperimentBudget returns the budget of an experiment and its usage. The
+// budget is unlimited until it's set.
+func (r *ResourceManager) GetExperimentBudget(experimentId string) (*ExperimentBudgetStatus, error) {
+	if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+		return nil, util.Wrap(err, "Failed to get the experiment budget")
+	}
+	budget, err := r.getExperimentBudget(experimentId)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := r.runStore.GetExperimentUsage(experimentId)
+	if err != nil {
+		return nil, err
+	}
+	status := &ExperimentBudgetStatus{
+		Budget:    &ExperimentBudget{Enforcement: model.ExperimentBudgetBlock},
+		RunCount:  usage.RunCount,
+		TaskHours: float64(usage.TaskSeconds) / 3600,
+	}
+	if budget != nil {
+		status.Budget = &ExperimentBudget{
+			MaxRuns:      budget.MaxRuns,
+			MaxTaskHours: float64(budget.MaxTaskSeconds) / 3600,
+			Enforcement:  budget.Enforcement,
+		}
+		status.Exceeded = exceededBudget(budget, usage) != ""
+	}
+	return status, nil
+}
+
+// SetExperimentBudget replaces the budget of an experiment. It applies to the
+// runs and jobs created afterwards; the runs already running aren't stopped.
+func (r *ResourceManager) SetExperimentBudget(experimentId string, budget *ExperimentBudget) error {
+	if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+		return util.Wrap(err, "Failed to set the experiment budget")
+	}
+	if budget.MaxRuns < 0 {
+		return util.NewInvalidInputError("The maximum number of runs can't be negative.")
+	}
+	if budget.MaxTaskHours < 0 || math.IsNaN(budget.MaxTaskHours) || math.IsInf(budget.MaxTaskHours, 0) {
+		return util.NewInvalidInputError("The maximum number of task hours must be a non-negative number.")
+	}
+	switch budget.Enforcement {
+	case "":
+		budget.Enforcement = model.ExperimentBudgetBlock
+	case model.ExperimentBudgetBlock, model.ExperimentBudgetWarn:
+	default:
+		return util.NewInvalidInputError("The enforcement of the budget must be %q or %q, got %q.",
+			model.ExperimentBudgetBlock, model.ExperimentBudgetWarn, budget.Enforcement)
+	}
+	return r.experimentStore.SetExperimentBudget(&model.ExperimentBudget{
+		ExperimentUUID: experimentId,
+		MaxRuns:        budget.MaxRuns,
+		MaxTaskSeconds: int64(math.Round(budget.MaxTaskHours * 3600)),
+		Enforcement:    budget.Enforcement,
+		UpdatedAtInSec: r.time.Now().Unix(),
+	})
+}
+
+// getExperimentBudget returns the budget of an experiment, or nil if it has
+// none. The stored budgets without an enforcement block.
+func (r *ResourceManager) getExperimentBudget(experimentId string) (*model.ExperimentBudget, error) {
+	budget, err := r.experimentStore.GetExperimentBudget(experimentId)
+	if err != nil || budget == nil {
+		return nil, err
+	}
+	if budget.Enforcement == "" {
+		budget.Enforcement = model.ExperimentBudgetBlock
+	}
+	return budget, nil
+}
+
+// checkExperimentBudget checks the budget of the experiment owning a new run or
+// job. It returns an error once the budget is used up and blocks, and a warning
+// if it only warns.
+func (r *ResourceManager) checkExperimentBudget(references []*api.ResourceReference) (string, error) {
+	experimentId := common.GetExperimentIDFromAPIResourceReferences(references)
+	if experimentId == "" {
+		return "", nil
+	}
+	budget, err := r.getExperimentBudget(experimentId)
+	if err != nil || budget == nil || (budget.MaxRuns == 0 && budget.MaxTaskSeconds == 0) {
+		return "", err
+	}
+	usage, err := r.runStore.GetExperimentUsage(experimentId)
+	if err != nil {
+		return "", err
+	}
+	exceeded := exceededBudget(budget, usage)
+	if exceeded == "" {
+		return "", nil
+	}
+	if budget.Enforcement == model.ExperimentBudgetWarn {
+		return fmt.Sprintf("Experiment %s exceeds its budget: %s.", experimentId, exceeded), nil
+	}
+	return "", util.NewFailedPreconditionError(
+		errors.New("Experiment budget exceeded"),
+		"Experiment %s has used up its budget: %s. Please raise the budget or use another experiment.",
+		experimentId, exceeded).WithReason(util.ReasonExperimentBudgetExceeded)
+}
+
+// exceededBudget describes which limit of the budget the usage reaches, or
+// returns an empty string if there's none.
+func exceededBudget(budget *model.ExperimentBudget, usage *model.ExperimentUsage) string {
+	if budget.MaxRuns > 0 && usage.RunCount >= budget.MaxRuns {
+		return fmt.Sprintf("%d runs of the %d allowed", usage.RunCount, budget.MaxRuns)
+	}
+	if budget.MaxTaskSeconds > 0 && usage.TaskSeconds >= budget.MaxTaskSeconds {
+		return fmt.Sprintf("%.2f task hours of the %.2f allowed",
+			float64(usage.TaskSeconds)/3600, float64(budget.MaxTaskSeconds)/3600)
+	}
+	return ""
+}
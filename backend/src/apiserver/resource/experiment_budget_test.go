@@ -0,0 +1,85 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetExperimentBudget(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	status, err := manager.GetExperimentBudget(experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, &ExperimentBudgetStatus{Budget: &ExperimentBudget{Enforcement: "block"}}, status)
+
+	err = manager.SetExperimentBudget(experiment.UUID, &ExperimentBudget{MaxRuns: -1})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	err = manager.SetExperimentBudget(experiment.UUID, &ExperimentBudget{Enforcement: "throttle"})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	err = manager.SetExperimentBudget("unknown", &ExperimentBudget{})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	assert.Nil(t, manager.SetExperimentBudget(experiment.UUID, &ExperimentBudget{MaxRuns: 10, MaxTaskHours: 1.5}))
+	status, err = manager.GetExperimentBudget(experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, &ExperimentBudgetStatus{
+		Budget: &ExperimentBudget{MaxRuns: 10, MaxTaskHours: 1.5, Enforcement: "block"},
+	}, status)
+}
+
+func TestCreateRun_ExperimentBudget(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+	assert.Nil(t, manager.SetExperimentBudget(experiment.UUID, &ExperimentBudget{MaxRuns: 1}))
+	newRun := func() *api.Run {
+		return &api.Run{
+			Name:         "run1",
+			PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+			ResourceReferences: []*api.ResourceReference{{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			}},
+		}
+	}
+	_, err := manager.CreateRun(context.Background(), newRun())
+	assert.Nil(t, err)
+
+	_, err = manager.CreateRun(context.Background(), newRun())
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "1 runs of the 1 allowed")
+	status, err := manager.GetExperimentBudget(experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), status.RunCount)
+	assert.True(t, status.Exceeded)
+
+	// A budget which only warns flags the run instead.
+	assert.Nil(t, manager.SetExperimentBudget(experiment.UUID, &ExperimentBudget{MaxRuns: 1, Enforcement: "warn"}))
+	runDetail, err := manager.DryRunCreateRun(context.Background(), newRun())
+	assert.Nil(t, err)
+	var workflow tektonV1.PipelineRun
+	assert.Nil(t, json.Unmarshal([]byte(runDetail.WorkflowRuntimeManifest), &workflow))
+	assert.Contains(t, workflow.Annotations[util.AnnotationKeyBudgetWarning], "exceeds its budget")
+}
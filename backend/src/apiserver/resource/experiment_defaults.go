@@ -0,0 +1,170 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+)
+
+// ExperimentDefaults are merged into every run and job created in an
+// experiment. The parameters and the service account apply when the run or job
+// doesn't set them, and the pod template settings when its pipeline doesn't.
+type ExperimentDefaults struct {
+	Parameters     []*api.Parameter `json:"parameters"`
+	ServiceAccount string           `json:"service_account"`
+	PodTemplate    *pod.PodTemplate `json:"pod_template,omitempty"`
+}
+
+// GetExperimentDefaults returns the defaults of the runs and jobs of an
+// experiment, which are empty until they're set.
+func (r *ResourceManager) GetExperimentDefaults(experimentId string) (*ExperimentDefaults, error) {
+	if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+		return nil, util.Wrap(err, "Failed to get the experiment defaults")
+	}
+	defaults, err := r.getExperimentDefaults(experimentId)
+	if err != nil {
+		return nil, err
+	}
+	if defaults == nil {
+		return &ExperimentDefaults{Parameters: []*api.Parameter{}}, nil
+	}
+	return defaults, nil
+}
+
+// SetExperimentDefaults replaces the defaults of the runs and jobs of an
+// experiment. They apply to the runs and jobs created afterwards.
+func (r *ResourceManager) SetExperimentDefaults(experimentId string, defaults *ExperimentDefaults) error {
+	if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+		return util.Wrap(err, "Failed to set the experiment defaults")
+	}
+	names := map[string]bool{}
+	for _, parameter := range defaults.Parameters {
+		if parameter.GetName() == "" {
+			return util.NewInvalidInputError("The name of a default parameter is empty.")
+		}
+		if names[parameter.GetName()] {
+			return util.NewInvalidInputError("The default parameter %s is set twice.", parameter.GetName())
+		}
+		names[parameter.GetName()] = true
+	}
+	parameters, err := json.Marshal(defaults.Parameters)
+	if err != nil {
+		return util.NewInvalidInputErrorWithDetails(err, "Failed to marshal the default parameters")
+	}
+	if len(parameters) > util.MaxParameterBytes {
+		return util.NewInvalidInputError("The default parameters exceed the maximum size of %v.", util.MaxParameterBytes)
+	}
+	podTemplate := []byte{}
+	if defaults.PodTemplate != nil {
+		if podTemplate, err = json.Marshal(defaults.PodTemplate); err != nil {
+			return util.NewInvalidInputErrorWithDetails(err, "Failed to marshal the default pod template")
+		}
+	}
+	return r.experimentStore.SetExperimentDefaults(&model.ExperimentDefaults{
+		ExperimentUUID: experimentId,
+		Parameters:     string(parameters),
+		ServiceAccount: defaults.ServiceAccount,
+		PodTemplate:    string(podTemplate),
+		UpdatedAtInSec: r.time.Now().Unix(),
+	})
+}
+
+// getExperimentDefaults returns the defaults of an experiment, or nil if it
+// has none.
+func (r *ResourceManager) getExperimentDefaults(experimentId string) (*ExperimentDefaults, error) {
+	stored, err := r.experimentStore.GetExperimentDefaults(experimentId)
+	if err != nil || stored == nil {
+		return nil, err
+	}
+	defaults := &ExperimentDefaults{ServiceAccount: stored.ServiceAccount}
+	if err := json.Unmarshal([]byte(stored.Parameters), &defaults.Parameters); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to parse the default parameters of experiment %v", experimentId)
+	}
+	if stored.PodTemplate != "" {
+		defaults.PodTemplate = &pod.PodTemplate{}
+		if err := json.Unmarshal([]byte(stored.PodTemplate), defaults.PodTemplate); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse the default pod template of experiment %v", experimentId)
+		}
+	}
+	return defaults, nil
+}
+
+// getDefaultsOfReferencedExperiment returns the defaults of the experiment
+// owning a run or job, or nil if it has none.
+func (r *ResourceManager) getDefaultsOfReferencedExperiment(references []*api.ResourceReference) (*ExperimentDefaults, error) {
+	experimentId := common.GetExperimentIDFromAPIResourceReferences(references)
+	if experimentId == "" {
+		return nil, nil
+	}
+	return r.getExperimentDefaults(experimentId)
+}
+
+// applyTo merges the default parameters and service account into a run or job.
+// The default parameters its pipeline doesn't declare are skipped, so that the
+// experiment can hold the parameters of several pipelines.
+func (d *ExperimentDefaults) applyTo(tmpl template.Template, pipelineSpec **api.PipelineSpec, serviceAccount *string) error {
+	if d == nil {
+		return nil
+	}
+	if *serviceAccount == "" {
+		*serviceAccount = d.ServiceAccount
+	}
+	if len(d.Parameters) == 0 {
+		return nil
+	}
+	paramsJSON, err := tmpl.ParametersJSON()
+	if err != nil {
+		return util.Wrap(err, "Failed to get the parameters of the pipeline")
+	}
+	declared, err := template.UnmarshalParameters(paramsJSON)
+	if err != nil {
+		return util.Wrap(err, "Failed to parse the parameters of the pipeline")
+	}
+	if *pipelineSpec == nil {
+		*pipelineSpec = &api.PipelineSpec{}
+	}
+	set := map[string]bool{}
+	for _, parameter := range (*pipelineSpec).Parameters {
+		set[parameter.GetName()] = true
+	}
+	for _, parameter := range declared {
+		if set[parameter.Name] {
+			continue
+		}
+		for _, defaultParameter := range d.Parameters {
+			if defaultParameter.GetName() == parameter.Name {
+				(*pipelineSpec).Parameters = append((*pipelineSpec).Parameters,
+					&api.Parameter{Name: defaultParameter.GetName(), Value: defaultParameter.GetValue()})
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// podTemplate returns the default pod template, or nil if there's none.
+func (d *ExperimentDefaults) podTemplate() *pod.PodTemplate {
+	if d == nil {
+		return nil
+	}
+	return d.PodTemplate
+}
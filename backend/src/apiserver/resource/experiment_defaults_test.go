@@ -0,0 +1,112 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var testWorkflowWithParams = util.NewWorkflow(&tektonV1.PipelineRun{
+	TypeMeta:   v1.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "PipelineRun"},
+	ObjectMeta: v1.ObjectMeta{Name: "workflow-name", Namespace: "ns1"},
+	Spec: tektonV1.PipelineRunSpec{
+		Params: []tektonV1.Param{
+			{Name: "learning_rate", Value: tektonV1.ParamValue{Type: tektonV1.ParamTypeString, StringVal: "0.01"}},
+			{Name: "epochs", Value: tektonV1.ParamValue{Type: tektonV1.ParamTypeString, StringVal: "1"}},
+		},
+	},
+})
+
+func TestSetExperimentDefaults(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	defaults, err := manager.GetExperimentDefaults(experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, &ExperimentDefaults{Parameters: []*api.Parameter{}}, defaults)
+
+	err = manager.SetExperimentDefaults(experiment.UUID, &ExperimentDefaults{
+		Parameters: []*api.Parameter{{Name: "epochs", Value: "1"}, {Name: "epochs", Value: "2"}},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is set twice")
+
+	err = manager.SetExperimentDefaults("unknown", &ExperimentDefaults{})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	expected := &ExperimentDefaults{
+		Parameters:     []*api.Parameter{{Name: "epochs", Value: "10"}},
+		ServiceAccount: "team-runner",
+		PodTemplate:    &pod.PodTemplate{NodeSelector: map[string]string{"pool": "gpu"}},
+	}
+	assert.Nil(t, manager.SetExperimentDefaults(experiment.UUID, expected))
+	defaults, err = manager.GetExperimentDefaults(experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, defaults)
+}
+
+func TestCreateRun_ExperimentDefaults(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+	err := manager.SetExperimentDefaults(experiment.UUID, &ExperimentDefaults{
+		Parameters: []*api.Parameter{
+			{Name: "learning_rate", Value: "0.1"},
+			{Name: "epochs", Value: "10"},
+			// The pipeline doesn't declare it, so it's skipped.
+			{Name: "batch_size", Value: "32"},
+		},
+		ServiceAccount: "team-runner",
+		PodTemplate:    &pod.PodTemplate{NodeSelector: map[string]string{"pool": "gpu"}},
+	})
+	assert.Nil(t, err)
+
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflowWithParams.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "epochs", Value: "3"}},
+		},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}
+	runDetail, err := manager.DryRunCreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	var workflow tektonV1.PipelineRun
+	assert.Nil(t, json.Unmarshal([]byte(runDetail.WorkflowRuntimeManifest), &workflow))
+	params := util.NewWorkflow(&workflow).GetWorkflowParametersAsMap()
+	// The parameters of the run win.
+	assert.Equal(t, map[string]string{"learning_rate": "0.1", "epochs": "3"}, params)
+	assert.Equal(t, "team-runner", workflow.Spec.TaskRunTemplate.ServiceAccountName)
+	assert.Equal(t, map[string]string{"pool": "gpu"}, workflow.Spec.TaskRunTemplate.PodTemplate.NodeSelector)
+
+	apiRun.PipelineSpec.Parameters = nil
+	apiRun.ServiceAccount = "other-runner"
+	runDetail, err = manager.DryRunCreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal([]byte(runDetail.WorkflowRuntimeManifest), &workflow))
+	assert.Equal(t, "other-runner", workflow.Spec.TaskRunTemplate.ServiceAccountName)
+}
@@ -0,0 +1,311 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// The metadata writer records the executions of a run in a context of this
+// type, named after the PipelineRun.
+const metadataRunContextType = "KfpRun"
+
+const (
+	LineageNodeArtifact  = "artifact"
+	LineageNodeExecution = "execution"
+)
+
+// LineageNode is an artifact or an execution of a lineage graph. Its ID is
+// prefixed by its kind, e.g. "artifact/12", since artifact and execution IDs
+// overlap.
+type LineageNode struct {
+	ID         string            `json:"id"`
+	Kind       string            `json:"kind"`
+	Name       string            `json:"name,omitempty"`
+	Type       string            `json:"type,omitempty"`
+	URI        string            `json:"uri,omitempty"`
+	State      string            `json:"state,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// LineageEdge points in the direction data flows, from an input artifact to
+// the execution that consumed it, or from an execution to its output artifact.
+type LineageEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// LineageGraph is the lineage of an artifact or a run. Root is the node whose
+// lineage was requested, if any.
+type LineageGraph struct {
+	Root  string         `json:"root,omitempty"`
+	Nodes []*LineageNode `json:"nodes"`
+	Edges []*LineageEdge `json:"edges"`
+}
+
+// GetArtifactLineage returns the executions and artifacts upstream and
+// downstream of an MLMD artifact, up to depth executions away from it.
+func (r *ResourceManager) GetArtifactLineage(ctx context.Context, artifactID int64, depth int) (*LineageGraph, error) {
+	if r.metadataClient == nil {
+		return nil, errMetadataNotConfigured()
+	}
+	artifacts, err := r.metadataClient.GetArtifactsByID(ctx, []int64{artifactID})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get artifact %d", artifactID)
+	}
+	if len(artifacts) == 0 {
+		return nil, util.NewResourceNotFoundError("Artifact", strconv.FormatInt(artifactID, 10))
+	}
+	builder := newLineageBuilder(r.metadataClient)
+	builder.artifacts[artifactID] = true
+	upstream := []int64{artifactID}
+	downstream := []int64{artifactID}
+	for i := 0; i < depth && len(upstream)+len(downstream) > 0; i++ {
+		if upstream, err = builder.walk(ctx, upstream, true); err != nil {
+			return nil, err
+		}
+		if downstream, err = builder.walk(ctx, downstream, false); err != nil {
+			return nil, err
+		}
+	}
+	graph, err := builder.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graph.Root = lineageNodeID(LineageNodeArtifact, artifactID)
+	return graph, nil
+}
+
+// GetRunLineage returns the executions of a run recorded in MLMD, together with
+// the artifacts they consumed and produced.
+func (r *ResourceManager) GetRunLineage(ctx context.Context, runID string) (*LineageGraph, error) {
+	if r.metadataClient == nil {
+		return nil, errMetadataNotConfigured()
+	}
+	workflow, err := r.getRuntimeWorkflow(runID, "read lineage")
+	if err != nil {
+		return nil, err
+	}
+	builder := newLineageBuilder(r.metadataClient)
+	runContext, err := r.metadataClient.GetContextByTypeAndName(ctx, metadataRunContextType, workflow.Name)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the metadata context of run %s", runID)
+	}
+	// Runs which haven't recorded anything yet have no context.
+	if runContext == nil {
+		return builder.build(ctx)
+	}
+	executions, err := r.metadataClient.GetExecutionsByContext(ctx, runContext.ID)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the executions of run %s", runID)
+	}
+	executionIDs := make([]int64, 0, len(executions))
+	for _, execution := range executions {
+		executionIDs = append(executionIDs, execution.ID)
+	}
+	if len(executionIDs) > 0 {
+		events, err := r.metadataClient.GetEventsByExecutionIDs(ctx, executionIDs)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the events of run %s", runID)
+		}
+		for _, execution := range executions {
+			builder.executions[execution.ID] = true
+		}
+		for _, event := range events {
+			builder.addEvent(event)
+		}
+	}
+	return builder.build(ctx)
+}
+
+func errMetadataNotConfigured() error {
+	return util.NewFailedPreconditionError(errors.New("ML Metadata isn't configured"),
+		"Lineage can't be queried since ML Metadata isn't deployed")
+}
+
+func lineageNodeID(kind string, id int64) string {
+	return fmt.Sprintf("%s/%d", kind, id)
+}
+
+// lineageBuilder collects the artifacts, executions and events of a lineage
+// graph, which are fetched once the graph is complete.
+type lineageBuilder struct {
+	metadataClient client.MetadataClientInterface
+	artifacts      map[int64]bool
+	executions     map[int64]bool
+	edges          map[LineageEdge]bool
+	// The executions whose inputs, respectively outputs, were walked.
+	walkedUpstream   map[int64]bool
+	walkedDownstream map[int64]bool
+}
+
+func newLineageBuilder(metadataClient client.MetadataClientInterface) *lineageBuilder {
+	return &lineageBuilder{
+		metadataClient:   metadataClient,
+		artifacts:        map[int64]bool{},
+		executions:       map[int64]bool{},
+		edges:            map[LineageEdge]bool{},
+		walkedUpstream:   map[int64]bool{},
+		walkedDownstream: map[int64]bool{},
+	}
+}
+
+func (b *lineageBuilder) addEvent(event *client.MetadataEvent) {
+	artifact := lineageNodeID(LineageNodeArtifact, event.ArtifactID)
+	execution := lineageNodeID(LineageNodeExecution, event.ExecutionID)
+	switch {
+	case event.IsInput():
+		b.edges[LineageEdge{Source: artifact, Target: execution}] = true
+	case event.IsOutput():
+		b.edges[LineageEdge{Source: execution, Target: artifact}] = true
+	default:
+		return
+	}
+	b.artifacts[event.ArtifactID] = true
+	b.executions[event.ExecutionID] = true
+}
+
+// walk moves one execution away from the artifacts, upstream to the executions
+// that produced them and their inputs, or downstream to the executions that
+// consumed them and their outputs. It returns the artifacts reached.
+func (b *lineageBuilder) walk(ctx context.Context, artifactIDs []int64, upstream bool) ([]int64, error) {
+	if len(artifactIDs) == 0 {
+		return nil, nil
+	}
+	walked := b.walkedDownstream
+	if upstream {
+		walked = b.walkedUpstream
+	}
+	events, err := b.metadataClient.GetEventsByArtifactIDs(ctx, artifactIDs)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the events of artifacts %v", artifactIDs)
+	}
+	var executionIDs []int64
+	for _, event := range events {
+		if (upstream && event.IsOutput()) || (!upstream && event.IsInput()) {
+			b.addEvent(event)
+			if !walked[event.ExecutionID] {
+				walked[event.ExecutionID] = true
+				executionIDs = append(executionIDs, event.ExecutionID)
+			}
+		}
+	}
+	if len(executionIDs) == 0 {
+		return nil, nil
+	}
+	events, err = b.metadataClient.GetEventsByExecutionIDs(ctx, executionIDs)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the events of executions %v", executionIDs)
+	}
+	var reached []int64
+	for _, event := range events {
+		if (upstream && event.IsInput()) || (!upstream && event.IsOutput()) {
+			if !b.artifacts[event.ArtifactID] {
+				reached = append(reached, event.ArtifactID)
+			}
+			b.addEvent(event)
+		}
+	}
+	return reached, nil
+}
+
+func (b *lineageBuilder) build(ctx context.Context) (*LineageGraph, error) {
+	graph := &LineageGraph{Nodes: []*LineageNode{}, Edges: []*LineageEdge{}}
+	if len(b.artifacts) > 0 {
+		artifacts, err := b.metadataClient.GetArtifactsByID(ctx, sortedIDs(b.artifacts))
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the artifacts of the lineage")
+		}
+		typeIDs := map[int64]bool{}
+		for _, artifact := range artifacts {
+			typeIDs[artifact.TypeID] = true
+		}
+		types, err := b.metadataClient.GetArtifactTypesByID(ctx, sortedIDs(typeIDs))
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the artifact types of the lineage")
+		}
+		for _, artifact := range artifacts {
+			graph.Nodes = append(graph.Nodes, &LineageNode{
+				ID:         lineageNodeID(LineageNodeArtifact, artifact.ID),
+				Kind:       LineageNodeArtifact,
+				Name:       lineageNodeName(artifact.Name, artifact.Properties, "name"),
+				Type:       types[artifact.TypeID],
+				URI:        artifact.URI,
+				State:      artifact.State,
+				Properties: artifact.Properties,
+			})
+		}
+	}
+	if len(b.executions) > 0 {
+		executions, err := b.metadataClient.GetExecutionsByID(ctx, sortedIDs(b.executions))
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the executions of the lineage")
+		}
+		typeIDs := map[int64]bool{}
+		for _, execution := range executions {
+			typeIDs[execution.TypeID] = true
+		}
+		types, err := b.metadataClient.GetExecutionTypesByID(ctx, sortedIDs(typeIDs))
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the execution types of the lineage")
+		}
+		for _, execution := range executions {
+			graph.Nodes = append(graph.Nodes, &LineageNode{
+				ID:         lineageNodeID(LineageNodeExecution, execution.ID),
+				Kind:       LineageNodeExecution,
+				Name:       lineageNodeName(execution.Name, execution.Properties, "component_id"),
+				Type:       types[execution.TypeID],
+				State:      execution.State,
+				Properties: execution.Properties,
+			})
+		}
+	}
+	for edge := range b.edges {
+		edge := edge
+		graph.Edges = append(graph.Edges, &edge)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return graph.Edges[i].Source < graph.Edges[j].Source
+		}
+		return graph.Edges[i].Target < graph.Edges[j].Target
+	})
+	return graph, nil
+}
+
+// lineageNodeName falls back to the property that the metadata writer names
+// artifacts and executions with.
+func lineageNodeName(name string, properties map[string]string, property string) string {
+	if name != "" {
+		return name
+	}
+	return properties[property]
+}
+
+func sortedIDs(ids map[int64]bool) []int64 {
+	sorted := make([]int64, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
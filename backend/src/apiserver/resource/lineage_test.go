@@ -0,0 +1,124 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// initWithLineage records raw-data -> ingest -> dataset -> train -> model, metrics
+// and model -> deploy -> endpoint, where ingest and train belong to run-1.
+func initWithLineage(t *testing.T) (*FakeClientManager, *ResourceManager) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	metadataClient := store.MetadataClientFake
+	metadataClient.AddArtifact(&client.MetadataArtifact{ID: 1, TypeID: 1, URI: "gs://raw-data"}, "system.Dataset")
+	metadataClient.AddArtifact(&client.MetadataArtifact{ID: 2, TypeID: 1, Name: "dataset"}, "system.Dataset")
+	metadataClient.AddArtifact(&client.MetadataArtifact{ID: 3, TypeID: 2, Properties: map[string]string{"name": "model"}}, "system.Model")
+	metadataClient.AddArtifact(&client.MetadataArtifact{ID: 4, TypeID: 3, Name: "metrics"}, "system.Metrics")
+	metadataClient.AddArtifact(&client.MetadataArtifact{ID: 5, TypeID: 4, Name: "endpoint"}, "system.Artifact")
+	metadataClient.AddContext(&client.MetadataContext{ID: 1, Name: "run-1"}, "KfpRun")
+	metadataClient.AddExecution(&client.MetadataExecution{ID: 1, TypeID: 1, Name: "ingest"}, "components.ingest", 1,
+		[]int64{1}, []int64{2})
+	metadataClient.AddExecution(&client.MetadataExecution{ID: 2, TypeID: 2, Properties: map[string]string{"component_id": "train"}},
+		"components.train", 1, []int64{2}, []int64{3, 4})
+	metadataClient.AddExecution(&client.MetadataExecution{ID: 3, TypeID: 3, Name: "deploy"}, "components.deploy", 2,
+		[]int64{3}, []int64{5})
+	return store, NewResourceManager(store)
+}
+
+func TestGetArtifactLineage(t *testing.T) {
+	store, manager := initWithLineage(t)
+	defer store.Close()
+
+	graph, err := manager.GetArtifactLineage(context.Background(), 3, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, &LineageGraph{
+		Root: "artifact/3",
+		Nodes: []*LineageNode{
+			{ID: "artifact/2", Kind: LineageNodeArtifact, Name: "dataset", Type: "system.Dataset"},
+			{ID: "artifact/3", Kind: LineageNodeArtifact, Name: "model", Type: "system.Model", Properties: map[string]string{"name": "model"}},
+			{ID: "artifact/5", Kind: LineageNodeArtifact, Name: "endpoint", Type: "system.Artifact"},
+			{ID: "execution/2", Kind: LineageNodeExecution, Name: "train", Type: "components.train", Properties: map[string]string{"component_id": "train"}},
+			{ID: "execution/3", Kind: LineageNodeExecution, Name: "deploy", Type: "components.deploy"},
+		},
+		Edges: []*LineageEdge{
+			{Source: "artifact/2", Target: "execution/2"},
+			{Source: "artifact/3", Target: "execution/3"},
+			{Source: "execution/2", Target: "artifact/3"},
+			{Source: "execution/3", Target: "artifact/5"},
+		},
+	}, graph)
+
+	graph, err = manager.GetArtifactLineage(context.Background(), 3, 2)
+	assert.Nil(t, err)
+	assert.Len(t, graph.Nodes, 7)
+	assert.Equal(t, &LineageEdge{Source: "artifact/1", Target: "execution/1"}, graph.Edges[0])
+}
+
+func TestGetArtifactLineage_NotFound(t *testing.T) {
+	store, manager := initWithLineage(t)
+	defer store.Close()
+
+	_, err := manager.GetArtifactLineage(context.Background(), 6, 1)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetArtifactLineage_MetadataNotConfigured(t *testing.T) {
+	store, manager := initWithLineage(t)
+	defer store.Close()
+	manager.metadataClient = nil
+
+	_, err := manager.GetArtifactLineage(context.Background(), 3, 1)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetRunLineage(t *testing.T) {
+	store, manager := initWithLineage(t)
+	defer store.Close()
+	for _, name := range []string{"run-1", "run-2"} {
+		workflow := util.NewWorkflow(&tektonV1.PipelineRun{ObjectMeta: v1.ObjectMeta{Name: name, Namespace: "ns1"}})
+		_, err := store.RunStore().CreateRun(&model.RunDetail{
+			Run:             model.Run{UUID: name, Name: name, Namespace: "ns1", StorageState: "STORAGESTATE_AVAILABLE"},
+			PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: workflow.ToStringForStore()},
+		})
+		assert.Nil(t, err)
+	}
+
+	graph, err := manager.GetRunLineage(context.Background(), "run-1")
+	assert.Nil(t, err)
+	var nodes []string
+	for _, node := range graph.Nodes {
+		nodes = append(nodes, node.ID)
+	}
+	assert.Equal(t, []string{"artifact/1", "artifact/2", "artifact/3", "artifact/4", "execution/1", "execution/2"}, nodes)
+	assert.Len(t, graph.Edges, 5)
+
+	// run-2 hasn't recorded any metadata.
+	graph, err = manager.GetRunLineage(context.Background(), "run-2")
+	assert.Nil(t, err)
+	assert.Equal(t, &LineageGraph{Nodes: []*LineageNode{}, Edges: []*LineageEdge{}}, graph)
+
+	_, err = manager.GetRunLineage(context.Background(), "run-3")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
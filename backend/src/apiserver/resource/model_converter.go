@@ -72,11 +72,7 @@ func (r *ResourceManager) ToModelRunDetail(run *api.Run, runId string, workflow
 		}
 	}
 
-	experimentUUID, err := r.getOwningExperimentUUID(run.ResourceReferences)
-	if err != nil {
-		return nil, util.Wrap(err, "Error getting the experiment UUID")
-	}
-
+	experimentUUID := getOwningExperimentUUID(run.ResourceReferences)
 	runDetail := &model.RunDetail{
 		Run: model.Run{
 			UUID:               runId,
@@ -352,17 +348,13 @@ func (r *ResourceManager) getResourceName(resourceType model.ResourceType, resou
 	}
 }
 
-func (r *ResourceManager) getOwningExperimentUUID(references []*api.ResourceReference) (string, error) {
-	var experimentUUID string
+// getOwningExperimentUUID returns the experiment owning a run, or the empty
+// string if it has none.
+func getOwningExperimentUUID(references []*api.ResourceReference) string {
 	for _, ref := range references {
 		if ref.Key.Type == api.ResourceType_EXPERIMENT && ref.Relationship == api.Relationship_OWNER {
-			experimentUUID = ref.Key.Id
-			break
+			return ref.Key.Id
 		}
 	}
-
-	if experimentUUID == "" {
-		return "", util.NewInternalServerError(nil, "Missing owning experiment UUID")
-	}
-	return experimentUUID, nil
+	return ""
 }
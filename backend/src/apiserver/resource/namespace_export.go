@@ -0,0 +1,625 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// The version of the format of the namespace exports.
+const namespaceExportFormatVersion = 1
+
+const namespaceExportPageSize = 100
+
+// The kinds of the records of a namespace export.
+const (
+	NamespaceExportHeader          = "header"
+	NamespaceExportExperiment      = "experiment"
+	NamespaceExportPipeline        = "pipeline"
+	NamespaceExportPipelineVersion = "pipeline_version"
+	NamespaceExportJob             = "job"
+	NamespaceExportRun             = "run"
+	// NamespaceExportArtifacts is the prefix of the artifacts of a run in the
+	// object store, which are copied along with the export.
+	NamespaceExportArtifacts = "artifacts"
+	// NamespaceExportEnd marks the end of a complete export.
+	NamespaceExportEnd = "end"
+)
+
+// NamespaceExportRecord is a line of a namespace export, which is a sequence of
+// JSON records: a header, the experiments, the pipelines and their versions, the
+// jobs, the runs and the prefixes of their artifacts, and an end marker.
+// Unlike a backup, the resources are imported with new IDs, so the export can be
+// imported into another namespace of the same cluster.
+type NamespaceExportRecord struct {
+	Kind string `json:"kind"`
+	// The header.
+	FormatVersion int        `json:"format_version,omitempty"`
+	Namespace     string     `json:"namespace,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	// The resources. The pipelines and pipeline versions come with their
+	// templates, the jobs with their ScheduledWorkflows.
+	Experiment        *model.Experiment         `json:"experiment,omitempty"`
+	Pipeline          *model.Pipeline           `json:"pipeline,omitempty"`
+	PipelineVersion   *model.PipelineVersion    `json:"pipeline_version,omitempty"`
+	Template          []byte                    `json:"template,omitempty"`
+	Job               *model.Job                `json:"job,omitempty"`
+	ScheduledWorkflow *swfapi.ScheduledWorkflow `json:"scheduled_workflow,omitempty"`
+	Run               *model.Run                `json:"run,omitempty"`
+	// The prefix of artifacts.
+	Key string `json:"key,omitempty"`
+}
+
+// NamespaceExportSummary counts the resources exported, or imported, and the
+// prefixes of the artifacts to copy.
+type NamespaceExportSummary struct {
+	Namespace        string `json:"namespace"`
+	Experiments      int    `json:"experiments"`
+	Pipelines        int    `json:"pipelines"`
+	PipelineVersions int    `json:"pipeline_versions"`
+	Jobs             int    `json:"jobs"`
+	Runs             int    `json:"runs"`
+	ArtifactPrefixes int    `json:"artifact_prefixes"`
+}
+
+// ExportNamespace writes the experiments, pipelines, jobs and runs of a namespace
+// to the writer. The runs are exported without their runtime status, and their
+// artifacts are copied with the tools of the object store, using the exported
+// prefixes.
+func (r *ResourceManager) ExportNamespace(ctx context.Context, namespace string, w io.Writer) (*NamespaceExportSummary, error) {
+	if err := CheckNamespaceExportEnabled(namespace); err != nil {
+		return nil, err
+	}
+	encoder := json.NewEncoder(w)
+	write := func(record *NamespaceExportRecord) error {
+		if err := encoder.Encode(record); err != nil {
+			return util.NewInternalServerError(err, "Failed to write the export of namespace %s", namespace)
+		}
+		return nil
+	}
+	now := r.time.Now().UTC()
+	header := &NamespaceExportRecord{Kind: NamespaceExportHeader, FormatVersion: namespaceExportFormatVersion,
+		Namespace: namespace, CreatedAt: &now}
+	if err := write(header); err != nil {
+		return nil, err
+	}
+	summary := &NamespaceExportSummary{Namespace: namespace}
+	filterContext := &common.FilterContext{
+		ReferenceKey:      &common.ReferenceKey{Type: common.Namespace, ID: namespace},
+		IncludeRunArchive: true,
+	}
+	if err := r.exportExperiments(filterContext, summary, write); err != nil {
+		return nil, util.Wrapf(err, "Failed to export namespace %s", namespace)
+	}
+	if err := r.exportPipelines(filterContext, summary, write); err != nil {
+		return nil, util.Wrapf(err, "Failed to export namespace %s", namespace)
+	}
+	if err := r.exportJobs(ctx, filterContext, summary, write); err != nil {
+		return nil, util.Wrapf(err, "Failed to export namespace %s", namespace)
+	}
+	if err := r.exportRuns(filterContext, summary, write); err != nil {
+		return nil, util.Wrapf(err, "Failed to export namespace %s", namespace)
+	}
+	if err := write(&NamespaceExportRecord{Kind: NamespaceExportEnd}); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// CheckNamespaceExportEnabled fails in single-user mode, where the experiments and
+// pipelines don't belong to a namespace. An installation is moved with a backup
+// instead.
+func CheckNamespaceExportEnabled(namespace string) error {
+	if !common.IsMultiUserMode() {
+		return util.NewFailedPreconditionError(errors.New("not in multi-user mode"),
+			"Namespaces are only exported and imported in multi-user mode, use a backup instead")
+	}
+	if namespace == "" {
+		return util.NewInvalidInputError("The namespace is empty")
+	}
+	return nil
+}
+
+func (r *ResourceManager) exportExperiments(filterContext *common.FilterContext, summary *NamespaceExportSummary,
+	write func(*NamespaceExportRecord) error) error {
+	opts, err := list.NewOptions(&model.Experiment{}, namespaceExportPageSize, "", nil)
+	if err != nil {
+		return err
+	}
+	for {
+		experiments, _, nextPageToken, err := r.experimentStore.ListExperiments(filterContext, opts)
+		if err != nil {
+			return util.Wrap(err, "Failed to list the experiments")
+		}
+		for _, experiment := range experiments {
+			if err := write(&NamespaceExportRecord{Kind: NamespaceExportExperiment, Experiment: experiment}); err != nil {
+				return err
+			}
+			summary.Experiments++
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, namespaceExportPageSize)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *ResourceManager) exportPipelines(filterContext *common.FilterContext, summary *NamespaceExportSummary,
+	write func(*NamespaceExportRecord) error) error {
+	opts, err := list.NewOptions(&model.Pipeline{}, namespaceExportPageSize, "", nil)
+	if err != nil {
+		return err
+	}
+	for {
+		pipelines, _, nextPageToken, err := r.pipelineStore.ListPipelines(filterContext, opts)
+		if err != nil {
+			return util.Wrap(err, "Failed to list the pipelines")
+		}
+		for _, pipeline := range pipelines {
+			if err := r.exportPipeline(pipeline, summary, write); err != nil {
+				return err
+			}
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, namespaceExportPageSize)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// exportPipeline writes a pipeline with the template of its implicit version,
+// which shares its ID, and then its other versions. If the implicit version was
+// deleted, the pipeline is written with the template of its default version.
+func (r *ResourceManager) exportPipeline(pipeline *model.Pipeline, summary *NamespaceExportSummary,
+	write func(*NamespaceExportRecord) error) error {
+	var versions []*model.PipelineVersion
+	templateVersionId := pipeline.DefaultVersionId
+	opts, err := list.NewOptions(&model.PipelineVersion{}, namespaceExportPageSize, "", nil)
+	if err != nil {
+		return err
+	}
+	for {
+		page, _, nextPageToken, err := r.pipelineStore.ListPipelineVersions(pipeline.UUID, opts)
+		if err != nil {
+			return util.Wrapf(err, "Failed to list the versions of pipeline %s", pipeline.UUID)
+		}
+		for _, version := range page {
+			if version.UUID == pipeline.UUID {
+				templateVersionId = version.UUID
+				continue
+			}
+			versions = append(versions, version)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, namespaceExportPageSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	pipelineFile, err := r.getPipelineFile(templateVersionId)
+	if err != nil {
+		return util.Wrapf(err, "Failed to get the template of pipeline %s", pipeline.UUID)
+	}
+	if err := write(&NamespaceExportRecord{Kind: NamespaceExportPipeline, Pipeline: pipeline, Template: pipelineFile}); err != nil {
+		return err
+	}
+	summary.Pipelines++
+	for _, version := range versions {
+		pipelineFile, err := r.getPipelineFile(version.UUID)
+		if err != nil {
+			return util.Wrapf(err, "Failed to get the template of pipeline version %s", version.UUID)
+		}
+		record := &NamespaceExportRecord{Kind: NamespaceExportPipelineVersion, PipelineVersion: version, Template: pipelineFile}
+		if err := write(record); err != nil {
+			return err
+		}
+		summary.PipelineVersions++
+	}
+	return nil
+}
+
+func (r *ResourceManager) exportJobs(ctx context.Context, filterContext *common.FilterContext, summary *NamespaceExportSummary,
+	write func(*NamespaceExportRecord) error) error {
+	opts, err := list.NewOptions(&model.Job{}, namespaceExportPageSize, "", nil)
+	if err != nil {
+		return err
+	}
+	for {
+		jobs, _, nextPageToken, err := r.jobStore.ListJobs(filterContext, opts)
+		if err != nil {
+			return util.Wrap(err, "Failed to list the jobs")
+		}
+		for _, job := range jobs {
+			swf, err := r.getScheduledWorkflowClient(job.Namespace).Get(ctx, job.Name, v1.GetOptions{})
+			if err != nil {
+				if util.IsNotFound(err) {
+					// The job can't be recreated without its ScheduledWorkflow.
+					log.Warnf("Job %s isn't exported, its ScheduledWorkflow %s wasn't found", job.UUID, job.Name)
+					continue
+				}
+				return util.NewInternalServerError(err, "Failed to get the ScheduledWorkflow of job %s", job.UUID)
+			}
+			record := &NamespaceExportRecord{Kind: NamespaceExportJob, Job: job, ScheduledWorkflow: swf}
+			if err := write(record); err != nil {
+				return err
+			}
+			summary.Jobs++
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, namespaceExportPageSize)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *ResourceManager) exportRuns(filterContext *common.FilterContext, summary *NamespaceExportSummary,
+	write func(*NamespaceExportRecord) error) error {
+	opts, err := list.NewOptions(&model.Run{}, namespaceExportPageSize, "", nil)
+	if err != nil {
+		return err
+	}
+	for {
+		runs, _, nextPageToken, err := r.runStore.ListRuns(filterContext, opts)
+		if err != nil {
+			return util.Wrap(err, "Failed to list the runs")
+		}
+		for _, run := range runs {
+			if err := write(&NamespaceExportRecord{Kind: NamespaceExportRun, Run: run}); err != nil {
+				return err
+			}
+			summary.Runs++
+			if run.Name == "" {
+				continue
+			}
+			for _, prefix := range r.runArtifactPrefixes(run) {
+				if err := write(&NamespaceExportRecord{Kind: NamespaceExportArtifacts, Key: prefix}); err != nil {
+					return err
+				}
+				summary.ArtifactPrefixes++
+			}
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, namespaceExportPageSize)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// namespaceImport maps the IDs of the exported resources to the IDs of the
+// imported ones.
+type namespaceImport struct {
+	namespace       string
+	mapping         *template.ReferenceMapping
+	experiments     map[string]string
+	pipelines       map[string]string
+	versions        map[string]string
+	defaultVersions map[string]string
+	jobs            map[string]string
+	summary         *NamespaceExportSummary
+	resourceManager *ResourceManager
+	complete        bool
+}
+
+// ImportNamespace imports a namespace export into a namespace, of this cluster or
+// another one, with new IDs. The service accounts and secrets referenced by the
+// jobs, runs and templates are renamed with the mapping. The ScheduledWorkflows
+// of the jobs are created in the namespace, and the runs are imported as records,
+// without running them again. The import stops at the first error, keeping the
+// resources imported before it.
+func (r *ResourceManager) ImportNamespace(ctx context.Context, reader io.Reader, namespace string,
+	mapping *template.ReferenceMapping) (*NamespaceExportSummary, error) {
+	if err := CheckNamespaceExportEnabled(namespace); err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(reader)
+	var header NamespaceExportRecord
+	if err := decoder.Decode(&header); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to read the header of the namespace export")
+	}
+	if header.Kind != NamespaceExportHeader || header.FormatVersion != namespaceExportFormatVersion {
+		return nil, util.NewInvalidInputError("Unsupported namespace export format %q version %v", header.Kind, header.FormatVersion)
+	}
+	i := &namespaceImport{
+		namespace:       namespace,
+		mapping:         mapping,
+		experiments:     map[string]string{},
+		pipelines:       map[string]string{},
+		versions:        map[string]string{},
+		defaultVersions: map[string]string{},
+		jobs:            map[string]string{},
+		summary:         &NamespaceExportSummary{Namespace: namespace},
+		resourceManager: r,
+	}
+	for {
+		var record NamespaceExportRecord
+		if err := decoder.Decode(&record); err != nil {
+			if err == io.EOF && i.complete {
+				return i.summary, nil
+			}
+			if err == io.EOF {
+				return i.summary, util.NewInvalidInputError("The namespace export is incomplete")
+			}
+			return i.summary, util.NewInvalidInputErrorWithDetails(err, "Failed to read the namespace export")
+		}
+		if i.complete {
+			return i.summary, util.NewInvalidInputError("Unexpected %q record after the end of the namespace export", record.Kind)
+		}
+		if err := i.importRecord(ctx, &record); err != nil {
+			return i.summary, util.Wrapf(err, "Failed to import namespace %s into namespace %s", header.Namespace, namespace)
+		}
+	}
+}
+
+func (i *namespaceImport) importRecord(ctx context.Context, record *NamespaceExportRecord) error {
+	switch {
+	case record.Kind == NamespaceExportExperiment && record.Experiment != nil:
+		return i.importExperiment(record.Experiment)
+	case record.Kind == NamespaceExportPipeline && record.Pipeline != nil:
+		return i.importPipeline(record.Pipeline, record.Template)
+	case record.Kind == NamespaceExportPipelineVersion && record.PipelineVersion != nil:
+		return i.importPipelineVersion(record.PipelineVersion, record.Template)
+	case record.Kind == NamespaceExportJob && record.Job != nil && record.ScheduledWorkflow != nil:
+		return i.importJob(ctx, record.Job, record.ScheduledWorkflow)
+	case record.Kind == NamespaceExportRun && record.Run != nil:
+		return i.importRun(record.Run)
+	case record.Kind == NamespaceExportArtifacts:
+		i.summary.ArtifactPrefixes++
+	case record.Kind == NamespaceExportEnd:
+		i.complete = true
+	default:
+		return util.NewInvalidInputError("Invalid %q record in the namespace export", record.Kind)
+	}
+	return nil
+}
+
+func (i *namespaceImport) importExperiment(experiment *model.Experiment) error {
+	imported, err := i.resourceManager.experimentStore.CreateExperiment(&model.Experiment{
+		Name:         experiment.Name,
+		Description:  experiment.Description,
+		Namespace:    i.namespace,
+		StorageState: experiment.StorageState,
+	})
+	if err != nil {
+		return util.Wrapf(err, "Failed to import experiment %s", experiment.Name)
+	}
+	i.experiments[experiment.UUID] = imported.UUID
+	i.summary.Experiments++
+	return nil
+}
+
+func (i *namespaceImport) importPipeline(pipeline *model.Pipeline, pipelineFile []byte) error {
+	pipelineFile, err := i.remapManifest(pipelineFile)
+	if err != nil {
+		return util.Wrapf(err, "Failed to import pipeline %s", pipeline.Name)
+	}
+	imported, err := i.resourceManager.CreatePipeline(pipeline.Name, pipeline.Description, i.namespace, pipelineFile)
+	if err != nil {
+		return util.Wrapf(err, "Failed to import pipeline %s", pipeline.Name)
+	}
+	i.pipelines[pipeline.UUID] = imported.UUID
+	// The implicit version shares the ID of its pipeline.
+	i.versions[pipeline.UUID] = imported.UUID
+	i.defaultVersions[pipeline.UUID] = pipeline.DefaultVersionId
+	i.summary.Pipelines++
+	return nil
+}
+
+func (i *namespaceImport) importPipelineVersion(version *model.PipelineVersion, pipelineFile []byte) error {
+	pipelineId, ok := i.pipelines[version.PipelineId]
+	if !ok {
+		return util.NewInvalidInputError("Pipeline version %s is exported without its pipeline %s", version.UUID, version.PipelineId)
+	}
+	pipelineFile, err := i.remapManifest(pipelineFile)
+	if err != nil {
+		return util.Wrapf(err, "Failed to import pipeline version %s", version.Name)
+	}
+	imported, err := i.resourceManager.CreatePipelineVersion(&api.PipelineVersion{
+		Name:          version.Name,
+		Description:   version.Description,
+		CodeSourceUrl: version.CodeSourceUrl,
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE, Id: pipelineId},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}, pipelineFile, i.defaultVersions[version.PipelineId] == version.UUID)
+	if err != nil {
+		return util.Wrapf(err, "Failed to import pipeline version %s", version.Name)
+	}
+	i.versions[version.UUID] = imported.UUID
+	i.summary.PipelineVersions++
+	return nil
+}
+
+// importJob creates the ScheduledWorkflow of a job in the namespace, and then
+// stores the job, which takes the ID of the ScheduledWorkflow.
+func (i *namespaceImport) importJob(ctx context.Context, job *model.Job, swf *swfapi.ScheduledWorkflow) error {
+	r := i.resourceManager
+	generateName := swf.GenerateName
+	if generateName == "" {
+		generateName = swf.Name + "-"
+	}
+	newSwf := &swfapi.ScheduledWorkflow{
+		ObjectMeta: v1.ObjectMeta{
+			GenerateName: generateName,
+			Labels:       swf.Labels,
+			Annotations:  swf.Annotations,
+		},
+		Spec: swf.Spec,
+	}
+	if err := i.remapObject(newSwf); err != nil {
+		return util.Wrapf(err, "Failed to import job %s", job.DisplayName)
+	}
+	created, err := r.getScheduledWorkflowClient(i.namespace).Create(ctx, newSwf, v1.CreateOptions{})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create the ScheduledWorkflow of job %s", job.DisplayName)
+	}
+
+	imported := *job
+	imported.UUID = string(created.UID)
+	imported.Name = created.Name
+	imported.Namespace = i.namespace
+	imported.ServiceAccount = i.mapping.ServiceAccount(job.ServiceAccount)
+	imported.Conditions = util.NewScheduledWorkflow(created).ConditionSummary()
+	imported.UpdatedAtInSec = r.time.Now().Unix()
+	if err := i.remapPipelineSpec(&imported.PipelineSpec); err != nil {
+		return util.Wrapf(err, "Failed to import job %s", job.DisplayName)
+	}
+	imported.ResourceReferences = i.remapReferences(imported.UUID, job.ResourceReferences)
+	if _, err := r.jobStore.CreateJob(&imported); err != nil {
+		return util.Wrapf(err, "Failed to import job %s", job.DisplayName)
+	}
+	i.jobs[job.UUID] = imported.UUID
+	i.summary.Jobs++
+	return nil
+}
+
+func (i *namespaceImport) importRun(run *model.Run) error {
+	r := i.resourceManager
+	experimentId, ok := i.experiments[run.ExperimentUUID]
+	if !ok {
+		return util.NewInvalidInputError("Run %s is exported without its experiment %s", run.UUID, run.ExperimentUUID)
+	}
+	id, err := r.uuid.NewRandom()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to generate the ID of run %s", run.DisplayName)
+	}
+	imported := *run
+	imported.UUID = id.String()
+	imported.ExperimentUUID = experimentId
+	imported.Namespace = i.namespace
+	imported.ServiceAccount = i.mapping.ServiceAccount(run.ServiceAccount)
+	if err := i.remapPipelineSpec(&imported.PipelineSpec); err != nil {
+		return util.Wrapf(err, "Failed to import run %s", run.DisplayName)
+	}
+	imported.ResourceReferences = i.remapReferences(imported.UUID, run.ResourceReferences)
+	imported.Metrics = nil
+	if _, err := r.runStore.CreateRun(&model.RunDetail{Run: imported}); err != nil {
+		return util.Wrapf(err, "Failed to import run %s", run.DisplayName)
+	}
+	for _, metric := range run.Metrics {
+		importedMetric := *metric
+		importedMetric.RunUUID = imported.UUID
+		if err := r.runStore.ReportMetric(&importedMetric); err != nil {
+			return util.Wrapf(err, "Failed to import the metrics of run %s", run.DisplayName)
+		}
+	}
+	i.summary.Runs++
+	return nil
+}
+
+// remapReferences points the resource references of an imported job or run at
+// the imported resources. The references to resources which weren't exported,
+// e.g. shared pipelines, are kept if they exist, and dropped otherwise.
+func (i *namespaceImport) remapReferences(resourceId string, references []*model.ResourceReference) []*model.ResourceReference {
+	var remapped []*model.ResourceReference
+	for _, reference := range references {
+		imported := *reference
+		imported.ResourceUUID = resourceId
+		var ids map[string]string
+		switch reference.ReferenceType {
+		case common.Namespace:
+			imported.ReferenceUUID = i.namespace
+			imported.ReferenceName = i.namespace
+			remapped = append(remapped, &imported)
+			continue
+		case common.Experiment:
+			ids = i.experiments
+		case common.Pipeline:
+			ids = i.pipelines
+		case common.PipelineVersion:
+			ids = i.versions
+		case common.Job:
+			ids = i.jobs
+		}
+		if id, ok := ids[reference.ReferenceUUID]; ok {
+			imported.ReferenceUUID = id
+		} else if _, err := i.resourceManager.getResourceName(reference.ReferenceType, reference.ReferenceUUID); err != nil {
+			log.Warnf("Dropping the reference of %s to %s %s, which wasn't exported: %v", resourceId,
+				reference.ReferenceType, reference.ReferenceUUID, err)
+			continue
+		}
+		remapped = append(remapped, &imported)
+	}
+	return remapped
+}
+
+func (i *namespaceImport) remapPipelineSpec(spec *model.PipelineSpec) error {
+	if id, ok := i.pipelines[spec.PipelineId]; ok {
+		spec.PipelineId = id
+	}
+	workflowSpecManifest, err := i.remapManifest([]byte(spec.WorkflowSpecManifest))
+	if err != nil {
+		return err
+	}
+	pipelineSpecManifest, err := i.remapManifest([]byte(spec.PipelineSpecManifest))
+	if err != nil {
+		return err
+	}
+	spec.WorkflowSpecManifest = string(workflowSpecManifest)
+	spec.PipelineSpecManifest = string(pipelineSpecManifest)
+	return nil
+}
+
+func (i *namespaceImport) remapManifest(manifest []byte) ([]byte, error) {
+	remapped, _, err := template.RemapReferences(manifest, i.mapping)
+	return remapped, err
+}
+
+// remapObject renames the service accounts and secrets referenced by a
+// Kubernetes object, in place.
+func (i *namespaceImport) remapObject(object interface{}) error {
+	manifest, err := json.Marshal(object)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal the object to remap")
+	}
+	remapped, ok, err := template.RemapReferences(manifest, i.mapping)
+	if err != nil || !ok {
+		return err
+	}
+	if err := yaml.Unmarshal(remapped, object); err != nil {
+		return util.NewInternalServerError(err, "Failed to unmarshal the remapped object")
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// initNamespaceForExport creates an experiment, a pipeline with two versions, a
+// job and a run with a metric in namespace ns1.
+func initNamespaceForExport(t *testing.T) (*FakeClientManager, *ResourceManager) {
+	initEnvVars()
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	manager := NewResourceManager(store)
+	manager.uuid = util.NewUUIDGenerator()
+	experiment, err := manager.CreateExperiment(&api.Experiment{
+		Name: "e1",
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	assert.Nil(t, err)
+	pipeline, err := manager.CreatePipeline("p1", "", "ns1", []byte(testWorkflow.ToStringForStore()))
+	assert.Nil(t, err)
+	store.pipelineStore.(*storage.PipelineStore).SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	_, err = manager.CreatePipelineVersion(&api.PipelineVersion{
+		Name: "v2",
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE, Id: pipeline.UUID},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}, []byte(testWorkflow.ToStringForStore()), true)
+	assert.Nil(t, err)
+
+	experimentReference := []*api.ResourceReference{{
+		Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+		Relationship: api.Relationship_OWNER,
+	}}
+	_, err = manager.CreateJob(context.Background(), &api.Job{
+		Name:               "j1",
+		Enabled:            true,
+		ServiceAccount:     "sa1",
+		PipelineSpec:       &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: experimentReference,
+	})
+	assert.Nil(t, err)
+	run, err := manager.CreateRun(context.Background(), &api.Run{
+		Name:               "run1",
+		ServiceAccount:     "sa1",
+		PipelineSpec:       &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: experimentReference,
+	})
+	assert.Nil(t, err)
+	err = manager.ReportMetric(&api.RunMetric{
+		Name:   "accuracy",
+		NodeId: "node-1",
+		Value:  &api.RunMetric_NumberValue{NumberValue: 0.9},
+		Format: api.RunMetric_RAW,
+	}, run.UUID)
+	assert.Nil(t, err)
+	return store, manager
+}
+
+func TestExportAndImportNamespace(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	store, manager := initNamespaceForExport(t)
+	defer store.Close()
+
+	var export bytes.Buffer
+	summary, err := manager.ExportNamespace(context.Background(), "ns1", &export)
+	assert.Nil(t, err)
+	assert.Equal(t, &NamespaceExportSummary{Namespace: "ns1", Experiments: 1, Pipelines: 1, PipelineVersions: 1, Jobs: 1,
+		Runs: 1, ArtifactPrefixes: 2}, summary)
+
+	importStore := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer importStore.Close()
+	importStore.pipelineStore.(*storage.PipelineStore).SetUUIDGenerator(util.NewUUIDGenerator())
+	importManager := NewResourceManager(importStore)
+	importManager.uuid = util.NewUUIDGenerator()
+	mapping := &template.ReferenceMapping{ServiceAccounts: map[string]string{"sa1": "sa2"}}
+	imported, err := importManager.ImportNamespace(context.Background(), bytes.NewReader(export.Bytes()), "ns2", mapping)
+	assert.Nil(t, err)
+	assert.Equal(t, &NamespaceExportSummary{Namespace: "ns2", Experiments: 1, Pipelines: 1, PipelineVersions: 1, Jobs: 1,
+		Runs: 1, ArtifactPrefixes: 2}, imported)
+
+	filterContext := &common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns2"}}
+	opts, err := list.NewOptions(&model.Experiment{}, 10, "", nil)
+	assert.Nil(t, err)
+	experiments, _, _, err := importManager.ListExperiments(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 1)
+	assert.Equal(t, "e1", experiments[0].Name)
+
+	opts, err = list.NewOptions(&model.Pipeline{}, 10, "", nil)
+	assert.Nil(t, err)
+	pipelines, _, _, err := importManager.ListPipelines(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Len(t, pipelines, 1)
+	defaultVersion, err := importManager.GetPipelineVersion(pipelines[0].DefaultVersionId)
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", defaultVersion.Name)
+	_, err = importManager.GetPipelineVersionTemplate(defaultVersion.UUID)
+	assert.Nil(t, err)
+
+	// The job has a new ScheduledWorkflow, using the renamed service account.
+	opts, err = list.NewOptions(&model.Job{}, 10, "", nil)
+	assert.Nil(t, err)
+	jobs, _, _, err := importManager.ListJobs(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, "sa2", jobs[0].ServiceAccount)
+	assert.Len(t, jobs[0].ResourceReferences, 1)
+	assert.Equal(t, experiments[0].UUID, jobs[0].ResourceReferences[0].ReferenceUUID)
+	swf, err := importManager.getScheduledWorkflowClient("ns2").Get(context.Background(), jobs[0].Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "sa2", swf.Spec.Workflow.Spec.TaskRunTemplate.ServiceAccountName)
+
+	opts, err = list.NewOptions(&model.Run{}, 10, "", nil)
+	assert.Nil(t, err)
+	runs, _, _, err := importManager.ListRuns(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, "run1", runs[0].DisplayName)
+	assert.Equal(t, "sa2", runs[0].ServiceAccount)
+	assert.Equal(t, experiments[0].UUID, runs[0].ExperimentUUID)
+	assert.Len(t, runs[0].Metrics, 1)
+	assert.Equal(t, runs[0].UUID, runs[0].Metrics[0].RunUUID)
+}
+
+func TestImportNamespace_IncompleteExport(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	store, manager := initNamespaceForExport(t)
+	defer store.Close()
+	var export bytes.Buffer
+	_, err := manager.ExportNamespace(context.Background(), "ns1", &export)
+	assert.Nil(t, err)
+	lines := strings.SplitAfter(export.String(), "\n")
+
+	importStore := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer importStore.Close()
+	importManager := NewResourceManager(importStore)
+	// Only the experiment is imported.
+	truncated := strings.Join(lines[:2], "")
+	imported, err := importManager.ImportNamespace(context.Background(), strings.NewReader(truncated), "ns2", &template.ReferenceMapping{})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "The namespace export is incomplete")
+	assert.Equal(t, 1, imported.Experiments)
+
+	_, err = importManager.ImportNamespace(context.Background(), strings.NewReader(`{"kind": "header", "format_version": 2}`), "ns2",
+		&template.ReferenceMapping{})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestExportNamespace_SingleUserMode(t *testing.T) {
+	store, manager, _ := initWithExperiment(t)
+	defer store.Close()
+	var export bytes.Buffer
+	_, err := manager.ExportNamespace(context.Background(), "ns1", &export)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	assert.Equal(t, 0, export.Len())
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
+	log "github.com/sirupsen/logrus"
+)
+
+// notifyRunFinished notifies the routes of the run which finished in the state,
+// unless it was cancelled. A failure doesn't fail the report of the run. It's a
+// no-op when no notification route is configured.
+func (r *ResourceManager) notifyRunFinished(runID string, state string) {
+	if r.notifier == nil {
+		return
+	}
+	event := notification.EventOfState(state)
+	if event == "" {
+		return
+	}
+	run, err := r.runStore.GetRun(runID)
+	if err != nil {
+		log.Warnf("Failed to get run %s to notify it %s: %v", runID, event, err)
+		return
+	}
+	duration := time.Duration(run.FinishedAtInSec-run.CreatedAtInSec) * time.Second
+	r.notify(runNotification(&run.Run, event, duration))
+}
+
+// NotifyLongRunningRuns notifies the routes of the runs which ran longer than
+// the threshold during the last interval, so that each run is notified once
+// when the long running runs are looked for every interval. It returns the
+// number of runs notified.
+func (r *ResourceManager) NotifyLongRunningRuns(threshold time.Duration, interval time.Duration) (int, error) {
+	if r.notifier == nil {
+		return 0, nil
+	}
+	now := r.time.Now()
+	runs, err := r.runStore.ListUnfinishedRunsCreatedBetween(now.Add(-threshold-interval).Unix(), now.Add(-threshold).Unix())
+	if err != nil {
+		return 0, err
+	}
+	for _, run := range runs {
+		duration := now.Sub(time.Unix(run.CreatedAtInSec, 0))
+		r.notify(runNotification(&run.Run, notification.EventLongRunning, duration))
+	}
+	return len(runs), nil
+}
+
+func (r *ResourceManager) notify(n *notification.Notification) {
+	if err := r.notifier.Notify(n); err != nil {
+		log.Warnf("Failed to notify run %s %s: %v", n.RunID, n.Event, err)
+	}
+}
+
+func runNotification(run *model.Run, event string, duration time.Duration) *notification.Notification {
+	return &notification.Notification{
+		Event:        event,
+		RunID:        run.UUID,
+		RunName:      run.DisplayName,
+		Namespace:    run.Namespace,
+		ExperimentID: run.ExperimentUUID,
+		State:        run.Conditions,
+		Duration:     duration.Round(time.Second),
+	}
+}
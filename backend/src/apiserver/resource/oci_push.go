@@ -0,0 +1,168 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// The number of artifacts pushed at once to the OCI registry.
+const ociPushBatchSize = 100
+
+// The annotations of the pushed artifacts, which tools like KServe and Argo CD
+// can select them by.
+const (
+	ociAnnotationRunID           = "pipelines.kubeflow.org/run_id"
+	ociAnnotationPipelineVersion = "pipelines.kubeflow.org/pipeline_version_id"
+	ociAnnotationTask            = "pipelines.kubeflow.org/producing_task"
+	ociAnnotationArtifactName    = "pipelines.kubeflow.org/artifact_name"
+	ociAnnotationArtifactType    = "pipelines.kubeflow.org/artifact_type"
+	ociAnnotationPropertyPrefix  = "pipelines.kubeflow.org/property."
+)
+
+var ociInvalidRepositoryChars = regexp.MustCompile("[^a-z0-9._-]+")
+
+func errOCIRegistryNotConfigured() error {
+	return util.NewFailedPreconditionError(errors.New("OCI registry isn't configured"),
+		"Artifacts can't be pushed since no OCI registry is configured")
+}
+
+// PushArtifactsToRegistry pushes the indexed artifacts of the types to the OCI
+// registry, oldest first, and returns how many were pushed. An artifact that
+// fails to be pushed is retried the next time.
+func (r *ResourceManager) PushArtifactsToRegistry(types []string) (int, error) {
+	if r.ociRegistryClient == nil {
+		return 0, errOCIRegistryNotConfigured()
+	}
+	if len(types) == 0 {
+		return 0, nil
+	}
+	artifacts, err := r.artifactMetadataStore.ListArtifactsToPush(types, ociPushBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	pushed := 0
+	for _, artifact := range artifacts {
+		if _, err := r.pushArtifact(context.Background(), artifact); err != nil {
+			log.Warningf("Failed to push the artifact %v of run %v: %v", artifact.ObjectKey, artifact.RunUUID, err)
+			continue
+		}
+		pushed++
+	}
+	return pushed, nil
+}
+
+// PushArtifact pushes an artifact of a run to the OCI registry, and returns the
+// digest reference it can be pulled by. The artifact is pushed again even if it
+// already was, so that its tags point to it.
+func (r *ResourceManager) PushArtifact(ctx context.Context, runID string, nodeID string, artifactName string) (string, error) {
+	if r.ociRegistryClient == nil {
+		return "", errOCIRegistryNotConfigured()
+	}
+	_, artifactKey, err := r.getArtifactKey(runID, nodeID, artifactName)
+	if err != nil {
+		return "", err
+	}
+	artifact, err := r.artifactMetadataStore.GetArtifact(runID, artifactKey)
+	if err != nil {
+		return "", util.Wrapf(err, "Failed to get artifact %v, it's indexed once its run finishes", artifactKey)
+	}
+	return r.pushArtifact(ctx, artifact)
+}
+
+// pushArtifact pushes an indexed artifact to the OCI registry, tagged with its
+// run and the pipeline version of the run, and records its reference.
+func (r *ResourceManager) pushArtifact(ctx context.Context, artifact *model.ArtifactMetadata) (string, error) {
+	run, err := r.runStore.GetRun(artifact.RunUUID)
+	if err != nil {
+		return "", err
+	}
+	objectStore, err := r.objectStore.ForNamespace(artifact.Namespace)
+	if err != nil {
+		return "", err
+	}
+	artifactPath, err := r.resolveArtifactKey(artifact.ObjectKey)
+	if err != nil {
+		return "", err
+	}
+
+	annotations := map[string]string{
+		ociAnnotationRunID:        artifact.RunUUID,
+		ociAnnotationTask:         artifact.TaskName,
+		ociAnnotationArtifactName: artifact.Name,
+	}
+	if artifact.Type != "" {
+		annotations[ociAnnotationArtifactType] = artifact.Type
+	}
+	if artifact.CustomProperties != "" {
+		var properties map[string]string
+		if err := json.Unmarshal([]byte(artifact.CustomProperties), &properties); err != nil {
+			return "", util.NewInternalServerError(err, "Failed to unmarshal the properties of artifact %v", artifact.ObjectKey)
+		}
+		for key, value := range properties {
+			annotations[ociAnnotationPropertyPrefix+key] = value
+		}
+	}
+	tags := []string{"run-" + artifact.RunUUID}
+	for _, reference := range run.ResourceReferences {
+		if reference.ReferenceType == common.PipelineVersion {
+			tags = append(tags, "version-"+reference.ReferenceUUID)
+			annotations[ociAnnotationPipelineVersion] = reference.ReferenceUUID
+		}
+	}
+
+	reference, err := r.ociRegistryClient.Push(ctx, &client.OCIArtifact{
+		Repository:  ociRepository(artifact.Namespace, run.PipelineName, artifact.TaskName, artifact.Name),
+		Tags:        tags,
+		FileName:    path.Base(artifact.ObjectKey),
+		Size:        artifact.Size,
+		Open:        func() (io.ReadCloser, error) { return objectStore.OpenFile(artifactPath) },
+		Annotations: annotations,
+	})
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to push artifact %v to the OCI registry", artifact.ObjectKey)
+	}
+	if err := r.artifactMetadataStore.SetOCIReference(artifact.UUID, reference); err != nil {
+		return "", err
+	}
+	ociPushCounter.Inc()
+	return reference, nil
+}
+
+// ociRepository returns the repository an artifact is pushed to, below the
+// namespace and the pipeline of its run if any, named after the task producing
+// it and its name.
+func ociRepository(namespace string, pipelineName string, taskName string, artifactName string) string {
+	var components []string
+	for _, component := range []string{namespace, pipelineName, taskName + "-" + artifactName} {
+		component = strings.Trim(ociInvalidRepositoryChars.ReplaceAllString(strings.ToLower(component), "-"), "._-")
+		if component != "" {
+			components = append(components, component)
+		}
+	}
+	return strings.Join(components, "/")
+}
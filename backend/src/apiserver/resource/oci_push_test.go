@@ -0,0 +1,134 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// initWithIndexedArtifacts indexes a model and a dataset of a run created from
+// a pipeline version.
+func initWithIndexedArtifacts(t *testing.T) (*FakeClientManager, *ResourceManager) {
+	store, manager, _ := initWithDuplicateArtifacts(t)
+	workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+		ObjectMeta: v1.ObjectMeta{Name: "run-4", Namespace: "ns1", Annotations: map[string]string{
+			util.AnnotationKeyOutputArtifacts: `{"train": [
+				{"key": "artifacts/$PIPELINERUN/train/model.tgz", "name": "train-model", "type": "Model",
+				 "properties": {"framework": "tensorflow"}},
+				{"key": "artifacts/$PIPELINERUN/train/data.tgz", "name": "train-data", "type": "Dataset"}]}`,
+		}},
+		Status: tektonV1.PipelineRunStatus{PipelineRunStatusFields: tektonV1.PipelineRunStatusFields{
+			ChildReferences: []tektonV1.ChildStatusReference{{Name: "run-4-train", PipelineTaskName: "train"}},
+		}},
+	})
+	_, err := store.RunStore().CreateRun(&model.RunDetail{
+		Run: model.Run{
+			UUID:            "run4",
+			Name:            "run-4",
+			Namespace:       "ns1",
+			StorageState:    "STORAGESTATE_AVAILABLE",
+			FinishedAtInSec: 1,
+			PipelineSpec:    model.PipelineSpec{PipelineName: "My Pipeline"},
+			ResourceReferences: []*model.ResourceReference{{
+				ResourceUUID:  "run4",
+				ResourceType:  common.Run,
+				ReferenceUUID: "version1",
+				ReferenceName: "v1",
+				ReferenceType: common.PipelineVersion,
+				Relationship:  common.Creator,
+			}},
+		},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: workflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, store.objectStore.AddFile([]byte("model"), "artifacts/run-4/train/model.tgz"))
+	assert.Nil(t, store.objectStore.AddFile([]byte("data"), "artifacts/run-4/train/data.tgz"))
+	assert.Nil(t, manager.indexRunArtifacts("run4", workflow))
+	return store, manager
+}
+
+func TestPushArtifactsToRegistry(t *testing.T) {
+	store, manager := initWithIndexedArtifacts(t)
+	defer store.Close()
+	registry := store.OCIRegistryClientFake
+
+	pushed, err := manager.PushArtifactsToRegistry([]string{"Model"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, pushed)
+	assert.Len(t, registry.Artifacts, 1)
+	artifact := registry.Artifacts[0]
+	assert.Equal(t, "ns1/my-pipeline/train-model", artifact.Repository)
+	assert.Equal(t, []string{"run-run4", "version-version1"}, artifact.Tags)
+	assert.Equal(t, "model.tgz", artifact.FileName)
+	assert.Equal(t, int64(5), artifact.Size)
+	assert.Equal(t, map[string]string{
+		ociAnnotationRunID:                        "run4",
+		ociAnnotationPipelineVersion:              "version1",
+		ociAnnotationTask:                         "train",
+		ociAnnotationArtifactName:                 "model",
+		ociAnnotationArtifactType:                 "Model",
+		ociAnnotationPropertyPrefix + "framework": "tensorflow",
+	}, artifact.Annotations)
+	assert.Equal(t, []byte("model"), registry.Contents[0])
+
+	// The pushed artifacts record their reference and aren't pushed again.
+	indexed, err := store.ArtifactMetadataStore().GetArtifact("run4", "artifacts/run-4/train/model.tgz")
+	assert.Nil(t, err)
+	assert.Contains(t, indexed.OCIReference, "registry.example.com/ns1/my-pipeline/train-model@sha256:")
+	pushed, err = manager.PushArtifactsToRegistry([]string{"Model"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, pushed)
+}
+
+func TestPushArtifact(t *testing.T) {
+	store, manager := initWithIndexedArtifacts(t)
+	defer store.Close()
+
+	reference, err := manager.PushArtifact(context.Background(), "run4", "train", "data")
+
+	assert.Nil(t, err)
+	assert.Contains(t, reference, "registry.example.com/ns1/my-pipeline/train-data@sha256:")
+	assert.Equal(t, []byte("data"), store.OCIRegistryClientFake.Contents[0])
+
+	// Only the indexed artifacts of the finished runs are pushed.
+	_, err = manager.PushArtifact(context.Background(), "run1", "node", "a")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
+
+func TestPushArtifact_NotConfigured(t *testing.T) {
+	store, _ := initWithIndexedArtifacts(t)
+	defer store.Close()
+	store.OCIRegistryClientFake = nil
+	manager := NewResourceManager(store)
+
+	_, err := manager.PushArtifact(context.Background(), "run4", "train", "data")
+
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.FailedPrecondition))
+}
+
+func TestOCIRepository(t *testing.T) {
+	assert.Equal(t, "kubeflow-user/my-pipeline/train-model", ociRepository("kubeflow-user", "My Pipeline!", "train", "model"))
+	assert.Equal(t, "train-model", ociRepository("", "", "train", "model"))
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The dependencies of the API server checked by CheckDependencies.
+const (
+	DependencyDatabase    = "database"
+	DependencyObjectStore = "object_store"
+	DependencyKubernetes  = "kubernetes"
+)
+
+// The object store is reached by listing a folder nobody writes to, so that the
+// listing is cheap whatever the size of the bucket.
+const readinessProbePrefix = ".readiness/probe"
+
+// DependencyStatus is the result of the check of a dependency. Err is nil when
+// the dependency is reachable.
+type DependencyStatus struct {
+	Name    string
+	Latency time.Duration
+	Err     error
+}
+
+// CheckDependencies checks that the database, the object store and the Kubernetes
+// API are reachable. The dependencies are checked concurrently, each within the
+// timeout, and their statuses returned in that order.
+func (r *ResourceManager) CheckDependencies(ctx context.Context, timeout time.Duration) []DependencyStatus {
+	checks := []struct {
+		name  string
+		check func(ctx context.Context) error
+	}{
+		{DependencyDatabase, r.dBStatusStore.Ping},
+		{DependencyObjectStore, func(ctx context.Context) error {
+			_, err := r.objectStore.ListFiles(readinessProbePrefix)
+			return err
+		}},
+		{DependencyKubernetes, func(ctx context.Context) error {
+			_, err := r.k8sCoreClient.PodClient(common.GetPodNamespace()).List(ctx, v1.ListOptions{Limit: 1})
+			return err
+		}},
+	}
+
+	statuses := make([]DependencyStatus, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, name string, check func(ctx context.Context) error) {
+			defer wg.Done()
+			start := time.Now()
+			err := checkWithTimeout(ctx, timeout, check)
+			statuses[i] = DependencyStatus{Name: name, Latency: time.Since(start), Err: err}
+		}(i, c.name, c.check)
+	}
+	wg.Wait()
+	return statuses
+}
+
+// checkWithTimeout returns the error of the check, or an error once the timeout
+// elapses for the checks which can't be canceled, e.g. of the object store.
+func checkWithTimeout(ctx context.Context, timeout time.Duration, check func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "No response after %v", timeout)
+	}
+}
@@ -15,30 +15,42 @@
 package resource
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"sort"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/cenkalti/backoff"
-	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/audit"
 	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/webhook"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflowclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	workflowclient "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -47,6 +59,7 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 )
 
 // Metric variables. Please prefix the metric names with resource_manager_.
@@ -56,8 +69,62 @@ var (
 		Name: "resource_manager_workflow_gc",
 		Help: "The number of gabarage-collected workflows",
 	})
+
+	// Count the deleted artifacts due to the artifact retention policy.
+	artifactGCCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_artifact_gc",
+		Help: "The number of garbage-collected artifacts",
+	})
+
+	// Count the artifacts moved to the content addressed storage.
+	artifactDedupCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_artifact_dedup",
+		Help: "The number of deduplicated artifacts",
+	})
+
+	// Count the artifacts pushed to the OCI registry.
+	ociPushCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_oci_push",
+		Help: "The number of artifacts pushed to the OCI registry",
+	})
+
+	// Count the runs created through the API, for their throughput.
+	runCreatedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_runs_created",
+		Help: "The number of runs created through the API",
+	})
 )
 
+// How often a replica checks whether a lease held by another replica was released.
+const leaseRetryInterval = 2 * time.Second
+
+// The number of runs listed at once when collecting the expired artifacts.
+const artifactGCPageSize = 100
+
+// Why an artifact is garbage-collected.
+const (
+	ArtifactExpiredByAge  = "max_age"
+	ArtifactExpiredBySize = "max_total_size"
+)
+
+// ExpiredArtifact is an object of a run that the artifact retention policy
+// expires.
+type ExpiredArtifact struct {
+	RunID        string    `json:"run_id"`
+	Namespace    string    `json:"namespace,omitempty"`
+	ExperimentID string    `json:"experiment_id,omitempty"`
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last_modified"`
+	Reason       string    `json:"reason"`
+}
+
+type ArtifactGCReport struct {
+	DryRun    bool               `json:"dry_run"`
+	Artifacts []*ExpiredArtifact `json:"artifacts"`
+	TotalSize int64              `json:"total_size"`
+}
+
 type ClientManagerInterface interface {
 	ExperimentStore() storage.ExperimentStoreInterface
 	PipelineStore() storage.PipelineStoreInterface
@@ -67,13 +134,33 @@ type ClientManagerInterface interface {
 	ResourceReferenceStore() storage.ResourceReferenceStoreInterface
 	DBStatusStore() storage.DBStatusStoreInterface
 	DefaultExperimentStore() storage.DefaultExperimentStoreInterface
+	AuditStore() storage.AuditStoreInterface
+	AuditSink() audit.SinkInterface
+	// EventPublisher publishes the lifecycle events of the resources. It may
+	// be nil.
+	EventPublisher() eventbus.PublisherInterface
+	// Notifier sends the notifications of the runs to the routes of the
+	// notification policy. It may be nil.
+	Notifier() notification.NotifierInterface
+	LeaseStore() storage.LeaseStoreInterface
+	ArtifactBlobStore() storage.ArtifactBlobStoreInterface
+	UploadSessionStore() storage.UploadSessionStoreInterface
+	WebhookStore() storage.WebhookStoreInterface
+	BackupStore() storage.BackupStoreInterface
+	ArtifactMetadataStore() storage.ArtifactMetadataStoreInterface
 	ObjectStore() storage.ObjectStoreInterface
+	Encryptor() *storage.Encryptor
 	TektonClient() client.TektonClientInterface
 	SwfClient() client.SwfClientInterface
 	KubernetesCoreClient() client.KubernetesCoreInterface
 	SubjectAccessReviewClient() client.SubjectAccessReviewInterface
 	TokenReviewClient() client.TokenReviewInterface
+	MetadataClient() client.MetadataClientInterface
+	OCIRegistryClient() client.OCIRegistryClientInterface
 	LogArchive() archive.LogArchiveInterface
+	// RunEventRecorder records the Events of the runs on their PipelineRuns. It
+	// may be nil.
+	RunEventRecorder() record.EventRecorder
 	Time() util.TimeInterface
 	UUID() util.UUIDGeneratorInterface
 	Authenticators() []kfpauth.Authenticator
@@ -88,19 +175,53 @@ type ResourceManager struct {
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
+	auditStore                storage.AuditStoreInterface
+	leaseStore                storage.LeaseStoreInterface
+	artifactBlobStore         storage.ArtifactBlobStoreInterface
+	uploadSessionStore        storage.UploadSessionStoreInterface
+	webhookStore              storage.WebhookStoreInterface
+	backupStore               storage.BackupStoreInterface
+	artifactMetadataStore     storage.ArtifactMetadataStoreInterface
+	auditSink                 audit.SinkInterface
+	eventPublisher            eventbus.PublisherInterface
+	notifier                  notification.NotifierInterface
 	objectStore               storage.ObjectStoreInterface
+	encryptor                 *storage.Encryptor
 	swfClient                 client.SwfClientInterface
 	k8sCoreClient             client.KubernetesCoreInterface
 	subjectAccessReviewClient client.SubjectAccessReviewInterface
 	tokenReviewClient         client.TokenReviewInterface
+	metadataClient            client.MetadataClientInterface
+	ociRegistryClient         client.OCIRegistryClientInterface
 	logArchive                archive.LogArchiveInterface
+	runEventRecorder          record.EventRecorder
 	time                      util.TimeInterface
 	uuid                      util.UUIDGeneratorInterface
 	authenticators            []kfpauth.Authenticator
 	tektonClient              client.TektonClientInterface
+	templateCache             *template.Cache
+	artifactCache             *storage.ObjectCache
+	webhookHostPolicy         *webhook.HostPolicy
+	webhookSender             *webhook.Sender
+
+	// webhookMu guards the cache of the enabled webhook subscriptions, which
+	// is read for each event.
+	webhookMu                sync.Mutex
+	webhookSubscriptions     []*model.WebhookSubscription
+	webhookSubscriptionsTime time.Time
+
+	// drainMu guards draining, and inflightRuns counts the run creations which
+	// started before Drain was called.
+	drainMu      sync.Mutex
+	draining     bool
+	inflightRuns sync.WaitGroup
+
+	// runSubmissions signals the run submitter that runs were stored.
+	runSubmissions chan struct{}
 }
 
 func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
+	webhookHostPolicy := webhook.NewHostPolicy(common.GetWebhookAllowedHosts(), common.GetWebhookDeniedHosts())
 	return &ResourceManager{
 		tektonClient:              clientManager.TektonClient(),
 		experimentStore:           clientManager.ExperimentStore(),
@@ -111,15 +232,34 @@ func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
 		resourceReferenceStore:    clientManager.ResourceReferenceStore(),
 		dBStatusStore:             clientManager.DBStatusStore(),
 		defaultExperimentStore:    clientManager.DefaultExperimentStore(),
+		auditStore:                clientManager.AuditStore(),
+		auditSink:                 clientManager.AuditSink(),
+		eventPublisher:            clientManager.EventPublisher(),
+		notifier:                  clientManager.Notifier(),
+		leaseStore:                clientManager.LeaseStore(),
+		artifactBlobStore:         clientManager.ArtifactBlobStore(),
+		uploadSessionStore:        clientManager.UploadSessionStore(),
+		webhookStore:              clientManager.WebhookStore(),
+		backupStore:               clientManager.BackupStore(),
+		webhookHostPolicy:         webhookHostPolicy,
+		webhookSender:             webhook.NewSender(common.GetWebhookTimeout(), webhookHostPolicy),
+		artifactMetadataStore:     clientManager.ArtifactMetadataStore(),
 		objectStore:               clientManager.ObjectStore(),
+		encryptor:                 clientManager.Encryptor(),
 		swfClient:                 clientManager.SwfClient(),
 		k8sCoreClient:             clientManager.KubernetesCoreClient(),
 		subjectAccessReviewClient: clientManager.SubjectAccessReviewClient(),
 		tokenReviewClient:         clientManager.TokenReviewClient(),
+		metadataClient:            clientManager.MetadataClient(),
+		ociRegistryClient:         clientManager.OCIRegistryClient(),
 		logArchive:                clientManager.LogArchive(),
+		runEventRecorder:          clientManager.RunEventRecorder(),
 		time:                      clientManager.Time(),
 		uuid:                      clientManager.UUID(),
 		authenticators:            clientManager.Authenticators(),
+		templateCache:             template.NewCache(common.GetTemplateCacheSize()),
+		artifactCache:             storage.NewObjectCache(common.GetArtifactCacheDir(), common.GetArtifactCacheSize()),
+		runSubmissions:            make(chan struct{}, 1),
 	}
 }
 
@@ -153,59 +293,136 @@ func (r *ResourceManager) ListExperiments(filterContext *common.FilterContext, o
 }
 
 func (r *ResourceManager) DeleteExperiment(experimentID string) error {
-	_, err := r.experimentStore.GetExperiment(experimentID)
+	experiment, err := r.experimentStore.GetExperiment(experimentID)
 	if err != nil {
 		return util.Wrap(err, "Delete experiment failed")
 	}
-	return r.experimentStore.DeleteExperiment(experimentID)
+	if isSoftDeleteEnabled() {
+		err = util.Wrap(r.experimentStore.SoftDeleteExperiment(experimentID, r.time.Now().Unix()), "Delete experiment failed")
+	} else {
+		err = r.experimentStore.DeleteExperiment(experimentID)
+	}
+	if err == nil {
+		r.publishEvent(eventbus.EventTypeExperimentDeleted, experimentEventData(experiment))
+	}
+	return err
 }
 
-func (r *ResourceManager) ArchiveExperiment(ctx context.Context, experimentId string) error {
-	// To archive an experiment
-	// (1) update our persistent agent to disable CRDs of jobs in experiment
-	// (2) update database to
-	// (2.1) archive experiemnts
-	// (2.2) archive runs
-	// (2.3) disable jobs
+// ArchiveExperiment archives an experiment and, following the policy, archives
+// its runs and pauses its jobs. The ScheduledWorkflows of the jobs are paused
+// before the database is updated, and resumed if the update fails.
+func (r *ResourceManager) ArchiveExperiment(ctx context.Context, experimentId string, policy model.ExperimentArchivePolicy) (*model.ExperimentArchiveResult, error) {
+	var jobs []*model.Job
+	if policy.Jobs {
+		var err error
+		jobs, err = r.listExperimentJobs(experimentId, true)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to archive experiment")
+		}
+		if err = r.setScheduledWorkflowsEnabled(jobs, false); err != nil {
+			return nil, util.Wrap(err, "Failed to archive experiment")
+		}
+	}
+	result, err := r.experimentStore.ArchiveExperiment(experimentId, policy)
+	if err != nil {
+		r.revertScheduledWorkflowsEnabled(jobs, false)
+		return nil, err
+	}
+	for _, job := range jobs {
+		r.publishEvent(eventbus.EventTypeJobDisabled, jobEventData(job))
+	}
+	return result, nil
+}
+
+// UnarchiveExperiment restores an archived experiment and, following the
+// policy, restores its runs and enables its jobs again.
+func (r *ResourceManager) UnarchiveExperiment(experimentId string, policy model.ExperimentArchivePolicy) (*model.ExperimentArchiveResult, error) {
+	var jobs []*model.Job
+	if policy.Jobs {
+		var err error
+		jobs, err = r.listExperimentJobs(experimentId, false)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to unarchive experiment")
+		}
+		if err = r.setScheduledWorkflowsEnabled(jobs, true); err != nil {
+			return nil, util.Wrap(err, "Failed to unarchive experiment")
+		}
+	}
+	result, err := r.experimentStore.UnarchiveExperiment(experimentId, policy)
+	if err != nil {
+		r.revertScheduledWorkflowsEnabled(jobs, true)
+		return nil, err
+	}
+	for _, job := range jobs {
+		r.publishEvent(eventbus.EventTypeJobEnabled, jobEventData(job))
+	}
+	return result, nil
+}
+
+// listExperimentJobs returns the jobs of an experiment that are enabled, or
+// disabled.
+func (r *ResourceManager) listExperimentJobs(experimentId string, enabled bool) ([]*model.Job, error) {
 	opts, err := list.NewOptions(&model.Job{}, 50, "name", nil)
 	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to create list jobs options when archiving experiment. ")
+		return nil, util.NewInternalServerError(err,
+			"Failed to create list jobs options of experiment. ")
 	}
+	var result []*model.Job
 	for {
 		jobs, _, newToken, err := r.jobStore.ListJobs(&common.FilterContext{
 			ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: experimentId}}, opts)
 		if err != nil {
-			return util.NewInternalServerError(err,
-				"Failed to list jobs of to-be-archived experiment. expID: %v", experimentId)
+			return nil, util.NewInternalServerError(err,
+				"Failed to list jobs of experiment. expID: %v", experimentId)
 		}
 		for _, job := range jobs {
-			_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(
-				context.Background(),
-				job.Name,
-				types.MergePatchType,
-				[]byte(fmt.Sprintf(`{"spec":{"enabled":%s}}`, strconv.FormatBool(false))),
-				v1.PatchOptions{})
-			if err != nil {
-				return util.NewInternalServerError(err,
-					"Failed to disable job CR. jobID: %v", job.UUID)
+			if job.Enabled == enabled {
+				result = append(result, job)
 			}
 		}
 		if newToken == "" {
-			break
-		} else {
-			opts, err = list.NewOptionsFromToken(newToken, 50)
-			if err != nil {
-				return util.NewInternalServerError(err,
-					"Failed to create list jobs options from page token when archiving experiment. ")
-			}
+			return result, nil
+		}
+		opts, err = list.NewOptionsFromToken(newToken, 50)
+		if err != nil {
+			return nil, util.NewInternalServerError(err,
+				"Failed to create list jobs options from page token of experiment. ")
 		}
 	}
-	return r.experimentStore.ArchiveExperiment(experimentId)
 }
 
-func (r *ResourceManager) UnarchiveExperiment(experimentId string) error {
-	return r.experimentStore.UnarchiveExperiment(experimentId)
+// setScheduledWorkflowsEnabled enables or disables the ScheduledWorkflows of
+// the jobs. If one of them fails, the ones already changed are changed back.
+func (r *ResourceManager) setScheduledWorkflowsEnabled(jobs []*model.Job, enabled bool) error {
+	for i, job := range jobs {
+		if err := r.patchScheduledWorkflowEnabled(job, enabled); err != nil {
+			r.revertScheduledWorkflowsEnabled(jobs[:i], enabled)
+			return util.NewInternalServerError(err,
+				"Failed to enable/disable job CR. Enabled: %v, jobID: %v", enabled, job.UUID)
+		}
+	}
+	return nil
+}
+
+// revertScheduledWorkflowsEnabled changes back the ScheduledWorkflows changed by
+// setScheduledWorkflowsEnabled. The failures are only logged, since the caller
+// is already returning an error.
+func (r *ResourceManager) revertScheduledWorkflowsEnabled(jobs []*model.Job, enabled bool) {
+	for _, job := range jobs {
+		if err := r.patchScheduledWorkflowEnabled(job, !enabled); err != nil {
+			log.Errorf("Failed to revert the job CR to enabled=%v. jobID: %v, error: %v", !enabled, job.UUID, err)
+		}
+	}
+}
+
+func (r *ResourceManager) patchScheduledWorkflowEnabled(job *model.Job, enabled bool) error {
+	_, err := r.getScheduledWorkflowClient(job.Namespace).Patch(
+		context.Background(),
+		job.Name,
+		types.MergePatchType,
+		[]byte(fmt.Sprintf(`{"spec":{"enabled":%s}}`, strconv.FormatBool(enabled))),
+		v1.PatchOptions{})
+	return err
 }
 
 func (r *ResourceManager) ListPipelines(filterContext *common.FilterContext, opts *list.Options) (
@@ -218,16 +435,29 @@ func (r *ResourceManager) GetPipeline(pipelineId string) (*model.Pipeline, error
 }
 
 func (r *ResourceManager) DeletePipeline(pipelineId string) error {
-	_, err := r.pipelineStore.GetPipeline(pipelineId)
+	pipeline, err := r.pipelineStore.GetPipeline(pipelineId)
 	if err != nil {
 		return util.Wrap(err, "Delete pipeline failed")
 	}
+	if isSoftDeleteEnabled() {
+		err = util.Wrap(r.pipelineStore.SoftDeletePipeline(pipelineId, r.time.Now().Unix()), "Delete pipeline failed")
+	} else {
+		err = r.purgePipeline(pipelineId)
+	}
+	if err == nil {
+		r.publishEvent(eventbus.EventTypePipelineDeleted, pipelineEventData(pipeline))
+	}
+	return err
+}
 
+// purgePipeline deletes the pipeline and its file.
+func (r *ResourceManager) purgePipeline(pipelineId string) error {
 	// Mark pipeline as deleting so it's not visible to user.
-	err = r.pipelineStore.UpdatePipelineStatus(pipelineId, model.PipelineDeleting)
+	err := r.pipelineStore.UpdatePipelineStatus(pipelineId, model.PipelineDeleting)
 	if err != nil {
 		return util.Wrap(err, "Delete pipeline failed")
 	}
+	r.templateCache.Invalidate(pipelineId)
 
 	// Delete pipeline file and DB entry.
 	// Not fail the request if this step failed. A background run will do the cleanup.
@@ -240,12 +470,12 @@ func (r *ResourceManager) DeletePipeline(pipelineId string) error {
 	// or or exploring other performance optimization tools provided by gcs.
 	err = r.objectStore.DeleteFile(r.objectStore.GetPipelineKey(fmt.Sprint(pipelineId)))
 	if err != nil {
-		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline file for pipeline %v", pipelineId))
+		log.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline file for pipeline %v", pipelineId))
 		return nil
 	}
 	err = r.pipelineStore.DeletePipeline(pipelineId)
 	if err != nil {
-		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline DB entry for pipeline %v", pipelineId))
+		log.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline DB entry for pipeline %v", pipelineId))
 	}
 	return nil
 }
@@ -254,11 +484,24 @@ func (r *ResourceManager) UpdatePipelineDefaultVersion(pipelineId string, versio
 	return r.pipelineStore.UpdatePipelineDefaultVersion(pipelineId, versionId)
 }
 
+// checkTemplateTypeEnabled fails for the v2 pipeline specs when the server
+// doesn't accept them.
+func checkTemplateTypeEnabled(tmpl template.Template) error {
+	if tmpl.IsV2() && !common.IsFeatureEnabled(common.FeatureV2Pipelines) {
+		return util.NewFailedPreconditionError(errors.New("v2 pipelines are disabled"),
+			"KFP v2 pipelines aren't accepted by this server, compile the pipeline for Tekton")
+	}
+	return nil
+}
+
 func (r *ResourceManager) CreatePipeline(name string, description string, namespace string, pipelineFile []byte) (*model.Pipeline, error) {
 	tmpl, err := template.New(pipelineFile)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
+	if err := checkTemplateTypeEnabled(tmpl); err != nil {
+		return nil, util.Wrap(err, "Create pipeline failed")
+	}
 	if tmpl.IsV2() {
 		tmpl.OverrideV2PipelineName(name, namespace)
 	}
@@ -266,6 +509,10 @@ func (r *ResourceManager) CreatePipeline(name string, description string, namesp
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
+	warningsJSON, err := template.MarshalWarnings(tmpl.Analyze())
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Create pipeline failed")
+	}
 
 	// Create an entry with status of creating the pipeline
 	pipeline := &model.Pipeline{
@@ -278,6 +525,7 @@ func (r *ResourceManager) CreatePipeline(name string, description string, namesp
 			Name:       name,
 			Parameters: paramsJSON,
 			Status:     model.PipelineVersionCreating,
+			Warnings:   warningsJSON,
 		}}
 	newPipeline, err := r.pipelineStore.CreatePipeline(pipeline)
 	if err != nil {
@@ -285,8 +533,7 @@ func (r *ResourceManager) CreatePipeline(name string, description string, namesp
 	}
 
 	// Store the pipeline file to a path dependent on pipeline version
-	err = r.objectStore.AddFile(pipelineFile,
-		r.objectStore.GetPipelineKey(fmt.Sprint(newPipeline.DefaultVersion.UUID)))
+	err = r.addPipelineFile(pipelineFile, fmt.Sprint(newPipeline.DefaultVersion.UUID), newPipeline.Namespace)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
@@ -301,6 +548,7 @@ func (r *ResourceManager) CreatePipeline(name string, description string, namesp
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
+	r.publishEvent(eventbus.EventTypePipelineCreated, pipelineEventData(newPipeline))
 	return newPipeline, nil
 }
 
@@ -309,6 +557,7 @@ func (r *ResourceManager) UpdatePipelineStatus(pipelineId string, status model.P
 }
 
 func (r *ResourceManager) UpdatePipelineVersionStatus(pipelineId string, status model.PipelineVersionStatus) error {
+	r.templateCache.Invalidate(pipelineId)
 	return r.pipelineStore.UpdatePipelineVersionStatus(pipelineId, status)
 }
 
@@ -323,7 +572,7 @@ func (r *ResourceManager) GetPipelineTemplate(pipelineId string) ([]byte, error)
 		return nil, util.Wrap(err,
 			"Get pipeline template failed since no default version is defined")
 	}
-	template, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(fmt.Sprint(pipeline.DefaultVersion.UUID)))
+	template, err := r.getPipelineFile(fmt.Sprint(pipeline.DefaultVersion.UUID))
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline template failed")
 	}
@@ -336,6 +585,12 @@ func (r *ResourceManager) AuthenticateRequest(ctx context.Context) (string, erro
 		return "", util.NewUnauthenticatedError(errors.New("Request error: context is nil"), "Request error: context is nil.")
 	}
 
+	// The requests authenticated by the interceptor of the gRPC server, or the
+	// middleware of the HTTP server, aren't authenticated again.
+	if authentication := kfpauth.AuthenticationFromContext(ctx); authentication != nil {
+		return authentication.UserIdentity, authentication.Err
+	}
+
 	// If the request header contains the user identity, requests are authorized
 	// based on the namespace field in the request.
 	var errlist []error
@@ -350,26 +605,86 @@ func (r *ResourceManager) AuthenticateRequest(ctx context.Context) (string, erro
 }
 
 func (r *ResourceManager) CreateRun(ctx context.Context, apiRun *api.Run) (*model.RunDetail, error) {
+	if err := r.startRunCreation(); err != nil {
+		return nil, err
+	}
+	defer r.inflightRuns.Done()
+
+	runDetail, submission, err := r.generateRun(ctx, apiRun, false)
+	if err != nil {
+		return nil, err
+	}
+	// Store run metadata into database, along with the submission of its
+	// PipelineRun. The run submitter creates the PipelineRun, so that a crash
+	// can't leave a run without its PipelineRun or a PipelineRun without its run.
+	limit := common.GetMaxConcurrentRunsPerNamespace()
+	var createdRun *model.RunDetail
+	createRun := func() error {
+		_, span := tracing.Start(ctx, "RunStore.CreateRun", attribute.String("run_id", submission.RunUUID))
+		createdRun, err = r.runStore.CreateRunWithSubmission(runDetail, submission, limit)
+		tracing.End(span, err)
+		return err
+	}
+	if limit > 0 {
+		// The active runs are counted in the transaction creating the run, which
+		// doesn't prevent concurrent transactions from counting the same runs.
+		err = r.RunWithLease(common.ConcurrentRunsLeasePrefix+runDetail.Namespace, common.ConcurrentRunsLeaseTTL, createRun)
+	} else {
+		err = createRun()
+	}
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case r.runSubmissions <- struct{}{}:
+	default:
+		// The run submitter is already signaled.
+	}
+	runCreatedCounter.Inc()
+	r.publishEvent(eventbus.EventTypeRunCreated, runEventData(&createdRun.Run))
+	return createdRun, nil
+}
+
+// DryRunCreateRun validates a run and generates its PipelineRun like CreateRun,
+// without storing or submitting anything, so that the PipelineRun can be
+// reviewed. The ID of the returned run isn't reserved, and the default
+// experiment isn't created: the run has no experiment until it exists.
+func (r *ResourceManager) DryRunCreateRun(ctx context.Context, apiRun *api.Run) (*model.RunDetail, error) {
+	runDetail, _, err := r.generateRun(ctx, apiRun, true)
+	if err != nil {
+		return nil, err
+	}
+	return runDetail, nil
+}
+
+// generateRun validates a run and generates its PipelineRun, returning the run
+// to store and the submission of its PipelineRun. A dry run doesn't write to
+// the database.
+func (r *ResourceManager) generateRun(ctx context.Context, apiRun *api.Run, dryRun bool) (*model.RunDetail, *model.RunSubmission, error) {
 	// Get manifest from either of the two places:
 	// (1) raw manifest in pipeline_spec
 	// (2) pipeline version in resource_references
 	// And the latter takes priority over the former when the manifest is from pipeline_spec.pipeline_id
 	// workflow/pipeline manifest and pipeline id/version will not exist at the same time, guaranteed by the validation phase
+	_, span := tracing.Start(ctx, "ObjectStore.GetManifest")
 	manifestBytes, err := getManifestBytes(apiRun.PipelineSpec, &apiRun.ResourceReferences, r)
+	tracing.End(span, err)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	uuid, err := r.uuid.NewRandom()
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to generate run ID.")
+		return nil, nil, util.NewInternalServerError(err, "Failed to generate run ID.")
 	}
 	runId := uuid.String()
 
 	runAt := r.time.Now().Unix()
 
-	tmpl, err := template.New(manifestBytes)
+	_, span = tracing.Start(ctx, "TemplateCache.Get")
+	tmpl, err := r.templateCache.Get(getPipelineVersionId(apiRun.ResourceReferences), manifestBytes)
+	tracing.End(span, err)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	runWorkflowOptions := template.RunWorkflowOptions{
@@ -378,30 +693,62 @@ func (r *ResourceManager) CreateRun(ctx context.Context, apiRun *api.Run) (*mode
 	}
 
 	// Add a reference to the default experiment if run does not already have a containing experiment
-	ref, err := r.getDefaultExperimentIfNoExperiment(apiRun.GetResourceReferences())
+	var ref *api.ResourceReference
+	if dryRun {
+		ref, err = r.findDefaultExperimentIfNoExperiment(apiRun.GetResourceReferences())
+	} else {
+		ref, err = r.getDefaultExperimentIfNoExperiment(apiRun.GetResourceReferences())
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if ref != nil {
-		apiRun.ResourceReferences = append(apiRun.GetResourceReferences(), ref)
+		apiRun.ResourceReferences = append(withoutNamespaceReferences(apiRun.GetResourceReferences()), ref)
+	}
+
+	var namespace string
+	if common.GetExperimentIDFromAPIResourceReferences(apiRun.GetResourceReferences()) == "" {
+		// A dry run before the default experiment is created.
+		namespace = defaultExperimentNamespace(apiRun.GetResourceReferences())
+	} else {
+		namespace, err = r.getNamespaceFromExperiment(apiRun.GetResourceReferences())
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	namespace, err := r.getNamespaceFromExperiment(apiRun.GetResourceReferences())
+	budgetWarning, err := r.checkExperimentBudget(apiRun.GetResourceReferences())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	workflow, err := tmpl.RunWorkflow(apiRun, runWorkflowOptions, namespace)
+	defaults, err := r.getDefaultsOfReferencedExperiment(apiRun.GetResourceReferences())
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "failed to generate the workflow.")
+		return nil, nil, err
+	}
+	if err = defaults.applyTo(tmpl, &apiRun.PipelineSpec, &apiRun.ServiceAccount); err != nil {
+		return nil, nil, err
 	}
 
-	// Create Tekton pipelineRun CRD resource
-	newWorkflow, err := r.getWorkflowClient(namespace).Create(ctx, workflow.Get(), v1.CreateOptions{})
-	wfs, _ := json.Marshal(newWorkflow)
-	glog.Infof(string(wfs))
+	workflow, err := tmpl.RunWorkflow(apiRun, runWorkflowOptions, namespace)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to create a workflow for (%s)", workflow.Name)
+		return nil, nil, util.NewInternalServerError(err, "failed to generate the workflow.")
+	}
+	util.MergeDefaultPodTemplate(&workflow.Spec, defaults.podTemplate())
+	if _, err = util.ReferenceSecretParameters(&workflow.Spec); err != nil {
+		return nil, nil, err
+	}
+	if budgetWarning != "" {
+		log.Warningf("Run %s was created over budget. %s", runId, budgetWarning)
+		workflow.SetAnnotations(util.AnnotationKeyBudgetWarning, budgetWarning)
+	}
+	if warnings := tmpl.Analyze(); len(warnings) > 0 {
+		warningsJSON, err := template.MarshalWarnings(warnings)
+		if err != nil {
+			return nil, nil, util.NewInternalServerError(err, "Failed to marshal template warnings.")
+		}
+		log.Warningf("Run %s was created from a template with warnings: %s", runId, warningsJSON)
+		workflow.SetAnnotations(util.AnnotationKeyTemplateWarnings, warningsJSON)
 	}
 
 	// Patched the default value to apiRun
@@ -410,20 +757,28 @@ func (r *ResourceManager) CreateRun(ctx context.Context, apiRun *api.Run) (*mode
 			var err error
 			param.Value, err = common.PatchPipelineDefaultParameter(param.Value)
 			if err != nil {
-				return nil, fmt.Errorf("failed to patch default value to pipeline. Error: %v", err)
+				return nil, nil, fmt.Errorf("failed to patch default value to pipeline. Error: %v", err)
 			}
 		}
 	}
 
-	// Store run metadata into database
-	runDetail, err := r.ToModelRunDetail(apiRun, runId, util.NewWorkflow(newWorkflow), string(manifestBytes), tmpl.GetTemplateType())
+	runDetail, err := r.ToModelRunDetail(apiRun, runId, workflow, string(manifestBytes), tmpl.GetTemplateType())
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to convert run model")
+		return nil, nil, util.Wrap(err, "Failed to convert run model")
+	}
+	if runDetail.ExperimentUUID == "" && !dryRun {
+		return nil, nil, util.NewInternalServerError(nil, "Missing owning experiment UUID")
+	}
+	submission := &model.RunSubmission{
+		RunUUID:        runId,
+		Namespace:      namespace,
+		Workflow:       workflow.ToStringForStore(),
+		CreatedAtInSec: runAt,
 	}
 
 	// Assign the create at time.
 	runDetail.CreatedAtInSec = runAt
-	return r.runStore.CreateRun(runDetail)
+	return runDetail, submission, nil
 }
 
 func (r *ResourceManager) GetRun(runId string) (*model.RunDetail, error) {
@@ -436,11 +791,19 @@ func (r *ResourceManager) ListRuns(filterContext *common.FilterContext,
 }
 
 func (r *ResourceManager) ArchiveRun(runId string) error {
-	return r.runStore.ArchiveRun(runId)
+	if err := r.runStore.ArchiveRun(runId); err != nil {
+		return err
+	}
+	r.publishRunEvent(eventbus.EventTypeRunArchived, runId, "", "")
+	return nil
 }
 
 func (r *ResourceManager) UnarchiveRun(runId string) error {
-	return r.runStore.UnarchiveRun(runId)
+	if err := r.runStore.UnarchiveRun(runId); err != nil {
+		return err
+	}
+	r.publishRunEvent(eventbus.EventTypeRunUnarchived, runId, "", "")
+	return nil
 }
 
 func (r *ResourceManager) DeleteRun(ctx context.Context, runID string) error {
@@ -448,15 +811,38 @@ func (r *ResourceManager) DeleteRun(ctx context.Context, runID string) error {
 	if err != nil {
 		return util.Wrap(err, "Delete run failed")
 	}
-	namespace, err := r.GetNamespaceFromRunID(runID)
-	if err != nil {
-		return util.Wrap(err, "Delete run failed")
+	if isSoftDeleteEnabled() {
+		err = util.Wrap(r.runStore.SoftDeleteRun(runID, r.time.Now().Unix()), "Delete run failed")
+	} else {
+		err = r.purgeRun(ctx, runDetail)
+	}
+	if err == nil {
+		r.publishEvent(eventbus.EventTypeRunDeleted, runEventData(&runDetail.Run))
 	}
-	err = r.getWorkflowClient(namespace).Delete(ctx, runDetail.Name, v1.DeleteOptions{})
+	return err
+}
+
+// purgeRun deletes the run, its workflow and its artifacts.
+func (r *ResourceManager) purgeRun(ctx context.Context, runDetail *model.RunDetail) error {
+	runID := runDetail.UUID
+	namespace := runDetail.Namespace
+	err := r.getWorkflowClient(namespace).Delete(ctx, runDetail.Name, v1.DeleteOptions{})
 	if err != nil {
 		// API won't need to delete the workflow CR
 		// once persistent agent sync the state to DB and set TTL for it.
-		glog.Warningf("Failed to delete run %v. Error: %v", runDetail.Name, err.Error())
+		log.Warningf("Failed to delete run %v. Error: %v", runDetail.Name, err.Error())
+	}
+	objectStore, err := r.objectStore.ForNamespace(namespace)
+	if err != nil {
+		return util.Wrap(err, "Delete run failed")
+	}
+	err = r.releaseRunArtifacts(objectStore, runID)
+	if err != nil {
+		return util.Wrap(err, "Delete run failed")
+	}
+	err = r.artifactMetadataStore.DeleteRunArtifacts(runID)
+	if err != nil {
+		return util.Wrap(err, "Delete run failed")
 	}
 	err = r.runStore.DeleteRun(runID)
 	if err != nil {
@@ -494,8 +880,9 @@ func (r *ResourceManager) ListJobs(filterContext *common.FilterContext,
 	return r.jobStore.ListJobs(filterContext, opts)
 }
 
-// TerminateWorkflow terminates a pipelinerun by setting its status to Cancelled
-func TerminateWorkflow(ctx context.Context, wfClient workflowclient.PipelineRunInterface, name string) error {
+// TerminateWorkflow terminates a pipelinerun by setting its status to Cancelled,
+// and returns the patched pipelinerun.
+func TerminateWorkflow(ctx context.Context, wfClient workflowclient.PipelineRunInterface, name string) (*workflowapi.PipelineRun, error) {
 	patchObj := map[string]interface{}{
 		"spec": map[string]interface{}{
 			"status": common.GetTerminateStatus(),
@@ -504,16 +891,17 @@ func TerminateWorkflow(ctx context.Context, wfClient workflowclient.PipelineRunI
 
 	patch, err := json.Marshal(patchObj)
 	if err != nil {
-		return util.NewInternalServerError(err, "Unexpected error while marshalling a patch object.")
+		return nil, util.NewInternalServerError(err, "Unexpected error while marshalling a patch object.")
 	}
 
+	var workflow *workflowapi.PipelineRun
 	var operation = func() error {
-		_, err = wfClient.Patch(ctx, name, types.MergePatchType, patch, v1.PatchOptions{})
+		workflow, err = wfClient.Patch(ctx, name, types.MergePatchType, patch, v1.PatchOptions{})
 		return err
 	}
 	var backoffPolicy = backoff.WithMaxRetries(backoff.NewConstantBackOff(100), 10)
 	err = backoff.Retry(operation, backoffPolicy)
-	return err
+	return workflow, err
 }
 
 func (r *ResourceManager) TerminateRun(ctx context.Context, runId string) error {
@@ -532,10 +920,16 @@ func (r *ResourceManager) TerminateRun(ctx context.Context, runId string) error
 		return util.Wrap(err, "Terminate run failed")
 	}
 
-	err = TerminateWorkflow(ctx, r.getWorkflowClient(namespace), runDetail.Run.Name)
+	workflow, err := TerminateWorkflow(ctx, r.getWorkflowClient(namespace), runDetail.Run.Name)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to terminate the run")
 	}
+	if user := r.requestUser(ctx); user != "" {
+		r.recordRunEvent(workflow, util.EventReasonRunTerminated, "Terminated by user %v through Kubeflow Pipelines", user)
+	} else {
+		r.recordRunEvent(workflow, util.EventReasonRunTerminated, "Terminated through Kubeflow Pipelines")
+	}
+	r.publishEvent(eventbus.EventTypeRunTerminated, runEventData(&runDetail.Run))
 	return nil
 }
 
@@ -583,6 +977,7 @@ func (r *ResourceManager) RetryRun(ctx context.Context, runId string) error {
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to update the database entry.")
 	}
+	r.publishEvent(eventbus.EventTypeRunRetried, runEventData(&runDetail.Run))
 	return nil
 }
 
@@ -596,12 +991,16 @@ func (r *ResourceManager) readRunLogFromArchive(run *model.RunDetail, nodeId str
 		return util.NewInternalServerError(err, "Failed to retrieve the runtime pipeline spec from the run")
 	}
 
-	logPath, err := r.logArchive.GetLogObjectKey(workflow, nodeId)
+	logPath, err := archivedLogKey(r.logArchive, workflow, nodeId)
 	if err != nil {
 		return err
 	}
 
-	logContent, err := r.objectStore.GetFile(logPath)
+	objectStore, err := r.objectStore.ForNamespace(workflow.Namespace)
+	if err != nil {
+		return err
+	}
+	logContent, err := objectStore.GetFile(logPath)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to retrieve the log file from archive")
 	}
@@ -615,6 +1014,57 @@ func (r *ResourceManager) readRunLogFromArchive(run *model.RunDetail, nodeId str
 	return nil
 }
 
+// archivedLogKey returns where the log of a pod of the workflow was archived.
+// The location recorded by the persistence agent takes precedence over the one
+// derived from the log archive configuration.
+func archivedLogKey(logArchive archive.LogArchiveInterface, workflow *util.Workflow, podName string) (string, error) {
+	if recorded, ok := workflow.GetAnnotations()[util.AnnotationKeyArchivedLogs]; ok {
+		keys := map[string]string{}
+		if err := json.Unmarshal([]byte(recorded), &keys); err == nil && keys[podName] != "" {
+			return keys[podName], nil
+		}
+	}
+	return logArchive.GetLogObjectKey(workflow, podName)
+}
+
+// ArchiveTaskRunLog copies the log of a completed TaskRun pod to the log
+// archive, so that it can still be read once the pod is garbage collected, and
+// returns the object store key it was written to.
+func (r *ResourceManager) ArchiveTaskRunLog(ctx context.Context, workflow *util.Workflow, podName string) (string, error) {
+	if r.logArchive == nil {
+		return "", util.NewFailedPreconditionError(errors.New("log archive is not configured"),
+			"Failed to archive the log of pod %s", podName)
+	}
+	key, err := r.logArchive.GetLogObjectKey(workflow, podName)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to archive the log of pod %s", podName)
+	}
+	logOptions := r.getPodLogOptions(false)
+	content, err := r.k8sCoreClient.PodClient(workflow.Namespace).GetLogs(podName, &logOptions).DoRaw(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", util.NewNotFoundError(err, "Failed to archive the log of pod %s", podName)
+		}
+		return "", util.NewInternalServerError(err, "Failed to read the log of pod %s", podName)
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(content); err != nil {
+		return "", util.NewInternalServerError(err, "Failed to compress the log of pod %s", podName)
+	}
+	if err := writer.Close(); err != nil {
+		return "", util.NewInternalServerError(err, "Failed to compress the log of pod %s", podName)
+	}
+	objectStore, err := r.objectStore.ForNamespace(workflow.Namespace)
+	if err != nil {
+		return "", util.Wrapf(err, "Failed to archive the log of pod %s", podName)
+	}
+	if err := objectStore.AddFileInNamespace(compressed.Bytes(), key, workflow.Namespace); err != nil {
+		return "", util.Wrapf(err, "Failed to archive the log of pod %s", podName)
+	}
+	return key, nil
+}
+
 func (r *ResourceManager) ReadLog(ctx context.Context, runId string, nodeId string, follow bool, dst io.Writer) error {
 	run, err := r.checkRunExist(runId)
 	if err != nil {
@@ -644,7 +1094,7 @@ func (r *ResourceManager) readRunLogFromPod(ctx context.Context, run *model.RunD
 	podLogs, err := req.Stream(ctx)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
-			glog.Errorf("Failed to access Pod log: %v", err)
+			log.Errorf("Failed to access Pod log: %v", err)
 		}
 		return util.NewInternalServerError(err, "error in opening log stream")
 	}
@@ -685,7 +1135,7 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.Job) (*mode
 		return nil, err
 	}
 
-	tmpl, err := template.New(manifestBytes)
+	tmpl, err := r.templateCache.Get(getPipelineVersionId(apiJob.ResourceReferences), manifestBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -696,7 +1146,7 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.Job) (*mode
 		return nil, err
 	}
 	if ref != nil {
-		apiJob.ResourceReferences = append(apiJob.GetResourceReferences(), ref)
+		apiJob.ResourceReferences = append(withoutNamespaceReferences(apiJob.GetResourceReferences()), ref)
 	}
 
 	namespace, err := r.getNamespaceFromExperiment(apiJob.GetResourceReferences())
@@ -704,11 +1154,39 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.Job) (*mode
 		return nil, err
 	}
 
+	budgetWarning, err := r.checkExperimentBudget(apiJob.GetResourceReferences())
+	if err != nil {
+		return nil, err
+	}
+	if budgetWarning != "" {
+		log.Warningf("Job %s was created over budget. %s", apiJob.GetName(), budgetWarning)
+	}
+
+	defaults, err := r.getDefaultsOfReferencedExperiment(apiJob.GetResourceReferences())
+	if err != nil {
+		return nil, err
+	}
+	if err = defaults.applyTo(tmpl, &apiJob.PipelineSpec, &apiJob.ServiceAccount); err != nil {
+		return nil, err
+	}
+
 	scheduledWorkflow, err := tmpl.ScheduledWorkflow(apiJob, namespace)
 
 	if err != nil {
 		return nil, util.Wrap(err, "failed to generate the scheduledWorkflow.")
 	}
+	util.MergeDefaultPodTemplate(&scheduledWorkflow.Spec.Workflow.Spec, defaults.podTemplate())
+	secretReferences, err := util.ReferenceSecretParameters(&scheduledWorkflow.Spec.Workflow.Spec)
+	if err != nil {
+		return nil, err
+	}
+	// The controller overrides the parameters of the PipelineRuns it creates with
+	// the ones of the scheduled workflow.
+	for i, parameter := range scheduledWorkflow.Spec.Workflow.Parameters {
+		if reference, ok := secretReferences[parameter.Name]; ok {
+			scheduledWorkflow.Spec.Workflow.Parameters[i].Value = reference
+		}
+	}
 
 	newScheduledWorkflow, err := r.getScheduledWorkflowClient(namespace).Create(ctx, scheduledWorkflow, v1.CreateOptions{})
 	if err != nil {
@@ -723,7 +1201,12 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.Job) (*mode
 	now := r.time.Now().Unix()
 	job.CreatedAtInSec = now
 	job.UpdatedAtInSec = now
-	return r.jobStore.CreateJob(job)
+	job, err = r.jobStore.CreateJob(job)
+	if err != nil {
+		return nil, err
+	}
+	r.publishEvent(eventbus.EventTypeJobCreated, jobEventData(job))
+	return job, nil
 }
 
 func (r *ResourceManager) EnableJob(ctx context.Context, jobID string, enabled bool) error {
@@ -740,12 +1223,7 @@ func (r *ResourceManager) EnableJob(ctx context.Context, jobID string, enabled b
 		return util.Wrap(err, "Enable/Disable job failed")
 	}
 
-	_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(
-		context.Background(),
-		job.Name,
-		types.MergePatchType,
-		[]byte(fmt.Sprintf(`{"spec":{"enabled":%s}}`, strconv.FormatBool(enabled))),
-		v1.PatchOptions{})
+	err = r.patchScheduledWorkflowEnabled(job, enabled)
 	if err != nil {
 		return util.NewInternalServerError(err,
 			"Failed to enable/disable job CR. Enabled: %v, jobID: %v",
@@ -758,6 +1236,11 @@ func (r *ResourceManager) EnableJob(ctx context.Context, jobID string, enabled b
 			enabled, jobID)
 	}
 
+	if enabled {
+		r.publishEvent(eventbus.EventTypeJobEnabled, jobEventData(job))
+	} else {
+		r.publishEvent(eventbus.EventTypeJobDisabled, jobEventData(job))
+	}
 	return nil
 }
 
@@ -766,8 +1249,25 @@ func (r *ResourceManager) DeleteJob(ctx context.Context, jobID string) error {
 	if err != nil {
 		return util.Wrap(err, "Delete job failed")
 	}
+	if isSoftDeleteEnabled() {
+		// The job stops scheduling runs, and stays disabled once restored.
+		if err := r.EnableJob(ctx, jobID, false); err != nil {
+			return util.Wrap(err, "Delete job failed")
+		}
+		err = util.Wrap(r.jobStore.SoftDeleteJob(jobID, r.time.Now().Unix()), "Delete job failed")
+	} else {
+		err = r.purgeJob(ctx, job)
+	}
+	if err == nil {
+		r.publishEvent(eventbus.EventTypeJobDeleted, jobEventData(job))
+	}
+	return err
+}
 
-	err = r.getScheduledWorkflowClient(job.Namespace).Delete(ctx, job.Name, v1.DeleteOptions{})
+// purgeJob deletes the job and its scheduled workflow.
+func (r *ResourceManager) purgeJob(ctx context.Context, job *model.Job) error {
+	jobID := job.UUID
+	err := r.getScheduledWorkflowClient(job.Namespace).Delete(ctx, job.Name, v1.DeleteOptions{})
 	if err != nil {
 		if !util.IsNotFound(err) {
 			// For any error other than NotFound
@@ -775,7 +1275,7 @@ func (r *ResourceManager) DeleteJob(ctx context.Context, jobID string) error {
 		}
 
 		// The ScheduledWorkflow was not found.
-		glog.Infof("Deleting job '%v', but skipped deleting ScheduledWorkflow '%v' in namespace '%v' because it was not found. jobID: %v", job.Name, job.Name, job.Namespace, jobID)
+		log.Infof("Deleting job '%v', but skipped deleting ScheduledWorkflow '%v' in namespace '%v' because it was not found. jobID: %v", job.Name, job.Name, job.Namespace, jobID)
 		// Continue the execution, because we want to delete the
 		// ScheduledWorkflow. We can skip deleting the ScheduledWorkflow
 		// when it no longer exists.
@@ -797,6 +1297,15 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 	if len(workflow.Namespace) == 0 {
 		return util.NewInvalidInputError("Workflow missing namespace")
 	}
+	if workflow.IsStatusDelta() {
+		if err := r.mergeStatusDelta(runId, workflow); err != nil {
+			return err
+		}
+	} else if _, ok := workflow.GetAnnotations()[util.AnnotationKeyCustomRunStatuses]; ok {
+		if err := r.retainCustomRunStatuses(runId, workflow); err != nil {
+			return err
+		}
+	}
 
 	if workflow.PersistedFinalState() {
 		// If workflow's final state has being persisted, the workflow should be garbage collected.
@@ -817,6 +1326,11 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 	// If the run was Running and got terminated (activeDeadlineSeconds set to 0),
 	// ignore its condition and mark it as such
 	condition := workflow.Condition()
+	if workflow.IsStalled() {
+		// The run stays stalled until its PipelineRun progresses again.
+		condition = util.StalledCondition
+	}
+	previousCondition, runStored := r.storedRunCondition(runId)
 	if jobId == "" {
 		// If a run doesn't have job ID, it's a one-time run created by Pipeline API server.
 		// In this case the DB entry should already been created when argo workflow CR is created.
@@ -826,7 +1340,7 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 			}
 			// Handle run not found in run store error.
 			// To avoid letting the workflow leak for ever, we need to GC it when its record does not exist in KFP DB.
-			glog.Errorf("Cannot find reported workflow name=%q namespace=%q runId=%q in run store. "+
+			log.Errorf("Cannot find reported workflow name=%q namespace=%q runId=%q in run store. "+
 				"Deleting the workflow to avoid resource leaking. "+
 				"This can be caused by installing two KFP instances that try to manage the same workflows "+
 				"or an unknown bug. If you encounter this, recommend reporting more details in https://github.com/kubeflow/pipelines/issues/6189.",
@@ -894,9 +1408,27 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 		if err != nil {
 			return util.Wrap(err, "Failed to create or update the run.")
 		}
+		if !runStored {
+			r.publishEvent(eventbus.EventTypeJobTriggered, eventbus.EventData{
+				ResourceType: eventbus.ResourceTypeJob,
+				ResourceID:   jobId,
+				Name:         jobName,
+				Namespace:    workflow.Namespace,
+				ExperimentID: experimentRef.ReferenceUUID,
+				RunID:        runId,
+			})
+		}
+	}
+	if condition != "" && condition != previousCondition {
+		r.publishRunEvent(eventbus.EventTypeRunPhaseChanged, runId, condition, previousCondition)
 	}
 
 	if workflow.IsInFinalState() {
+		// The artifacts are indexed before the final state is marked as persisted,
+		// so that the run is reported again if indexing fails.
+		if err := r.indexRunArtifacts(runId, workflow); err != nil {
+			return util.Wrapf(err, "Failed to index the artifacts of run %s", runId)
+		}
 		err := AddWorkflowLabel(ctx, r.getWorkflowClient(workflow.Namespace), workflow.Name, util.LabelKeyWorkflowPersistedFinalState, "true")
 		if err != nil {
 			message := fmt.Sprintf("Failed to add PersistedFinalState label to workflow %s", workflow.GetName())
@@ -909,11 +1441,58 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 				return util.Wrapf(err, message)
 			}
 		}
+		if !workflow.PersistedFinalState() {
+			r.publishRunEvent(eventbus.EventTypeRunFinished, runId, condition, "")
+			r.notifyRunFinished(runId, condition)
+		}
 	}
 
 	return nil
 }
 
+// mergeStatusDelta completes a workflow reported with only the TaskRun and
+// CustomRun statuses that changed, using the runtime manifest stored for the run.
+func (r *ResourceManager) mergeStatusDelta(runId string, workflow *util.Workflow) error {
+	base, err := r.getStoredWorkflow(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run to apply a status delta")
+	}
+	return util.MergeStatusDelta(base, workflow)
+}
+
+// retainCustomRunStatuses keeps the statuses of the CustomRuns, such as loop
+// iterations, that were garbage collected since the previous report of a run.
+func (r *ResourceManager) retainCustomRunStatuses(runId string, workflow *util.Workflow) error {
+	base, err := r.getStoredWorkflow(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run to retain the CustomRun statuses")
+	}
+	if base == nil {
+		return nil
+	}
+	return util.RetainChildStatuses(base, workflow, util.AnnotationKeyCustomRunStatuses)
+}
+
+// getStoredWorkflow returns the runtime manifest stored for a run, or nil if
+// there is none yet.
+func (r *ResourceManager) getStoredWorkflow(runId string) (*util.Workflow, error) {
+	run, err := r.runStore.GetRun(runId)
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if run.WorkflowRuntimeManifest == "" {
+		return nil, nil
+	}
+	var storedWorkflow workflowapi.PipelineRun
+	if err := json.Unmarshal([]byte(run.WorkflowRuntimeManifest), &storedWorkflow); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to unmarshal the stored workflow of run %s", runId)
+	}
+	return util.NewWorkflow(&storedWorkflow), nil
+}
+
 // AddWorkflowLabel add label for a workflow
 func AddWorkflowLabel(ctx context.Context, wfClient workflowclient.PipelineRunInterface, name string, labelKey string, labelValue string) error {
 	patchObj := map[string]interface{}{
@@ -979,17 +1558,24 @@ func (r *ResourceManager) getWorkflowSpecBytesFromPipelineSpec(spec *api.Pipelin
 	return nil, util.NewInvalidInputError("Please provide a valid pipeline spec")
 }
 
-func (r *ResourceManager) getManifestBytesFromPipelineVersion(references []*api.ResourceReference) ([]byte, error) {
+// getPipelineVersionId returns the ID of the pipeline version that created the
+// run or job, or an empty string if it was created from an inline manifest.
+func getPipelineVersionId(references []*api.ResourceReference) string {
 	var pipelineVersionId = ""
 	for _, reference := range references {
 		if reference.Key.Type == api.ResourceType_PIPELINE_VERSION && reference.Relationship == api.Relationship_CREATOR {
 			pipelineVersionId = reference.Key.Id
 		}
 	}
+	return pipelineVersionId
+}
+
+func (r *ResourceManager) getManifestBytesFromPipelineVersion(references []*api.ResourceReference) ([]byte, error) {
+	pipelineVersionId := getPipelineVersionId(references)
 	if len(pipelineVersionId) == 0 {
 		return nil, util.NewInvalidInputError("No pipeline version.")
 	}
-	manifestBytes, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(pipelineVersionId))
+	manifestBytes, err := r.getPipelineFile(pipelineVersionId)
 	if err != nil {
 		return nil, util.Wrap(err, "Get manifest bytes from PipelineVersion failed.")
 	}
@@ -1025,14 +1611,14 @@ func (r *ResourceManager) CreateDefaultExperiment() (string, error) {
 	}
 	// If default experiment ID is already present, don't fail, simply return.
 	if defaultExperimentId != "" {
-		glog.Infof("Default experiment already exists! ID: %v", defaultExperimentId)
+		log.Infof("Default experiment already exists! ID: %v", defaultExperimentId)
 		return "", nil
 	}
 
 	// Create default experiment
 	defaultExperiment := &api.Experiment{
-		Name:        "Default",
-		Description: "All runs created without specifying an experiment will be grouped here.",
+		Name:        common.GetDefaultExperimentName(""),
+		Description: common.GetDefaultExperimentDescription(""),
 	}
 	experiment, err := r.CreateExperiment(defaultExperiment)
 	if err != nil {
@@ -1045,12 +1631,13 @@ func (r *ResourceManager) CreateDefaultExperiment() (string, error) {
 		return "", fmt.Errorf("Failed to set default experiment ID. Err: %v", err)
 	}
 
-	glog.Infof("Default experiment is set. ID is: %v", experiment.UUID)
+	log.Infof("Default experiment is set. ID is: %v", experiment.UUID)
 	return experiment.UUID, nil
 }
 
 // getDefaultExperimentIfNoExperiment If the provided run does not include a reference to a containing
-// experiment, then we fetch the default experiment's ID and create a reference to that.
+// experiment, then we fetch the default experiment's ID and create a reference to that. In multi-user
+// mode, it's the default experiment of the namespace the run references.
 func (r *ResourceManager) getDefaultExperimentIfNoExperiment(references []*api.ResourceReference) (*api.ResourceReference, error) {
 	// First check if there is already a referenced experiment
 	for _, ref := range references {
@@ -1059,11 +1646,190 @@ func (r *ResourceManager) getDefaultExperimentIfNoExperiment(references []*api.R
 		}
 	}
 	if common.IsMultiUserMode() {
-		return nil, util.NewInvalidInputError("Experiment is required in resource references.")
+		namespace := common.GetNamespaceFromAPIResourceReferences(references)
+		if namespace == "" {
+			return nil, util.NewInvalidInputError("Experiment or namespace is required in resource references.")
+		}
+		experimentId, err := r.GetNamespaceDefaultExperimentId(namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &api.ResourceReference{
+			Key:          &api.ResourceKey{Id: experimentId, Type: api.ResourceType_EXPERIMENT},
+			Relationship: api.Relationship_OWNER,
+		}, nil
 	}
 	return r.getDefaultExperimentResourceReference(references)
 }
 
+// findDefaultExperimentIfNoExperiment is the read-only getDefaultExperimentIfNoExperiment of
+// the dry runs: it returns nil when the default experiment isn't created yet.
+func (r *ResourceManager) findDefaultExperimentIfNoExperiment(references []*api.ResourceReference) (*api.ResourceReference, error) {
+	for _, ref := range references {
+		if ref.Key.Type == api.ResourceType_EXPERIMENT && ref.Relationship == api.Relationship_OWNER {
+			return nil, nil
+		}
+	}
+	var experimentId string
+	var err error
+	if common.IsMultiUserMode() {
+		namespace := common.GetNamespaceFromAPIResourceReferences(references)
+		if namespace == "" {
+			return nil, util.NewInvalidInputError("Experiment or namespace is required in resource references.")
+		}
+		experimentId, err = r.getNamespaceDefaultExperimentId(namespace)
+	} else {
+		experimentId, err = r.GetDefaultExperimentId()
+	}
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to retrieve default experiment")
+	}
+	if experimentId == "" {
+		return nil, nil
+	}
+	return &api.ResourceReference{
+		Key:          &api.ResourceKey{Id: experimentId, Type: api.ResourceType_EXPERIMENT},
+		Relationship: api.Relationship_OWNER,
+	}, nil
+}
+
+// defaultExperimentNamespace returns the namespace of the default experiment
+// that the references resolve to, like getNamespaceFromExperiment once it exists.
+func defaultExperimentNamespace(references []*api.ResourceReference) string {
+	if common.IsMultiUserMode() {
+		return common.GetNamespaceFromAPIResourceReferences(references)
+	}
+	return common.GetPodNamespace()
+}
+
+// withoutNamespaceReferences drops the namespace references, which are replaced
+// by the reference to the default experiment of the namespace.
+func withoutNamespaceReferences(references []*api.ResourceReference) []*api.ResourceReference {
+	var filtered []*api.ResourceReference
+	for _, ref := range references {
+		if ref.Key.Type != api.ResourceType_NAMESPACE {
+			filtered = append(filtered, ref)
+		}
+	}
+	return filtered
+}
+
+// GetNamespaceDefaultExperimentId returns the ID of the default experiment of a
+// namespace, which is created with the configured name and description the first
+// time it's needed. In single-user mode, it's the default experiment shared by
+// all the runs.
+func (r *ResourceManager) GetNamespaceDefaultExperimentId(namespace string) (string, error) {
+	if !common.IsMultiUserMode() {
+		ref, err := r.getDefaultExperimentResourceReference(nil)
+		if err != nil {
+			return "", err
+		}
+		return ref.Key.Id, nil
+	}
+	experimentId, err := r.getNamespaceDefaultExperimentId(namespace)
+	if err != nil || experimentId != "" {
+		return experimentId, err
+	}
+	err = r.RunWithLease(common.DefaultExperimentLeasePrefix+namespace, common.DefaultExperimentLeaseTTL, func() error {
+		// Another replica may have created it while this one waited for the lease.
+		experimentId, err = r.getNamespaceDefaultExperimentId(namespace)
+		if err != nil || experimentId != "" {
+			return err
+		}
+		experimentId, err = r.createNamespaceDefaultExperiment(namespace)
+		return err
+	})
+	if err != nil {
+		return "", util.Wrapf(err, "Failed to create the default experiment of namespace %v", namespace)
+	}
+	return experimentId, nil
+}
+
+// getNamespaceDefaultExperimentId returns the ID of the default experiment of a
+// namespace, or the empty string if it has none or its experiment was deleted.
+func (r *ResourceManager) getNamespaceDefaultExperimentId(namespace string) (string, error) {
+	experimentId, err := r.defaultExperimentStore.GetNamespaceDefaultExperimentId(namespace)
+	if err != nil || experimentId == "" {
+		return "", err
+	}
+	if _, err := r.experimentStore.GetExperiment(experimentId); err != nil {
+		if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+			return "", nil
+		}
+		return "", util.Wrapf(err, "Failed to get the default experiment of namespace %v", namespace)
+	}
+	return experimentId, nil
+}
+
+// createNamespaceDefaultExperiment creates the default experiment of a namespace.
+// If the namespace already has an experiment with the name, like one created by
+// hand, it becomes the default experiment.
+func (r *ResourceManager) createNamespaceDefaultExperiment(namespace string) (string, error) {
+	name := common.GetDefaultExperimentName(namespace)
+	experiment, err := r.CreateExperiment(&api.Experiment{
+		Name:        name,
+		Description: common.GetDefaultExperimentDescription(namespace),
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Id: namespace, Type: api.ResourceType_NAMESPACE},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	if err != nil {
+		if !util.IsUserErrorCodeMatch(err, codes.AlreadyExists) {
+			return "", err
+		}
+		experiment, err = r.getExperimentByName(namespace, name)
+		if err != nil {
+			return "", err
+		}
+	}
+	if err := r.defaultExperimentStore.SetNamespaceDefaultExperimentId(namespace, experiment.UUID); err != nil {
+		return "", err
+	}
+	log.Infof("The default experiment of namespace %v is set. ID is: %v", namespace, experiment.UUID)
+	return experiment.UUID, nil
+}
+
+func (r *ResourceManager) getExperimentByName(namespace string, name string) (*model.Experiment, error) {
+	filter := &api.Filter{Predicates: []*api.Predicate{{
+		Op:    api.Predicate_EQUALS,
+		Key:   "name",
+		Value: &api.Predicate_StringValue{StringValue: name},
+	}}}
+	opts, err := list.NewOptions(&model.Experiment{}, 1, "", filter)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create the list options")
+	}
+	filterContext := &common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: namespace}}
+	experiments, _, _, err := r.experimentStore.ListExperiments(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to find experiment %v in namespace %v", name, namespace)
+	}
+	if len(experiments) == 0 {
+		return nil, util.NewResourceNotFoundError("Experiment", name)
+	}
+	return experiments[0], nil
+}
+
+// SetNamespaceDefaultExperiment makes an experiment of a namespace its default
+// experiment, grouping the runs and jobs created without one from now on.
+func (r *ResourceManager) SetNamespaceDefaultExperiment(namespace string, experimentId string) error {
+	if !common.IsMultiUserMode() {
+		return util.NewInvalidInputError("The namespaces only have their own default experiment in multi-user mode.")
+	}
+	experiment, err := r.experimentStore.GetExperiment(experimentId)
+	if err != nil {
+		return util.Wrap(err, "Failed to set the default experiment")
+	}
+	if experiment.Namespace != namespace {
+		return util.NewInvalidInputError("Experiment %v isn't in namespace %v.", experimentId, namespace)
+	}
+	if experiment.StorageState == api.Experiment_STORAGESTATE_ARCHIVED.String() {
+		return util.NewInvalidInputError("Experiment %v is archived.", experimentId)
+	}
+	return r.defaultExperimentStore.SetNamespaceDefaultExperimentId(namespace, experimentId)
+}
+
 func (r *ResourceManager) getDefaultExperimentResourceReference(references []*api.ResourceReference) (*api.ResourceReference, error) {
 	// Create reference to the default experiment
 	defaultExperimentId, err := r.GetDefaultExperimentId()
@@ -1071,7 +1837,7 @@ func (r *ResourceManager) getDefaultExperimentResourceReference(references []*ap
 		return nil, util.NewInternalServerError(err, "Failed to retrieve default experiment")
 	}
 	if defaultExperimentId == "" {
-		glog.Info("No default experiment was found. Creating a new default experiment")
+		log.Info("No default experiment was found. Creating a new default experiment")
 		defaultExperimentId, err = r.CreateDefaultExperiment()
 		if defaultExperimentId == "" || err != nil {
 			return nil, util.NewInternalServerError(err, "Failed to create new default experiment")
@@ -1093,14 +1859,116 @@ func (r *ResourceManager) ReportMetric(metric *api.RunMetric, runUUID string) er
 }
 
 // ReadArtifact parses run's workflow to find artifact file path and reads the content of the file
-// from object store.
+// from object store, or the artifact cache.
 func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName string) ([]byte, error) {
+	if err := checkArtifactProxyEnabled(); err != nil {
+		return nil, err
+	}
+	objectStore, artifactPath, err := r.getArtifactPath(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	return r.artifactCache.GetFile(objectStore, artifactPath)
+}
+
+// checkArtifactProxyEnabled fails when the artifacts are only downloaded with
+// signed URLs.
+func checkArtifactProxyEnabled() error {
+	if !common.IsFeatureEnabled(common.FeatureArtifactProxy) {
+		return util.NewFailedPreconditionError(errors.New("artifact proxy is disabled"),
+			"Artifacts aren't served by the API server, download them with a signed URL")
+	}
+	return nil
+}
+
+// GetArtifactSignedURL returns a URL that grants the GET or PUT method on an
+// artifact of a run until the expiry elapses, so that clients can transfer it
+// without going through the API server.
+func (r *ResourceManager) GetArtifactSignedURL(runID string, nodeID string, artifactName string, method string,
+	expiry time.Duration) (*storage.SignedURL, error) {
+	if method != http.MethodPut {
+		objectStore, artifactPath, err := r.getArtifactPath(runID, nodeID, artifactName)
+		if err != nil {
+			return nil, err
+		}
+		return objectStore.GetSignedURL(artifactPath, method, expiry)
+	}
+	namespace, artifactKey, err := r.getArtifactKey(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	objectStore, err := r.objectStore.ForNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	// The upload replaces the artifact, not the content it shares with others.
+	if err := r.restoreArtifact(objectStore, artifactKey, namespace); err != nil {
+		return nil, util.Wrapf(err, "Failed to restore the deduplicated artifact %v", artifactKey)
+	}
+	return objectStore.GetSignedURL(artifactKey, method, expiry)
+}
+
+// PreviewArtifact returns the first headSize and last tailSize bytes of an
+// artifact of a run, along with its detected content type.
+func (r *ResourceManager) PreviewArtifact(runID string, nodeID string, artifactName string, headSize int,
+	tailSize int) (*ArtifactPreview, error) {
+	if err := checkArtifactProxyEnabled(); err != nil {
+		return nil, err
+	}
+	objectStore, artifactPath, err := r.getArtifactPath(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := r.artifactCache.OpenFile(objectStore, artifactPath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return previewArtifact(reader, headSize, tailSize)
+}
+
+// getArtifactPath returns the object store holding the content of an artifact,
+// and the key under which it's stored.
+func (r *ResourceManager) getArtifactPath(runID string, nodeID string, artifactName string) (storage.ObjectStoreInterface, string, error) {
+	namespace, artifactKey, err := r.getArtifactKey(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, "", err
+	}
+	objectStore, err := r.objectStore.ForNamespace(namespace)
+	if err != nil {
+		return nil, "", err
+	}
+	artifactPath, err := r.resolveArtifactKey(artifactKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return objectStore, artifactPath, nil
+}
+
+// getArtifactKey returns the namespace the run's workflow ran in, and the key of
+// an artifact that it records.
+func (r *ResourceManager) getArtifactKey(runID string, nodeID string, artifactName string) (string, string, error) {
+	workflow, err := r.getRuntimeWorkflow(runID, "read artifact")
+	if err != nil {
+		return "", "", err
+	}
+	artifactPath := workflow.FindObjectStoreArtifactKeyOrEmpty(nodeID, artifactName)
+	if artifactPath == "" {
+		return "", "", util.NewResourceNotFoundError(
+			"artifact", common.CreateArtifactPath(runID, nodeID, artifactName))
+	}
+	return workflow.Namespace, artifactPath, nil
+}
+
+// getRuntimeWorkflow returns the workflow that a run executed. The operation
+// names what is unsupported for runs with v2 IR spec.
+func (r *ResourceManager) getRuntimeWorkflow(runID string, operation string) (*util.Workflow, error) {
 	run, err := r.runStore.GetRun(runID)
 	if err != nil {
 		return nil, err
 	}
 	if run.WorkflowRuntimeManifest == "" {
-		return nil, util.NewInvalidInputError("read artifact from run with v2 IR spec is not supported")
+		return nil, util.NewInvalidInputError("%s from run with v2 IR spec is not supported", operation)
 	}
 	var storageWorkflow workflowapi.PipelineRun
 	err = json.Unmarshal([]byte(run.WorkflowRuntimeManifest), &storageWorkflow)
@@ -1109,13 +1977,7 @@ func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName
 		return nil, util.NewInternalServerError(
 			err, "failed to unmarshal workflow '%s'", run.WorkflowRuntimeManifest)
 	}
-	workflow := util.NewWorkflow(&storageWorkflow)
-	artifactPath := workflow.FindObjectStoreArtifactKeyOrEmpty(nodeID, artifactName)
-	if artifactPath == "" {
-		return nil, util.NewResourceNotFoundError(
-			"artifact", common.CreateArtifactPath(runID, nodeID, artifactName))
-	}
-	return r.objectStore.GetFile(artifactPath)
+	return util.NewWorkflow(&storageWorkflow), nil
 }
 
 func (r *ResourceManager) GetDefaultExperimentId() (string, error) {
@@ -1150,17 +2012,24 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline version failed")
 	}
+	if err := checkTemplateTypeEnabled(tmpl); err != nil {
+		return nil, util.Wrap(err, "Create pipeline version failed")
+	}
+	pipeline, err := r.GetPipeline(pipelineId)
+	if err != nil {
+		return nil, util.Wrap(err, "Create pipeline version failed")
+	}
 	if tmpl.IsV2() {
-		pipeline, err := r.GetPipeline(pipelineId)
-		if err != nil {
-			return nil, util.Wrap(err, "Create pipeline version failed")
-		}
 		tmpl.OverrideV2PipelineName(pipeline.Name, pipeline.Namespace)
 	}
 	paramsJSON, err := tmpl.ParametersJSON()
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline version failed")
 	}
+	warningsJSON, err := template.MarshalWarnings(tmpl.Analyze())
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Create pipeline version failed")
+	}
 
 	// Construct model.PipelineVersion
 	version := &model.PipelineVersion{
@@ -1170,6 +2039,7 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 		Parameters:    paramsJSON,
 		CodeSourceUrl: apiVersion.CodeSourceUrl,
 		Description:   apiVersion.Description,
+		Warnings:      warningsJSON,
 	}
 	version, err = r.pipelineStore.CreatePipelineVersion(version, updateDefaultVersion)
 	if err != nil {
@@ -1177,7 +2047,7 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 	}
 
 	// Store the pipeline file
-	err = r.objectStore.AddFile(pipelineFile, r.objectStore.GetPipelineKey(fmt.Sprint(version.UUID)))
+	err = r.addPipelineFile(pipelineFile, fmt.Sprint(version.UUID), pipeline.Namespace)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline version failed")
 	}
@@ -1190,6 +2060,7 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 		return nil, util.Wrap(err, "Create pipeline version failed")
 	}
 
+	r.publishEvent(eventbus.EventTypePipelineVersionCreated, pipelineVersionEventData(version, pipeline))
 	return version, nil
 }
 
@@ -1202,7 +2073,7 @@ func (r *ResourceManager) ListPipelineVersions(pipelineId string, opts *list.Opt
 }
 
 func (r *ResourceManager) DeletePipelineVersion(pipelineVersionId string) error {
-	_, err := r.pipelineStore.GetPipelineVersion(pipelineVersionId)
+	version, err := r.pipelineStore.GetPipelineVersion(pipelineVersionId)
 	if err != nil {
 		return util.Wrap(err, "Delete pipeline version failed")
 	}
@@ -1212,18 +2083,20 @@ func (r *ResourceManager) DeletePipelineVersion(pipelineVersionId string) error
 	if err != nil {
 		return util.Wrap(err, "Delete pipeline version failed")
 	}
+	r.templateCache.Invalidate(pipelineVersionId)
 
 	err = r.objectStore.DeleteFile(r.objectStore.GetPipelineKey(fmt.Sprint(pipelineVersionId)))
 	if err != nil {
-		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline file for pipeline version %v", pipelineVersionId))
+		log.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline file for pipeline version %v", pipelineVersionId))
 		return util.Wrap(err, "Delete pipeline version failed")
 	}
 	err = r.pipelineStore.DeletePipelineVersion(pipelineVersionId)
 	if err != nil {
-		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline DB entry for pipeline %v", pipelineVersionId))
+		log.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline DB entry for pipeline %v", pipelineVersionId))
 		return util.Wrap(err, "Delete pipeline version failed")
 	}
 
+	r.publishEvent(eventbus.EventTypePipelineVersionDeleted, pipelineVersionEventData(version, nil))
 	return nil
 }
 
@@ -1234,7 +2107,7 @@ func (r *ResourceManager) GetPipelineVersionTemplate(versionId string) ([]byte,
 		return nil, util.Wrap(err, "Get pipeline version template failed")
 	}
 
-	template, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(fmt.Sprint(versionId)))
+	template, err := r.getPipelineFile(fmt.Sprint(versionId))
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline version template failed")
 	}
@@ -1242,6 +2115,109 @@ func (r *ResourceManager) GetPipelineVersionTemplate(versionId string) ([]byte,
 	return template, nil
 }
 
+// addPipelineFile stores the pipeline file of a pipeline version, compressing it
+// if it's larger than the configured threshold, then encrypting it when an
+// encryption key is configured.
+func (r *ResourceManager) addPipelineFile(pipelineFile []byte, versionId string, namespace string) error {
+	manifest, err := storage.CompressManifest(pipelineFile, common.GetManifestCompressionThreshold())
+	if err != nil {
+		return err
+	}
+	manifest, err = r.encryptor.Encrypt(manifest)
+	if err != nil {
+		return err
+	}
+	return r.objectStore.AddFileInNamespace(manifest, r.objectStore.GetPipelineKey(versionId), namespace)
+}
+
+// MigratePipelineVersionTemplates upgrades the stored templates of all pipeline
+// versions from tekton.dev/v1beta1 to tekton.dev/v1 and returns the number of
+// templates migrated. Templates are converted on read regardless, so this only
+// saves the conversion and makes stored templates match what's run.
+func (r *ResourceManager) MigratePipelineVersionTemplates() (int, error) {
+	const pageSize = 100
+	migrated := 0
+	opts, err := list.NewOptions(&model.Pipeline{}, pageSize, "", nil)
+	if err != nil {
+		return migrated, err
+	}
+	for {
+		pipelines, _, nextPageToken, err := r.pipelineStore.ListPipelines(&common.FilterContext{}, opts)
+		if err != nil {
+			return migrated, util.Wrap(err, "Failed to list pipelines to migrate")
+		}
+		for _, pipeline := range pipelines {
+			count, err := r.migratePipelineVersionTemplates(pipeline.UUID, pipeline.Namespace, pageSize)
+			migrated += count
+			if err != nil {
+				return migrated, err
+			}
+		}
+		if nextPageToken == "" {
+			return migrated, nil
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, pageSize)
+		if err != nil {
+			return migrated, err
+		}
+	}
+}
+
+func (r *ResourceManager) migratePipelineVersionTemplates(pipelineId string, namespace string, pageSize int) (int, error) {
+	migrated := 0
+	opts, err := list.NewOptions(&model.PipelineVersion{}, pageSize, "", nil)
+	if err != nil {
+		return migrated, err
+	}
+	for {
+		versions, _, nextPageToken, err := r.pipelineStore.ListPipelineVersions(pipelineId, opts)
+		if err != nil {
+			return migrated, util.Wrap(err, "Failed to list pipeline versions to migrate")
+		}
+		for _, version := range versions {
+			pipelineFile, err := r.getPipelineFile(version.UUID)
+			if err != nil {
+				return migrated, util.Wrapf(err, "Failed to get the template of pipeline version %v", version.UUID)
+			}
+			migratedFile, ok, err := template.MigrateToV1(pipelineFile)
+			if err != nil {
+				// Leave templates that can't be converted for the user to fix.
+				log.Warningf("Skipping migration of pipeline version %v: %v", version.UUID, err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			if err := r.addPipelineFile(migratedFile, version.UUID, namespace); err != nil {
+				return migrated, util.Wrapf(err, "Failed to store the migrated template of pipeline version %v", version.UUID)
+			}
+			r.templateCache.Invalidate(version.UUID)
+			migrated++
+		}
+		if nextPageToken == "" {
+			return migrated, nil
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, pageSize)
+		if err != nil {
+			return migrated, err
+		}
+	}
+}
+
+// getPipelineFile returns the pipeline file of a pipeline version, decrypting and
+// decompressing it if needed.
+func (r *ResourceManager) getPipelineFile(versionId string) ([]byte, error) {
+	manifest, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(versionId))
+	if err != nil {
+		return nil, err
+	}
+	manifest, err = r.encryptor.Decrypt(manifest)
+	if err != nil {
+		return nil, err
+	}
+	return storage.DecompressManifest(manifest)
+}
+
 func (r *ResourceManager) IsRequestAuthorized(ctx context.Context, userIdentity string, resourceAttributes *authorizationv1.ResourceAttributes) error {
 	result, err := r.subjectAccessReviewClient.Create(
 		context.Background(),
@@ -1330,3 +2306,313 @@ func (r *ResourceManager) getNamespaceFromExperiment(references []*api.ResourceR
 	}
 	return namespace, nil
 }
+
+// RunWithLease runs fn while holding the named lease, waiting for other replicas to
+// release it first. The lease is renewed while fn runs, and expires after ttl if
+// the replica dies.
+func (r *ResourceManager) RunWithLease(name string, ttl time.Duration, fn func() error) error {
+	holder, err := r.uuid.NewRandom()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a lease holder id")
+	}
+	for {
+		acquired, err := r.leaseStore.AcquireLease(name, holder.String(), ttl)
+		if err != nil {
+			return util.Wrapf(err, "Failed to acquire lease %v", name)
+		}
+		if acquired {
+			break
+		}
+		log.Infof("Lease %v is held by another replica, waiting", name)
+		time.Sleep(leaseRetryInterval)
+	}
+	defer r.releaseLease(name, holder.String())
+	defer r.renewLease(name, holder.String(), ttl)()
+	return fn()
+}
+
+// TryWithLease runs fn only if the named lease isn't held by another replica,
+// and returns whether it ran. Periodic jobs use it to run on a single replica.
+func (r *ResourceManager) TryWithLease(name string, ttl time.Duration, fn func() error) (bool, error) {
+	holder, err := r.uuid.NewRandom()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to create a lease holder id")
+	}
+	acquired, err := r.leaseStore.AcquireLease(name, holder.String(), ttl)
+	if err != nil {
+		return false, util.Wrapf(err, "Failed to acquire lease %v", name)
+	}
+	if !acquired {
+		return false, nil
+	}
+	defer r.releaseLease(name, holder.String())
+	defer r.renewLease(name, holder.String(), ttl)()
+	return true, fn()
+}
+
+// renewLease renews the lease held by the holder every third of its ttl, until the
+// returned function is called, so that a function running longer than the ttl
+// keeps the lease. The function waits for the renewals to stop, so the lease
+// isn't taken again once released.
+func (r *ResourceManager) renewLease(name string, holder string, ttl time.Duration) func() {
+	interval := ttl / 3
+	if interval <= 0 {
+		return func() {}
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				acquired, err := r.leaseStore.AcquireLease(name, holder, ttl)
+				if err != nil {
+					log.Warnf("Failed to renew lease %v: %v", name, err)
+				} else if !acquired {
+					log.Errorf("Lease %v expired and was taken by another replica", name)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func (r *ResourceManager) releaseLease(name string, holder string) {
+	if err := r.leaseStore.ReleaseLease(name, holder); err != nil {
+		log.Errorf("Failed to release lease %v: %v", name, err)
+	}
+}
+
+// CollectExpiredArtifacts finds the artifacts and archived logs of the finished
+// runs that the retention policy expires, and deletes them unless dryRun is set.
+// The total size limits apply to the runs of an experiment when its rule is
+// experiment specific, and to the runs of a namespace otherwise. The oldest
+// objects are deleted first to get under them.
+func (r *ResourceManager) CollectExpiredArtifacts(policy *common.ArtifactRetentionPolicy, dryRun bool) (*ArtifactGCReport, error) {
+	type artifactScope struct {
+		rule      common.ArtifactRetentionRule
+		artifacts []*ExpiredArtifact
+	}
+	scopes := map[string]*artifactScope{}
+	opts, err := list.NewOptions(&model.Run{}, artifactGCPageSize, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		runs, _, nextPageToken, err := r.runStore.ListRuns(&common.FilterContext{IncludeRunArchive: true}, opts)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to list the runs to collect the expired artifacts")
+		}
+		for _, run := range runs {
+			if run.FinishedAtInSec == 0 || run.Name == "" {
+				continue
+			}
+			rule := policy.RuleFor(run.Namespace, run.ExperimentUUID)
+			if rule.IsEmpty() {
+				continue
+			}
+			scopeKey := "namespace/" + run.Namespace
+			if rule.ExperimentID != "" {
+				scopeKey = "experiment/" + rule.ExperimentID
+			}
+			scope, ok := scopes[scopeKey]
+			if !ok {
+				scope = &artifactScope{rule: rule}
+				scopes[scopeKey] = scope
+			}
+			artifacts, err := r.listRunArtifacts(run)
+			if err != nil {
+				return nil, err
+			}
+			scope.artifacts = append(scope.artifacts, artifacts...)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		opts, err = list.NewOptionsFromToken(nextPageToken, artifactGCPageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scopeKeys := make([]string, 0, len(scopes))
+	for scopeKey := range scopes {
+		scopeKeys = append(scopeKeys, scopeKey)
+	}
+	sort.Strings(scopeKeys)
+	report := &ArtifactGCReport{DryRun: dryRun, Artifacts: []*ExpiredArtifact{}}
+	now := r.time.Now()
+	for _, scopeKey := range scopeKeys {
+		scope := scopes[scopeKey]
+		for _, artifact := range selectExpiredArtifacts(scope.artifacts, scope.rule, now) {
+			if !dryRun {
+				objectStore, err := r.objectStore.ForNamespace(artifact.Namespace)
+				if err != nil {
+					return report, err
+				}
+				if err := r.deleteArtifact(objectStore, artifact.Key); err != nil {
+					return report, util.Wrapf(err, "Failed to delete the expired artifact %v of run %v", artifact.Key, artifact.RunID)
+				}
+				if err := r.artifactMetadataStore.DeleteArtifact(artifact.RunID, artifact.Key); err != nil {
+					return report, err
+				}
+				artifactGCCounter.Inc()
+			}
+			report.Artifacts = append(report.Artifacts, artifact)
+			report.TotalSize += artifact.Size
+		}
+	}
+	return report, nil
+}
+
+// listRunArtifacts lists the objects stored under the KFP owned prefixes of a
+// run, that is its artifacts and archived logs.
+func (r *ResourceManager) listRunArtifacts(run *model.Run) ([]*ExpiredArtifact, error) {
+	prefixes := r.runArtifactPrefixes(run)
+	objectStore, err := r.objectStore.ForNamespace(run.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []*ExpiredArtifact
+	for _, prefix := range prefixes {
+		objects, err := objectStore.ListFiles(prefix)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to list the artifacts of run %v", run.UUID)
+		}
+		for _, object := range objects {
+			artifacts = append(artifacts, &ExpiredArtifact{
+				RunID:        run.UUID,
+				Namespace:    run.Namespace,
+				ExperimentID: run.ExperimentUUID,
+				Key:          object.Key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			})
+		}
+	}
+	// The deduplicated artifacts are only recorded in the database.
+	references, err := r.artifactBlobStore.ListRunReferences(run.UUID)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list the artifacts of run %v", run.UUID)
+	}
+	for _, reference := range references {
+		artifacts = append(artifacts, &ExpiredArtifact{
+			RunID:        run.UUID,
+			Namespace:    run.Namespace,
+			ExperimentID: run.ExperimentUUID,
+			Key:          reference.ObjectKey,
+			Size:         reference.Size,
+			LastModified: time.Unix(reference.CreatedAtInSec, 0),
+		})
+	}
+	return artifacts, nil
+}
+
+// runArtifactPrefixes returns the KFP owned prefixes of the objects of a run in
+// the object store.
+func (r *ResourceManager) runArtifactPrefixes(run *model.Run) []string {
+	prefixes := []string{"artifacts/" + run.Name + "/"}
+	if r.logArchive != nil {
+		if prefix, err := r.logArchive.GetLogObjectPrefix(run.Name); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// selectExpiredArtifacts returns the artifacts older than the maximum age of the
+// rule, and then the oldest ones until the others fit in its maximum total size.
+func selectExpiredArtifacts(artifacts []*ExpiredArtifact, rule common.ArtifactRetentionRule, now time.Time) []*ExpiredArtifact {
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		return artifacts[i].LastModified.Before(artifacts[j].LastModified)
+	})
+	var totalSize int64
+	for _, artifact := range artifacts {
+		totalSize += artifact.Size
+	}
+	var expired []*ExpiredArtifact
+	for _, artifact := range artifacts {
+		switch {
+		case rule.MaxAge > 0 && now.Sub(artifact.LastModified) > rule.MaxAge:
+			artifact.Reason = ArtifactExpiredByAge
+		case rule.MaxTotalSize > 0 && totalSize > rule.MaxTotalSize:
+			artifact.Reason = ArtifactExpiredBySize
+		default:
+			continue
+		}
+		totalSize -= artifact.Size
+		expired = append(expired, artifact)
+	}
+	return expired
+}
+
+// Drain rejects new run creations and waits for the ones in flight to finish,
+// so a shutdown doesn't cut the requests storing runs short.
+func (r *ResourceManager) Drain(ctx context.Context) error {
+	r.drainMu.Lock()
+	r.draining = true
+	r.drainMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.inflightRuns.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return util.NewInternalServerError(ctx.Err(), "Timed out waiting for the run creations in flight")
+	}
+}
+
+// RunSubmissions signals that runs were stored, so that the run submitter
+// creates their PipelineRuns without waiting for its next round.
+func (r *ResourceManager) RunSubmissions() <-chan struct{} {
+	return r.runSubmissions
+}
+
+func (r *ResourceManager) startRunCreation() error {
+	r.drainMu.Lock()
+	defer r.drainMu.Unlock()
+	if r.draining {
+		return util.NewUnavailableError(errors.New("server is shutting down"),
+			"The API server is shutting down, retry the request").WithReason(util.ReasonShuttingDown)
+	}
+	r.inflightRuns.Add(1)
+	return nil
+}
+
+// RecordAuditEvent assigns an id and a timestamp to the event and writes it to the
+// configured audit sink. It's a no-op when auditing is disabled.
+func (r *ResourceManager) RecordAuditEvent(event *model.AuditEvent) error {
+	if r.auditSink == nil {
+		return nil
+	}
+	id, err := r.uuid.NewRandom()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create an audit event id")
+	}
+	event.UUID = id.String()
+	event.CreatedAtInSec = r.time.Now().Unix()
+	return r.auditSink.Write(event)
+}
+
+// ListAuditEvents lists the recorded audit events. Only events written to the
+// database sink can be queried.
+func (r *ResourceManager) ListAuditEvents(opts *list.Options) ([]*model.AuditEvent, int, string, error) {
+	if _, ok := r.auditSink.(*audit.DBSink); !ok {
+		return nil, 0, "", util.NewBadRequestError(
+			errors.New("Audit events are not stored in the database"),
+			"Audit events can only be queried when %s is set to '%s'", common.AuditSink, audit.SinkTypeDB)
+	}
+	return r.auditStore.ListAuditEvents(opts)
+}
@@ -16,16 +16,26 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/notification"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -101,6 +111,7 @@ func initWithOneTimeRunV2(t *testing.T) (*FakeClientManager, *ResourceManager, *
 	}
 	runDetail, err := manager.CreateRun(context.Background(), apiRun)
 	assert.Nil(t, err)
+	submitRuns(t, manager)
 	return store, manager, runDetail
 }
 
@@ -114,14 +125,42 @@ func (m *FakeBadObjectStore) AddFile(template []byte, filePath string) error {
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
 
+func (m *FakeBadObjectStore) AddFileInNamespace(template []byte, filePath string, namespace string) error {
+	return m.AddFile(template, filePath)
+}
+
 func (m *FakeBadObjectStore) DeleteFile(filePath string) error {
 	return errors.New("Not implemented.")
 }
 
+func (m *FakeBadObjectStore) ForNamespace(namespace string) (storage.ObjectStoreInterface, error) {
+	return m, nil
+}
+
+func (m *FakeBadObjectStore) CopyFile(srcPath string, dstPath string, namespace string) error {
+	return util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
+func (m *FakeBadObjectStore) ComposeFile(srcPaths []string, dstPath string, namespace string) error {
+	return util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
 func (m *FakeBadObjectStore) GetFile(filePath string) ([]byte, error) {
 	return []byte(""), nil
 }
 
+func (m *FakeBadObjectStore) OpenFile(filePath string) (io.ReadCloser, error) {
+	return nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
+func (m *FakeBadObjectStore) ListFiles(prefix string) ([]storage.ObjectInfo, error) {
+	return nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
+func (m *FakeBadObjectStore) GetSignedURL(filePath string, method string, expiry time.Duration) (*storage.SignedURL, error) {
+	return nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
 func (m *FakeBadObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
@@ -212,9 +251,17 @@ func initWithExperimentAndPipelineAndRun(t *testing.T) (*FakeClientManager, *Res
 	}
 	runDetail, err := manager.CreateRun(context.Background(), apiRun)
 	assert.Nil(t, err)
+	submitRuns(t, manager)
 	return store, manager, exp, pipeline, runDetail
 }
 
+// submitRuns creates the PipelineRuns of the created runs, like the run
+// submitter of the API server does in the background.
+func submitRuns(t *testing.T, manager *ResourceManager) {
+	_, err := manager.SubmitPendingRuns(context.Background())
+	assert.Nil(t, err)
+}
+
 // Util function to create an initial state with pipeline uploaded
 func initWithJob(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job) {
 	store, manager, exp := initWithExperiment(t)
@@ -256,6 +303,7 @@ func initWithPatchedRun(t *testing.T) (*FakeClientManager, *ResourceManager, *mo
 	}
 	runDetail, err := manager.CreateRun(context.Background(), apiRun)
 	assert.Nil(t, err)
+	submitRuns(t, manager)
 	return store, manager, runDetail
 }
 
@@ -270,6 +318,17 @@ func TestCreatePipeline_GetParametersError(t *testing.T) {
 	assert.Contains(t, err.Error(), "InvalidInputError")
 }
 
+func TestCreatePipeline_V2PipelinesDisabled(t *testing.T) {
+	viper.Set(common.FeaturesConfig+"."+string(common.FeatureV2Pipelines), "false")
+	defer viper.Set(common.FeaturesConfig+"."+string(common.FeatureV2Pipelines), nil)
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	_, err := manager.CreatePipeline("pipeline1", "", "", []byte(v2SpecHelloWorld))
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "KFP v2 pipelines aren't accepted")
+}
+
 func TestGetPipelineTemplate(t *testing.T) {
 	store, manager, p := initWithPipeline(t)
 	defer store.Close()
@@ -290,6 +349,286 @@ func TestGetPipelineTemplate_PipelineMetadataNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "Pipeline 1 not found")
 }
 
+func TestMigratePipelineVersionTemplates(t *testing.T) {
+	store, manager, p := initWithPipeline(t)
+	defer store.Close()
+	migrated, err := manager.MigratePipelineVersionTemplates()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, migrated)
+	actualTemplate, err := manager.GetPipelineTemplate(p.UUID)
+	assert.Nil(t, err)
+	assert.Contains(t, string(actualTemplate), "apiVersion: tekton.dev/v1\n")
+
+	// Migrated templates are left as is.
+	migrated, err = manager.MigratePipelineVersionTemplates()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, migrated)
+}
+
+func TestCreateRun_ConcurrentRunLimit(t *testing.T) {
+	viper.Set(common.MaxConcurrentRunsPerNamespace, "1")
+	defer viper.Set(common.MaxConcurrentRunsPerNamespace, "0")
+	store, manager, _ := initWithPatchedRun(t)
+	defer store.Close()
+
+	apiRun := &api.Run{
+		Name:         "run2",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+	}
+	_, err := manager.CreateRun(context.Background(), apiRun)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "already has 1 active runs")
+}
+
+func TestDryRunCreateRun(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}
+	runDetail, err := manager.DryRunCreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	assert.Equal(t, "run1", runDetail.DisplayName)
+	assert.Equal(t, experiment.UUID, runDetail.ExperimentUUID)
+	var workflow tektonV1.PipelineRun
+	assert.Nil(t, json.Unmarshal([]byte(runDetail.WorkflowRuntimeManifest), &workflow))
+	assert.Equal(t, runDetail.UUID, workflow.Labels[util.LabelKeyWorkflowRunId])
+
+	// Nothing is stored or submitted.
+	assert.Equal(t, 0, store.TektonClientFake.GetWorkflowCount())
+	_, err = store.RunStore().GetRun(runDetail.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	apiRun.PipelineSpec.WorkflowManifest = "I am invalid yaml"
+	_, err = manager.DryRunCreateRun(context.Background(), apiRun)
+	assert.NotNil(t, err)
+}
+
+func TestDryRunCreateRun_DefaultExperiment(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	countExperiments := func() int {
+		opts, err := list.NewOptions(&model.Experiment{}, 10, "", nil)
+		assert.Nil(t, err)
+		_, total, _, err := store.ExperimentStore().ListExperiments(&common.FilterContext{}, opts)
+		assert.Nil(t, err)
+		return total
+	}
+
+	// The default experiment isn't created by a dry run.
+	apiRun := &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+	}
+	runDetail, err := manager.DryRunCreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	assert.Equal(t, "", runDetail.ExperimentUUID)
+	assert.Equal(t, 0, countExperiments())
+	defaultExperimentId, err := manager.GetDefaultExperimentId()
+	assert.Nil(t, err)
+	assert.Equal(t, "", defaultExperimentId)
+
+	// Once it exists, the dry run resolves it.
+	defaultExperimentId, err = manager.CreateDefaultExperiment()
+	assert.Nil(t, err)
+	apiRun.ResourceReferences = nil
+	runDetail, err = manager.DryRunCreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	assert.Equal(t, defaultExperimentId, runDetail.ExperimentUUID)
+	assert.Equal(t, 1, countExperiments())
+}
+
+func TestCreateRun_SecretParameters(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflowWithParams.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "learning_rate", Value: "secretKeyRef:db-credentials/password"}},
+		},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	var workflow tektonV1.PipelineRun
+	assert.Nil(t, json.Unmarshal([]byte(runDetail.WorkflowRuntimeManifest), &workflow))
+	assert.Equal(t, "$(KFP_SECRET_PARAM_LEARNING_RATE)", util.NewWorkflow(&workflow).GetWorkflowParametersAsMap()["learning_rate"])
+	assert.Equal(t, "db-credentials", workflow.Spec.TaskRunTemplate.PodTemplate.Env[0].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "password", workflow.Spec.TaskRunTemplate.PodTemplate.Env[0].ValueFrom.SecretKeyRef.Key)
+
+	// The run keeps the reference to the secret.
+	stored, err := store.RunStore().GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Contains(t, stored.PipelineSpec.Parameters, "secretKeyRef:db-credentials/password")
+
+	apiRun.PipelineSpec.Parameters = []*api.Parameter{{Name: "learning_rate", Value: "secretKeyRef:db-credentials"}}
+	_, err = manager.CreateRun(context.Background(), apiRun)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestCreateRun_SubmittedInBackground(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+
+	runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	assert.Nil(t, err)
+
+	// The run is stored with its submission, and the run submitter is signaled.
+	_, err = store.RunStore().GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, store.TektonClientFake.GetWorkflowCount())
+	submissions, err := store.RunStore().ListRunSubmissions(time.Now().Unix(), 10)
+	assert.Nil(t, err)
+	assert.Len(t, submissions, 1)
+	assert.Equal(t, runDetail.UUID, submissions[0].RunUUID)
+	select {
+	case <-manager.RunSubmissions():
+	default:
+		t.Error("The run submitter wasn't signaled")
+	}
+
+	// The run submitter creates the PipelineRun and deletes the submission.
+	submitRuns(t, manager)
+	assert.Equal(t, 1, store.TektonClientFake.GetWorkflowCount())
+	submissions, err = store.RunStore().ListRunSubmissions(time.Now().Unix(), 10)
+	assert.Nil(t, err)
+	assert.Empty(t, submissions)
+}
+
+func TestCreateRun_CountsCreatedRuns(t *testing.T) {
+	before := testutil.ToFloat64(runCreatedCounter)
+	store, _, _, _, _ := initWithExperimentAndPipelineAndRun(t)
+	defer store.Close()
+	assert.Equal(t, before+1, testutil.ToFloat64(runCreatedCounter))
+}
+
+func TestCreateRun_RecordsRunCreatedEvent(t *testing.T) {
+	store, _, _, _, runDetail := initWithExperimentAndPipelineAndRun(t)
+	defer store.Close()
+
+	assert.Len(t, store.RunEventRecorderFake.Events, 1)
+	assert.Equal(t, fmt.Sprintf("Normal RunCreated Created by Kubeflow Pipelines for run %v", runDetail.UUID),
+		<-store.RunEventRecorderFake.Events)
+}
+
+func TestCreateRun_PublishesEvent(t *testing.T) {
+	store, manager, _, _, runDetail := initWithExperimentAndPipelineAndRun(t)
+	defer store.Close()
+	assert.Nil(t, manager.ArchiveRun(runDetail.UUID))
+
+	events := store.EventPublisherFake.Events()
+	assert.Len(t, events, 4)
+	for i, eventType := range []string{
+		eventbus.EventTypePipelineCreated,
+		eventbus.EventTypePipelineVersionCreated,
+		eventbus.EventTypeRunCreated,
+		eventbus.EventTypeRunArchived,
+	} {
+		assert.Equal(t, eventType, events[i].Type)
+	}
+	assert.Equal(t, runDetail.UUID, events[2].Subject)
+	assert.Equal(t, eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeRun,
+		ResourceID:   runDetail.UUID,
+		Name:         runDetail.DisplayName,
+		Namespace:    runDetail.Namespace,
+		ExperimentID: runDetail.ExperimentUUID,
+	}, events[3].Data)
+}
+
+func TestSubmitPendingRuns(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTime(time.Unix(3600, 0)))
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	workflow := util.NewWorkflow(testWorkflow.DeepCopy())
+	workflow.SetName("workflow-name-12345")
+	workflow.SetLabels(util.LabelKeyWorkflowRunId, "run-1")
+	for _, submission := range []*model.RunSubmission{
+		{RunUUID: "run-1", Namespace: "ns1", Workflow: workflow.ToStringForStore(), CreatedAtInSec: 1},
+		{RunUUID: "run-2", Namespace: "ns1", Workflow: "I am invalid", CreatedAtInSec: 2},
+	} {
+		_, err := store.RunStore().CreateRunWithSubmission(&model.RunDetail{
+			Run: model.Run{UUID: submission.RunUUID, Name: submission.RunUUID, Namespace: submission.Namespace},
+		}, submission, 0)
+		assert.Nil(t, err)
+	}
+
+	submitted, err := manager.SubmitPendingRuns(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, submitted)
+	_, err = store.TektonClientFake.PipelineRun("ns1").Get(context.Background(), "workflow-name-12345", v1.GetOptions{})
+	assert.Nil(t, err)
+	// The run whose workflow is invalid fails rather than being submitted again.
+	run, err := manager.GetRun("run-2")
+	assert.Nil(t, err)
+	assert.Equal(t, "Failed", run.Conditions)
+	submissions, err := store.RunStore().ListRunSubmissions(3600, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, submissions)
+}
+
+func TestRecordAuditEvent(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	err := manager.RecordAuditEvent(&model.AuditEvent{
+		Actor:  "user@google.com",
+		Method: "/api.RunService/CreateRun",
+		Result: model.AuditResultSucceeded,
+	})
+	assert.Nil(t, err)
+
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", nil)
+	assert.Nil(t, err)
+	events, totalSize, _, err := manager.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, &model.AuditEvent{
+		UUID:           DefaultFakeUUID,
+		CreatedAtInSec: 1,
+		Actor:          "user@google.com",
+		Method:         "/api.RunService/CreateRun",
+		Result:         model.AuditResultSucceeded,
+	}, events[0])
+}
+
+func TestListAuditEvents_NotQueryable(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	store.AuditSinkFake = nil
+	manager := NewResourceManager(store)
+	assert.Nil(t, manager.RecordAuditEvent(&model.AuditEvent{Method: "/api.RunService/CreateRun"}))
+
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", nil)
+	assert.Nil(t, err)
+	_, _, _, err = manager.ListAuditEvents(opts)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Audit events can only be queried")
+}
+
 // Removed Argo related tests (check the top page comments for more details)
 
 func TestCreateRun_EmptyPipelineSpec(t *testing.T) {
@@ -351,6 +690,26 @@ func TestDeleteExperiment(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func TestDeleteExperiment_SoftDeletePublishesEvent(t *testing.T) {
+	viper.Set(common.SoftDeletePurgeWindow, "1h")
+	defer viper.Set(common.SoftDeletePurgeWindow, "0")
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+	assert.Nil(t, manager.DeleteExperiment(experiment.UUID))
+
+	events := store.EventPublisherFake.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, eventbus.EventTypeExperimentDeleted, events[0].Type)
+	assert.Equal(t, eventbus.ResourceTypeExperiment, events[0].Data.ResourceType)
+	assert.Equal(t, experiment.UUID, events[0].Subject)
+	assert.Equal(t, experiment.Name, events[0].Data.Name)
+
+	// The experiment is restored.
+	assert.Nil(t, manager.Undelete(common.Experiment, experiment.UUID))
+	_, err := manager.GetExperiment(experiment.UUID)
+	assert.Nil(t, err)
+}
+
 func TestDeleteExperiment_ClearsDefaultExperiment(t *testing.T) {
 	store, manager, experiment := initWithExperiment(t)
 	defer store.Close()
@@ -450,6 +809,55 @@ func TestCreateJob_ThroughWorkflowSpec(t *testing.T) {
 
 // Removed Argo related tests (check the top page comments for more details)
 
+func TestArchiveExperiment_PausesJobs(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+
+	result, err := manager.ArchiveExperiment(context.Background(), DefaultFakeUUID,
+		model.ExperimentArchivePolicy{Runs: true, Jobs: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{job.UUID}, result.JobIDs)
+	job, err = manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.False(t, job.Enabled)
+
+	result, err = manager.UnarchiveExperiment(DefaultFakeUUID, model.ExperimentArchivePolicy{Jobs: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{job.UUID}, result.JobIDs)
+	job, err = manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.True(t, job.Enabled)
+}
+
+func TestArchiveExperiment_KeepsJobs(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+
+	result, err := manager.ArchiveExperiment(context.Background(), DefaultFakeUUID, model.ExperimentArchivePolicy{Runs: true})
+	assert.Nil(t, err)
+	assert.Empty(t, result.JobIDs)
+	job, err = manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.True(t, job.Enabled)
+}
+
+func TestArchiveExperiment_CustomResourceFailure(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	manager.swfClient = client.NewFakeSwfClientWithBadWorkflow()
+
+	_, err := manager.ArchiveExperiment(context.Background(), DefaultFakeUUID, model.ExperimentArchivePolicy{Runs: true, Jobs: true})
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Failed to enable/disable job CR")
+	// Nothing is archived when a job can't be paused.
+	experiment, err := manager.GetExperiment(DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Experiment_STORAGESTATE_AVAILABLE.String(), experiment.StorageState)
+	job, err = manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.True(t, job.Enabled)
+}
+
 func TestEnableJob_JobNotExist(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -516,6 +924,88 @@ func TestDeleteJob(t *testing.T) {
 	assert.Contains(t, err.Error(), fmt.Sprintf("Job %v not found", job.UUID))
 }
 
+func TestDeleteJob_PublishesEvents(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	assert.Nil(t, manager.DeleteJob(context.Background(), job.UUID))
+
+	events := store.EventPublisherFake.Events()
+	assert.Len(t, events, 2)
+	assert.Equal(t, eventbus.EventTypeJobCreated, events[0].Type)
+	assert.Equal(t, eventbus.EventTypeJobDeleted, events[1].Type)
+	assert.Equal(t, eventbus.ResourceTypeJob, events[1].Data.ResourceType)
+	assert.Equal(t, job.UUID, events[1].Subject)
+	assert.Equal(t, job.DisplayName, events[1].Data.Name)
+	assert.NotEmpty(t, events[1].Data.ExperimentID)
+}
+
+func TestReportWorkflowResource_PublishesJobTriggeredAndPhaseChanged(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+
+	var pipelineRun tektonV1.PipelineRun
+	assert.Nil(t, json.Unmarshal([]byte(fmt.Sprintf(`{
+		"metadata":{"name":"run-from-job","namespace":"ns1","creationTimestamp":"2023-01-01T00:00:00Z",
+			"labels":{"%s":"run1"},
+			"ownerReferences":[{"apiVersion":"kubeflow.org/v1beta1","kind":"ScheduledWorkflow","name":"j1","uid":"%s"}]},
+		"status":{"conditions":[{"type":"Succeeded","status":"Unknown","reason":"Running"}]}}`,
+		util.LabelKeyWorkflowRunId, job.UUID)), &pipelineRun))
+	workflow := util.NewWorkflow(&pipelineRun)
+	assert.Nil(t, manager.ReportWorkflowResource(context.Background(), workflow))
+	// The run is reported again without any change.
+	assert.Nil(t, manager.ReportWorkflowResource(context.Background(), workflow))
+
+	events := store.EventPublisherFake.Events()
+	assert.Len(t, events, 3)
+	assert.Equal(t, eventbus.EventTypeJobTriggered, events[1].Type)
+	assert.Equal(t, job.UUID, events[1].Subject)
+	assert.Equal(t, "run1", events[1].Data.RunID)
+	assert.Equal(t, eventbus.EventTypeRunPhaseChanged, events[2].Type)
+	assert.Equal(t, "run1", events[2].Subject)
+	assert.Equal(t, "Running", events[2].Data.State)
+	assert.Equal(t, job.UUID, events[2].Data.JobID)
+}
+
+func TestNotifyRunFinished(t *testing.T) {
+	store, manager, exp, _, runDetail := initWithExperimentAndPipelineAndRun(t)
+	defer store.Close()
+	assert.Nil(t, store.RunStore().UpdateRun(runDetail.UUID, "Failed", runDetail.CreatedAtInSec+90, ""))
+
+	manager.notifyRunFinished(runDetail.UUID, "Failed")
+	// The cancelled runs aren't notified.
+	manager.notifyRunFinished(runDetail.UUID, "Cancelled")
+
+	notifications := store.NotifierFake.Notifications()
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, &notification.Notification{
+		Event:        notification.EventFailed,
+		RunID:        runDetail.UUID,
+		RunName:      "run1",
+		Namespace:    runDetail.Namespace,
+		ExperimentID: exp.UUID,
+		State:        "Failed",
+		Duration:     90 * time.Second,
+	}, notifications[0])
+}
+
+func TestNotifyLongRunningRuns(t *testing.T) {
+	store, manager, _, _, runDetail := initWithExperimentAndPipelineAndRun(t)
+	defer store.Close()
+
+	notified, err := manager.NotifyLongRunningRuns(0, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, notified)
+	// The run is notified once it ran longer than the threshold.
+	notified, err = manager.NotifyLongRunningRuns(time.Hour, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, notified)
+
+	notifications := store.NotifierFake.Notifications()
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, notification.EventLongRunning, notifications[0].Event)
+	assert.Equal(t, runDetail.UUID, notifications[0].RunID)
+}
+
 func TestDeleteJob_JobNotExist(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -584,6 +1074,275 @@ func TestCreateDefaultExperiment(t *testing.T) {
 	assert.Equal(t, expectedExperiment, experiment)
 }
 
+func TestRunWithLease(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	err := manager.RunWithLease(common.StartupLeaseName, common.StartupLeaseTTL, func() error {
+		// Other replicas can't take the lease meanwhile.
+		acquired, err := store.LeaseStore().AcquireLease(common.StartupLeaseName, "other-replica", common.StartupLeaseTTL)
+		assert.Nil(t, err)
+		assert.False(t, acquired)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// The lease is released afterwards, even if the function fails.
+	err = manager.RunWithLease(common.StartupLeaseName, common.StartupLeaseTTL, func() error {
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	acquired, err := store.LeaseStore().AcquireLease(common.StartupLeaseName, "other-replica", common.StartupLeaseTTL)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+}
+
+func TestRunWithLease_Renewed(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	// The function outlasts the ttl, the lease is renewed meanwhile.
+	err := manager.RunWithLease(common.StartupLeaseName, 30*time.Millisecond, func() error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// The renewals stop before the lease is released.
+	acquired, err := store.LeaseStore().AcquireLease(common.StartupLeaseName, "other-replica", time.Hour)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+}
+
+func TestTryWithLease(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	ran, err := manager.TryWithLease(common.ArtifactGCLeaseName, time.Hour, func() error { return nil })
+	assert.Nil(t, err)
+	assert.True(t, ran)
+
+	// The function is skipped while another replica holds the lease.
+	acquired, err := store.LeaseStore().AcquireLease(common.ArtifactGCLeaseName, "other-replica", time.Hour)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+	ran, err = manager.TryWithLease(common.ArtifactGCLeaseName, time.Hour, func() error {
+		t.Fatal("ran without the lease")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.False(t, ran)
+}
+
+func TestCollectExpiredArtifacts(t *testing.T) {
+	day := 24 * time.Hour
+	store := NewFakeClientManagerOrFatal(util.NewFakeTime(time.Unix(0, 0).Add(10 * day)))
+	defer store.Close()
+	minioClient := storage.NewFakeMinioClient()
+	store.objectStore = storage.NewMinioObjectStore(minioClient, "", "pipelines", false)
+	manager := NewResourceManager(store)
+
+	for _, run := range []model.Run{
+		{UUID: "run1", ExperimentUUID: "exp1", Name: "run-1", Namespace: "ns1", FinishedAtInSec: 1},
+		{UUID: "run2", ExperimentUUID: "exp2", Name: "run-2", Namespace: "ns1"},
+		{UUID: "run3", ExperimentUUID: "exp3", Name: "run-3", Namespace: "ns2", FinishedAtInSec: 1},
+	} {
+		run.StorageState = "STORAGESTATE_AVAILABLE"
+		_, err := store.RunStore().CreateRun(&model.RunDetail{Run: run})
+		assert.Nil(t, err)
+	}
+	for key, object := range map[string]struct {
+		content      string
+		lastModified time.Duration
+	}{
+		"artifacts/run-1/node/a.tgz": {"abc", day},
+		"/logs/run-1/node/main.log":  {"ab", 9 * day},
+		"artifacts/run-2/node/a.tgz": {"abc", day},
+		"artifacts/run-3/node/a.tgz": {"abcde", 8 * day},
+		"artifacts/run-3/node/b.tgz": {"abcde", 9 * day},
+	} {
+		assert.Nil(t, store.objectStore.AddFile([]byte(object.content), key))
+		minioClient.SetLastModified(key, time.Unix(0, 0).Add(object.lastModified))
+	}
+	policy := &common.ArtifactRetentionPolicy{
+		Default: common.ArtifactRetentionRule{MaxAge: 5 * day},
+		Rules:   []common.ArtifactRetentionRule{{Namespace: "ns2", MaxTotalSize: 6}},
+	}
+
+	report, err := manager.CollectExpiredArtifacts(policy, true)
+	assert.Nil(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, int64(8), report.TotalSize)
+	assert.Equal(t, 2, len(report.Artifacts))
+	assert.Equal(t, "artifacts/run-1/node/a.tgz", report.Artifacts[0].Key)
+	assert.Equal(t, ArtifactExpiredByAge, report.Artifacts[0].Reason)
+	assert.Equal(t, "run3", report.Artifacts[1].RunID)
+	assert.Equal(t, "artifacts/run-3/node/a.tgz", report.Artifacts[1].Key)
+	assert.Equal(t, ArtifactExpiredBySize, report.Artifacts[1].Reason)
+	assert.Equal(t, 5, minioClient.GetObjectCount())
+
+	report, err = manager.CollectExpiredArtifacts(policy, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(report.Artifacts))
+	assert.Equal(t, 3, minioClient.GetObjectCount())
+	assert.False(t, minioClient.ExistObject("artifacts/run-1/node/a.tgz"))
+	assert.False(t, minioClient.ExistObject("artifacts/run-3/node/a.tgz"))
+	assert.True(t, minioClient.ExistObject("artifacts/run-2/node/a.tgz"))
+}
+
+func TestCollectExpiredArtifacts_ListError(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	store.objectStore = &FakeBadObjectStore{}
+	manager := NewResourceManager(store)
+	_, err := store.RunStore().CreateRun(&model.RunDetail{Run: model.Run{
+		UUID: "run1", Name: "run-1", StorageState: "STORAGESTATE_AVAILABLE", FinishedAtInSec: 1}})
+	assert.Nil(t, err)
+
+	policy := &common.ArtifactRetentionPolicy{Default: common.ArtifactRetentionRule{MaxAge: time.Hour}}
+	_, err = manager.CollectExpiredArtifacts(policy, true)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to list the artifacts of run run1")
+}
+
+func TestGetArtifactSignedURL(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+		ObjectMeta: v1.ObjectMeta{Name: "run-1", Namespace: "ns1"},
+		Status: tektonV1.PipelineRunStatus{PipelineRunStatusFields: tektonV1.PipelineRunStatusFields{
+			ChildReferences: []tektonV1.ChildStatusReference{{Name: "run-1-node", PipelineTaskName: "node"}},
+		}},
+	})
+	_, err := store.RunStore().CreateRun(&model.RunDetail{
+		Run:             model.Run{UUID: "run1", Name: "run-1", Namespace: "ns1", StorageState: "STORAGESTATE_AVAILABLE"},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: workflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+
+	signedURL, err := manager.GetArtifactSignedURL("run1", "node", "output", http.MethodGet, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://minio//artifacts/run-1/node/output.tgz?expiry=3600", signedURL.URL)
+
+	_, err = manager.GetArtifactSignedURL("run2", "node", "output", http.MethodGet, time.Hour)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestPreviewArtifact(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+		ObjectMeta: v1.ObjectMeta{Name: "run-1", Namespace: "ns1"},
+		Status: tektonV1.PipelineRunStatus{PipelineRunStatusFields: tektonV1.PipelineRunStatusFields{
+			ChildReferences: []tektonV1.ChildStatusReference{{Name: "run-1-node", PipelineTaskName: "node"}},
+		}},
+	})
+	_, err := store.RunStore().CreateRun(&model.RunDetail{
+		Run:             model.Run{UUID: "run1", Name: "run-1", Namespace: "ns1", StorageState: "STORAGESTATE_AVAILABLE"},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: workflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+	err = store.ObjectStore().AddFile(tarGzip(t, "data.csv", "a,b\n1,2\n3,4\n"), "artifacts/run-1/node/output.tgz")
+	assert.Nil(t, err)
+
+	preview, err := manager.PreviewArtifact("run1", "node", "output", 6, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, "data.csv", preview.FileName)
+	assert.Equal(t, ArtifactContentTypeCSV, preview.ContentType)
+	assert.Equal(t, []string{"a", "b"}, preview.Columns)
+	assert.Equal(t, []byte("a,b\n1,"), preview.Head)
+	assert.Equal(t, []byte("3,4\n"), preview.Tail)
+	assert.True(t, preview.Truncated)
+
+	_, err = manager.PreviewArtifact("run2", "node", "output", 6, 4)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestPreviewArtifact_ProxyDisabled(t *testing.T) {
+	viper.Set(common.FeaturesConfig+"."+string(common.FeatureArtifactProxy), "false")
+	defer viper.Set(common.FeaturesConfig+"."+string(common.FeatureArtifactProxy), nil)
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	_, err := manager.PreviewArtifact("run1", "node", "output", 6, 4)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	_, err = manager.ReadArtifact("run1", "node", "output")
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestPreviewArtifact_Cached(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifact-cache")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	viper.Set(common.ArtifactCacheDir, dir)
+	defer viper.Set(common.ArtifactCacheDir, "")
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+		ObjectMeta: v1.ObjectMeta{Name: "run-1", Namespace: "ns1"},
+		Status: tektonV1.PipelineRunStatus{PipelineRunStatusFields: tektonV1.PipelineRunStatusFields{
+			ChildReferences: []tektonV1.ChildStatusReference{{Name: "run-1-node", PipelineTaskName: "node"}},
+		}},
+	})
+	_, err = store.RunStore().CreateRun(&model.RunDetail{
+		Run:             model.Run{UUID: "run1", Name: "run-1", Namespace: "ns1", StorageState: "STORAGESTATE_AVAILABLE"},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: workflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+	artifact := tarGzip(t, "data.csv", "a,b\n1,2\n")
+	assert.Nil(t, store.ObjectStore().AddFile(artifact, "artifacts/run-1/node/output.tgz"))
+
+	preview, err := manager.PreviewArtifact("run1", "node", "output", 100, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("a,b\n1,2\n"), preview.Head)
+	assert.Equal(t, int64(len(artifact)), manager.artifactCache.Size())
+	content, err := manager.ReadArtifact("run1", "node", "output")
+	assert.Nil(t, err)
+	assert.Equal(t, artifact, content)
+
+	// A changed artifact isn't read from the cache.
+	assert.Nil(t, store.ObjectStore().AddFile(tarGzip(t, "data.csv", "c,d\n"), "artifacts/run-1/node/output.tgz"))
+	preview, err = manager.PreviewArtifact("run1", "node", "output", 100, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("c,d\n"), preview.Head)
+}
+
+func TestDrain(t *testing.T) {
+	store, manager, _ := initWithPatchedRun(t)
+	defer store.Close()
+
+	assert.Nil(t, manager.Drain(context.Background()))
+
+	apiRun := &api.Run{
+		Name:         "run2",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+	}
+	_, err := manager.CreateRun(context.Background(), apiRun)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.Unavailable, err.(*util.UserError).ExternalStatusCode())
+	assert.Equal(t, util.ReasonShuttingDown, err.(*util.UserError).Reason())
+}
+
+func TestDrain_WaitsForRunsInFlight(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	assert.Nil(t, manager.startRunCreation())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.NotNil(t, manager.Drain(ctx))
+
+	manager.inflightRuns.Done()
+	assert.Nil(t, manager.Drain(context.Background()))
+}
+
 func TestCreateDefaultExperiment_MultiUser(t *testing.T) {
 	viper.Set(common.MultiUserMode, "true")
 	defer viper.Set(common.MultiUserMode, "false")
@@ -608,6 +1367,97 @@ func TestCreateDefaultExperiment_MultiUser(t *testing.T) {
 	assert.Equal(t, expectedExperiment, experiment)
 }
 
+func TestGetNamespaceDefaultExperimentId(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	viper.Set(common.DefaultExperimentName, "{namespace} runs")
+	defer viper.Set(common.DefaultExperimentName, nil)
+
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	// The default experiment of a namespace is created the first time.
+	experimentID, err := manager.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, experimentID)
+	experiment, err := manager.GetExperiment(experimentID)
+	assert.Nil(t, err)
+	assert.Equal(t, "ns1 runs", experiment.Name)
+	assert.Equal(t, "ns1", experiment.Namespace)
+	assert.Equal(t, "All runs created without specifying an experiment will be grouped here.", experiment.Description)
+	experimentID, err = manager.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, experimentID)
+
+	// An existing experiment with the name becomes the default experiment.
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store)
+	_, err = manager.CreateExperiment(&api.Experiment{
+		Name: "ns2 runs",
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Id: "ns2", Type: api.ResourceType_NAMESPACE},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	assert.Nil(t, err)
+	experimentID, err = manager.GetNamespaceDefaultExperimentId("ns2")
+	assert.Nil(t, err)
+	assert.Equal(t, FakeUUIDOne, experimentID)
+
+	// The runs referencing a namespace go in its default experiment.
+	ref, err := manager.getDefaultExperimentIfNoExperiment([]*api.ResourceReference{{
+		Key:          &api.ResourceKey{Id: "ns1", Type: api.ResourceType_NAMESPACE},
+		Relationship: api.Relationship_OWNER,
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, ref.Key.Id)
+	_, err = manager.getDefaultExperimentIfNoExperiment(nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Experiment or namespace is required")
+}
+
+func TestSetNamespaceDefaultExperiment(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	_, err := manager.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store)
+	_, err = manager.CreateExperiment(&api.Experiment{
+		Name: "team",
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Id: "ns1", Type: api.ResourceType_NAMESPACE},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	assert.Nil(t, err)
+
+	// The experiment must be in the namespace.
+	err = manager.SetNamespaceDefaultExperiment("ns2", FakeUUIDOne)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "isn't in namespace ns2")
+
+	assert.Nil(t, manager.SetNamespaceDefaultExperiment("ns1", FakeUUIDOne))
+	experimentID, err := manager.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, FakeUUIDOne, experimentID)
+
+	// Once the default experiment is deleted, the experiment with the default name
+	// is the default again.
+	assert.Nil(t, manager.DeleteExperiment(FakeUUIDOne))
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(NonDefaultFakeUUID, nil))
+	manager = NewResourceManager(store)
+	experimentID, err = manager.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, experimentID)
+}
+
 func TestGetPodLogOptions(t *testing.T) {
 	initEnvVars()
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
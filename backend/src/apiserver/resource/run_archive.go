@@ -0,0 +1,41 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+)
+
+// MoveOldRunsToArchive moves the finished runs of the months older than the age
+// to the run archive, a whole month at a time, so run_details only holds the
+// recent months. It returns the number of moved runs.
+func (r *ResourceManager) MoveOldRunsToArchive(age time.Duration) (int, error) {
+	moved := 0
+	for {
+		count, err := r.runStore.MoveRunsToArchive(archiveCutoff(r.time.Now(), age).Unix(), common.RunArchiveBatchSize)
+		moved += count
+		if err != nil || count < common.RunArchiveBatchSize {
+			return moved, err
+		}
+	}
+}
+
+// archiveCutoff returns the start of the month, in UTC, of the time age ago.
+func archiveCutoff(now time.Time, age time.Duration) time.Time {
+	t := now.Add(-age).UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// recordRunEvent records a Normal Event on the PipelineRun of a run, which
+// must be the object returned by the Kubernetes API so that the Event refers to
+// its UID. It's a no-op without a recorder.
+func (r *ResourceManager) recordRunEvent(workflow *workflowapi.PipelineRun, reason, messageFmt string, args ...interface{}) {
+	if r.runEventRecorder == nil || workflow == nil {
+		return
+	}
+	r.runEventRecorder.Eventf(workflow, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// requestUser returns the user the request is authenticated as in multi-user
+// mode, and an empty string otherwise.
+func (r *ResourceManager) requestUser(ctx context.Context) string {
+	if !common.IsMultiUserMode() {
+		return ""
+	}
+	user, err := r.AuthenticateRequest(ctx)
+	if err != nil {
+		return ""
+	}
+	return user
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The page size of the runs listed to be moved.
+const moveRunsPageSize = 100
+
+// MoveRun moves a run to another experiment.
+func (r *ResourceManager) MoveRun(runId string, experimentId string) error {
+	run, err := r.runStore.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to move the run")
+	}
+	if run.ExperimentUUID == experimentId {
+		return util.NewInvalidInputError("Run %v is already in experiment %v.", runId, experimentId)
+	}
+	_, err = r.moveRuns([]*model.Run{&run.Run}, experimentId)
+	return err
+}
+
+// MoveExperimentRuns moves the runs of an experiment matching the filter, or
+// all of them if it's nil, to another experiment. Either all of them are moved
+// or none is. It returns the number of runs moved.
+func (r *ResourceManager) MoveExperimentRuns(sourceExperimentId string, filter *api.Filter, experimentId string) (int, error) {
+	if sourceExperimentId == experimentId {
+		return 0, util.NewInvalidInputError("The runs are already in experiment %v.", experimentId)
+	}
+	if _, err := r.experimentStore.GetExperiment(sourceExperimentId); err != nil {
+		return 0, util.Wrap(err, "Failed to move the runs")
+	}
+	opts, err := list.NewOptions(&model.Run{}, moveRunsPageSize, "", filter)
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to create the options to list the runs to move")
+	}
+	filterContext := &common.FilterContext{
+		ReferenceKey:      &common.ReferenceKey{Type: common.Experiment, ID: sourceExperimentId},
+		IncludeRunArchive: true,
+	}
+	var runs []*model.Run
+	for {
+		page, _, nextPageToken, err := r.runStore.ListRuns(filterContext, opts)
+		if err != nil {
+			return 0, util.Wrap(err, "Failed to list the runs to move")
+		}
+		runs = append(runs, page...)
+		if nextPageToken == "" {
+			break
+		}
+		if opts, err = list.NewOptionsFromToken(nextPageToken, moveRunsPageSize); err != nil {
+			return 0, util.Wrap(err, "Failed to create the options to list the runs to move")
+		}
+	}
+	return r.moveRuns(runs, experimentId)
+}
+
+// moveRuns moves the runs to an experiment, which has to be in their namespace
+// in multi-user mode and can't be archived.
+func (r *ResourceManager) moveRuns(runs []*model.Run, experimentId string) (int, error) {
+	experiment, err := r.experimentStore.GetExperiment(experimentId)
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to move the runs")
+	}
+	if experiment.StorageState == api.Experiment_STORAGESTATE_ARCHIVED.String() {
+		return 0, util.NewInvalidInputError("Experiment %v is archived.", experimentId)
+	}
+	runIds := make([]string, 0, len(runs))
+	for _, run := range runs {
+		if common.IsMultiUserMode() && run.Namespace != experiment.Namespace {
+			return 0, util.NewInvalidInputError("Run %v can't be moved out of namespace %v to experiment %v.",
+				run.UUID, run.Namespace, experimentId)
+		}
+		runIds = append(runIds, run.UUID)
+	}
+	return r.runStore.MoveRunsToExperiment(runIds, experiment)
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMoveRuns(t *testing.T) {
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+	_, err := manager.CreateRun(context.Background(), &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	assert.Nil(t, err)
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store)
+	_, err = manager.CreateExperiment(&api.Experiment{Name: "e2"})
+	assert.Nil(t, err)
+
+	assert.Nil(t, manager.MoveRun(DefaultFakeUUID, FakeUUIDOne))
+	run, err := manager.GetRun(DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, FakeUUIDOne, run.ExperimentUUID)
+	assert.Equal(t, "e2", run.ResourceReferences[0].ReferenceName)
+
+	// Only the runs matching the filter are moved.
+	filterByName := func(name string) *api.Filter {
+		return &api.Filter{Predicates: []*api.Predicate{{
+			Key: "name", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: name},
+		}}}
+	}
+	moved, err := manager.MoveExperimentRuns(FakeUUIDOne, filterByName("other"), experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, moved)
+	moved, err = manager.MoveExperimentRuns(FakeUUIDOne, filterByName("run1"), experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, moved)
+	run, err = manager.GetRun(DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, experiment.UUID, run.ExperimentUUID)
+
+	_, err = manager.MoveExperimentRuns(experiment.UUID, nil, experiment.UUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	err = manager.MoveRun(DefaultFakeUUID, experiment.UUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	err = manager.MoveRun(DefaultFakeUUID, "unknown")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	// The runs can't be moved to an archived experiment.
+	_, err = manager.ArchiveExperiment(context.Background(), FakeUUIDOne, model.ExperimentArchivePolicy{})
+	assert.Nil(t, err)
+	err = manager.MoveRun(DefaultFakeUUID, FakeUUIDOne)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is archived")
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// rejectedSubmissionError is returned for the run submissions which can't
+// succeed when retried.
+type rejectedSubmissionError struct {
+	err error
+}
+
+func (e *rejectedSubmissionError) Error() string {
+	return e.err.Error()
+}
+
+// isRejectedSubmission returns whether the workflow of the run submission was
+// rejected, rather than failed to be created.
+func isRejectedSubmission(err error) bool {
+	if _, ok := err.(*rejectedSubmissionError); ok {
+		return true
+	}
+	return apierrors.IsInvalid(err) || apierrors.IsBadRequest(err)
+}
+
+// submitRun creates the PipelineRun of the run submission, then deletes the
+// submission. The PipelineRun names are derived from the run IDs, so a
+// PipelineRun created for the run before a crash is adopted rather than
+// duplicated: the run ID is the idempotency key of the submission. The
+// RunCreated Event is recorded on the PipelineRuns it creates.
+func (r *ResourceManager) submitRun(ctx context.Context, submission *model.RunSubmission) error {
+	var workflow workflowapi.PipelineRun
+	if err := json.Unmarshal([]byte(submission.Workflow), &workflow); err != nil {
+		return &rejectedSubmissionError{util.NewInternalServerError(err, "Failed to unmarshal the workflow of run %v", submission.RunUUID)}
+	}
+	workflowClient := r.getWorkflowClient(submission.Namespace)
+	created, err := workflowClient.Create(ctx, &workflow, v1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		existing, getErr := workflowClient.Get(ctx, workflow.Name, v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		if existing.Labels[util.LabelKeyWorkflowRunId] != submission.RunUUID {
+			return &rejectedSubmissionError{fmt.Errorf("workflow %v already belongs to run %v",
+				workflow.Name, existing.Labels[util.LabelKeyWorkflowRunId])}
+		}
+		// The RunCreated Event was recorded when the PipelineRun was created.
+		created, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+	r.recordRunEvent(created, util.EventReasonRunCreated, "Created by Kubeflow Pipelines for run %v", submission.RunUUID)
+	// The run submitter deletes the submission after creating the PipelineRun
+	// again, which is a no-op.
+	if err := r.runStore.DeleteRunSubmission(submission.RunUUID); err != nil {
+		log.Warningf("Failed to delete the submission of run %v: %v", submission.RunUUID, err)
+	}
+	return nil
+}
+
+// SubmitPendingRuns creates the PipelineRuns of the runs which were stored but
+// not submitted yet, whether they were just created or the API server crashed
+// before submitting them. The runs whose workflows are rejected, or fail to be
+// created common.RunSubmissionMaxAttempts times, are marked failed. It returns
+// the number of submitted runs.
+func (r *ResourceManager) SubmitPendingRuns(ctx context.Context) (int, error) {
+	// The creation times are in seconds, the runs stored this second are included.
+	createdBefore := r.time.Now().Unix() + 1
+	submissions, err := r.runStore.ListRunSubmissions(createdBefore, common.RunSubmissionBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	submitted := 0
+	for _, submission := range submissions {
+		err := r.submitRun(ctx, submission)
+		if err == nil {
+			submitted++
+			continue
+		}
+		log.Warningf("Failed to submit run %v (attempt %d): %v", submission.RunUUID, submission.Attempts+1, err)
+		if isRejectedSubmission(err) || submission.Attempts+1 >= common.RunSubmissionMaxAttempts {
+			if err := r.runStore.FailRunSubmission(submission.RunUUID, r.time.Now().Unix()); err != nil {
+				return submitted, err
+			}
+			continue
+		}
+		if err := r.runStore.UpdateRunSubmission(submission.RunUUID, err.Error()); err != nil {
+			return submitted, err
+		}
+	}
+	return submitted, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// isSoftDeleteEnabled returns whether the deleted pipelines, experiments, jobs
+// and runs are kept for the purge window, to be restored by an admin.
+func isSoftDeleteEnabled() bool {
+	return common.GetSoftDeletePurgeWindow() > 0
+}
+
+// Undelete restores the soft deleted resource of the type: a pipeline, an
+// experiment, a job or a run. A restored job stays disabled.
+func (r *ResourceManager) Undelete(resourceType model.ResourceType, id string) error {
+	var err error
+	switch resourceType {
+	case common.Pipeline:
+		err = r.pipelineStore.UndeletePipeline(id)
+	case common.Experiment:
+		err = r.experimentStore.UndeleteExperiment(id)
+	case common.Job:
+		err = r.jobStore.UndeleteJob(id)
+	case common.Run:
+		err = r.runStore.UndeleteRun(id)
+	default:
+		return util.NewInvalidInputError("Resources of type %v can't be restored", resourceType)
+	}
+	return util.Wrap(err, "Undelete failed")
+}
+
+// PurgeDeletedResources deletes the resources soft deleted longer than the purge
+// window ago, with their workflows, files and artifacts. It returns the number of
+// purged resources.
+func (r *ResourceManager) PurgeDeletedResources(ctx context.Context) (int, error) {
+	deletedBefore := r.time.Now().Add(-common.GetSoftDeletePurgeWindow()).Unix()
+	purged := 0
+
+	runs, err := r.runStore.ListRunsDeletedBefore(deletedBefore)
+	if err != nil {
+		return purged, err
+	}
+	for _, run := range runs {
+		if err := r.purgeRun(ctx, run); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	jobs, err := r.jobStore.ListJobsDeletedBefore(deletedBefore)
+	if err != nil {
+		return purged, err
+	}
+	for _, job := range jobs {
+		if err := r.purgeJob(ctx, job); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	pipelines, err := r.pipelineStore.ListPipelinesDeletedBefore(deletedBefore)
+	if err != nil {
+		return purged, err
+	}
+	for _, pipeline := range pipelines {
+		if err := r.purgePipeline(pipeline.UUID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	experiments, err := r.experimentStore.ListExperimentsDeletedBefore(deletedBefore)
+	if err != nil {
+		return purged, err
+	}
+	for _, experiment := range experiments {
+		if err := r.experimentStore.DeleteExperiment(experiment.UUID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CheckStalledRuns looks for the unfinished runs whose PipelineRuns didn't
+// progress for longer than the timeout, e.g. because an admission webhook
+// failure left them unstarted. If resubmit is set, their PipelineRuns are
+// resubmitted up to common.StalledRunMaxResubmissions times; otherwise, or once
+// the resubmissions are used up, the runs are marked stalled until their
+// PipelineRuns progress again. A run which fails to be handled doesn't stop the
+// others. It returns the number of stalled runs found.
+func (r *ResourceManager) CheckStalledRuns(ctx context.Context, timeout time.Duration, resubmit bool) (int, error) {
+	now := r.time.Now()
+	runs, err := r.runStore.ListUnfinishedRunsCreatedBetween(0, now.Add(-timeout).Unix())
+	if err != nil {
+		return 0, err
+	}
+	stalled := 0
+	for _, run := range runs {
+		pipelineRun, err := r.getWorkflowClient(run.Namespace).Get(ctx, run.Name, v1.GetOptions{})
+		if util.IsNotFound(err) {
+			// The run submitter creates the PipelineRuns which are missing.
+			continue
+		}
+		if err != nil {
+			log.Warningf("Failed to get the PipelineRun of run %v to check whether it stalled: %v", run.UUID, err)
+			continue
+		}
+		workflow := util.NewWorkflow(pipelineRun)
+		if workflow.IsInFinalState() || workflow.IsStalled() {
+			continue
+		}
+		lastProgress := workflow.LastProgressAt()
+		if createdAt := time.Unix(run.CreatedAtInSec, 0); createdAt.After(lastProgress) {
+			lastProgress = createdAt
+		}
+		if now.Sub(lastProgress) < timeout {
+			continue
+		}
+		stalled++
+		if resubmit && workflow.Resubmissions() < common.StalledRunMaxResubmissions {
+			err = r.resubmitWorkflow(ctx, run, workflow)
+		} else {
+			err = r.markRunStalled(ctx, run, workflow, lastProgress)
+		}
+		if err != nil {
+			log.Warningf("Failed to handle the stalled run %v: %v", run.UUID, err)
+		}
+	}
+	return stalled, nil
+}
+
+// markRunStalled marks the run stalled, along with its PipelineRun, so that the
+// persistence agent reports it stalled until the PipelineRun progresses again.
+func (r *ResourceManager) markRunStalled(ctx context.Context, run *model.RunDetail, workflow *util.Workflow, lastProgress time.Time) error {
+	workflow.SetAnnotations(util.AnnotationKeyStalledAt, strconv.FormatInt(lastProgress.Unix(), 10))
+	updated, err := r.getWorkflowClient(run.Namespace).Update(ctx, workflow.PipelineRun, v1.UpdateOptions{})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to mark the PipelineRun of run %v stalled", run.UUID)
+	}
+	if err := r.runStore.UpdateRun(run.UUID, util.StalledCondition, 0, workflow.ToStringForStore()); err != nil {
+		return util.Wrapf(err, "Failed to mark run %v stalled", run.UUID)
+	}
+	log.Warningf("Run %v stalled: its status didn't change since %v", run.UUID, lastProgress.UTC().Format(time.RFC3339))
+	r.recordRunEvent(updated, util.EventReasonRunStalled, "The status of run %v didn't change since %v",
+		run.UUID, lastProgress.UTC().Format(time.RFC3339))
+	if run.Conditions != util.StalledCondition {
+		r.publishRunEvent(eventbus.EventTypeRunPhaseChanged, run.UUID, util.StalledCondition, run.Conditions)
+	}
+	return nil
+}
+
+// resubmitWorkflow deletes the PipelineRun of a stalled run and creates it again
+// with the same name and labels, so that it's still reported for the run.
+func (r *ResourceManager) resubmitWorkflow(ctx context.Context, run *model.RunDetail, workflow *util.Workflow) error {
+	annotations := map[string]string{}
+	for key, value := range workflow.GetAnnotations() {
+		annotations[key] = value
+	}
+	resubmissions := workflow.Resubmissions() + 1
+	annotations[util.AnnotationKeyResubmissions] = strconv.Itoa(resubmissions)
+	delete(annotations, util.AnnotationKeyStalledAt)
+	resubmitted := &workflowapi.PipelineRun{
+		TypeMeta: workflow.TypeMeta,
+		ObjectMeta: v1.ObjectMeta{
+			Name:            workflow.Name,
+			Namespace:       workflow.Namespace,
+			Labels:          workflow.Labels,
+			Annotations:     annotations,
+			OwnerReferences: workflow.OwnerReferences,
+		},
+		Spec: *workflow.Spec.DeepCopy(),
+	}
+	workflowClient := r.getWorkflowClient(run.Namespace)
+	if err := workflowClient.Delete(ctx, workflow.Name, v1.DeleteOptions{}); err != nil && !util.IsNotFound(err) {
+		return util.NewInternalServerError(err, "Failed to delete the stalled PipelineRun of run %v", run.UUID)
+	}
+	created, err := workflowClient.Create(ctx, resubmitted, v1.CreateOptions{})
+	if err != nil {
+		// The PipelineRun is gone, the run is left stalled for the users to retry or delete it.
+		if updateErr := r.runStore.UpdateRun(run.UUID, util.StalledCondition, 0, workflow.ToStringForStore()); updateErr != nil {
+			log.Warningf("Failed to mark run %v stalled: %v", run.UUID, updateErr)
+		}
+		return util.NewInternalServerError(err, "Failed to resubmit the stalled PipelineRun of run %v", run.UUID)
+	}
+	if err := r.runStore.UpdateRun(run.UUID, run.Conditions, 0, util.NewWorkflow(created).ToStringForStore()); err != nil {
+		return util.Wrapf(err, "Failed to update the resubmitted run %v", run.UUID)
+	}
+	log.Infof("Resubmitted the stalled PipelineRun of run %v (resubmission %d)", run.UUID, resubmissions)
+	r.recordRunEvent(created, util.EventReasonRunResubmitted, "Resubmitted the stalled PipelineRun of run %v (resubmission %d of %d)",
+		run.UUID, resubmissions, common.StalledRunMaxResubmissions)
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// initWithStalledRun stores a run created an hour ago, whose PipelineRun never
+// got a status.
+func initWithStalledRun(t *testing.T) (*FakeClientManager, *ResourceManager) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTime(time.Unix(3600, 0)))
+	manager := NewResourceManager(store)
+	workflow := util.NewWorkflow(testWorkflow.DeepCopy())
+	workflow.SetName("workflow-stalled")
+	workflow.SetLabels(util.LabelKeyWorkflowRunId, "run-1")
+	_, err := store.TektonClientFake.PipelineRun("ns1").Create(context.Background(), workflow.PipelineRun, v1.CreateOptions{})
+	assert.Nil(t, err)
+	_, err = store.RunStore().CreateRun(&model.RunDetail{
+		Run: model.Run{UUID: "run-1", Name: "workflow-stalled", Namespace: "ns1", CreatedAtInSec: 1, Conditions: "Running"},
+	})
+	assert.Nil(t, err)
+	return store, manager
+}
+
+func TestCheckStalledRuns(t *testing.T) {
+	store, manager := initWithStalledRun(t)
+	defer store.Close()
+
+	stalled, err := manager.CheckStalledRuns(context.Background(), time.Minute, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, stalled)
+	run, err := manager.GetRun("run-1")
+	assert.Nil(t, err)
+	assert.Equal(t, util.StalledCondition, run.Conditions)
+	pipelineRun, err := store.TektonClientFake.PipelineRun("ns1").Get(context.Background(), "workflow-stalled", v1.GetOptions{})
+	assert.Nil(t, err)
+	workflow := util.NewWorkflow(pipelineRun)
+	assert.True(t, workflow.IsStalled())
+
+	// The runs already marked stalled are left alone.
+	stalled, err = manager.CheckStalledRuns(context.Background(), time.Minute, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, stalled)
+
+	// The persistence agent reports the run stalled until its PipelineRun progresses.
+	assert.Nil(t, manager.ReportWorkflowResource(context.Background(), workflow))
+	run, err = manager.GetRun("run-1")
+	assert.Nil(t, err)
+	assert.Equal(t, util.StalledCondition, run.Conditions)
+	startTime := v1.Unix(3700, 0)
+	workflow.Status.PipelineRunStatusFields.StartTime = &startTime
+	assert.Nil(t, manager.ReportWorkflowResource(context.Background(), workflow))
+	run, err = manager.GetRun("run-1")
+	assert.Nil(t, err)
+	assert.NotEqual(t, util.StalledCondition, run.Conditions)
+}
+
+func TestCheckStalledRuns_Resubmit(t *testing.T) {
+	store, manager := initWithStalledRun(t)
+	defer store.Close()
+
+	// The fake PipelineRuns never get a status, so they stall again until the
+	// resubmissions are used up.
+	for i := 1; i <= common.StalledRunMaxResubmissions; i++ {
+		stalled, err := manager.CheckStalledRuns(context.Background(), time.Minute, true)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, stalled)
+		pipelineRun, err := store.TektonClientFake.PipelineRun("ns1").Get(context.Background(), "workflow-stalled", v1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, i, util.NewWorkflow(pipelineRun).Resubmissions())
+		assert.Equal(t, "run-1", pipelineRun.Labels[util.LabelKeyWorkflowRunId])
+		run, err := manager.GetRun("run-1")
+		assert.Nil(t, err)
+		assert.Equal(t, "Running", run.Conditions)
+	}
+
+	stalled, err := manager.CheckStalledRuns(context.Background(), time.Minute, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, stalled)
+	run, err := manager.GetRun("run-1")
+	assert.Nil(t, err)
+	assert.Equal(t, util.StalledCondition, run.Conditions)
+}
@@ -0,0 +1,259 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// The chunks of an upload are stored under the offset they start at, padded so
+// that they are listed in order.
+const uploadPrefix = "uploads"
+
+func uploadChunkKey(uploadID string, offset int64) string {
+	return path.Join(uploadPrefix, uploadID, fmt.Sprintf("%020d", offset))
+}
+
+// CreateUpload starts a resumable upload of a file, which is an artifact of the
+// run if runID is set, or else a pipeline package of the namespace.
+func (r *ResourceManager) CreateUpload(namespace string, runID string, fileName string, size int64) (*model.UploadSession, error) {
+	if size <= 0 {
+		return nil, util.NewInvalidInputError("The size of an upload must be positive, got %v", size)
+	}
+	if runID != "" {
+		run, err := r.GetRun(runID)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to create an upload")
+		}
+		namespace = run.Namespace
+	} else if size > int64(common.GetMaxManifestSize()) {
+		return nil, util.NewInvalidInputError("The pipeline package is %v bytes, larger than the maximum of %v bytes",
+			size, common.GetMaxManifestSize())
+	}
+	id, err := r.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to generate the upload id")
+	}
+	session := &model.UploadSession{
+		UUID:           id.String(),
+		Namespace:      namespace,
+		RunUUID:        runID,
+		FileName:       fileName,
+		Size:           size,
+		CreatedAtInSec: r.time.Now().Unix(),
+	}
+	if err := r.uploadSessionStore.CreateUploadSession(session); err != nil {
+		return nil, util.Wrap(err, "Failed to create an upload")
+	}
+	return session, nil
+}
+
+func (r *ResourceManager) GetUpload(uploadID string) (*model.UploadSession, error) {
+	return r.uploadSessionStore.GetUploadSession(uploadID)
+}
+
+// UploadChunk stores the chunk of an upload starting at the offset, which has to
+// be the size uploaded so far. A client resuming an upload gets the upload to
+// know which offset to continue from.
+func (r *ResourceManager) UploadChunk(uploadID string, offset int64, chunk []byte) (*model.UploadSession, error) {
+	session, err := r.uploadSessionStore.GetUploadSession(uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if offset != session.UploadedSize {
+		return nil, util.NewFailedPreconditionError(errors.New("offset mismatch"),
+			"The chunk starts at offset %v, but %v bytes of upload %v were uploaded", offset, session.UploadedSize, uploadID)
+	}
+	end := offset + int64(len(chunk))
+	switch {
+	case len(chunk) == 0:
+		return nil, util.NewInvalidInputError("The chunk is empty")
+	case int64(len(chunk)) > common.MaxUploadChunkSize:
+		return nil, util.NewInvalidInputError("The chunk is larger than %v bytes", common.MaxUploadChunkSize)
+	case end > session.Size:
+		return nil, util.NewInvalidInputError("The chunk ends at offset %v, past the size %v of upload %v", end, session.Size, uploadID)
+	case end < session.Size && int64(len(chunk)) < common.MinUploadChunkSize:
+		return nil, util.NewInvalidInputError("Only the last chunk may be smaller than %v bytes", common.MinUploadChunkSize)
+	}
+	objectStore, err := r.objectStore.ForNamespace(session.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := objectStore.AddFileInNamespace(chunk, uploadChunkKey(uploadID, offset), session.Namespace); err != nil {
+		return nil, util.Wrapf(err, "Failed to store a chunk of upload %v", uploadID)
+	}
+	advanced, err := r.uploadSessionStore.AdvanceUploadSession(uploadID, offset, end)
+	if err != nil {
+		return nil, err
+	}
+	if !advanced {
+		return nil, util.NewFailedPreconditionError(errors.New("offset mismatch"),
+			"Another chunk of upload %v was uploaded at offset %v concurrently", uploadID, offset)
+	}
+	session.UploadedSize = end
+	return session, nil
+}
+
+// ReadUpload returns the content of a complete upload of a pipeline package.
+func (r *ResourceManager) ReadUpload(uploadID string) (*model.UploadSession, []byte, error) {
+	session, err := r.completeUpload(uploadID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.RunUUID != "" {
+		return nil, nil, util.NewInvalidInputError("Upload %v is an artifact of run %v", uploadID, session.RunUUID)
+	}
+	objectStore, err := r.objectStore.ForNamespace(session.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	chunkKeys, err := r.listUploadChunks(objectStore, session)
+	if err != nil {
+		return nil, nil, err
+	}
+	var content bytes.Buffer
+	for _, chunkKey := range chunkKeys {
+		chunk, err := objectStore.GetFile(chunkKey)
+		if err != nil {
+			return nil, nil, util.Wrapf(err, "Failed to read upload %v", uploadID)
+		}
+		content.Write(chunk)
+	}
+	return session, content.Bytes(), nil
+}
+
+// CompleteArtifactUpload replaces the content of an artifact of a run with a
+// complete upload, which is composed in the object store without going through
+// the API server. The upload is deleted then.
+func (r *ResourceManager) CompleteArtifactUpload(runID string, nodeID string, artifactName string, uploadID string) error {
+	session, err := r.completeUpload(uploadID)
+	if err != nil {
+		return err
+	}
+	if session.RunUUID != runID {
+		return util.NewInvalidInputError("Upload %v isn't an artifact of run %v", uploadID, runID)
+	}
+	namespace, artifactKey, err := r.getArtifactKey(runID, nodeID, artifactName)
+	if err != nil {
+		return err
+	}
+	objectStore, err := r.objectStore.ForNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	chunkKeys, err := r.listUploadChunks(objectStore, session)
+	if err != nil {
+		return err
+	}
+	if err := objectStore.ComposeFile(chunkKeys, artifactKey, namespace); err != nil {
+		return util.Wrapf(err, "Failed to store upload %v as artifact %v", uploadID, artifactKey)
+	}
+	// The upload replaced the artifact, not the content it shared with others.
+	if _, err := r.dropArtifactReference(objectStore, artifactKey); err != nil {
+		return err
+	}
+	return r.deleteUpload(objectStore, session)
+}
+
+// DeleteUpload deletes an upload and its chunks.
+func (r *ResourceManager) DeleteUpload(uploadID string) error {
+	session, err := r.uploadSessionStore.GetUploadSession(uploadID)
+	if err != nil {
+		return err
+	}
+	objectStore, err := r.objectStore.ForNamespace(session.Namespace)
+	if err != nil {
+		return err
+	}
+	return r.deleteUpload(objectStore, session)
+}
+
+// CollectExpiredUploads deletes the uploads created more than UploadTTL ago,
+// which the clients abandoned. It returns the number of deleted uploads.
+func (r *ResourceManager) CollectExpiredUploads() (int, error) {
+	sessions, err := r.uploadSessionStore.ListUploadSessionsCreatedBefore(r.time.Now().Add(-common.UploadTTL).Unix())
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, session := range sessions {
+		objectStore, err := r.objectStore.ForNamespace(session.Namespace)
+		if err != nil {
+			return deleted, err
+		}
+		if err := r.deleteUpload(objectStore, session); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (r *ResourceManager) completeUpload(uploadID string) (*model.UploadSession, error) {
+	session, err := r.uploadSessionStore.GetUploadSession(uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsComplete() {
+		return nil, util.NewFailedPreconditionError(errors.New("incomplete upload"),
+			"Only %v of the %v bytes of upload %v were uploaded", session.UploadedSize, session.Size, uploadID)
+	}
+	return session, nil
+}
+
+// listUploadChunks returns the keys of the chunks of an upload in order,
+// checking that they are contiguous.
+func (r *ResourceManager) listUploadChunks(objectStore storage.ObjectStoreInterface, session *model.UploadSession) ([]string, error) {
+	objects, err := objectStore.ListFiles(path.Join(uploadPrefix, session.UUID) + "/")
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list the chunks of upload %v", session.UUID)
+	}
+	var chunkKeys []string
+	var offset int64
+	for _, object := range objects {
+		if object.Key != uploadChunkKey(session.UUID, offset) {
+			return nil, util.NewInternalServerError(errors.New("missing chunk"),
+				"The chunk at offset %v of upload %v is missing", offset, session.UUID)
+		}
+		chunkKeys = append(chunkKeys, object.Key)
+		offset += object.Size
+	}
+	if offset != session.Size {
+		return nil, util.NewInternalServerError(errors.New("missing chunk"),
+			"The chunks of upload %v hold %v of its %v bytes", session.UUID, offset, session.Size)
+	}
+	return chunkKeys, nil
+}
+
+func (r *ResourceManager) deleteUpload(objectStore storage.ObjectStoreInterface, session *model.UploadSession) error {
+	objects, err := objectStore.ListFiles(path.Join(uploadPrefix, session.UUID) + "/")
+	if err != nil {
+		return util.Wrapf(err, "Failed to list the chunks of upload %v", session.UUID)
+	}
+	for _, object := range objects {
+		if err := objectStore.DeleteFile(object.Key); err != nil {
+			return util.Wrapf(err, "Failed to delete the chunks of upload %v", session.UUID)
+		}
+	}
+	return r.uploadSessionStore.DeleteUploadSession(session.UUID)
+}
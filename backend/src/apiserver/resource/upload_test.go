@@ -0,0 +1,125 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpload_Pipeline(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	session, err := manager.CreateUpload("ns1", "", "pipeline.yaml", common.MinUploadChunkSize+3)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, session.UUID)
+	assert.Equal(t, "ns1", session.Namespace)
+	assert.Equal(t, int64(0), session.UploadedSize)
+
+	// Only the last chunk may be small.
+	_, err = manager.UploadChunk(DefaultFakeUUID, 0, []byte("abc"))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	first := bytes.Repeat([]byte("a"), int(common.MinUploadChunkSize))
+	session, err = manager.UploadChunk(DefaultFakeUUID, 0, first)
+	assert.Nil(t, err)
+	assert.Equal(t, common.MinUploadChunkSize, session.UploadedSize)
+
+	// A chunk sent again after its response was lost is rejected, the client
+	// resumes from the uploaded size.
+	_, err = manager.UploadChunk(DefaultFakeUUID, 0, first)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	_, _, err = manager.ReadUpload(DefaultFakeUUID)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+
+	session, err = manager.UploadChunk(DefaultFakeUUID, common.MinUploadChunkSize, []byte("abc"))
+	assert.Nil(t, err)
+	assert.True(t, session.IsComplete())
+
+	session, content, err := manager.ReadUpload(DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "pipeline.yaml", session.FileName)
+	assert.Equal(t, append(first, []byte("abc")...), content)
+
+	assert.Nil(t, manager.DeleteUpload(DefaultFakeUUID))
+	_, err = manager.GetUpload(DefaultFakeUUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	files, err := store.ObjectStore().ListFiles("uploads/")
+	assert.Nil(t, err)
+	assert.Empty(t, files)
+}
+
+func TestUpload_Artifact(t *testing.T) {
+	store, manager, minioClient := initWithDuplicateArtifacts(t)
+	defer store.Close()
+	_, err := manager.DeduplicateArtifacts()
+	assert.Nil(t, err)
+
+	_, err = manager.CreateUpload("", "run1", "a.tgz", 3)
+	assert.Nil(t, err)
+	_, err = manager.UploadChunk(DefaultFakeUUID, 0, []byte("xyz"))
+	assert.Nil(t, err)
+	assert.Nil(t, manager.CompleteArtifactUpload("run1", "node", "a", DefaultFakeUUID))
+
+	// The upload replaces the artifact, without changing the other artifacts.
+	content, err := manager.ReadArtifact("run1", "node", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("xyz"), content)
+	content, err = manager.ReadArtifact("run2", "node", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), content)
+	assert.False(t, minioClient.ExistObject(uploadChunkKey(DefaultFakeUUID, 0)))
+	_, err = manager.GetUpload(DefaultFakeUUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestUpload_ArtifactOfAnotherRun(t *testing.T) {
+	store, manager, _ := initWithDuplicateArtifacts(t)
+	defer store.Close()
+
+	_, err := manager.CreateUpload("", "run1", "a.tgz", 3)
+	assert.Nil(t, err)
+	_, err = manager.UploadChunk(DefaultFakeUUID, 0, []byte("xyz"))
+	assert.Nil(t, err)
+	err = manager.CompleteArtifactUpload("run2", "node", "a", DefaultFakeUUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	_, _, err = manager.ReadUpload(DefaultFakeUUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestCollectExpiredUploads(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	_, err := manager.CreateUpload("ns1", "", "pipeline.yaml", 10)
+	assert.Nil(t, err)
+
+	deleted, err := manager.CollectExpiredUploads()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, deleted)
+
+	manager.time = util.NewFakeTime(manager.time.Now().Add(common.UploadTTL + time.Second))
+	deleted, err = manager.CollectExpiredUploads()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted)
+}
@@ -0,0 +1,281 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/webhook"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+// Count the webhook delivery attempts by their result.
+var webhookDeliveryCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "resource_manager_webhook_deliveries",
+	Help: "The number of webhook delivery attempts, by result",
+}, []string{"result"})
+
+// CreateWebhookSubscription subscribes the URL of the subscription to the events
+// it filters. The subscription is enabled, and its events are delivered from
+// the next event on.
+func (r *ResourceManager) CreateWebhookSubscription(subscription *model.WebhookSubscription) (*model.WebhookSubscription, error) {
+	if err := r.validateWebhookSubscription(subscription); err != nil {
+		return nil, util.Wrap(err, "Failed to create a webhook subscription")
+	}
+	id, err := r.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to generate the webhook subscription id")
+	}
+	now := r.time.Now().Unix()
+	subscription.UUID = id.String()
+	subscription.Enabled = true
+	subscription.CreatedAtInSec = now
+	subscription.UpdatedAtInSec = now
+	if err := r.webhookStore.CreateWebhookSubscription(subscription); err != nil {
+		return nil, util.Wrap(err, "Failed to create a webhook subscription")
+	}
+	r.invalidateWebhookSubscriptions()
+	return subscription, nil
+}
+
+func (r *ResourceManager) GetWebhookSubscription(subscriptionID string) (*model.WebhookSubscription, error) {
+	return r.webhookStore.GetWebhookSubscription(subscriptionID)
+}
+
+// ListWebhookSubscriptions lists the subscriptions of a namespace, or all of
+// them when the namespace is empty.
+func (r *ResourceManager) ListWebhookSubscriptions(namespace string) ([]*model.WebhookSubscription, error) {
+	return r.webhookStore.ListWebhookSubscriptions(namespace)
+}
+
+// UpdateWebhookSubscription replaces the name, URL, filters and enablement of a
+// subscription. The secret is kept when the update has none, and the
+// namespace can't be changed.
+func (r *ResourceManager) UpdateWebhookSubscription(subscriptionID string, update *model.WebhookSubscription) (*model.WebhookSubscription, error) {
+	subscription, err := r.webhookStore.GetWebhookSubscription(subscriptionID)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to update the webhook subscription")
+	}
+	subscription.Name = update.Name
+	subscription.URL = update.URL
+	subscription.EventTypes = update.EventTypes
+	subscription.ExperimentID = update.ExperimentID
+	subscription.Enabled = update.Enabled
+	if update.Secret != "" {
+		subscription.Secret = update.Secret
+	}
+	if err := r.validateWebhookSubscription(subscription); err != nil {
+		return nil, util.Wrap(err, "Failed to update the webhook subscription")
+	}
+	subscription.UpdatedAtInSec = r.time.Now().Unix()
+	if err := r.webhookStore.UpdateWebhookSubscription(subscription); err != nil {
+		return nil, util.Wrap(err, "Failed to update the webhook subscription")
+	}
+	r.invalidateWebhookSubscriptions()
+	return subscription, nil
+}
+
+// DeleteWebhookSubscription deletes a subscription, and its deliveries, including
+// the pending ones.
+func (r *ResourceManager) DeleteWebhookSubscription(subscriptionID string) error {
+	if _, err := r.webhookStore.GetWebhookSubscription(subscriptionID); err != nil {
+		return util.Wrap(err, "Failed to delete the webhook subscription")
+	}
+	if err := r.webhookStore.DeleteWebhookSubscription(subscriptionID); err != nil {
+		return util.Wrap(err, "Failed to delete the webhook subscription")
+	}
+	r.invalidateWebhookSubscriptions()
+	return nil
+}
+
+// ListWebhookDeliveries lists the latest deliveries of a subscription, in the
+// status unless it's empty.
+func (r *ResourceManager) ListWebhookDeliveries(subscriptionID string, status string, limit int) ([]*model.WebhookDelivery, error) {
+	switch status {
+	case "", model.WebhookDeliveryPending, model.WebhookDeliverySucceeded, model.WebhookDeliveryFailed:
+	default:
+		return nil, util.NewInvalidInputError("Invalid webhook delivery status %q", status)
+	}
+	if _, err := r.webhookStore.GetWebhookSubscription(subscriptionID); err != nil {
+		return nil, util.Wrap(err, "Failed to list the webhook deliveries")
+	}
+	return r.webhookStore.ListWebhookDeliveries(subscriptionID, status, limit)
+}
+
+func (r *ResourceManager) validateWebhookSubscription(subscription *model.WebhookSubscription) error {
+	if err := webhook.ValidateSubscription(subscription, r.webhookHostPolicy); err != nil {
+		return err
+	}
+	if subscription.ExperimentID != "" {
+		experiment, err := r.experimentStore.GetExperiment(subscription.ExperimentID)
+		if err != nil {
+			return util.Wrapf(err, "Failed to get experiment %s", subscription.ExperimentID)
+		}
+		if subscription.Namespace != "" && experiment.Namespace != subscription.Namespace {
+			return util.NewInvalidInputError("Experiment %s isn't in namespace %s", subscription.ExperimentID, subscription.Namespace)
+		}
+	}
+	return nil
+}
+
+// enabledWebhookSubscriptions returns the subscriptions the events are delivered
+// to. They're cached for a while since they're read for each event, so the
+// changes made through another replica, or the persistence agent, take effect
+// after the cache expires.
+func (r *ResourceManager) enabledWebhookSubscriptions() []*model.WebhookSubscription {
+	r.webhookMu.Lock()
+	defer r.webhookMu.Unlock()
+	if time.Since(r.webhookSubscriptionsTime) < common.WebhookSubscriptionCacheDuration {
+		return r.webhookSubscriptions
+	}
+	subscriptions, err := r.webhookStore.ListEnabledWebhookSubscriptions()
+	if err != nil {
+		// The stale subscriptions are used until they can be listed again.
+		log.Warnf("Failed to list the webhook subscriptions: %v", err)
+		return r.webhookSubscriptions
+	}
+	r.webhookSubscriptions = subscriptions
+	r.webhookSubscriptionsTime = time.Now()
+	return subscriptions
+}
+
+func (r *ResourceManager) invalidateWebhookSubscriptions() {
+	r.webhookMu.Lock()
+	defer r.webhookMu.Unlock()
+	r.webhookSubscriptionsTime = time.Time{}
+}
+
+// enqueueWebhookDeliveries stores a pending delivery of the event for each
+// subscription it matches. The deliveries are sent by DeliverWebhooks.
+func (r *ResourceManager) enqueueWebhookDeliveries(event *eventbus.Event) {
+	var deliveries []*model.WebhookDelivery
+	for _, subscription := range r.enabledWebhookSubscriptions() {
+		if !webhook.Matches(subscription, event) {
+			continue
+		}
+		id, err := r.uuid.NewRandom()
+		if err != nil {
+			log.Warnf("Failed to create the id of the delivery of event %s: %v", event.ID, err)
+			return
+		}
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			UUID:               id.String(),
+			SubscriptionUUID:   subscription.UUID,
+			EventID:            event.ID,
+			EventType:          event.Type,
+			Status:             model.WebhookDeliveryPending,
+			NextAttemptAtInSec: event.Time.Unix(),
+			CreatedAtInSec:     event.Time.Unix(),
+		})
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Warnf("Failed to marshal event %s for the webhooks: %v", event.ID, err)
+		return
+	}
+	for _, delivery := range deliveries {
+		delivery.Payload = string(payload)
+	}
+	if err := r.webhookStore.CreateWebhookDeliveries(deliveries); err != nil {
+		log.Warnf("Failed to store the webhook deliveries of event %s: %v", event.ID, err)
+	}
+}
+
+// DeliverWebhooks sends a batch of the pending webhook deliveries which are due,
+// and purges the finished deliveries older than the retention. A failed
+// delivery is retried with an exponential backoff until it runs out of
+// attempts. It returns the number of deliveries sent successfully.
+func (r *ResourceManager) DeliverWebhooks(maxAttempts int, retention time.Duration) (int, error) {
+	now := r.time.Now()
+	deliveries, err := r.webhookStore.ListDueWebhookDeliveries(now.Unix(), common.WebhookDeliveryBatchSize)
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to list the due webhook deliveries")
+	}
+	subscriptions := map[string]*model.WebhookSubscription{}
+	delivered := 0
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionUUID]
+		if !ok {
+			subscription, err = r.webhookStore.GetWebhookSubscription(delivery.SubscriptionUUID)
+			if err != nil {
+				return delivered, util.Wrapf(err, "Failed to get webhook subscription %s", delivery.SubscriptionUUID)
+			}
+			subscriptions[delivery.SubscriptionUUID] = subscription
+		}
+		delivery.Attempts++
+		if !subscription.Enabled {
+			delivery.Status = model.WebhookDeliveryFailed
+			delivery.LastError = "The webhook subscription is disabled"
+		} else if code, err := r.webhookSender.Send(subscription, delivery, now); err == nil {
+			delivery.Status = model.WebhookDeliverySucceeded
+			delivery.ResponseCode = int64(code)
+			delivery.LastError = ""
+			delivery.DeliveredAtInSec = now.Unix()
+			delivered++
+		} else {
+			// Only the status code is kept, since the errors of the connection
+			// would tell the users about the networks the server reaches.
+			log.Warnf("Failed to send webhook delivery %s: %v", delivery.UUID, err)
+			delivery.ResponseCode = int64(code)
+			delivery.LastError = webhookDeliveryError(code)
+			if delivery.Attempts >= int64(maxAttempts) {
+				delivery.Status = model.WebhookDeliveryFailed
+			} else {
+				delivery.NextAttemptAtInSec = now.Add(webhookDeliveryBackoff(delivery.Attempts)).Unix()
+			}
+		}
+		webhookDeliveryCounter.WithLabelValues(delivery.Status).Inc()
+		if err := r.webhookStore.UpdateWebhookDelivery(delivery); err != nil {
+			return delivered, util.Wrapf(err, "Failed to record the attempt of webhook delivery %s", delivery.UUID)
+		}
+	}
+	if _, err := r.webhookStore.DeleteWebhookDeliveriesCreatedBefore(now.Add(-retention).Unix()); err != nil {
+		return delivered, util.Wrap(err, "Failed to purge the webhook deliveries")
+	}
+	return delivered, nil
+}
+
+// webhookDeliveryError describes a failed delivery attempt by the status code
+// of its response, zero if there was none.
+func webhookDeliveryError(code int) string {
+	if code == 0 {
+		return "The receiver couldn't be reached"
+	}
+	return fmt.Sprintf("The receiver responded with status code %d", code)
+}
+
+// webhookDeliveryBackoff returns how long to wait before the next attempt of a
+// delivery after its failed attempts.
+func webhookDeliveryBackoff(attempts int64) time.Duration {
+	backoff := common.WebhookDeliveryInitialBackoff
+	for i := int64(1); i < attempts && backoff < common.WebhookDeliveryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > common.WebhookDeliveryMaxBackoff {
+		return common.WebhookDeliveryMaxBackoff
+	}
+	return backoff
+}
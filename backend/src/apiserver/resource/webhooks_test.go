@@ -0,0 +1,158 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/webhook"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestWebhookSubscriptions(t *testing.T) {
+	viper.Set(common.WebhookAllowedHosts, "ci.example.com")
+	defer viper.Set(common.WebhookAllowedHosts, "")
+	store, manager, experiment := initWithExperiment(t)
+	defer store.Close()
+	manager.uuid = util.NewUUIDGenerator()
+
+	_, err := manager.CreateWebhookSubscription(&model.WebhookSubscription{Name: "ci", URL: "not a url"})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	_, err = manager.CreateWebhookSubscription(&model.WebhookSubscription{Name: "ci", URL: "http://10.0.0.1/hook"})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	_, err = manager.CreateWebhookSubscription(&model.WebhookSubscription{Name: "ci", URL: "https://ci.example.com/hook",
+		ExperimentID: "missing"})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	subscription, err := manager.CreateWebhookSubscription(&model.WebhookSubscription{Name: "ci",
+		Namespace: experiment.Namespace, URL: "https://ci.example.com/hook", Secret: "s3cr3t",
+		EventTypes: "run.*", ExperimentID: experiment.UUID})
+	assert.Nil(t, err)
+	assert.True(t, subscription.Enabled)
+	subscriptions, err := manager.ListWebhookSubscriptions(experiment.Namespace)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.WebhookSubscription{subscription}, subscriptions)
+
+	// The secret is kept when the update has none.
+	updated, err := manager.UpdateWebhookSubscription(subscription.UUID, &model.WebhookSubscription{Name: "ci",
+		URL: "https://ci.example.com/hook2", EventTypes: "job.*"})
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", updated.Secret)
+	assert.Equal(t, "https://ci.example.com/hook2", updated.URL)
+	assert.False(t, updated.Enabled)
+	assert.Empty(t, manager.enabledWebhookSubscriptions())
+
+	_, err = manager.ListWebhookDeliveries(subscription.UUID, "lost", 10)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	assert.Nil(t, manager.DeleteWebhookSubscription(subscription.UUID))
+	_, err = manager.GetWebhookSubscription(subscription.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestDeliverWebhooks(t *testing.T) {
+	var mu sync.Mutex
+	var requests []*http.Request
+	var bodies [][]byte
+	failing := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	viper.Set(common.WebhookAllowedHosts, "127.0.0.1")
+	defer viper.Set(common.WebhookAllowedHosts, "")
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	manager.uuid = util.NewUUIDGenerator()
+	subscription, err := manager.CreateWebhookSubscription(&model.WebhookSubscription{Name: "ci", Namespace: "ns1",
+		URL: server.URL, Secret: "s3cr3t", EventTypes: "job.*"})
+	assert.Nil(t, err)
+
+	manager.publishEvent(eventbus.EventTypeJobCreated, eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeJob, ResourceID: "job1", Namespace: "ns1"})
+	// The events of the other namespaces and types aren't delivered.
+	manager.publishEvent(eventbus.EventTypeJobCreated, eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeJob, ResourceID: "job2", Namespace: "ns2"})
+	manager.publishEvent(eventbus.EventTypeRunCreated, eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeRun, ResourceID: "run1", Namespace: "ns1"})
+
+	delivered, err := manager.DeliverWebhooks(2, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, delivered)
+	deliveries, err := manager.ListWebhookDeliveries(subscription.UUID, "", 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, model.WebhookDeliveryPending, deliveries[0].Status)
+	assert.Equal(t, int64(1), deliveries[0].Attempts)
+	assert.Equal(t, int64(http.StatusServiceUnavailable), deliveries[0].ResponseCode)
+	assert.Equal(t, "The receiver responded with status code 503", deliveries[0].LastError)
+
+	// The failed delivery is retried after the backoff.
+	delivered, err = manager.DeliverWebhooks(2, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Len(t, requests, 1)
+	failing = false
+	manager.time = util.NewFakeTime(time.Unix(deliveries[0].NextAttemptAtInSec, 0))
+	delivered, err = manager.DeliverWebhooks(2, time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, delivered)
+
+	deliveries, err = manager.ListWebhookDeliveries(subscription.UUID, model.WebhookDeliverySucceeded, 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, int64(2), deliveries[0].Attempts)
+	assert.Empty(t, deliveries[0].LastError)
+
+	request, body := requests[1], bodies[1]
+	timestamp, err := strconv.ParseInt(request.Header.Get(webhook.HeaderTimestamp), 10, 64)
+	assert.Nil(t, err)
+	assert.Equal(t, webhook.Sign("s3cr3t", timestamp, body), request.Header.Get(webhook.HeaderSignature))
+	var event eventbus.Event
+	assert.Nil(t, json.Unmarshal(body, &event))
+	assert.Equal(t, eventbus.EventTypeJobCreated, event.Type)
+	assert.Equal(t, "job1", event.Data.ResourceID)
+}
+
+func TestWebhookDeliveryBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhookDeliveryBackoff(1))
+	assert.Equal(t, time.Minute, webhookDeliveryBackoff(2))
+	assert.Equal(t, 4*time.Minute, webhookDeliveryBackoff(4))
+	assert.Equal(t, time.Hour, webhookDeliveryBackoff(20))
+}
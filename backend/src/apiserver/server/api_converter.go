@@ -15,12 +15,17 @@
 package server
 
 import (
+	"encoding/json"
+
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func ToApiExperiment(experiment *model.Experiment) *api.Experiment {
@@ -203,6 +208,30 @@ func ToApiRunDetail(run *model.RunDetail) *api.RunDetail {
 	}
 }
 
+// applyRunView clears the fields of the run which aren't part of the view.
+func applyRunView(run *api.Run, view api.Run_View) *api.Run {
+	if view == api.Run_BASIC && run.GetPipelineSpec() != nil {
+		run.PipelineSpec.WorkflowManifest = ""
+		run.PipelineSpec.PipelineManifest = ""
+	}
+	return run
+}
+
+func applyRunDetailView(run *api.RunDetail, view api.Run_View) *api.RunDetail {
+	applyRunView(run.GetRun(), view)
+	if view == api.Run_BASIC {
+		run.PipelineRuntime = nil
+	}
+	return run
+}
+
+func applyPipelineVersionView(version *api.PipelineVersion, view api.PipelineVersion_View) *api.PipelineVersion {
+	if view == api.PipelineVersion_BASIC && version != nil {
+		version.Parameters = nil
+	}
+	return version
+}
+
 func ToApiTask(task *model.Task) *api.Task {
 	return &api.Task{
 		Id:              task.UUID,
@@ -344,3 +373,186 @@ func toApiTrigger(trigger model.Trigger) *api.Trigger {
 	}
 	return &api.Trigger{}
 }
+
+func ToApiAuditEvent(event *model.AuditEvent) *api.AuditEvent {
+	return &api.AuditEvent{
+		Id:             event.UUID,
+		CreatedAt:      &timestamp.Timestamp{Seconds: event.CreatedAtInSec},
+		Actor:          event.Actor,
+		Namespace:      event.Namespace,
+		Method:         event.Method,
+		ResourceId:     event.ResourceId,
+		ResourceBefore: event.ResourceBefore,
+		ResourceAfter:  event.ResourceAfter,
+		Result:         event.Result,
+		Error:          event.Error,
+	}
+}
+
+func ToApiAuditEvents(events []*model.AuditEvent) []*api.AuditEvent {
+	apiEvents := make([]*api.AuditEvent, 0)
+	for _, event := range events {
+		apiEvents = append(apiEvents, ToApiAuditEvent(event))
+	}
+	return apiEvents
+}
+
+func ToApiArtifactGCReport(report *resource.ArtifactGCReport) *api.ReportExpiredArtifactsResponse {
+	artifacts := make([]*api.ExpiredArtifact, 0)
+	for _, artifact := range report.Artifacts {
+		artifacts = append(artifacts, &api.ExpiredArtifact{
+			RunId:        artifact.RunID,
+			Namespace:    artifact.Namespace,
+			ExperimentId: artifact.ExperimentID,
+			Key:          artifact.Key,
+			Size:         artifact.Size,
+			LastModified: &timestamp.Timestamp{Seconds: artifact.LastModified.Unix()},
+			Reason:       artifact.Reason,
+		})
+	}
+	return &api.ReportExpiredArtifactsResponse{
+		DryRun:    report.DryRun,
+		Artifacts: artifacts,
+		TotalSize: report.TotalSize,
+	}
+}
+
+func ToApiArtifactMetadata(artifact *model.ArtifactMetadata) *api.ArtifactMetadata {
+	return &api.ArtifactMetadata{
+		Id:               artifact.UUID,
+		Namespace:        artifact.Namespace,
+		RunId:            artifact.RunUUID,
+		ProducingTask:    artifact.TaskName,
+		Name:             artifact.Name,
+		Type:             artifact.Type,
+		Size:             artifact.Size,
+		ObjectKey:        artifact.ObjectKey,
+		CustomProperties: artifact.CustomProperties,
+		CreatedAt:        &timestamp.Timestamp{Seconds: artifact.CreatedAtInSec},
+		OciReference:     artifact.OCIReference,
+	}
+}
+
+func ToApiArtifactMetadataList(artifacts []*model.ArtifactMetadata) []*api.ArtifactMetadata {
+	apiArtifacts := make([]*api.ArtifactMetadata, 0)
+	for _, artifact := range artifacts {
+		apiArtifacts = append(apiArtifacts, ToApiArtifactMetadata(artifact))
+	}
+	return apiArtifacts
+}
+
+func ToApiArtifactPreview(preview *resource.ArtifactPreview) *api.ArtifactPreview {
+	schema := make([]*api.ParquetColumn, 0)
+	for _, column := range preview.Schema {
+		schema = append(schema, &api.ParquetColumn{Name: column.Name, Type: column.Type})
+	}
+	return &api.ArtifactPreview{
+		FileName:    preview.FileName,
+		Size:        preview.Size,
+		ContentType: preview.ContentType,
+		Head:        preview.Head,
+		Tail:        preview.Tail,
+		Truncated:   preview.Truncated,
+		Columns:     preview.Columns,
+		Keys:        preview.Keys,
+		Schema:      schema,
+	}
+}
+
+func ToApiLineageGraph(graph *resource.LineageGraph) *api.LineageGraph {
+	nodes := make([]*api.LineageNode, 0)
+	for _, node := range graph.Nodes {
+		nodes = append(nodes, &api.LineageNode{
+			Id:         node.ID,
+			Kind:       node.Kind,
+			Name:       node.Name,
+			Type:       node.Type,
+			Uri:        node.URI,
+			State:      node.State,
+			Properties: node.Properties,
+		})
+	}
+	edges := make([]*api.LineageEdge, 0)
+	for _, edge := range graph.Edges {
+		edges = append(edges, &api.LineageEdge{Source: edge.Source, Target: edge.Target})
+	}
+	return &api.LineageGraph{Root: graph.Root, Nodes: nodes, Edges: edges}
+}
+
+func ToApiUpload(session *model.UploadSession) *api.Upload {
+	return &api.Upload{
+		Id:           session.UUID,
+		Namespace:    session.Namespace,
+		RunId:        session.RunUUID,
+		FileName:     session.FileName,
+		Size:         session.Size,
+		UploadedSize: session.UploadedSize,
+	}
+}
+
+// ToApiWebhook converts a webhook subscription, whose secret is never returned.
+func ToApiWebhook(subscription *model.WebhookSubscription) *api.Webhook {
+	return &api.Webhook{
+		Id:           subscription.UUID,
+		Name:         subscription.Name,
+		Namespace:    subscription.Namespace,
+		Url:          subscription.URL,
+		HasSecret:    subscription.Secret != "",
+		EventTypes:   subscription.EventTypeList(),
+		ExperimentId: subscription.ExperimentID,
+		Enabled:      &wrappers.BoolValue{Value: subscription.Enabled},
+		CreatedAt:    &timestamp.Timestamp{Seconds: subscription.CreatedAtInSec},
+		UpdatedAt:    &timestamp.Timestamp{Seconds: subscription.UpdatedAtInSec},
+	}
+}
+
+func ToApiWebhookDelivery(delivery *model.WebhookDelivery) *api.WebhookDelivery {
+	apiDelivery := &api.WebhookDelivery{
+		Id:           delivery.UUID,
+		EventId:      delivery.EventID,
+		EventType:    delivery.EventType,
+		Status:       delivery.Status,
+		Attempts:     int32(delivery.Attempts),
+		ResponseCode: int32(delivery.ResponseCode),
+		LastError:    delivery.LastError,
+		CreatedAt:    &timestamp.Timestamp{Seconds: delivery.CreatedAtInSec},
+	}
+	if delivery.Status == model.WebhookDeliveryPending {
+		apiDelivery.NextAttemptAt = &timestamp.Timestamp{Seconds: delivery.NextAttemptAtInSec}
+	}
+	if delivery.DeliveredAtInSec > 0 {
+		apiDelivery.DeliveredAt = &timestamp.Timestamp{Seconds: delivery.DeliveredAtInSec}
+	}
+	return apiDelivery
+}
+
+func ToApiExperimentDefaults(defaults *resource.ExperimentDefaults) (*api.ExperimentDefaults, error) {
+	apiDefaults := &api.ExperimentDefaults{
+		Parameters:     defaults.Parameters,
+		ServiceAccount: defaults.ServiceAccount,
+	}
+	if defaults.PodTemplate != nil {
+		podTemplate, err := json.Marshal(defaults.PodTemplate)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to marshal the default pod template")
+		}
+		apiDefaults.PodTemplate = &structpb.Struct{}
+		if err := apiDefaults.PodTemplate.UnmarshalJSON(podTemplate); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to convert the default pod template")
+		}
+	}
+	return apiDefaults, nil
+}
+
+func ToApiExperimentBudgetStatus(status *resource.ExperimentBudgetStatus) *api.ExperimentBudgetStatus {
+	return &api.ExperimentBudgetStatus{
+		Budget: &api.ExperimentBudget{
+			MaxRuns:      status.Budget.MaxRuns,
+			MaxTaskHours: status.Budget.MaxTaskHours,
+			Enforcement:  status.Budget.Enforcement,
+		},
+		RunCount:  status.RunCount,
+		TaskHours: status.TaskHours,
+		Exceeded:  status.Exceeded,
+	}
+}
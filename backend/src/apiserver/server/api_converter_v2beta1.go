@@ -0,0 +1,392 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/golang/protobuf/jsonpb"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The v2beta1 servers are implemented on top of the v1 servers, so the v2beta1
+// resources are converted from and to their v1 counterparts.
+
+// toApiRunV1 converts a v2beta1 run to the v1 run it's created as.
+func toApiRunV1(run *apiv2beta1.Run) (*api.Run, error) {
+	pipelineSpec, references, err := toApiPipelineSpecV1(
+		run.GetPipelineSpec(), run.GetPipelineVersionReference(), run.GetRuntimeConfig())
+	if err != nil {
+		return nil, err
+	}
+	if run.GetExperimentId() != "" {
+		references = append(references, &api.ResourceReference{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: run.GetExperimentId()},
+			Relationship: api.Relationship_OWNER,
+		})
+	}
+	return &api.Run{
+		Name:               run.GetDisplayName(),
+		Description:        run.GetDescription(),
+		ServiceAccount:     run.GetServiceAccount(),
+		PipelineSpec:       pipelineSpec,
+		ResourceReferences: references,
+	}, nil
+}
+
+// toApiJob converts a v2beta1 recurring run to the v1 job it's created as.
+func toApiJob(recurringRun *apiv2beta1.RecurringRun) (*api.Job, error) {
+	pipelineSpec, references, err := toApiPipelineSpecV1(
+		recurringRun.GetPipelineSpec(), recurringRun.GetPipelineVersionReference(), recurringRun.GetRuntimeConfig())
+	if err != nil {
+		return nil, err
+	}
+	if recurringRun.GetExperimentId() != "" {
+		references = append(references, &api.ResourceReference{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: recurringRun.GetExperimentId()},
+			Relationship: api.Relationship_OWNER,
+		})
+	} else if recurringRun.GetNamespace() != "" {
+		references = append(references, &api.ResourceReference{
+			Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: recurringRun.GetNamespace()},
+			Relationship: api.Relationship_OWNER,
+		})
+	}
+	enabled := recurringRun.GetMode() != apiv2beta1.RecurringRun_DISABLE
+	mode := api.Job_ENABLED
+	if !enabled {
+		mode = api.Job_DISABLED
+	}
+	return &api.Job{
+		Name:               recurringRun.GetDisplayName(),
+		Description:        recurringRun.GetDescription(),
+		ServiceAccount:     recurringRun.GetServiceAccount(),
+		PipelineSpec:       pipelineSpec,
+		ResourceReferences: references,
+		MaxConcurrency:     recurringRun.GetMaxConcurrency(),
+		Trigger:            toApiTriggerV1(recurringRun.GetTrigger()),
+		Mode:               mode,
+		Enabled:            enabled,
+		NoCatchup:          recurringRun.GetNoCatchup(),
+	}, nil
+}
+
+// toApiPipelineSpecV1 converts the pipeline source and the runtime config of a
+// v2beta1 run or recurring run to a v1 pipeline spec, and the resource reference
+// of its pipeline version, if any.
+func toApiPipelineSpecV1(pipelineSpec *structpb.Struct, versionReference *apiv2beta1.PipelineVersionReference,
+	runtimeConfig *apiv2beta1.RuntimeConfig) (*api.PipelineSpec, []*api.ResourceReference, error) {
+	apiPipelineSpec := &api.PipelineSpec{}
+	var references []*api.ResourceReference
+	if pipelineSpec != nil {
+		// The SDK sends the pipeline spec along with the platform spec, when the
+		// pipeline has one.
+		if nested := pipelineSpec.GetFields()["pipeline_spec"].GetStructValue(); nested != nil {
+			pipelineSpec = nested
+		}
+		manifest, err := (&jsonpb.Marshaler{}).MarshalToString(pipelineSpec)
+		if err != nil {
+			return nil, nil, util.NewInvalidInputErrorWithDetails(err, "Invalid pipeline spec.")
+		}
+		apiPipelineSpec.PipelineManifest = manifest
+	}
+	if versionReference != nil {
+		apiPipelineSpec.PipelineId = versionReference.GetPipelineId()
+		if versionReference.GetPipelineVersionId() != "" {
+			references = append(references, &api.ResourceReference{
+				Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: versionReference.GetPipelineVersionId()},
+				Relationship: api.Relationship_CREATOR,
+			})
+		}
+	}
+	if runtimeConfig != nil {
+		apiRuntimeConfig, err := toApiRuntimeConfigV1(runtimeConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		apiPipelineSpec.RuntimeConfig = apiRuntimeConfig
+	}
+	return apiPipelineSpec, references, nil
+}
+
+// toApiRuntimeConfigV1 converts the parameters of a v2beta1 runtime config, which
+// are JSON values, to the integer, double and string values of the v1 API. The
+// booleans, lists and structs are passed as their JSON encoding, like the SDK
+// passes them to the components.
+func toApiRuntimeConfigV1(runtimeConfig *apiv2beta1.RuntimeConfig) (*api.PipelineSpec_RuntimeConfig, error) {
+	parameters := make(map[string]*api.Value)
+	for name, value := range runtimeConfig.GetParameters() {
+		switch v := value.GetKind().(type) {
+		case *structpb.Value_StringValue:
+			parameters[name] = &api.Value{Value: &api.Value_StringValue{StringValue: v.StringValue}}
+		case *structpb.Value_NumberValue:
+			if v.NumberValue == math.Trunc(v.NumberValue) && math.Abs(v.NumberValue) < math.MaxInt64 {
+				parameters[name] = &api.Value{Value: &api.Value_IntValue{IntValue: int64(v.NumberValue)}}
+			} else {
+				parameters[name] = &api.Value{Value: &api.Value_DoubleValue{DoubleValue: v.NumberValue}}
+			}
+		case *structpb.Value_NullValue:
+			continue
+		default:
+			valueBytes, err := json.Marshal(value.AsInterface())
+			if err != nil {
+				return nil, util.NewInvalidInputErrorWithDetails(err, "Invalid runtime config parameter "+name+".")
+			}
+			parameters[name] = &api.Value{Value: &api.Value_StringValue{StringValue: string(valueBytes)}}
+		}
+	}
+	return &api.PipelineSpec_RuntimeConfig{
+		Parameters:   parameters,
+		PipelineRoot: runtimeConfig.GetPipelineRoot(),
+	}, nil
+}
+
+func toApiTriggerV1(trigger *apiv2beta1.Trigger) *api.Trigger {
+	if cronSchedule := trigger.GetCronSchedule(); cronSchedule != nil {
+		return &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+			StartTime: cronSchedule.GetStartTime(),
+			EndTime:   cronSchedule.GetEndTime(),
+			Cron:      cronSchedule.GetCron(),
+		}}}
+	}
+	if periodicSchedule := trigger.GetPeriodicSchedule(); periodicSchedule != nil {
+		return &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+			StartTime:      periodicSchedule.GetStartTime(),
+			EndTime:        periodicSchedule.GetEndTime(),
+			IntervalSecond: periodicSchedule.GetIntervalSecond(),
+		}}}
+	}
+	return nil
+}
+
+// ToApiRunV2beta1 converts a v1 run to a v2beta1 run.
+func ToApiRunV2beta1(run *api.Run) *apiv2beta1.Run {
+	apiRun := &apiv2beta1.Run{
+		RunId:          run.GetId(),
+		DisplayName:    run.GetName(),
+		Description:    run.GetDescription(),
+		ServiceAccount: run.GetServiceAccount(),
+		ExperimentId:   common.GetExperimentIDFromAPIResourceReferences(run.GetResourceReferences()),
+		RecurringRunId: getResourceIdFromAPIResourceReferences(run.GetResourceReferences(), api.ResourceType_JOB),
+		CreatedAt:      run.GetCreatedAt(),
+		ScheduledAt:    run.GetScheduledAt(),
+		FinishedAt:     run.GetFinishedAt(),
+		State:          toApiRuntimeState(run.GetStatus()),
+		RuntimeConfig:  toApiRuntimeConfigV2beta1(run.GetPipelineSpec()),
+		Error:          toApiStatus(run.GetError()),
+		StorageState:   apiv2beta1.Run_AVAILABLE,
+	}
+	if run.GetStorageState() == api.Run_STORAGESTATE_ARCHIVED {
+		apiRun.StorageState = apiv2beta1.Run_ARCHIVED
+	}
+	if versionReference := toApiPipelineVersionReference(run.GetPipelineSpec(), run.GetResourceReferences()); versionReference != nil {
+		apiRun.PipelineSource = &apiv2beta1.Run_PipelineVersionReference{PipelineVersionReference: versionReference}
+	} else if pipelineSpec := toApiPipelineSpecStruct(run.GetPipelineSpec().GetPipelineManifest()); pipelineSpec != nil {
+		apiRun.PipelineSource = &apiv2beta1.Run_PipelineSpec{PipelineSpec: pipelineSpec}
+	}
+	return apiRun
+}
+
+func ToApiRunsV2beta1(runs []*api.Run) []*apiv2beta1.Run {
+	apiRuns := make([]*apiv2beta1.Run, 0)
+	for _, run := range runs {
+		apiRuns = append(apiRuns, ToApiRunV2beta1(run))
+	}
+	return apiRuns
+}
+
+// ToApiRecurringRun converts a v1 job to a v2beta1 recurring run.
+func ToApiRecurringRun(job *api.Job) *apiv2beta1.RecurringRun {
+	apiRecurringRun := &apiv2beta1.RecurringRun{
+		RecurringRunId: job.GetId(),
+		DisplayName:    job.GetName(),
+		Description:    job.GetDescription(),
+		ServiceAccount: job.GetServiceAccount(),
+		MaxConcurrency: job.GetMaxConcurrency(),
+		NoCatchup:      job.GetNoCatchup(),
+		Trigger:        toApiTriggerV2beta1(job.GetTrigger()),
+		CreatedAt:      job.GetCreatedAt(),
+		UpdatedAt:      job.GetUpdatedAt(),
+		ExperimentId:   common.GetExperimentIDFromAPIResourceReferences(job.GetResourceReferences()),
+		Namespace:      common.GetNamespaceFromAPIResourceReferences(job.GetResourceReferences()),
+		RuntimeConfig:  toApiRuntimeConfigV2beta1(job.GetPipelineSpec()),
+		Error:          toApiStatus(job.GetError()),
+		Mode:           apiv2beta1.RecurringRun_DISABLE,
+		Status:         apiv2beta1.RecurringRun_DISABLED,
+	}
+	if job.GetEnabled() {
+		apiRecurringRun.Mode = apiv2beta1.RecurringRun_ENABLE
+		apiRecurringRun.Status = apiv2beta1.RecurringRun_ENABLED
+	}
+	if versionReference := toApiPipelineVersionReference(job.GetPipelineSpec(), job.GetResourceReferences()); versionReference != nil {
+		apiRecurringRun.PipelineSource = &apiv2beta1.RecurringRun_PipelineVersionReference{PipelineVersionReference: versionReference}
+	} else if pipelineSpec := toApiPipelineSpecStruct(job.GetPipelineSpec().GetPipelineManifest()); pipelineSpec != nil {
+		apiRecurringRun.PipelineSource = &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: pipelineSpec}
+	}
+	return apiRecurringRun
+}
+
+func ToApiRecurringRuns(jobs []*api.Job) []*apiv2beta1.RecurringRun {
+	apiRecurringRuns := make([]*apiv2beta1.RecurringRun, 0)
+	for _, job := range jobs {
+		apiRecurringRuns = append(apiRecurringRuns, ToApiRecurringRun(job))
+	}
+	return apiRecurringRuns
+}
+
+// ToApiPipelineV2beta1 converts a v1 pipeline to a v2beta1 pipeline.
+func ToApiPipelineV2beta1(pipeline *api.Pipeline) *apiv2beta1.Pipeline {
+	return &apiv2beta1.Pipeline{
+		PipelineId:  pipeline.GetId(),
+		DisplayName: pipeline.GetName(),
+		Description: pipeline.GetDescription(),
+		CreatedAt:   pipeline.GetCreatedAt(),
+		Namespace:   common.GetNamespaceFromAPIResourceReferences(pipeline.GetResourceReferences()),
+		Error:       toApiStatus(pipeline.GetError()),
+	}
+}
+
+func ToApiPipelinesV2beta1(pipelines []*api.Pipeline) []*apiv2beta1.Pipeline {
+	apiPipelines := make([]*apiv2beta1.Pipeline, 0)
+	for _, pipeline := range pipelines {
+		apiPipelines = append(apiPipelines, ToApiPipelineV2beta1(pipeline))
+	}
+	return apiPipelines
+}
+
+// ToApiPipelineVersionV2beta1 converts a v1 pipeline version to a v2beta1 pipeline
+// version, without its pipeline spec.
+func ToApiPipelineVersionV2beta1(version *api.PipelineVersion) *apiv2beta1.PipelineVersion {
+	apiVersion := &apiv2beta1.PipelineVersion{
+		PipelineId:        getResourceIdFromAPIResourceReferences(version.GetResourceReferences(), api.ResourceType_PIPELINE),
+		PipelineVersionId: version.GetId(),
+		DisplayName:       version.GetName(),
+		Description:       version.GetDescription(),
+		CreatedAt:         version.GetCreatedAt(),
+		CodeSourceUrl:     version.GetCodeSourceUrl(),
+	}
+	if version.GetPackageUrl() != nil {
+		apiVersion.PackageUrl = &apiv2beta1.Url{PipelineUrl: version.GetPackageUrl().GetPipelineUrl()}
+	}
+	return apiVersion
+}
+
+func ToApiPipelineVersionsV2beta1(versions []*api.PipelineVersion) []*apiv2beta1.PipelineVersion {
+	apiVersions := make([]*apiv2beta1.PipelineVersion, 0)
+	for _, version := range versions {
+		apiVersions = append(apiVersions, ToApiPipelineVersionV2beta1(version))
+	}
+	return apiVersions
+}
+
+// toApiRuntimeState maps the status of a run, which is the reason of the
+// condition of its PipelineRun, to a v2beta1 runtime state.
+func toApiRuntimeState(status string) apiv2beta1.RuntimeState {
+	switch status {
+	case "", "Pending":
+		return apiv2beta1.RuntimeState_PENDING
+	case "Succeeded", "Completed":
+		return apiv2beta1.RuntimeState_SUCCEEDED
+	case "Failed", "PipelineRunTimeout", "PipelineRunCouldntCancel", "InvalidTaskResultReference":
+		return apiv2beta1.RuntimeState_FAILED
+	case "Cancelled", "PipelineRunCancelled", "StoppedRunFinally", "CancelledRunFinally":
+		return apiv2beta1.RuntimeState_CANCELED
+	case model.RunTerminatingConditions:
+		return apiv2beta1.RuntimeState_CANCELING
+	default:
+		return apiv2beta1.RuntimeState_RUNNING
+	}
+}
+
+// toApiRuntimeConfigV2beta1 returns the parameters of a v1 pipeline spec as a
+// v2beta1 runtime config. They're stored as strings.
+func toApiRuntimeConfigV2beta1(pipelineSpec *api.PipelineSpec) *apiv2beta1.RuntimeConfig {
+	if len(pipelineSpec.GetParameters()) == 0 {
+		return nil
+	}
+	parameters := make(map[string]*structpb.Value)
+	for _, parameter := range pipelineSpec.GetParameters() {
+		parameters[parameter.GetName()] = structpb.NewStringValue(parameter.GetValue())
+	}
+	return &apiv2beta1.RuntimeConfig{Parameters: parameters}
+}
+
+func toApiPipelineVersionReference(pipelineSpec *api.PipelineSpec, references []*api.ResourceReference) *apiv2beta1.PipelineVersionReference {
+	versionId := getResourceIdFromAPIResourceReferences(references, api.ResourceType_PIPELINE_VERSION)
+	if versionId == "" && pipelineSpec.GetPipelineId() == "" {
+		return nil
+	}
+	return &apiv2beta1.PipelineVersionReference{
+		PipelineId:        pipelineSpec.GetPipelineId(),
+		PipelineVersionId: versionId,
+	}
+}
+
+// toApiPipelineSpecStruct returns the IR pipeline spec of a v1 pipeline manifest as
+// a struct, or nil if there's none.
+func toApiPipelineSpecStruct(pipelineManifest string) *structpb.Struct {
+	if pipelineManifest == "" {
+		return nil
+	}
+	pipelineSpec := &structpb.Struct{}
+	if err := jsonpb.UnmarshalString(pipelineManifest, pipelineSpec); err != nil {
+		return nil
+	}
+	return pipelineSpec
+}
+
+func toApiTriggerV2beta1(trigger *api.Trigger) *apiv2beta1.Trigger {
+	if cronSchedule := trigger.GetCronSchedule(); cronSchedule != nil {
+		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+			StartTime: cronSchedule.GetStartTime(),
+			EndTime:   cronSchedule.GetEndTime(),
+			Cron:      cronSchedule.GetCron(),
+		}}}
+	}
+	if periodicSchedule := trigger.GetPeriodicSchedule(); periodicSchedule != nil {
+		return &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_PeriodicSchedule{PeriodicSchedule: &apiv2beta1.PeriodicSchedule{
+			StartTime:      periodicSchedule.GetStartTime(),
+			EndTime:        periodicSchedule.GetEndTime(),
+			IntervalSecond: periodicSchedule.GetIntervalSecond(),
+		}}}
+	}
+	return &apiv2beta1.Trigger{}
+}
+
+// toApiStatus returns the error message of a v1 resource as a status, or nil if
+// there's none.
+func toApiStatus(errorMessage string) *statuspb.Status {
+	if errorMessage == "" {
+		return nil
+	}
+	return &statuspb.Status{Code: int32(codes.Unknown), Message: errorMessage}
+}
+
+func getResourceIdFromAPIResourceReferences(references []*api.ResourceReference, resourceType api.ResourceType) string {
+	for _, reference := range references {
+		if reference.GetKey().GetType() == resourceType {
+			return reference.GetKey().GetId()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestToApiRunV1(t *testing.T) {
+	pipelineSpec, err := structpb.NewStruct(map[string]interface{}{
+		"pipelineInfo": map[string]interface{}{"name": "hello-world"},
+	})
+	require.Nil(t, err)
+	run := &apiv2beta1.Run{
+		ExperimentId:   "exp1",
+		DisplayName:    "run1",
+		Description:    "first run",
+		ServiceAccount: "sa1",
+		PipelineSource: &apiv2beta1.Run_PipelineSpec{PipelineSpec: pipelineSpec},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{
+				"text":    structpb.NewStringValue("hello"),
+				"count":   structpb.NewNumberValue(3),
+				"ratio":   structpb.NewNumberValue(0.5),
+				"enabled": structpb.NewBoolValue(true),
+				"skipped": structpb.NewNullValue(),
+			},
+			PipelineRoot: "minio://bucket/root",
+		},
+	}
+
+	apiRun, err := toApiRunV1(run)
+	require.Nil(t, err)
+	assert.Equal(t, &api.Run{
+		Name:           "run1",
+		Description:    "first run",
+		ServiceAccount: "sa1",
+		PipelineSpec: &api.PipelineSpec{
+			PipelineManifest: `{"pipelineInfo":{"name":"hello-world"}}`,
+			RuntimeConfig: &api.PipelineSpec_RuntimeConfig{
+				Parameters: map[string]*api.Value{
+					"text":    {Value: &api.Value_StringValue{StringValue: "hello"}},
+					"count":   {Value: &api.Value_IntValue{IntValue: 3}},
+					"ratio":   {Value: &api.Value_DoubleValue{DoubleValue: 0.5}},
+					"enabled": {Value: &api.Value_StringValue{StringValue: "true"}},
+				},
+				PipelineRoot: "minio://bucket/root",
+			},
+		},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "exp1"},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}, apiRun)
+}
+
+func TestToApiRunV1_PipelineSpecWithPlatformSpec(t *testing.T) {
+	pipelineSpec, err := structpb.NewStruct(map[string]interface{}{
+		"pipeline_spec": map[string]interface{}{"pipelineInfo": map[string]interface{}{"name": "hello-world"}},
+		"platform_spec": map[string]interface{}{},
+	})
+	require.Nil(t, err)
+
+	apiRun, err := toApiRunV1(&apiv2beta1.Run{PipelineSource: &apiv2beta1.Run_PipelineSpec{PipelineSpec: pipelineSpec}})
+	require.Nil(t, err)
+	assert.Equal(t, `{"pipelineInfo":{"name":"hello-world"}}`, apiRun.GetPipelineSpec().GetPipelineManifest())
+}
+
+func TestToApiRunV1_PipelineVersionReference(t *testing.T) {
+	apiRun, err := toApiRunV1(&apiv2beta1.Run{
+		DisplayName: "run1",
+		PipelineSource: &apiv2beta1.Run_PipelineVersionReference{PipelineVersionReference: &apiv2beta1.PipelineVersionReference{
+			PipelineId:        "pipeline1",
+			PipelineVersionId: "version1",
+		}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "pipeline1", apiRun.GetPipelineSpec().GetPipelineId())
+	assert.Empty(t, apiRun.GetPipelineSpec().GetPipelineManifest())
+	assert.Equal(t, []*api.ResourceReference{{
+		Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: "version1"},
+		Relationship: api.Relationship_CREATOR,
+	}}, apiRun.GetResourceReferences())
+}
+
+func TestToApiJob(t *testing.T) {
+	job, err := toApiJob(&apiv2beta1.RecurringRun{
+		DisplayName:    "recurring1",
+		Namespace:      "ns1",
+		MaxConcurrency: 2,
+		NoCatchup:      true,
+		Mode:           apiv2beta1.RecurringRun_DISABLE,
+		Trigger: &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_PeriodicSchedule{PeriodicSchedule: &apiv2beta1.PeriodicSchedule{
+			IntervalSecond: 60,
+		}}},
+		PipelineSource: &apiv2beta1.RecurringRun_PipelineVersionReference{PipelineVersionReference: &apiv2beta1.PipelineVersionReference{
+			PipelineId: "pipeline1",
+		}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, &api.Job{
+		Name:           "recurring1",
+		PipelineSpec:   &api.PipelineSpec{PipelineId: "pipeline1"},
+		MaxConcurrency: 2,
+		NoCatchup:      true,
+		Mode:           api.Job_DISABLED,
+		Enabled:        false,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+			IntervalSecond: 60,
+		}}},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}, job)
+}
+
+func TestToApiRunV2beta1(t *testing.T) {
+	run := &api.Run{
+		Id:           "run1",
+		Name:         "name1",
+		StorageState: api.Run_STORAGESTATE_ARCHIVED,
+		CreatedAt:    &timestamp.Timestamp{Seconds: 1},
+		Status:       "PipelineRunTimeout",
+		Error:        "timed out",
+		PipelineSpec: &api.PipelineSpec{
+			PipelineId: "pipeline1",
+			Parameters: []*api.Parameter{{Name: "text", Value: "hello"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "exp1"},
+				Relationship: api.Relationship_OWNER,
+			},
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_JOB, Id: "job1"},
+				Relationship: api.Relationship_CREATOR,
+			},
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: "version1"},
+				Relationship: api.Relationship_CREATOR,
+			},
+		},
+	}
+
+	apiRun := ToApiRunV2beta1(run)
+	assert.Equal(t, "run1", apiRun.GetRunId())
+	assert.Equal(t, "name1", apiRun.GetDisplayName())
+	assert.Equal(t, "exp1", apiRun.GetExperimentId())
+	assert.Equal(t, "job1", apiRun.GetRecurringRunId())
+	assert.Equal(t, apiv2beta1.Run_ARCHIVED, apiRun.GetStorageState())
+	assert.Equal(t, apiv2beta1.RuntimeState_FAILED, apiRun.GetState())
+	assert.Equal(t, "timed out", apiRun.GetError().GetMessage())
+	assert.Equal(t, int64(1), apiRun.GetCreatedAt().GetSeconds())
+	assert.Equal(t, "hello", apiRun.GetRuntimeConfig().GetParameters()["text"].GetStringValue())
+	assert.Equal(t, &apiv2beta1.PipelineVersionReference{PipelineId: "pipeline1", PipelineVersionId: "version1"},
+		apiRun.GetPipelineVersionReference())
+}
+
+func TestToApiRunV2beta1_PipelineSpec(t *testing.T) {
+	apiRun := ToApiRunV2beta1(&api.Run{
+		Id:           "run1",
+		PipelineSpec: &api.PipelineSpec{PipelineManifest: `{"pipelineInfo":{"name":"hello-world"}}`},
+	})
+	assert.Nil(t, apiRun.GetPipelineVersionReference())
+	assert.Equal(t, "hello-world",
+		apiRun.GetPipelineSpec().GetFields()["pipelineInfo"].GetStructValue().GetFields()["name"].GetStringValue())
+	assert.Nil(t, apiRun.GetError())
+	assert.Equal(t, apiv2beta1.Run_AVAILABLE, apiRun.GetStorageState())
+	assert.Equal(t, apiv2beta1.RuntimeState_PENDING, apiRun.GetState())
+}
+
+func TestToApiRuntimeState(t *testing.T) {
+	for status, state := range map[string]apiv2beta1.RuntimeState{
+		"":                     apiv2beta1.RuntimeState_PENDING,
+		"Running":              apiv2beta1.RuntimeState_RUNNING,
+		"Succeeded":            apiv2beta1.RuntimeState_SUCCEEDED,
+		"Completed":            apiv2beta1.RuntimeState_SUCCEEDED,
+		"Failed":               apiv2beta1.RuntimeState_FAILED,
+		"PipelineRunCancelled": apiv2beta1.RuntimeState_CANCELED,
+		"CancelledRunFinally":  apiv2beta1.RuntimeState_CANCELED,
+		"Terminating":          apiv2beta1.RuntimeState_CANCELING,
+	} {
+		assert.Equal(t, state, toApiRuntimeState(status), status)
+	}
+}
+
+func TestToApiRecurringRun(t *testing.T) {
+	recurringRun := ToApiRecurringRun(&api.Job{
+		Id:             "job1",
+		Name:           "name1",
+		Enabled:        true,
+		MaxConcurrency: 3,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+			Cron: "0 * * * * *",
+		}}},
+		PipelineSpec: &api.PipelineSpec{PipelineId: "pipeline1"},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "exp1"},
+				Relationship: api.Relationship_OWNER,
+			},
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Equal(t, "job1", recurringRun.GetRecurringRunId())
+	assert.Equal(t, "name1", recurringRun.GetDisplayName())
+	assert.Equal(t, "exp1", recurringRun.GetExperimentId())
+	assert.Equal(t, "ns1", recurringRun.GetNamespace())
+	assert.Equal(t, int64(3), recurringRun.GetMaxConcurrency())
+	assert.Equal(t, apiv2beta1.RecurringRun_ENABLE, recurringRun.GetMode())
+	assert.Equal(t, apiv2beta1.RecurringRun_ENABLED, recurringRun.GetStatus())
+	assert.Equal(t, "0 * * * * *", recurringRun.GetTrigger().GetCronSchedule().GetCron())
+	assert.Equal(t, "pipeline1", recurringRun.GetPipelineVersionReference().GetPipelineId())
+}
+
+func TestToApiPipelineVersionV2beta1(t *testing.T) {
+	version := ToApiPipelineVersionV2beta1(&api.PipelineVersion{
+		Id:         "version1",
+		Name:       "name1",
+		PackageUrl: &api.Url{PipelineUrl: "https://example.com/pipeline.yaml"},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE, Id: "pipeline1"},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	assert.Equal(t, &apiv2beta1.PipelineVersion{
+		PipelineId:        "pipeline1",
+		PipelineVersionId: "version1",
+		DisplayName:       "name1",
+		PackageUrl:        &apiv2beta1.Url{PipelineUrl: "https://example.com/pipeline.yaml"},
+	}, version)
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type ArtifactPreviewServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// PreviewArtifact returns the first and last bytes of an artifact of a run,
+// along with its detected content type and the CSV columns, JSON keys or parquet
+// schema it starts with, so that large artifacts can be previewed without
+// downloading them. The head and tail default to 4096 and 0 bytes, and are
+// capped at 1Mb.
+func (s *ArtifactPreviewServer) PreviewArtifact(ctx context.Context, request *api.PreviewArtifactRequest) (*api.ArtifactPreview, error) {
+	headSize := int(request.Head)
+	if headSize == 0 {
+		headSize = common.DefaultArtifactPreviewSize
+	}
+	if err := validatePreviewSize(headSize); err != nil {
+		return nil, util.Wrap(err, "Invalid head")
+	}
+	tailSize := int(request.Tail)
+	if err := validatePreviewSize(tailSize); err != nil {
+		return nil, util.Wrap(err, "Invalid tail")
+	}
+
+	if err := s.canReadArtifact(ctx, request.RunId); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	preview, err := s.resourceManager.PreviewArtifact(request.RunId, request.NodeId, request.ArtifactName, headSize, tailSize)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to preview the artifact")
+	}
+	return ToApiArtifactPreview(preview), nil
+}
+
+func validatePreviewSize(size int) error {
+	if size < 0 || size > common.MaxArtifactPreviewSize {
+		return util.NewInvalidInputError("The size must be between 0 and %d bytes", common.MaxArtifactPreviewSize)
+	}
+	return nil
+}
+
+func (s *ArtifactPreviewServer) canReadArtifact(ctx context.Context, runId string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	run, err := s.resourceManager.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: run.Namespace,
+		Verb:      common.RbacResourceVerbReadArtifact,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      run.Name,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewArtifactPreviewServer(resourceManager *resource.ResourceManager) *ArtifactPreviewServer {
+	return &ArtifactPreviewServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func addArtifact(t *testing.T, clientManager *resource.FakeClientManager, name string, content string) {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	assert.Nil(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}))
+	_, err := tarWriter.Write([]byte(content))
+	assert.Nil(t, err)
+	assert.Nil(t, tarWriter.Close())
+	assert.Nil(t, gzipWriter.Close())
+	assert.Nil(t, clientManager.ObjectStore().AddFile(buffer.Bytes(), "artifacts/run-1/node/output.tgz"))
+}
+
+func TestPreviewArtifact(t *testing.T) {
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	addArtifact(t, clientManager, "metrics.json", `{"accuracy": 0.9, "loss": 0.1}`)
+	server := NewArtifactPreviewServer(resource.NewResourceManager(clientManager))
+
+	response, err := server.PreviewArtifact(context.Background(), &api.PreviewArtifactRequest{
+		RunId: "run1", NodeId: "node", ArtifactName: "output", Head: 12, Tail: 6})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.ArtifactPreview{
+		FileName:    "metrics.json",
+		Size:        30,
+		ContentType: resource.ArtifactContentTypeJSON,
+		Head:        []byte(`{"accuracy":`),
+		Tail:        []byte(`: 0.1}`),
+		Truncated:   true,
+		Keys:        []string{"accuracy"},
+		Schema:      []*api.ParquetColumn{},
+	}, response)
+}
+
+func TestPreviewArtifact_InvalidRequest(t *testing.T) {
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	addArtifact(t, clientManager, "data.csv", "a,b\n1,2\n")
+	server := NewArtifactPreviewServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.PreviewArtifact(context.Background(), &api.PreviewArtifactRequest{
+		RunId: "run1", NodeId: "node", ArtifactName: "output", Head: -1})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.PreviewArtifact(context.Background(), &api.PreviewArtifactRequest{
+		RunId: "run1", NodeId: "node", ArtifactName: "output", Tail: 2000000})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.PreviewArtifact(context.Background(), &api.PreviewArtifactRequest{
+		RunId: "run2", NodeId: "node", ArtifactName: "output"})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestPreviewArtifact_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewArtifactPreviewServer(resource.NewResourceManager(clientManager))
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := server.PreviewArtifact(ctx, &api.PreviewArtifactRequest{RunId: "run1", NodeId: "node", ArtifactName: "output"})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type ArtifactPushServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// PushArtifact pushes an artifact of a finished run to the configured OCI
+// registry, tagged with the run and the pipeline version of the run.
+func (s *ArtifactPushServer) PushArtifact(ctx context.Context, request *api.PushArtifactRequest) (*api.PushArtifactResponse, error) {
+	if err := s.canReadArtifact(ctx, request.RunId); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	reference, err := s.resourceManager.PushArtifact(ctx, request.RunId, request.NodeId, request.ArtifactName)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to push the artifact")
+	}
+	return &api.PushArtifactResponse{Reference: reference}, nil
+}
+
+// canReadArtifact authorizes reading the artifacts of a run in multi-user mode,
+// which pushing them to the registry amounts to.
+func (s *ArtifactPushServer) canReadArtifact(ctx context.Context, runId string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	run, err := s.resourceManager.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: run.Namespace,
+		Verb:      common.RbacResourceVerbReadArtifact,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      run.Name,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewArtifactPushServer(resourceManager *resource.ResourceManager) *ArtifactPushServer {
+	return &ArtifactPushServer{resourceManager: resourceManager}
+}
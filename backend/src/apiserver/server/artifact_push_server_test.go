@@ -0,0 +1,63 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestPushArtifact(t *testing.T) {
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	assert.Nil(t, clientManager.ObjectStore().AddFile([]byte("model"), "artifacts/run-1/node/output.tgz"))
+	assert.Nil(t, clientManager.ArtifactMetadataStore().IndexRunArtifacts("run1", []*model.ArtifactMetadata{{
+		UUID:      "artifact1",
+		Namespace: "ns1",
+		RunUUID:   "run1",
+		TaskName:  "node",
+		Name:      "output",
+		Type:      "Model",
+		Size:      5,
+		ObjectKey: "artifacts/run-1/node/output.tgz",
+	}}))
+	server := NewArtifactPushServer(resource.NewResourceManager(clientManager))
+
+	response, err := server.PushArtifact(context.Background(), &api.PushArtifactRequest{RunId: "run1", NodeId: "node", ArtifactName: "output"})
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(response.Reference, "registry.example.com/ns1/node-output@sha256:"))
+	assert.Equal(t, []string{"run-run1"}, clientManager.OCIRegistryClientFake.Artifacts[0].Tags)
+
+	// The artifacts that aren't indexed can't be pushed.
+	_, err = server.PushArtifact(context.Background(), &api.PushArtifactRequest{RunId: "run1", NodeId: "node", ArtifactName: "other"})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestPushArtifact_NotConfigured(t *testing.T) {
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	clientManager.OCIRegistryClientFake = nil
+	server := NewArtifactPushServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.PushArtifact(context.Background(), &api.PushArtifactRequest{RunId: "run1", NodeId: "node", ArtifactName: "output"})
+	AssertUserError(t, err, codes.FailedPrecondition)
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type ArtifactRetentionServer struct {
+	resourceManager *resource.ResourceManager
+	policy          *common.ArtifactRetentionPolicy
+}
+
+// ReportExpiredArtifacts lists the artifacts that the retention policy expires,
+// without deleting them.
+func (s *ArtifactRetentionServer) ReportExpiredArtifacts(ctx context.Context, request *api.ReportExpiredArtifactsRequest) (*api.ReportExpiredArtifactsResponse, error) {
+	if err := s.canListArtifacts(ctx); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	report, err := s.resourceManager.CollectExpiredArtifacts(s.policy, true)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to report the expired artifacts")
+	}
+	return ToApiArtifactGCReport(report), nil
+}
+
+// canListArtifacts authorizes the report in multi-user mode. The report spans
+// every namespace, so only cluster admins are allowed to request it.
+func (s *ArtifactRetentionServer) canListArtifacts(ctx context.Context) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbList,
+		Group:    common.RbacPipelinesGroup,
+		Version:  common.RbacPipelinesVersion,
+		Resource: common.RbacResourceTypeArtifacts,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewArtifactRetentionServer(resourceManager *resource.ResourceManager, policy *common.ArtifactRetentionPolicy) *ArtifactRetentionServer {
+	return &ArtifactRetentionServer{resourceManager: resourceManager, policy: policy}
+}
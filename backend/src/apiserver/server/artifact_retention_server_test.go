@@ -0,0 +1,68 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestReportExpiredArtifacts(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	_, err := clientManager.RunStore().CreateRun(&model.RunDetail{Run: model.Run{
+		UUID: "run1", Name: "run-1", Namespace: "ns1", StorageState: "STORAGESTATE_AVAILABLE", FinishedAtInSec: 1}})
+	assert.Nil(t, err)
+	assert.Nil(t, clientManager.ObjectStore().AddFile([]byte("abc"), "artifacts/run-1/node/a.tgz"))
+	policy := &common.ArtifactRetentionPolicy{Default: common.ArtifactRetentionRule{MaxTotalSize: 1}}
+	server := NewArtifactRetentionServer(resource.NewResourceManager(clientManager), policy)
+
+	report, err := server.ReportExpiredArtifacts(context.Background(), &api.ReportExpiredArtifactsRequest{})
+	assert.Nil(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, int64(3), report.TotalSize)
+	assert.Equal(t, "run1", report.Artifacts[0].RunId)
+	assert.Equal(t, "artifacts/run-1/node/a.tgz", report.Artifacts[0].Key)
+	assert.Equal(t, resource.ArtifactExpiredBySize, report.Artifacts[0].Reason)
+
+	// The report doesn't delete the artifacts.
+	_, err = clientManager.ObjectStore().GetFile("artifacts/run-1/node/a.tgz")
+	assert.Nil(t, err)
+}
+
+func TestReportExpiredArtifacts_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewArtifactRetentionServer(resource.NewResourceManager(clientManager), &common.ArtifactRetentionPolicy{})
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := server.ReportExpiredArtifacts(ctx, &api.ReportExpiredArtifactsRequest{})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type ArtifactSearchServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// SearchArtifacts lists the artifacts indexed when their runs were persisted. The
+// namespace is required in multi-user mode.
+func (s *ArtifactSearchServer) SearchArtifacts(ctx context.Context, request *api.SearchArtifactsRequest) (*api.SearchArtifactsResponse, error) {
+	if common.IsMultiUserMode() && request.Namespace == "" {
+		return nil, util.NewInvalidInputError("Namespace is required to search artifacts in multi-user mode")
+	}
+	if err := s.canSearchArtifacts(ctx, request.Namespace); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	opts, err := validatedListOptions(&model.ArtifactMetadata{}, request.PageToken, int(request.PageSize), request.SortBy, request.Filter)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+
+	filterContext := &common.FilterContext{}
+	if request.Namespace != "" {
+		filterContext.ReferenceKey = &common.ReferenceKey{Type: common.Namespace, ID: request.Namespace}
+	}
+	artifacts, totalSize, nextPageToken, err := s.resourceManager.SearchArtifacts(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to search artifacts")
+	}
+	return &api.SearchArtifactsResponse{
+		Artifacts:     ToApiArtifactMetadataList(artifacts),
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// canSearchArtifacts authorizes listing the runs of the namespace in multi-user
+// mode, since the artifacts are outputs of the runs.
+func (s *ArtifactSearchServer) canSearchArtifacts(ctx context.Context, namespace string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      common.RbacResourceVerbList,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewArtifactSearchServer(resourceManager *resource.ResourceManager) *ArtifactSearchServer {
+	return &ArtifactSearchServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func initWithIndexedArtifacts(t *testing.T) *resource.FakeClientManager {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	err := clientManager.ArtifactMetadataStore().IndexRunArtifacts("run1", []*model.ArtifactMetadata{
+		{UUID: "1", Namespace: "ns1", RunUUID: "run1", TaskName: "train", Name: "model", Type: "Model", Size: 600 << 20, CreatedAtInSec: 1},
+		{UUID: "2", Namespace: "ns1", RunUUID: "run1", TaskName: "train", Name: "metrics", Type: "Metrics", Size: 10, CreatedAtInSec: 1},
+	})
+	assert.Nil(t, err)
+	return clientManager
+}
+
+func TestSearchArtifacts(t *testing.T) {
+	clientManager := initWithIndexedArtifacts(t)
+	defer clientManager.Close()
+	server := NewArtifactSearchServer(resource.NewResourceManager(clientManager))
+
+	filter := `{"predicates": [{"key": "type", "op": "EQUALS", "string_value": "Model"},
+		{"key": "size", "op": "GREATER_THAN", "long_value": 524288000}]}`
+	response, err := server.SearchArtifacts(context.Background(), &api.SearchArtifactsRequest{Filter: filter})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), response.TotalSize)
+	assert.Equal(t, "model", response.Artifacts[0].Name)
+	assert.Equal(t, "train", response.Artifacts[0].ProducingTask)
+	assert.Equal(t, int64(600<<20), response.Artifacts[0].Size)
+}
+
+func TestSearchArtifacts_InvalidFilter(t *testing.T) {
+	clientManager := initWithIndexedArtifacts(t)
+	defer clientManager.Close()
+	server := NewArtifactSearchServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.SearchArtifacts(context.Background(), &api.SearchArtifactsRequest{Filter: "size"})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
+func TestSearchArtifacts_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := initWithIndexedArtifacts(t)
+	defer clientManager.Close()
+	server := NewArtifactSearchServer(resource.NewResourceManager(clientManager))
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := server.SearchArtifacts(ctx, &api.SearchArtifactsRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	response, err := server.SearchArtifacts(ctx, &api.SearchArtifactsRequest{Namespace: "ns2"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), response.TotalSize)
+	assert.Empty(t, response.Artifacts)
+
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server = NewArtifactSearchServer(resource.NewResourceManager(clientManager))
+	_, err = server.SearchArtifacts(ctx, &api.SearchArtifactsRequest{Namespace: "ns1"})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+)
+
+// ArtifactServer serves the ArtifactService, whose calls are implemented by the
+// servers of the artifact features.
+type ArtifactServer struct {
+	*ArtifactRetentionServer
+	*ArtifactURLServer
+	*ArtifactPreviewServer
+	*ArtifactSearchServer
+	*ArtifactPushServer
+}
+
+func NewArtifactServer(resourceManager *resource.ResourceManager, retentionPolicy *common.ArtifactRetentionPolicy) *ArtifactServer {
+	return &ArtifactServer{
+		ArtifactRetentionServer: NewArtifactRetentionServer(resourceManager, retentionPolicy),
+		ArtifactURLServer:       NewArtifactURLServer(resourceManager),
+		ArtifactPreviewServer:   NewArtifactPreviewServer(resourceManager),
+		ArtifactSearchServer:    NewArtifactSearchServer(resourceManager),
+		ArtifactPushServer:      NewArtifactPushServer(resourceManager),
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const (
+	ArtifactNameKey = "artifact_name"
+)
+
+type ArtifactURLServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// GetArtifactSignedURL returns a URL to download (GET) or upload (PUT) an
+// artifact of a run directly from the object store, so that large artifacts
+// aren't proxied through the API server. The method and expiry default to GET
+// and 15m. The request to the URL has to set the returned headers.
+func (s *ArtifactURLServer) GetArtifactSignedURL(ctx context.Context, request *api.GetArtifactSignedURLRequest) (*api.GetArtifactSignedURLResponse, error) {
+	method := http.MethodGet
+	if request.Method != "" {
+		method = request.Method
+	}
+	expiry := common.DefaultSignedURLExpiry
+	if request.Expiry != "" {
+		var err error
+		expiry, err = time.ParseDuration(request.Expiry)
+		if err != nil {
+			return nil, util.NewInvalidInputErrorWithDetails(err, "Invalid expiry")
+		}
+	}
+
+	if err := s.canAccessArtifact(ctx, request.RunId, method); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	expiresAt := s.resourceManager.GetTime().Now().Add(expiry)
+	signedURL, err := s.resourceManager.GetArtifactSignedURL(request.RunId, request.NodeId, request.ArtifactName, method, expiry)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the signed URL of the artifact")
+	}
+	return &api.GetArtifactSignedURLResponse{
+		Url:       signedURL.URL,
+		Method:    method,
+		Headers:   signedURL.Headers,
+		ExpiresAt: &timestamp.Timestamp{Seconds: expiresAt.Unix()},
+	}, nil
+}
+
+// canAccessArtifact authorizes reading, or writing for uploads, the artifacts
+// of a run in multi-user mode.
+func (s *ArtifactURLServer) canAccessArtifact(ctx context.Context, runId string, method string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	run, err := s.resourceManager.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run")
+	}
+	verb := common.RbacResourceVerbReadArtifact
+	if method == http.MethodPut {
+		verb = common.RbacResourceVerbWriteArtifact
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: run.Namespace,
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      run.Name,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewArtifactURLServer(resourceManager *resource.ResourceManager) *ArtifactURLServer {
+	return &ArtifactURLServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	tektonV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func initWithArtifactRun(t *testing.T) *resource.FakeClientManager {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	workflow := util.NewWorkflow(&tektonV1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-1", Namespace: "ns1"},
+		Status: tektonV1.PipelineRunStatus{PipelineRunStatusFields: tektonV1.PipelineRunStatusFields{
+			ChildReferences: []tektonV1.ChildStatusReference{{Name: "run-1-node", PipelineTaskName: "node"}},
+		}},
+	})
+	_, err := clientManager.RunStore().CreateRun(&model.RunDetail{
+		Run:             model.Run{UUID: "run1", Name: "run-1", Namespace: "ns1", StorageState: "STORAGESTATE_AVAILABLE"},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: workflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+	return clientManager
+}
+
+func TestGetArtifactSignedURL(t *testing.T) {
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	server := NewArtifactURLServer(resource.NewResourceManager(clientManager))
+
+	response, err := server.GetArtifactSignedURL(context.Background(), &api.GetArtifactSignedURLRequest{
+		RunId: "run1", NodeId: "node", ArtifactName: "output", Method: http.MethodPut, Expiry: "1h"})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://minio//artifacts/run-1/node/output.tgz?expiry=3600", response.Url)
+	assert.Equal(t, http.MethodPut, response.Method)
+	assert.True(t, response.ExpiresAt.Seconds > 3600)
+}
+
+func TestGetArtifactSignedURL_InvalidRequest(t *testing.T) {
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	server := NewArtifactURLServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.GetArtifactSignedURL(context.Background(), &api.GetArtifactSignedURLRequest{
+		RunId: "run1", NodeId: "node", ArtifactName: "output", Expiry: "soon"})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.GetArtifactSignedURL(context.Background(), &api.GetArtifactSignedURLRequest{
+		RunId: "run1", NodeId: "node", ArtifactName: "output", Method: http.MethodDelete})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.GetArtifactSignedURL(context.Background(), &api.GetArtifactSignedURLRequest{
+		RunId: "run2", NodeId: "node", ArtifactName: "output"})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestGetArtifactSignedURL_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewArtifactURLServer(resource.NewResourceManager(clientManager))
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := server.GetArtifactSignedURL(ctx, &api.GetArtifactSignedURLRequest{RunId: "run1", NodeId: "node", ArtifactName: "output"})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type AuditServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// ListAuditEvents lists the recorded audit events.
+func (s *AuditServer) ListAuditEvents(ctx context.Context, request *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	if err := s.canListAuditEvents(ctx); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	opts, err := validatedListOptions(&model.AuditEvent{}, request.PageToken, int(request.PageSize), request.SortBy, request.Filter)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+
+	events, totalSize, nextPageToken, err := s.resourceManager.ListAuditEvents(opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list audit events")
+	}
+	return &api.ListAuditEventsResponse{
+		AuditEvents:   ToApiAuditEvents(events),
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// canListAuditEvents authorizes listing the audit events in multi-user mode. Audit
+// events aren't namespaced, so only cluster admins are allowed to list them.
+func (s *AuditServer) canListAuditEvents(ctx context.Context) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbList,
+		Group:    common.RbacPipelinesGroup,
+		Version:  common.RbacPipelinesVersion,
+		Resource: common.RbacResourceTypeAuditEvents,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewAuditServer(resourceManager *resource.ResourceManager) *AuditServer {
+	return &AuditServer{resourceManager: resourceManager}
+}
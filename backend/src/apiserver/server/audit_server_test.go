@@ -0,0 +1,83 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	resourceManager := resource.NewResourceManager(clientManager)
+	err := resourceManager.RecordAuditEvent(&model.AuditEvent{
+		Method: "/v1.RunService/CreateRun",
+		Result: model.AuditResultSucceeded,
+	})
+	assert.Nil(t, err)
+	server := NewAuditServer(resourceManager)
+
+	response, err := server.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{PageSize: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), response.TotalSize)
+	assert.Equal(t, "/v1.RunService/CreateRun", response.AuditEvents[0].Method)
+	assert.Equal(t, resource.DefaultFakeUUID, response.AuditEvents[0].Id)
+}
+
+func TestListAuditEvents_InvalidPageToken(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	server := NewAuditServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{PageToken: "invalid"})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
+func TestListAuditEvents_NotQueryable(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	clientManager.AuditSinkFake = nil
+	server := NewAuditServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Audit events can only be queried")
+}
+
+func TestListAuditEvents_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewAuditServer(resource.NewResourceManager(clientManager))
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := server.ListAuditEvents(ctx, &api.ListAuditEventsRequest{})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type BackupServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// Backup streams a gzipped backup of the pipelines, runs, jobs and experiments,
+// which Restore restores into a fresh installation. These endpoints aren't
+// exposed through grpc, since grpc-gateway cannot stream native HTTP content.
+func (s *BackupServer) Backup(w http.ResponseWriter, r *http.Request) {
+	log.Infof("Backup called")
+
+	if err := s.canAccessBackups(r, common.RbacResourceVerbCreate); err != nil {
+		s.writeErrorToResponse(w, http.StatusForbidden, util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=kfp-backup-%d.jsonl.gz", s.resourceManager.GetTime().Now().Unix()))
+	writer := gzip.NewWriter(w)
+	summary, err := s.resourceManager.Backup(r.Context(), writer)
+	if err != nil {
+		// The response has started, the backup is left without its end record
+		// so that it can't be restored.
+		log.Errorf("Failed to back up. Error: %+v", err)
+		return
+	}
+	if err := writer.Close(); err != nil {
+		log.Errorf("Failed to write the backup. Error: %v", err)
+		return
+	}
+	log.Infof("Backed up %v rows, %d templates and the keys of %d objects", summary.Rows, summary.Templates, summary.Objects)
+}
+
+// Restore restores the gzipped backup of the body into a fresh installation.
+func (s *BackupServer) Restore(w http.ResponseWriter, r *http.Request) {
+	log.Infof("Restore called")
+
+	if err := s.canAccessBackups(r, common.RbacResourceVerbRestore); err != nil {
+		s.writeErrorToResponse(w, http.StatusForbidden, util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+	reader, err := gzip.NewReader(r.Body)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputErrorWithDetails(err, "The backup isn't gzipped"))
+		return
+	}
+	defer reader.Close()
+	summary, err := s.resourceManager.Restore(r.Context(), reader)
+	if err != nil {
+		code := runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err)))
+		s.writeErrorToResponse(w, code, err)
+		return
+	}
+	log.Infof("Restored %v rows and %d templates", summary.Rows, summary.Templates)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
+
+// canAccessBackups authorizes the backups in multi-user mode. A backup holds the
+// resources of all the namespaces, so only the users bound to the verb cluster
+// wide are allowed to create or restore one: the SubjectAccessReview has no
+// namespace.
+func (s *BackupServer) canAccessBackups(r *http.Request, verb string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: metav1.NamespaceAll,
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeBackups,
+	}
+	return isRequestAuthorized(s.resourceManager, r.Context(), resourceAttributes)
+}
+
+func (s *BackupServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
+	log.Errorf("Failed to back up or restore. Error: %+v", err)
+	w.WriteHeader(code)
+	errorResponse := util.ToAPIError(err)
+	errBytes, err := json.Marshal(errorResponse)
+	if err != nil {
+		w.Write([]byte("Error backing up or restoring"))
+	}
+	w.Write(errBytes)
+}
+
+func NewBackupServer(resourceManager *resource.ResourceManager) *BackupServer {
+	return &BackupServer{resourceManager: resourceManager}
+}
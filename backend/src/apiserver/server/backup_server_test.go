@@ -0,0 +1,77 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func TestCanAccessBackups(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	server := NewBackupServer(resource.NewResourceManager(clientManager))
+
+	req, _ := http.NewRequest("GET", "/apis/v1/backup", nil)
+	req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"admin@google.com")
+	assert.Nil(t, server.canAccessBackups(withRequestMetadata(req), common.RbacResourceVerbCreate))
+}
+
+func TestCanAccessBackups_Unauthenticated(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	server := NewBackupServer(resource.NewResourceManager(clientManager))
+
+	// The identity header isn't trusted unless the request is authenticated.
+	req, _ := http.NewRequest("GET", "/apis/v1/backup", nil)
+	req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"admin@google.com")
+	err := server.canAccessBackups(req, common.RbacResourceVerbCreate)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), auth.IdentityHeaderMissingError.Error())
+}
+
+func TestCanAccessBackups_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewBackupServer(resource.NewResourceManager(clientManager))
+
+	req, _ := http.NewRequest("POST", "/apis/v1/restore", nil)
+	req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"user@google.com")
+	err := server.canAccessBackups(withRequestMetadata(req), common.RbacResourceVerbRestore)
+	// The review is cluster scoped, a namespace admin isn't allowed to restore.
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbRestore,
+		Group:    common.RbacPipelinesGroup,
+		Version:  common.RbacPipelinesVersion,
+		Resource: common.RbacResourceTypeBackups,
+	}
+	assert.EqualError(t, err, getPermissionDeniedError("user@google.com", resourceAttributes).Error())
+}
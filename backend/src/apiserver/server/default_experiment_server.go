@@ -0,0 +1,62 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// GetDefaultExperiment returns the default experiment of a namespace, creating
+// it if the namespace doesn't have one yet.
+func (s *ExperimentServer) GetDefaultExperiment(ctx context.Context, request *api.GetDefaultExperimentRequest) (*api.DefaultExperiment, error) {
+	err := s.canAccessExperiment(ctx, "", &authorizationv1.ResourceAttributes{
+		Namespace: request.Namespace,
+		Verb:      common.RbacResourceVerbGet,
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	experimentID, err := s.resourceManager.GetNamespaceDefaultExperimentId(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DefaultExperiment{Namespace: request.Namespace, ExperimentId: experimentID}, nil
+}
+
+// SetDefaultExperiment makes an experiment of a namespace its default
+// experiment.
+func (s *ExperimentServer) SetDefaultExperiment(ctx context.Context, request *api.SetDefaultExperimentRequest) (*api.DefaultExperiment, error) {
+	err := s.canAccessExperiment(ctx, "", &authorizationv1.ResourceAttributes{
+		Namespace: request.Namespace,
+		Verb:      common.RbacResourceVerbUpdate,
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	if request.ExperimentId == "" {
+		return nil, util.NewInvalidInputError("The experiment_id is empty.")
+	}
+	if err := s.resourceManager.SetNamespaceDefaultExperiment(request.Namespace, request.ExperimentId); err != nil {
+		return nil, err
+	}
+	log.Infof("The default experiment of namespace %s is set to %s", request.Namespace, request.ExperimentId)
+	return &api.DefaultExperiment{Namespace: request.Namespace, ExperimentId: request.ExperimentId}, nil
+}
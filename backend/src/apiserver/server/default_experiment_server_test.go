@@ -0,0 +1,83 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func TestDefaultExperiment(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	server := ExperimentServer{resource.NewResourceManager(clientManager), &ExperimentServerOptions{CollectMetrics: false}}
+
+	result, err := server.GetDefaultExperiment(ctx, &api.GetDefaultExperimentRequest{Namespace: "ns1"})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.DefaultExperiment{Namespace: "ns1", ExperimentId: resource.DefaultFakeUUID}, result)
+
+	// The experiment of another namespace can't be its default experiment.
+	_, err = server.SetDefaultExperiment(ctx, &api.SetDefaultExperimentRequest{Namespace: "ns2", ExperimentId: resource.DefaultFakeUUID})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.SetDefaultExperiment(ctx, &api.SetDefaultExperimentRequest{Namespace: "ns1"})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	result, err = server.SetDefaultExperiment(ctx, &api.SetDefaultExperimentRequest{Namespace: "ns1", ExperimentId: resource.DefaultFakeUUID})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.DefaultExperiment{Namespace: "ns1", ExperimentId: resource.DefaultFakeUUID}, result)
+}
+
+func TestDefaultExperiment_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := ExperimentServer{resource.NewResourceManager(clientManager), &ExperimentServerOptions{CollectMetrics: false}}
+
+	_, err := server.GetDefaultExperiment(ctx, &api.GetDefaultExperimentRequest{Namespace: "ns1"})
+	assert.NotNil(t, err)
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      common.RbacResourceVerbGet,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeExperiments,
+	}
+	assert.EqualError(
+		t,
+		err,
+		wrapFailedAuthzRequestError(wrapFailedAuthzApiResourcesError(getPermissionDeniedError(userIdentity, resourceAttributes))).Error(),
+	)
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// GetExperimentBudget returns the budget of an experiment along with the runs
+// and task hours it used.
+func (s *ExperimentServer) GetExperimentBudget(ctx context.Context, request *api.GetExperimentBudgetRequest) (*api.ExperimentBudgetStatus, error) {
+	err := s.canAccessExperiment(ctx, request.ExperimentId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	status, err := s.resourceManager.GetExperimentBudget(request.ExperimentId)
+	if err != nil {
+		return nil, err
+	}
+	return ToApiExperimentBudgetStatus(status), nil
+}
+
+// SetExperimentBudget replaces the budget of an experiment, e.g.
+// {"max_runs": 100, "max_task_hours": 50, "enforcement": "warn"}.
+func (s *ExperimentServer) SetExperimentBudget(ctx context.Context, request *api.SetExperimentBudgetRequest) (*api.ExperimentBudgetStatus, error) {
+	err := s.canAccessExperiment(ctx, request.ExperimentId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	budget := &resource.ExperimentBudget{
+		MaxRuns:      request.GetBudget().GetMaxRuns(),
+		MaxTaskHours: request.GetBudget().GetMaxTaskHours(),
+		Enforcement:  request.GetBudget().GetEnforcement(),
+	}
+	if err := s.resourceManager.SetExperimentBudget(request.ExperimentId, budget); err != nil {
+		return nil, err
+	}
+	log.Infof("The budget of experiment %s is set", request.ExperimentId)
+	status, err := s.resourceManager.GetExperimentBudget(request.ExperimentId)
+	if err != nil {
+		return nil, err
+	}
+	return ToApiExperimentBudgetStatus(status), nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestExperimentBudget(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := ExperimentServer{resourceManager, &ExperimentServerOptions{CollectMetrics: false}}
+	experiment, err := resourceManager.CreateExperiment(&api.Experiment{Name: "exp1"})
+	assert.Nil(t, err)
+
+	status, err := server.GetExperimentBudget(nil, &api.GetExperimentBudgetRequest{ExperimentId: experiment.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.ExperimentBudgetStatus{Budget: &api.ExperimentBudget{Enforcement: "block"}}, status)
+
+	status, err = server.SetExperimentBudget(nil, &api.SetExperimentBudgetRequest{
+		ExperimentId: experiment.UUID,
+		Budget:       &api.ExperimentBudget{MaxRuns: 100, MaxTaskHours: 50, Enforcement: "warn"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.ExperimentBudgetStatus{
+		Budget: &api.ExperimentBudget{MaxRuns: 100, MaxTaskHours: 50, Enforcement: "warn"},
+	}, status)
+
+	_, err = server.SetExperimentBudget(nil, &api.SetExperimentBudgetRequest{
+		ExperimentId: experiment.UUID,
+		Budget:       &api.ExperimentBudget{MaxRuns: -1},
+	})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.SetExperimentBudget(nil, &api.SetExperimentBudgetRequest{
+		ExperimentId: experiment.UUID,
+		Budget:       &api.ExperimentBudget{Enforcement: "stop"},
+	})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.GetExperimentBudget(nil, &api.GetExperimentBudgetRequest{ExperimentId: "unknown"})
+	AssertUserError(t, err, codes.NotFound)
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// GetExperimentDefaults returns the defaults merged into the runs and jobs of
+// an experiment.
+func (s *ExperimentServer) GetExperimentDefaults(ctx context.Context, request *api.GetExperimentDefaultsRequest) (*api.ExperimentDefaults, error) {
+	err := s.canAccessExperiment(ctx, request.ExperimentId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	defaults, err := s.resourceManager.GetExperimentDefaults(request.ExperimentId)
+	if err != nil {
+		return nil, err
+	}
+	return ToApiExperimentDefaults(defaults)
+}
+
+// SetExperimentDefaults replaces the defaults of the runs and jobs of an
+// experiment, e.g. {"parameters": [{"name": "learning_rate", "value": "0.1"}],
+// "service_account": "team-a-runner", "pod_template": {"nodeSelector": {"pool": "gpu"}}}.
+func (s *ExperimentServer) SetExperimentDefaults(ctx context.Context, request *api.SetExperimentDefaultsRequest) (*api.ExperimentDefaults, error) {
+	err := s.canAccessExperiment(ctx, request.ExperimentId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	defaults, err := toResourceExperimentDefaults(request.Defaults)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resourceManager.SetExperimentDefaults(request.ExperimentId, defaults); err != nil {
+		return nil, err
+	}
+	log.Infof("The defaults of experiment %s are set", request.ExperimentId)
+	return ToApiExperimentDefaults(defaults)
+}
+
+// toResourceExperimentDefaults converts the defaults of a request. The pod
+// template is a Tekton pod template, whose unknown fields are rejected.
+func toResourceExperimentDefaults(apiDefaults *api.ExperimentDefaults) (*resource.ExperimentDefaults, error) {
+	defaults := &resource.ExperimentDefaults{
+		Parameters:     apiDefaults.GetParameters(),
+		ServiceAccount: apiDefaults.GetServiceAccount(),
+	}
+	if defaults.Parameters == nil {
+		defaults.Parameters = []*api.Parameter{}
+	}
+	if apiDefaults.GetPodTemplate() != nil {
+		podTemplate, err := apiDefaults.GetPodTemplate().MarshalJSON()
+		if err != nil {
+			return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to read the default pod template")
+		}
+		defaults.PodTemplate = &pod.PodTemplate{}
+		decoder := json.NewDecoder(bytes.NewReader(podTemplate))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(defaults.PodTemplate); err != nil {
+			return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to read the default pod template")
+		}
+	}
+	return defaults, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestExperimentDefaults(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := ExperimentServer{resourceManager, &ExperimentServerOptions{CollectMetrics: false}}
+	experiment, err := resourceManager.CreateExperiment(&api.Experiment{Name: "exp1"})
+	assert.Nil(t, err)
+
+	result, err := server.GetExperimentDefaults(nil, &api.GetExperimentDefaultsRequest{ExperimentId: experiment.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.ExperimentDefaults{Parameters: []*api.Parameter{}}, result)
+
+	podTemplate, err := structpb.NewStruct(map[string]interface{}{"nodeSelector": map[string]interface{}{"pool": "gpu"}})
+	assert.Nil(t, err)
+	defaults := &api.ExperimentDefaults{
+		Parameters:     []*api.Parameter{{Name: "epochs", Value: "10"}},
+		ServiceAccount: "team-runner",
+		PodTemplate:    podTemplate,
+	}
+	_, err = server.SetExperimentDefaults(nil, &api.SetExperimentDefaultsRequest{ExperimentId: experiment.UUID, Defaults: defaults})
+	assert.Nil(t, err)
+
+	result, err = server.GetExperimentDefaults(nil, &api.GetExperimentDefaultsRequest{ExperimentId: experiment.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, "team-runner", result.ServiceAccount)
+	assert.Equal(t, defaults.Parameters[0].String(), result.Parameters[0].String())
+	assert.Equal(t, podTemplate.AsMap(), result.PodTemplate.AsMap())
+
+	// The pod template is a Tekton pod template.
+	podTemplate, err = structpb.NewStruct(map[string]interface{}{"nodeSelecter": map[string]interface{}{"pool": "gpu"}})
+	assert.Nil(t, err)
+	_, err = server.SetExperimentDefaults(nil, &api.SetExperimentDefaultsRequest{
+		ExperimentId: experiment.UUID,
+		Defaults:     &api.ExperimentDefaults{PodTemplate: podTemplate},
+	})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.GetExperimentDefaults(nil, &api.GetExperimentDefaultsRequest{ExperimentId: "unknown"})
+	AssertUserError(t, err, codes.NotFound)
+}
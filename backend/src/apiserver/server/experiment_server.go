@@ -191,9 +191,27 @@ func (s *ExperimentServer) DeleteExperiment(ctx context.Context, request *api.De
 	return &empty.Empty{}, nil
 }
 
+func (s *ExperimentServer) UndeleteExperiment(ctx context.Context, request *api.UndeleteExperimentRequest) (*empty.Empty, error) {
+	err := canUndelete(s.resourceManager, ctx, common.RbacResourceTypeExperiments)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	err = s.resourceManager.Undelete(common.Experiment, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.options.CollectMetrics {
+		experimentCount.Inc()
+	}
+	return &empty.Empty{}, nil
+}
+
 func ValidateCreateExperimentRequest(request *api.CreateExperimentRequest) error {
 	if request.Experiment == nil || request.Experiment.Name == "" {
-		return util.NewInvalidInputError("Experiment name is empty. Please specify a valid experiment name.")
+		return util.NewInvalidInputError("Experiment name is empty. Please specify a valid experiment name.").
+			WithFieldViolation("experiment.name", "The name must not be empty.")
 	}
 
 	resourceReferences := request.Experiment.GetResourceReferences()
@@ -251,7 +269,7 @@ func (s *ExperimentServer) canAccessExperiment(ctx context.Context, experimentID
 	return nil
 }
 
-func (s *ExperimentServer) ArchiveExperiment(ctx context.Context, request *api.ArchiveExperimentRequest) (*empty.Empty, error) {
+func (s *ExperimentServer) ArchiveExperiment(ctx context.Context, request *api.ArchiveExperimentRequest) (*api.ArchiveExperimentResponse, error) {
 	if s.options.CollectMetrics {
 		archiveExperimentRequests.Inc()
 	}
@@ -260,14 +278,20 @@ func (s *ExperimentServer) ArchiveExperiment(ctx context.Context, request *api.A
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
-	err = s.resourceManager.ArchiveExperiment(ctx, request.Id)
+	result, err := s.resourceManager.ArchiveExperiment(ctx, request.Id, model.ExperimentArchivePolicy{
+		Runs: !request.KeepRunsAvailable,
+		Jobs: !request.KeepJobsEnabled,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &empty.Empty{}, nil
+	return &api.ArchiveExperimentResponse{
+		ArchivedRunCount: int32(result.RunCount),
+		PausedJobIds:     result.JobIDs,
+	}, nil
 }
 
-func (s *ExperimentServer) UnarchiveExperiment(ctx context.Context, request *api.UnarchiveExperimentRequest) (*empty.Empty, error) {
+func (s *ExperimentServer) UnarchiveExperiment(ctx context.Context, request *api.UnarchiveExperimentRequest) (*api.UnarchiveExperimentResponse, error) {
 	if s.options.CollectMetrics {
 		unarchiveExperimentRequests.Inc()
 	}
@@ -276,11 +300,17 @@ func (s *ExperimentServer) UnarchiveExperiment(ctx context.Context, request *api
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
-	err = s.resourceManager.UnarchiveExperiment(request.Id)
+	result, err := s.resourceManager.UnarchiveExperiment(request.Id, model.ExperimentArchivePolicy{
+		Runs: request.RestoreRuns,
+		Jobs: request.EnableJobs,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &empty.Empty{}, nil
+	return &api.UnarchiveExperimentResponse{
+		RestoredRunCount: int32(result.RunCount),
+		EnabledJobIds:    result.JobIDs,
+	}, nil
 }
 
 func NewExperimentServer(resourceManager *resource.ResourceManager, options *ExperimentServerOptions) *ExperimentServer {
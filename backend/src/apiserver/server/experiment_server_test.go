@@ -13,6 +13,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
@@ -265,6 +266,61 @@ func TestGetExperiment_Multiuser(t *testing.T) {
 	assert.Equal(t, expectedExperiment, result)
 }
 
+func TestUndeleteExperiment(t *testing.T) {
+	viper.Set(common.SoftDeletePurgeWindow, "1h")
+	defer viper.Set(common.SoftDeletePurgeWindow, "0")
+
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := ExperimentServer{resourceManager: resourceManager, options: &ExperimentServerOptions{CollectMetrics: false}}
+	experiment := &api.Experiment{Name: "ex1", Description: "first experiment"}
+
+	createResult, err := server.CreateExperiment(nil, &api.CreateExperimentRequest{Experiment: experiment})
+	assert.Nil(t, err)
+	_, err = server.DeleteExperiment(nil, &api.DeleteExperimentRequest{Id: createResult.Id})
+	assert.Nil(t, err)
+	_, err = server.GetExperiment(nil, &api.GetExperimentRequest{Id: createResult.Id})
+	AssertUserError(t, err, codes.NotFound)
+
+	_, err = server.UndeleteExperiment(nil, &api.UndeleteExperimentRequest{Id: createResult.Id})
+	assert.Nil(t, err)
+	result, err := server.GetExperiment(nil, &api.GetExperimentRequest{Id: createResult.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, "ex1", result.Name)
+
+	// The experiment isn't deleted anymore.
+	_, err = server.UndeleteExperiment(nil, &api.UndeleteExperimentRequest{Id: createResult.Id})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestUndeleteExperiment_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, experiment := initWithExperiment_SubjectAccessReview_Unauthorized(t)
+	defer clients.Close()
+
+	server := ExperimentServer{manager, &ExperimentServerOptions{CollectMetrics: false}}
+
+	_, err := server.UndeleteExperiment(ctx, &api.UndeleteExperimentRequest{Id: experiment.UUID})
+	assert.NotNil(t, err)
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbUndelete,
+		Group:    common.RbacPipelinesGroup,
+		Version:  common.RbacPipelinesVersion,
+		Resource: common.RbacResourceTypeExperiments,
+	}
+	assert.EqualError(
+		t,
+		err,
+		wrapFailedAuthzRequestError(getPermissionDeniedError(userIdentity, resourceAttributes)).Error(),
+	)
+}
+
 func TestListExperiment(t *testing.T) {
 	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	resourceManager := resource.NewResourceManager(clientManager)
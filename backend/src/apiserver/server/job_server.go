@@ -91,16 +91,9 @@ func (s *JobServer) CreateJob(ctx context.Context, request *api.CreateJobRequest
 	}
 
 	if common.IsMultiUserMode() {
-		experimentID := common.GetExperimentIDFromAPIResourceReferences(request.Job.ResourceReferences)
-		if experimentID == "" {
-			return nil, util.NewInvalidInputError("Job has no experiment.")
-		}
-		namespace, err := s.resourceManager.GetNamespaceFromExperimentID(experimentID)
+		namespace, err := getNamespaceOfNewResource(s.resourceManager, request.Job.ResourceReferences)
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to get experiment for job.")
-		}
-		if namespace == "" {
-			return nil, util.NewInvalidInputError("Job's experiment has no namespace.")
+			return nil, err
 		}
 		resourceAttributes := &authorizationv1.ResourceAttributes{
 			Namespace: namespace,
@@ -251,6 +244,23 @@ func (s *JobServer) DeleteJob(ctx context.Context, request *api.DeleteJobRequest
 	return &empty.Empty{}, nil
 }
 
+func (s *JobServer) UndeleteJob(ctx context.Context, request *api.UndeleteJobRequest) (*empty.Empty, error) {
+	err := canUndelete(s.resourceManager, ctx, common.RbacResourceTypeJobs)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	err = s.resourceManager.Undelete(common.Job, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.options.CollectMetrics {
+		jobCount.Inc()
+	}
+	return &empty.Empty{}, nil
+}
+
 func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) error {
 	job := request.Job
 
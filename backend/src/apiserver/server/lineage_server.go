@@ -0,0 +1,109 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strconv"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type LineageServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// GetArtifactLineage returns the graph of executions and artifacts upstream and
+// downstream of an ML Metadata artifact. The depth limits how many executions
+// away from the artifact the graph goes, and defaults to 3.
+func (s *LineageServer) GetArtifactLineage(ctx context.Context, request *api.GetArtifactLineageRequest) (*api.LineageGraph, error) {
+	artifactID, err := strconv.ParseInt(request.ArtifactId, 10, 64)
+	if err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Invalid artifact ID")
+	}
+	depth := int(request.Depth)
+	if depth == 0 {
+		depth = common.DefaultLineageDepth
+	}
+	if depth < 1 || depth > common.MaxLineageDepth {
+		return nil, util.NewInvalidInputError("Invalid depth %d, it must be between 1 and %d", depth, common.MaxLineageDepth)
+	}
+
+	if err := s.canGetArtifactLineage(ctx); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	graph, err := s.resourceManager.GetArtifactLineage(ctx, artifactID, depth)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the lineage of the artifact")
+	}
+	return ToApiLineageGraph(graph), nil
+}
+
+// GetRunLineage returns the graph of the executions of a run recorded in ML
+// Metadata and of the artifacts they consumed and produced.
+func (s *LineageServer) GetRunLineage(ctx context.Context, request *api.GetRunLineageRequest) (*api.LineageGraph, error) {
+	if err := s.canGetRunLineage(ctx, request.RunId); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	graph, err := s.resourceManager.GetRunLineage(ctx, request.RunId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the lineage of the run")
+	}
+	return ToApiLineageGraph(graph), nil
+}
+
+// canGetArtifactLineage requires the permission to get artifacts cluster-wide in
+// multi-user mode, since ML Metadata isn't namespaced and lineage crosses runs.
+func (s *LineageServer) canGetArtifactLineage(ctx context.Context) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbGet,
+		Group:    common.RbacPipelinesGroup,
+		Version:  common.RbacPipelinesVersion,
+		Resource: common.RbacResourceTypeArtifacts,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func (s *LineageServer) canGetRunLineage(ctx context.Context, runId string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	run, err := s.resourceManager.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: run.Namespace,
+		Verb:      common.RbacResourceVerbGet,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      run.Name,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewLineageServer(resourceManager *resource.ResourceManager) *LineageServer {
+	return &LineageServer{resourceManager: resourceManager}
+}
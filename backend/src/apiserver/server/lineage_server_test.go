@@ -0,0 +1,110 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func initWithLineage(t *testing.T) *resource.FakeClientManager {
+	clientManager := initWithArtifactRun(t)
+	metadataClient := clientManager.MetadataClientFake
+	metadataClient.AddArtifact(&client.MetadataArtifact{ID: 1, TypeID: 1, Name: "dataset"}, "system.Dataset")
+	metadataClient.AddArtifact(&client.MetadataArtifact{ID: 2, TypeID: 2, Name: "model"}, "system.Model")
+	metadataClient.AddContext(&client.MetadataContext{ID: 1, Name: "run-1"}, "KfpRun")
+	metadataClient.AddExecution(&client.MetadataExecution{ID: 1, TypeID: 1, Name: "train"}, "components.train", 1,
+		[]int64{1}, []int64{2})
+	return clientManager
+}
+
+func TestGetArtifactLineage(t *testing.T) {
+	clientManager := initWithLineage(t)
+	defer clientManager.Close()
+	server := NewLineageServer(resource.NewResourceManager(clientManager))
+
+	graph, err := server.GetArtifactLineage(context.Background(), &api.GetArtifactLineageRequest{ArtifactId: "2", Depth: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "artifact/2", graph.Root)
+	assert.Len(t, graph.Nodes, 3)
+	assert.Equal(t, []*api.LineageEdge{
+		{Source: "artifact/1", Target: "execution/1"},
+		{Source: "execution/1", Target: "artifact/2"},
+	}, graph.Edges)
+}
+
+func TestGetArtifactLineage_InvalidRequest(t *testing.T) {
+	clientManager := initWithLineage(t)
+	defer clientManager.Close()
+	server := NewLineageServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.GetArtifactLineage(context.Background(), &api.GetArtifactLineageRequest{ArtifactId: "model"})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.GetArtifactLineage(context.Background(), &api.GetArtifactLineageRequest{ArtifactId: "2", Depth: 100})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.GetArtifactLineage(context.Background(), &api.GetArtifactLineageRequest{ArtifactId: "3"})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestGetArtifactLineage_MetadataNotConfigured(t *testing.T) {
+	clientManager := initWithLineage(t)
+	defer clientManager.Close()
+	clientManager.MetadataClientFake = nil
+	server := NewLineageServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.GetArtifactLineage(context.Background(), &api.GetArtifactLineageRequest{ArtifactId: "2"})
+	AssertUserError(t, err, codes.FailedPrecondition)
+}
+
+func TestGetRunLineage(t *testing.T) {
+	clientManager := initWithLineage(t)
+	defer clientManager.Close()
+	server := NewLineageServer(resource.NewResourceManager(clientManager))
+
+	graph, err := server.GetRunLineage(context.Background(), &api.GetRunLineageRequest{RunId: "run1"})
+	assert.Nil(t, err)
+	assert.Len(t, graph.Nodes, 3)
+	assert.Len(t, graph.Edges, 2)
+
+	_, err = server.GetRunLineage(context.Background(), &api.GetRunLineageRequest{RunId: "run2"})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestGetLineage_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := initWithLineage(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewLineageServer(resource.NewResourceManager(clientManager))
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := server.GetArtifactLineage(ctx, &api.GetArtifactLineageRequest{ArtifactId: "2"})
+	AssertUserError(t, err, codes.PermissionDenied)
+	_, err = server.GetRunLineage(ctx, &api.GetRunLineageRequest{RunId: "run1"})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type NamespaceExportServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// ExportNamespace streams a gzipped export of the experiments, pipelines, jobs
+// and runs of a namespace, which ImportNamespace imports into another namespace
+// or cluster. These endpoints are served on the HTTP mux rather than through the
+// gateway, since the exports are streamed as they're written and read, and can
+// be larger than the gRPC messages.
+func (s *NamespaceExportServer) ExportNamespace(w http.ResponseWriter, r *http.Request) {
+	namespace := mux.Vars(r)["namespace"]
+	log.Infof("ExportNamespace called for namespace %s", namespace)
+
+	if err := s.canAccessNamespace(r, namespace, common.RbacResourceVerbExport); err != nil {
+		s.writeErrorToResponse(w, http.StatusForbidden, util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+	if err := resource.CheckNamespaceExportEnabled(namespace); err != nil {
+		s.writeErrorToResponse(w, runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err))), err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=kfp-%s-%d.jsonl.gz", namespace, s.resourceManager.GetTime().Now().Unix()))
+	writer := gzip.NewWriter(w)
+	summary, err := s.resourceManager.ExportNamespace(r.Context(), namespace, writer)
+	if err != nil {
+		// The response has started, the export is left without its end record
+		// so that it can't be imported.
+		log.Errorf("Failed to export namespace %s. Error: %+v", namespace, err)
+		return
+	}
+	if err := writer.Close(); err != nil {
+		log.Errorf("Failed to write the export of namespace %s. Error: %v", namespace, err)
+		return
+	}
+	log.Infof("Exported namespace %s: %+v", namespace, summary)
+}
+
+// ImportNamespace imports the gzipped namespace export of the body into a
+// namespace. The service accounts and secrets referenced by the export are
+// renamed with the repeated service_account and secret query parameters, e.g.
+// service_account=default-editor:pipeline-runner.
+func (s *NamespaceExportServer) ImportNamespace(w http.ResponseWriter, r *http.Request) {
+	namespace := mux.Vars(r)["namespace"]
+	log.Infof("ImportNamespace called for namespace %s", namespace)
+
+	if err := s.canAccessNamespace(r, namespace, common.RbacResourceVerbImport); err != nil {
+		s.writeErrorToResponse(w, http.StatusForbidden, util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+	mapping := &template.ReferenceMapping{}
+	var err error
+	query := r.URL.Query()
+	if mapping.ServiceAccounts, err = parseNameMapping(query["service_account"]); err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	if mapping.Secrets, err = parseNameMapping(query["secret"]); err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	reader, err := gzip.NewReader(r.Body)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputErrorWithDetails(err, "The namespace export isn't gzipped"))
+		return
+	}
+	defer reader.Close()
+	summary, err := s.resourceManager.ImportNamespace(r.Context(), reader, namespace, mapping)
+	if err != nil {
+		// The resources imported before the error are kept.
+		if summary != nil {
+			err = util.Wrapf(err, "Imported %d experiments, %d pipelines, %d pipeline versions, %d jobs and %d runs before the error",
+				summary.Experiments, summary.Pipelines, summary.PipelineVersions, summary.Jobs, summary.Runs)
+		}
+		code := runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err)))
+		s.writeErrorToResponse(w, code, err)
+		return
+	}
+	log.Infof("Imported into namespace %s: %+v", namespace, summary)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
+
+// parseNameMapping parses renames of the form old:new.
+func parseNameMapping(values []string) (map[string]string, error) {
+	names := map[string]string{}
+	for _, value := range values {
+		parts := strings.Split(value, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, util.NewInvalidInputError("Invalid rename %q, expected old:new", value)
+		}
+		names[parts[0]] = parts[1]
+	}
+	return names, nil
+}
+
+func (s *NamespaceExportServer) canAccessNamespace(r *http.Request, namespace string, verb string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeNamespaces,
+	}
+	return isRequestAuthorized(s.resourceManager, r.Context(), resourceAttributes)
+}
+
+func (s *NamespaceExportServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
+	log.Errorf("Failed to export or import a namespace. Error: %+v", err)
+	w.WriteHeader(code)
+	errorResponse := util.ToAPIError(err)
+	errBytes, err := json.Marshal(errorResponse)
+	if err != nil {
+		w.Write([]byte("Error exporting or importing a namespace"))
+	}
+	w.Write(errBytes)
+}
+
+func NewNamespaceExportServer(resourceManager *resource.ResourceManager) *NamespaceExportServer {
+	return &NamespaceExportServer{resourceManager: resourceManager}
+}
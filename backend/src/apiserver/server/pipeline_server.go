@@ -25,6 +25,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	authorizationv1 "k8s.io/api/authorization/v1"
 
@@ -114,7 +115,7 @@ func (s *PipelineServer) CreatePipeline(ctx context.Context, request *api.Create
 			"Please double check the URL is valid and can be accessed by the pipeline system.", pipelineUrl)
 	}
 	pipelineFileName := path.Base(pipelineUrl)
-	pipelineFile, err := ReadPipelineFile(pipelineFileName, resp.Body, MaxFileLength)
+	pipelineFile, err := template.ReadPipelineFile(pipelineFileName, resp.Body, common.GetMaxManifestSize())
 	if err != nil {
 		return nil, util.Wrap(err, "The URL is valid but pipeline system failed to read the file.")
 	}
@@ -256,9 +257,26 @@ func (s *PipelineServer) DeletePipeline(ctx context.Context, request *api.Delete
 	return &empty.Empty{}, nil
 }
 
+func (s *PipelineServer) UndeletePipeline(ctx context.Context, request *api.UndeletePipelineRequest) (*empty.Empty, error) {
+	err := canUndelete(s.resourceManager, ctx, common.RbacResourceTypePipelines)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	err = s.resourceManager.Undelete(common.Pipeline, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.options.CollectMetrics {
+		pipelineCount.Inc()
+	}
+	return &empty.Empty{}, nil
+}
+
 func (s *PipelineServer) GetTemplate(ctx context.Context, request *api.GetTemplateRequest) (*api.GetTemplateResponse, error) {
 	resourceAttributes := &authorizationv1.ResourceAttributes{
-		Verb: common.RbacResourceVerbList,
+		Verb: common.RbacResourceVerbGet,
 	}
 	err := s.CanAccessPipeline(ctx, request.Id, resourceAttributes)
 	if err != nil {
@@ -274,12 +292,14 @@ func (s *PipelineServer) GetTemplate(ctx context.Context, request *api.GetTempla
 
 func ValidateCreatePipelineRequest(request *api.CreatePipelineRequest) error {
 	if request.Pipeline.Url == nil || request.Pipeline.Url.PipelineUrl == "" {
-		return util.NewInvalidInputError("Pipeline URL is empty. Please specify a valid URL.")
+		return util.NewInvalidInputError("Pipeline URL is empty. Please specify a valid URL.").
+			WithFieldViolation("pipeline.url.pipeline_url", "The URL must not be empty.")
 	}
 
 	if _, err := url.ParseRequestURI(request.Pipeline.Url.PipelineUrl); err != nil {
 		return util.NewInvalidInputError(
-			"Invalid Pipeline URL %v. Please specify a valid URL", request.Pipeline.Url.PipelineUrl)
+			"Invalid Pipeline URL %v. Please specify a valid URL", request.Pipeline.Url.PipelineUrl).
+			WithFieldViolation("pipeline.url.pipeline_url", err.Error())
 	}
 	return nil
 }
@@ -296,7 +316,8 @@ func (s *PipelineServer) CreatePipelineVersion(ctx context.Context, request *api
 	// Read pipeline file.
 	if request.Version == nil || request.Version.PackageUrl == nil ||
 		len(request.Version.PackageUrl.PipelineUrl) == 0 {
-		return nil, util.NewInvalidInputError("Pipeline URL is empty. Please specify a valid URL.")
+		return nil, util.NewInvalidInputError("Pipeline URL is empty. Please specify a valid URL.").
+			WithFieldViolation("version.package_url.pipeline_url", "The URL must not be empty.")
 	}
 	pipelineUrl := request.Version.PackageUrl.PipelineUrl
 	if _, err := url.ParseRequestURI(request.Version.PackageUrl.PipelineUrl); err != nil {
@@ -307,7 +328,7 @@ func (s *PipelineServer) CreatePipelineVersion(ctx context.Context, request *api
 		return nil, util.NewInternalServerError(err, "Failed to download the pipeline from %v. Please double check the URL is valid and can be accessed by the pipeline system.", pipelineUrl)
 	}
 	pipelineFileName := path.Base(pipelineUrl)
-	pipelineFile, err := ReadPipelineFile(pipelineFileName, resp.Body, MaxFileLength)
+	pipelineFile, err := template.ReadPipelineFile(pipelineFileName, resp.Body, common.GetMaxManifestSize())
 	if err != nil {
 		return nil, util.Wrap(err, "The URL is valid but pipeline system failed to read the file.")
 	}
@@ -323,9 +344,10 @@ func (s *PipelineServer) CreatePipelineVersion(ctx context.Context, request *api
 		return nil, util.Wrap(err, "Create pipeline version failed due to missing pipeline id")
 	}
 	resourceAttributes := &authorizationv1.ResourceAttributes{
-		Verb: common.RbacResourceVerbList,
+		Verb:        common.RbacResourceVerbCreate,
+		Subresource: common.RbacSubresourceVersions,
 	}
-	if err = s.CanAccessPipeline(ctx, "", resourceAttributes); err != nil {
+	if err = s.CanAccessPipeline(ctx, pipelineId, resourceAttributes); err != nil {
 		return nil, util.Wrap(err, "Failed to authorize with API resource references")
 	}
 
@@ -341,7 +363,7 @@ func (s *PipelineServer) GetPipelineVersion(ctx context.Context, request *api.Ge
 		getPipelineVersionRequests.Inc()
 	}
 	resourceAttributes := &authorizationv1.ResourceAttributes{
-		Verb: common.RbacResourceVerbList,
+		Verb: common.RbacResourceVerbGet,
 	}
 	err := s.CanAccessPipelineVersion(ctx, request.VersionId, resourceAttributes)
 	if err != nil {
@@ -351,7 +373,11 @@ func (s *PipelineServer) GetPipelineVersion(ctx context.Context, request *api.Ge
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline version failed.")
 	}
-	return ToApiPipelineVersion(version)
+	apiVersion, err := ToApiPipelineVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	return applyPipelineVersionView(apiVersion, request.View), nil
 }
 
 func (s *PipelineServer) ListPipelineVersions(ctx context.Context, request *api.ListPipelineVersionsRequest) (*api.ListPipelineVersionsResponse, error) {
@@ -408,14 +434,10 @@ func (s *PipelineServer) DeletePipelineVersion(ctx context.Context, request *api
 	if s.options.CollectMetrics {
 		deletePipelineVersionRequests.Inc()
 	}
-	pipelineVersion, err := s.resourceManager.GetPipelineVersion(request.VersionId)
-	if err != nil {
-		return nil, util.Wrap(err, "Failed to get Pipeline Version.")
-	}
 	resourceAttributes := &authorizationv1.ResourceAttributes{
-		Verb: common.RbacResourceVerbList,
+		Verb: common.RbacResourceVerbDelete,
 	}
-	err = s.CanAccessPipeline(ctx, pipelineVersion.PipelineId, resourceAttributes)
+	err := s.CanAccessPipelineVersion(ctx, request.VersionId, resourceAttributes)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize with API resource references")
 	}
@@ -429,7 +451,7 @@ func (s *PipelineServer) DeletePipelineVersion(ctx context.Context, request *api
 
 func (s *PipelineServer) GetPipelineVersionTemplate(ctx context.Context, request *api.GetPipelineVersionTemplateRequest) (*api.GetTemplateResponse, error) {
 	resourceAttributes := &authorizationv1.ResourceAttributes{
-		Verb: common.RbacResourceVerbList,
+		Verb: common.RbacResourceVerbGet,
 	}
 	err := s.CanAccessPipelineVersion(ctx, request.VersionId, resourceAttributes)
 	if err != nil {
@@ -464,6 +486,7 @@ func (s *PipelineServer) CanAccessPipelineVersion(ctx context.Context, versionId
 	resourceAttributes.Group = common.RbacPipelinesGroup
 	resourceAttributes.Version = common.RbacPipelinesVersion
 	resourceAttributes.Resource = common.RbacResourceTypePipelines
+	resourceAttributes.Subresource = common.RbacSubresourceVersions
 	err := isAuthorized(s.resourceManager, ctx, resourceAttributes)
 	if err != nil {
 		return util.Wrap(err, "Failed to authorize with API resource references")
@@ -0,0 +1,185 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// PipelineServerV2beta1 serves the v2beta1 PipelineService of the KFP v2 SDK. The
+// calls are passed to the v1 pipeline server, so the pipelines are validated and
+// authorized the same way.
+type PipelineServerV2beta1 struct {
+	resourceManager *resource.ResourceManager
+	pipelineServer  *PipelineServer
+}
+
+// CreatePipelineAndVersion creates a pipeline from the package of its version,
+// which becomes its default version. Like the default versions of the v1 API, the
+// version is named after the pipeline.
+func (s *PipelineServerV2beta1) CreatePipelineAndVersion(ctx context.Context, request *apiv2beta1.CreatePipelineAndVersionRequest) (*apiv2beta1.Pipeline, error) {
+	if request.GetPipeline() == nil {
+		return nil, util.NewInvalidInputError("Pipeline is empty. Please specify a valid pipeline.")
+	}
+	pipeline := &api.Pipeline{
+		Name:        request.GetPipeline().GetDisplayName(),
+		Description: request.GetPipeline().GetDescription(),
+	}
+	if packageUrl := request.GetPipelineVersion().GetPackageUrl(); packageUrl != nil {
+		pipeline.Url = &api.Url{PipelineUrl: packageUrl.GetPipelineUrl()}
+	}
+	if namespace := request.GetPipeline().GetNamespace(); namespace != "" {
+		pipeline.ResourceReferences = []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: namespace},
+			Relationship: api.Relationship_OWNER,
+		}}
+	}
+	newPipeline, err := s.pipelineServer.CreatePipeline(ctx, &api.CreatePipelineRequest{Pipeline: pipeline})
+	if err != nil {
+		return nil, err
+	}
+	apiPipeline := ToApiPipelineV2beta1(newPipeline)
+	apiPipeline.Namespace = request.GetPipeline().GetNamespace()
+	return apiPipeline, nil
+}
+
+func (s *PipelineServerV2beta1) GetPipeline(ctx context.Context, request *apiv2beta1.GetPipelineRequest) (*apiv2beta1.Pipeline, error) {
+	pipeline, err := s.pipelineServer.GetPipeline(ctx, &api.GetPipelineRequest{Id: request.GetPipelineId()})
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := s.resourceManager.GetNamespaceFromPipelineID(request.GetPipelineId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the namespace of the pipeline.")
+	}
+	apiPipeline := ToApiPipelineV2beta1(pipeline)
+	apiPipeline.Namespace = namespace
+	return apiPipeline, nil
+}
+
+// ListPipelines lists the pipelines of a namespace, or the shared pipelines
+// without one.
+func (s *PipelineServerV2beta1) ListPipelines(ctx context.Context, request *apiv2beta1.ListPipelinesRequest) (*apiv2beta1.ListPipelinesResponse, error) {
+	response, err := s.pipelineServer.ListPipelines(ctx, &api.ListPipelinesRequest{
+		PageToken:            request.GetPageToken(),
+		PageSize:             request.GetPageSize(),
+		SortBy:               request.GetSortBy(),
+		Filter:               request.GetFilter(),
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: request.GetNamespace()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	apiPipelines := ToApiPipelinesV2beta1(response.GetPipelines())
+	for _, apiPipeline := range apiPipelines {
+		apiPipeline.Namespace = request.GetNamespace()
+	}
+	return &apiv2beta1.ListPipelinesResponse{
+		Pipelines:     apiPipelines,
+		TotalSize:     response.GetTotalSize(),
+		NextPageToken: response.GetNextPageToken(),
+	}, nil
+}
+
+func (s *PipelineServerV2beta1) DeletePipeline(ctx context.Context, request *apiv2beta1.DeletePipelineRequest) (*empty.Empty, error) {
+	return s.pipelineServer.DeletePipeline(ctx, &api.DeletePipelineRequest{Id: request.GetPipelineId()})
+}
+
+func (s *PipelineServerV2beta1) CreatePipelineVersion(ctx context.Context, request *apiv2beta1.CreatePipelineVersionRequest) (*apiv2beta1.PipelineVersion, error) {
+	if request.GetPipelineVersion() == nil {
+		return nil, util.NewInvalidInputError("Pipeline version is empty. Please specify a valid pipeline version.")
+	}
+	version := &api.PipelineVersion{
+		Name:          request.GetPipelineVersion().GetDisplayName(),
+		Description:   request.GetPipelineVersion().GetDescription(),
+		CodeSourceUrl: request.GetPipelineVersion().GetCodeSourceUrl(),
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE, Id: request.GetPipelineId()},
+			Relationship: api.Relationship_OWNER,
+		}},
+	}
+	if packageUrl := request.GetPipelineVersion().GetPackageUrl(); packageUrl != nil {
+		version.PackageUrl = &api.Url{PipelineUrl: packageUrl.GetPipelineUrl()}
+	}
+	newVersion, err := s.pipelineServer.CreatePipelineVersion(ctx, &api.CreatePipelineVersionRequest{Version: version})
+	if err != nil {
+		return nil, err
+	}
+	return ToApiPipelineVersionV2beta1(newVersion), nil
+}
+
+// GetPipelineVersion returns a version of a pipeline along with its pipeline spec,
+// if it's a v2 pipeline.
+func (s *PipelineServerV2beta1) GetPipelineVersion(ctx context.Context, request *apiv2beta1.GetPipelineVersionRequest) (*apiv2beta1.PipelineVersion, error) {
+	version, err := s.pipelineServer.GetPipelineVersion(ctx, &api.GetPipelineVersionRequest{VersionId: request.GetPipelineVersionId()})
+	if err != nil {
+		return nil, err
+	}
+	apiVersion := ToApiPipelineVersionV2beta1(version)
+	if apiVersion.GetPipelineId() != request.GetPipelineId() {
+		return nil, util.NewResourceNotFoundError("PipelineVersion", request.GetPipelineVersionId())
+	}
+	templateBytes, err := s.resourceManager.GetPipelineVersionTemplate(request.GetPipelineVersionId())
+	if err != nil {
+		return nil, util.Wrap(err, "Get pipeline version template failed.")
+	}
+	if tmpl, err := template.New(templateBytes); err == nil && tmpl.IsV2() {
+		apiVersion.PipelineSpec = toApiPipelineSpecStruct(string(tmpl.Bytes()))
+	}
+	return apiVersion, nil
+}
+
+func (s *PipelineServerV2beta1) ListPipelineVersions(ctx context.Context, request *apiv2beta1.ListPipelineVersionsRequest) (*apiv2beta1.ListPipelineVersionsResponse, error) {
+	response, err := s.pipelineServer.ListPipelineVersions(ctx, &api.ListPipelineVersionsRequest{
+		ResourceKey: &api.ResourceKey{Type: api.ResourceType_PIPELINE, Id: request.GetPipelineId()},
+		PageToken:   request.GetPageToken(),
+		PageSize:    request.GetPageSize(),
+		SortBy:      request.GetSortBy(),
+		Filter:      request.GetFilter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2beta1.ListPipelineVersionsResponse{
+		PipelineVersions: ToApiPipelineVersionsV2beta1(response.GetVersions()),
+		TotalSize:        response.GetTotalSize(),
+		NextPageToken:    response.GetNextPageToken(),
+	}, nil
+}
+
+func (s *PipelineServerV2beta1) DeletePipelineVersion(ctx context.Context, request *apiv2beta1.DeletePipelineVersionRequest) (*empty.Empty, error) {
+	version, err := s.resourceManager.GetPipelineVersion(request.GetPipelineVersionId())
+	if err != nil {
+		return nil, util.Wrap(err, "Get pipeline version failed.")
+	}
+	if version.PipelineId != request.GetPipelineId() {
+		return nil, util.NewResourceNotFoundError("PipelineVersion", request.GetPipelineVersionId())
+	}
+	return s.pipelineServer.DeletePipelineVersion(ctx, &api.DeletePipelineVersionRequest{VersionId: request.GetPipelineVersionId()})
+}
+
+func NewPipelineServerV2beta1(resourceManager *resource.ResourceManager, options *PipelineServerOptions) *PipelineServerV2beta1 {
+	return &PipelineServerV2beta1{
+		resourceManager: resourceManager,
+		pipelineServer:  NewPipelineServer(resourceManager, options),
+	}
+}
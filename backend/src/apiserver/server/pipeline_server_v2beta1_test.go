@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestPipelineServerV2beta1_GetPipelineAndVersion(t *testing.T) {
+	initEnvVars()
+	clients := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clients.Close()
+	manager := resource.NewResourceManager(clients)
+	pipeline, err := manager.CreatePipeline("hello-world", "says hello", "", []byte(v2SpecHelloWorld))
+	require.Nil(t, err)
+	server := NewPipelineServerV2beta1(manager, &PipelineServerOptions{CollectMetrics: false})
+
+	apiPipeline, err := server.GetPipeline(context.Background(), &apiv2beta1.GetPipelineRequest{PipelineId: pipeline.UUID})
+	require.Nil(t, err)
+	assert.Equal(t, pipeline.UUID, apiPipeline.GetPipelineId())
+	assert.Equal(t, "hello-world", apiPipeline.GetDisplayName())
+	assert.Equal(t, "says hello", apiPipeline.GetDescription())
+
+	// The default version of a pipeline has the same id.
+	version, err := server.GetPipelineVersion(context.Background(), &apiv2beta1.GetPipelineVersionRequest{
+		PipelineId:        pipeline.UUID,
+		PipelineVersionId: pipeline.UUID,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, pipeline.UUID, version.GetPipelineId())
+	assert.Equal(t, pipeline.UUID, version.GetPipelineVersionId())
+	assert.NotNil(t, version.GetPipelineSpec().GetFields()["root"])
+
+	versions, err := server.ListPipelineVersions(context.Background(), &apiv2beta1.ListPipelineVersionsRequest{
+		PipelineId: pipeline.UUID,
+	})
+	require.Nil(t, err)
+	require.Len(t, versions.GetPipelineVersions(), 1)
+	assert.Equal(t, pipeline.UUID, versions.GetPipelineVersions()[0].GetPipelineVersionId())
+}
+
+func TestPipelineServerV2beta1_GetPipelineVersion_OfAnotherPipeline(t *testing.T) {
+	clients, manager, pipeline := initWithPipeline(t)
+	defer clients.Close()
+	server := NewPipelineServerV2beta1(manager, &PipelineServerOptions{CollectMetrics: false})
+
+	_, err := server.GetPipelineVersion(context.Background(), &apiv2beta1.GetPipelineVersionRequest{
+		PipelineId:        "another-pipeline",
+		PipelineVersionId: pipeline.UUID,
+	})
+	AssertUserError(t, err, codes.NotFound)
+
+	_, err = server.DeletePipelineVersion(context.Background(), &apiv2beta1.DeletePipelineVersionRequest{
+		PipelineId:        "another-pipeline",
+		PipelineVersionId: pipeline.UUID,
+	})
+	AssertUserError(t, err, codes.NotFound)
+}
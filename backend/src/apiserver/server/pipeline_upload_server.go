@@ -15,21 +15,22 @@
 package server
 
 import (
-	"context"
+	"bytes"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"net/url"
 
-	"github.com/golang/glog"
 	"github.com/golang/protobuf/jsonpb"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
@@ -55,6 +56,11 @@ var (
 		Help: "The number of pipeline version upload requests",
 	})
 
+	compilePipelineRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_upload_compile_requests",
+		Help: "The number of pipeline compile requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 )
 
@@ -78,15 +84,8 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 		uploadPipelineRequests.Inc()
 	}
 
-	glog.Infof("Upload pipeline called")
-	file, header, err := r.FormFile(FormFileKey)
-	if err != nil {
-		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read pipeline from file"))
-		return
-	}
-	defer file.Close()
-
-	pipelineFile, err := ReadPipelineFile(header.Filename, file, MaxFileLength)
+	log.Infof("Upload pipeline called")
+	fileName, pipelineFile, upload, err := s.readPipelineFile(r)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error read pipeline file."))
 		return
@@ -98,6 +97,11 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Invalid pipeline namespace."))
 		return
 	}
+	if upload != nil && upload.Namespace != pipelineNamespace {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputError(
+			"Upload %v belongs to namespace %q, not %q", upload.UUID, upload.Namespace, pipelineNamespace))
+		return
+	}
 
 	err = s.canUploadVersionedPipeline(r, pipelineNamespace)
 	if err != nil {
@@ -105,7 +109,7 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 		return
 	}
 	fileNameQueryString := r.URL.Query().Get(NameQueryStringKey)
-	pipelineName, err := GetPipelineName(fileNameQueryString, header.Filename)
+	pipelineName, err := GetPipelineName(fileNameQueryString, fileName)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Invalid pipeline name."))
 		return
@@ -121,6 +125,7 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline"))
 		return
 	}
+	s.deleteUpload(upload)
 
 	w.Header().Set("Content-Type", "application/json")
 	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
@@ -142,15 +147,8 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 		uploadPipelineVersionRequests.Inc()
 	}
 
-	glog.Infof("Upload pipeline version called")
-	file, header, err := r.FormFile(FormFileKey)
-	if err != nil {
-		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read pipeline version from file"))
-		return
-	}
-	defer file.Close()
-
-	pipelineFile, err := ReadPipelineFile(header.Filename, file, MaxFileLength)
+	log.Infof("Upload pipeline version called")
+	fileName, pipelineFile, upload, err := s.readPipelineFile(r)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error read pipeline version file."))
 		return
@@ -158,7 +156,7 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 
 	versionNameQueryString := r.URL.Query().Get(NameQueryStringKey)
 	// If new version's name is not included in query string, use file name.
-	pipelineVersionName, err := GetPipelineName(versionNameQueryString, header.Filename)
+	pipelineVersionName, err := GetPipelineName(versionNameQueryString, fileName)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Invalid pipeline version name."))
 		return
@@ -177,6 +175,11 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to get namespace from pipelineId."))
 		return
 	}
+	if upload != nil && upload.Namespace != namespace {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputError(
+			"Upload %v belongs to namespace %q, not %q", upload.UUID, upload.Namespace, namespace))
+		return
+	}
 
 	err = s.canUploadVersionedPipeline(r, namespace)
 	if err != nil {
@@ -202,6 +205,7 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline version"))
 		return
 	}
+	s.deleteUpload(upload)
 
 	w.Header().Set("Content-Type", "application/json")
 	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
@@ -221,11 +225,100 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 	}
 }
 
+// CompilePipelineResponse is the response of the pipeline compile endpoint.
+type CompilePipelineResponse struct {
+	PipelineRun interface{}        `json:"pipeline_run"`
+	Warnings    []template.Warning `json:"warnings,omitempty"`
+}
+
+// HTTP multipart endpoint for compiling a pipeline file without creating a pipeline.
+// The response contains the PipelineRun the server would store for the file, so CI
+// systems can validate compilation against the exact server version. In multi-user
+// mode, callers must be allowed to create pipelines in the namespace of the query.
+func (s *PipelineUploadServer) CompilePipeline(w http.ResponseWriter, r *http.Request) {
+	if s.options.CollectMetrics {
+		compilePipelineRequests.Inc()
+	}
+
+	log.Infof("Compile pipeline called")
+	namespace, err := GetPipelineNamespace(r.URL.Query().Get(NamespaceStringQuery))
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Invalid pipeline namespace."))
+		return
+	}
+	if common.IsMultiUserMode() && namespace == "" {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputError("Namespace is required to compile pipelines in multi-user mode"))
+		return
+	}
+	err = s.canUploadVersionedPipeline(r, namespace)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Authorization to namespace failed."))
+		return
+	}
+
+	file, header, err := r.FormFile(FormFileKey)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read pipeline from file"))
+		return
+	}
+	defer file.Close()
+
+	pipelineFile, err := template.ReadPipelineFile(header.Filename, file, common.GetMaxManifestSize())
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error read pipeline file."))
+		return
+	}
+
+	workflow, warnings, err := template.Compile(pipelineFile)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error compiling pipeline"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(CompilePipelineResponse{PipelineRun: workflow.PipelineRun, Warnings: warnings})
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error compiling pipeline"))
+		return
+	}
+}
+
+// readPipelineFile reads the pipeline file of the multipart form, or of the
+// complete resumable upload of the upload_id query parameter. It returns the
+// name of the file, its content, and the upload it was read from if any.
+func (s *PipelineUploadServer) readPipelineFile(r *http.Request) (string, []byte, *model.UploadSession, error) {
+	if uploadID := r.URL.Query().Get(UploadIDKey); uploadID != "" {
+		upload, content, err := s.resourceManager.ReadUpload(uploadID)
+		if err != nil {
+			return "", nil, nil, util.Wrap(err, "Failed to read pipeline from upload")
+		}
+		pipelineFile, err := template.ReadPipelineFile(upload.FileName, bytes.NewReader(content), common.GetMaxManifestSize())
+		return upload.FileName, pipelineFile, upload, err
+	}
+	file, header, err := r.FormFile(FormFileKey)
+	if err != nil {
+		return "", nil, nil, util.Wrap(err, "Failed to read pipeline from file")
+	}
+	defer file.Close()
+	pipelineFile, err := template.ReadPipelineFile(header.Filename, file, common.GetMaxManifestSize())
+	return header.Filename, pipelineFile, nil, err
+}
+
+// deleteUpload deletes the upload a pipeline was created from. An upload that
+// fails to be deleted expires later.
+func (s *PipelineUploadServer) deleteUpload(upload *model.UploadSession) {
+	if upload == nil {
+		return
+	}
+	if err := s.resourceManager.DeleteUpload(upload.UUID); err != nil {
+		log.Warningf("Failed to delete upload %v: %v", upload.UUID, err)
+	}
+}
+
 func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, namespace string) error {
 	if namespace == "" {
 		return nil
 	}
-	userIdentityHeader := r.Header.Get(common.GetKubeflowUserIDHeader())
 	resourceAttributes := &authorizationv1.ResourceAttributes{
 		Namespace: namespace,
 		Verb:      common.RbacResourceVerbCreate,
@@ -233,7 +326,7 @@ func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, names
 		Version:   common.RbacPipelinesVersion,
 		Resource:  common.RbacResourceTypePipelines,
 	}
-	err := s.resourceManager.IsRequestAuthorized(context.TODO(), userIdentityHeader, resourceAttributes)
+	err := isRequestAuthorized(s.resourceManager, r.Context(), resourceAttributes)
 	if err != nil {
 		return util.Wrap(err, "Authorization Failure.")
 	}
@@ -241,9 +334,9 @@ func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, names
 }
 
 func (s *PipelineUploadServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
-	glog.Errorf("Failed to upload pipelines. Error: %+v", err)
+	log.Errorf("Failed to upload pipelines. Error: %+v", err)
 	w.WriteHeader(code)
-	errorResponse := api.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)}
+	errorResponse := util.ToAPIError(err)
 	errBytes, err := json.Marshal(errorResponse)
 	if err != nil {
 		w.Write([]byte("Error uploading pipeline"))
@@ -23,11 +23,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -151,6 +153,102 @@ func TestUploadPipeline(t *testing.T) {
 	}
 }
 
+func TestCompilePipeline(t *testing.T) {
+	_, server := setupClientManagerAndServer()
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.yaml", "apiVersion: tekton.dev/v1beta1\nkind: PipelineRun\nmetadata:\n  name: hello\nspec:\n  pipelineSpec:\n    tasks:\n    - name: hello\n      taskSpec:\n        steps:\n        - name: main\n", writer)
+	response := uploadPipeline("/apis/v1/pipelines/compile",
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.CompilePipeline)
+	assert.Equal(t, 200, response.Code)
+
+	parsedResponse := struct {
+		PipelineRun struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		} `json:"pipeline_run"`
+		Warnings []struct {
+			Code string `json:"code"`
+		} `json:"warnings"`
+	}{}
+	assert.Nil(t, json.Unmarshal(response.Body.Bytes(), &parsedResponse))
+	assert.Equal(t, "tekton.dev/v1", parsedResponse.PipelineRun.APIVersion)
+	assert.Equal(t, "PipelineRun", parsedResponse.PipelineRun.Kind)
+	assert.Len(t, parsedResponse.Warnings, 1)
+	assert.Equal(t, "MISSING_IMAGE", parsedResponse.Warnings[0].Code)
+
+	// Nothing is stored.
+	opts, err := list.NewOptions(&model.Pipeline{}, 10, "", nil)
+	assert.Nil(t, err)
+	_, totalSize, _, err := server.resourceManager.ListPipelines(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, totalSize)
+}
+
+func TestCompilePipeline_InvalidTemplate(t *testing.T) {
+	_, server := setupClientManagerAndServer()
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.yaml", "apiVersion: argoproj.io/v1alpha1\nkind: Workflow", writer)
+	response := uploadPipeline("/apis/v1/pipelines/compile",
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.CompilePipeline)
+	assert.Equal(t, 400, response.Code)
+	assert.Contains(t, response.Body.String(), "Error compiling pipeline")
+}
+
+func TestCompilePipeline_V2(t *testing.T) {
+	_, server := setupClientManagerAndServer()
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.json", v2SpecHelloWorld, writer)
+	response := uploadPipeline("/apis/v1/pipelines/compile",
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.CompilePipeline)
+	assert.Equal(t, 200, response.Code)
+
+	parsedResponse := struct {
+		PipelineRun struct {
+			Kind string `json:"kind"`
+			Spec struct {
+				PipelineSpec struct {
+					Tasks []struct {
+						Name string `json:"name"`
+					} `json:"tasks"`
+				} `json:"pipelineSpec"`
+			} `json:"spec"`
+		} `json:"pipeline_run"`
+	}{}
+	assert.Nil(t, json.Unmarshal(response.Body.Bytes(), &parsedResponse))
+	assert.Equal(t, "PipelineRun", parsedResponse.PipelineRun.Kind)
+	assert.Len(t, parsedResponse.PipelineRun.Spec.PipelineSpec.Tasks, 1)
+	assert.Equal(t, "hello-world", parsedResponse.PipelineRun.Spec.PipelineSpec.Tasks[0].Name)
+}
+
+func TestCompilePipeline_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, server := setupClientManagerAndServer()
+	compile := func(url string) *httptest.ResponseRecorder {
+		bytesBuffer, writer := setupWriter("")
+		setWriterWithBuffer("uploadfile", "hello-world.json", v2SpecHelloWorld, writer)
+		req, _ := http.NewRequest("POST", url, bytes.NewReader(bytesBuffer.Bytes()))
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"user@google.com")
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(server.CompilePipeline).ServeHTTP(rr, withRequestMetadata(req))
+		return rr
+	}
+
+	response := compile("/apis/v1/pipelines/compile")
+	assert.Equal(t, 400, response.Code)
+	assert.Contains(t, response.Body.String(), "Namespace is required")
+
+	response = compile("/apis/v1/pipelines/compile?namespace=ns1")
+	assert.Equal(t, 200, response.Code)
+
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server = updateClientManager(clientManager, util.NewFakeUUIDGeneratorOrFatal(fakeVersionUUID, nil))
+	response = compile("/apis/v1/pipelines/compile?namespace=ns1")
+	assert.Equal(t, 400, response.Code)
+	assert.Contains(t, response.Body.String(), "Authorization to namespace failed")
+}
+
 func updateClientManager(clientManager *resource.FakeClientManager, uuid util.UUIDGeneratorInterface) PipelineUploadServer {
 	clientManager.UpdateUUID(uuid)
 	resourceManager := resource.NewResourceManager(clientManager)
@@ -0,0 +1,102 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	readinessStatusOK          = "ok"
+	readinessStatusUnavailable = "unavailable"
+)
+
+type ReadinessServer struct {
+	resourceManager *resource.ResourceManager
+	timeout         time.Duration
+}
+
+type ReadinessResponse struct {
+	Status       string               `json:"status"`
+	Dependencies []DependencyResponse `json:"dependencies"`
+}
+
+type DependencyResponse struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Readyz checks the dependencies of the API server, and responds with 503 Service
+// Unavailable if any of them is unreachable, so that no traffic is routed to the
+// API server until they are back. The status of every dependency is in the body.
+// It's served on the HTTP mux rather than through the gateway, so that kubelet
+// probes get the 503 and aren't subject to authentication or rate limiting. gRPC
+// clients probe the standard health service instead, which UpdateHealth sets.
+func (s *ReadinessServer) Readyz(w http.ResponseWriter, r *http.Request) {
+	response := &ReadinessResponse{Status: readinessStatusOK}
+	for _, dependency := range s.resourceManager.CheckDependencies(r.Context(), s.timeout) {
+		dependencyResponse := DependencyResponse{
+			Name:      dependency.Name,
+			Status:    readinessStatusOK,
+			LatencyMs: dependency.Latency.Milliseconds(),
+		}
+		if dependency.Err != nil {
+			log.Warnf("Readiness check of the %s failed. Error: %v", dependency.Name, dependency.Err)
+			dependencyResponse.Status = readinessStatusUnavailable
+			dependencyResponse.Error = dependency.Err.Error()
+			response.Status = readinessStatusUnavailable
+		}
+		response.Dependencies = append(response.Dependencies, dependencyResponse)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if response.Status != readinessStatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// UpdateHealth sets the gRPC health status of the services, and of the server as
+// a whole, to NOT_SERVING if any dependency of the API server is unreachable and
+// to SERVING otherwise. The status stays NOT_SERVING once the health server is
+// shut down. It returns whether all the dependencies are reachable.
+func (s *ReadinessServer) UpdateHealth(ctx context.Context, healthServer *health.Server, services []string) bool {
+	status := healthpb.HealthCheckResponse_SERVING
+	for _, dependency := range s.resourceManager.CheckDependencies(ctx, s.timeout) {
+		if dependency.Err != nil {
+			log.Warnf("Health check of the %s failed. Error: %v", dependency.Name, dependency.Err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	healthServer.SetServingStatus("", status)
+	for _, service := range services {
+		healthServer.SetServingStatus(service, status)
+	}
+	return status == healthpb.HealthCheckResponse_SERVING
+}
+
+func NewReadinessServer(resourceManager *resource.ResourceManager, timeout time.Duration) *ReadinessServer {
+	return &ReadinessServer{resourceManager: resourceManager, timeout: timeout}
+}
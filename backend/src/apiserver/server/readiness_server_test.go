@@ -0,0 +1,108 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestReadyz(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	server := NewReadinessServer(resource.NewResourceManager(clientManager), time.Second)
+
+	req, _ := http.NewRequest("GET", "/apis/v1/readyz", nil)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(server.Readyz).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var response ReadinessResponse
+	assert.Nil(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(t, "ok", response.Status)
+	assert.Len(t, response.Dependencies, 3)
+	for i, name := range []string{resource.DependencyDatabase, resource.DependencyObjectStore, resource.DependencyKubernetes} {
+		assert.Equal(t, name, response.Dependencies[i].Name)
+		assert.Equal(t, "ok", response.Dependencies[i].Status)
+		assert.Empty(t, response.Dependencies[i].Error)
+	}
+}
+
+func TestReadyz_DatabaseUnavailable(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	server := NewReadinessServer(resource.NewResourceManager(clientManager), time.Second)
+	clientManager.Close()
+
+	req, _ := http.NewRequest("GET", "/apis/v1/readyz", nil)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(server.Readyz).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	var response ReadinessResponse
+	assert.Nil(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(t, "unavailable", response.Status)
+	assert.Equal(t, resource.DependencyDatabase, response.Dependencies[0].Name)
+	assert.Equal(t, "unavailable", response.Dependencies[0].Status)
+	assert.Contains(t, response.Dependencies[0].Error, "Failed to reach the database")
+	assert.Equal(t, "ok", response.Dependencies[1].Status)
+}
+
+func TestUpdateHealth(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	server := NewReadinessServer(resource.NewResourceManager(clientManager), time.Second)
+	healthServer := health.NewServer()
+	services := []string{"v1.RunService"}
+	healthStatus := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.Nil(t, err)
+		return response.Status
+	}
+
+	assert.True(t, server.UpdateHealth(context.Background(), healthServer, services))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus("v1.RunService"))
+
+	// The services aren't served while the database is unreachable.
+	clientManager.Close()
+	assert.False(t, server.UpdateHealth(context.Background(), healthServer, services))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, healthStatus(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, healthStatus("v1.RunService"))
+}
+
+func TestUpdateHealth_Shutdown(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	server := NewReadinessServer(resource.NewResourceManager(clientManager), time.Second)
+	healthServer := health.NewServer()
+	services := []string{"v1.RunService"}
+	assert.True(t, server.UpdateHealth(context.Background(), healthServer, services))
+
+	// The services stay unserved once the server shuts down.
+	healthServer.Shutdown()
+	server.UpdateHealth(context.Background(), healthServer, services)
+	response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "v1.RunService"})
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, response.Status)
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// RecurringRunServer serves the v2beta1 RecurringRunService of the KFP v2 SDK. The
+// recurring runs are the v1 jobs, and the calls are passed to the job server.
+type RecurringRunServer struct {
+	jobServer *JobServer
+}
+
+func (s *RecurringRunServer) CreateRecurringRun(ctx context.Context, request *apiv2beta1.CreateRecurringRunRequest) (*apiv2beta1.RecurringRun, error) {
+	if request.GetRecurringRun() == nil {
+		return nil, util.NewInvalidInputError("Recurring run is empty. Please specify a valid recurring run.")
+	}
+	job, err := toApiJob(request.GetRecurringRun())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to convert the recurring run.")
+	}
+	newJob, err := s.jobServer.CreateJob(ctx, &api.CreateJobRequest{Job: job})
+	if err != nil {
+		return nil, err
+	}
+	return ToApiRecurringRun(newJob), nil
+}
+
+func (s *RecurringRunServer) GetRecurringRun(ctx context.Context, request *apiv2beta1.GetRecurringRunRequest) (*apiv2beta1.RecurringRun, error) {
+	job, err := s.jobServer.GetJob(ctx, &api.GetJobRequest{Id: request.GetRecurringRunId()})
+	if err != nil {
+		return nil, err
+	}
+	return ToApiRecurringRun(job), nil
+}
+
+// ListRecurringRuns lists the recurring runs of an experiment or, without one, of
+// a namespace.
+func (s *RecurringRunServer) ListRecurringRuns(ctx context.Context, request *apiv2beta1.ListRecurringRunsRequest) (*apiv2beta1.ListRecurringRunsResponse, error) {
+	var resourceReferenceKey *api.ResourceKey
+	if request.GetExperimentId() != "" {
+		resourceReferenceKey = &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: request.GetExperimentId()}
+	} else if request.GetNamespace() != "" {
+		resourceReferenceKey = &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: request.GetNamespace()}
+	}
+	response, err := s.jobServer.ListJobs(ctx, &api.ListJobsRequest{
+		PageToken:            request.GetPageToken(),
+		PageSize:             request.GetPageSize(),
+		SortBy:               request.GetSortBy(),
+		Filter:               request.GetFilter(),
+		ResourceReferenceKey: resourceReferenceKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2beta1.ListRecurringRunsResponse{
+		RecurringRuns: ToApiRecurringRuns(response.GetJobs()),
+		TotalSize:     response.GetTotalSize(),
+		NextPageToken: response.GetNextPageToken(),
+	}, nil
+}
+
+func (s *RecurringRunServer) EnableRecurringRun(ctx context.Context, request *apiv2beta1.EnableRecurringRunRequest) (*empty.Empty, error) {
+	return s.jobServer.EnableJob(ctx, &api.EnableJobRequest{Id: request.GetRecurringRunId()})
+}
+
+func (s *RecurringRunServer) DisableRecurringRun(ctx context.Context, request *apiv2beta1.DisableRecurringRunRequest) (*empty.Empty, error) {
+	return s.jobServer.DisableJob(ctx, &api.DisableJobRequest{Id: request.GetRecurringRunId()})
+}
+
+func (s *RecurringRunServer) DeleteRecurringRun(ctx context.Context, request *apiv2beta1.DeleteRecurringRunRequest) (*empty.Empty, error) {
+	return s.jobServer.DeleteJob(ctx, &api.DeleteJobRequest{Id: request.GetRecurringRunId()})
+}
+
+func NewRecurringRunServer(resourceManager *resource.ResourceManager, options *JobServerOptions) *RecurringRunServer {
+	return &RecurringRunServer{jobServer: NewJobServer(resourceManager, options)}
+}
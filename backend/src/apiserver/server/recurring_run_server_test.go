@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringRunServer_CreateGetListAndDisable(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRecurringRunServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	createdRecurringRun, err := server.CreateRecurringRun(context.Background(), &apiv2beta1.CreateRecurringRunRequest{
+		RecurringRun: &apiv2beta1.RecurringRun{
+			ExperimentId:   experiment.UUID,
+			DisplayName:    "recurring1",
+			MaxConcurrency: 1,
+			Mode:           apiv2beta1.RecurringRun_ENABLE,
+			Trigger: &apiv2beta1.Trigger{Trigger: &apiv2beta1.Trigger_CronSchedule{CronSchedule: &apiv2beta1.CronSchedule{
+				Cron: "1 * * * *",
+			}}},
+			PipelineSource: &apiv2beta1.RecurringRun_PipelineSpec{PipelineSpec: v2SpecHelloWorldStruct(t)},
+		},
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, createdRecurringRun.GetRecurringRunId())
+	assert.Equal(t, "recurring1", createdRecurringRun.GetDisplayName())
+	assert.Equal(t, experiment.UUID, createdRecurringRun.GetExperimentId())
+	assert.Equal(t, apiv2beta1.RecurringRun_ENABLED, createdRecurringRun.GetStatus())
+	assert.Equal(t, "1 * * * *", createdRecurringRun.GetTrigger().GetCronSchedule().GetCron())
+
+	listed, err := server.ListRecurringRuns(context.Background(), &apiv2beta1.ListRecurringRunsRequest{ExperimentId: experiment.UUID})
+	require.Nil(t, err)
+	require.Len(t, listed.GetRecurringRuns(), 1)
+	assert.Equal(t, createdRecurringRun.GetRecurringRunId(), listed.GetRecurringRuns()[0].GetRecurringRunId())
+
+	_, err = server.DisableRecurringRun(context.Background(), &apiv2beta1.DisableRecurringRunRequest{
+		RecurringRunId: createdRecurringRun.GetRecurringRunId(),
+	})
+	require.Nil(t, err)
+
+	recurringRun, err := server.GetRecurringRun(context.Background(), &apiv2beta1.GetRecurringRunRequest{
+		RecurringRunId: createdRecurringRun.GetRecurringRunId(),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, apiv2beta1.RecurringRun_DISABLE, recurringRun.GetMode())
+	assert.Equal(t, apiv2beta1.RecurringRun_DISABLED, recurringRun.GetStatus())
+}
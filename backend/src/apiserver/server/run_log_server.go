@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/golang/glog"
 	"github.com/gorilla/mux"
-	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
 // These are valid conditions of a ScheduledWorkflow.
@@ -41,7 +43,7 @@ type RunLogServer struct {
 // Log streaming endpoint
 // This endpoint is not exposed through grpc endpoint, since grpc-gateway cannot handle native HTTP content streaming.
 func (s *RunLogServer) ReadRunLog(w http.ResponseWriter, r *http.Request) {
-	glog.Infof("Read run log called")
+	log.Infof("Read run log called")
 
 	vars := mux.Vars(r)
 
@@ -59,6 +61,11 @@ func (s *RunLogServer) ReadRunLog(w http.ResponseWriter, r *http.Request) {
 
 	follow := vars[Follow] == "true" // defaults to false
 
+	if err := s.canReadRunLog(r, runId); err != nil {
+		s.writeErrorToResponse(w, http.StatusForbidden, util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "text/plain")
 	w.Header().Set("Cache-Control", "no-cache, private")
@@ -69,10 +76,30 @@ func (s *RunLogServer) ReadRunLog(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// canReadRunLog authorizes reading the logs of a run in multi-user mode.
+func (s *RunLogServer) canReadRunLog(r *http.Request, runId string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	run, err := s.resourceManager.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to get the run")
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: run.Namespace,
+		Verb:      common.RbacResourceVerbReadLog,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      run.Name,
+	}
+	return isRequestAuthorized(s.resourceManager, r.Context(), resourceAttributes)
+}
+
 func (s *RunLogServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
-	glog.Errorf("Failed to read run log. Error: %+v", err)
+	log.Errorf("Failed to read run log. Error: %+v", err)
 	w.WriteHeader(code)
-	errorResponse := api.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)}
+	errorResponse := util.ToAPIError(err)
 	errBytes, err := json.Marshal(errorResponse)
 	if err != nil {
 		w.Write([]byte("Error reading run log"))
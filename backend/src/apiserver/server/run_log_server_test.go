@@ -0,0 +1,74 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const runLogPath = "/apis/v1/runs/{run_id}/nodes/{node_id}/log"
+
+func newRunLogRequest() *http.Request {
+	req, _ := http.NewRequest("GET", "/apis/v1/runs/run1/nodes/node/log", nil)
+	req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"user@google.com")
+	return withRequestMetadata(req)
+}
+
+func TestCanReadRunLog(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	server := NewRunLogServer(resource.NewResourceManager(clientManager))
+
+	assert.Nil(t, server.canReadRunLog(newRunLogRequest(), "run1"))
+}
+
+func TestCanReadRunLog_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewRunLogServer(resource.NewResourceManager(clientManager))
+
+	err := server.canReadRunLog(newRunLogRequest(), "run1")
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      common.RbacResourceVerbReadLog,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      "run-1",
+	}
+	assert.EqualError(t, err, getPermissionDeniedError("user@google.com", resourceAttributes).Error())
+
+	// The logs aren't read when the request is denied.
+	router := mux.NewRouter()
+	router.HandleFunc(runLogPath, server.ReadRunLog)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, newRunLogRequest())
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -17,9 +17,9 @@ package server
 import (
 	"regexp"
 
-	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 )
 
@@ -80,7 +80,7 @@ func NewReportRunMetricResult(
 	}
 	result.Message = userError.ExternalMessage()
 	if result.Status == api.ReportRunMetricsResponse_ReportRunMetricResult_INTERNAL_ERROR {
-		glog.Errorf("Internal error '%v' when reporting metric '%s/%s'", err, nodeID, metricName)
+		log.Errorf("Internal error '%v' when reporting metric '%s/%s'", err, nodeID, metricName)
 	}
 	return result
 }
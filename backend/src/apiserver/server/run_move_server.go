@@ -0,0 +1,71 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// MoveRun moves a run to another experiment.
+func (s *RunServer) MoveRun(ctx context.Context, request *api.MoveRunRequest) (*api.MoveRunsResponse, error) {
+	if request.ExperimentId == "" {
+		return nil, util.NewInvalidInputError("The experiment_id is empty.")
+	}
+	err := s.canAccessRun(ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	if err := s.resourceManager.MoveRun(request.RunId, request.ExperimentId); err != nil {
+		return nil, err
+	}
+	log.Infof("Run %s is moved to experiment %s", request.RunId, request.ExperimentId)
+	return &api.MoveRunsResponse{ExperimentId: request.ExperimentId, MovedRuns: 1}, nil
+}
+
+// MoveExperimentRuns moves the runs of an experiment matching the filter to
+// another experiment at once, e.g. {"experiment_id": "...", "filter":
+// "{\"predicates\": [{\"key\": \"name\", \"op\": \"IS_SUBSTRING\", \"string_value\": \"tuning\"}]}"}.
+func (s *RunServer) MoveExperimentRuns(ctx context.Context, request *api.MoveExperimentRunsRequest) (*api.MoveRunsResponse, error) {
+	if request.ExperimentId == "" {
+		return nil, util.NewInvalidInputError("The experiment_id is empty.")
+	}
+	filter, err := parseAPIFilter(request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	experiment, err := s.resourceManager.GetExperiment(request.SourceExperimentId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.canAccessRun(ctx, "", &authorizationv1.ResourceAttributes{
+		Namespace: experiment.Namespace,
+		Verb:      common.RbacResourceVerbUpdate,
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	moved, err := s.resourceManager.MoveExperimentRuns(request.SourceExperimentId, filter, request.ExperimentId)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("%d runs of experiment %s are moved to experiment %s", moved, request.SourceExperimentId, request.ExperimentId)
+	return &api.MoveRunsResponse{ExperimentId: request.ExperimentId, MovedRuns: int32(moved)}, nil
+}
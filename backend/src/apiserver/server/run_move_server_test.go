@@ -0,0 +1,63 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMoveRuns(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	_, err := resourceManager.CreateRun(context.Background(), &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			Relationship: api.Relationship_OWNER,
+		}},
+	})
+	assert.Nil(t, err)
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(resource.FakeUUIDOne, nil))
+	resourceManager = resource.NewResourceManager(clientManager)
+	_, err = resourceManager.CreateExperiment(&api.Experiment{Name: "exp2"})
+	assert.Nil(t, err)
+	server := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+
+	response, err := server.MoveRun(nil, &api.MoveRunRequest{RunId: resource.DefaultFakeUUID, ExperimentId: resource.FakeUUIDOne})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.MoveRunsResponse{ExperimentId: resource.FakeUUIDOne, MovedRuns: 1}, response)
+
+	response, err = server.MoveExperimentRuns(nil, &api.MoveExperimentRunsRequest{
+		SourceExperimentId: resource.FakeUUIDOne,
+		ExperimentId:       experiment.UUID,
+		Filter:             `{"predicates": [{"key": "name", "op": "EQUALS", "string_value": "run1"}]}`,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.MoveRunsResponse{ExperimentId: experiment.UUID, MovedRuns: 1}, response)
+
+	_, err = server.MoveRun(nil, &api.MoveRunRequest{RunId: resource.DefaultFakeUUID})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	_, err = server.MoveRun(nil, &api.MoveRunRequest{RunId: "unknown", ExperimentId: experiment.UUID})
+	AssertUserError(t, err, codes.NotFound)
+}
@@ -110,16 +110,9 @@ func (s *RunServer) CreateRun(ctx context.Context, request *api.CreateRunRequest
 	}
 
 	if common.IsMultiUserMode() {
-		experimentID := common.GetExperimentIDFromAPIResourceReferences(request.Run.ResourceReferences)
-		if experimentID == "" {
-			return nil, util.NewInvalidInputError("Job has no experiment.")
-		}
-		namespace, err := s.resourceManager.GetNamespaceFromExperimentID(experimentID)
+		namespace, err := getNamespaceOfNewResource(s.resourceManager, request.Run.ResourceReferences)
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to get experiment for job.")
-		}
-		if namespace == "" {
-			return nil, util.NewInvalidInputError("Job's experiment has no namespace.")
+			return nil, err
 		}
 		resourceAttributes := &authorizationv1.ResourceAttributes{
 			Namespace: namespace,
@@ -132,6 +125,14 @@ func (s *RunServer) CreateRun(ctx context.Context, request *api.CreateRunRequest
 		}
 	}
 
+	if request.DryRun {
+		run, err := s.resourceManager.DryRunCreateRun(ctx, request.Run)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to dry run the creation of a run.")
+		}
+		return ToApiRunDetail(run), nil
+	}
+
 	run, err := s.resourceManager.CreateRun(ctx, request.Run)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create a new run.")
@@ -157,7 +158,7 @@ func (s *RunServer) GetRun(ctx context.Context, request *api.GetRunRequest) (*ap
 	if err != nil {
 		return nil, err
 	}
-	return ToApiRunDetail(run), nil
+	return applyRunDetailView(ToApiRunDetail(run), request.View), nil
 }
 
 func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest) (*api.ListRunsResponse, error) {
@@ -216,11 +217,16 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 		}
 	}
 
+	filterContext.IncludeRunArchive = request.IncludeRunArchive
 	runs, total_size, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to list runs.")
 	}
-	return &api.ListRunsResponse{Runs: ToApiRuns(runs), TotalSize: int32(total_size), NextPageToken: nextPageToken}, nil
+	apiRuns := ToApiRuns(runs)
+	for _, run := range apiRuns {
+		applyRunView(run, request.View)
+	}
+	return &api.ListRunsResponse{Runs: apiRuns, TotalSize: int32(total_size), NextPageToken: nextPageToken}, nil
 }
 
 func (s *RunServer) ArchiveRun(ctx context.Context, request *api.ArchiveRunRequest) (*empty.Empty, error) {
@@ -275,11 +281,30 @@ func (s *RunServer) DeleteRun(ctx context.Context, request *api.DeleteRunRequest
 	return &empty.Empty{}, nil
 }
 
+func (s *RunServer) UndeleteRun(ctx context.Context, request *api.UndeleteRunRequest) (*empty.Empty, error) {
+	err := canUndelete(s.resourceManager, ctx, common.RbacResourceTypeRuns)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	err = s.resourceManager.Undelete(common.Run, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.options.CollectMetrics {
+		runCount.Inc()
+	}
+	return &empty.Empty{}, nil
+}
+
 func (s *RunServer) ReportRunMetrics(ctx context.Context, request *api.ReportRunMetricsRequest) (*api.ReportRunMetricsResponse, error) {
 	if s.options.CollectMetrics {
 		reportRunMetricsRequests.Inc()
 	}
 
+	// Metrics are reported by the persistence agent, which doesn't send a user
+	// identity, so like the report server this isn't authorized per user.
 	// Makes sure run exists
 	_, err := s.resourceManager.GetRun(request.GetRunId())
 	if err != nil {
@@ -305,6 +330,11 @@ func (s *RunServer) ReadArtifact(ctx context.Context, request *api.ReadArtifactR
 		readArtifactRequests.Inc()
 	}
 
+	err := s.canAccessRun(ctx, request.GetRunId(), &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbReadArtifact})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
 	content, err := s.resourceManager.ReadArtifact(
 		request.GetRunId(), request.GetNodeId(), request.GetArtifactName())
 	if err != nil {
@@ -318,7 +348,8 @@ func (s *RunServer) ReadArtifact(ctx context.Context, request *api.ReadArtifactR
 func (s *RunServer) validateCreateRunRequest(request *api.CreateRunRequest) error {
 	run := request.Run
 	if run.Name == "" {
-		return util.NewInvalidInputError("The run name is empty. Please specify a valid name.")
+		return util.NewInvalidInputError("The run name is empty. Please specify a valid name.").
+			WithFieldViolation("run.name", "The name must not be empty.")
 	}
 	return ValidatePipelineSpecAndResourceReferences(s.resourceManager, run.PipelineSpec, run.ResourceReferences)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -38,6 +39,28 @@ func TestCreateRun(t *testing.T) {
 	expectedRuntimeWorkflow.Spec.TaskRunTemplate.ServiceAccountName = "pipeline-runner"
 }
 
+func TestCreateRun_DryRun(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	run := &api.Run{
+		Name:               "run1",
+		ResourceReferences: validReference,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+	}
+	runDetail, err := server.CreateRun(nil, &api.CreateRunRequest{Run: run, DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "run1", runDetail.Run.Name)
+	assert.Contains(t, runDetail.PipelineRuntime.WorkflowManifest, util.LabelKeyWorkflowRunId)
+	assert.Equal(t, 0, clients.TektonClientFake.GetWorkflowCount())
+
+	listed, err := server.ListRuns(nil, &api.ListRunsRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, listed.Runs)
+}
+
 func TestCreateRunPatch(t *testing.T) {
 	clients, manager, _ := initWithExperiment(t)
 	defer clients.Close()
@@ -140,6 +163,38 @@ func TestListRun(t *testing.T) {
 
 }
 
+func TestGetRunAndListRuns_BasicView(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	run := &api.Run{
+		Name:               "run1",
+		ResourceReferences: validReference,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+	}
+	createdRun, err := server.CreateRun(nil, &api.CreateRunRequest{Run: run})
+	assert.Nil(t, err)
+
+	fullRun, err := server.GetRun(nil, &api.GetRunRequest{RunId: createdRun.Run.Id})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, fullRun.Run.PipelineSpec.WorkflowManifest)
+	assert.NotNil(t, fullRun.PipelineRuntime)
+
+	basicRun, err := server.GetRun(nil, &api.GetRunRequest{RunId: createdRun.Run.Id, View: api.Run_BASIC})
+	assert.Nil(t, err)
+	assert.Equal(t, "run1", basicRun.Run.Name)
+	assert.Empty(t, basicRun.Run.PipelineSpec.WorkflowManifest)
+	assert.Nil(t, basicRun.PipelineRuntime)
+
+	listed, err := server.ListRuns(nil, &api.ListRunsRequest{View: api.Run_BASIC})
+	assert.Nil(t, err)
+	assert.Len(t, listed.Runs, 1)
+	assert.Equal(t, createdRun.Run.Id, listed.Runs[0].Id)
+	assert.Empty(t, listed.Runs[0].PipelineSpec.WorkflowManifest)
+}
+
 func TestListRuns_Unauthorized(t *testing.T) {
 	viper.Set(common.MultiUserMode, "true")
 	defer viper.Set(common.MultiUserMode, "false")
@@ -433,3 +488,48 @@ func TestReportRunMetrics_PartialFailures(t *testing.T) {
 }
 
 // Removed tests with old auth spec: "TestCanAccessRun_Unauthorized", "TestCanAccessRun_Authorized", "TestCanAccessRun_Unauthenticated"
+
+func TestReadArtifact_Multiuser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	addArtifact(t, clientManager, "metrics.json", `{"accuracy": 0.9}`)
+	server := NewRunServer(resource.NewResourceManager(clientManager), &RunServerOptions{CollectMetrics: false})
+
+	response, err := server.ReadArtifact(ctx, &api.ReadArtifactRequest{RunId: "run1", NodeId: "node", ArtifactName: "output"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, response.GetData())
+}
+
+func TestReadArtifact_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	addArtifact(t, clientManager, "metrics.json", `{"accuracy": 0.9}`)
+	server := NewRunServer(resource.NewResourceManager(clientManager), &RunServerOptions{CollectMetrics: false})
+
+	_, err := server.ReadArtifact(ctx, &api.ReadArtifactRequest{RunId: "run1", NodeId: "node", ArtifactName: "output"})
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      common.RbacResourceVerbReadArtifact,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      "run-1",
+	}
+	assert.EqualError(
+		t,
+		err,
+		wrapFailedAuthzRequestError(wrapFailedAuthzApiResourcesError(getPermissionDeniedError(userIdentity, resourceAttributes))).Error(),
+	)
+}
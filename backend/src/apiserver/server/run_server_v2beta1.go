@@ -0,0 +1,116 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// RunServerV2beta1 serves the v2beta1 RunService of the KFP v2 SDK. The calls are
+// passed to the v1 run server, so the runs are validated and authorized the same
+// way.
+type RunServerV2beta1 struct {
+	runServer *RunServer
+}
+
+func (s *RunServerV2beta1) CreateRun(ctx context.Context, request *apiv2beta1.CreateRunRequest) (*apiv2beta1.Run, error) {
+	if request.GetRun() == nil {
+		return nil, util.NewInvalidInputError("Run is empty. Please specify a valid run.")
+	}
+	run, err := toApiRunV1(request.GetRun())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to convert the run.")
+	}
+	runDetail, err := s.runServer.CreateRun(ctx, &api.CreateRunRequest{Run: run})
+	if err != nil {
+		return nil, err
+	}
+	return ToApiRunV2beta1(runDetail.GetRun()), nil
+}
+
+func (s *RunServerV2beta1) GetRun(ctx context.Context, request *apiv2beta1.GetRunRequest) (*apiv2beta1.Run, error) {
+	runDetail, err := s.runServer.GetRun(ctx, &api.GetRunRequest{RunId: request.GetRunId()})
+	if err != nil {
+		return nil, err
+	}
+	return ToApiRunV2beta1(runDetail.GetRun()), nil
+}
+
+// ListRuns lists the runs of an experiment or, without one, of a namespace.
+func (s *RunServerV2beta1) ListRuns(ctx context.Context, request *apiv2beta1.ListRunsRequest) (*apiv2beta1.ListRunsResponse, error) {
+	var resourceReferenceKey *api.ResourceKey
+	if request.GetExperimentId() != "" {
+		resourceReferenceKey = &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: request.GetExperimentId()}
+	} else if request.GetNamespace() != "" {
+		resourceReferenceKey = &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: request.GetNamespace()}
+	}
+	response, err := s.runServer.ListRuns(ctx, &api.ListRunsRequest{
+		PageToken:            request.GetPageToken(),
+		PageSize:             request.GetPageSize(),
+		SortBy:               request.GetSortBy(),
+		Filter:               request.GetFilter(),
+		ResourceReferenceKey: resourceReferenceKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2beta1.ListRunsResponse{
+		Runs:          ToApiRunsV2beta1(response.GetRuns()),
+		TotalSize:     response.GetTotalSize(),
+		NextPageToken: response.GetNextPageToken(),
+	}, nil
+}
+
+func (s *RunServerV2beta1) ArchiveRun(ctx context.Context, request *apiv2beta1.ArchiveRunRequest) (*empty.Empty, error) {
+	return s.runServer.ArchiveRun(ctx, &api.ArchiveRunRequest{Id: request.GetRunId()})
+}
+
+func (s *RunServerV2beta1) UnarchiveRun(ctx context.Context, request *apiv2beta1.UnarchiveRunRequest) (*empty.Empty, error) {
+	return s.runServer.UnarchiveRun(ctx, &api.UnarchiveRunRequest{Id: request.GetRunId()})
+}
+
+func (s *RunServerV2beta1) DeleteRun(ctx context.Context, request *apiv2beta1.DeleteRunRequest) (*empty.Empty, error) {
+	return s.runServer.DeleteRun(ctx, &api.DeleteRunRequest{Id: request.GetRunId()})
+}
+
+func (s *RunServerV2beta1) ReadArtifact(ctx context.Context, request *apiv2beta1.ReadArtifactRequest) (*apiv2beta1.ReadArtifactResponse, error) {
+	response, err := s.runServer.ReadArtifact(ctx, &api.ReadArtifactRequest{
+		RunId:        request.GetRunId(),
+		NodeId:       request.GetNodeId(),
+		ArtifactName: request.GetArtifactName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2beta1.ReadArtifactResponse{Data: response.GetData()}, nil
+}
+
+func (s *RunServerV2beta1) TerminateRun(ctx context.Context, request *apiv2beta1.TerminateRunRequest) (*empty.Empty, error) {
+	return s.runServer.TerminateRun(ctx, &api.TerminateRunRequest{RunId: request.GetRunId()})
+}
+
+func (s *RunServerV2beta1) RetryRun(ctx context.Context, request *apiv2beta1.RetryRunRequest) (*empty.Empty, error) {
+	return s.runServer.RetryRun(ctx, &api.RetryRunRequest{RunId: request.GetRunId()})
+}
+
+func NewRunServerV2beta1(resourceManager *resource.ResourceManager, options *RunServerOptions) *RunServerV2beta1 {
+	return &RunServerV2beta1{runServer: NewRunServer(resourceManager, options)}
+}
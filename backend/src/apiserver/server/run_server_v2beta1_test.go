@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	apiv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func v2SpecHelloWorldStruct(t *testing.T) *structpb.Struct {
+	pipelineSpec := &structpb.Struct{}
+	require.Nil(t, jsonpb.UnmarshalString(v2SpecHelloWorld, pipelineSpec))
+	return pipelineSpec
+}
+
+func TestRunServerV2beta1_CreateGetAndListRuns(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServerV2beta1(manager, &RunServerOptions{CollectMetrics: false})
+
+	createdRun, err := server.CreateRun(context.Background(), &apiv2beta1.CreateRunRequest{Run: &apiv2beta1.Run{
+		ExperimentId:   experiment.UUID,
+		DisplayName:    "run1",
+		PipelineSource: &apiv2beta1.Run_PipelineSpec{PipelineSpec: v2SpecHelloWorldStruct(t)},
+		RuntimeConfig: &apiv2beta1.RuntimeConfig{
+			Parameters: map[string]*structpb.Value{"text": structpb.NewStringValue("hello")},
+		},
+	}})
+	require.Nil(t, err)
+	assert.NotEmpty(t, createdRun.GetRunId())
+	assert.Equal(t, "run1", createdRun.GetDisplayName())
+	assert.Equal(t, experiment.UUID, createdRun.GetExperimentId())
+	assert.Equal(t, "hello", createdRun.GetRuntimeConfig().GetParameters()["text"].GetStringValue())
+	assert.Equal(t, "hello-world",
+		createdRun.GetPipelineSpec().GetFields()["pipelineInfo"].GetStructValue().GetFields()["name"].GetStringValue())
+
+	run, err := server.GetRun(context.Background(), &apiv2beta1.GetRunRequest{RunId: createdRun.GetRunId()})
+	require.Nil(t, err)
+	assert.Equal(t, createdRun.GetRunId(), run.GetRunId())
+	assert.Equal(t, apiv2beta1.Run_AVAILABLE, run.GetStorageState())
+
+	listed, err := server.ListRuns(context.Background(), &apiv2beta1.ListRunsRequest{ExperimentId: experiment.UUID})
+	require.Nil(t, err)
+	assert.Equal(t, int32(1), listed.GetTotalSize())
+	require.Len(t, listed.GetRuns(), 1)
+	assert.Equal(t, createdRun.GetRunId(), listed.GetRuns()[0].GetRunId())
+}
+
+func TestRunServerV2beta1_ArchiveRun(t *testing.T) {
+	clients, manager, runDetail := initWithOneTimeRun(t)
+	defer clients.Close()
+	server := NewRunServerV2beta1(manager, &RunServerOptions{CollectMetrics: false})
+
+	_, err := server.ArchiveRun(context.Background(), &apiv2beta1.ArchiveRunRequest{RunId: runDetail.UUID})
+	require.Nil(t, err)
+
+	run, err := server.GetRun(context.Background(), &apiv2beta1.GetRunRequest{RunId: runDetail.UUID})
+	require.Nil(t, err)
+	assert.Equal(t, apiv2beta1.Run_ARCHIVED, run.GetStorageState())
+}
+
+func TestRunServerV2beta1_CreateRun_NoRun(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServerV2beta1(manager, &RunServerOptions{CollectMetrics: false})
+
+	_, err := server.CreateRun(context.Background(), &apiv2beta1.CreateRunRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
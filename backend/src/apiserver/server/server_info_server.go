@@ -0,0 +1,50 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+)
+
+type ServerInfoServer struct{}
+
+// GetServerInfo returns the version of the API server and the state of its
+// features, so that the UI and the SDK can adapt to what the server supports.
+// The call isn't authorized, so that it's served to the unauthenticated clients
+// too.
+func (s *ServerInfoServer) GetServerInfo(ctx context.Context, request *empty.Empty) (*api.ServerInfo, error) {
+	features := make([]*api.ServerFeature, 0)
+	for _, feature := range common.ListFeatures() {
+		features = append(features, &api.ServerFeature{
+			Name:        string(feature.Name),
+			Enabled:     feature.Enabled,
+			Description: feature.Description,
+		})
+	}
+	return &api.ServerInfo{
+		CommitSha: common.GetStringConfigWithDefault("COMMIT_SHA", "unknown"),
+		TagName:   common.GetStringConfigWithDefault("TAG_NAME", "unknown"),
+		MultiUser: common.IsMultiUserMode(),
+		Features:  features,
+	}, nil
+}
+
+func NewServerInfoServer() *ServerInfoServer {
+	return &ServerInfoServer{}
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServerInfo(t *testing.T) {
+	viper.Set("TAG_NAME", "1.8.0")
+	defer viper.Set("TAG_NAME", nil)
+	viper.Set(common.FeaturesConfig+"."+string(common.FeatureArtifactProxy), "false")
+	defer viper.Set(common.FeaturesConfig+"."+string(common.FeatureArtifactProxy), nil)
+	server := NewServerInfoServer()
+
+	response, err := server.GetServerInfo(context.Background(), &empty.Empty{})
+	assert.Nil(t, err)
+	assert.Equal(t, "unknown", response.CommitSha)
+	assert.Equal(t, "1.8.0", response.TagName)
+	assert.False(t, response.MultiUser)
+	enabled := map[common.Feature]bool{}
+	for _, feature := range response.Features {
+		enabled[common.Feature(feature.Name)] = feature.Enabled
+	}
+	assert.Equal(t, map[common.Feature]bool{
+		common.FeatureArtifactProxy: false,
+		common.FeatureCaching:       true,
+		common.FeatureV2Pipelines:   true,
+	}, enabled)
+}
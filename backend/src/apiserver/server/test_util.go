@@ -16,9 +16,11 @@ package server
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
@@ -204,6 +206,12 @@ func initWithPipeline(t *testing.T) (*resource.FakeClientManager, *resource.Reso
 	return store, manager, p
 }
 
+// withRequestMetadata passes the headers of a request to its handler as incoming
+// gRPC metadata, like the authentication middleware of the HTTP server does.
+func withRequestMetadata(req *http.Request) *http.Request {
+	return req.WithContext(auth.NewIncomingContext(req))
+}
+
 func AssertUserError(t *testing.T, err error, expectedCode codes.Code) {
 	userError, ok := err.(*util.UserError)
 	assert.True(t, ok)
@@ -0,0 +1,197 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const (
+	UploadIDKey          = "upload_id"
+	OffsetQueryStringKey = "offset"
+)
+
+type UploadServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// CreateUpload starts a resumable upload of a pipeline package of a namespace,
+// or of an artifact of a run. The file is then sent in chunks to UploadChunk, and
+// used by the pipeline upload endpoints or CompleteArtifactUpload.
+func (s *UploadServer) CreateUpload(ctx context.Context, request *api.CreateUploadRequest) (*api.Upload, error) {
+	upload := request.Upload
+	if upload == nil {
+		return nil, util.NewInvalidInputError("Upload is empty. Please specify a valid upload")
+	}
+	if err := s.canUpload(ctx, upload.Namespace, upload.RunId); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	session, err := s.resourceManager.CreateUpload(upload.Namespace, upload.RunId, upload.FileName, upload.Size)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create the upload")
+	}
+	return ToApiUpload(session), nil
+}
+
+// GetUpload returns the size uploaded so far, which a client resumes from.
+func (s *UploadServer) GetUpload(ctx context.Context, request *api.GetUploadRequest) (*api.Upload, error) {
+	session, err := s.getAuthorizedUpload(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return ToApiUpload(session), nil
+}
+
+// DeleteUpload abandons an upload.
+func (s *UploadServer) DeleteUpload(ctx context.Context, request *api.DeleteUploadRequest) (*empty.Empty, error) {
+	session, err := s.getAuthorizedUpload(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resourceManager.DeleteUpload(session.UUID); err != nil {
+		return nil, util.Wrap(err, "Failed to delete the upload")
+	}
+	return &empty.Empty{}, nil
+}
+
+// CompleteArtifactUpload replaces an artifact of a run with a complete upload.
+func (s *UploadServer) CompleteArtifactUpload(ctx context.Context, request *api.CompleteArtifactUploadRequest) (*empty.Empty, error) {
+	if request.UploadId == "" {
+		return nil, util.NewInvalidInputError("Upload ID is empty. Please specify a valid upload")
+	}
+	if err := s.canUpload(ctx, "", request.RunId); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	err := s.resourceManager.CompleteArtifactUpload(request.RunId, request.NodeId, request.ArtifactName, request.UploadId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to complete the artifact upload")
+	}
+	return &empty.Empty{}, nil
+}
+
+// UploadChunk stores the body of the request as the chunk of an upload at the
+// offset query parameter, which has to be the size uploaded so far. Chunks are
+// raw bytes, which the gRPC gateway can't take as a request body, so they're
+// uploaded through HTTP only.
+func (s *UploadServer) UploadChunk(w http.ResponseWriter, r *http.Request) {
+	uploadID, ok := mux.Vars(r)[UploadIDKey]
+	if !ok {
+		s.writeErrorToResponse(w, http.StatusBadRequest, fmt.Errorf("missing path parameter: '%s'", UploadIDKey))
+		return
+	}
+	session, err := s.getAuthorizedUpload(r.Context(), uploadID)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	offset, err := strconv.ParseInt(r.URL.Query().Get(OffsetQueryStringKey), 10, 64)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputErrorWithDetails(err, "Invalid offset"))
+		return
+	}
+	// Read one more byte than allowed to reject the chunks that are too large.
+	chunk, err := ioutil.ReadAll(io.LimitReader(r.Body, common.MaxUploadChunkSize+1))
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputErrorWithDetails(err, "Failed to read the chunk"))
+		return
+	}
+	session, err = s.resourceManager.UploadChunk(session.UUID, offset, chunk)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
+	if err := marshaler.Marshal(w, ToApiUpload(session)); err != nil {
+		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error writing the upload"))
+	}
+}
+
+func (s *UploadServer) getAuthorizedUpload(ctx context.Context, uploadID string) (*model.UploadSession, error) {
+	session, err := s.resourceManager.GetUpload(uploadID)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the upload")
+	}
+	if err := s.canUpload(ctx, session.Namespace, session.RunUUID); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	return session, nil
+}
+
+// canUpload authorizes creating pipelines in the namespace, or writing the
+// artifacts of the run if runID is set, in multi-user mode.
+func (s *UploadServer) canUpload(ctx context.Context, namespace string, runID string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      common.RbacResourceVerbCreate,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypePipelines,
+	}
+	if runID != "" {
+		run, err := s.resourceManager.GetRun(runID)
+		if err != nil {
+			return util.Wrap(err, "Failed to get the run")
+		}
+		resourceAttributes.Namespace = run.Namespace
+		resourceAttributes.Verb = common.RbacResourceVerbWriteArtifact
+		resourceAttributes.Resource = common.RbacResourceTypeRuns
+		resourceAttributes.Name = run.Name
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func (s *UploadServer) writeError(w http.ResponseWriter, err error) {
+	code := runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err)))
+	s.writeErrorToResponse(w, code, err)
+}
+
+func (s *UploadServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
+	log.Errorf("Failed to upload. Error: %+v", err)
+	w.WriteHeader(code)
+	errorResponse := util.ToAPIError(err)
+	errBytes, err := json.Marshal(errorResponse)
+	if err != nil {
+		w.Write([]byte("Error uploading"))
+	}
+	w.Write(errBytes)
+}
+
+func NewUploadServer(resourceManager *resource.ResourceManager) *UploadServer {
+	return &UploadServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/gorilla/mux"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func serveUploadRequest(resourceManager *resource.ResourceManager, req *http.Request) *httptest.ResponseRecorder {
+	uploadServer := NewUploadServer(resourceManager)
+	pipelineUploadServer := NewPipelineUploadServer(resourceManager, &PipelineUploadServerOptions{CollectMetrics: false})
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v1/uploads/{upload_id}", uploadServer.UploadChunk).Methods(http.MethodPut)
+	router.HandleFunc("/apis/v1/pipelines/upload", pipelineUploadServer.UploadPipeline)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func parseUploadResponse(t *testing.T, rr *httptest.ResponseRecorder) *api.Upload {
+	var upload api.Upload
+	assert.Nil(t, jsonpb.Unmarshal(rr.Body, &upload))
+	return &upload
+}
+
+func TestUpload_Pipeline(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := NewUploadServer(resourceManager)
+	spec := "apiVersion: tekton.dev/v1\nkind: PipelineRun"
+
+	upload, err := server.CreateUpload(context.Background(), &api.CreateUploadRequest{
+		Upload: &api.Upload{FileName: "hello-world.yaml", Size: 43}})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.Upload{Id: resource.DefaultFakeUUID, FileName: "hello-world.yaml", Size: 43}, upload)
+
+	req, _ := http.NewRequest(http.MethodPut, "/apis/v1/uploads/"+resource.DefaultFakeUUID+"?offset=0", strings.NewReader(spec))
+	rr := serveUploadRequest(resourceManager, req)
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, int64(43), parseUploadResponse(t, rr).UploadedSize)
+
+	req, _ = http.NewRequest(http.MethodPost, "/apis/v1/pipelines/upload?upload_id="+resource.DefaultFakeUUID, nil)
+	rr = serveUploadRequest(resourceManager, req)
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Contains(t, rr.Body.String(), `"name":"hello-world.yaml"`)
+
+	// The upload is deleted once the pipeline is created.
+	_, err = server.GetUpload(context.Background(), &api.GetUploadRequest{Id: resource.DefaultFakeUUID})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestUpload_Resume(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := NewUploadServer(resourceManager)
+
+	_, err := server.CreateUpload(context.Background(), &api.CreateUploadRequest{
+		Upload: &api.Upload{FileName: "hello-world.yaml", Size: 43}})
+	assert.Nil(t, err)
+
+	// A chunk that doesn't start at the uploaded size is rejected.
+	req, _ := http.NewRequest(http.MethodPut, "/apis/v1/uploads/"+resource.DefaultFakeUUID+"?offset=10", strings.NewReader("abc"))
+	rr := serveUploadRequest(resourceManager, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	upload, err := server.GetUpload(context.Background(), &api.GetUploadRequest{Id: resource.DefaultFakeUUID})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), upload.UploadedSize)
+
+	// An incomplete upload can't be used.
+	req, _ = http.NewRequest(http.MethodPost, "/apis/v1/pipelines/upload?upload_id="+resource.DefaultFakeUUID, nil)
+	rr = serveUploadRequest(resourceManager, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	_, err = server.DeleteUpload(context.Background(), &api.DeleteUploadRequest{Id: resource.DefaultFakeUUID})
+	assert.Nil(t, err)
+}
+
+func TestUpload_Artifact(t *testing.T) {
+	clientManager := initWithArtifactRun(t)
+	defer clientManager.Close()
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := NewUploadServer(resourceManager)
+
+	upload, err := server.CreateUpload(context.Background(), &api.CreateUploadRequest{
+		Upload: &api.Upload{RunId: "run1", FileName: "output.tgz", Size: 3}})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.Upload{Id: resource.DefaultFakeUUID, Namespace: "ns1", RunId: "run1", FileName: "output.tgz", Size: 3}, upload)
+
+	req, _ := http.NewRequest(http.MethodPut, "/apis/v1/uploads/"+resource.DefaultFakeUUID+"?offset=0", bytes.NewReader([]byte("xyz")))
+	rr := serveUploadRequest(resourceManager, req)
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	_, err = server.CompleteArtifactUpload(context.Background(), &api.CompleteArtifactUploadRequest{
+		RunId: "run1", NodeId: "node", ArtifactName: "output", UploadId: resource.DefaultFakeUUID})
+	assert.Nil(t, err)
+
+	content, err := resourceManager.ReadArtifact("run1", "node", "output")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("xyz"), content)
+}
@@ -1,34 +1,26 @@
 package server
 
 import (
-	"archive/tar"
-	"archive/zip"
-	"bufio"
-	"bytes"
-	"compress/gzip"
 	"context"
 	"encoding/json"
-	"io"
-	"io/ioutil"
 	"net/url"
 	"strings"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 
-	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
 // These are valid conditions of a ScheduledWorkflow.
 const (
 	MaxFileNameLength = 100
-	MaxFileLength     = 32 << 20 // 32Mb
 )
 
 // This method extract the common logic of naming the pipeline.
@@ -48,144 +40,6 @@ func GetPipelineName(queryString string, fileName string) (string, error) {
 	return pipelineName, nil
 }
 
-func loadFile(fileReader io.Reader, maxFileLength int) ([]byte, error) {
-	reader := bufio.NewReader(fileReader)
-	pipelineFile := make([]byte, maxFileLength+1)
-	size, err := reader.Read(pipelineFile)
-	if err != nil && err != io.EOF {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Error read pipeline file.")
-	}
-	if size == maxFileLength+1 {
-		return nil, util.NewInvalidInputError("File size too large. Maximum supported size: %v", maxFileLength)
-	}
-
-	return pipelineFile[:size], nil
-}
-
-func isYamlFile(fileName string) bool {
-	return strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml")
-}
-
-func isJSONFile(fileName string) bool {
-	return strings.HasSuffix(fileName, ".json")
-}
-
-func isPipelineYamlFile(fileName string) bool {
-	return fileName == "pipeline.yaml"
-}
-
-func isZipFile(compressedFile []byte) bool {
-	return len(compressedFile) > 2 && compressedFile[0] == '\x50' && compressedFile[1] == '\x4B' //Signature of zip file is "PK"
-}
-
-func isCompressedTarballFile(compressedFile []byte) bool {
-	return len(compressedFile) > 2 && compressedFile[0] == '\x1F' && compressedFile[1] == '\x8B'
-}
-
-func DecompressPipelineTarball(compressedFile []byte) ([]byte, error) {
-	gzipReader, err := gzip.NewReader(bytes.NewReader(compressedFile))
-	if err != nil {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Error extracting pipeline from the tarball file. Not a valid tarball file.")
-	}
-	// New behavior: searching for the "pipeline.yaml" file.
-	tarReader := tar.NewReader(gzipReader)
-	for {
-		header, err := tarReader.Next()
-		if err == io.EOF {
-			tarReader = nil
-			break
-		}
-		if err != nil {
-			return nil, util.NewInvalidInputErrorWithDetails(err, "Error extracting pipeline from the tarball file. Not a valid tarball file.")
-		}
-		if isPipelineYamlFile(header.Name) {
-			//Found the pipeline file.
-			break
-		}
-	}
-	// Old behavior - taking the first file in the archive
-	if tarReader == nil {
-		// Resetting the reader
-		gzipReader, err = gzip.NewReader(bytes.NewReader(compressedFile))
-		if err != nil {
-			return nil, util.NewInvalidInputErrorWithDetails(err, "Error extracting pipeline from the tarball file. Not a valid tarball file.")
-		}
-		tarReader = tar.NewReader(gzipReader)
-		header, err := tarReader.Next()
-		if err != nil {
-			return nil, util.NewInvalidInputErrorWithDetails(err, "Error extracting pipeline from the tarball file. Not a valid tarball file.")
-		}
-		if !isYamlFile(header.Name) {
-			return nil, util.NewInvalidInputError("Error extracting pipeline from the tarball file. Expecting a pipeline.yaml file inside the tarball. Got: %v", header.Name)
-		}
-	}
-
-	decompressedFile, err := ioutil.ReadAll(tarReader)
-	if err != nil {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Error reading pipeline YAML from the tarball file.")
-	}
-	return decompressedFile, err
-}
-
-func DecompressPipelineZip(compressedFile []byte) ([]byte, error) {
-	reader, err := zip.NewReader(bytes.NewReader(compressedFile), int64(len(compressedFile)))
-	if err != nil {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Error extracting pipeline from the zip file. Not a valid zip file.")
-	}
-	if len(reader.File) < 1 {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Error extracting pipeline from the zip file. Empty zip file.")
-	}
-
-	// Old behavior - taking the first file in the archive
-	pipelineYamlFile := reader.File[0]
-	// New behavior: searching for the "pipeline.yaml" file.
-	for _, file := range reader.File {
-		if isPipelineYamlFile(file.Name) {
-			pipelineYamlFile = file
-			break
-		}
-	}
-
-	if !isYamlFile(pipelineYamlFile.Name) {
-		return nil, util.NewInvalidInputError("Error extracting pipeline from the zip file. Expecting a pipeline.yaml file inside the zip. Got: %v", pipelineYamlFile.Name)
-	}
-	rc, err := pipelineYamlFile.Open()
-	if err != nil {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Error extracting pipeline from the zip file. Failed to read the content.")
-	}
-	decompressedFile, err := ioutil.ReadAll(rc)
-	if err != nil {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Error reading pipeline YAML from the zip file.")
-	}
-	return decompressedFile, err
-}
-
-func ReadPipelineFile(fileName string, fileReader io.Reader, maxFileLength int) ([]byte, error) {
-	// Read file into size limited byte array.
-	pipelineFileBytes, err := loadFile(fileReader, maxFileLength)
-	if err != nil {
-		return nil, util.Wrap(err, "Error read pipeline file.")
-	}
-
-	var processedFile []byte
-	switch {
-	case isYamlFile(fileName):
-		processedFile = pipelineFileBytes
-	case isJSONFile(fileName):
-		processedFile = pipelineFileBytes
-	case isZipFile(pipelineFileBytes):
-		processedFile, err = DecompressPipelineZip(pipelineFileBytes)
-	case isCompressedTarballFile(pipelineFileBytes):
-		processedFile, err = DecompressPipelineTarball(pipelineFileBytes)
-	default:
-		return nil, util.NewInvalidInputError("Unexpected pipeline file format. Support .zip, .tar.gz, .json or YAML.")
-	}
-	if err != nil {
-		return nil, util.Wrap(err, "Error decompress the pipeline file")
-	}
-	return processedFile, nil
-}
-
 func printParameters(params []*api.Parameter) string {
 	var s strings.Builder
 	for _, p := range params {
@@ -219,6 +73,28 @@ func ValidateExperimentResourceReference(resourceManager *resource.ResourceManag
 	return nil
 }
 
+// getNamespaceOfNewResource returns the namespace a run or job is created in, in
+// multi-user mode: the namespace of its experiment or, without an experiment,
+// the namespace it references, whose default experiment it's grouped in.
+func getNamespaceOfNewResource(resourceManager *resource.ResourceManager, references []*api.ResourceReference) (string, error) {
+	experimentID := common.GetExperimentIDFromAPIResourceReferences(references)
+	if experimentID == "" {
+		namespace := common.GetNamespaceFromAPIResourceReferences(references)
+		if namespace == "" {
+			return "", util.NewInvalidInputError("Job has no experiment or namespace.")
+		}
+		return namespace, nil
+	}
+	namespace, err := resourceManager.GetNamespaceFromExperimentID(experimentID)
+	if err != nil {
+		return "", util.Wrap(err, "Failed to get experiment for job.")
+	}
+	if namespace == "" {
+		return "", util.NewInvalidInputError("Job's experiment has no namespace.")
+	}
+	return namespace, nil
+}
+
 func ValidatePipelineSpecAndResourceReferences(resourceManager *resource.ResourceManager, spec *api.PipelineSpec, resourceReferences []*api.ResourceReference) error {
 	pipelineId := spec.GetPipelineId()
 	workflowManifest := spec.GetWorkflowManifest()
@@ -353,11 +229,11 @@ func isAuthorized(resourceManager *resource.ResourceManager, ctx context.Context
 	if common.IsMultiUserSharedReadMode() &&
 		(resourceAttributes.Verb == common.RbacResourceVerbGet ||
 			resourceAttributes.Verb == common.RbacResourceVerbList) {
-		glog.Infof("Multi-user shared read mode is enabled. Request allowed: %+v", resourceAttributes)
+		log.Infof("Multi-user shared read mode is enabled. Request allowed: %+v", resourceAttributes)
 		return nil
 	}
 
-	glog.Info("Getting user identity...")
+	log.Info("Getting user identity...")
 	userIdentity, err := resourceManager.AuthenticateRequest(ctx)
 	if err != nil {
 		return err
@@ -367,14 +243,41 @@ func isAuthorized(resourceManager *resource.ResourceManager, ctx context.Context
 		return util.NewUnauthenticatedError(errors.New("Request header error: user identity is empty."), "Request header error: user identity is empty.")
 	}
 
-	glog.Infof("User: %s, ResourceAttributes: %+v", userIdentity, resourceAttributes)
-	glog.Info("Authorizing request...")
+	log.Infof("User: %s, ResourceAttributes: %+v", userIdentity, resourceAttributes)
+	log.Info("Authorizing request...")
 	err = resourceManager.IsRequestAuthorized(ctx, userIdentity, resourceAttributes)
 	if err != nil {
-		glog.Info(err.Error())
+		log.Info(err.Error())
 		return err
 	}
 
-	glog.Infof("Authorized user '%s': %+v", userIdentity, resourceAttributes)
+	log.Infof("Authorized user '%s': %+v", userIdentity, resourceAttributes)
 	return nil
 }
+
+// isRequestAuthorized verifies whether the user identity the request was
+// authenticated with can perform some action on a resource, like isAuthorized,
+// except that the reads aren't allowed to everybody in the multi-user shared read
+// mode, e.g. for the resources only admins can read.
+func isRequestAuthorized(resourceManager *resource.ResourceManager, ctx context.Context, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	userIdentity, err := resourceManager.AuthenticateRequest(ctx)
+	if err != nil {
+		return err
+	}
+	return resourceManager.IsRequestAuthorized(ctx, userIdentity, resourceAttributes)
+}
+
+// canUndelete authorizes the restore of a soft deleted resource. The deleted
+// resources are hidden from their namespaces, so only cluster admins are
+// allowed to restore them in multi-user mode.
+func canUndelete(resourceManager *resource.ResourceManager, ctx context.Context, rbacResourceType string) error {
+	return isRequestAuthorized(resourceManager, ctx, &authorizationv1.ResourceAttributes{
+		Verb:     common.RbacResourceVerbUndelete,
+		Group:    common.RbacPipelinesGroup,
+		Version:  common.RbacPipelinesVersion,
+		Resource: rbacResourceType,
+	})
+}
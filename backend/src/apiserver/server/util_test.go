@@ -1,9 +1,6 @@
 package server
 
 import (
-	"io/ioutil"
-	"os"
-	"strings"
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
@@ -12,21 +9,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// Removed tarball tests that check for argo yaml and old auth dep: "TestDecompressPipelineTarball", "TestDecompressPipelineTarball_NonYamlTarball",
-// "TestDecompressPipelineZip", "TestDecompressPipelineZip_NonYamlZip", "TestDecompressPipelineZip_EmptyZip", "TestReadPipelineFile_YAML",
-// "TestReadPipelineFile_Zip", "TestReadPipelineFile_Zip_AnyExtension", "TestReadPipelineFile_MultifileZip", "TestReadPipelineFile_Tarball",
-// "TestReadPipelineFile_Tarball_AnyExtension", "TestReadPipelineFile_MultifileTarball", "TestReadPipelineFile_UnknownFileFormat",
-// "TestValidatePipelineSpecAndResourceReferences_PipelineIdNotParentOfPipelineVersionId"
-
-func TestReadPipelineFile_JSON(t *testing.T) {
-	file, _ := os.Open("test/v2-hello-world.json")
-	fileBytes, err := ReadPipelineFile("v2-hello-world.json", file, MaxFileLength)
-	assert.Nil(t, err)
-
-	expectedFileBytes, _ := ioutil.ReadFile("test/v2-hello-world.json")
-	assert.Equal(t, expectedFileBytes, fileBytes)
-}
-
 func TestGetPipelineName_QueryStringNotEmpty(t *testing.T) {
 	pipelineName, err := GetPipelineName("pipeline%20one", "file one")
 	assert.Nil(t, err)
@@ -52,56 +34,6 @@ func TestGetPipelineName_NameTooLong(t *testing.T) {
 	assert.Contains(t, err.Error(), "name too long")
 }
 
-func TestLoadFile(t *testing.T) {
-	file := "12345"
-	bytes, err := loadFile(strings.NewReader(file), 5)
-	assert.Nil(t, err)
-	assert.Equal(t, []byte(file), bytes)
-}
-
-func TestLoadFile_ExceedSizeLimit(t *testing.T) {
-	file := "12345"
-	_, err := loadFile(strings.NewReader(file), 4)
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "File size too large")
-}
-
-// removed tests (check top page comment)
-
-func TestDecompressPipelineTarball_MalformattedTarball(t *testing.T) {
-	tarballByte, _ := ioutil.ReadFile("test/malformatted_tarball.tar.gz")
-	_, err := DecompressPipelineTarball(tarballByte)
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "Not a valid tarball file")
-}
-
-// removed tests (check top page comment)
-
-func TestDecompressPipelineTarball_EmptyTarball(t *testing.T) {
-	tarballByte, _ := ioutil.ReadFile("test/empty_tarball/empty.tar.gz")
-	_, err := DecompressPipelineTarball(tarballByte)
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "Not a valid tarball file")
-}
-
-// removed tests (check top page comment)
-
-func TestDecompressPipelineZip_MalformattedZip(t *testing.T) {
-	zipByte, _ := ioutil.ReadFile("test/malformatted_zip.zip")
-	_, err := DecompressPipelineZip(zipByte)
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "Not a valid zip file")
-}
-
-func TestDecompressPipelineZip_MalformedZip2(t *testing.T) {
-	zipByte, _ := ioutil.ReadFile("test/malformed_zip2.zip")
-	_, err := DecompressPipelineZip(zipByte)
-	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "Not a valid zip file")
-}
-
-// removed tests (check top page comment)
-
 func TestValidateExperimentResourceReference(t *testing.T) {
 	clients, manager, _ := initWithExperiment(t)
 	defer clients.Close()
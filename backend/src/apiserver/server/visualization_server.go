@@ -9,12 +9,12 @@ import (
 	"net/url"
 	"strings"
 
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
@@ -128,11 +128,11 @@ func isVisualizationServiceAlive(serviceURL string) error {
 
 	if err != nil {
 		wrappedErr := util.Wrap(err, fmt.Sprintf("Unable to verify visualization service aliveness by sending request to %s", serviceURL))
-		glog.Error(wrappedErr)
+		log.Error(wrappedErr)
 		return wrappedErr
 	} else if resp.StatusCode != http.StatusOK {
 		wrappedErr := errors.New(fmt.Sprintf("Unable to verify visualization service aliveness by sending request to %s and get response code: %s !", serviceURL, resp.Status))
-		glog.Error(wrappedErr)
+		log.Error(wrappedErr)
 		return wrappedErr
 	}
 	return nil
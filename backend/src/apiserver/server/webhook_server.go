@@ -0,0 +1,185 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type WebhookServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// CreateWebhook subscribes a URL to the lifecycle events of the resources of a
+// namespace, or of all the namespaces for the cluster admins. The events are
+// posted as CloudEvents, signed with the secret when set. The subscriptions are
+// created enabled.
+func (s *WebhookServer) CreateWebhook(ctx context.Context, request *api.CreateWebhookRequest) (*api.Webhook, error) {
+	webhook := request.GetWebhook()
+	if webhook == nil {
+		return nil, util.NewInvalidInputError("The webhook subscription is missing")
+	}
+	if err := s.canAccessWebhooks(ctx, webhook.Namespace, common.RbacResourceVerbCreate); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	subscription, err := s.resourceManager.CreateWebhookSubscription(&model.WebhookSubscription{
+		Name:         webhook.Name,
+		Namespace:    webhook.Namespace,
+		URL:          webhook.Url,
+		Secret:       webhook.Secret,
+		EventTypes:   strings.Join(webhook.EventTypes, ","),
+		ExperimentID: webhook.ExperimentId,
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create the webhook subscription")
+	}
+	return ToApiWebhook(subscription), nil
+}
+
+// ListWebhooks lists the subscriptions of the namespace, or all of them when it's
+// empty.
+func (s *WebhookServer) ListWebhooks(ctx context.Context, request *api.ListWebhooksRequest) (*api.ListWebhooksResponse, error) {
+	if err := s.canAccessWebhooks(ctx, request.Namespace, common.RbacResourceVerbList); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	subscriptions, err := s.resourceManager.ListWebhookSubscriptions(request.Namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list the webhook subscriptions")
+	}
+	webhooks := make([]*api.Webhook, 0)
+	for _, subscription := range subscriptions {
+		webhooks = append(webhooks, ToApiWebhook(subscription))
+	}
+	return &api.ListWebhooksResponse{Webhooks: webhooks}, nil
+}
+
+func (s *WebhookServer) GetWebhook(ctx context.Context, request *api.GetWebhookRequest) (*api.Webhook, error) {
+	subscription, err := s.getAuthorizedWebhook(ctx, request.Id, common.RbacResourceVerbGet)
+	if err != nil {
+		return nil, err
+	}
+	return ToApiWebhook(subscription), nil
+}
+
+// UpdateWebhook replaces a subscription. The secret is kept when the request has
+// none, and the subscription is kept enabled unless enabled is false.
+func (s *WebhookServer) UpdateWebhook(ctx context.Context, request *api.UpdateWebhookRequest) (*api.Webhook, error) {
+	webhook := request.GetWebhook()
+	if webhook == nil {
+		return nil, util.NewInvalidInputError("The webhook subscription is missing")
+	}
+	subscription, err := s.getAuthorizedWebhook(ctx, request.Id, common.RbacResourceVerbUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.Namespace != "" && webhook.Namespace != subscription.Namespace {
+		return nil, util.NewInvalidInputError("The namespace of webhook %s can't be changed", subscription.UUID)
+	}
+	update := &model.WebhookSubscription{
+		Name:         webhook.Name,
+		URL:          webhook.Url,
+		Secret:       webhook.Secret,
+		EventTypes:   strings.Join(webhook.EventTypes, ","),
+		ExperimentID: webhook.ExperimentId,
+		Enabled:      webhook.Enabled == nil || webhook.Enabled.Value,
+	}
+	subscription, err = s.resourceManager.UpdateWebhookSubscription(subscription.UUID, update)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to update the webhook subscription")
+	}
+	return ToApiWebhook(subscription), nil
+}
+
+// DeleteWebhook deletes a subscription and its deliveries.
+func (s *WebhookServer) DeleteWebhook(ctx context.Context, request *api.DeleteWebhookRequest) (*empty.Empty, error) {
+	subscription, err := s.getAuthorizedWebhook(ctx, request.Id, common.RbacResourceVerbDelete)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resourceManager.DeleteWebhookSubscription(subscription.UUID); err != nil {
+		return nil, util.Wrap(err, "Failed to delete the webhook subscription")
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListWebhookDeliveries lists the latest deliveries of a subscription, up to the
+// page size, in the status unless it's empty, so that the failures can be
+// investigated.
+func (s *WebhookServer) ListWebhookDeliveries(ctx context.Context, request *api.ListWebhookDeliveriesRequest) (*api.ListWebhookDeliveriesResponse, error) {
+	if request.PageSize < 0 {
+		return nil, util.NewInvalidInputError("Invalid page size %d", request.PageSize)
+	}
+	subscription, err := s.getAuthorizedWebhook(ctx, request.WebhookId, common.RbacResourceVerbGet)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = common.DefaultWebhookDeliveryListSize
+	}
+	if pageSize > common.MaxWebhookDeliveryListSize {
+		pageSize = common.MaxWebhookDeliveryListSize
+	}
+	deliveries, err := s.resourceManager.ListWebhookDeliveries(subscription.UUID, request.Status, pageSize)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list the webhook deliveries")
+	}
+	apiDeliveries := make([]*api.WebhookDelivery, 0)
+	for _, delivery := range deliveries {
+		apiDeliveries = append(apiDeliveries, ToApiWebhookDelivery(delivery))
+	}
+	return &api.ListWebhookDeliveriesResponse{Deliveries: apiDeliveries}, nil
+}
+
+func (s *WebhookServer) getAuthorizedWebhook(ctx context.Context, webhookID string, verb string) (*model.WebhookSubscription, error) {
+	subscription, err := s.resourceManager.GetWebhookSubscription(webhookID)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the webhook subscription")
+	}
+	if err := s.canAccessWebhooks(ctx, subscription.Namespace, verb); err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	return subscription, nil
+}
+
+// canAccessWebhooks authorizes the verb on the webhooks of the namespace in
+// multi-user mode. The subscriptions of all the namespaces are only accessed by
+// the cluster admins.
+func (s *WebhookServer) canAccessWebhooks(ctx context.Context, namespace string, verb string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeWebhooks,
+	}
+	return isRequestAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
+func NewWebhookServer(resourceManager *resource.ResourceManager) *WebhookServer {
+	return &WebhookServer{resourceManager: resourceManager}
+}
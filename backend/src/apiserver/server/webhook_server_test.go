@@ -0,0 +1,99 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestWebhookServer(t *testing.T) {
+	viper.Set(common.WebhookAllowedHosts, "ci.example.com")
+	defer viper.Set(common.WebhookAllowedHosts, "")
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	server := NewWebhookServer(resource.NewResourceManager(clientManager))
+	ctx := context.Background()
+
+	webhook, err := server.CreateWebhook(ctx, &api.CreateWebhookRequest{Webhook: &api.Webhook{
+		Name:       "ci",
+		Namespace:  "ns1",
+		Url:        "https://ci.example.com/hook",
+		Secret:     "s3cr3t",
+		EventTypes: []string{"run.finished"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, resource.DefaultFakeUUID, webhook.Id)
+	assert.True(t, webhook.HasSecret)
+	assert.True(t, webhook.Enabled.GetValue())
+	assert.Equal(t, []string{"run.finished"}, webhook.EventTypes)
+	// The secret is never returned.
+	assert.Empty(t, webhook.Secret)
+
+	_, err = server.CreateWebhook(ctx, &api.CreateWebhookRequest{Webhook: &api.Webhook{
+		Name:       "ci",
+		Url:        "https://ci.example.com/hook",
+		EventTypes: []string{"run.unknown"},
+	}})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	webhooks, err := server.ListWebhooks(ctx, &api.ListWebhooksRequest{Namespace: "ns1"})
+	assert.Nil(t, err)
+	assert.Len(t, webhooks.Webhooks, 1)
+	assert.Equal(t, resource.DefaultFakeUUID, webhooks.Webhooks[0].Id)
+
+	// The subscription is kept enabled unless enabled is false.
+	webhook, err = server.UpdateWebhook(ctx, &api.UpdateWebhookRequest{Id: resource.DefaultFakeUUID, Webhook: &api.Webhook{
+		Name: "ci",
+		Url:  "https://ci.example.com/hook2",
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://ci.example.com/hook2", webhook.Url)
+	assert.True(t, webhook.HasSecret)
+	assert.True(t, webhook.Enabled.GetValue())
+	webhook, err = server.UpdateWebhook(ctx, &api.UpdateWebhookRequest{Id: resource.DefaultFakeUUID, Webhook: &api.Webhook{
+		Name:    "ci",
+		Url:     "https://ci.example.com/hook2",
+		Enabled: &wrappers.BoolValue{Value: false},
+	}})
+	assert.Nil(t, err)
+	assert.False(t, webhook.Enabled.GetValue())
+
+	_, err = server.UpdateWebhook(ctx, &api.UpdateWebhookRequest{Id: resource.DefaultFakeUUID, Webhook: &api.Webhook{
+		Name:      "ci",
+		Namespace: "ns2",
+		Url:       "https://ci.example.com/hook2",
+	}})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	deliveries, err := server.ListWebhookDeliveries(ctx, &api.ListWebhookDeliveriesRequest{WebhookId: resource.DefaultFakeUUID, Status: "failed"})
+	assert.Nil(t, err)
+	assert.Empty(t, deliveries.Deliveries)
+
+	_, err = server.DeleteWebhook(ctx, &api.DeleteWebhookRequest{Id: resource.DefaultFakeUUID})
+	assert.Nil(t, err)
+
+	_, err = server.GetWebhook(ctx, &api.GetWebhookRequest{Id: resource.DefaultFakeUUID})
+	AssertUserError(t, err, codes.NotFound)
+}
@@ -0,0 +1,246 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type ArtifactBlobStoreInterface interface {
+	// GetBlobKey returns the key of the blob that an artifact refers to, or an
+	// empty string if the artifact isn't deduplicated.
+	GetBlobKey(objectKey string) (string, error)
+	HasBlob(blobKey string) (bool, error)
+	// AddReference makes an artifact refer to a blob, creating the blob if it's
+	// the first reference. Adding an existing reference does nothing.
+	AddReference(reference *model.ArtifactReference) error
+	ListRunReferences(runUUID string) ([]*model.ArtifactReference, error)
+	// DeleteReference removes the reference of an artifact, and returns the blob
+	// it referred to, or nil if the artifact isn't deduplicated. The blob is
+	// deleted from the database once its RefCount drops to zero, the caller has
+	// to delete its content then.
+	DeleteReference(objectKey string) (*model.ArtifactBlob, error)
+}
+
+// Implementation of a ArtifactBlobStoreInterface. The reference counts are kept
+// in the same transaction as the references.
+type ArtifactBlobStore struct {
+	db *DB
+}
+
+func (s *ArtifactBlobStore) GetBlobKey(objectKey string) (string, error) {
+	sql, args, err := sq.Select("BlobKey").From("artifact_references").Where(sq.Eq{"ObjectKey": objectKey}).ToSql()
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Error creating query to get the blob of artifact %v.", objectKey)
+	}
+	blobKey, err := s.queryString(s.db.DB, sql, args)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to get the blob of artifact %v.", objectKey)
+	}
+	return blobKey, nil
+}
+
+func (s *ArtifactBlobStore) HasBlob(blobKey string) (bool, error) {
+	sql, args, err := sq.Select("BlobKey").From("artifact_blobs").Where(sq.Eq{"BlobKey": blobKey}).ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Error creating query to get blob %v.", blobKey)
+	}
+	found, err := s.queryString(s.db.DB, sql, args)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to get blob %v.", blobKey)
+	}
+	return found != "", nil
+}
+
+func (s *ArtifactBlobStore) AddReference(reference *model.ArtifactReference) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start a transaction to add a reference to blob %v.", reference.BlobKey)
+	}
+	sql, args, err := sq.
+		Insert("artifact_references").
+		SetMap(sq.Eq{
+			"ObjectKey":      reference.ObjectKey,
+			"BlobKey":        reference.BlobKey,
+			"RunUUID":        reference.RunUUID,
+			"Size":           reference.Size,
+			"CreatedAtInSec": reference.CreatedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Error creating query to add a reference to blob %v.", reference.BlobKey)
+	}
+	if _, err = tx.Exec(sql, args...); err != nil {
+		tx.Rollback()
+		if s.db.IsDuplicateError(err) {
+			return nil
+		}
+		return util.NewInternalServerError(err, "Failed to add a reference to blob %v.", reference.BlobKey)
+	}
+
+	sql, args, err = sq.
+		Update("artifact_blobs").
+		Set("RefCount", sq.Expr("RefCount + 1")).
+		Where(sq.Eq{"BlobKey": reference.BlobKey}).
+		ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Error creating query to add a reference to blob %v.", reference.BlobKey)
+	}
+	result, err := tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to add a reference to blob %v.", reference.BlobKey)
+	}
+	if updated, err := result.RowsAffected(); err != nil || updated == 0 {
+		sql, args, err = sq.
+			Insert("artifact_blobs").
+			SetMap(sq.Eq{"BlobKey": reference.BlobKey, "Size": reference.Size, "RefCount": 1}).
+			ToSql()
+		if err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Error creating query to create blob %v.", reference.BlobKey)
+		}
+		if _, err = tx.Exec(sql, args...); err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to create blob %v.", reference.BlobKey)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to commit the reference to blob %v.", reference.BlobKey)
+	}
+	return nil
+}
+
+func (s *ArtifactBlobStore) ListRunReferences(runUUID string) ([]*model.ArtifactReference, error) {
+	sql, args, err := sq.
+		Select("ObjectKey", "BlobKey", "RunUUID", "Size", "CreatedAtInSec").
+		From("artifact_references").
+		Where(sq.Eq{"RunUUID": runUUID}).
+		OrderBy("ObjectKey").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Error creating query to list the artifact references of run %v.", runUUID)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the artifact references of run %v.", runUUID)
+	}
+	defer rows.Close()
+	var references []*model.ArtifactReference
+	for rows.Next() {
+		var reference model.ArtifactReference
+		err = rows.Scan(&reference.ObjectKey, &reference.BlobKey, &reference.RunUUID, &reference.Size, &reference.CreatedAtInSec)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to list the artifact references of run %v.", runUUID)
+		}
+		references = append(references, &reference)
+	}
+	return references, nil
+}
+
+func (s *ArtifactBlobStore) DeleteReference(objectKey string) (*model.ArtifactBlob, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to start a transaction to delete the reference of artifact %v.", objectKey)
+	}
+	blob, err := s.deleteReference(tx, objectKey)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to delete the reference of artifact %v.", objectKey)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to commit the deletion of the reference of artifact %v.", objectKey)
+	}
+	return blob, nil
+}
+
+func (s *ArtifactBlobStore) deleteReference(tx *sql.Tx, objectKey string) (*model.ArtifactBlob, error) {
+	query, args, err := sq.Select("BlobKey").From("artifact_references").Where(sq.Eq{"ObjectKey": objectKey}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	// Lock the reference so that concurrent deletions don't both decrement the
+	// count.
+	blobKey, err := s.queryString(tx, s.db.SelectForUpdate(query), args)
+	if err != nil || blobKey == "" {
+		return nil, err
+	}
+	if query, args, err = sq.Delete("artifact_references").Where(sq.Eq{"ObjectKey": objectKey}).ToSql(); err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(query, args...); err != nil {
+		return nil, err
+	}
+	query, args, err = sq.
+		Update("artifact_blobs").
+		Set("RefCount", sq.Expr("RefCount - 1")).
+		Where(sq.Eq{"BlobKey": blobKey}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(query, args...); err != nil {
+		return nil, err
+	}
+	query, args, err = sq.Select("Size", "RefCount").From("artifact_blobs").Where(sq.Eq{"BlobKey": blobKey}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	blob := &model.ArtifactBlob{BlobKey: blobKey}
+	if err = tx.QueryRow(query, args...).Scan(&blob.Size, &blob.RefCount); err != nil {
+		return nil, err
+	}
+	if blob.RefCount <= 0 {
+		if query, args, err = sq.Delete("artifact_blobs").Where(sq.Eq{"BlobKey": blobKey}).ToSql(); err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(query, args...); err != nil {
+			return nil, err
+		}
+	}
+	return blob, nil
+}
+
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// queryString returns the string in the first column of the first row, or an
+// empty string if there are no rows.
+func (s *ArtifactBlobStore) queryString(db queryer, query string, args []interface{}) (string, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	var value string
+	if rows.Next() {
+		if err = rows.Scan(&value); err != nil {
+			return "", err
+		}
+	}
+	return value, rows.Err()
+}
+
+// factory function for artifact blob store
+func NewArtifactBlobStore(db *DB) *ArtifactBlobStore {
+	return &ArtifactBlobStore{db: db}
+}
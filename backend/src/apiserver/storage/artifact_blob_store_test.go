@@ -0,0 +1,88 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactBlobStore_AddReference(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewArtifactBlobStore(db)
+
+	for _, reference := range []*model.ArtifactReference{
+		{ObjectKey: "artifacts/run-1/a.tgz", BlobKey: "blob1", RunUUID: "run1", Size: 10, CreatedAtInSec: 1},
+		{ObjectKey: "artifacts/run-2/a.tgz", BlobKey: "blob1", RunUUID: "run2", Size: 10, CreatedAtInSec: 2},
+		// Adding a reference twice doesn't count it twice.
+		{ObjectKey: "artifacts/run-2/a.tgz", BlobKey: "blob1", RunUUID: "run2", Size: 10, CreatedAtInSec: 2},
+		{ObjectKey: "artifacts/run-2/b.tgz", BlobKey: "blob2", RunUUID: "run2", Size: 20, CreatedAtInSec: 3},
+	} {
+		assert.Nil(t, store.AddReference(reference))
+	}
+
+	blobKey, err := store.GetBlobKey("artifacts/run-2/a.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, "blob1", blobKey)
+	blobKey, err = store.GetBlobKey("artifacts/run-3/a.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, "", blobKey)
+
+	found, err := store.HasBlob("blob2")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	found, err = store.HasBlob("blob3")
+	assert.Nil(t, err)
+	assert.False(t, found)
+
+	references, err := store.ListRunReferences("run2")
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.ArtifactReference{
+		{ObjectKey: "artifacts/run-2/a.tgz", BlobKey: "blob1", RunUUID: "run2", Size: 10, CreatedAtInSec: 2},
+		{ObjectKey: "artifacts/run-2/b.tgz", BlobKey: "blob2", RunUUID: "run2", Size: 20, CreatedAtInSec: 3},
+	}, references)
+}
+
+func TestArtifactBlobStore_DeleteReference(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewArtifactBlobStore(db)
+	assert.Nil(t, store.AddReference(&model.ArtifactReference{
+		ObjectKey: "artifacts/run-1/a.tgz", BlobKey: "blob1", RunUUID: "run1", Size: 10}))
+	assert.Nil(t, store.AddReference(&model.ArtifactReference{
+		ObjectKey: "artifacts/run-2/a.tgz", BlobKey: "blob1", RunUUID: "run2", Size: 10}))
+
+	blob, err := store.DeleteReference("artifacts/run-1/a.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ArtifactBlob{BlobKey: "blob1", Size: 10, RefCount: 1}, blob)
+	found, err := store.HasBlob("blob1")
+	assert.Nil(t, err)
+	assert.True(t, found)
+
+	// The blob is deleted with its last reference.
+	blob, err = store.DeleteReference("artifacts/run-2/a.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ArtifactBlob{BlobKey: "blob1", Size: 10, RefCount: 0}, blob)
+	found, err = store.HasBlob("blob1")
+	assert.Nil(t, err)
+	assert.False(t, found)
+
+	blob, err = store.DeleteReference("artifacts/run-2/a.tgz")
+	assert.Nil(t, err)
+	assert.Nil(t, blob)
+}
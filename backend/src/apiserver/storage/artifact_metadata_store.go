@@ -0,0 +1,322 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+)
+
+type ArtifactMetadataStoreInterface interface {
+	// IndexRunArtifacts replaces the indexed artifacts of a run, so a run that is
+	// reported again isn't indexed twice. The OCI references of the artifacts
+	// that are indexed again are kept.
+	IndexRunArtifacts(runUUID string, artifacts []*model.ArtifactMetadata) error
+	SearchArtifacts(filterContext *common.FilterContext, opts *list.Options) ([]*model.ArtifactMetadata, int, string, error)
+	GetArtifact(runUUID string, objectKey string) (*model.ArtifactMetadata, error)
+	// ListArtifactsToPush returns the oldest artifacts of the types that haven't
+	// been pushed to the OCI registry yet.
+	ListArtifactsToPush(types []string, limit int) ([]*model.ArtifactMetadata, error)
+	SetOCIReference(uuid string, reference string) error
+	DeleteRunArtifacts(runUUID string) error
+	DeleteArtifact(runUUID string, objectKey string) error
+}
+
+type ArtifactMetadataStore struct {
+	db *DB
+}
+
+var (
+	artifactMetadataColumns = []string{
+		"UUID",
+		"Namespace",
+		"RunUUID",
+		"TaskName",
+		"Name",
+		"Type",
+		"Size",
+		"ObjectKey",
+		"CustomProperties",
+		"CreatedAtInSec",
+		"OCIReference",
+	}
+)
+
+func (s *ArtifactMetadataStore) IndexRunArtifacts(runUUID string, artifacts []*model.ArtifactMetadata) error {
+	deleteSql, deleteArgs, err := sq.Delete("artifact_metadata").Where(sq.Eq{"RunUUID": runUUID}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the artifacts of run %v: %v", runUUID, err.Error())
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start a transaction to index the artifacts of run %v", runUUID)
+	}
+	references, err := s.getOCIReferences(tx, runUUID)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to get the OCI references of the artifacts of run %v: %v", runUUID, err.Error())
+	}
+	if _, err := tx.Exec(deleteSql, deleteArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete the artifacts of run %v: %v", runUUID, err.Error())
+	}
+	for _, artifact := range artifacts {
+		insertSql, insertArgs, err := sq.
+			Insert("artifact_metadata").
+			SetMap(sq.Eq{
+				"UUID":             artifact.UUID,
+				"Namespace":        artifact.Namespace,
+				"RunUUID":          runUUID,
+				"TaskName":         artifact.TaskName,
+				"Name":             artifact.Name,
+				"Type":             artifact.Type,
+				"Size":             artifact.Size,
+				"ObjectKey":        artifact.ObjectKey,
+				"CustomProperties": artifact.CustomProperties,
+				"CreatedAtInSec":   artifact.CreatedAtInSec,
+				"OCIReference":     references[artifact.UUID],
+			}).
+			ToSql()
+		if err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to create query to index artifact %v: %v", artifact.ObjectKey, err.Error())
+		}
+		if _, err := tx.Exec(insertSql, insertArgs...); err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to add artifact %v to artifact_metadata table: %v", artifact.ObjectKey, err.Error())
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to commit the artifacts of run %v", runUUID)
+	}
+	return nil
+}
+
+// getOCIReferences returns the OCI references of the pushed artifacts of a run
+// by their ids.
+func (s *ArtifactMetadataStore) getOCIReferences(tx *sql.Tx, runUUID string) (map[string]string, error) {
+	sql, args, err := sq.Select("UUID", "OCIReference").From("artifact_metadata").
+		Where(sq.And{sq.Eq{"RunUUID": runUUID}, sq.NotEq{"OCIReference": nil}, sq.NotEq{"OCIReference": ""}}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	references := map[string]string{}
+	for rows.Next() {
+		var uuid, reference string
+		if err := rows.Scan(&uuid, &reference); err != nil {
+			return nil, err
+		}
+		references[uuid] = reference
+	}
+	return references, rows.Err()
+}
+
+// Runs two SQL queries in a transaction to return a list of matching artifacts, as well as their
+// total_size. The total_size does not reflect the page size.
+func (s *ArtifactMetadataStore) SearchArtifacts(filterContext *common.FilterContext, opts *list.Options) ([]*model.ArtifactMetadata, int, string, error) {
+	errorF := func(err error) ([]*model.ArtifactMetadata, int, string, error) {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to search artifacts: %v", err)
+	}
+
+	sqlBuilder := s.filterByReference(filterContext, sq.Select(artifactMetadataColumns...).From("artifact_metadata"))
+	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(opts.AddFilterToSelect(sqlBuilder)).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	sqlBuilder = s.filterByReference(filterContext, sq.Select("count(*)").From("artifact_metadata"))
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// Use a transaction to make sure we're returning the total_size of the same rows queried.
+	// The rows may be read from a replica lagging slightly behind.
+	tx, err := s.db.Reader().Begin()
+	if err != nil {
+		log.Errorf("Failed to start transaction to search artifacts")
+		return errorF(err)
+	}
+
+	rows, err := tx.Query(rowsSql, rowsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	artifacts, err := s.scanRows(rows)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	rows.Close()
+
+	sizeRow, err := tx.Query(sizeSql, sizeArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	totalSize, err := list.ScanRowToTotalSize(sizeRow)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	sizeRow.Close()
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf("Failed to commit transaction to search artifacts")
+		return errorF(err)
+	}
+
+	if len(artifacts) <= opts.PageSize {
+		return artifacts, totalSize, "", nil
+	}
+
+	npt, err := opts.NextPageToken(artifacts[opts.PageSize-1])
+	return artifacts[:opts.PageSize], totalSize, npt, err
+}
+
+// filterByReference restricts the artifacts to those of a namespace or a run.
+func (s *ArtifactMetadataStore) filterByReference(filterContext *common.FilterContext, sqlBuilder sq.SelectBuilder) sq.SelectBuilder {
+	if filterContext.ReferenceKey == nil {
+		return sqlBuilder
+	}
+	switch filterContext.ReferenceKey.Type {
+	case common.Namespace:
+		return sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
+	case common.Run:
+		return sqlBuilder.Where(sq.Eq{"RunUUID": filterContext.ReferenceKey.ID})
+	}
+	return sqlBuilder
+}
+
+func (s *ArtifactMetadataStore) GetArtifact(runUUID string, objectKey string) (*model.ArtifactMetadata, error) {
+	sql, args, err := sq.Select(artifactMetadataColumns...).From("artifact_metadata").
+		Where(sq.Eq{"RunUUID": runUUID, "ObjectKey": objectKey}).Limit(1).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get artifact %v: %v", objectKey, err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get artifact %v: %v", objectKey, err.Error())
+	}
+	defer rows.Close()
+	artifacts, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get artifact %v: %v", objectKey, err.Error())
+	}
+	if len(artifacts) == 0 {
+		return nil, util.NewResourceNotFoundError("artifact", objectKey)
+	}
+	return artifacts[0], nil
+}
+
+func (s *ArtifactMetadataStore) ListArtifactsToPush(types []string, limit int) ([]*model.ArtifactMetadata, error) {
+	sql, args, err := sq.Select(artifactMetadataColumns...).From("artifact_metadata").
+		Where(sq.And{sq.Eq{"Type": types}, sq.Or{sq.Eq{"OCIReference": nil}, sq.Eq{"OCIReference": ""}}}).
+		OrderBy("CreatedAtInSec", "UUID").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list the artifacts to push: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the artifacts to push: %v", err.Error())
+	}
+	defer rows.Close()
+	artifacts, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the artifacts to push: %v", err.Error())
+	}
+	return artifacts, nil
+}
+
+func (s *ArtifactMetadataStore) SetOCIReference(uuid string, reference string) error {
+	sql, args, err := sq.Update("artifact_metadata").
+		SetMap(sq.Eq{"OCIReference": reference}).
+		Where(sq.Eq{"UUID": uuid}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to set the OCI reference of artifact %v: %v", uuid, err.Error())
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to set the OCI reference of artifact %v: %v", uuid, err.Error())
+	}
+	return nil
+}
+
+func (s *ArtifactMetadataStore) DeleteRunArtifacts(runUUID string) error {
+	return s.delete(sq.Eq{"RunUUID": runUUID}, "the artifacts of run "+runUUID)
+}
+
+func (s *ArtifactMetadataStore) DeleteArtifact(runUUID string, objectKey string) error {
+	return s.delete(sq.Eq{"RunUUID": runUUID, "ObjectKey": objectKey}, "artifact "+objectKey)
+}
+
+func (s *ArtifactMetadataStore) delete(where sq.Eq, description string) error {
+	sql, args, err := sq.Delete("artifact_metadata").Where(where).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete %v: %v", description, err.Error())
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete %v: %v", description, err.Error())
+	}
+	return nil
+}
+
+func (s *ArtifactMetadataStore) scanRows(rows *sql.Rows) ([]*model.ArtifactMetadata, error) {
+	var artifacts []*model.ArtifactMetadata
+	for rows.Next() {
+		var artifact model.ArtifactMetadata
+		var customProperties, ociReference sql.NullString
+		err := rows.Scan(
+			&artifact.UUID,
+			&artifact.Namespace,
+			&artifact.RunUUID,
+			&artifact.TaskName,
+			&artifact.Name,
+			&artifact.Type,
+			&artifact.Size,
+			&artifact.ObjectKey,
+			&customProperties,
+			&artifact.CreatedAtInSec,
+			&ociReference,
+		)
+		if err != nil {
+			return artifacts, err
+		}
+		artifact.CustomProperties = customProperties.String
+		artifact.OCIReference = ociReference.String
+		artifacts = append(artifacts, &artifact)
+	}
+	return artifacts, nil
+}
+
+// factory function for artifact metadata store
+func NewArtifactMetadataStore(db *DB) *ArtifactMetadataStore {
+	return &ArtifactMetadataStore{db: db}
+}
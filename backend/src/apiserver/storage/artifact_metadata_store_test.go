@@ -0,0 +1,143 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func createArtifactMetadata(id string, namespace string, runUUID string, name string, size int64, createdAtInSec int64) *model.ArtifactMetadata {
+	return &model.ArtifactMetadata{
+		UUID:           id,
+		Namespace:      namespace,
+		RunUUID:        runUUID,
+		TaskName:       "train",
+		Name:           name,
+		Type:           "Model",
+		Size:           size,
+		ObjectKey:      "artifacts/" + runUUID + "/train/" + name + ".tgz",
+		CreatedAtInSec: createdAtInSec,
+	}
+}
+
+func TestSearchArtifacts_Filter(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewArtifactMetadataStore(db)
+	small := createArtifactMetadata(fakeID, "ns1", "run1", "small", 10, 1)
+	large := createArtifactMetadata(fakeIDTwo, "ns1", "run1", "large", 600<<20, 2)
+	large.CustomProperties = `{"framework":"tensorflow"}`
+	other := createArtifactMetadata(fakeIDThree, "ns2", "run2", "large", 700<<20, 3)
+	assert.Nil(t, store.IndexRunArtifacts("run1", []*model.ArtifactMetadata{small, large}))
+	assert.Nil(t, store.IndexRunArtifacts("run2", []*model.ArtifactMetadata{other}))
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{Key: "type", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "Model"}},
+			{Key: "size", Op: api.Predicate_GREATER_THAN, Value: &api.Predicate_LongValue{LongValue: 500 << 20}},
+			{Key: "created_at", Op: api.Predicate_GREATER_THAN_EQUALS, Value: &api.Predicate_LongValue{LongValue: 2}},
+		},
+	}
+	opts, err := list.NewOptions(&model.ArtifactMetadata{}, 10, "", filterProto)
+	assert.Nil(t, err)
+	artifacts, totalSize, nextPageToken, err := store.SearchArtifacts(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, []*model.ArtifactMetadata{large, other}, artifacts)
+
+	// The namespace restricts the artifacts a user can find.
+	artifacts, totalSize, _, err = store.SearchArtifacts(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns2"}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, []*model.ArtifactMetadata{other}, artifacts)
+
+	filterProto = &api.Filter{
+		Predicates: []*api.Predicate{{
+			Key:   "custom_properties",
+			Op:    api.Predicate_IS_SUBSTRING,
+			Value: &api.Predicate_StringValue{StringValue: `"framework":"tensorflow"`},
+		}},
+	}
+	opts, err = list.NewOptions(&model.ArtifactMetadata{}, 10, "", filterProto)
+	assert.Nil(t, err)
+	artifacts, _, _, err = store.SearchArtifacts(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.ArtifactMetadata{large}, artifacts)
+}
+
+func TestIndexRunArtifacts_Replace(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewArtifactMetadataStore(db)
+	assert.Nil(t, store.IndexRunArtifacts("run1", []*model.ArtifactMetadata{
+		createArtifactMetadata(fakeID, "ns1", "run1", "a", 1, 1),
+		createArtifactMetadata(fakeIDTwo, "ns1", "run1", "b", 1, 1),
+	}))
+	b := createArtifactMetadata(fakeIDThree, "ns1", "run1", "b", 2, 2)
+	assert.Nil(t, store.IndexRunArtifacts("run1", []*model.ArtifactMetadata{b}))
+
+	opts, err := list.NewOptions(&model.ArtifactMetadata{}, 10, "", nil)
+	assert.Nil(t, err)
+	artifacts, _, _, err := store.SearchArtifacts(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.ArtifactMetadata{b}, artifacts)
+
+	assert.Nil(t, store.DeleteArtifact("run1", b.ObjectKey))
+	artifacts, _, _, err = store.SearchArtifacts(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Empty(t, artifacts)
+}
+
+func TestListArtifactsToPush(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewArtifactMetadataStore(db)
+	model1 := createArtifactMetadata(fakeID, "ns1", "run1", "model", 1, 2)
+	model2 := createArtifactMetadata(fakeIDTwo, "ns1", "run1", "model2", 1, 1)
+	metrics := createArtifactMetadata(fakeIDThree, "ns1", "run1", "metrics", 1, 1)
+	metrics.Type = "Metrics"
+	assert.Nil(t, store.IndexRunArtifacts("run1", []*model.ArtifactMetadata{model1, model2, metrics}))
+
+	artifacts, err := store.ListArtifactsToPush([]string{"Model", "Dataset"}, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.ArtifactMetadata{model2, model1}, artifacts)
+
+	// The pushed artifacts aren't listed, and keep their reference when the run
+	// is indexed again.
+	assert.Nil(t, store.SetOCIReference(model2.UUID, "registry.example.com/model2@sha256:1"))
+	assert.Nil(t, store.IndexRunArtifacts("run1", []*model.ArtifactMetadata{
+		createArtifactMetadata(fakeID, "ns1", "run1", "model", 1, 2),
+		createArtifactMetadata(fakeIDTwo, "ns1", "run1", "model2", 1, 1),
+	}))
+	artifacts, err = store.ListArtifactsToPush([]string{"Model"}, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.ArtifactMetadata{model1}, artifacts)
+	artifact, err := store.GetArtifact("run1", model2.ObjectKey)
+	assert.Nil(t, err)
+	assert.Equal(t, "registry.example.com/model2@sha256:1", artifact.OCIReference)
+
+	_, err = store.GetArtifact("run2", model2.ObjectKey)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
+)
+
+type AuditStoreInterface interface {
+	CreateAuditEvent(event *model.AuditEvent) error
+	ListAuditEvents(opts *list.Options) ([]*model.AuditEvent, int, string, error)
+}
+
+type AuditStore struct {
+	db *DB
+}
+
+var (
+	auditEventColumns = []string{
+		"UUID",
+		"CreatedAtInSec",
+		"Actor",
+		"Namespace",
+		"Method",
+		"ResourceId",
+		"ResourceBefore",
+		"ResourceAfter",
+		"Result",
+		"Error",
+	}
+)
+
+// CreateAuditEvent inserts the event as is. The caller assigns the id and the
+// timestamp, so every sink records the same values.
+func (s *AuditStore) CreateAuditEvent(event *model.AuditEvent) error {
+	sql, args, err := sq.
+		Insert("audit_events").
+		SetMap(sq.Eq{
+			"UUID":           event.UUID,
+			"CreatedAtInSec": event.CreatedAtInSec,
+			"Actor":          event.Actor,
+			"Namespace":      event.Namespace,
+			"Method":         event.Method,
+			"ResourceId":     event.ResourceId,
+			"ResourceBefore": event.ResourceBefore,
+			"ResourceAfter":  event.ResourceAfter,
+			"Result":         event.Result,
+			"Error":          event.Error,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to insert audit event: %v", err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to add audit event to audit_events table: %v", err.Error())
+	}
+	return nil
+}
+
+// Runs two SQL queries in a transaction to return a list of matching audit events, as well as their
+// total_size. The total_size does not reflect the page size.
+func (s *AuditStore) ListAuditEvents(opts *list.Options) ([]*model.AuditEvent, int, string, error) {
+	errorF := func(err error) ([]*model.AuditEvent, int, string, error) {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to list audit events: %v", err)
+	}
+
+	sqlBuilder := opts.AddFilterToSelect(sq.Select(auditEventColumns...).From("audit_events"))
+	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(sqlBuilder).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sq.Select("count(*)").From("audit_events")).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// Use a transaction to make sure we're returning the total_size of the same rows queried
+	tx, err := s.db.Begin()
+	if err != nil {
+		log.Errorf("Failed to start transaction to list audit events")
+		return errorF(err)
+	}
+
+	rows, err := tx.Query(rowsSql, rowsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	events, err := s.scanRows(rows)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	rows.Close()
+
+	sizeRow, err := tx.Query(sizeSql, sizeArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	totalSize, err := list.ScanRowToTotalSize(sizeRow)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	sizeRow.Close()
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf("Failed to commit transaction to list audit events")
+		return errorF(err)
+	}
+
+	if len(events) <= opts.PageSize {
+		return events, totalSize, "", nil
+	}
+
+	npt, err := opts.NextPageToken(events[opts.PageSize-1])
+	return events[:opts.PageSize], totalSize, npt, err
+}
+
+func (s *AuditStore) scanRows(rows *sql.Rows) ([]*model.AuditEvent, error) {
+	var events []*model.AuditEvent
+	for rows.Next() {
+		var event model.AuditEvent
+		var resourceBefore, resourceAfter, errorMessage sql.NullString
+		err := rows.Scan(
+			&event.UUID,
+			&event.CreatedAtInSec,
+			&event.Actor,
+			&event.Namespace,
+			&event.Method,
+			&event.ResourceId,
+			&resourceBefore,
+			&resourceAfter,
+			&event.Result,
+			&errorMessage,
+		)
+		if err != nil {
+			return events, err
+		}
+		event.ResourceBefore = resourceBefore.String
+		event.ResourceAfter = resourceAfter.String
+		event.Error = errorMessage.String
+		events = append(events, &event)
+	}
+	return events, nil
+}
+
+// factory function for audit store
+func NewAuditStore(db *DB) *AuditStore {
+	return &AuditStore{db: db}
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAuditEvent(id string, createdAtInSec int64, actor string) *model.AuditEvent {
+	return &model.AuditEvent{
+		UUID:           id,
+		CreatedAtInSec: createdAtInSec,
+		Actor:          actor,
+		Namespace:      "ns1",
+		Method:         "/api.RunService/CreateRun",
+		ResourceId:     "run1",
+		ResourceAfter:  `{"UUID":"run1"}`,
+		Result:         model.AuditResultSucceeded,
+	}
+}
+
+func TestListAuditEvents_Pagination(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	auditStore := NewAuditStore(db)
+	event1 := createAuditEvent(fakeID, 1, "user1")
+	event2 := createAuditEvent(fakeIDTwo, 2, "user2")
+	event3 := createAuditEvent(fakeIDThree, 3, "user1")
+	event3.Result = model.AuditResultFailed
+	event3.Error = "Unauthorized access"
+	for _, event := range []*model.AuditEvent{event1, event2, event3} {
+		assert.Nil(t, auditStore.CreateAuditEvent(event))
+	}
+
+	opts, err := list.NewOptions(&model.AuditEvent{}, 2, "", nil)
+	assert.Nil(t, err)
+	events, totalSize, nextPageToken, err := auditStore.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.NotEmpty(t, nextPageToken)
+	assert.Equal(t, []*model.AuditEvent{event1, event2}, events)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	assert.Nil(t, err)
+	events, totalSize, nextPageToken, err = auditStore.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, []*model.AuditEvent{event3}, events)
+}
+
+func TestListAuditEvents_Filter(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	auditStore := NewAuditStore(db)
+	for _, event := range []*model.AuditEvent{
+		createAuditEvent(fakeID, 1, "user1"),
+		createAuditEvent(fakeIDTwo, 2, "user2"),
+		createAuditEvent(fakeIDThree, 3, "user1"),
+	} {
+		assert.Nil(t, auditStore.CreateAuditEvent(event))
+	}
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{{
+			Key:   "actor",
+			Op:    api.Predicate_EQUALS,
+			Value: &api.Predicate_StringValue{StringValue: "user1"},
+		}},
+	}
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "created_at desc", filterProto)
+	assert.Nil(t, err)
+	events, totalSize, _, err := auditStore.ListAuditEvents(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Equal(t, fakeIDThree, events[0].UUID)
+	assert.Equal(t, fakeID, events[1].UUID)
+}
@@ -0,0 +1,219 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// azureCopyPollInterval is how often the status of a pending copy is checked.
+var azureCopyPollInterval = time.Second
+
+// Create interface for Azure Blob client struct, making it more unit testable.
+type AzureBlobClientInterface interface {
+	PutBlob(containerName, blobName string, content []byte) error
+	GetBlob(containerName, blobName string) (io.Reader, error)
+	OpenBlob(containerName, blobName string) (io.ReadCloser, error)
+	DeleteBlob(containerName, blobName string) error
+	CopyBlob(containerName, srcBlobName, dstBlobName string) error
+	ListBlobs(containerName, prefix string) ([]ObjectInfo, error)
+	SignURL(method, containerName, blobName string, expiry time.Duration) (*SignedURL, error)
+}
+
+// AzureBlobClient calls the Azure Blob Storage REST API. Client is expected to
+// authenticate the requests. Signer, when set, signs the URLs of the blobs.
+type AzureBlobClient struct {
+	Client   *http.Client
+	Endpoint string
+	Signer   URLSigner
+}
+
+func (c *AzureBlobClient) PutBlob(containerName, blobName string, content []byte) error {
+	request, err := http.NewRequest(http.MethodPut, c.blobURL(containerName, blobName), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/octet-stream")
+	request.Header.Set("x-ms-blob-type", "BlockBlob")
+	_, err = c.do(request)
+	return err
+}
+
+// OpenBlob streams the content of the blob, which the caller has to close.
+func (c *AzureBlobClient) OpenBlob(containerName, blobName string) (io.ReadCloser, error) {
+	request, err := http.NewRequest(http.MethodGet, c.blobURL(containerName, blobName), nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.open(request)
+}
+
+func (c *AzureBlobClient) GetBlob(containerName, blobName string) (io.Reader, error) {
+	request, err := http.NewRequest(http.MethodGet, c.blobURL(containerName, blobName), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (c *AzureBlobClient) DeleteBlob(containerName, blobName string) error {
+	request, err := http.NewRequest(http.MethodDelete, c.blobURL(containerName, blobName), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(request)
+	return err
+}
+
+// CopyBlob copies the blob within the container. Azure may copy the blob
+// asynchronously, in which case the destination is polled until the copy is
+// over.
+func (c *AzureBlobClient) CopyBlob(containerName, srcBlobName, dstBlobName string) error {
+	request, err := http.NewRequest(http.MethodPut, c.blobURL(containerName, dstBlobName), nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("x-ms-copy-source", c.blobURL(containerName, srcBlobName))
+	for {
+		response, err := c.send(request)
+		if err != nil {
+			return err
+		}
+		response.Body.Close()
+		switch status := response.Header.Get("x-ms-copy-status"); status {
+		case "", "success":
+			return nil
+		case "pending":
+			time.Sleep(azureCopyPollInterval)
+			if request, err = http.NewRequest(http.MethodHead, c.blobURL(containerName, dstBlobName), nil); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("copy of %s to %s is %s: %s", srcBlobName, dstBlobName, status,
+				response.Header.Get("x-ms-copy-status-description"))
+		}
+	}
+}
+
+type azureBlobList struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			LastModified  string `xml:"Last-Modified"`
+			ContentLength int64  `xml:"Content-Length"`
+			Etag          string `xml:"Etag"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (c *AzureBlobClient) ListBlobs(containerName, prefix string) ([]ObjectInfo, error) {
+	var blobs []ObjectInfo
+	marker := ""
+	for {
+		query := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {prefix}}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		listURL := fmt.Sprintf("%s/%s?%s", c.Endpoint, url.PathEscape(containerName), query.Encode())
+		request, err := http.NewRequest(http.MethodGet, listURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err := c.do(request)
+		if err != nil {
+			return nil, err
+		}
+		// The listing may start with a UTF-8 byte order mark.
+		body = bytes.TrimPrefix(body, []byte("\xef\xbb\xbf"))
+		var list azureBlobList
+		if err := xml.Unmarshal(body, &list); err != nil {
+			return nil, err
+		}
+		for _, blob := range list.Blobs {
+			lastModified, err := time.Parse(time.RFC1123, blob.Properties.LastModified)
+			if err != nil {
+				return nil, err
+			}
+			blobs = append(blobs, ObjectInfo{Key: blob.Name, Size: blob.Properties.ContentLength, LastModified: lastModified, ETag: blob.Properties.Etag})
+		}
+		if list.NextMarker == "" {
+			return blobs, nil
+		}
+		marker = list.NextMarker
+	}
+}
+
+func (c *AzureBlobClient) SignURL(method, containerName, blobName string, expiry time.Duration) (*SignedURL, error) {
+	if c.Signer == nil {
+		return nil, ErrURLSigningUnsupported
+	}
+	return c.Signer.SignURL(method, containerName, blobName, expiry)
+}
+
+// blobURL escapes each segment of the blob name, keeping its virtual folders.
+func (c *AzureBlobClient) blobURL(containerName, blobName string) string {
+	segments := strings.Split(blobName, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return fmt.Sprintf("%s/%s/%s", c.Endpoint, url.PathEscape(containerName), strings.Join(segments, "/"))
+}
+
+func (c *AzureBlobClient) do(request *http.Request) ([]byte, error) {
+	body, err := c.open(request)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// open sends the request and returns the body of a successful response, which
+// the caller has to close.
+func (c *AzureBlobClient) open(request *http.Request) (io.ReadCloser, error) {
+	response, err := c.send(request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// send sends the request and returns the response if it's successful, whose
+// body the caller has to close.
+func (c *AzureBlobClient) send(request *http.Request) (*http.Response, error) {
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+		return nil, fmt.Errorf("%s %s: %s: %s", request.Method, request.URL.Path, response.Status, body)
+	}
+	return response, nil
+}
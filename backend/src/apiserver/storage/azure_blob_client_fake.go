@@ -0,0 +1,110 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type FakeAzureBlobClient struct {
+	blobs        map[string][]byte
+	lastModified map[string]time.Time
+}
+
+func NewFakeAzureBlobClient() *FakeAzureBlobClient {
+	return &FakeAzureBlobClient{
+		blobs:        make(map[string][]byte),
+		lastModified: make(map[string]time.Time),
+	}
+}
+
+func (c *FakeAzureBlobClient) PutBlob(containerName, blobName string, content []byte) error {
+	c.blobs[blobName] = content
+	c.lastModified[blobName] = time.Now()
+	return nil
+}
+
+func (c *FakeAzureBlobClient) GetBlob(containerName, blobName string) (io.Reader, error) {
+	if _, ok := c.blobs[blobName]; !ok {
+		return nil, errors.New("blob not found")
+	}
+	return bytes.NewReader(c.blobs[blobName]), nil
+}
+
+func (c *FakeAzureBlobClient) OpenBlob(containerName, blobName string) (io.ReadCloser, error) {
+	reader, err := c.GetBlob(containerName, blobName)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(reader), nil
+}
+
+func (c *FakeAzureBlobClient) DeleteBlob(containerName, blobName string) error {
+	if _, ok := c.blobs[blobName]; !ok {
+		return errors.New("blob not found")
+	}
+	delete(c.blobs, blobName)
+	delete(c.lastModified, blobName)
+	return nil
+}
+
+func (c *FakeAzureBlobClient) CopyBlob(containerName, srcBlobName, dstBlobName string) error {
+	content, ok := c.blobs[srcBlobName]
+	if !ok {
+		return errors.New("blob not found")
+	}
+	c.blobs[dstBlobName] = content
+	c.lastModified[dstBlobName] = time.Now()
+	return nil
+}
+
+func (c *FakeAzureBlobClient) ListBlobs(containerName, prefix string) ([]ObjectInfo, error) {
+	var blobs []ObjectInfo
+	for blobName, content := range c.blobs {
+		if strings.HasPrefix(blobName, prefix) {
+			blobs = append(blobs, ObjectInfo{Key: blobName, Size: int64(len(content)), LastModified: c.lastModified[blobName]})
+		}
+	}
+	sort.Slice(blobs, func(i, j int) bool { return blobs[i].Key < blobs[j].Key })
+	return blobs, nil
+}
+
+func (c *FakeAzureBlobClient) SignURL(method, containerName, blobName string, expiry time.Duration) (*SignedURL, error) {
+	signedURL, err := fakeSignedURL("azure", containerName, blobName, expiry)
+	if err != nil {
+		return nil, err
+	}
+	if method == http.MethodPut {
+		return &SignedURL{URL: signedURL.String(), Headers: map[string]string{"x-ms-blob-type": "BlockBlob"}}, nil
+	}
+	return &SignedURL{URL: signedURL.String()}, nil
+}
+
+func (c *FakeAzureBlobClient) GetBlobCount() int {
+	return len(c.blobs)
+}
+
+func (c *FakeAzureBlobClient) ExistBlob(blobName string) bool {
+	_, ok := c.blobs[blobName]
+	return ok
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"io"
+	"path"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// Managing pipeline using Azure Blob Storage
+type AzureBlobObjectStore struct {
+	blobClient    AzureBlobClientInterface
+	containerName string
+	baseFolder    string
+}
+
+// GetPipelineKey adds the configured base folder to pipeline id.
+func (a *AzureBlobObjectStore) GetPipelineKey(pipelineID string) string {
+	return path.Join(a.baseFolder, pipelineID)
+}
+
+func (a *AzureBlobObjectStore) AddFile(file []byte, filePath string) error {
+	err := a.blobClient.PutBlob(a.containerName, filePath, file)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	return nil
+}
+
+// AddFileInNamespace stores a file like AddFile, the bucket settings apply to
+// every namespace.
+func (a *AzureBlobObjectStore) AddFileInNamespace(file []byte, filePath string, namespace string) error {
+	return a.AddFile(file, filePath)
+}
+
+func (a *AzureBlobObjectStore) DeleteFile(filePath string) error {
+	err := a.blobClient.DeleteBlob(a.containerName, filePath)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
+	}
+	return nil
+}
+
+// CopyFile copies a file like AddFileInNamespace, the container settings apply
+// to every namespace.
+func (a *AzureBlobObjectStore) CopyFile(srcPath string, dstPath string, namespace string) error {
+	err := a.blobClient.CopyBlob(a.containerName, srcPath, dstPath)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to copy %v to %v", srcPath, dstPath)
+	}
+	return nil
+}
+
+func (a *AzureBlobObjectStore) ComposeFile(srcPaths []string, dstPath string, namespace string) error {
+	return util.NewFailedPreconditionError(ErrComposeUnsupported, "Failed to compose %v", dstPath)
+}
+
+func (a *AzureBlobObjectStore) GetFile(filePath string) ([]byte, error) {
+	reader, err := a.blobClient.GetBlob(a.containerName, filePath)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(reader)
+	return buf.Bytes(), nil
+}
+
+func (a *AzureBlobObjectStore) OpenFile(filePath string) (io.ReadCloser, error) {
+	reader, err := a.blobClient.OpenBlob(a.containerName, filePath)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return reader, nil
+}
+
+func (a *AzureBlobObjectStore) ListFiles(prefix string) ([]ObjectInfo, error) {
+	blobs, err := a.blobClient.ListBlobs(a.containerName, prefix)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list %v", prefix)
+	}
+	return blobs, nil
+}
+
+func (a *AzureBlobObjectStore) GetSignedURL(filePath string, method string, expiry time.Duration) (*SignedURL, error) {
+	if err := validateSignedURLRequest(method, expiry); err != nil {
+		return nil, err
+	}
+	signedURL, err := a.blobClient.SignURL(method, a.containerName, filePath, expiry)
+	if err != nil {
+		return nil, signedURLError(err, filePath)
+	}
+	return signedURL, nil
+}
+
+func (a *AzureBlobObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(a, o, filePath)
+}
+
+func (a *AzureBlobObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(a, o, filePath)
+}
+
+// ForNamespace returns the store itself, namespaces can't be isolated in Azure
+// Blob Storage.
+func (a *AzureBlobObjectStore) ForNamespace(namespace string) (ObjectStoreInterface, error) {
+	return a, nil
+}
+
+func NewAzureBlobObjectStore(blobClient AzureBlobClientInterface, containerName string, baseFolder string) *AzureBlobObjectStore {
+	return &AzureBlobObjectStore{blobClient: blobClient, containerName: containerName, baseFolder: baseFolder}
+}
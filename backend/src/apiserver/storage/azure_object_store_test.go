@@ -0,0 +1,181 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAzureBlobAddFile(t *testing.T) {
+	blobClient := NewFakeAzureBlobClient()
+	manager := NewAzureBlobObjectStore(blobClient, "container", "pipeline")
+	error := manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, 1, blobClient.GetBlobCount())
+	assert.True(t, blobClient.ExistBlob("pipeline/1"))
+}
+
+func TestAzureBlobGetFileError(t *testing.T) {
+	manager := NewAzureBlobObjectStore(NewFakeAzureBlobClient(), "container", "pipeline")
+	_, error := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestAzureBlobDeleteFile(t *testing.T) {
+	blobClient := NewFakeAzureBlobClient()
+	manager := NewAzureBlobObjectStore(blobClient, "container", "pipeline")
+	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	error := manager.DeleteFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, 0, blobClient.GetBlobCount())
+}
+
+func TestAzureBlobCopyFile(t *testing.T) {
+	manager := NewAzureBlobObjectStore(NewFakeAzureBlobClient(), "container", "pipeline")
+	manager.AddFile([]byte("abc"), "artifacts/a")
+	error := manager.CopyFile("artifacts/a", "artifacts/b", "ns1")
+	assert.Nil(t, error)
+	file, error := manager.GetFile("artifacts/b")
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abc"), file)
+}
+
+func TestAzureBlobYamlFile(t *testing.T) {
+	manager := NewAzureBlobObjectStore(NewFakeAzureBlobClient(), "container", "pipeline")
+	error := manager.AddAsYamlFile(Foo{ID: 1}, manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	var foo Foo
+	error = manager.GetFromYamlFile(&foo, manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, Foo{ID: 1}, foo)
+}
+
+func TestAzureBlobClient(t *testing.T) {
+	blobs := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/container/pipeline/1" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			if r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+				http.Error(w, "missing blob type", http.StatusBadRequest)
+				return
+			}
+			blobs["pipeline/1"], _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			content, ok := blobs["pipeline/1"]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write(content)
+		case http.MethodDelete:
+			delete(blobs, "pipeline/1")
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+	manager := NewAzureBlobObjectStore(&AzureBlobClient{Client: server.Client(), Endpoint: server.URL}, "container", "pipeline")
+
+	assert.Nil(t, manager.AddFile([]byte("abc"), manager.GetPipelineKey("1")))
+	file, err := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), file)
+	reader, err := manager.OpenFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	file, err = ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), file)
+	assert.Nil(t, reader.Close())
+	assert.Nil(t, manager.DeleteFile(manager.GetPipelineKey("1")))
+	_, err = manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestAzureBlobClientListBlobs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodGet || r.URL.Path != "/container" || query.Get("comp") != "list" || query.Get("prefix") != "artifacts/" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if query.Get("marker") == "" {
+			w.Write([]byte("\xef\xbb\xbf<?xml version=\"1.0\" encoding=\"utf-8\"?><EnumerationResults><Blobs><Blob><Name>artifacts/a</Name>" +
+				"<Properties><Last-Modified>Mon, 02 Jan 2023 03:04:05 GMT</Last-Modified><Content-Length>3</Content-Length></Properties>" +
+				"</Blob></Blobs><NextMarker>next</NextMarker></EnumerationResults>"))
+			return
+		}
+		w.Write([]byte("<EnumerationResults><Blobs><Blob><Name>artifacts/b</Name>" +
+			"<Properties><Last-Modified>Mon, 02 Jan 2023 03:04:05 GMT</Last-Modified><Content-Length>5</Content-Length></Properties>" +
+			"</Blob></Blobs><NextMarker/></EnumerationResults>"))
+	}))
+	defer server.Close()
+	manager := NewAzureBlobObjectStore(&AzureBlobClient{Client: server.Client(), Endpoint: server.URL}, "container", "pipeline")
+
+	files, err := manager.ListFiles("artifacts/")
+	assert.Nil(t, err)
+	lastModified := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, 2, len(files))
+	assert.Equal(t, "artifacts/a", files[0].Key)
+	assert.Equal(t, int64(3), files[0].Size)
+	assert.True(t, lastModified.Equal(files[0].LastModified))
+	assert.Equal(t, "artifacts/b", files[1].Key)
+	assert.Equal(t, int64(5), files[1].Size)
+}
+
+func TestAzureBlobClientCopyBlob(t *testing.T) {
+	azureCopyPollInterval = 0
+	defer func() { azureCopyPollInterval = time.Second }()
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/container/artifacts/b" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			if !strings.HasSuffix(r.Header.Get("x-ms-copy-source"), "/container/artifacts/a") {
+				http.Error(w, "unexpected copy source", http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("x-ms-copy-status", "pending")
+			w.WriteHeader(http.StatusAccepted)
+		case http.MethodHead:
+			polls++
+			if polls == 1 {
+				w.Header().Set("x-ms-copy-status", "pending")
+			} else {
+				w.Header().Set("x-ms-copy-status", "success")
+			}
+		}
+	}))
+	defer server.Close()
+	manager := NewAzureBlobObjectStore(&AzureBlobClient{Client: server.Client(), Endpoint: server.URL}, "container", "pipeline")
+
+	assert.Nil(t, manager.CopyFile("artifacts/a", "artifacts/b", "ns1"))
+	assert.Equal(t, 2, polls)
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"regexp"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// BackupTables are the tables of the pipelines, runs, jobs and experiments which
+// are backed up, in the order they're restored, so that the rows referenced by
+// foreign keys are restored first.
+var BackupTables = []string{
+	"experiments", "default_experiments", "namespace_default_experiments", "experiment_defaults", "experiment_budgets",
+	"pipelines", "pipeline_versions", "jobs", "run_details", "run_metrics", "tasks", "resource_references",
+	"run_details_archive", "run_metrics_archive", "artifact_blobs", "artifact_references", "artifact_metadata",
+}
+
+// The tables which have to be empty for a backup to be restored, so that the
+// runs and jobs of an installation aren't replaced by mistake.
+var restoreEmptyTables = []string{"run_details", "run_details_archive", "jobs"}
+
+// backupColumnPattern matches the names of the columns a backup can restore, so
+// that they're safe to use in the queries.
+var backupColumnPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+type BackupStoreInterface interface {
+	// SchemaVersion returns the version of the last migration applied to the
+	// database, which a backup is restored to.
+	SchemaVersion() (int64, error)
+	// Backup reads the rows of the backup tables, in order, from a consistent
+	// snapshot of the database. The rows map the columns to their values.
+	Backup(ctx context.Context, write func(table string, row map[string]interface{}) error) error
+	// Restore replaces the rows of the backup tables with the rows read until
+	// io.EOF, in a single transaction, keeping their IDs. It fails when the
+	// database has runs or jobs. It returns the number of rows restored in each
+	// table.
+	Restore(ctx context.Context, read func() (string, map[string]interface{}, error)) (map[string]int, error)
+}
+
+type BackupStore struct {
+	db *DB
+}
+
+func (s *BackupStore) SchemaVersion() (int64, error) {
+	var version sql.NullInt64
+	if err := s.db.QueryRow("SELECT MAX(Version) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get the schema version")
+	}
+	return version.Int64, nil
+}
+
+func (s *BackupStore) Backup(ctx context.Context, write func(table string, row map[string]interface{}) error) error {
+	// A repeatable read transaction reads all the tables from the same snapshot.
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start the backup transaction")
+	}
+	defer tx.Rollback()
+	for _, table := range BackupTables {
+		if err := backupTable(ctx, tx, table, write); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func backupTable(ctx context.Context, tx *sql.Tx, table string, write func(table string, row map[string]interface{}) error) error {
+	rows, err := tx.QueryContext(ctx, "SELECT * FROM "+table)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to back up table %s", table)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to get the columns of table %s", table)
+	}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return util.NewInternalServerError(err, "Failed to back up table %s", table)
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			// The drivers return the text columns as bytes.
+			if value, ok := values[i].([]byte); ok {
+				row[column] = string(value)
+			} else {
+				row[column] = values[i]
+			}
+		}
+		if err := write(table, row); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return util.NewInternalServerError(err, "Failed to back up table %s", table)
+	}
+	return nil
+}
+
+func (s *BackupStore) Restore(ctx context.Context, read func() (string, map[string]interface{}, error)) (map[string]int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to start the restore transaction")
+	}
+	defer tx.Rollback()
+	for _, table := range restoreEmptyTables {
+		var count int64
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table).Scan(&count); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to count the rows of table %s", table)
+		}
+		if count > 0 {
+			return nil, util.NewFailedPreconditionError(errors.New("installation not empty"),
+				"Table %s has %d rows, a backup is only restored to a fresh installation", table, count)
+		}
+	}
+	// The sample pipelines and the default experiment of the fresh installation
+	// are replaced. The tables referencing others are emptied first.
+	for i := len(BackupTables) - 1; i >= 0; i-- {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+BackupTables[i]); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to empty table %s", BackupTables[i])
+		}
+	}
+
+	restored := map[string]int{}
+	position := 0
+	for {
+		table, row, err := read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to read the backup")
+		}
+		// The rows are restored in the order of their tables.
+		index := backupTableIndex(table)
+		if index < position {
+			return nil, util.NewInvalidInputError("Unexpected rows of table %q in the backup", table)
+		}
+		position = index
+		for column := range row {
+			if !backupColumnPattern.MatchString(column) {
+				return nil, util.NewInvalidInputError("Invalid column %q of table %s in the backup", column, table)
+			}
+		}
+		query, args, err := sq.Insert(table).SetMap(row).ToSql()
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Error creating query to restore a row of table %s", table)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to restore a row of table %s", table)
+		}
+		restored[table]++
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to commit the restore")
+	}
+	return restored, nil
+}
+
+// backupTableIndex returns the position of a table in the restore order, or -1
+// if the table isn't backed up.
+func backupTableIndex(table string) int {
+	for i, backupTable := range BackupTables {
+		if backupTable == table {
+			return i
+		}
+	}
+	return -1
+}
+
+func NewBackupStore(db *DB) *BackupStore {
+	return &BackupStore{db: db}
+}
@@ -22,25 +22,40 @@ import (
 
 	"github.com/VividCortex/mysqlerr"
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 	sqlite3 "github.com/mattn/go-sqlite3"
 )
 
 // DB a struct wrapping plain sql library with SQL dialect, to solve any feature
-// difference between MySQL, which is used in production, and Sqlite, which is used
-// for unit testing.
+// difference between MySQL and PostgreSQL, which are used in production, and Sqlite,
+// which is used for unit testing.
 type DB struct {
 	*sql.DB
 	SQLDialect
+	replicas  *replicaSet
+	encryptor *Encryptor
 }
 
 // NewDB creates a DB
 func NewDB(db *sql.DB, dialect SQLDialect) *DB {
-	return &DB{db, dialect}
+	return &DB{DB: db, SQLDialect: dialect}
+}
+
+// SetEncryptor makes the stores encrypt the pipeline manifests and the parameters
+// of the runs and jobs they write. The values stored as is stay readable.
+func (d *DB) SetEncryptor(encryptor *Encryptor) {
+	d.encryptor = encryptor
+}
+
+// Encryptor returns the encryptor of the sensitive values, or nil when they are
+// stored as is.
+func (d *DB) Encryptor() *Encryptor {
+	return d.encryptor
 }
 
 // SQLDialect abstracts common sql queries which vary in different dialect.
-// It is used to bridge the difference between mysql (production) and sqlite
-// (test).
+// It is used to bridge the difference between mysql and postgresql (production)
+// and sqlite (test).
 type SQLDialect interface {
 	// GroupConcat builds query to group concatenate `expr` in each row and use `separator`
 	// to join rows in a group.
@@ -56,6 +71,10 @@ type SQLDialect interface {
 	// Modifies the SELECT clause in query to return one that locks the selected
 	// row for update.
 	SelectForUpdate(query string) string
+
+	// GroupByColumns returns the columns to group by in a query which selects `columns`
+	// along with aggregates of the rows joined to each `key`.
+	GroupByColumns(key string, columns []string) []string
 }
 
 // MySQLDialect implements SQLDialect with mysql dialect implementation.
@@ -115,11 +134,54 @@ func (d SQLiteDialect) SelectForUpdate(query string) string {
 	return query
 }
 
+// MySQL infers the other selected columns from the key they depend on.
+func (d MySQLDialect) GroupByColumns(key string, columns []string) []string {
+	return []string{key}
+}
+
+func (d SQLiteDialect) GroupByColumns(key string, columns []string) []string {
+	return []string{key}
+}
+
 func (d SQLiteDialect) IsDuplicateError(err error) bool {
 	sqlError, ok := err.(sqlite3.Error)
 	return ok && sqlError.Code == sqlite3.ErrConstraint
 }
 
+// PostgreSQLDialect implements SQLDialect with postgresql dialect implementation.
+type PostgreSQLDialect struct{}
+
+func (d PostgreSQLDialect) GroupConcat(expr string, separator string) string {
+	return fmt.Sprintf("string_agg(%s, %s)", expr, quotePostgreSQLString(separator))
+}
+
+func (d PostgreSQLDialect) Concat(exprs []string, separator string) string {
+	separatorSQL := "||"
+	if separator != "" {
+		separatorSQL = fmt.Sprintf("||%s||", quotePostgreSQLString(separator))
+	}
+	return strings.Join(exprs, separatorSQL)
+}
+
+func (d PostgreSQLDialect) IsDuplicateError(err error) bool {
+	sqlError, ok := err.(*pq.Error)
+	return ok && sqlError.Code == pgUniqueViolation
+}
+
+func (d PostgreSQLDialect) SelectForUpdate(query string) string {
+	return query + " FOR UPDATE"
+}
+
+// PostgreSQL only infers the columns depending on the primary key of a table, so
+// all the columns selected from a subquery need to be grouped by.
+func (d PostgreSQLDialect) GroupByColumns(key string, columns []string) []string {
+	return columns
+}
+
+func quotePostgreSQLString(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
 func NewMySQLDialect() MySQLDialect {
 	return MySQLDialect{}
 }
@@ -127,3 +189,7 @@ func NewMySQLDialect() MySQLDialect {
 func NewSQLiteDialect() SQLiteDialect {
 	return SQLiteDialect{}
 }
+
+func NewPostgreSQLDialect() PostgreSQLDialect {
+	return PostgreSQLDialect{}
+}
@@ -17,10 +17,10 @@ package storage
 import (
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/jinzhu/gorm"
-	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 	_ "github.com/mattn/go-sqlite3"
+	log "github.com/sirupsen/logrus"
 )
 
 func NewFakeDb() (*DB, error) {
@@ -29,26 +29,18 @@ func NewFakeDb() (*DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Could not create the GORM database: %v", err)
 	}
+	fakeDB := NewDB(db.DB(), NewSQLiteDialect())
 	// Create tables
-	db.AutoMigrate(
-		&model.Experiment{},
-		&model.Job{},
-		&model.Pipeline{},
-		&model.PipelineVersion{},
-		&model.ResourceReference{},
-		&model.RunDetail{},
-		&model.RunMetric{},
-		&model.Task{},
-		&model.DBStatus{},
-		&model.DefaultExperiment{})
-
-	return NewDB(db.DB(), NewSQLiteDialect()), nil
+	if err := NewMigrator(fakeDB, Migrations, util.NewFakeTimeForEpoch()).MigrateToLatest(); err != nil {
+		return nil, fmt.Errorf("Could not create the tables: %v", err)
+	}
+	return fakeDB, nil
 }
 
 func NewFakeDbOrFatal() *DB {
 	db, err := NewFakeDb()
 	if err != nil {
-		glog.Fatalf("The fake DB doesn't create successfully. Fail fast.")
+		log.Fatalf("The fake DB doesn't create successfully. Fail fast.")
 	}
 	return db
 }
@@ -0,0 +1,211 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+// MySQLDriverName is the name of the database/sql driver for MySQL. Like the
+// PostgreSQL driver, it measures the duration of the queries.
+const MySQLDriverName = "kfp-mysql"
+
+var (
+	queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "The duration of the database queries, by operation",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 8),
+	}, []string{"operation"})
+	slowQueries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_slow_queries_total",
+		Help: "The number of database queries slower than the slow query threshold",
+	})
+
+	// slowQueryThreshold holds the time.Duration past which the queries are logged.
+	slowQueryThreshold int64
+)
+
+func init() {
+	sql.Register(MySQLDriverName, instrumentedDriver{&mysql.MySQLDriver{}})
+}
+
+// SetSlowQueryThreshold logs the queries taking longer than the threshold, and
+// counts them. Zero disables the logging.
+func SetSlowQueryThreshold(threshold time.Duration) {
+	atomic.StoreInt64(&slowQueryThreshold, int64(threshold))
+}
+
+func observeQuery(query string, start time.Time) {
+	elapsed := time.Since(start)
+	queryDuration.WithLabelValues(queryOperation(query)).Observe(elapsed.Seconds())
+	threshold := time.Duration(atomic.LoadInt64(&slowQueryThreshold))
+	if threshold > 0 && elapsed >= threshold {
+		slowQueries.Inc()
+		log.Warningf("Slow query took %v: %s", elapsed, query)
+	}
+}
+
+// queryOperations are the statements the durations of the queries are labeled
+// with, the others being labeled as other.
+var queryOperations = map[string]bool{"select": true, "insert": true, "update": true, "delete": true}
+
+// queryOperation returns the statement of a query, e.g. select.
+func queryOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "other"
+	}
+	operation := strings.ToLower(fields[0])
+	if !queryOperations[operation] {
+		return "other"
+	}
+	return operation
+}
+
+type instrumentedDriver struct {
+	driver.Driver
+}
+
+func (d instrumentedDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return instrumentedConn{conn}, nil
+}
+
+// instrumentedConn measures the queries run directly on the connection, and
+// through the statements it prepares.
+type instrumentedConn struct {
+	driver.Conn
+}
+
+func (c instrumentedConn) Prepare(query string) (driver.Stmt, error) {
+	stmt, err := c.Conn.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	return instrumentedStmt{stmt, query}, nil
+}
+
+func (c instrumentedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	preparer, ok := c.Conn.(driver.ConnPrepareContext)
+	if !ok {
+		return c.Prepare(query)
+	}
+	stmt, err := preparer.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return instrumentedStmt{stmt, query}, nil
+}
+
+func (c instrumentedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	result, err := execer.ExecContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		observeQuery(query, start)
+	}
+	return result, err
+}
+
+func (c instrumentedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		observeQuery(query, start)
+	}
+	return rows, err
+}
+
+func (c instrumentedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c instrumentedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c instrumentedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+type instrumentedStmt struct {
+	driver.Stmt
+	query string
+}
+
+func (s instrumentedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	defer observeQuery(s.query, time.Now())
+	return s.Stmt.Exec(args)
+}
+
+func (s instrumentedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	defer observeQuery(s.query, time.Now())
+	return s.Stmt.Query(args)
+}
+
+func (s instrumentedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := s.Stmt.(driver.StmtExecContext)
+	if !ok {
+		return s.Exec(namedValuesToValues(args))
+	}
+	defer observeQuery(s.query, time.Now())
+	return execer.ExecContext(ctx, args)
+}
+
+func (s instrumentedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := s.Stmt.(driver.StmtQueryContext)
+	if !ok {
+		return s.Query(namedValuesToValues(args))
+	}
+	defer observeQuery(s.query, time.Now())
+	return queryer.QueryContext(ctx, args)
+}
+
+func namedValuesToValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	return values
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	sql.Register("instrumented-sqlite3", instrumentedDriver{&sqlite3.SQLiteDriver{}})
+}
+
+func TestInstrumentedDriver_CountsSlowQueries(t *testing.T) {
+	db, err := sql.Open("instrumented-sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	defer SetSlowQueryThreshold(0)
+
+	_, err = db.Exec("CREATE TABLE t (v INTEGER)")
+	assert.Nil(t, err)
+	before := testutil.ToFloat64(slowQueries)
+
+	SetSlowQueryThreshold(time.Hour)
+	_, err = db.Exec("INSERT INTO t (v) VALUES (?)", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, before, testutil.ToFloat64(slowQueries))
+
+	SetSlowQueryThreshold(time.Nanosecond)
+	_, err = db.Exec("INSERT INTO t (v) VALUES (?)", 2)
+	assert.Nil(t, err)
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT count(*) FROM t WHERE v > ?", 0).Scan(&count))
+	assert.Equal(t, 2, count)
+	assert.Equal(t, before+2, testutil.ToFloat64(slowQueries))
+}
+
+func TestQueryOperation(t *testing.T) {
+	assert.Equal(t, "select", queryOperation("SELECT count(*) FROM t"))
+	assert.Equal(t, "insert", queryOperation("\n  insert INTO t (v) VALUES (?)"))
+	assert.Equal(t, "update", queryOperation("UPDATE t SET v = ?"))
+	assert.Equal(t, "delete", queryOperation("DELETE FROM t"))
+	assert.Equal(t, "other", queryOperation("CREATE TABLE t (v INTEGER)"))
+	assert.Equal(t, "other", queryOperation(""))
+}
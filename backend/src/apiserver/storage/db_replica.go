@@ -0,0 +1,164 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// replica is a read replica of the database, in sync while its replication lag
+// is within the tolerated lag.
+type replica struct {
+	db     *DB
+	inSync int32
+}
+
+// replicaSet routes the reads which tolerate a stale result, such as listing
+// runs, to the read replicas of the database.
+type replicaSet struct {
+	replicas []*replica
+	next     uint32
+	stop     chan struct{}
+}
+
+// SetReadReplicas sends the reads of the stores tolerating a result as stale as
+// maxLag to the replicas, round robin. The lag of the replicas is checked every
+// interval: a replica lagging further behind, or unreachable, is skipped until it
+// catches up. The reads fall back to the primary when no replica is in sync.
+func (d *DB) SetReadReplicas(replicas []*sql.DB, maxLag time.Duration, interval time.Duration) {
+	set := &replicaSet{stop: make(chan struct{})}
+	for _, db := range replicas {
+		set.replicas = append(set.replicas, &replica{db: NewDB(db, d.SQLDialect)})
+	}
+	set.checkLag(maxLag)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				set.checkLag(maxLag)
+			case <-set.stop:
+				return
+			}
+		}
+	}()
+	d.replicas = set
+}
+
+// Reader returns the database to send the reads tolerating a stale result to:
+// one of the read replicas in sync, or the primary.
+func (d *DB) Reader() *DB {
+	if d.replicas == nil {
+		return d
+	}
+	count := uint32(len(d.replicas.replicas))
+	start := atomic.AddUint32(&d.replicas.next, 1)
+	for i := uint32(0); i < count; i++ {
+		r := d.replicas.replicas[(start+i)%count]
+		if atomic.LoadInt32(&r.inSync) == 1 {
+			return r.db
+		}
+	}
+	return d
+}
+
+// Close closes the connections to the database and to its read replicas.
+func (d *DB) Close() error {
+	if d.replicas != nil {
+		close(d.replicas.stop)
+		for _, r := range d.replicas.replicas {
+			r.db.Close()
+		}
+	}
+	return d.DB.Close()
+}
+
+func (s *replicaSet) checkLag(maxLag time.Duration) {
+	for i, r := range s.replicas {
+		lag, err := replicationLag(r.db)
+		inSync := err == nil && lag <= maxLag
+		if err != nil {
+			log.Warningf("Failed to check the replication lag of read replica %d: %v", i, err)
+		} else if !inSync {
+			log.Warningf("Read replica %d lags %v behind the primary, reading from the other replicas", i, lag)
+		}
+		var value int32
+		if inSync {
+			value = 1
+		}
+		atomic.StoreInt32(&r.inSync, value)
+	}
+}
+
+// replicationLag returns how far behind its primary the replica is.
+func replicationLag(db *DB) (time.Duration, error) {
+	switch db.SQLDialect.(type) {
+	case PostgreSQLDialect:
+		var seconds float64
+		err := db.QueryRow(`SELECT CASE WHEN pg_is_in_recovery()
+			THEN COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) ELSE 0 END`).Scan(&seconds)
+		if err != nil {
+			return 0, errors.Wrap(err, "Failed to query the replication lag")
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	case MySQLDialect:
+		return mySQLReplicationLag(db)
+	default:
+		return 0, db.Ping()
+	}
+}
+
+// mySQLReplicationLag reads the Seconds_Behind_Master column of the replica
+// status, which is NULL when the replication is stopped.
+func mySQLReplicationLag(db *DB) (time.Duration, error) {
+	rows, err := db.Query("SHOW SLAVE STATUS")
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to query the replica status")
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		// Not a replica.
+		return 0, rows.Err()
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to read the replica status")
+	}
+	values := make([]sql.NullString, len(columns))
+	pointers := make([]interface{}, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	if err := rows.Scan(pointers...); err != nil {
+		return 0, errors.Wrap(err, "Failed to read the replica status")
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Master" {
+			continue
+		}
+		if !values[i].Valid {
+			return 0, errors.New("Replication is stopped")
+		}
+		seconds, err := time.ParseDuration(values[i].String + "s")
+		return seconds, errors.Wrap(err, "Failed to parse the replication lag")
+	}
+	return 0, errors.New("Replica status has no Seconds_Behind_Master column")
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReader_NoReplicas(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+
+	assert.Equal(t, db, db.Reader())
+}
+
+func TestReader_RoutesToReplicasInSync(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	replica1 := NewFakeDbOrFatal()
+	replica2 := NewFakeDbOrFatal()
+	db.SetReadReplicas([]*sql.DB{replica1.DB, replica2.DB}, time.Second, time.Hour)
+
+	first := db.Reader()
+	second := db.Reader()
+	assert.NotEqual(t, db, first)
+	assert.NotEqual(t, db, second)
+	assert.NotEqual(t, first.DB, second.DB)
+	assert.Equal(t, first.DB, db.Reader().DB)
+
+	// The unreachable replica is skipped, then the primary is read.
+	replica1.DB.Close()
+	db.replicas.checkLag(time.Second)
+	assert.Equal(t, replica2.DB, db.Reader().DB)
+	assert.Equal(t, replica2.DB, db.Reader().DB)
+	replica2.DB.Close()
+	db.replicas.checkLag(time.Second)
+	assert.Equal(t, db, db.Reader())
+}
@@ -15,9 +15,11 @@
 package storage
 
 import (
+	"context"
+
 	sq "github.com/Masterminds/squirrel"
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 )
 
 var (
@@ -27,6 +29,8 @@ var (
 type DBStatusStoreInterface interface {
 	HaveSamplesLoaded() (bool, error)
 	MarkSampleLoaded() error
+	// Ping checks that the database is reachable.
+	Ping(ctx context.Context) error
 }
 
 var (
@@ -75,7 +79,7 @@ func (s *DBStatusStore) InitializeDBStatusTable() error {
 	}
 	err = tx.Commit()
 	if err != nil {
-		glog.Error("Failed to commit transaction to initialize database status table")
+		log.Error("Failed to commit transaction to initialize database status table")
 		return util.NewInternalServerError(err, "Failed to initializing the database status table.")
 	}
 	return nil
@@ -117,6 +121,13 @@ func (s *DBStatusStore) MarkSampleLoaded() error {
 	return nil
 }
 
+func (s *DBStatusStore) Ping(ctx context.Context) error {
+	if err := s.db.PingContext(ctx); err != nil {
+		return util.NewInternalServerError(err, "Failed to reach the database")
+	}
+	return nil
+}
+
 // factory function for database status store
 func NewDBStatusStore(db *DB) *DBStatusStore {
 	s := &DBStatusStore{db: db}
@@ -91,3 +91,47 @@ func TestSQLiteDialect_Concat_WithoutSeparator(t *testing.T) {
 	expectedQuery := `col1||col2`
 	assert.Equal(t, expectedQuery, actualQuery)
 }
+
+func TestPostgreSQLDialect_GroupConcat_WithSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.GroupConcat(`col1||','||col2`, ";")
+
+	expectedQuery := `string_agg(col1||','||col2, ';')`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_GroupConcat_WithoutSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.GroupConcat(`col1||','||col2`, "")
+
+	expectedQuery := `string_agg(col1||','||col2, '')`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_Concat_WithSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.Concat([]string{"col1", "col2"}, "'")
+
+	expectedQuery := `col1||''''||col2`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_Concat_WithoutSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.Concat([]string{"col1", "col2"}, "")
+
+	expectedQuery := `col1||col2`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestDialect_GroupByColumns(t *testing.T) {
+	columns := []string{"rd.UUID", "rd.Name"}
+
+	assert.Equal(t, []string{"rd.UUID"}, NewMySQLDialect().GroupByColumns("rd.UUID", columns))
+	assert.Equal(t, []string{"rd.UUID"}, NewSQLiteDialect().GroupByColumns("rd.UUID", columns))
+	assert.Equal(t, columns, NewPostgreSQLDialect().GroupByColumns("rd.UUID", columns))
+}
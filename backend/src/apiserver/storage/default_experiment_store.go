@@ -18,8 +18,8 @@ import (
 	"database/sql"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 )
 
 var (
@@ -29,6 +29,8 @@ var (
 type DefaultExperimentStoreInterface interface {
 	GetDefaultExperimentId() (string, error)
 	SetDefaultExperimentId(id string) error
+	GetNamespaceDefaultExperimentId(namespace string) (string, error)
+	SetNamespaceDefaultExperimentId(namespace string, id string) error
 }
 
 // Implementation of a DefaultExperimentStoreInterface. This stores the default experiment's ID,
@@ -71,7 +73,7 @@ func (s *DefaultExperimentStore) initializeDefaultExperimentTable() error {
 	}
 	err = tx.Commit()
 	if err != nil {
-		glog.Error("Failed to commit transaction to initialize default experiment table")
+		log.Error("Failed to commit transaction to initialize default experiment table")
 		return util.NewInternalServerError(err, "Failed to initializing the default experiment table.")
 	}
 	return nil
@@ -137,6 +139,81 @@ func (s *DefaultExperimentStore) UnsetDefaultExperimentIdIfIdMatches(tx *sql.Tx,
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to clear default experiment with ID: %s", id)
 	}
+
+	// The namespace whose default experiment is deleted gets a new one on its next run.
+	sql, args, err = sq.
+		Delete("namespace_default_experiments").
+		Where(sq.Eq{"ExperimentId": id}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create command to clear namespace default experiment with ID: %s", id)
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to clear namespace default experiment with ID: %s", id)
+	}
+	return nil
+}
+
+// GetNamespaceDefaultExperimentId returns the ID of the default experiment of a
+// namespace, or the empty string if the namespace doesn't have one yet.
+func (s *DefaultExperimentStore) GetNamespaceDefaultExperimentId(namespace string) (string, error) {
+	sql, args, err := sq.
+		Select("ExperimentId").
+		From("namespace_default_experiments").
+		Where(sq.Eq{"Namespace": namespace}).
+		ToSql()
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Error creating query to get the default experiment ID of namespace %s", namespace)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Error when getting the default experiment ID of namespace %s", namespace)
+	}
+	defer rows.Close()
+
+	var experimentId string
+	if rows.Next() {
+		if err = rows.Scan(&experimentId); err != nil {
+			return "", util.NewInternalServerError(err, "Error when scanning row to find the default experiment ID of namespace %s", namespace)
+		}
+	}
+	return experimentId, nil
+}
+
+// SetNamespaceDefaultExperimentId sets the default experiment of a namespace,
+// replacing the previous one.
+func (s *DefaultExperimentStore) SetNamespaceDefaultExperimentId(namespace string, id string) error {
+	deleteSql, deleteArgs, err := sq.
+		Delete("namespace_default_experiments").
+		Where(sq.Eq{"Namespace": namespace}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to set the default experiment of namespace %s", namespace)
+	}
+	insertSql, insertArgs, err := sq.
+		Insert("namespace_default_experiments").
+		SetMap(sq.Eq{"Namespace": namespace, "ExperimentId": id}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to set the default experiment of namespace %s", namespace)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start a transaction to set the default experiment of namespace %s", namespace)
+	}
+	if _, err = tx.Exec(deleteSql, deleteArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Error clearing the default experiment of namespace %s", namespace)
+	}
+	if _, err = tx.Exec(insertSql, insertArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Error setting the default experiment of namespace %s", namespace)
+	}
+	if err = tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to commit the default experiment of namespace %s", namespace)
+	}
 	return nil
 }
 
@@ -93,3 +93,33 @@ func TestUnsetDefaultExperimentIdIfIdMatches(t *testing.T) {
 
 	db.Close()
 }
+
+func TestGetAndSetNamespaceDefaultExperimentId(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	defaultExperimentStore := NewDefaultExperimentStore(db)
+
+	// A namespace has no default experiment until one is set
+	experimentId, err := defaultExperimentStore.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, "", experimentId)
+
+	assert.Nil(t, defaultExperimentStore.SetNamespaceDefaultExperimentId("ns1", "exp1"))
+	assert.Nil(t, defaultExperimentStore.SetNamespaceDefaultExperimentId("ns2", "exp2"))
+	// Setting it again replaces it
+	assert.Nil(t, defaultExperimentStore.SetNamespaceDefaultExperimentId("ns1", "exp3"))
+	experimentId, err = defaultExperimentStore.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, "exp3", experimentId)
+
+	// Clearing the experiment only clears the namespace it's the default of
+	tx, _ := db.Begin()
+	assert.Nil(t, defaultExperimentStore.UnsetDefaultExperimentIdIfIdMatches(tx, "exp3"))
+	assert.Nil(t, tx.Commit())
+	experimentId, err = defaultExperimentStore.GetNamespaceDefaultExperimentId("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, "", experimentId)
+	experimentId, err = defaultExperimentStore.GetNamespaceDefaultExperimentId("ns2")
+	assert.Nil(t, err)
+	assert.Equal(t, "exp2", experimentId)
+}
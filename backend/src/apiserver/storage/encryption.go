@@ -0,0 +1,290 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	// encryptedPrefix starts the encrypted values, so that the values stored
+	// before the encryption was enabled are still read as is.
+	encryptedPrefix = "kfpenc:v1:"
+	// dataKeySize is the size of the AES-256 data keys.
+	dataKeySize = 32
+	// CloudKMSEndpoint is the endpoint of the Google Cloud KMS API.
+	CloudKMSEndpoint = "https://cloudkms.googleapis.com"
+)
+
+// KeyEncryptionKey wraps the data keys that encrypt the sensitive values.
+type KeyEncryptionKey interface {
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+// LocalKeyEncryptionKey wraps the data keys with an AES-256 key held by the API
+// server.
+type LocalKeyEncryptionKey struct {
+	aead cipher.AEAD
+}
+
+func NewLocalKeyEncryptionKey(key []byte) (*LocalKeyEncryptionKey, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("the local encryption key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalKeyEncryptionKey{aead: aead}, nil
+}
+
+func (k *LocalKeyEncryptionKey) WrapKey(dataKey []byte) ([]byte, error) {
+	return seal(k.aead, dataKey)
+}
+
+func (k *LocalKeyEncryptionKey) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	return open(k.aead, wrappedKey)
+}
+
+// CloudKMSKeyEncryptionKey wraps the data keys with a Google Cloud KMS key, named
+// projects/*/locations/*/keyRings/*/cryptoKeys/*. Client is expected to
+// authenticate the requests.
+type CloudKMSKeyEncryptionKey struct {
+	Client   *http.Client
+	Endpoint string
+	KeyName  string
+}
+
+func (k *CloudKMSKeyEncryptionKey) WrapKey(dataKey []byte) ([]byte, error) {
+	var response struct {
+		Ciphertext []byte `json:"ciphertext"`
+	}
+	err := k.call("encrypt", map[string][]byte{"plaintext": dataKey}, &response)
+	return response.Ciphertext, err
+}
+
+func (k *CloudKMSKeyEncryptionKey) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	var response struct {
+		Plaintext []byte `json:"plaintext"`
+	}
+	err := k.call("decrypt", map[string][]byte{"ciphertext": wrappedKey}, &response)
+	return response.Plaintext, err
+}
+
+// call sends a request to a method of the key. The []byte fields are base64
+// encoded in JSON, as the API expects.
+func (k *CloudKMSKeyEncryptionKey) call(method string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/v1/%s:%s", k.Endpoint, k.KeyName, method)
+	httpResponse, err := k.Client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return util.Wrapf(err, "Failed to %s a data key with KMS key %s", method, k.KeyName)
+	}
+	defer httpResponse.Body.Close()
+	content, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return util.Wrapf(err, "Failed to %s a data key with KMS key %s", method, k.KeyName)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to %s a data key with KMS key %s: %s: %s", method, k.KeyName, httpResponse.Status, content)
+	}
+	return json.Unmarshal(content, response)
+}
+
+// Encryptor encrypts the sensitive values stored by KFP with envelope encryption:
+// the values are encrypted with an AES-256-GCM data key, stored along with them
+// wrapped by the key encryption key. A data key is generated per Encryptor, and
+// the unwrapped data keys are cached, so that the key encryption key, which may
+// be remote, isn't called for every value. A nil Encryptor stores the values as is.
+type Encryptor struct {
+	kek        KeyEncryptionKey
+	aead       cipher.AEAD
+	wrappedKey []byte
+	// dataKeys caches the AEADs of the wrapped data keys.
+	dataKeys sync.Map
+}
+
+func NewEncryptor(kek KeyEncryptionKey) (*Encryptor, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, util.Wrap(err, "Failed to generate a data key")
+	}
+	wrappedKey, err := kek.WrapKey(dataKey)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to wrap the data key")
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	e := &Encryptor{kek: kek, aead: aead, wrappedKey: wrappedKey}
+	e.dataKeys.Store(string(wrappedKey), aead)
+	return e, nil
+}
+
+// Encrypt returns the prefix, the length of the wrapped data key, the wrapped
+// data key, and the value sealed with the data key.
+func (e *Encryptor) Encrypt(plaintext []byte) ([]byte, error) {
+	if e == nil {
+		return plaintext, nil
+	}
+	sealed, err := seal(e.aead, plaintext)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to encrypt value")
+	}
+	keyLength := make([]byte, 2)
+	binary.BigEndian.PutUint16(keyLength, uint16(len(e.wrappedKey)))
+	encrypted := make([]byte, 0, len(encryptedPrefix)+len(keyLength)+len(e.wrappedKey)+len(sealed))
+	encrypted = append(encrypted, encryptedPrefix...)
+	encrypted = append(encrypted, keyLength...)
+	encrypted = append(encrypted, e.wrappedKey...)
+	return append(encrypted, sealed...), nil
+}
+
+// Decrypt reverses Encrypt. Values that aren't encrypted are returned as is.
+func (e *Encryptor) Decrypt(value []byte) ([]byte, error) {
+	if !bytes.HasPrefix(value, []byte(encryptedPrefix)) {
+		return value, nil
+	}
+	if e == nil {
+		return nil, util.NewInternalServerError(fmt.Errorf("no encryption key configured"), "Failed to decrypt value")
+	}
+	value = value[len(encryptedPrefix):]
+	if len(value) < 2 || len(value) < 2+int(binary.BigEndian.Uint16(value)) {
+		return nil, util.NewInternalServerError(fmt.Errorf("truncated value"), "Failed to decrypt value")
+	}
+	keyLength := int(binary.BigEndian.Uint16(value))
+	aead, err := e.dataKey(value[2 : 2+keyLength])
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to unwrap the data key of value")
+	}
+	plaintext, err := open(aead, value[2+keyLength:])
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to decrypt value")
+	}
+	return plaintext, nil
+}
+
+// EncryptString encrypts a value stored in a text column, base64 encoding it.
+func (e *Encryptor) EncryptString(plaintext string) (string, error) {
+	if e == nil || plaintext == "" {
+		return plaintext, nil
+	}
+	encrypted, err := e.Encrypt([]byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(encrypted[len(encryptedPrefix):]), nil
+}
+
+// DecryptString reverses EncryptString. Values that aren't encrypted are
+// returned as is.
+func (e *Encryptor) DecryptString(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return value, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value[len(encryptedPrefix):])
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to decode encrypted value")
+	}
+	plaintext, err := e.Decrypt(append([]byte(encryptedPrefix), decoded...))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func (e *Encryptor) dataKey(wrappedKey []byte) (cipher.AEAD, error) {
+	if aead, ok := e.dataKeys.Load(string(wrappedKey)); ok {
+		return aead.(cipher.AEAD), nil
+	}
+	dataKey, err := e.kek.UnwrapKey(wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	e.dataKeys.Store(string(wrappedKey), aead)
+	return aead, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext with a random nonce, prepended to the ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// encryptStrings encrypts the values in place.
+func encryptStrings(e *Encryptor, values ...*string) error {
+	for _, value := range values {
+		encrypted, err := e.EncryptString(*value)
+		if err != nil {
+			return err
+		}
+		*value = encrypted
+	}
+	return nil
+}
+
+// decryptStrings decrypts the values in place.
+func decryptStrings(e *Encryptor, values ...*string) error {
+	for _, value := range values {
+		decrypted, err := e.DecryptString(*value)
+		if err != nil {
+			return err
+		}
+		*value = decrypted
+	}
+	return nil
+}
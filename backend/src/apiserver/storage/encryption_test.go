@@ -0,0 +1,147 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingKeyEncryptionKey counts the unwrapped data keys.
+type countingKeyEncryptionKey struct {
+	*LocalKeyEncryptionKey
+	unwrapped int
+}
+
+func (k *countingKeyEncryptionKey) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	k.unwrapped++
+	return k.LocalKeyEncryptionKey.UnwrapKey(wrappedKey)
+}
+
+func newTestEncryptor(t *testing.T) (*Encryptor, *countingKeyEncryptionKey) {
+	local, err := NewLocalKeyEncryptionKey(bytes.Repeat([]byte{1}, 32))
+	assert.Nil(t, err)
+	kek := &countingKeyEncryptionKey{LocalKeyEncryptionKey: local}
+	encryptor, err := NewEncryptor(kek)
+	assert.Nil(t, err)
+	return encryptor, kek
+}
+
+func TestNewLocalKeyEncryptionKey_InvalidSize(t *testing.T) {
+	_, err := NewLocalKeyEncryptionKey([]byte("short"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "must be 32 bytes")
+}
+
+func TestEncryptor(t *testing.T) {
+	encryptor, kek := newTestEncryptor(t)
+
+	encrypted, err := encryptor.Encrypt([]byte("manifest"))
+	assert.Nil(t, err)
+	assert.False(t, bytes.Contains(encrypted, []byte("manifest")))
+	decrypted, err := encryptor.Decrypt(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "manifest", string(decrypted))
+
+	encryptedString, err := encryptor.EncryptString(`[{"name":"token","value":"secret"}]`)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(encryptedString, encryptedPrefix))
+	assert.NotContains(t, encryptedString, "secret")
+	decryptedString, err := encryptor.DecryptString(encryptedString)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"name":"token","value":"secret"}]`, decryptedString)
+	assert.Equal(t, 0, kek.unwrapped)
+
+	// Another API server unwraps the data key once.
+	other, err := NewEncryptor(kek)
+	assert.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		decryptedString, err = other.DecryptString(encryptedString)
+		assert.Nil(t, err)
+		assert.Equal(t, `[{"name":"token","value":"secret"}]`, decryptedString)
+	}
+	assert.Equal(t, 1, kek.unwrapped)
+}
+
+func TestEncryptor_PlaintextValues(t *testing.T) {
+	encryptor, _ := newTestEncryptor(t)
+	decrypted, err := encryptor.DecryptString("stored before encryption")
+	assert.Nil(t, err)
+	assert.Equal(t, "stored before encryption", decrypted)
+	empty, err := encryptor.EncryptString("")
+	assert.Nil(t, err)
+	assert.Equal(t, "", empty)
+
+	var none *Encryptor
+	value, err := none.EncryptString("value")
+	assert.Nil(t, err)
+	assert.Equal(t, "value", value)
+	_, err = none.DecryptString(encryptedPrefix + "AAAA")
+	assert.NotNil(t, err)
+}
+
+func TestEncryptor_Tampered(t *testing.T) {
+	encryptor, _ := newTestEncryptor(t)
+	encrypted, err := encryptor.Encrypt([]byte("manifest"))
+	assert.Nil(t, err)
+	encrypted[len(encrypted)-1] ^= 1
+	_, err = encryptor.Decrypt(encrypted)
+	assert.NotNil(t, err)
+	_, err = encryptor.Decrypt([]byte(encryptedPrefix + "x"))
+	assert.NotNil(t, err)
+}
+
+func TestRunStore_Encrypted(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	encryptor, _ := newTestEncryptor(t)
+	db.SetEncryptor(encryptor)
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+
+	run := &model.RunDetail{
+		Run: model.Run{
+			UUID:           "1",
+			Name:           "run1",
+			CreatedAtInSec: 1,
+			PipelineSpec: model.PipelineSpec{
+				WorkflowSpecManifest: "workflow spec",
+				Parameters:           `[{"name":"token","value":"secret"}]`,
+			},
+		},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: "workflow runtime"},
+	}
+	created, err := runStore.CreateRun(run)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"name":"token","value":"secret"}]`, created.Parameters)
+
+	var parameters, workflowRuntimeManifest string
+	err = db.QueryRow(`SELECT Parameters, WorkflowRuntimeManifest FROM run_details WHERE UUID = '1'`).
+		Scan(&parameters, &workflowRuntimeManifest)
+	assert.Nil(t, err)
+	assert.NotContains(t, parameters, "secret")
+	assert.NotContains(t, workflowRuntimeManifest, "workflow runtime")
+
+	assert.Nil(t, runStore.UpdateRun("1", "Succeeded", 2, "updated workflow"))
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "workflow spec", runDetail.WorkflowSpecManifest)
+	assert.Equal(t, `[{"name":"token","value":"secret"}]`, runDetail.Parameters)
+	assert.Equal(t, "updated workflow", runDetail.WorkflowRuntimeManifest)
+}
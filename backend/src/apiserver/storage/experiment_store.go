@@ -6,12 +6,12 @@ import (
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 )
 
 type ExperimentStoreInterface interface {
@@ -19,8 +19,15 @@ type ExperimentStoreInterface interface {
 	GetExperiment(uuid string) (*model.Experiment, error)
 	CreateExperiment(*model.Experiment) (*model.Experiment, error)
 	DeleteExperiment(uuid string) error
-	ArchiveExperiment(expId string) error
-	UnarchiveExperiment(expId string) error
+	ArchiveExperiment(expId string, policy model.ExperimentArchivePolicy) (*model.ExperimentArchiveResult, error)
+	UnarchiveExperiment(expId string, policy model.ExperimentArchivePolicy) (*model.ExperimentArchiveResult, error)
+	SoftDeleteExperiment(uuid string, deletedAtInSec int64) error
+	UndeleteExperiment(uuid string) error
+	ListExperimentsDeletedBefore(deletedBeforeInSec int64) ([]*model.Experiment, error)
+	GetExperimentDefaults(expId string) (*model.ExperimentDefaults, error)
+	SetExperimentDefaults(defaults *model.ExperimentDefaults) error
+	GetExperimentBudget(expId string) (*model.ExperimentBudget, error)
+	SetExperimentBudget(budget *model.ExperimentBudget) error
 }
 
 type ExperimentStore struct {
@@ -50,7 +57,7 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 	}
 
 	// SQL for getting the filtered and paginated rows
-	sqlBuilder := sq.Select(experimentColumns...).From("experiments")
+	sqlBuilder := sq.Select(experimentColumns...).From("experiments").Where(notDeleted)
 	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
 		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
 	}
@@ -63,7 +70,7 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 
 	// SQL for getting total size. This matches the query to get all the rows above, in order
 	// to do the same filter, but counts instead of scanning the rows.
-	sqlBuilder = sq.Select("count(*)").From("experiments")
+	sqlBuilder = sq.Select("count(*)").From("experiments").Where(notDeleted)
 	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
 		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
 	}
@@ -75,7 +82,7 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 	// Use a transaction to make sure we're returning the total_size of the same rows queried
 	tx, err := s.db.Begin()
 	if err != nil {
-		glog.Errorf("Failed to start transaction to list jobs")
+		log.Errorf("Failed to start transaction to list jobs")
 		return errorF(err)
 	}
 
@@ -105,7 +112,7 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 
 	err = tx.Commit()
 	if err != nil {
-		glog.Errorf("Failed to commit transaction to list experiments")
+		log.Errorf("Failed to commit transaction to list experiments")
 		return errorF(err)
 	}
 
@@ -113,7 +120,7 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 		return exps, total_size, "", nil
 	}
 
-	npt, err := opts.NextPageToken(exps[opts.PageSize])
+	npt, err := opts.NextPageToken(exps[opts.PageSize-1])
 	return exps[:opts.PageSize], total_size, npt, err
 }
 
@@ -122,6 +129,7 @@ func (s *ExperimentStore) GetExperiment(uuid string) (*model.Experiment, error)
 		Select(experimentColumns...).
 		From("experiments").
 		Where(sq.Eq{"uuid": uuid}).
+		Where(notDeleted).
 		Limit(1).
 		ToSql()
 	if err != nil {
@@ -206,7 +214,8 @@ func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model
 	if err != nil {
 		if s.db.IsDuplicateError(err) {
 			return nil, util.NewAlreadyExistError(
-				"Failed to create a new experiment. The name %v already exists. Please specify a new name.", experiment.Name)
+				"Failed to create a new experiment. The name %v already exists. Please specify a new name.", experiment.Name).
+				WithReason(util.ReasonExperimentNameExists)
 		}
 		return nil, util.NewInternalServerError(err, "Failed to add experiment to experiment table: %v",
 			err.Error())
@@ -240,6 +249,16 @@ func (s *ExperimentStore) DeleteExperiment(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for experiment %v ", id)
 	}
+	err = deleteExperimentDefaults(tx, id)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = deleteExperimentBudget(tx, id)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 	err = tx.Commit()
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to delete experiment %v and its resource references from table", id)
@@ -247,10 +266,11 @@ func (s *ExperimentStore) DeleteExperiment(id string) error {
 	return nil
 }
 
-func (s *ExperimentStore) ArchiveExperiment(expId string) error {
+func (s *ExperimentStore) ArchiveExperiment(expId string, policy model.ExperimentArchivePolicy) (*model.ExperimentArchiveResult, error) {
 	// ArchiveExperiment results in
 	// 1. The experiment getting archived
-	// 2. All the runs in the experiment getting archived no matter what previous storage state they are in
+	// 2. If the policy archives the runs, all the runs in the experiment getting archived
+	// 3. If the policy pauses the jobs, all the enabled jobs in the experiment getting disabled
 	sql, args, err := sq.
 		Update("experiments").
 		SetMap(sq.Eq{
@@ -259,10 +279,106 @@ func (s *ExperimentStore) ArchiveExperiment(expId string) error {
 		Where(sq.Eq{"UUID": expId}).
 		ToSql()
 	if err != nil {
-		return util.NewInternalServerError(err,
+		return nil, util.NewInternalServerError(err,
 			"Failed to create query to archive experiment %s. error: '%v'", expId, err.Error())
 	}
 
+	// In a single transaction, we update experiments, run_details and jobs tables.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to archive an experiment.")
+	}
+
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err,
+			"Failed to archive experiment %s. error: '%v'", expId, err.Error())
+	}
+
+	result := &model.ExperimentArchiveResult{}
+	if policy.Runs {
+		result.RunCount, err = setExperimentRunsStorageState(tx, expId, api.Run_STORAGESTATE_ARCHIVED.String())
+		if err != nil {
+			tx.Rollback()
+			return nil, util.Wrapf(err, "Failed to archive the runs in an experiment %s", expId)
+		}
+	}
+	if policy.Jobs {
+		result.JobIDs, err = setExperimentJobsEnabled(tx, expId, false, s.time.Now().Unix())
+		if err != nil {
+			tx.Rollback()
+			return nil, util.Wrapf(err, "Failed to disable all jobs in an experiment %s", expId)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to archive an experiment %s and its runs", expId)
+	}
+
+	return result, nil
+}
+
+func (s *ExperimentStore) UnarchiveExperiment(expId string, policy model.ExperimentArchivePolicy) (*model.ExperimentArchiveResult, error) {
+	// UnarchiveExperiment results in
+	// 1. The experiment getting unarchived
+	// 2. If the policy restores the runs, all the archived runs in the experiment getting unarchived
+	// 3. If the policy enables the jobs, all the disabled jobs in the experiment getting enabled
+	// Otherwise the archived runs and disabled jobs stay archived.
+	sql, args, err := sq.
+		Update("experiments").
+		SetMap(sq.Eq{
+			"StorageState": api.Experiment_STORAGESTATE_AVAILABLE.String(),
+		}).
+		Where(sq.Eq{"UUID": expId}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to create query to unarchive experiment %s. error: '%v'", expId, err.Error())
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to unarchive an experiment.")
+	}
+
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err,
+			"Failed to unarchive experiment %s. error: '%v'", expId, err.Error())
+	}
+
+	result := &model.ExperimentArchiveResult{}
+	if policy.Runs {
+		result.RunCount, err = setExperimentRunsStorageState(tx, expId, api.Run_STORAGESTATE_AVAILABLE.String())
+		if err != nil {
+			tx.Rollback()
+			return nil, util.Wrapf(err, "Failed to unarchive the runs in an experiment %s", expId)
+		}
+	}
+	if policy.Jobs {
+		result.JobIDs, err = setExperimentJobsEnabled(tx, expId, true, s.time.Now().Unix())
+		if err != nil {
+			tx.Rollback()
+			return nil, util.Wrapf(err, "Failed to enable all jobs in an experiment %s", expId)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to unarchive an experiment %s", expId)
+	}
+
+	return result, nil
+}
+
+// setExperimentRunsStorageState moves the runs of an experiment that aren't in
+// the storage state yet to it, and returns how many were moved.
+func setExperimentRunsStorageState(tx *sql.Tx, expId string, storageState string) (int64, error) {
 	// TODO(jingzhang36): use inner join to replace nested query for better performance.
 	filteredRunsSql, filteredRunsArgs, err := sq.Select("ResourceUUID").
 		From("resource_references as rf").
@@ -271,34 +387,45 @@ func (s *ExperimentStore) ArchiveExperiment(expId string) error {
 			sq.Eq{"rf.ReferenceUUID": expId},
 			sq.Eq{"rf.ReferenceType": common.Experiment}}).ToSql()
 	if err != nil {
-		return util.NewInternalServerError(err,
+		return 0, util.NewInternalServerError(err,
 			"Failed to create query to filter the runs in an experiment %s. error: '%v'", expId, err.Error())
 	}
-	updateRunsSql, updateRunsArgs, err := sq.
-		Update("run_details").
-		SetMap(sq.Eq{
-			"StorageState": api.Run_STORAGESTATE_ARCHIVED.String(),
-		}).
-		Where(fmt.Sprintf("UUID in (%s)", filteredRunsSql), filteredRunsArgs...).
-		ToSql()
-	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to create query to archive the runs in an experiment %s. error: '%v'", expId, err.Error())
-	}
-
-	updateRunsWithExperimentUUIDSql, updateRunsWithExperimentUUIDArgs, err := sq.
-		Update("run_details").
-		SetMap(sq.Eq{
-			"StorageState": api.Run_STORAGESTATE_ARCHIVED.String(),
-		}).
-		Where(sq.Eq{"ExperimentUUID": expId}).
-		Where(sq.NotEq{"StorageState": api.Run_STORAGESTATE_ARCHIVED.String()}).
-		ToSql()
-	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to create query to archive the runs in an experiment %s. error: '%v'", expId, err.Error())
+	// The runs are found through their resource references and through their
+	// ExperimentUUID, which older runs don't have, in run_details and in the run
+	// archive.
+	var count int64
+	for _, table := range runDetailsTables {
+		updateRunsSql, updateRunsArgs, err := sq.
+			Update(table).
+			SetMap(sq.Eq{"StorageState": storageState}).
+			Where(sq.NotEq{"StorageState": storageState}).
+			Where(sq.Or{
+				sq.Expr(fmt.Sprintf("UUID in (%s)", filteredRunsSql), filteredRunsArgs...),
+				sq.Eq{"ExperimentUUID": expId},
+			}).
+			ToSql()
+		if err != nil {
+			return 0, util.NewInternalServerError(err,
+				"Failed to create query to update the runs in an experiment %s. error: '%v'", expId, err.Error())
+		}
+		result, err := tx.Exec(updateRunsSql, updateRunsArgs...)
+		if err != nil {
+			return 0, util.NewInternalServerError(err,
+				"Failed to update the runs in an experiment %s. error: '%v'", expId, err.Error())
+		}
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return 0, util.NewInternalServerError(err,
+				"Failed to count the updated runs in an experiment %s. error: '%v'", expId, err.Error())
+		}
+		count += updated
 	}
+	return count, nil
+}
 
+// setExperimentJobsEnabled enables or disables the jobs of an experiment that
+// aren't already, and returns their IDs.
+func setExperimentJobsEnabled(tx *sql.Tx, expId string, enabled bool, now int64) ([]string, error) {
 	// TODO(jingzhang36): use inner join to replace nested query for better performance.
 	filteredJobsSql, filteredJobsArgs, err := sq.Select("ResourceUUID").
 		From("resource_references as rf").
@@ -307,98 +434,248 @@ func (s *ExperimentStore) ArchiveExperiment(expId string) error {
 			sq.Eq{"rf.ReferenceUUID": expId},
 			sq.Eq{"rf.ReferenceType": common.Experiment}}).ToSql()
 	if err != nil {
-		return util.NewInternalServerError(err,
+		return nil, util.NewInternalServerError(err,
 			"Failed to create query to filter the jobs in an experiment %s. error: '%v'", expId, err.Error())
 	}
-	now := s.time.Now().Unix()
+	selectJobsSql, selectJobsArgs, err := sq.
+		Select("UUID").
+		From("jobs").
+		Where(sq.Eq{"Enabled": !enabled}).
+		Where(fmt.Sprintf("UUID in (%s)", filteredJobsSql), filteredJobsArgs...).
+		OrderBy("UUID").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to create query to list the jobs in an experiment %s. error: '%v'", expId, err.Error())
+	}
+	rows, err := tx.Query(selectJobsSql, selectJobsArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to list the jobs in an experiment %s. error: '%v'", expId, err.Error())
+	}
+	var jobIDs []string
+	for rows.Next() {
+		var jobID string
+		if err := rows.Scan(&jobID); err != nil {
+			rows.Close()
+			return nil, util.NewInternalServerError(err,
+				"Failed to read the jobs in an experiment %s. error: '%v'", expId, err.Error())
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+	rows.Close()
+	if len(jobIDs) == 0 {
+		return nil, nil
+	}
+
 	updateJobsSql, updateJobsArgs, err := sq.
 		Update("jobs").
 		SetMap(sq.Eq{
-			"Enabled":        false,
+			"Enabled":        enabled,
 			"UpdatedAtInSec": now}).
-		Where(sq.Eq{"Enabled": true}).
-		Where(fmt.Sprintf("UUID in (%s)", filteredJobsSql), filteredJobsArgs...).
+		Where(sq.Eq{"UUID": jobIDs}).
 		ToSql()
 	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to create query to archive the jobs in an experiment %s. error: '%v'", expId, err.Error())
+		return nil, util.NewInternalServerError(err,
+			"Failed to create query to update the jobs in an experiment %s. error: '%v'", expId, err.Error())
 	}
-
-	// In a single transaction, we update experiments, run_details and jobs tables.
-	tx, err := s.db.Begin()
+	_, err = tx.Exec(updateJobsSql, updateJobsArgs...)
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to create a new transaction to archive an experiment.")
+		return nil, util.NewInternalServerError(err,
+			"Failed to update the jobs in an experiment %s. error: '%v'", expId, err.Error())
 	}
+	return jobIDs, nil
+}
 
-	_, err = tx.Exec(sql, args...)
-	if err != nil {
-		tx.Rollback()
-		return util.NewInternalServerError(err,
-			"Failed to archive experiment %s. error: '%v'", expId, err.Error())
+// factory function for experiment store
+func NewExperimentStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *ExperimentStore {
+	return &ExperimentStore{
+		db:                     db,
+		time:                   time,
+		uuid:                   uuid,
+		resourceReferenceStore: NewResourceReferenceStore(db),
+		defaultExperimentStore: NewDefaultExperimentStore(db),
 	}
+}
 
-	_, err = tx.Exec(updateRunsSql, updateRunsArgs...)
-	if err != nil {
-		tx.Rollback()
-		return util.NewInternalServerError(err,
-			"Failed to archive runs with experiment reference being %s. error: '%v'", expId, err.Error())
+// SoftDeleteExperiment hides the experiment until it's restored or purged.
+func (s *ExperimentStore) SoftDeleteExperiment(id string, deletedAtInSec int64) error {
+	found, err := setDeletedAt(s.db, "experiments", id, deletedAtInSec)
+	if err == nil && !found {
+		return util.NewResourceNotFoundError("Experiment", id)
 	}
+	return err
+}
 
-	_, err = tx.Exec(updateRunsWithExperimentUUIDSql, updateRunsWithExperimentUUIDArgs...)
-	if err != nil {
-		tx.Rollback()
-		return util.NewInternalServerError(err,
-			"Failed to archive runs with ExperimentUUID being %s. error: '%v'", expId, err.Error())
+// UndeleteExperiment restores the soft deleted experiment.
+func (s *ExperimentStore) UndeleteExperiment(id string) error {
+	found, err := setDeletedAt(s.db, "experiments", id, 0)
+	if err == nil && !found {
+		return util.NewResourceNotFoundError("Deleted experiment", id)
 	}
+	return err
+}
 
-	_, err = tx.Exec(updateJobsSql, updateJobsArgs...)
+// GetExperimentDefaults returns the defaults of the runs and jobs of an
+// experiment, or nil if it has none.
+func (s *ExperimentStore) GetExperimentDefaults(expId string) (*model.ExperimentDefaults, error) {
+	sql, args, err := sq.
+		Select("ExperimentUUID", "Parameters", "ServiceAccount", "PodTemplate", "UpdatedAtInSec").
+		From("experiment_defaults").
+		Where(sq.Eq{"ExperimentUUID": expId}).
+		ToSql()
 	if err != nil {
-		tx.Rollback()
-		return util.NewInternalServerError(err,
-			"Failed to disable all jobs in an experiment %s. error: '%v'", expId, err.Error())
+		return nil, util.NewInternalServerError(err, "Failed to create query to get the defaults of experiment %v", expId)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the defaults of experiment %v", expId)
 	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, nil
+	}
+	defaults := &model.ExperimentDefaults{}
+	err = rows.Scan(&defaults.ExperimentUUID, &defaults.Parameters, &defaults.ServiceAccount, &defaults.PodTemplate,
+		&defaults.UpdatedAtInSec)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to parse the defaults of experiment %v", expId)
+	}
+	return defaults, nil
+}
 
-	err = tx.Commit()
+// SetExperimentDefaults replaces the defaults of the runs and jobs of an
+// experiment.
+func (s *ExperimentStore) SetExperimentDefaults(defaults *model.ExperimentDefaults) error {
+	sql, args, err := sq.
+		Insert("experiment_defaults").
+		SetMap(sq.Eq{
+			"ExperimentUUID": defaults.ExperimentUUID,
+			"Parameters":     defaults.Parameters,
+			"ServiceAccount": defaults.ServiceAccount,
+			"PodTemplate":    defaults.PodTemplate,
+			"UpdatedAtInSec": defaults.UpdatedAtInSec,
+		}).
+		ToSql()
 	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to set the defaults of experiment %v", defaults.ExperimentUUID)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start a transaction to set the defaults of experiment %v", defaults.ExperimentUUID)
+	}
+	if err = deleteExperimentDefaults(tx, defaults.ExperimentUUID); err != nil {
 		tx.Rollback()
-		return util.NewInternalServerError(err, "Failed to archive an experiment %s and its runs", expId)
+		return err
 	}
+	if _, err = tx.Exec(sql, args...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to set the defaults of experiment %v", defaults.ExperimentUUID)
+	}
+	if err = tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to commit the defaults of experiment %v", defaults.ExperimentUUID)
+	}
+	return nil
+}
 
+func deleteExperimentDefaults(tx *sql.Tx, expId string) error {
+	deleteSql, args, err := sq.Delete("experiment_defaults").Where(sq.Eq{"ExperimentUUID": expId}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the defaults of experiment %v", expId)
+	}
+	if _, err = tx.Exec(deleteSql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete the defaults of experiment %v", expId)
+	}
 	return nil
 }
 
-func (s *ExperimentStore) UnarchiveExperiment(expId string) error {
-	// UnarchiveExperiment results in
-	// 1. The experiment getting unarchived
-	// 2. All the archived runs and disabled jobs will stay archived
+// GetExperimentBudget returns the budget of an experiment, or nil if it has
+// none.
+func (s *ExperimentStore) GetExperimentBudget(expId string) (*model.ExperimentBudget, error) {
 	sql, args, err := sq.
-		Update("experiments").
+		Select("ExperimentUUID", "MaxRuns", "MaxTaskSeconds", "Enforcement", "UpdatedAtInSec").
+		From("experiment_budgets").
+		Where(sq.Eq{"ExperimentUUID": expId}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get the budget of experiment %v", expId)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the budget of experiment %v", expId)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, nil
+	}
+	budget := &model.ExperimentBudget{}
+	err = rows.Scan(&budget.ExperimentUUID, &budget.MaxRuns, &budget.MaxTaskSeconds, &budget.Enforcement,
+		&budget.UpdatedAtInSec)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to parse the budget of experiment %v", expId)
+	}
+	return budget, nil
+}
+
+// SetExperimentBudget replaces the budget of an experiment.
+func (s *ExperimentStore) SetExperimentBudget(budget *model.ExperimentBudget) error {
+	sql, args, err := sq.
+		Insert("experiment_budgets").
 		SetMap(sq.Eq{
-			"StorageState": api.Experiment_STORAGESTATE_AVAILABLE.String(),
+			"ExperimentUUID": budget.ExperimentUUID,
+			"MaxRuns":        budget.MaxRuns,
+			"MaxTaskSeconds": budget.MaxTaskSeconds,
+			"Enforcement":    budget.Enforcement,
+			"UpdatedAtInSec": budget.UpdatedAtInSec,
 		}).
-		Where(sq.Eq{"UUID": expId}).
 		ToSql()
 	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to create query to unarchive experiment %s. error: '%v'", expId, err.Error())
+		return util.NewInternalServerError(err, "Failed to create query to set the budget of experiment %v", budget.ExperimentUUID)
 	}
-
-	_, err = s.db.Exec(sql, args...)
+	tx, err := s.db.Begin()
 	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to unarchive experiment %s. error: '%v'", expId, err.Error())
+		return util.NewInternalServerError(err, "Failed to start a transaction to set the budget of experiment %v", budget.ExperimentUUID)
 	}
+	if err = deleteExperimentBudget(tx, budget.ExperimentUUID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err = tx.Exec(sql, args...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to set the budget of experiment %v", budget.ExperimentUUID)
+	}
+	if err = tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to commit the budget of experiment %v", budget.ExperimentUUID)
+	}
+	return nil
+}
 
+func deleteExperimentBudget(tx *sql.Tx, expId string) error {
+	deleteSql, args, err := sq.Delete("experiment_budgets").Where(sq.Eq{"ExperimentUUID": expId}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the budget of experiment %v", expId)
+	}
+	if _, err = tx.Exec(deleteSql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete the budget of experiment %v", expId)
+	}
 	return nil
 }
 
-// factory function for experiment store
-func NewExperimentStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *ExperimentStore {
-	return &ExperimentStore{
-		db:                     db,
-		time:                   time,
-		uuid:                   uuid,
-		resourceReferenceStore: NewResourceReferenceStore(db),
-		defaultExperimentStore: NewDefaultExperimentStore(db),
+// ListExperimentsDeletedBefore returns the experiments soft deleted before the
+// time, to be purged.
+func (s *ExperimentStore) ListExperimentsDeletedBefore(deletedBeforeInSec int64) ([]*model.Experiment, error) {
+	sql, args, err := sq.Select(experimentColumns...).From("experiments").Where(deletedBefore(deletedBeforeInSec)).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list deleted experiments: %v", err.Error())
 	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deleted experiments: %v", err.Error())
+	}
+	defer r.Close()
+	experiments, err := s.scanRows(r)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deleted experiments: %v", err.Error())
+	}
+	return experiments, nil
 }
@@ -96,6 +96,38 @@ func TestListExperiments_Pagination(t *testing.T) {
 	assert.Equal(t, experimentsExpected2, experiments)
 }
 
+func TestListExperiments_Pagination_InsertBetweenPages(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment1"))
+	experimentStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil)
+	experimentStore.CreateExperiment(createExperiment("experiment2"))
+	experimentStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDThree, nil)
+	experimentStore.CreateExperiment(createExperiment("experiment4"))
+
+	opts, err := list.NewOptions(&model.Experiment{}, 2, "name", nil)
+	assert.Nil(t, err)
+	experiments, _, nextPageToken, err := experimentStore.ListExperiments(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 2)
+	assert.Equal(t, "experiment2", experiments[1].Name)
+
+	// An experiment sorted right after the end of the first page is created
+	// while paginating. It must be part of the second page.
+	experimentStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDFour, nil)
+	experimentStore.CreateExperiment(createExperiment("experiment3"))
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	assert.Nil(t, err)
+	experiments, _, nextPageToken, err = experimentStore.ListExperiments(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Empty(t, nextPageToken)
+	assert.Len(t, experiments, 2)
+	assert.Equal(t, "experiment3", experiments[0].Name)
+	assert.Equal(t, "experiment4", experiments[1].Name)
+}
+
 func TestListExperiments_Pagination_Descend(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	defer db.Close()
@@ -339,6 +371,70 @@ func TestDeleteExperiment(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func TestGetAndSetExperimentDefaults(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment1"))
+
+	defaults, err := experimentStore.GetExperimentDefaults(fakeID)
+	assert.Nil(t, err)
+	assert.Nil(t, defaults)
+
+	assert.Nil(t, experimentStore.SetExperimentDefaults(&model.ExperimentDefaults{
+		ExperimentUUID: fakeID, Parameters: "[]", ServiceAccount: "runner", UpdatedAtInSec: 1}))
+	expected := &model.ExperimentDefaults{
+		ExperimentUUID: fakeID,
+		Parameters:     `[{"name":"epochs","value":"10"}]`,
+		ServiceAccount: "team-runner",
+		PodTemplate:    `{"nodeSelector":{"pool":"gpu"}}`,
+		UpdatedAtInSec: 2,
+	}
+	// Setting the defaults again replaces them.
+	assert.Nil(t, experimentStore.SetExperimentDefaults(expected))
+	defaults, err = experimentStore.GetExperimentDefaults(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, defaults)
+
+	// The defaults are deleted with the experiment.
+	assert.Nil(t, experimentStore.DeleteExperiment(fakeID))
+	defaults, err = experimentStore.GetExperimentDefaults(fakeID)
+	assert.Nil(t, err)
+	assert.Nil(t, defaults)
+}
+
+func TestGetAndSetExperimentBudget(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment1"))
+
+	budget, err := experimentStore.GetExperimentBudget(fakeID)
+	assert.Nil(t, err)
+	assert.Nil(t, budget)
+
+	assert.Nil(t, experimentStore.SetExperimentBudget(&model.ExperimentBudget{
+		ExperimentUUID: fakeID, MaxRuns: 10, Enforcement: model.ExperimentBudgetBlock, UpdatedAtInSec: 1}))
+	expected := &model.ExperimentBudget{
+		ExperimentUUID: fakeID,
+		MaxRuns:        100,
+		MaxTaskSeconds: 3600,
+		Enforcement:    model.ExperimentBudgetWarn,
+		UpdatedAtInSec: 2,
+	}
+	// Setting the budget again replaces it.
+	assert.Nil(t, experimentStore.SetExperimentBudget(expected))
+	budget, err = experimentStore.GetExperimentBudget(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, budget)
+
+	// The budget is deleted with the experiment.
+	assert.Nil(t, experimentStore.DeleteExperiment(fakeID))
+	budget, err = experimentStore.GetExperimentBudget(fakeID)
+	assert.Nil(t, err)
+	assert.Nil(t, budget)
+}
+
 func TestDeleteExperiment_InternalError(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	defer db.Close()
@@ -435,7 +531,7 @@ func TestArchiveExperiment_InternalError(t *testing.T) {
 	experimentStore.CreateExperiment(createExperiment("experiment1"))
 	db.Close()
 
-	err := experimentStore.ArchiveExperiment(fakeID)
+	_, err := experimentStore.ArchiveExperiment(fakeID, model.ExperimentArchivePolicy{Runs: true, Jobs: true})
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode(),
 		"Expected archive experiment to return internal error")
 }
@@ -552,8 +648,9 @@ func TestArchiveAndUnarchiveExperiment(t *testing.T) {
 	jobStore.CreateJob(job2)
 
 	// Archive experiment and verify the experiment and two runs in it are all archived.
-	err := experimentStore.ArchiveExperiment(fakeID)
+	result, err := experimentStore.ArchiveExperiment(fakeID, model.ExperimentArchivePolicy{Runs: true, Jobs: true})
 	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentArchiveResult{RunCount: 1, JobIDs: []string{"1"}}, result)
 	exp, err := experimentStore.GetExperiment(fakeID)
 	assert.Nil(t, err)
 	assert.Equal(t, api.Experiment_STORAGESTATE_ARCHIVED.String(), exp.StorageState)
@@ -570,8 +667,9 @@ func TestArchiveAndUnarchiveExperiment(t *testing.T) {
 	assert.Equal(t, false, jobs[1].Enabled)
 
 	// Unarchive the experiment, and verify the experiment is unarchived while two runs in it stay archived.
-	err = experimentStore.UnarchiveExperiment(fakeID)
+	result, err = experimentStore.UnarchiveExperiment(fakeID, model.ExperimentArchivePolicy{})
 	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentArchiveResult{}, result)
 	exp, err = experimentStore.GetExperiment(fakeID)
 	assert.Nil(t, err)
 	assert.Equal(t, api.Experiment_STORAGESTATE_AVAILABLE.String(), exp.StorageState)
@@ -587,12 +685,147 @@ func TestArchiveAndUnarchiveExperiment(t *testing.T) {
 	assert.Equal(t, false, jobs[1].Enabled)
 }
 
+func TestArchiveAndUnarchiveExperiment_Policy(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment1"))
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	for _, id := range []string{"1", "2"} {
+		runStore.CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:           id,
+				Name:           "run" + id,
+				DisplayName:    "run" + id,
+				StorageState:   api.Run_STORAGESTATE_ARCHIVED.String(),
+				Namespace:      "n1",
+				CreatedAtInSec: 1,
+				Conditions:     "done",
+				ExperimentUUID: fakeID,
+			},
+		})
+	}
+	jobStore := NewJobStore(db, util.NewFakeTimeForEpoch())
+	jobStore.CreateJob(&model.Job{
+		UUID:        "1",
+		DisplayName: "pp 1",
+		Name:        "pp1",
+		Namespace:   "n1",
+		Enabled:     false,
+		Conditions:  "ready",
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID: "1", ResourceType: common.Job, ReferenceUUID: fakeID,
+				ReferenceName: "experiment1", ReferenceType: common.Experiment,
+				Relationship: common.Owner,
+			},
+		},
+	})
+	experimentStore.ArchiveExperiment(fakeID, model.ExperimentArchivePolicy{})
+
+	// Unarchive the experiment with its runs and jobs.
+	result, err := experimentStore.UnarchiveExperiment(fakeID, model.ExperimentArchivePolicy{Runs: true, Jobs: true})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentArchiveResult{RunCount: 2, JobIDs: []string{"1"}}, result)
+	run, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_AVAILABLE.String(), run.StorageState)
+	job, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.True(t, job.Enabled)
+
+	// Archive the experiment alone, its runs and jobs are left as they are.
+	result, err = experimentStore.ArchiveExperiment(fakeID, model.ExperimentArchivePolicy{})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentArchiveResult{}, result)
+	exp, err := experimentStore.GetExperiment(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Experiment_STORAGESTATE_ARCHIVED.String(), exp.StorageState)
+	run, err = runStore.GetRun("2")
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_AVAILABLE.String(), run.StorageState)
+	job, err = jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.True(t, job.Enabled)
+}
+
+func TestArchiveAndUnarchiveExperiment_RunArchive(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experimentStore.CreateExperiment(createExperiment("experiment1"))
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	for _, id := range []string{"1", "2"} {
+		runStore.CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:            id,
+				Name:            "run" + id,
+				DisplayName:     "run" + id,
+				StorageState:    api.Run_STORAGESTATE_AVAILABLE.String(),
+				Namespace:       "n1",
+				CreatedAtInSec:  1,
+				FinishedAtInSec: 2,
+				Conditions:      "Succeeded",
+				ExperimentUUID:  fakeID,
+			},
+		})
+	}
+	moved, err := runStore.MoveRunsToArchive(2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, moved)
+
+	// The runs moved to the run archive are archived and unarchived with the others.
+	result, err := experimentStore.ArchiveExperiment(fakeID, model.ExperimentArchivePolicy{Runs: true})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentArchiveResult{RunCount: 2}, result)
+	for _, id := range []string{"1", "2"} {
+		run, err := runStore.GetRun(id)
+		assert.Nil(t, err)
+		assert.Equal(t, api.Run_STORAGESTATE_ARCHIVED.String(), run.StorageState)
+	}
+	result, err = experimentStore.UnarchiveExperiment(fakeID, model.ExperimentArchivePolicy{Runs: true})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentArchiveResult{RunCount: 2}, result)
+	for _, id := range []string{"1", "2"} {
+		run, err := runStore.GetRun(id)
+		assert.Nil(t, err)
+		assert.Equal(t, api.Run_STORAGESTATE_AVAILABLE.String(), run.StorageState)
+	}
+}
+
 func TestUnarchiveExperiment_InternalError(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
 	db.Close()
 
-	err := experimentStore.UnarchiveExperiment(fakeID)
+	_, err := experimentStore.UnarchiveExperiment(fakeID, model.ExperimentArchivePolicy{})
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode(),
 		"Expected unarchive experiment to return internal error")
 }
+
+func TestSoftDeleteExperiment_NameReuse(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	_, err := experimentStore.CreateExperiment(createExperiment("experiment1"))
+	assert.Nil(t, err)
+	assert.Nil(t, experimentStore.SoftDeleteExperiment(fakeID, 10))
+
+	// The name of the deleted experiment can be reused.
+	experimentStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil)
+	_, err = experimentStore.CreateExperiment(createExperiment("experiment1"))
+	assert.Nil(t, err)
+
+	// The deleted experiment can't be restored while its name is taken.
+	err = experimentStore.UndeleteExperiment(fakeID)
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Its name is taken by another one")
+
+	assert.Nil(t, experimentStore.SoftDeleteExperiment(fakeIDTwo, 11))
+	assert.Nil(t, experimentStore.UndeleteExperiment(fakeID))
+	experiment, err := experimentStore.GetExperiment(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, "experiment1", experiment.Name)
+}
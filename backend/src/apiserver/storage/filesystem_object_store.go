@@ -0,0 +1,206 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// FilesystemObjectStore stores the files in a local directory, for the standalone
+// mode, where the API server runs without an object store deployment.
+type FilesystemObjectStore struct {
+	rootDir    string
+	baseFolder string
+}
+
+// GetPipelineKey adds the configured base folder to pipeline id.
+func (f *FilesystemObjectStore) GetPipelineKey(pipelineID string) string {
+	return path.Join(f.baseFolder, pipelineID)
+}
+
+// localPath returns the path of the file in the root directory. Paths escaping
+// the root directory are rejected.
+func (f *FilesystemObjectStore) localPath(filePath string) (string, error) {
+	cleaned := path.Clean("/" + filePath)
+	if cleaned == "/" {
+		return "", util.NewInvalidInputError("Invalid file path %q", filePath)
+	}
+	return filepath.Join(f.rootDir, filepath.FromSlash(cleaned)), nil
+}
+
+func (f *FilesystemObjectStore) AddFile(file []byte, filePath string) error {
+	localPath, err := f.localPath(filePath)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomically(localPath, file); err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	return nil
+}
+
+// AddFileInNamespace stores a file like AddFile, the directory is shared by
+// every namespace.
+func (f *FilesystemObjectStore) AddFileInNamespace(file []byte, filePath string, namespace string) error {
+	return f.AddFile(file, filePath)
+}
+
+func (f *FilesystemObjectStore) DeleteFile(filePath string) error {
+	localPath, err := f.localPath(filePath)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(localPath); err != nil && !os.IsNotExist(err) {
+		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
+	}
+	return nil
+}
+
+func (f *FilesystemObjectStore) CopyFile(srcPath string, dstPath string, namespace string) error {
+	return f.ComposeFile([]string{srcPath}, dstPath, namespace)
+}
+
+func (f *FilesystemObjectStore) ComposeFile(srcPaths []string, dstPath string, namespace string) error {
+	var content []byte
+	for _, srcPath := range srcPaths {
+		file, err := f.GetFile(srcPath)
+		if err != nil {
+			return err
+		}
+		content = append(content, file...)
+	}
+	return f.AddFile(content, dstPath)
+}
+
+func (f *FilesystemObjectStore) GetFile(filePath string) ([]byte, error) {
+	localPath, err := f.localPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := ioutil.ReadFile(localPath)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return file, nil
+}
+
+func (f *FilesystemObjectStore) OpenFile(filePath string) (io.ReadCloser, error) {
+	localPath, err := f.localPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(localPath)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return file, nil
+}
+
+func (f *FilesystemObjectStore) ListFiles(prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	prefix = strings.TrimPrefix(prefix, "/")
+	// Only walk the directory of the prefix.
+	walkDir := f.rootDir
+	if dir := path.Dir(prefix); dir != "." {
+		walkDir = filepath.Join(f.rootDir, filepath.FromSlash(path.Clean("/"+dir)))
+	}
+	err := filepath.Walk(walkDir, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			return nil
+		}
+		relativePath, err := filepath.Rel(f.rootDir, localPath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(relativePath)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		objects = append(objects, ObjectInfo{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+			ETag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list %v", prefix)
+	}
+	return objects, nil
+}
+
+// GetSignedURL fails, since the files are only served by the API server.
+func (f *FilesystemObjectStore) GetSignedURL(filePath string, method string, expiry time.Duration) (*SignedURL, error) {
+	if err := validateSignedURLRequest(method, expiry); err != nil {
+		return nil, err
+	}
+	return nil, signedURLError(ErrURLSigningUnsupported, filePath)
+}
+
+func (f *FilesystemObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(f, o, filePath)
+}
+
+func (f *FilesystemObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(f, o, filePath)
+}
+
+// ForNamespace returns the store itself, namespaces can't be isolated in a
+// local directory.
+func (f *FilesystemObjectStore) ForNamespace(namespace string) (ObjectStoreInterface, error) {
+	return f, nil
+}
+
+// writeFileAtomically writes the file to a temporary file of its directory, then
+// renames it, so that readers never see a partial file.
+func writeFileAtomically(localPath string, content []byte) error {
+	dir := filepath.Dir(localPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(localPath)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), localPath)
+}
+
+func NewFilesystemObjectStore(rootDir string, baseFolder string) *FilesystemObjectStore {
+	return &FilesystemObjectStore{rootDir: rootDir, baseFolder: baseFolder}
+}
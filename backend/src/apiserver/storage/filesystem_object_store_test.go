@@ -0,0 +1,138 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func newTestFilesystemObjectStore(t *testing.T) (*FilesystemObjectStore, string) {
+	rootDir, err := ioutil.TempDir("", "objects")
+	assert.Nil(t, err)
+	return NewFilesystemObjectStore(rootDir, "pipeline"), rootDir
+}
+
+func TestFilesystemAddAndGetFile(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	error := manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	file, error := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abc"), file)
+	_, error = os.Stat(filepath.Join(rootDir, "pipeline", "1"))
+	assert.Nil(t, error)
+
+	reader, error := manager.OpenFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	defer reader.Close()
+	content, error := ioutil.ReadAll(reader)
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abc"), content)
+}
+
+func TestFilesystemGetFileError(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	_, error := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestFilesystemPathsStayInRootDir(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	error := manager.AddFile([]byte("abc"), "../../escaped")
+	assert.Nil(t, error)
+	_, error = os.Stat(filepath.Join(rootDir, "escaped"))
+	assert.Nil(t, error)
+	error = manager.AddFile([]byte("abc"), "/")
+	assert.Equal(t, codes.InvalidArgument, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestFilesystemDeleteFile(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	error := manager.DeleteFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	_, error = manager.GetFile(manager.GetPipelineKey("1"))
+	assert.NotNil(t, error)
+	// Deleting a missing file succeeds, like in the object stores.
+	assert.Nil(t, manager.DeleteFile(manager.GetPipelineKey("1")))
+}
+
+func TestFilesystemCopyAndComposeFile(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	manager.AddFile([]byte("abc"), "artifacts/a")
+	manager.AddFile([]byte("def"), "artifacts/b")
+	error := manager.CopyFile("artifacts/a", "artifacts/c", "ns1")
+	assert.Nil(t, error)
+	file, error := manager.GetFile("artifacts/c")
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abc"), file)
+
+	error = manager.ComposeFile([]string{"artifacts/a", "artifacts/b"}, "artifacts/d", "ns1")
+	assert.Nil(t, error)
+	file, error = manager.GetFile("artifacts/d")
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abcdef"), file)
+}
+
+func TestFilesystemListFiles(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	manager.AddFile([]byte("abc"), "artifacts/run1/a")
+	manager.AddFile([]byte("abcd"), "artifacts/run1/nested/b")
+	manager.AddFile([]byte("abc"), "artifacts/run2/a")
+
+	objects, error := manager.ListFiles("artifacts/run1/")
+	assert.Nil(t, error)
+	assert.Equal(t, 2, len(objects))
+	assert.Equal(t, "artifacts/run1/a", objects[0].Key)
+	assert.Equal(t, int64(3), objects[0].Size)
+	assert.Equal(t, "artifacts/run1/nested/b", objects[1].Key)
+
+	objects, error = manager.ListFiles("missing/")
+	assert.Nil(t, error)
+	assert.Empty(t, objects)
+}
+
+func TestFilesystemSignedURLUnsupported(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	_, error := manager.GetSignedURL("artifacts/a", http.MethodGet, time.Hour)
+	assert.NotNil(t, error)
+}
+
+func TestFilesystemYamlFile(t *testing.T) {
+	manager, rootDir := newTestFilesystemObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	error := manager.AddAsYamlFile(Foo{ID: 1}, manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	var foo Foo
+	error = manager.GetFromYamlFile(&foo, manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, Foo{ID: 1}, foo)
+}
@@ -0,0 +1,207 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// GCSEndpoint is the endpoint of the Google Cloud Storage JSON API.
+const GCSEndpoint = "https://storage.googleapis.com"
+
+// Create interface for GCS client struct, making it more unit testable.
+type GCSClientInterface interface {
+	PutObject(bucketName, objectName string, reader io.Reader) error
+	GetObject(bucketName, objectName string) (io.Reader, error)
+	OpenObject(bucketName, objectName string) (io.ReadCloser, error)
+	DeleteObject(bucketName, objectName string) error
+	CopyObject(bucketName, srcObjectName, dstObjectName string) error
+	ListObjects(bucketName, prefix string) ([]ObjectInfo, error)
+	SignURL(method, bucketName, objectName string, expiry time.Duration) (*SignedURL, error)
+}
+
+// GCSClient calls the Google Cloud Storage JSON API. Client is expected to
+// authenticate the requests. Signer, when set, signs the URLs of the objects.
+type GCSClient struct {
+	Client   *http.Client
+	Endpoint string
+	Signer   URLSigner
+}
+
+func (c *GCSClient) PutObject(bucketName, objectName string, reader io.Reader) error {
+	uploadURL := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=media&name=%s",
+		c.Endpoint, url.PathEscape(bucketName), url.QueryEscape(objectName))
+	request, err := http.NewRequest(http.MethodPost, uploadURL, reader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/octet-stream")
+	_, err = c.do(request)
+	return err
+}
+
+// OpenObject streams the content of the object, which the caller has to close.
+func (c *GCSClient) OpenObject(bucketName, objectName string) (io.ReadCloser, error) {
+	request, err := http.NewRequest(http.MethodGet, c.objectURL(bucketName, objectName)+"?alt=media", nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.open(request)
+}
+
+func (c *GCSClient) GetObject(bucketName, objectName string) (io.Reader, error) {
+	request, err := http.NewRequest(http.MethodGet, c.objectURL(bucketName, objectName)+"?alt=media", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (c *GCSClient) DeleteObject(bucketName, objectName string) error {
+	request, err := http.NewRequest(http.MethodDelete, c.objectURL(bucketName, objectName), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(request)
+	return err
+}
+
+type gcsRewriteResponse struct {
+	Done         bool   `json:"done"`
+	RewriteToken string `json:"rewriteToken"`
+}
+
+// CopyObject rewrites the object within the bucket. Large objects take several
+// calls, each of which continues from the token returned by the previous one.
+func (c *GCSClient) CopyObject(bucketName, srcObjectName, dstObjectName string) error {
+	rewriteURL := fmt.Sprintf("%s/rewriteTo/b/%s/o/%s", c.objectURL(bucketName, srcObjectName),
+		url.PathEscape(bucketName), url.PathEscape(dstObjectName))
+	rewriteToken := ""
+	for {
+		requestURL := rewriteURL
+		if rewriteToken != "" {
+			requestURL += "?" + url.Values{"rewriteToken": {rewriteToken}}.Encode()
+		}
+		request, err := http.NewRequest(http.MethodPost, requestURL, nil)
+		if err != nil {
+			return err
+		}
+		body, err := c.do(request)
+		if err != nil {
+			return err
+		}
+		var rewrite gcsRewriteResponse
+		if err := json.Unmarshal(body, &rewrite); err != nil {
+			return err
+		}
+		if rewrite.Done {
+			return nil
+		}
+		rewriteToken = rewrite.RewriteToken
+	}
+}
+
+type gcsObjectList struct {
+	Items []struct {
+		Name    string    `json:"name"`
+		Size    string    `json:"size"`
+		Updated time.Time `json:"updated"`
+		Etag    string    `json:"etag"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (c *GCSClient) ListObjects(bucketName, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	pageToken := ""
+	for {
+		query := url.Values{"prefix": {prefix}}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		listURL := fmt.Sprintf("%s/storage/v1/b/%s/o?%s", c.Endpoint, url.PathEscape(bucketName), query.Encode())
+		request, err := http.NewRequest(http.MethodGet, listURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err := c.do(request)
+		if err != nil {
+			return nil, err
+		}
+		var list gcsObjectList
+		if err := json.Unmarshal(body, &list); err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			// The JSON API encodes the 64-bit size as a string.
+			size, err := strconv.ParseInt(item.Size, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, ObjectInfo{Key: item.Name, Size: size, LastModified: item.Updated, ETag: item.Etag})
+		}
+		if list.NextPageToken == "" {
+			return objects, nil
+		}
+		pageToken = list.NextPageToken
+	}
+}
+
+func (c *GCSClient) SignURL(method, bucketName, objectName string, expiry time.Duration) (*SignedURL, error) {
+	if c.Signer == nil {
+		return nil, ErrURLSigningUnsupported
+	}
+	return c.Signer.SignURL(method, bucketName, objectName, expiry)
+}
+
+func (c *GCSClient) objectURL(bucketName, objectName string) string {
+	return fmt.Sprintf("%s/storage/v1/b/%s/o/%s", c.Endpoint, url.PathEscape(bucketName), url.PathEscape(objectName))
+}
+
+func (c *GCSClient) do(request *http.Request) ([]byte, error) {
+	body, err := c.open(request)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// open sends the request and returns the body of a successful response, which
+// the caller has to close.
+func (c *GCSClient) open(request *http.Request) (io.ReadCloser, error) {
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+		return nil, fmt.Errorf("%s %s: %s: %s", request.Method, request.URL.Path, response.Status, body)
+	}
+	return response.Body, nil
+}
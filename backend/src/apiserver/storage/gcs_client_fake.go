@@ -0,0 +1,108 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type FakeGCSClient struct {
+	objects      map[string][]byte
+	lastModified map[string]time.Time
+}
+
+func NewFakeGCSClient() *FakeGCSClient {
+	return &FakeGCSClient{
+		objects:      make(map[string][]byte),
+		lastModified: make(map[string]time.Time),
+	}
+}
+
+func (c *FakeGCSClient) PutObject(bucketName, objectName string, reader io.Reader) error {
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(reader)
+	c.objects[objectName] = buf.Bytes()
+	c.lastModified[objectName] = time.Now()
+	return nil
+}
+
+func (c *FakeGCSClient) GetObject(bucketName, objectName string) (io.Reader, error) {
+	if _, ok := c.objects[objectName]; !ok {
+		return nil, errors.New("object not found")
+	}
+	return bytes.NewReader(c.objects[objectName]), nil
+}
+
+func (c *FakeGCSClient) OpenObject(bucketName, objectName string) (io.ReadCloser, error) {
+	reader, err := c.GetObject(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(reader), nil
+}
+
+func (c *FakeGCSClient) DeleteObject(bucketName, objectName string) error {
+	if _, ok := c.objects[objectName]; !ok {
+		return errors.New("object not found")
+	}
+	delete(c.objects, objectName)
+	delete(c.lastModified, objectName)
+	return nil
+}
+
+func (c *FakeGCSClient) CopyObject(bucketName, srcObjectName, dstObjectName string) error {
+	content, ok := c.objects[srcObjectName]
+	if !ok {
+		return errors.New("object not found")
+	}
+	c.objects[dstObjectName] = content
+	c.lastModified[dstObjectName] = time.Now()
+	return nil
+}
+
+func (c *FakeGCSClient) ListObjects(bucketName, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	for objectName, content := range c.objects {
+		if strings.HasPrefix(objectName, prefix) {
+			objects = append(objects, ObjectInfo{Key: objectName, Size: int64(len(content)), LastModified: c.lastModified[objectName]})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (c *FakeGCSClient) SignURL(method, bucketName, objectName string, expiry time.Duration) (*SignedURL, error) {
+	signedURL, err := fakeSignedURL("gcs", bucketName, objectName, expiry)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedURL{URL: signedURL.String()}, nil
+}
+
+func (c *FakeGCSClient) GetObjectCount() int {
+	return len(c.objects)
+}
+
+func (c *FakeGCSClient) ExistObject(objectName string) bool {
+	_, ok := c.objects[objectName]
+	return ok
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"io"
+	"path"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// Managing pipeline using Google Cloud Storage
+type GCSObjectStore struct {
+	gcsClient  GCSClientInterface
+	bucketName string
+	baseFolder string
+}
+
+// GetPipelineKey adds the configured base folder to pipeline id.
+func (g *GCSObjectStore) GetPipelineKey(pipelineID string) string {
+	return path.Join(g.baseFolder, pipelineID)
+}
+
+func (g *GCSObjectStore) AddFile(file []byte, filePath string) error {
+	err := g.gcsClient.PutObject(g.bucketName, filePath, bytes.NewReader(file))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	return nil
+}
+
+// AddFileInNamespace stores a file like AddFile, the bucket settings apply to
+// every namespace.
+func (g *GCSObjectStore) AddFileInNamespace(file []byte, filePath string, namespace string) error {
+	return g.AddFile(file, filePath)
+}
+
+func (g *GCSObjectStore) DeleteFile(filePath string) error {
+	err := g.gcsClient.DeleteObject(g.bucketName, filePath)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
+	}
+	return nil
+}
+
+// CopyFile copies a file like AddFileInNamespace, the bucket settings apply to
+// every namespace.
+func (g *GCSObjectStore) CopyFile(srcPath string, dstPath string, namespace string) error {
+	err := g.gcsClient.CopyObject(g.bucketName, srcPath, dstPath)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to copy %v to %v", srcPath, dstPath)
+	}
+	return nil
+}
+
+func (g *GCSObjectStore) ComposeFile(srcPaths []string, dstPath string, namespace string) error {
+	return util.NewFailedPreconditionError(ErrComposeUnsupported, "Failed to compose %v", dstPath)
+}
+
+func (g *GCSObjectStore) GetFile(filePath string) ([]byte, error) {
+	reader, err := g.gcsClient.GetObject(g.bucketName, filePath)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(reader)
+	return buf.Bytes(), nil
+}
+
+func (g *GCSObjectStore) OpenFile(filePath string) (io.ReadCloser, error) {
+	reader, err := g.gcsClient.OpenObject(g.bucketName, filePath)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return reader, nil
+}
+
+func (g *GCSObjectStore) ListFiles(prefix string) ([]ObjectInfo, error) {
+	objects, err := g.gcsClient.ListObjects(g.bucketName, prefix)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list %v", prefix)
+	}
+	return objects, nil
+}
+
+func (g *GCSObjectStore) GetSignedURL(filePath string, method string, expiry time.Duration) (*SignedURL, error) {
+	if err := validateSignedURLRequest(method, expiry); err != nil {
+		return nil, err
+	}
+	signedURL, err := g.gcsClient.SignURL(method, g.bucketName, filePath, expiry)
+	if err != nil {
+		return nil, signedURLError(err, filePath)
+	}
+	return signedURL, nil
+}
+
+func (g *GCSObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(g, o, filePath)
+}
+
+func (g *GCSObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(g, o, filePath)
+}
+
+// ForNamespace returns the store itself, namespaces can't be isolated in GCS.
+func (g *GCSObjectStore) ForNamespace(namespace string) (ObjectStoreInterface, error) {
+	return g, nil
+}
+
+func NewGCSObjectStore(gcsClient GCSClientInterface, bucketName string, baseFolder string) *GCSObjectStore {
+	return &GCSObjectStore{gcsClient: gcsClient, bucketName: bucketName, baseFolder: baseFolder}
+}
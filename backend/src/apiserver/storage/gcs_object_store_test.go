@@ -0,0 +1,162 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGCSAddFile(t *testing.T) {
+	gcsClient := NewFakeGCSClient()
+	manager := NewGCSObjectStore(gcsClient, "bucket", "pipeline")
+	error := manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, 1, gcsClient.GetObjectCount())
+	assert.True(t, gcsClient.ExistObject("pipeline/1"))
+}
+
+func TestGCSGetFile(t *testing.T) {
+	manager := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	file, error := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abc"), file)
+}
+
+func TestGCSGetFileError(t *testing.T) {
+	manager := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	_, error := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGCSDeleteFile(t *testing.T) {
+	gcsClient := NewFakeGCSClient()
+	manager := NewGCSObjectStore(gcsClient, "bucket", "pipeline")
+	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	error := manager.DeleteFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, 0, gcsClient.GetObjectCount())
+}
+
+func TestGCSCopyFile(t *testing.T) {
+	manager := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	manager.AddFile([]byte("abc"), "artifacts/a")
+	error := manager.CopyFile("artifacts/a", "artifacts/b", "ns1")
+	assert.Nil(t, error)
+	file, error := manager.GetFile("artifacts/b")
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abc"), file)
+}
+
+func TestGCSYamlFile(t *testing.T) {
+	manager := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	error := manager.AddAsYamlFile(Foo{ID: 1}, manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	var foo Foo
+	error = manager.GetFromYamlFile(&foo, manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, Foo{ID: 1}, foo)
+}
+
+func TestGCSClient(t *testing.T) {
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/bucket/o":
+			objects[r.URL.Query().Get("name")], _ = ioutil.ReadAll(r.Body)
+		case r.Method == http.MethodGet && r.URL.Path == "/storage/v1/b/bucket/o/pipeline/1":
+			content, ok := objects["pipeline/1"]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write(content)
+		case r.Method == http.MethodDelete && r.URL.Path == "/storage/v1/b/bucket/o/pipeline/1":
+			delete(objects, "pipeline/1")
+		default:
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	manager := NewGCSObjectStore(&GCSClient{Client: server.Client(), Endpoint: server.URL}, "bucket", "pipeline")
+
+	assert.Nil(t, manager.AddFile([]byte("abc"), manager.GetPipelineKey("1")))
+	assert.Equal(t, []byte("abc"), objects["pipeline/1"])
+	file, err := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), file)
+	reader, err := manager.OpenFile(manager.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	file, err = ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), file)
+	assert.Nil(t, reader.Close())
+	assert.Nil(t, manager.DeleteFile(manager.GetPipelineKey("1")))
+	_, err = manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGCSClientListObjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/storage/v1/b/bucket/o" || r.URL.Query().Get("prefix") != "artifacts/" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("pageToken") == "" {
+			w.Write([]byte(`{"items":[{"name":"artifacts/a","size":"3","updated":"2023-01-02T03:04:05.000Z"}],"nextPageToken":"next"}`))
+			return
+		}
+		w.Write([]byte(`{"items":[{"name":"artifacts/b","size":"5","updated":"2023-01-02T03:04:05.000Z"}]}`))
+	}))
+	defer server.Close()
+	manager := NewGCSObjectStore(&GCSClient{Client: server.Client(), Endpoint: server.URL}, "bucket", "pipeline")
+
+	files, err := manager.ListFiles("artifacts/")
+	assert.Nil(t, err)
+	updated := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, []ObjectInfo{
+		{Key: "artifacts/a", Size: 3, LastModified: updated},
+		{Key: "artifacts/b", Size: 5, LastModified: updated},
+	}, files)
+}
+
+func TestGCSClientCopyObject(t *testing.T) {
+	var rewriteTokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/storage/v1/b/bucket/o/artifacts/a/rewriteTo/b/bucket/o/artifacts/b" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		rewriteTokens = append(rewriteTokens, r.URL.Query().Get("rewriteToken"))
+		if len(rewriteTokens) == 1 {
+			w.Write([]byte(`{"done":false,"rewriteToken":"next"}`))
+			return
+		}
+		w.Write([]byte(`{"done":true}`))
+	}))
+	defer server.Close()
+	manager := NewGCSObjectStore(&GCSClient{Client: server.Client(), Endpoint: server.URL}, "bucket", "pipeline")
+
+	assert.Nil(t, manager.CopyFile("artifacts/a", "artifacts/b", "ns1"))
+	assert.Equal(t, []string{"", "next"}, rewriteTokens)
+}
@@ -19,11 +19,11 @@ import (
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 )
 
 var jobColumns = []string{"UUID", "DisplayName", "Name", "Namespace", "ServiceAccount", "Description", "MaxConcurrency",
@@ -37,6 +37,9 @@ type JobStoreInterface interface {
 	GetJob(id string) (*model.Job, error)
 	CreateJob(*model.Job) (*model.Job, error)
 	DeleteJob(id string) error
+	SoftDeleteJob(id string, deletedAtInSec int64) error
+	UndeleteJob(id string) error
+	ListJobsDeletedBefore(deletedBeforeInSec int64) ([]*model.Job, error)
 	EnableJob(id string, enabled bool) error
 	UpdateJob(swf *util.ScheduledWorkflow) error
 }
@@ -66,10 +69,11 @@ func (s *JobStore) ListJobs(
 		return errorF(err)
 	}
 
-	// Use a transaction to make sure we're returning the total_size of the same rows queried
-	tx, err := s.db.Begin()
+	// Use a transaction to make sure we're returning the total_size of the same rows queried.
+	// The rows may be read from a replica lagging slightly behind.
+	tx, err := s.db.Reader().Begin()
 	if err != nil {
-		glog.Errorf("Failed to start transaction to list jobs")
+		log.Errorf("Failed to start transaction to list jobs")
 		return errorF(err)
 	}
 
@@ -98,7 +102,7 @@ func (s *JobStore) ListJobs(
 
 	err = tx.Commit()
 	if err != nil {
-		glog.Errorf("Failed to commit transaction to list jobs")
+		log.Errorf("Failed to commit transaction to list jobs")
 		return errorF(err)
 	}
 
@@ -106,7 +110,7 @@ func (s *JobStore) ListJobs(
 		return jobs, total_size, "", nil
 	}
 
-	npt, err := opts.NextPageToken(jobs[opts.PageSize])
+	npt, err := opts.NextPageToken(jobs[opts.PageSize-1])
 	return jobs[:opts.PageSize], total_size, npt, err
 }
 
@@ -128,7 +132,7 @@ func (s *JobStore) buildSelectJobsQuery(selectCount bool, opts *list.Options,
 		return "", nil, util.NewInternalServerError(err, "Failed to list jobs: %v", err)
 	}
 
-	sqlBuilder := opts.AddFilterToSelect(filteredSelectBuilder)
+	sqlBuilder := opts.AddFilterToSelect(filteredSelectBuilder.Where(notDeleted))
 
 	// If we're not just counting, then also add select columns and perform a left join
 	// to get resource reference information. Also add pagination.
@@ -146,7 +150,7 @@ func (s *JobStore) buildSelectJobsQuery(selectCount bool, opts *list.Options,
 }
 
 func (s *JobStore) GetJob(id string) (*model.Job, error) {
-	sql, args, err := s.addResourceReferences(sq.Select(jobColumns...).From("jobs")).
+	sql, args, err := s.addResourceReferences(sq.Select(jobColumns...).From("jobs").Where(notDeleted)).
 		Where(sq.Eq{"uuid": id}).
 		Limit(1).
 		ToSql()
@@ -171,13 +175,14 @@ func (s *JobStore) GetJob(id string) (*model.Job, error) {
 }
 
 func (s *JobStore) addResourceReferences(filteredSelectBuilder sq.SelectBuilder) sq.SelectBuilder {
-	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("r.Payload", ","), `"]"`}, "")
+	resourceRefConcatQuery := s.db.Concat([]string{`'['`, s.db.GroupConcat("r.Payload", ","), `']'`}, "")
+	columns := Map(jobColumns, func(column string) string { return "jobs." + column }) // Add prefix "jobs." to jobColumns
 	return sq.
-		Select("jobs.*", resourceRefConcatQuery+" AS refs").
+		Select(append(columns, resourceRefConcatQuery+" AS refs")...).
 		FromSelect(filteredSelectBuilder, "jobs").
 		// Append all the resource references for the run as a json column
 		LeftJoin("(select * from resource_references where ResourceType='Job') AS r ON jobs.UUID=r.ResourceUUID").
-		GroupBy("jobs.UUID")
+		GroupBy(s.db.GroupByColumns("jobs.UUID", columns)...)
 }
 
 func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
@@ -200,6 +205,9 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 			return nil, err
 		}
 		resourceReferences, err := parseResourceReferences(resourceReferencesInString)
+		if err := decryptStrings(s.db.Encryptor(), &pipelineSpecManifest, &workflowSpecManifest, &parameters); err != nil {
+			return nil, util.Wrapf(err, "Failed to decrypt job %v", uuid)
+		}
 		jobs = append(jobs, &model.Job{
 			UUID:               uuid,
 			DisplayName:        displayName,
@@ -268,6 +276,11 @@ func (s *JobStore) DeleteJob(id string) error {
 }
 
 func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
+	pipelineSpecManifest, workflowSpecManifest, parameters := j.PipelineSpecManifest, j.WorkflowSpecManifest, j.Parameters
+	if err := encryptStrings(s.db.Encryptor(), &pipelineSpecManifest, &workflowSpecManifest, &parameters); err != nil {
+		return nil, util.Wrapf(err, "Failed to encrypt job %v", j.Name)
+	}
+
 	jobSql, jobArgs, err := sq.
 		Insert("jobs").
 		SetMap(sq.Eq{
@@ -291,9 +304,9 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 			"UpdatedAtInSec":                 j.UpdatedAtInSec,
 			"PipelineId":                     j.PipelineId,
 			"PipelineName":                   j.PipelineName,
-			"PipelineSpecManifest":           j.PipelineSpecManifest,
-			"WorkflowSpecManifest":           j.WorkflowSpecManifest,
-			"Parameters":                     j.Parameters,
+			"PipelineSpecManifest":           pipelineSpecManifest,
+			"WorkflowSpecManifest":           workflowSpecManifest,
+			"Parameters":                     parameters,
 		}).ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create query to add job to job table: %v",
@@ -350,6 +363,10 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 	if err != nil {
 		return err
 	}
+	parameters, err = s.db.Encryptor().EncryptString(parameters)
+	if err != nil {
+		return util.Wrapf(err, "Failed to encrypt job %v", swf.UID)
+	}
 
 	sql, args, err := sq.
 		Update("jobs").
@@ -406,3 +423,41 @@ func NewJobStore(db *DB, time util.TimeInterface) *JobStore {
 		time:                   time,
 	}
 }
+
+// SoftDeleteJob hides the job until it's restored or purged.
+func (s *JobStore) SoftDeleteJob(id string, deletedAtInSec int64) error {
+	found, err := setDeletedAt(s.db, "jobs", id, deletedAtInSec)
+	if err == nil && !found {
+		return util.NewResourceNotFoundError("Job", id)
+	}
+	return err
+}
+
+// UndeleteJob restores the soft deleted job.
+func (s *JobStore) UndeleteJob(id string) error {
+	found, err := setDeletedAt(s.db, "jobs", id, 0)
+	if err == nil && !found {
+		return util.NewResourceNotFoundError("Deleted job", id)
+	}
+	return err
+}
+
+// ListJobsDeletedBefore returns the jobs soft deleted before the time, to be
+// purged.
+func (s *JobStore) ListJobsDeletedBefore(deletedBeforeInSec int64) ([]*model.Job, error) {
+	sql, args, err := s.addResourceReferences(
+		sq.Select(jobColumns...).From("jobs").Where(deletedBefore(deletedBeforeInSec))).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list deleted jobs: %v", err.Error())
+	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deleted jobs: %v", err.Error())
+	}
+	defer r.Close()
+	jobs, err := s.scanRows(r)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deleted jobs: %v", err.Error())
+	}
+	return jobs, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type LeaseStoreInterface interface {
+	// AcquireLease takes the named lease for the holder, unless another holder owns
+	// it and it hasn't expired. Acquiring a lease already owned by the holder renews it.
+	AcquireLease(name string, holder string, ttl time.Duration) (bool, error)
+	// ReleaseLease gives up the named lease if it's owned by the holder.
+	ReleaseLease(name string, holder string) error
+}
+
+// Implementation of a LeaseStoreInterface. Leases expire, so a replica that dies
+// while holding one doesn't block the others forever.
+type LeaseStore struct {
+	db   *DB
+	time util.TimeInterface
+}
+
+func (s *LeaseStore) AcquireLease(name string, holder string, ttl time.Duration) (bool, error) {
+	now := s.time.Now()
+	expiresAtInSec := now.Add(ttl).Unix()
+
+	// Take over the lease if it's ours or has expired.
+	sql, args, err := sq.
+		Update("leases").
+		SetMap(sq.Eq{"Holder": holder, "ExpiresAtInSec": expiresAtInSec}).
+		Where(sq.Eq{"Name": name}).
+		Where(sq.Or{sq.Eq{"Holder": holder}, sq.Lt{"ExpiresAtInSec": now.Unix()}}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Error creating query to acquire lease %v.", name)
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to acquire lease %v.", name)
+	}
+	if updated, err := result.RowsAffected(); err == nil && updated > 0 {
+		return true, nil
+	}
+
+	// Otherwise create it. This fails on the primary key if another holder owns it.
+	sql, args, err = sq.
+		Insert("leases").
+		SetMap(sq.Eq{"Name": name, "Holder": holder, "ExpiresAtInSec": expiresAtInSec}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Error creating query to acquire lease %v.", name)
+	}
+	if _, insertErr := s.db.Exec(sql, args...); insertErr != nil {
+		currentHolder, err := s.getHolder(name)
+		if err != nil {
+			return false, err
+		}
+		if currentHolder != "" && currentHolder != holder {
+			return false, nil
+		}
+		// MySQL reports no affected rows when the renewal doesn't change the row,
+		// e.g. when the holder renews the lease within the same second.
+		if currentHolder == holder {
+			return true, nil
+		}
+		return false, util.NewInternalServerError(insertErr, "Failed to acquire lease %v.", name)
+	}
+	return true, nil
+}
+
+func (s *LeaseStore) ReleaseLease(name string, holder string) error {
+	sql, args, err := sq.
+		Delete("leases").
+		Where(sq.Eq{"Name": name, "Holder": holder}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to release lease %v.", name)
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to release lease %v.", name)
+	}
+	return nil
+}
+
+func (s *LeaseStore) getHolder(name string) (string, error) {
+	sql, args, err := sq.Select("Holder").From("leases").Where(sq.Eq{"Name": name}).ToSql()
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Error creating query to get the holder of lease %v.", name)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to get the holder of lease %v.", name)
+	}
+	defer rows.Close()
+	var holder string
+	if rows.Next() {
+		if err = rows.Scan(&holder); err != nil {
+			return "", util.NewInternalServerError(err, "Failed to get the holder of lease %v.", name)
+		}
+	}
+	return holder, nil
+}
+
+// factory function for lease store
+func NewLeaseStore(db *DB, time util.TimeInterface) *LeaseStore {
+	return &LeaseStore{db: db, time: time}
+}
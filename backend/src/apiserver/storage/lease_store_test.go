@@ -0,0 +1,88 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquireLease(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	leaseStore := NewLeaseStore(db, util.NewFakeTimeForEpoch())
+
+	acquired, err := leaseStore.AcquireLease("startup", "replica1", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	// Held by another replica.
+	acquired, err = leaseStore.AcquireLease("startup", "replica2", time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, acquired)
+
+	// Renewed by the holder.
+	acquired, err = leaseStore.AcquireLease("startup", "replica1", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	// Other leases are independent.
+	acquired, err = leaseStore.AcquireLease("other", "replica2", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+}
+
+func TestAcquireLease_Expired(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	// The fake time advances a second on every call.
+	leaseStore := NewLeaseStore(db, util.NewFakeTimeForEpoch())
+
+	acquired, err := leaseStore.AcquireLease("startup", "replica1", 0)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = leaseStore.AcquireLease("startup", "replica2", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+}
+
+func TestReleaseLease(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	leaseStore := NewLeaseStore(db, util.NewFakeTimeForEpoch())
+
+	acquired, err := leaseStore.AcquireLease("startup", "replica1", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	// Only the holder releases the lease.
+	assert.Nil(t, leaseStore.ReleaseLease("startup", "replica2"))
+	acquired, err = leaseStore.AcquireLease("startup", "replica2", time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, acquired)
+
+	assert.Nil(t, leaseStore.ReleaseLease("startup", "replica1"))
+	acquired, err = leaseStore.AcquireLease("startup", "replica2", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	db.Close()
+	_, err = leaseStore.AcquireLease("startup", "replica1", time.Minute)
+	assert.NotNil(t, err)
+}
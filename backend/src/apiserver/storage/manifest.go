@@ -0,0 +1,62 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// CompressManifest gzips manifests larger than threshold bytes. Smaller manifests,
+// or any manifest when threshold isn't positive, are returned as is.
+func CompressManifest(manifest []byte, threshold int) ([]byte, error) {
+	if threshold <= 0 || len(manifest) <= threshold {
+		return manifest, nil
+	}
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(manifest); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to compress manifest")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to compress manifest")
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressManifest reverses CompressManifest. Uncompressed manifests, which are
+// YAML or JSON and can't start with the gzip magic number, are returned as is.
+func DecompressManifest(manifest []byte) ([]byte, error) {
+	if !isGzip(manifest) {
+		return manifest, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(manifest))
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to decompress manifest")
+	}
+	defer reader.Close()
+	decompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to decompress manifest")
+	}
+	return decompressed, nil
+}
+
+func isGzip(content []byte) bool {
+	return len(content) > 2 && content[0] == '\x1F' && content[1] == '\x8B'
+}
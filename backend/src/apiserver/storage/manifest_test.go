@@ -0,0 +1,48 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressManifest(t *testing.T) {
+	manifest := []byte("apiVersion: tekton.dev/v1\nkind: PipelineRun\n" + strings.Repeat("# padding\n", 100))
+
+	compressed, err := CompressManifest(manifest, 100)
+	assert.Nil(t, err)
+	assert.True(t, len(compressed) < len(manifest))
+	decompressed, err := DecompressManifest(compressed)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, decompressed)
+}
+
+func TestCompressManifest_BelowThreshold(t *testing.T) {
+	manifest := []byte("apiVersion: tekton.dev/v1\nkind: PipelineRun\n")
+
+	compressed, err := CompressManifest(manifest, len(manifest))
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, compressed)
+	compressed, err = CompressManifest(manifest, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, compressed)
+
+	decompressed, err := DecompressManifest(manifest)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, decompressed)
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Migration is a versioned change of the database schema, which can be reverted.
+// MySQL commits every DDL statement on its own, so a migration which fails half way
+// is run again from the start: Up and Down need to be idempotent.
+type Migration struct {
+	Version     int64
+	Description string
+	Up          func(db *DB) error
+	Down        func(db *DB) error
+}
+
+// AppliedMigration is the record of a migration applied to the database.
+type AppliedMigration struct {
+	Version        int64
+	Description    string
+	AppliedAtInSec int64
+}
+
+const createSchemaMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	Version bigint NOT NULL PRIMARY KEY,
+	Description varchar(255) NOT NULL,
+	AppliedAtInSec bigint NOT NULL
+)`
+
+// Migrator applies and reverts the migrations in order, and records the ones
+// applied in the schema_migrations table.
+type Migrator struct {
+	db         *DB
+	migrations []Migration
+	time       util.TimeInterface
+}
+
+// LatestVersion returns the version of the last migration, which is the version
+// of the schema this server works with.
+func (m *Migrator) LatestVersion() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// AppliedMigrations returns the migrations applied to the database, in order.
+func (m *Migrator) AppliedMigrations() ([]*AppliedMigration, error) {
+	if _, err := m.db.Exec(createSchemaMigrationsTable); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create the schema_migrations table")
+	}
+	sql, args, err := sq.
+		Select("Version", "Description", "AppliedAtInSec").
+		From("schema_migrations").
+		OrderBy("Version").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Error creating query to list the applied migrations")
+	}
+	rows, err := m.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the applied migrations")
+	}
+	defer rows.Close()
+	var applied []*AppliedMigration
+	for rows.Next() {
+		var migration AppliedMigration
+		if err := rows.Scan(&migration.Version, &migration.Description, &migration.AppliedAtInSec); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to list the applied migrations")
+		}
+		applied = append(applied, &migration)
+	}
+	return applied, nil
+}
+
+// Preflight checks that the migrations applied to the database are the first
+// migrations this server knows about, before anything is changed. A database
+// migrated by a newer release needs to be rolled back by that release first.
+func (m *Migrator) Preflight() error {
+	applied, err := m.AppliedMigrations()
+	if err != nil {
+		return err
+	}
+	for i, migration := range applied {
+		if i >= len(m.migrations) {
+			return util.NewFailedPreconditionError(errors.New("database schema is newer than the server"),
+				"The database schema is at version %v, which is newer than the latest version %v known by this server. "+
+					"Roll it back with the release which migrated it.", applied[len(applied)-1].Version, m.LatestVersion())
+		}
+		if migration.Version != m.migrations[i].Version {
+			return util.NewFailedPreconditionError(errors.New("unexpected migration applied"),
+				"The database schema has migration %v applied where migration %v was expected.",
+				migration.Version, m.migrations[i].Version)
+		}
+	}
+	return nil
+}
+
+// MigrateTo applies or reverts the migrations, one at a time, until the schema is
+// at the given version.
+func (m *Migrator) MigrateTo(version int64) error {
+	if err := m.Preflight(); err != nil {
+		return err
+	}
+	if version != 0 && m.index(version) < 0 {
+		return util.NewInvalidInputError("Unknown schema version %v.", version)
+	}
+	applied, err := m.AppliedMigrations()
+	if err != nil {
+		return err
+	}
+	// Preflight made sure the applied migrations are the first ones.
+	for i := len(applied); i < len(m.migrations) && m.migrations[i].Version <= version; i++ {
+		if err := m.apply(m.migrations[i]); err != nil {
+			return err
+		}
+	}
+	for i := len(applied) - 1; i >= 0 && m.migrations[i].Version > version; i-- {
+		if err := m.revert(m.migrations[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MigrateToLatest applies the migrations which haven't been applied yet.
+func (m *Migrator) MigrateToLatest() error {
+	return m.MigrateTo(m.LatestVersion())
+}
+
+func (m *Migrator) index(version int64) int {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *Migrator) apply(migration Migration) error {
+	log.Infof("Applying migration %v: %v", migration.Version, migration.Description)
+	if err := migration.Up(m.db); err != nil {
+		return util.Wrapf(err, "Failed to apply migration %v", migration.Version)
+	}
+	sql, args, err := sq.
+		Insert("schema_migrations").
+		SetMap(sq.Eq{
+			"Version":        migration.Version,
+			"Description":    migration.Description,
+			"AppliedAtInSec": m.time.Now().Unix(),
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to record migration %v", migration.Version)
+	}
+	if _, err := m.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to record migration %v", migration.Version)
+	}
+	return nil
+}
+
+func (m *Migrator) revert(migration Migration) error {
+	log.Infof("Reverting migration %v: %v", migration.Version, migration.Description)
+	if err := migration.Down(m.db); err != nil {
+		return util.Wrapf(err, "Failed to revert migration %v", migration.Version)
+	}
+	sql, args, err := sq.Delete("schema_migrations").Where(sq.Eq{"Version": migration.Version}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to remove the record of migration %v", migration.Version)
+	}
+	if _, err := m.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to remove the record of migration %v", migration.Version)
+	}
+	return nil
+}
+
+// NewMigrator creates a Migrator of the given migrations, which need to have
+// unique versions.
+func NewMigrator(db *DB, migrations []Migration, time util.TimeInterface) *Migrator {
+	sorted := append([]Migration{}, migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Version == sorted[i-1].Version {
+			panic(fmt.Sprintf("Duplicate migration version %v", sorted[i].Version))
+		}
+	}
+	return &Migrator{db: db, migrations: sorted, time: time}
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func execMigration(statement string) func(db *DB) error {
+	return func(db *DB) error {
+		_, err := db.Exec(statement)
+		return err
+	}
+}
+
+var testMigrations = []Migration{
+	{
+		Version:     100,
+		Description: "Create table a",
+		Up:          execMigration("CREATE TABLE a (ID varchar(255) NOT NULL PRIMARY KEY)"),
+		Down:        execMigration("DROP TABLE a"),
+	},
+	{
+		Version:     101,
+		Description: "Create table b",
+		Up:          execMigration("CREATE TABLE b (ID varchar(255) NOT NULL PRIMARY KEY)"),
+		Down:        execMigration("DROP TABLE b"),
+	},
+}
+
+// schemaVersions returns the versions of the schema migrations, followed by the
+// versions of the test migrations.
+func schemaVersions(testVersions ...int64) []int64 {
+	versions := []int64{}
+	for _, migration := range Migrations {
+		versions = append(versions, migration.Version)
+	}
+	return append(versions, testVersions...)
+}
+
+func appliedVersions(t *testing.T, migrator *Migrator) []int64 {
+	applied, err := migrator.AppliedMigrations()
+	assert.Nil(t, err)
+	versions := []int64{}
+	for _, migration := range applied {
+		versions = append(versions, migration.Version)
+	}
+	return versions
+}
+
+func TestFakeDb_IsMigrated(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	migrator := NewMigrator(db, Migrations, util.NewFakeTimeForEpoch())
+
+	assert.Nil(t, migrator.Preflight())
+	assert.Equal(t, schemaVersions(), appliedVersions(t, migrator))
+	// The migrations revert cleanly.
+	assert.Nil(t, migrator.MigrateTo(0))
+	_, err := db.Exec("SELECT * FROM run_details")
+	assert.NotNil(t, err)
+	assert.Nil(t, migrator.MigrateToLatest())
+}
+
+func TestMigrator_MigrateTo(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	// The migrations are sorted by version.
+	migrator := NewMigrator(db, append(Migrations, testMigrations[1], testMigrations[0]), util.NewFakeTimeForEpoch())
+	assert.Equal(t, int64(101), migrator.LatestVersion())
+
+	assert.Nil(t, migrator.MigrateToLatest())
+	assert.Equal(t, schemaVersions(100, 101), appliedVersions(t, migrator))
+	applied, err := migrator.AppliedMigrations()
+	assert.Nil(t, err)
+	assert.Equal(t, &AppliedMigration{Version: 101, Description: "Create table b", AppliedAtInSec: 2}, applied[len(Migrations)+1])
+	_, err = db.Exec("INSERT INTO b (ID) VALUES ('1')")
+	assert.Nil(t, err)
+
+	// Migrating again is a no-op.
+	assert.Nil(t, migrator.MigrateToLatest())
+
+	assert.Nil(t, migrator.MigrateTo(100))
+	assert.Equal(t, schemaVersions(100), appliedVersions(t, migrator))
+	_, err = db.Exec("INSERT INTO b (ID) VALUES ('1')")
+	assert.NotNil(t, err)
+
+	err = migrator.MigrateTo(50)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestMigrator_FailedMigrationIsNotRecorded(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	migrator := NewMigrator(db, append(Migrations, Migration{
+		Version:     100,
+		Description: "Fail",
+		Up:          func(db *DB) error { return errors.New("failed") },
+		Down:        func(db *DB) error { return nil },
+	}), util.NewFakeTimeForEpoch())
+
+	err := migrator.MigrateToLatest()
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to apply migration 100")
+	assert.Equal(t, schemaVersions(), appliedVersions(t, migrator))
+}
+
+func TestMigrator_Preflight(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	assert.Nil(t, NewMigrator(db, append(Migrations, testMigrations...), util.NewFakeTimeForEpoch()).MigrateToLatest())
+
+	// A server of an older release doesn't know about the latest migrations.
+	err := NewMigrator(db, Migrations, util.NewFakeTimeForEpoch()).Preflight()
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), fmt.Sprintf("newer than the latest version %d", Migrations[len(Migrations)-1].Version))
+
+	// A server which skipped a migration doesn't migrate.
+	migrator := NewMigrator(db, append(Migrations, testMigrations[1]), util.NewFakeTimeForEpoch())
+	err = migrator.MigrateToLatest()
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "migration 100 applied where migration 101 was expected")
+}
+
+func TestNewMigrator_DuplicateVersion(t *testing.T) {
+	assert.Panics(t, func() {
+		NewMigrator(nil, append(Migrations, Migrations...), util.NewFakeTimeForEpoch())
+	})
+}
@@ -16,8 +16,11 @@ package storage
 
 import (
 	"io"
+	"net/url"
+	"time"
 
 	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/encrypt"
 )
 
 // Create interface for minio client struct, making it more unit testable.
@@ -25,6 +28,11 @@ type MinioClientInterface interface {
 	PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (n int64, err error)
 	GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error)
 	DeleteObject(bucketName, objectName string) error
+	CopyObject(bucketName, srcObjectName, dstObjectName string, sse encrypt.ServerSide) error
+	ComposeObject(bucketName string, srcObjectNames []string, dstObjectName string, sse encrypt.ServerSide) error
+	ListObjects(bucketName, prefix string) ([]ObjectInfo, error)
+	PresignedGetObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error)
+	PresignedPutObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error)
 }
 
 type MinioClient struct {
@@ -42,3 +50,48 @@ func (c *MinioClient) GetObject(bucketName, objectName string, opts minio.GetObj
 func (c *MinioClient) DeleteObject(bucketName, objectName string) error {
 	return c.Client.RemoveObject(bucketName, objectName)
 }
+
+// CopyObject copies an object within the bucket without downloading it. The
+// copy is composed so that objects larger than 5GiB can be copied too.
+func (c *MinioClient) CopyObject(bucketName, srcObjectName, dstObjectName string, sse encrypt.ServerSide) error {
+	dst, err := minio.NewDestinationInfo(bucketName, dstObjectName, sse, nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.ComposeObject(dst, []minio.SourceInfo{minio.NewSourceInfo(bucketName, srcObjectName, nil)})
+}
+
+// ComposeObject concatenates objects of the bucket into an object. Every source
+// object but the last one has to be at least 5MiB.
+func (c *MinioClient) ComposeObject(bucketName string, srcObjectNames []string, dstObjectName string, sse encrypt.ServerSide) error {
+	dst, err := minio.NewDestinationInfo(bucketName, dstObjectName, sse, nil)
+	if err != nil {
+		return err
+	}
+	srcs := make([]minio.SourceInfo, 0, len(srcObjectNames))
+	for _, srcObjectName := range srcObjectNames {
+		srcs = append(srcs, minio.NewSourceInfo(bucketName, srcObjectName, nil))
+	}
+	return c.Client.ComposeObject(dst, srcs)
+}
+
+func (c *MinioClient) ListObjects(bucketName, prefix string) ([]ObjectInfo, error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	var objects []ObjectInfo
+	for object := range c.Client.ListObjectsV2(bucketName, prefix, true, doneCh) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		objects = append(objects, ObjectInfo{Key: object.Key, Size: object.Size, LastModified: object.LastModified, ETag: object.ETag})
+	}
+	return objects, nil
+}
+
+func (c *MinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return c.Client.PresignedGetObject(bucketName, objectName, expiry, nil)
+}
+
+func (c *MinioClient) PresignedPutObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return c.Client.PresignedPutObject(bucketName, objectName, expiry)
+}
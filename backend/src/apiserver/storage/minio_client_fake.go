@@ -16,19 +16,28 @@ package storage
 
 import (
 	"bytes"
+	"crypto/md5"
+	"fmt"
 	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/encrypt"
 	"github.com/pkg/errors"
 )
 
 type FakeMinioClient struct {
-	minioClient map[string][]byte
+	minioClient  map[string][]byte
+	lastModified map[string]time.Time
 }
 
 func NewFakeMinioClient() *FakeMinioClient {
 	return &FakeMinioClient{
-		minioClient: make(map[string][]byte),
+		minioClient:  make(map[string][]byte),
+		lastModified: make(map[string]time.Time),
 	}
 }
 
@@ -37,6 +46,7 @@ func (c *FakeMinioClient) PutObject(bucketName, objectName string, reader io.Rea
 	buf := new(bytes.Buffer)
 	buf.ReadFrom(reader)
 	c.minioClient[objectName] = buf.Bytes()
+	c.lastModified[objectName] = time.Now()
 	return 1, nil
 }
 
@@ -53,9 +63,67 @@ func (c *FakeMinioClient) DeleteObject(bucketName, objectName string) error {
 		return errors.New("object not found")
 	}
 	delete(c.minioClient, objectName)
+	delete(c.lastModified, objectName)
 	return nil
 }
 
+func (c *FakeMinioClient) CopyObject(bucketName, srcObjectName, dstObjectName string, sse encrypt.ServerSide) error {
+	content, ok := c.minioClient[srcObjectName]
+	if !ok {
+		return errors.New("object not found")
+	}
+	c.minioClient[dstObjectName] = content
+	c.lastModified[dstObjectName] = time.Now()
+	return nil
+}
+
+func (c *FakeMinioClient) ComposeObject(bucketName string, srcObjectNames []string, dstObjectName string, sse encrypt.ServerSide) error {
+	var content []byte
+	for _, srcObjectName := range srcObjectNames {
+		src, ok := c.minioClient[srcObjectName]
+		if !ok {
+			return errors.New("object not found")
+		}
+		content = append(content, src...)
+	}
+	c.minioClient[dstObjectName] = content
+	c.lastModified[dstObjectName] = time.Now()
+	return nil
+}
+
+func (c *FakeMinioClient) ListObjects(bucketName, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	for objectName, content := range c.minioClient {
+		if strings.HasPrefix(objectName, prefix) {
+			objects = append(objects, ObjectInfo{
+				Key:          objectName,
+				Size:         int64(len(content)),
+				LastModified: c.lastModified[objectName],
+				ETag:         fmt.Sprintf("%x", md5.Sum(content)),
+			})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (c *FakeMinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return fakeSignedURL("minio", bucketName, objectName, expiry)
+}
+
+func (c *FakeMinioClient) PresignedPutObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return fakeSignedURL("minio", bucketName, objectName, expiry)
+}
+
+func fakeSignedURL(host, bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return url.Parse(fmt.Sprintf("https://%s/%s/%s?expiry=%d", host, bucketName, objectName, int64(expiry.Seconds())))
+}
+
+// SetLastModified overrides when an object was last modified.
+func (c *FakeMinioClient) SetLastModified(objectName string, lastModified time.Time) {
+	c.lastModified[objectName] = lastModified
+}
+
 func (c *FakeMinioClient) GetObjectCount() int {
 	return len(c.minioClient)
 }
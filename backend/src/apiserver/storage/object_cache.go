@@ -0,0 +1,253 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+// The folder of the cache directory holding the cached files, which is emptied
+// when the cache is created.
+const objectCacheFolder = "kfp-object-cache"
+
+var errObjectTooLarge = errors.New("the file is larger than the object cache")
+
+var (
+	objectCacheHitCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "object_cache_hits",
+		Help: "The number of files read from the local object cache",
+	})
+	objectCacheMissCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "object_cache_misses",
+		Help: "The number of files downloaded to the local object cache",
+	})
+)
+
+// ObjectCache keeps the files recently read from the object store on the local
+// disk, keyed by their ETag, so that the artifacts read repeatedly, like the
+// CSVs rendered by the visualizations, aren't downloaded for every read. A file
+// that changes gets a new ETag, so it's downloaded again. The least recently
+// used files are evicted once the cache exceeds its size. A nil cache reads the
+// files from the object store.
+type ObjectCache struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type objectCacheEntry struct {
+	key  string
+	size int64
+}
+
+// NewObjectCache creates a cache of at most maxSize bytes in a folder of dir.
+// The files cached by a previous process aren't tracked, so the folder is
+// emptied. An empty dir or a size of zero or less disables caching.
+func NewObjectCache(dir string, maxSize int64) *ObjectCache {
+	if dir == "" || maxSize <= 0 {
+		return nil
+	}
+	cacheDir := filepath.Join(dir, objectCacheFolder)
+	err := os.RemoveAll(cacheDir)
+	if err == nil {
+		err = os.MkdirAll(cacheDir, 0700)
+	}
+	if err != nil {
+		log.Warningf("Disabling the object cache, failed to create %v: %v", cacheDir, err)
+		return nil
+	}
+	return &ObjectCache{
+		dir:     cacheDir,
+		maxSize: maxSize,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// OpenFile streams a file of the object store like ObjectStoreInterface.OpenFile,
+// from the cache.
+func (c *ObjectCache) OpenFile(objectStore ObjectStoreInterface, filePath string) (io.ReadCloser, error) {
+	return c.open(objectStore, filePath, "open", func() (io.ReadCloser, error) {
+		return objectStore.OpenFile(filePath)
+	})
+}
+
+// GetFile reads a file of the object store like ObjectStoreInterface.GetFile,
+// from the cache. The content is cached apart from OpenFile's, since the object
+// store may transform it.
+func (c *ObjectCache) GetFile(objectStore ObjectStoreInterface, filePath string) ([]byte, error) {
+	if c == nil {
+		return objectStore.GetFile(filePath)
+	}
+	reader, err := c.open(objectStore, filePath, "get", func() (io.ReadCloser, error) {
+		content, err := objectStore.GetFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// open returns the cached content of a file, fetching it to the cache on a
+// miss. The files larger than the cache, or whose ETag the object store doesn't
+// return, are fetched without caching them. Failing to cache a file doesn't fail
+// the read.
+func (c *ObjectCache) open(objectStore ObjectStoreInterface, filePath string, variant string,
+	fetch func() (io.ReadCloser, error)) (io.ReadCloser, error) {
+	if c == nil {
+		return fetch()
+	}
+	info, err := statFile(objectStore, filePath)
+	if err != nil {
+		log.Warningf("Reading %v without the object cache, failed to get its ETag: %v", filePath, err)
+		return fetch()
+	}
+	if info == nil || info.ETag == "" || info.Size > c.maxSize {
+		return fetch()
+	}
+	hash := sha256.Sum256([]byte(variant + "\x00" + filePath + "\x00" + info.ETag))
+	key := hex.EncodeToString(hash[:])
+
+	if file := c.openEntry(key); file != nil {
+		objectCacheHitCounter.Inc()
+		return file, nil
+	}
+	// Concurrent misses for the same file fetch it twice, which is cheaper than
+	// serializing the downloads.
+	objectCacheMissCounter.Inc()
+	if err := c.add(key, fetch); err != nil {
+		log.Warningf("Reading %v without the object cache, failed to cache it: %v", filePath, err)
+		return fetch()
+	}
+	if file := c.openEntry(key); file != nil {
+		return file, nil
+	}
+	return fetch()
+}
+
+// openEntry opens a cached file, or returns nil if it isn't cached. The file is
+// opened under the lock so that it can't be evicted in between.
+func (c *ObjectCache) openEntry(key string) *os.File {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	file, err := os.Open(filepath.Join(c.dir, key))
+	if err != nil {
+		c.remove(element)
+		return nil
+	}
+	c.order.MoveToFront(element)
+	return file
+}
+
+// add fetches a file to the cache, evicting the least recently used files to
+// make room for it.
+func (c *ObjectCache) add(key string, fetch func() (io.ReadCloser, error)) error {
+	reader, err := fetch()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	tmp, err := ioutil.TempFile(c.dir, "fetch-")
+	if err != nil {
+		return err
+	}
+	size, err := io.Copy(tmp, io.LimitReader(reader, c.maxSize+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && size > c.maxSize {
+		err = errObjectTooLarge
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; ok {
+		os.Remove(tmp.Name())
+		return nil
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(c.dir, key)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	c.entries[key] = c.order.PushFront(&objectCacheEntry{key: key, size: size})
+	c.size += size
+	for c.size > c.maxSize && c.order.Len() > 1 {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+// remove evicts a cached file. The readers that opened it can still read it.
+func (c *ObjectCache) remove(element *list.Element) {
+	entry := element.Value.(*objectCacheEntry)
+	c.order.Remove(element)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+	os.Remove(filepath.Join(c.dir, entry.key))
+}
+
+// Size returns the total size of the cached files.
+func (c *ObjectCache) Size() int64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// statFile returns the description of a file, or nil if it doesn't exist.
+func statFile(objectStore ObjectStoreInterface, filePath string) (*ObjectInfo, error) {
+	objects, err := objectStore.ListFiles(filePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, object := range objects {
+		if object.Key == filePath {
+			return &object, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingObjectStore counts the files read from the object store.
+type countingObjectStore struct {
+	ObjectStoreInterface
+	reads int
+}
+
+func (s *countingObjectStore) OpenFile(filePath string) (io.ReadCloser, error) {
+	s.reads++
+	return s.ObjectStoreInterface.OpenFile(filePath)
+}
+
+func (s *countingObjectStore) GetFile(filePath string) ([]byte, error) {
+	s.reads++
+	return s.ObjectStoreInterface.GetFile(filePath)
+}
+
+func newTestObjectCache(t *testing.T, maxSize int64) (*ObjectCache, func()) {
+	dir, err := ioutil.TempDir("", "object-cache")
+	assert.Nil(t, err)
+	return NewObjectCache(dir, maxSize), func() { os.RemoveAll(dir) }
+}
+
+func readCachedFile(t *testing.T, cache *ObjectCache, objectStore ObjectStoreInterface, filePath string) string {
+	reader, err := cache.OpenFile(objectStore, filePath)
+	assert.Nil(t, err)
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	return string(content)
+}
+
+func TestObjectCache(t *testing.T) {
+	cache, cleanup := newTestObjectCache(t, 10)
+	defer cleanup()
+	objectStore := &countingObjectStore{ObjectStoreInterface: NewFakeObjectStore()}
+	assert.Nil(t, objectStore.AddFile([]byte("abcd"), "artifacts/a.csv"))
+
+	assert.Equal(t, "abcd", readCachedFile(t, cache, objectStore, "artifacts/a.csv"))
+	assert.Equal(t, "abcd", readCachedFile(t, cache, objectStore, "artifacts/a.csv"))
+	assert.Equal(t, 1, objectStore.reads)
+	assert.Equal(t, int64(4), cache.Size())
+
+	// A changed file has a new ETag.
+	assert.Nil(t, objectStore.AddFile([]byte("efgh"), "artifacts/a.csv"))
+	assert.Equal(t, "efgh", readCachedFile(t, cache, objectStore, "artifacts/a.csv"))
+	assert.Equal(t, 2, objectStore.reads)
+
+	// GetFile caches its content apart.
+	content, err := cache.GetFile(objectStore, "artifacts/a.csv")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("efgh"), content)
+	content, err = cache.GetFile(objectStore, "artifacts/a.csv")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("efgh"), content)
+	assert.Equal(t, 3, objectStore.reads)
+}
+
+func TestObjectCache_Evict(t *testing.T) {
+	cache, cleanup := newTestObjectCache(t, 10)
+	defer cleanup()
+	objectStore := &countingObjectStore{ObjectStoreInterface: NewFakeObjectStore()}
+	assert.Nil(t, objectStore.AddFile([]byte("aaaa"), "a"))
+	assert.Nil(t, objectStore.AddFile([]byte("bbbb"), "b"))
+	assert.Nil(t, objectStore.AddFile([]byte("cccc"), "c"))
+	assert.Nil(t, objectStore.AddFile([]byte("large content"), "large"))
+
+	readCachedFile(t, cache, objectStore, "a")
+	readCachedFile(t, cache, objectStore, "b")
+	readCachedFile(t, cache, objectStore, "a")
+	// Caching c evicts b, the least recently used file.
+	readCachedFile(t, cache, objectStore, "c")
+	assert.Equal(t, int64(8), cache.Size())
+	assert.Equal(t, 3, objectStore.reads)
+	readCachedFile(t, cache, objectStore, "a")
+	assert.Equal(t, 3, objectStore.reads)
+	readCachedFile(t, cache, objectStore, "b")
+	assert.Equal(t, 4, objectStore.reads)
+
+	// The files larger than the cache aren't cached.
+	assert.Equal(t, "large content", readCachedFile(t, cache, objectStore, "large"))
+	assert.Equal(t, int64(8), cache.Size())
+}
+
+func TestObjectCache_Disabled(t *testing.T) {
+	cache := NewObjectCache("", 10)
+	objectStore := &countingObjectStore{ObjectStoreInterface: NewFakeObjectStore()}
+	assert.Nil(t, objectStore.AddFile([]byte("abcd"), "a"))
+
+	assert.Equal(t, "abcd", readCachedFile(t, cache, objectStore, "a"))
+	assert.Equal(t, "abcd", readCachedFile(t, cache, objectStore, "a"))
+	assert.Equal(t, 2, objectStore.reads)
+
+	_, err := cache.OpenFile(objectStore, "missing")
+	assert.NotNil(t, err)
+}
@@ -16,11 +16,21 @@ package storage
 
 import (
 	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"path"
 	"regexp"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go/v6"
+	"github.com/pkg/errors"
 	"sigs.k8s.io/yaml"
 )
 
@@ -31,11 +41,79 @@ const (
 // Interface for object store.
 type ObjectStoreInterface interface {
 	AddFile(template []byte, filePath string) error
+	// AddFileInNamespace stores a file that belongs to a namespace, applying the
+	// settings of the namespace such as its encryption key.
+	AddFileInNamespace(template []byte, filePath string, namespace string) error
 	DeleteFile(filePath string) error
+	// CopyFile copies a file within the object store without downloading it,
+	// applying the settings of the namespace like AddFileInNamespace.
+	CopyFile(srcPath string, dstPath string, namespace string) error
+	// ComposeFile concatenates files into a file within the object store without
+	// downloading them, applying the settings of the namespace like CopyFile.
+	ComposeFile(srcPaths []string, dstPath string, namespace string) error
 	GetFile(filePath string) ([]byte, error)
+	// OpenFile streams the content of a file, which the caller has to close.
+	OpenFile(filePath string) (io.ReadCloser, error)
+	// ListFiles lists the files under the prefix, including the ones in its
+	// subfolders.
+	ListFiles(prefix string) ([]ObjectInfo, error)
+	// GetSignedURL returns a URL that grants the GET or PUT method on a file
+	// without credentials until the expiry elapses.
+	GetSignedURL(filePath string, method string, expiry time.Duration) (*SignedURL, error)
 	AddAsYamlFile(o interface{}, filePath string) error
 	GetFromYamlFile(o interface{}, filePath string) error
 	GetPipelineKey(pipelineId string) string
+	// ForNamespace returns the object store holding the artifacts and logs of
+	// the runs of a namespace, which is the store itself unless the namespace
+	// is isolated.
+	ForNamespace(namespace string) (ObjectStoreInterface, error)
+}
+
+// ObjectInfo describes a file of the object store. The ETag changes whenever
+// the content of the file does.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+	ETag         string
+}
+
+// How long the object store of an isolated namespace is reused before its
+// credentials are read again.
+const namespaceObjectStoreTTL = 5 * time.Minute
+
+// NamespaceIsolation stores the objects of some namespaces in a bucket of their
+// own, or a prefix of the bucket, and accesses them with the credentials of the
+// namespace. The credentials are expected to be scoped to the bucket or prefix,
+// so that tenants can't read each other's artifacts even with raw S3 access.
+type NamespaceIsolation struct {
+	Namespaces map[string]common.ObjectStoreNamespace
+	// NewClient creates a client with the credentials in a secret of the
+	// namespace.
+	NewClient func(namespace string, credentialsSecret string) (MinioClientInterface, error)
+}
+
+// Validate checks that every isolated namespace has a bucket or a prefix, and
+// that the prefixes stay within the bucket.
+func (i *NamespaceIsolation) Validate() error {
+	for namespace, isolation := range i.Namespaces {
+		if isolation.BucketName == "" && isolation.Prefix == "" {
+			return fmt.Errorf("namespace %s requires a bucket name or a prefix", namespace)
+		}
+		if prefix := isolation.Prefix; prefix != "" && (path.Clean(prefix) != prefix || path.IsAbs(prefix) ||
+			prefix == ".." || strings.HasPrefix(prefix, "../")) {
+			return fmt.Errorf("invalid prefix %q for namespace %s", prefix, namespace)
+		}
+		if isolation.CredentialsSecret == "" {
+			return fmt.Errorf("namespace %s requires a credentials secret", namespace)
+		}
+	}
+	return nil
+}
+
+type namespaceObjectStore struct {
+	store     *MinioObjectStore
+	expiresAt time.Time
 }
 
 // Managing pipeline using Minio
@@ -44,6 +122,13 @@ type MinioObjectStore struct {
 	bucketName       string
 	baseFolder       string
 	disableMultipart bool
+	encryption       *ServerSideEncryption
+	// prefix is prepended to the keys of the objects of an isolated namespace.
+	prefix    string
+	isolation *NamespaceIsolation
+
+	namespaceStoresMu sync.Mutex
+	namespaceStores   map[string]namespaceObjectStore
 }
 
 // GetPipelineKey adds the configured base folder to pipeline id.
@@ -52,6 +137,14 @@ func (m *MinioObjectStore) GetPipelineKey(pipelineID string) string {
 }
 
 func (m *MinioObjectStore) AddFile(file []byte, filePath string) error {
+	return m.AddFileInNamespace(file, filePath, "")
+}
+
+func (m *MinioObjectStore) AddFileInNamespace(file []byte, filePath string, namespace string) error {
+	encryption, err := m.encryption.ForNamespace(namespace)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to configure the encryption of %v", filePath)
+	}
 
 	var parts int64
 
@@ -61,27 +154,81 @@ func (m *MinioObjectStore) AddFile(file []byte, filePath string) error {
 		parts = multipartDefaultSize
 	}
 
-	_, err := m.minioClient.PutObject(
-		m.bucketName, filePath, bytes.NewReader(file),
-		parts, minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	key, err := m.objectKey(filePath)
+	if err != nil {
+		return err
+	}
+	_, err = m.minioClient.PutObject(
+		m.bucketName, key, bytes.NewReader(file),
+		parts, minio.PutObjectOptions{ContentType: "application/octet-stream", ServerSideEncryption: encryption})
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+		return objectStoreError(err, "Failed to store %v", filePath)
 	}
 	return nil
 }
 
 func (m *MinioObjectStore) DeleteFile(filePath string) error {
-	err := m.minioClient.DeleteObject(m.bucketName, filePath)
+	key, err := m.objectKey(filePath)
+	if err != nil {
+		return err
+	}
+	err = m.minioClient.DeleteObject(m.bucketName, key)
+	if err != nil {
+		return objectStoreError(err, "Failed to delete %v", filePath)
+	}
+	return nil
+}
+
+func (m *MinioObjectStore) CopyFile(srcPath string, dstPath string, namespace string) error {
+	encryption, err := m.encryption.ForNamespace(namespace)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to configure the encryption of %v", dstPath)
+	}
+	srcKey, err := m.objectKey(srcPath)
+	if err != nil {
+		return err
+	}
+	dstKey, err := m.objectKey(dstPath)
+	if err != nil {
+		return err
+	}
+	if err = m.minioClient.CopyObject(m.bucketName, srcKey, dstKey, encryption); err != nil {
+		return objectStoreError(err, "Failed to copy %v to %v", srcPath, dstPath)
+	}
+	return nil
+}
+
+func (m *MinioObjectStore) ComposeFile(srcPaths []string, dstPath string, namespace string) error {
+	encryption, err := m.encryption.ForNamespace(namespace)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to configure the encryption of %v", dstPath)
+	}
+	srcKeys := make([]string, 0, len(srcPaths))
+	for _, srcPath := range srcPaths {
+		srcKey, err := m.objectKey(srcPath)
+		if err != nil {
+			return err
+		}
+		srcKeys = append(srcKeys, srcKey)
+	}
+	dstKey, err := m.objectKey(dstPath)
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
+		return err
+	}
+	if err = m.minioClient.ComposeObject(m.bucketName, srcKeys, dstKey, encryption); err != nil {
+		return objectStoreError(err, "Failed to compose %v", dstPath)
 	}
 	return nil
 }
 
 func (m *MinioObjectStore) GetFile(filePath string) ([]byte, error) {
-	reader, err := m.minioClient.GetObject(m.bucketName, filePath, minio.GetObjectOptions{})
+	key, err := m.objectKey(filePath)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+		return nil, err
+	}
+	reader, err := m.minioClient.GetObject(m.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, objectStoreError(err, "Failed to get %v", filePath)
 	}
 
 	buf := new(bytes.Buffer)
@@ -98,20 +245,146 @@ func (m *MinioObjectStore) GetFile(filePath string) ([]byte, error) {
 	return bytes, nil
 }
 
+func (m *MinioObjectStore) OpenFile(filePath string) (io.ReadCloser, error) {
+	key, err := m.objectKey(filePath)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := m.minioClient.GetObject(m.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, objectStoreError(err, "Failed to get %v", filePath)
+	}
+	if readCloser, ok := reader.(io.ReadCloser); ok {
+		return readCloser, nil
+	}
+	return ioutil.NopCloser(reader), nil
+}
+
+func (m *MinioObjectStore) ListFiles(prefix string) ([]ObjectInfo, error) {
+	listPrefix := prefix
+	if m.prefix != "" {
+		listPrefix = m.prefix + "/" + prefix
+	}
+	objects, err := m.minioClient.ListObjects(m.bucketName, listPrefix)
+	if err != nil {
+		return nil, objectStoreError(err, "Failed to list %v", prefix)
+	}
+	if m.prefix != "" {
+		for i := range objects {
+			objects[i].Key = strings.TrimPrefix(objects[i].Key, m.prefix+"/")
+		}
+	}
+	return objects, nil
+}
+
+func (m *MinioObjectStore) GetSignedURL(filePath string, method string, expiry time.Duration) (*SignedURL, error) {
+	if err := validateSignedURLRequest(method, expiry); err != nil {
+		return nil, err
+	}
+	key, err := m.objectKey(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var signedURL *url.URL
+	if method == http.MethodPut {
+		// The uploads wouldn't carry the encryption headers.
+		if m.encryption != nil && m.encryption.Type != "" {
+			return nil, util.NewFailedPreconditionError(errors.New("server side encryption is configured"),
+				"Failed to sign an upload URL for %v", filePath)
+		}
+		signedURL, err = m.minioClient.PresignedPutObject(m.bucketName, key, expiry)
+	} else {
+		signedURL, err = m.minioClient.PresignedGetObject(m.bucketName, key, expiry)
+	}
+	if err != nil {
+		return nil, signedURLError(err, filePath)
+	}
+	return &SignedURL{URL: signedURL.String()}, nil
+}
+
 func (m *MinioObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(m, o, filePath)
+}
+
+func (m *MinioObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(m, o, filePath)
+}
+
+// ForNamespace returns a store in the bucket or prefix of an isolated namespace,
+// which uses the credentials of the namespace.
+func (m *MinioObjectStore) ForNamespace(namespace string) (ObjectStoreInterface, error) {
+	if m.isolation == nil {
+		return m, nil
+	}
+	isolation, ok := m.isolation.Namespaces[namespace]
+	if !ok {
+		return m, nil
+	}
+	m.namespaceStoresMu.Lock()
+	defer m.namespaceStoresMu.Unlock()
+	now := time.Now()
+	if cached, ok := m.namespaceStores[namespace]; ok && now.Before(cached.expiresAt) {
+		return cached.store, nil
+	}
+	client, err := m.isolation.NewClient(namespace, isolation.CredentialsSecret)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create the object store client of namespace %v", namespace)
+	}
+	bucketName := m.bucketName
+	if isolation.BucketName != "" {
+		bucketName = isolation.BucketName
+	}
+	store := &MinioObjectStore{
+		minioClient:      instrumentMinioClient(client),
+		bucketName:       bucketName,
+		baseFolder:       m.baseFolder,
+		disableMultipart: m.disableMultipart,
+		encryption:       m.encryption,
+		prefix:           isolation.Prefix,
+	}
+	if m.namespaceStores == nil {
+		m.namespaceStores = map[string]namespaceObjectStore{}
+	}
+	m.namespaceStores[namespace] = namespaceObjectStore{store: store, expiresAt: now.Add(namespaceObjectStoreTTL)}
+	return store, nil
+}
+
+// objectKey returns the key of a file in the bucket, rejecting the paths that
+// would escape the prefix of an isolated namespace.
+func (m *MinioObjectStore) objectKey(filePath string) (string, error) {
+	if m.prefix == "" {
+		return filePath, nil
+	}
+	key := path.Join(m.prefix, filePath)
+	if !strings.HasPrefix(key, m.prefix+"/") {
+		return "", util.NewInvalidInputError("Path %v is outside of the namespace prefix", filePath)
+	}
+	return key, nil
+}
+
+// objectStoreError reports an error of the object store client, keeping the
+// status of the errors that have one, such as an unavailable artifact store.
+func objectStoreError(err error, internalMessageFormat string, a ...interface{}) error {
+	if _, ok := err.(*util.UserError); ok {
+		return util.Wrapf(err, internalMessageFormat, a...)
+	}
+	return util.NewInternalServerError(err, internalMessageFormat, a...)
+}
+
+func addAsYamlFile(store ObjectStoreInterface, o interface{}, filePath string) error {
 	bytes, err := yaml.Marshal(o)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to marshal %v: %v", filePath, err.Error())
 	}
-	err = m.AddFile(bytes, filePath)
+	err = store.AddFile(bytes, filePath)
 	if err != nil {
 		return util.Wrap(err, "Failed to add a yaml file.")
 	}
 	return nil
 }
 
-func (m *MinioObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
-	bytes, err := m.GetFile(filePath)
+func getFromYamlFile(store ObjectStoreInterface, o interface{}, filePath string) error {
+	bytes, err := store.GetFile(filePath)
 	if err != nil {
 		return util.Wrap(err, "Failed to read from a yaml file.")
 	}
@@ -122,10 +395,46 @@ func (m *MinioObjectStore) GetFromYamlFile(o interface{}, filePath string) error
 	return nil
 }
 
+// WithNamespaceIsolation makes the namespaces of the isolation use their own
+// bucket or prefix and credentials.
+func (m *MinioObjectStore) WithNamespaceIsolation(isolation *NamespaceIsolation) *MinioObjectStore {
+	m.isolation = isolation
+	return m
+}
+
+// WithServerSideEncryption makes S3 encrypt the stored files.
+func (m *MinioObjectStore) WithServerSideEncryption(encryption *ServerSideEncryption) *MinioObjectStore {
+	m.encryption = encryption
+	return m
+}
+
+// VerifyServerSideEncryption stores and deletes a probe file with each of the
+// configured encryption keys, so that a key that cannot be used is reported at
+// startup rather than when storing a pipeline.
+func (m *MinioObjectStore) VerifyServerSideEncryption() error {
+	if m.encryption == nil || m.encryption.Type == "" {
+		return nil
+	}
+	namespaces := []string{""}
+	for namespace := range m.encryption.NamespaceKMSKeyIDs {
+		namespaces = append(namespaces, namespace)
+	}
+	for _, namespace := range namespaces {
+		probePath := path.Join(m.baseFolder, ".sse-probe")
+		if err := m.AddFileInNamespace([]byte("probe"), probePath, namespace); err != nil {
+			return util.Wrapf(err, "Failed to store a file with the encryption of namespace %q", namespace)
+		}
+		if err := m.DeleteFile(probePath); err != nil {
+			return util.Wrap(err, "Failed to delete the encryption probe file")
+		}
+	}
+	return nil
+}
+
 func buildPath(folder, file string) string {
 	return folder + "/" + file
 }
 
 func NewMinioObjectStore(minioClient MinioClientInterface, bucketName string, baseFolder string, disableMultipart bool) *MinioObjectStore {
-	return &MinioObjectStore{minioClient: minioClient, bucketName: bucketName, baseFolder: baseFolder, disableMultipart: disableMultipart}
+	return &MinioObjectStore{minioClient: instrumentMinioClient(minioClient), bucketName: bucketName, baseFolder: baseFolder, disableMultipart: disableMultipart}
 }
@@ -0,0 +1,118 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io"
+	"net/url"
+	"time"
+
+	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/encrypt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	objectStoreRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "object_store_requests_total",
+		Help: "The number of calls to the object store, by operation and result",
+	}, []string{"operation", "result"})
+	objectStoreRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "object_store_request_duration_seconds",
+		Help:    "The duration of the calls to the object store, by operation",
+		Buckets: prometheus.ExponentialBuckets(0.005, 4, 8),
+	}, []string{"operation"})
+)
+
+// instrumentedMinioClient counts the calls to the object store and measures
+// their duration. The reads are measured until the object is opened, not read.
+type instrumentedMinioClient struct {
+	client MinioClientInterface
+}
+
+func instrumentMinioClient(client MinioClientInterface) MinioClientInterface {
+	if _, ok := client.(*instrumentedMinioClient); ok || client == nil {
+		return client
+	}
+	return &instrumentedMinioClient{client: client}
+}
+
+func observeObjectStoreRequest(operation string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	objectStoreRequests.WithLabelValues(operation, result).Inc()
+	objectStoreRequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+func (c *instrumentedMinioClient) PutObject(bucketName, objectName string, reader io.Reader, objectSize int64,
+	opts minio.PutObjectOptions) (int64, error) {
+	start := time.Now()
+	n, err := c.client.PutObject(bucketName, objectName, reader, objectSize, opts)
+	observeObjectStoreRequest("put", start, err)
+	return n, err
+}
+
+func (c *instrumentedMinioClient) GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error) {
+	start := time.Now()
+	reader, err := c.client.GetObject(bucketName, objectName, opts)
+	observeObjectStoreRequest("get", start, err)
+	return reader, err
+}
+
+func (c *instrumentedMinioClient) DeleteObject(bucketName, objectName string) error {
+	start := time.Now()
+	err := c.client.DeleteObject(bucketName, objectName)
+	observeObjectStoreRequest("delete", start, err)
+	return err
+}
+
+func (c *instrumentedMinioClient) CopyObject(bucketName, srcObjectName, dstObjectName string, sse encrypt.ServerSide) error {
+	start := time.Now()
+	err := c.client.CopyObject(bucketName, srcObjectName, dstObjectName, sse)
+	observeObjectStoreRequest("copy", start, err)
+	return err
+}
+
+func (c *instrumentedMinioClient) ComposeObject(bucketName string, srcObjectNames []string, dstObjectName string,
+	sse encrypt.ServerSide) error {
+	start := time.Now()
+	err := c.client.ComposeObject(bucketName, srcObjectNames, dstObjectName, sse)
+	observeObjectStoreRequest("compose", start, err)
+	return err
+}
+
+func (c *instrumentedMinioClient) ListObjects(bucketName, prefix string) ([]ObjectInfo, error) {
+	start := time.Now()
+	objects, err := c.client.ListObjects(bucketName, prefix)
+	observeObjectStoreRequest("list", start, err)
+	return objects, err
+}
+
+func (c *instrumentedMinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	start := time.Now()
+	signedURL, err := c.client.PresignedGetObject(bucketName, objectName, expiry)
+	observeObjectStoreRequest("presign_get", start, err)
+	return signedURL, err
+}
+
+func (c *instrumentedMinioClient) PresignedPutObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	start := time.Now()
+	signedURL, err := c.client.PresignedPutObject(bucketName, objectName, expiry)
+	observeObjectStoreRequest("presign_put", start, err)
+	return signedURL, err
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstrumentedMinioClient_CountsRequests(t *testing.T) {
+	putSuccesses := testutil.ToFloat64(objectStoreRequests.WithLabelValues("put", "success"))
+	putErrors := testutil.ToFloat64(objectStoreRequests.WithLabelValues("put", "error"))
+	getSuccesses := testutil.ToFloat64(objectStoreRequests.WithLabelValues("get", "success"))
+
+	store := NewMinioObjectStore(NewFakeMinioClient(), "", "pipeline", false)
+	assert.Nil(t, store.AddFile([]byte("template"), "file"))
+	_, err := store.GetFile("file")
+	assert.Nil(t, err)
+	assert.Equal(t, putSuccesses+1, testutil.ToFloat64(objectStoreRequests.WithLabelValues("put", "success")))
+	assert.Equal(t, getSuccesses+1, testutil.ToFloat64(objectStoreRequests.WithLabelValues("get", "success")))
+
+	badStore := NewMinioObjectStore(&FakeBadMinioClient{}, "", "pipeline", false)
+	assert.NotNil(t, badStore.AddFile([]byte("template"), "file"))
+	assert.Equal(t, putErrors+1, testutil.ToFloat64(objectStoreRequests.WithLabelValues("put", "error")))
+
+	// The client is instrumented once.
+	assert.Equal(t, store.minioClient, instrumentMinioClient(store.minioClient))
+}
@@ -17,10 +17,15 @@ package storage
 import (
 	"bytes"
 	"io"
+	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/encrypt"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
@@ -44,6 +49,26 @@ func (c *FakeBadMinioClient) DeleteObject(bucketName, objectName string) error {
 	return errors.New("some error")
 }
 
+func (c *FakeBadMinioClient) CopyObject(bucketName, srcObjectName, dstObjectName string, sse encrypt.ServerSide) error {
+	return errors.New("some error")
+}
+
+func (c *FakeBadMinioClient) ComposeObject(bucketName string, srcObjectNames []string, dstObjectName string, sse encrypt.ServerSide) error {
+	return errors.New("some error")
+}
+
+func (c *FakeBadMinioClient) ListObjects(bucketName, prefix string) ([]ObjectInfo, error) {
+	return nil, errors.New("some error")
+}
+
+func (c *FakeBadMinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return nil, errors.New("some error")
+}
+
+func (c *FakeBadMinioClient) PresignedPutObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return nil, errors.New("some error")
+}
+
 func TestAddFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -88,6 +113,98 @@ func TestDeleteFileError(t *testing.T) {
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 }
 
+func TestCopyFile(t *testing.T) {
+	minioClient := NewFakeMinioClient()
+	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
+	manager.AddFile([]byte("abc"), "artifacts/run-1/node/a.tgz")
+	error := manager.CopyFile("artifacts/run-1/node/a.tgz", "artifacts/.sha256/ns1/digest", "ns1")
+	assert.Nil(t, error)
+	file, error := manager.GetFile("artifacts/.sha256/ns1/digest")
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abc"), file)
+	assert.True(t, minioClient.ExistObject("artifacts/run-1/node/a.tgz"))
+}
+
+func TestCopyFileError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
+	error := manager.CopyFile("artifacts/run-1/node/a.tgz", "artifacts/.sha256/ns1/digest", "ns1")
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestComposeFile(t *testing.T) {
+	minioClient := NewFakeMinioClient()
+	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
+	manager.AddFile([]byte("abc"), "uploads/upload-1/00000000000000000000")
+	manager.AddFile([]byte("de"), "uploads/upload-1/00000000000000000003")
+	error := manager.ComposeFile(
+		[]string{"uploads/upload-1/00000000000000000000", "uploads/upload-1/00000000000000000003"}, "artifacts/run-1/node/a.tgz", "ns1")
+	assert.Nil(t, error)
+	file, error := manager.GetFile("artifacts/run-1/node/a.tgz")
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abcde"), file)
+}
+
+func TestComposeFileError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
+	error := manager.ComposeFile([]string{"uploads/upload-1/00000000000000000000"}, "artifacts/run-1/node/a.tgz", "ns1")
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestListFiles(t *testing.T) {
+	minioClient := NewFakeMinioClient()
+	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
+	manager.AddFile([]byte("abc"), "artifacts/run-1/node/a.tgz")
+	manager.AddFile([]byte("de"), "artifacts/run-1/node/b.tgz")
+	manager.AddFile([]byte("f"), "artifacts/run-2/node/a.tgz")
+	lastModified := time.Unix(1, 0)
+	minioClient.SetLastModified("artifacts/run-1/node/a.tgz", lastModified)
+	files, error := manager.ListFiles("artifacts/run-1/")
+	assert.Nil(t, error)
+	assert.Equal(t, 2, len(files))
+	assert.Equal(t, ObjectInfo{
+		Key:          "artifacts/run-1/node/a.tgz",
+		Size:         3,
+		LastModified: lastModified,
+		ETag:         "900150983cd24fb0d6963f7d28e17f72",
+	}, files[0])
+	assert.Equal(t, "artifacts/run-1/node/b.tgz", files[1].Key)
+}
+
+func TestListFilesError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
+	_, error := manager.ListFiles("artifacts/")
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetSignedURL(t *testing.T) {
+	manager := NewMinioObjectStore(NewFakeMinioClient(), "bucket", "pipeline", false)
+	signedURL, error := manager.GetSignedURL("artifacts/run-1/node/a.tgz", http.MethodGet, time.Hour)
+	assert.Nil(t, error)
+	assert.Equal(t, &SignedURL{URL: "https://minio/bucket/artifacts/run-1/node/a.tgz?expiry=3600"}, signedURL)
+
+	_, error = manager.GetSignedURL("artifacts/run-1/node/a.tgz", http.MethodDelete, time.Hour)
+	assert.Equal(t, codes.InvalidArgument, error.(*util.UserError).ExternalStatusCode())
+	_, error = manager.GetSignedURL("artifacts/run-1/node/a.tgz", http.MethodGet, 8*24*time.Hour)
+	assert.Equal(t, codes.InvalidArgument, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetSignedURL_PutWithServerSideEncryption(t *testing.T) {
+	manager := NewMinioObjectStore(NewFakeMinioClient(), "bucket", "pipeline", false).
+		WithServerSideEncryption(&ServerSideEncryption{Type: SSES3})
+	_, error := manager.GetSignedURL("artifacts/run-1/node/a.tgz", http.MethodPut, time.Hour)
+	assert.Equal(t, codes.FailedPrecondition, error.(*util.UserError).ExternalStatusCode())
+
+	signedURL, error := manager.GetSignedURL("artifacts/run-1/node/a.tgz", http.MethodGet, time.Hour)
+	assert.Nil(t, error)
+	assert.NotEmpty(t, signedURL.URL)
+}
+
+func TestGetSignedURLError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
+	_, error := manager.GetSignedURL("artifacts/run-1/node/a.tgz", http.MethodPut, time.Hour)
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
 func TestAddAsYamlFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -123,3 +240,75 @@ func TestGetFromYamlFile_UnmarshalError(t *testing.T) {
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 	assert.Contains(t, error.Error(), "Failed to unmarshal")
 }
+
+func TestForNamespace(t *testing.T) {
+	minioClient := NewFakeMinioClient()
+	var secrets []string
+	manager := NewMinioObjectStore(NewFakeMinioClient(), "mlpipeline", "pipelines", false).
+		WithNamespaceIsolation(&NamespaceIsolation{
+			Namespaces: map[string]common.ObjectStoreNamespace{
+				"ns1": {Prefix: "tenants/ns1", CredentialsSecret: "ns1-s3"},
+			},
+			NewClient: func(namespace string, credentialsSecret string) (MinioClientInterface, error) {
+				secrets = append(secrets, credentialsSecret)
+				return minioClient, nil
+			},
+		})
+
+	store, err := manager.ForNamespace("ns2")
+	assert.Nil(t, err)
+	assert.Equal(t, manager, store)
+
+	store, err = manager.ForNamespace("ns1")
+	assert.Nil(t, err)
+	assert.Nil(t, store.AddFile([]byte("abc"), "artifacts/run-1/node/a.tgz"))
+	assert.True(t, minioClient.ExistObject("tenants/ns1/artifacts/run-1/node/a.tgz"))
+	file, err := store.GetFile("artifacts/run-1/node/a.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), file)
+	files, err := store.ListFiles("artifacts/run-1/")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, "artifacts/run-1/node/a.tgz", files[0].Key)
+
+	// The paths can't escape the prefix of the namespace.
+	_, err = store.GetFile("../ns2/artifacts/run-2/node/a.tgz")
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	// The store of the namespace is reused.
+	_, err = manager.ForNamespace("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns1-s3"}, secrets)
+}
+
+func TestForNamespace_ClientError(t *testing.T) {
+	manager := NewMinioObjectStore(NewFakeMinioClient(), "mlpipeline", "pipelines", false).
+		WithNamespaceIsolation(&NamespaceIsolation{
+			Namespaces: map[string]common.ObjectStoreNamespace{
+				"ns1": {BucketName: "ns1-artifacts", CredentialsSecret: "ns1-s3"},
+			},
+			NewClient: func(namespace string, credentialsSecret string) (MinioClientInterface, error) {
+				return nil, errors.New("secret not found")
+			},
+		})
+	_, err := manager.ForNamespace("ns1")
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestNamespaceIsolation_Validate(t *testing.T) {
+	for _, test := range []struct {
+		isolation common.ObjectStoreNamespace
+		valid     bool
+	}{
+		{common.ObjectStoreNamespace{BucketName: "ns1-artifacts", CredentialsSecret: "s3"}, true},
+		{common.ObjectStoreNamespace{Prefix: "tenants/ns1", CredentialsSecret: "s3"}, true},
+		{common.ObjectStoreNamespace{CredentialsSecret: "s3"}, false},
+		{common.ObjectStoreNamespace{Prefix: "tenants/ns1"}, false},
+		{common.ObjectStoreNamespace{Prefix: "/tenants/ns1", CredentialsSecret: "s3"}, false},
+		{common.ObjectStoreNamespace{Prefix: "../ns1", CredentialsSecret: "s3"}, false},
+		{common.ObjectStoreNamespace{Prefix: "tenants/ns1/", CredentialsSecret: "s3"}, false},
+	} {
+		isolation := &NamespaceIsolation{Namespaces: map[string]common.ObjectStoreNamespace{"ns1": test.isolation}}
+		assert.Equal(t, test.valid, isolation.Validate() == nil, "%+v", test.isolation)
+	}
+}
@@ -19,11 +19,11 @@ import (
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 )
 
 // The order of the selected columns must match the order used in scan rows.
@@ -44,6 +44,7 @@ var pipelineColumns = []string{
 	"pipeline_versions.Status",
 	"pipeline_versions.CodeSourceUrl",
 	"pipeline_versions.Description",
+	"pipeline_versions.Warnings",
 }
 
 var pipelineVersionColumns = []string{
@@ -55,6 +56,7 @@ var pipelineVersionColumns = []string{
 	"pipeline_versions.Status",
 	"pipeline_versions.CodeSourceUrl",
 	"pipeline_versions.Description",
+	"pipeline_versions.Warnings",
 }
 
 type PipelineStoreInterface interface {
@@ -62,6 +64,9 @@ type PipelineStoreInterface interface {
 	GetPipeline(pipelineId string) (*model.Pipeline, error)
 	GetPipelineWithStatus(id string, status model.PipelineStatus) (*model.Pipeline, error)
 	DeletePipeline(pipelineId string) error
+	SoftDeletePipeline(pipelineId string, deletedAtInSec int64) error
+	UndeletePipeline(pipelineId string) error
+	ListPipelinesDeletedBefore(deletedBeforeInSec int64) ([]*model.Pipeline, error)
 	CreatePipeline(*model.Pipeline) (*model.Pipeline, error)
 	UpdatePipelineStatus(string, model.PipelineStatus) error
 	UpdatePipelineDefaultVersion(string, string) error
@@ -104,7 +109,7 @@ func (s *PipelineStore) ListPipelines(filterContext *common.FilterContext, opts
 				sq.Eq{"pipelines.Status": model.PipelineReady},
 			)
 		}
-		return query
+		return query.Where(sq.Eq{"pipelines.DeletedAtInSec": 0})
 	}
 
 	sqlBuilder := buildQuery(sq.Select(pipelineColumns...))
@@ -122,10 +127,11 @@ func (s *PipelineStore) ListPipelines(filterContext *common.FilterContext, opts
 		return errorF(err)
 	}
 
-	// Use a transaction to make sure we're returning the total_size of the same rows queried
-	tx, err := s.db.Begin()
+	// Use a transaction to make sure we're returning the total_size of the same rows queried.
+	// The rows may be read from a replica lagging slightly behind.
+	tx, err := s.db.Reader().Begin()
 	if err != nil {
-		glog.Errorf("Failed to start transaction to list pipelines")
+		log.Errorf("Failed to start transaction to list pipelines")
 		return errorF(err)
 	}
 
@@ -155,7 +161,7 @@ func (s *PipelineStore) ListPipelines(filterContext *common.FilterContext, opts
 
 	err = tx.Commit()
 	if err != nil {
-		glog.Errorf("Failed to commit transaction to list pipelines")
+		log.Errorf("Failed to commit transaction to list pipelines")
 		return errorF(err)
 	}
 
@@ -163,7 +169,7 @@ func (s *PipelineStore) ListPipelines(filterContext *common.FilterContext, opts
 		return pipelines, total_size, "", nil
 	}
 
-	npt, err := opts.NextPageToken(pipelines[opts.PageSize])
+	npt, err := opts.NextPageToken(pipelines[opts.PageSize-1])
 	return pipelines[:opts.PageSize], total_size, npt, err
 }
 
@@ -174,7 +180,7 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 		var defaultVersionId, namespace sql.NullString
 		var createdAtInSec int64
 		var status model.PipelineStatus
-		var versionUUID, versionName, versionParameters, versionPipelineId, versionCodeSourceUrl, versionStatus, versionDescription sql.NullString
+		var versionUUID, versionName, versionParameters, versionPipelineId, versionCodeSourceUrl, versionStatus, versionDescription, versionWarnings sql.NullString
 		var versionCreatedAtInSec sql.NullInt64
 		if err := rows.Scan(
 			&uuid,
@@ -192,7 +198,8 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 			&versionPipelineId,
 			&versionStatus,
 			&versionCodeSourceUrl,
-			&versionDescription); err != nil {
+			&versionDescription,
+			&versionWarnings); err != nil {
 			return nil, err
 		}
 		if defaultVersionId.Valid {
@@ -214,6 +221,7 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 					Status:         model.PipelineVersionStatus(versionStatus.String),
 					CodeSourceUrl:  versionCodeSourceUrl.String,
 					Description:    versionDescription.String,
+					Warnings:       versionWarnings.String,
 				}})
 		} else {
 			pipelines = append(pipelines, &model.Pipeline{
@@ -240,7 +248,7 @@ func (s *PipelineStore) GetPipelineWithStatus(id string, status model.PipelineSt
 		Select(pipelineColumns...).
 		From("pipelines").
 		LeftJoin("pipeline_versions on pipelines.DefaultVersionId = pipeline_versions.UUID").
-		Where(sq.And{sq.Eq{"pipelines.uuid": id}, sq.Eq{"pipelines.Status": status}}).
+		Where(sq.And{sq.Eq{"pipelines.uuid": id}, sq.Eq{"pipelines.Status": status}, sq.Eq{"pipelines.DeletedAtInSec": 0}}).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create query to get pipeline: %v", err.Error())
@@ -332,7 +340,8 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 				"Status":         string(newPipeline.DefaultVersion.Status),
 				"PipelineId":     newPipeline.UUID,
 				"Description":    newPipeline.DefaultVersion.Description,
-				"CodeSourceUrl":  newPipeline.DefaultVersion.CodeSourceUrl}).
+				"CodeSourceUrl":  newPipeline.DefaultVersion.CodeSourceUrl,
+				"Warnings":       newPipeline.DefaultVersion.Warnings}).
 		ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err,
@@ -352,7 +361,8 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 		if s.db.IsDuplicateError(err) {
 			tx.Rollback()
 			return nil, util.NewAlreadyExistError(
-				"Failed to create a new pipeline. The name %v already exist. Please specify a new name.", p.Name)
+				"Failed to create a new pipeline. The name %v already exist. Please specify a new name.", p.Name).
+				WithReason(util.ReasonPipelineNameExists)
 		}
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to add pipeline to pipeline table: %v",
@@ -364,7 +374,8 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 			tx.Rollback()
 			return nil, util.NewAlreadyExistError(
 				`Failed to create a new pipeline version. The name %v already
-				exist. Please specify a new name.`, p.DefaultVersion.Name)
+				exist. Please specify a new name.`, p.DefaultVersion.Name).
+				WithReason(util.ReasonPipelineVersionNameExists)
 		}
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err,
@@ -488,7 +499,8 @@ func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion, updatePi
 				"PipelineId":     newPipelineVersion.PipelineId,
 				"Status":         string(newPipelineVersion.Status),
 				"CodeSourceUrl":  newPipelineVersion.CodeSourceUrl,
-				"Description":    newPipelineVersion.Description}).
+				"Description":    newPipelineVersion.Description,
+				"Warnings":       newPipelineVersion.Warnings}).
 		ToSql()
 	if versionErr != nil {
 		return nil, util.NewInternalServerError(
@@ -522,7 +534,8 @@ func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion, updatePi
 		tx.Rollback()
 		if s.db.IsDuplicateError(err) {
 			return nil, util.NewAlreadyExistError(
-				"Failed to create a new pipeline version. The name %v already exist. Please specify a new name.", v.Name)
+				"Failed to create a new pipeline version. The name %v already exist. Please specify a new name.", v.Name).
+				WithReason(util.ReasonPipelineVersionNameExists)
 		}
 		return nil, util.NewInternalServerError(err, "Failed to add version to pipeline version table: %v",
 			err.Error())
@@ -595,7 +608,7 @@ func (s *PipelineStore) GetPipelineVersionWithStatus(versionId string, status mo
 func (s *PipelineStore) scanPipelineVersionRows(rows *sql.Rows) ([]*model.PipelineVersion, error) {
 	var pipelineVersions []*model.PipelineVersion
 	for rows.Next() {
-		var uuid, name, parameters, pipelineId, codeSourceUrl, status, description sql.NullString
+		var uuid, name, parameters, pipelineId, codeSourceUrl, status, description, warnings sql.NullString
 		var createdAtInSec sql.NullInt64
 		if err := rows.Scan(
 			&uuid,
@@ -606,6 +619,7 @@ func (s *PipelineStore) scanPipelineVersionRows(rows *sql.Rows) ([]*model.Pipeli
 			&status,
 			&codeSourceUrl,
 			&description,
+			&warnings,
 		); err != nil {
 			return nil, err
 		}
@@ -618,7 +632,8 @@ func (s *PipelineStore) scanPipelineVersionRows(rows *sql.Rows) ([]*model.Pipeli
 				PipelineId:     pipelineId.String,
 				CodeSourceUrl:  codeSourceUrl.String,
 				Status:         model.PipelineVersionStatus(status.String),
-				Description:    description.String})
+				Description:    description.String,
+				Warnings:       warnings.String})
 		}
 	}
 	return pipelineVersions, nil
@@ -652,7 +667,7 @@ func (s *PipelineStore) ListPipelineVersions(pipelineId string, opts *list.Optio
 	// rows queried.
 	tx, err := s.db.Begin()
 	if err != nil {
-		glog.Errorf("Failed to start transaction to list pipelines")
+		log.Errorf("Failed to start transaction to list pipelines")
 		return errorF(err)
 	}
 
@@ -682,7 +697,7 @@ func (s *PipelineStore) ListPipelineVersions(pipelineId string, opts *list.Optio
 
 	err = tx.Commit()
 	if err != nil {
-		glog.Errorf("Failed to commit transaction to list pipelines")
+		log.Errorf("Failed to commit transaction to list pipelines")
 		return errorF(err)
 	}
 
@@ -690,7 +705,7 @@ func (s *PipelineStore) ListPipelineVersions(pipelineId string, opts *list.Optio
 		return pipelineVersions, total_size, "", nil
 	}
 
-	npt, err := opts.NextPageToken(pipelineVersions[opts.PageSize])
+	npt, err := opts.NextPageToken(pipelineVersions[opts.PageSize-1])
 	return pipelineVersions[:opts.PageSize], total_size, npt, err
 }
 
@@ -821,3 +836,46 @@ func (s *PipelineStore) DeletePipelineVersion(versionId string) error {
 func (s *PipelineStore) SetUUIDGenerator(new_uuid util.UUIDGeneratorInterface) {
 	s.uuid = new_uuid
 }
+
+// SoftDeletePipeline hides the pipeline, and its versions, until it's restored or
+// purged.
+func (s *PipelineStore) SoftDeletePipeline(id string, deletedAtInSec int64) error {
+	found, err := setDeletedAt(s.db, "pipelines", id, deletedAtInSec)
+	if err == nil && !found {
+		return util.NewResourceNotFoundError("Pipeline", id)
+	}
+	return err
+}
+
+// UndeletePipeline restores the soft deleted pipeline.
+func (s *PipelineStore) UndeletePipeline(id string) error {
+	found, err := setDeletedAt(s.db, "pipelines", id, 0)
+	if err == nil && !found {
+		return util.NewResourceNotFoundError("Deleted pipeline", id)
+	}
+	return err
+}
+
+// ListPipelinesDeletedBefore returns the pipelines soft deleted before the time,
+// to be purged.
+func (s *PipelineStore) ListPipelinesDeletedBefore(deletedBeforeInSec int64) ([]*model.Pipeline, error) {
+	sql, args, err := sq.
+		Select(pipelineColumns...).
+		From("pipelines").
+		LeftJoin("pipeline_versions on pipelines.DefaultVersionId = pipeline_versions.UUID").
+		Where(sq.And{sq.Gt{"pipelines.DeletedAtInSec": 0}, sq.Lt{"pipelines.DeletedAtInSec": deletedBeforeInSec}}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list deleted pipelines: %v", err.Error())
+	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deleted pipelines: %v", err.Error())
+	}
+	defer r.Close()
+	pipelines, err := s.scanRows(r)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deleted pipelines: %v", err.Error())
+	}
+	return pipelines, nil
+}
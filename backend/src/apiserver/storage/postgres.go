@@ -0,0 +1,424 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// PostgreSQLDriverName is the name of the database/sql driver for PostgreSQL. It
+// wraps lib/pq to rebind the `?` placeholders the stores use to `$1`, `$2`...
+// and to measure the duration of the queries.
+const PostgreSQLDriverName = "kfp-postgres"
+
+// pgUniqueViolation is the SQLSTATE of a unique constraint violation.
+const pgUniqueViolation = "23505"
+
+func init() {
+	sql.Register(PostgreSQLDriverName, instrumentedDriver{postgresDriver{&pq.Driver{}}})
+}
+
+type postgresDriver struct {
+	driver.Driver
+}
+
+func (d postgresDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return postgresConn{conn}, nil
+}
+
+// postgresConn rebinds the queries before passing them to the lib/pq connection.
+type postgresConn struct {
+	driver.Conn
+}
+
+func (c postgresConn) Prepare(query string) (driver.Stmt, error) {
+	return c.Conn.Prepare(rebindPostgreSQL(query))
+}
+
+func (c postgresConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, rebindPostgreSQL(query))
+	}
+	return c.Prepare(query)
+}
+
+func (c postgresConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	return execer.ExecContext(ctx, rebindPostgreSQL(query), args)
+}
+
+func (c postgresConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	return queryer.QueryContext(ctx, rebindPostgreSQL(query), args)
+}
+
+func (c postgresConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c postgresConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// rebindPostgreSQL replaces the `?` placeholders outside of quoted strings and
+// identifiers with the numbered placeholders PostgreSQL expects.
+func rebindPostgreSQL(query string) string {
+	if !strings.Contains(query, "?") {
+		return query
+	}
+	var buffer strings.Builder
+	var quote rune
+	n := 0
+	for _, c := range query {
+		switch {
+		case quote != 0:
+			// A doubled quote is read as two quoted strings, which leaves the state unchanged.
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			n++
+			buffer.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		buffer.WriteRune(c)
+	}
+	return buffer.String()
+}
+
+// postgreSQLSchema creates the tables of the first schema version. The identifiers
+// aren't quoted, so that they are folded to lower case like in the queries of the
+// stores.
+var postgreSQLSchema = []string{
+	`CREATE TABLE IF NOT EXISTS experiments (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		Name varchar(255) NOT NULL,
+		Description varchar(255) NOT NULL,
+		CreatedAtInSec bigint NOT NULL,
+		Namespace varchar(255) NOT NULL,
+		StorageState varchar(255) NOT NULL
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_name_namespace ON experiments (Name, Namespace)`,
+	`CREATE TABLE IF NOT EXISTS jobs (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		DisplayName varchar(255) NOT NULL,
+		Name varchar(255) NOT NULL,
+		Namespace varchar(255) NOT NULL,
+		ServiceAccount varchar(255) NOT NULL,
+		Description varchar(255) NOT NULL,
+		MaxConcurrency bigint NOT NULL,
+		NoCatchup boolean NOT NULL,
+		CreatedAtInSec bigint NOT NULL,
+		UpdatedAtInSec bigint NOT NULL,
+		Enabled boolean NOT NULL,
+		CronScheduleStartTimeInSec bigint,
+		CronScheduleEndTimeInSec bigint,
+		Schedule varchar(255),
+		PeriodicScheduleStartTimeInSec bigint,
+		PeriodicScheduleEndTimeInSec bigint,
+		IntervalSecond bigint,
+		PipelineId varchar(255) NOT NULL,
+		PipelineName varchar(255) NOT NULL,
+		PipelineSpecManifest text,
+		WorkflowSpecManifest text NOT NULL,
+		Parameters text,
+		Conditions varchar(255) NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS pipelines (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		CreatedAtInSec bigint NOT NULL,
+		Name varchar(255) NOT NULL,
+		Description text NOT NULL,
+		Parameters text NOT NULL,
+		Status varchar(255) NOT NULL,
+		DefaultVersionId varchar(255),
+		Namespace varchar(63) DEFAULT ''
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS name_namespace_index ON pipelines (Name, Namespace)`,
+	`CREATE TABLE IF NOT EXISTS pipeline_versions (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		CreatedAtInSec bigint NOT NULL,
+		Name varchar(255) NOT NULL,
+		Parameters text NOT NULL,
+		PipelineId varchar(255) NOT NULL REFERENCES pipelines (UUID) ON DELETE CASCADE ON UPDATE CASCADE,
+		Status varchar(255) NOT NULL,
+		CodeSourceUrl varchar(255),
+		Description text NOT NULL,
+		Warnings text NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_pipeline_versions_CreatedAtInSec ON pipeline_versions (CreatedAtInSec)`,
+	`CREATE INDEX IF NOT EXISTS idx_pipeline_versions_PipelineId ON pipeline_versions (PipelineId)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_pipelineid_name ON pipeline_versions (Name, PipelineId)`,
+	`CREATE TABLE IF NOT EXISTS resource_references (
+		ResourceUUID varchar(255) NOT NULL,
+		ResourceType varchar(255) NOT NULL,
+		ReferenceUUID varchar(255) NOT NULL,
+		ReferenceName varchar(255) NOT NULL,
+		ReferenceType varchar(255) NOT NULL,
+		Relationship varchar(255) NOT NULL,
+		Payload text NOT NULL,
+		PRIMARY KEY (ResourceUUID, ResourceType, ReferenceType)
+	)`,
+	`CREATE INDEX IF NOT EXISTS referencefilter ON resource_references (ResourceType, ReferenceUUID, ReferenceType)`,
+	`CREATE TABLE IF NOT EXISTS run_details (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		ExperimentUUID varchar(255) NOT NULL,
+		DisplayName varchar(255) NOT NULL,
+		Name varchar(255) NOT NULL,
+		StorageState varchar(255) NOT NULL,
+		Namespace varchar(255) NOT NULL,
+		ServiceAccount varchar(255) NOT NULL,
+		Description varchar(255) NOT NULL,
+		CreatedAtInSec bigint NOT NULL,
+		ScheduledAtInSec bigint DEFAULT 0,
+		FinishedAtInSec bigint DEFAULT 0,
+		Conditions varchar(255) NOT NULL,
+		PipelineId varchar(255) NOT NULL,
+		PipelineName varchar(255) NOT NULL,
+		PipelineSpecManifest text,
+		WorkflowSpecManifest text NOT NULL,
+		Parameters text,
+		PipelineRuntimeManifest text NOT NULL,
+		WorkflowRuntimeManifest text NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS experimentuuid_createatinsec ON run_details (ExperimentUUID, CreatedAtInSec)`,
+	`CREATE INDEX IF NOT EXISTS experimentuuid_conditions_finishedatinsec ON run_details (ExperimentUUID, Conditions, FinishedAtInSec)`,
+	`CREATE TABLE IF NOT EXISTS run_metrics (
+		RunUUID varchar(255) NOT NULL REFERENCES run_details (UUID) ON DELETE CASCADE ON UPDATE CASCADE,
+		NodeID varchar(255) NOT NULL,
+		Name varchar(255) NOT NULL,
+		NumberValue double precision,
+		Format varchar(255),
+		Payload text NOT NULL,
+		PRIMARY KEY (RunUUID, NodeID, Name)
+	)`,
+	`CREATE TABLE IF NOT EXISTS tasks (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		Namespace varchar(255) NOT NULL,
+		PipelineName varchar(255) NOT NULL,
+		RunUUID varchar(255) NOT NULL REFERENCES run_details (UUID) ON DELETE CASCADE ON UPDATE CASCADE,
+		MLMDExecutionID varchar(255) NOT NULL,
+		CreatedTimestamp bigint NOT NULL,
+		FinishedTimestamp bigint,
+		Fingerprint varchar(255) NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS db_statuses (
+		HaveSamplesLoaded boolean NOT NULL PRIMARY KEY
+	)`,
+	`CREATE TABLE IF NOT EXISTS default_experiments (
+		DefaultExperimentId varchar(255) NOT NULL PRIMARY KEY
+	)`,
+	`CREATE TABLE IF NOT EXISTS audit_events (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		CreatedAtInSec bigint NOT NULL,
+		Actor varchar(255) NOT NULL,
+		Namespace varchar(255) NOT NULL,
+		Method varchar(255) NOT NULL,
+		ResourceId varchar(255) NOT NULL,
+		ResourceBefore text,
+		ResourceAfter text,
+		Result varchar(255) NOT NULL,
+		Error text
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_audit_events_CreatedAtInSec ON audit_events (CreatedAtInSec)`,
+	`CREATE TABLE IF NOT EXISTS leases (
+		Name varchar(255) NOT NULL PRIMARY KEY,
+		Holder varchar(255) NOT NULL,
+		ExpiresAtInSec bigint NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS artifact_blobs (
+		BlobKey varchar(255) NOT NULL PRIMARY KEY,
+		Size bigint NOT NULL,
+		RefCount bigint NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS artifact_references (
+		ObjectKey varchar(255) NOT NULL PRIMARY KEY,
+		BlobKey varchar(255) NOT NULL,
+		RunUUID varchar(255) NOT NULL,
+		Size bigint NOT NULL,
+		CreatedAtInSec bigint NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_references_BlobKey ON artifact_references (BlobKey)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_references_RunUUID ON artifact_references (RunUUID)`,
+	`CREATE TABLE IF NOT EXISTS upload_sessions (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		Namespace varchar(255) NOT NULL,
+		RunUUID varchar(255) NOT NULL,
+		FileName varchar(255) NOT NULL,
+		Size bigint NOT NULL,
+		UploadedSize bigint NOT NULL,
+		CreatedAtInSec bigint NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_upload_sessions_CreatedAtInSec ON upload_sessions (CreatedAtInSec)`,
+	`CREATE TABLE IF NOT EXISTS artifact_metadata (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		Namespace varchar(255) NOT NULL,
+		RunUUID varchar(255) NOT NULL,
+		TaskName varchar(255) NOT NULL,
+		Name varchar(255) NOT NULL,
+		Type varchar(255) NOT NULL,
+		Size bigint NOT NULL,
+		ObjectKey varchar(255) NOT NULL,
+		CustomProperties text,
+		CreatedAtInSec bigint NOT NULL,
+		OCIReference text
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_metadata_Namespace ON artifact_metadata (Namespace)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_metadata_RunUUID ON artifact_metadata (RunUUID)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_metadata_Name ON artifact_metadata (Name)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_metadata_Type ON artifact_metadata (Type)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_metadata_Size ON artifact_metadata (Size)`,
+	`CREATE INDEX IF NOT EXISTS idx_artifact_metadata_CreatedAtInSec ON artifact_metadata (CreatedAtInSec)`,
+}
+
+// postgreSQLRunSubmissionSchema creates the outbox of the runs to submit.
+var postgreSQLRunSubmissionSchema = []string{
+	`CREATE TABLE IF NOT EXISTS run_submissions (
+		RunUUID varchar(255) NOT NULL PRIMARY KEY,
+		Namespace varchar(255) NOT NULL,
+		Workflow text NOT NULL,
+		CreatedAtInSec bigint NOT NULL,
+		Attempts bigint NOT NULL DEFAULT 0,
+		LastError text NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS runsubmissions_createdatinsec ON run_submissions (CreatedAtInSec)`,
+}
+
+// postgreSQLWebhookSchema creates the webhook subscriptions and their deliveries.
+var postgreSQLWebhookSchema = []string{
+	`CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		Name varchar(255) NOT NULL,
+		Namespace varchar(255) NOT NULL,
+		URL varchar(2048) NOT NULL,
+		Secret text NOT NULL,
+		EventTypes text NOT NULL,
+		ExperimentID varchar(255) NOT NULL,
+		Enabled boolean NOT NULL,
+		CreatedAtInSec bigint NOT NULL,
+		UpdatedAtInSec bigint NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS webhooksubscriptions_namespace ON webhook_subscriptions (Namespace)`,
+	`CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		SubscriptionUUID varchar(255) NOT NULL,
+		EventID varchar(255) NOT NULL,
+		EventType varchar(255) NOT NULL,
+		Payload text NOT NULL,
+		Status varchar(255) NOT NULL,
+		Attempts bigint NOT NULL DEFAULT 0,
+		ResponseCode bigint NOT NULL DEFAULT 0,
+		LastError text NOT NULL,
+		NextAttemptAtInSec bigint NOT NULL,
+		CreatedAtInSec bigint NOT NULL,
+		DeliveredAtInSec bigint NOT NULL DEFAULT 0
+	)`,
+	`CREATE INDEX IF NOT EXISTS webhookdeliveries_subscriptionuuid ON webhook_deliveries (SubscriptionUUID)`,
+	`CREATE INDEX IF NOT EXISTS webhookdeliveries_nextattemptatinsec ON webhook_deliveries (NextAttemptAtInSec)`,
+	`CREATE INDEX IF NOT EXISTS webhookdeliveries_createdatinsec ON webhook_deliveries (CreatedAtInSec)`,
+}
+
+// postgreSQLNamespaceDefaultExperimentSchema creates the default experiments of
+// the namespaces.
+var postgreSQLNamespaceDefaultExperimentSchema = []string{
+	`CREATE TABLE IF NOT EXISTS namespace_default_experiments (
+		Namespace varchar(255) NOT NULL PRIMARY KEY,
+		ExperimentId varchar(255) NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS namespacedefaultexperiments_experimentid ON namespace_default_experiments (ExperimentId)`,
+}
+
+// postgreSQLExperimentDefaultsSchema creates the defaults of the runs and jobs
+// of the experiments.
+var postgreSQLExperimentDefaultsSchema = []string{
+	`CREATE TABLE IF NOT EXISTS experiment_defaults (
+		ExperimentUUID varchar(255) NOT NULL PRIMARY KEY,
+		Parameters text NOT NULL,
+		ServiceAccount varchar(255) NOT NULL,
+		PodTemplate text NOT NULL,
+		UpdatedAtInSec bigint NOT NULL
+	)`,
+}
+
+// postgreSQLExperimentBudgetSchema creates the budgets of the experiments.
+var postgreSQLExperimentBudgetSchema = []string{
+	`CREATE TABLE IF NOT EXISTS experiment_budgets (
+		ExperimentUUID varchar(255) NOT NULL PRIMARY KEY,
+		MaxRuns bigint NOT NULL,
+		MaxTaskSeconds bigint NOT NULL,
+		Enforcement varchar(255) NOT NULL,
+		UpdatedAtInSec bigint NOT NULL
+	)`,
+}
+
+// postgreSQLRunArchiveSchema creates the tables the old runs are moved to.
+var postgreSQLRunArchiveSchema = []string{
+	`CREATE TABLE IF NOT EXISTS run_details_archive (
+		UUID varchar(255) NOT NULL PRIMARY KEY,
+		ExperimentUUID varchar(255) NOT NULL,
+		DisplayName varchar(255) NOT NULL,
+		Name varchar(255) NOT NULL,
+		StorageState varchar(255) NOT NULL,
+		Namespace varchar(255) NOT NULL,
+		ServiceAccount varchar(255) NOT NULL,
+		Description varchar(255) NOT NULL,
+		CreatedAtInSec bigint NOT NULL,
+		ScheduledAtInSec bigint DEFAULT 0,
+		FinishedAtInSec bigint DEFAULT 0,
+		Conditions varchar(255) NOT NULL,
+		PipelineId varchar(255) NOT NULL,
+		PipelineName varchar(255) NOT NULL,
+		PipelineSpecManifest text,
+		WorkflowSpecManifest text NOT NULL,
+		Parameters text,
+		PipelineRuntimeManifest text NOT NULL,
+		WorkflowRuntimeManifest text NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS archive_experimentuuid_createatinsec ON run_details_archive (ExperimentUUID, CreatedAtInSec)`,
+	`CREATE INDEX IF NOT EXISTS archive_createatinsec ON run_details_archive (CreatedAtInSec)`,
+	`CREATE TABLE IF NOT EXISTS run_metrics_archive (
+		RunUUID varchar(255) NOT NULL,
+		NodeID varchar(255) NOT NULL,
+		Name varchar(255) NOT NULL,
+		NumberValue double precision,
+		Format varchar(255),
+		Payload text NOT NULL,
+		PRIMARY KEY (RunUUID, NodeID, Name)
+	)`,
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRebindPostgreSQL(t *testing.T) {
+	assert.Equal(t, "SELECT * FROM runs", rebindPostgreSQL("SELECT * FROM runs"))
+	assert.Equal(t,
+		"SELECT * FROM runs WHERE UUID = $1 AND Name IN ($2,$3)",
+		rebindPostgreSQL("SELECT * FROM runs WHERE UUID = ? AND Name IN (?,?)"))
+	assert.Equal(t,
+		`SELECT '?', 'it''s ?', "?" FROM runs WHERE UUID = $1`,
+		rebindPostgreSQL(`SELECT '?', 'it''s ?', "?" FROM runs WHERE UUID = ?`))
+}
+
+// newPostgreSQLDb connects to the database of KFP_POSTGRES_DSN, which is set in CI,
+// and recreates its tables with the migrations.
+func newPostgreSQLDb(t *testing.T) *DB {
+	dsn := os.Getenv("KFP_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("KFP_POSTGRES_DSN is not set")
+	}
+	db, err := sql.Open(PostgreSQLDriverName, dsn)
+	assert.Nil(t, err)
+	_, err = db.Exec("DROP SCHEMA public CASCADE; CREATE SCHEMA public")
+	assert.Nil(t, err)
+	postgresDB := NewDB(db, NewPostgreSQLDialect())
+	migrator := NewMigrator(postgresDB, Migrations, util.NewFakeTimeForEpoch())
+	// Every migration can be reverted and applied again.
+	assert.Nil(t, migrator.MigrateToLatest())
+	assert.Nil(t, migrator.MigrateTo(0))
+	assert.Nil(t, migrator.MigrateToLatest())
+	return postgresDB
+}
+
+func TestPostgreSQL_Experiments(t *testing.T) {
+	db := newPostgreSQLDb(t)
+	defer db.Close()
+	store := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeExpId, nil))
+
+	_, err := store.CreateExperiment(&model.Experiment{Name: "exp1", Namespace: "n1"})
+	assert.Nil(t, err)
+	store = NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeExpIdTwo, nil))
+	_, err = store.CreateExperiment(&model.Experiment{Name: "exp1", Namespace: "n1"})
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+
+	experiment, err := store.GetExperiment(defaultFakeExpId)
+	assert.Nil(t, err)
+	assert.Equal(t, "exp1", experiment.Name)
+}
+
+func TestPostgreSQL_ListRuns(t *testing.T) {
+	db := newPostgreSQLDb(t)
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	for _, id := range []string{"1", "2"} {
+		_, err := runStore.CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:           id,
+				ExperimentUUID: defaultFakeExpId,
+				Name:           "run" + id,
+				DisplayName:    "run" + id,
+				StorageState:   "STORAGESTATE_AVAILABLE",
+				Namespace:      "n1",
+				Conditions:     "done",
+				ResourceReferences: []*model.ResourceReference{{
+					ResourceUUID: id, ResourceType: common.Run,
+					ReferenceUUID: defaultFakeExpId, ReferenceName: "e1",
+					ReferenceType: common.Experiment, Relationship: common.Creator,
+				}},
+			},
+			PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: "workflow" + id},
+		})
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, runStore.ReportMetric(&model.RunMetric{RunUUID: "1", NodeID: "node1", Name: "accuracy", NumberValue: 2}))
+	assert.Nil(t, runStore.ReportMetric(&model.RunMetric{RunUUID: "2", NodeID: "node1", Name: "accuracy", NumberValue: 1}))
+
+	opts, err := list.NewOptions(&model.Run{}, 10, "metric:accuracy", nil)
+	assert.Nil(t, err)
+	runs, totalSize, _, err := runStore.ListRuns(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}}, opts)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Equal(t, "2", runs[0].UUID)
+	assert.Equal(t, "1", runs[1].UUID)
+	assert.Len(t, runs[0].Metrics, 1)
+	assert.Len(t, runs[0].ResourceReferences, 1)
+}
+
+func TestPostgreSQL_ListJobs(t *testing.T) {
+	db := newPostgreSQLDb(t)
+	defer db.Close()
+	jobStore := NewJobStore(db, util.NewFakeTimeForEpoch())
+	_, err := jobStore.CreateJob(&model.Job{
+		UUID:        "1",
+		DisplayName: "pp 1",
+		Name:        "pp1",
+		Namespace:   "n1",
+		Enabled:     true,
+		Conditions:  "ready",
+		Trigger: model.Trigger{
+			PeriodicSchedule: model.PeriodicSchedule{IntervalSecond: util.Int64Pointer(3)},
+		},
+		ResourceReferences: []*model.ResourceReference{{
+			ResourceUUID: "1", ResourceType: common.Job, ReferenceUUID: defaultFakeExpId,
+			ReferenceName: "e1", ReferenceType: common.Experiment, Relationship: common.Owner,
+		}},
+	})
+	assert.Nil(t, err)
+
+	opts, err := list.NewOptions(&model.Job{}, 10, "", nil)
+	assert.Nil(t, err)
+	jobs, totalSize, _, err := jobStore.ListJobs(&common.FilterContext{}, opts)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.True(t, jobs[0].Enabled)
+	assert.Equal(t, util.Int64Pointer(3), jobs[0].IntervalSecond)
+	assert.Len(t, jobs[0].ResourceReferences, 1)
+}
@@ -0,0 +1,291 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/encrypt"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	errObjectStoreTimeout = errors.New("object store request timed out")
+	errCircuitOpen        = errors.New("object store circuit breaker is open")
+)
+
+// Resilience configures how the object store client copes with an object store
+// that is slow or failing.
+type Resilience struct {
+	// Timeout bounds every request to the object store. Zero disables it.
+	Timeout time.Duration
+	// MaxRetries is how many times a request failing with a transient error is
+	// retried.
+	MaxRetries int
+	// RetryInterval is the interval before the first retry, which grows
+	// exponentially with the following ones.
+	RetryInterval time.Duration
+	// FailureThreshold is how many consecutive requests failing with a transient
+	// error open the circuit, failing the requests without sending them.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before a request is sent
+	// to probe the object store.
+	OpenDuration time.Duration
+}
+
+func (r *Resilience) Validate() error {
+	if r.Timeout < 0 {
+		return util.NewInvalidInputError("the timeout must not be negative, got %v", r.Timeout)
+	}
+	if r.MaxRetries < 0 {
+		return util.NewInvalidInputError("the max retries must not be negative, got %v", r.MaxRetries)
+	}
+	if r.MaxRetries > 0 && r.RetryInterval <= 0 {
+		return util.NewInvalidInputError("the retry interval must be positive, got %v", r.RetryInterval)
+	}
+	if r.FailureThreshold < 0 {
+		return util.NewInvalidInputError("the failure threshold must not be negative, got %v", r.FailureThreshold)
+	}
+	if r.FailureThreshold > 0 && r.OpenDuration <= 0 {
+		return util.NewInvalidInputError("the open duration must be positive, got %v", r.OpenDuration)
+	}
+	return nil
+}
+
+// circuitBreaker stops sending requests to the object store after consecutive
+// failures, and lets a single request probe it once the open duration elapsed.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	duration  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.threshold <= 0 || b.failures < b.threshold {
+		return true
+	}
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *circuitBreaker) record(now time.Time, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.threshold > 0 && b.failures >= b.threshold {
+		if b.failures == b.threshold {
+			log.Warningf("The object store failed %v consecutive requests, failing the requests for %v", b.failures, b.duration)
+		}
+		b.openUntil = now.Add(b.duration)
+	}
+}
+
+// ResilientMinioClient bounds the requests of a minio client with a timeout,
+// retries the ones failing with a transient error, and fails them fast while
+// the object store is unavailable.
+type ResilientMinioClient struct {
+	client     MinioClientInterface
+	resilience Resilience
+	breaker    *circuitBreaker
+	now        func() time.Time
+	sleep      func(time.Duration)
+}
+
+func NewResilientMinioClient(client MinioClientInterface, resilience *Resilience) *ResilientMinioClient {
+	return &ResilientMinioClient{
+		client:     client,
+		resilience: *resilience,
+		breaker:    &circuitBreaker{threshold: resilience.FailureThreshold, duration: resilience.OpenDuration},
+		now:        time.Now,
+		sleep:      time.Sleep,
+	}
+}
+
+// readerAtWithSize is implemented by the readers that can be read again from the
+// start, such as bytes.Reader, which lets the uploads be retried.
+type readerAtWithSize interface {
+	io.ReaderAt
+	Size() int64
+}
+
+func (c *ResilientMinioClient) PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (int64, error) {
+	readerAt, retryable := reader.(readerAtWithSize)
+	result, err := c.do(retryable, func() (interface{}, error) {
+		attemptReader := reader
+		if retryable {
+			// A timed out attempt may still be reading, so each attempt reads
+			// through its own section of the content.
+			attemptReader = io.NewSectionReader(readerAt, 0, readerAt.Size())
+		}
+		return c.client.PutObject(bucketName, objectName, attemptReader, objectSize, opts)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.(int64), nil
+}
+
+func (c *ResilientMinioClient) GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error) {
+	result, err := c.do(true, func() (interface{}, error) {
+		return c.client.GetObject(bucketName, objectName, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(io.Reader), nil
+}
+
+func (c *ResilientMinioClient) DeleteObject(bucketName, objectName string) error {
+	_, err := c.do(true, func() (interface{}, error) {
+		return nil, c.client.DeleteObject(bucketName, objectName)
+	})
+	return err
+}
+
+func (c *ResilientMinioClient) CopyObject(bucketName, srcObjectName, dstObjectName string, sse encrypt.ServerSide) error {
+	_, err := c.do(true, func() (interface{}, error) {
+		return nil, c.client.CopyObject(bucketName, srcObjectName, dstObjectName, sse)
+	})
+	return err
+}
+
+func (c *ResilientMinioClient) ComposeObject(bucketName string, srcObjectNames []string, dstObjectName string, sse encrypt.ServerSide) error {
+	_, err := c.do(true, func() (interface{}, error) {
+		return nil, c.client.ComposeObject(bucketName, srcObjectNames, dstObjectName, sse)
+	})
+	return err
+}
+
+func (c *ResilientMinioClient) ListObjects(bucketName, prefix string) ([]ObjectInfo, error) {
+	result, err := c.do(true, func() (interface{}, error) {
+		return c.client.ListObjects(bucketName, prefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]ObjectInfo), nil
+}
+
+func (c *ResilientMinioClient) PresignedGetObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	result, err := c.do(true, func() (interface{}, error) {
+		return c.client.PresignedGetObject(bucketName, objectName, expiry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*url.URL), nil
+}
+
+func (c *ResilientMinioClient) PresignedPutObject(bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	result, err := c.do(true, func() (interface{}, error) {
+		return c.client.PresignedPutObject(bucketName, objectName, expiry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*url.URL), nil
+}
+
+// do sends a request through the circuit breaker, retrying it while it fails
+// with a transient error. A request that still fails with one is reported as an
+// unavailable artifact store.
+func (c *ResilientMinioClient) do(retryable bool, request func() (interface{}, error)) (interface{}, error) {
+	if !c.breaker.allow(c.now()) {
+		return nil, util.NewUnavailableError(errCircuitOpen, "The artifact store is unavailable")
+	}
+	retries := backoff.NewExponentialBackOff()
+	retries.InitialInterval = c.resilience.RetryInterval
+	retries.MaxElapsedTime = 0
+	var result interface{}
+	var err error
+	for attempt := 0; ; attempt++ {
+		result, err = c.doWithTimeout(request)
+		if err == nil || !isTransientError(err) || !retryable || attempt >= c.resilience.MaxRetries {
+			break
+		}
+		log.Debugf("Retrying the object store request after a transient error: %v", err)
+		c.sleep(retries.NextBackOff())
+	}
+	transient := err != nil && isTransientError(err)
+	c.breaker.record(c.now(), transient)
+	if transient {
+		return nil, util.NewUnavailableError(err, "The artifact store is unavailable")
+	}
+	return result, err
+}
+
+// doWithTimeout returns once the request completes or times out. A timed out
+// request is abandoned, since the minio client requests can't be cancelled.
+func (c *ResilientMinioClient) doWithTimeout(request func() (interface{}, error)) (interface{}, error) {
+	if c.resilience.Timeout <= 0 {
+		return request()
+	}
+	type response struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan response, 1)
+	go func() {
+		result, err := request()
+		done <- response{result: result, err: err}
+	}()
+	timer := time.NewTimer(c.resilience.Timeout)
+	defer timer.Stop()
+	select {
+	case response := <-done:
+		return response.result, response.err
+	case <-timer.C:
+		return nil, errObjectStoreTimeout
+	}
+}
+
+// isTransientError reports whether a request may succeed if it's sent again.
+func isTransientError(err error) bool {
+	if err == errObjectStoreTimeout || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+	switch response := minio.ToErrorResponse(err); response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return response.Code == "SlowDown" || response.Code == "RequestTimeout"
+	}
+}
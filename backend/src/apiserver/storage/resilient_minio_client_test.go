@@ -0,0 +1,169 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// flakyMinioClient fails the first requests, then delegates to a fake client.
+type flakyMinioClient struct {
+	*FakeMinioClient
+	failures []error
+	requests int
+	delay    time.Duration
+}
+
+func (c *flakyMinioClient) fail() error {
+	c.requests++
+	time.Sleep(c.delay)
+	if len(c.failures) == 0 {
+		return nil
+	}
+	err := c.failures[0]
+	c.failures = c.failures[1:]
+	return err
+}
+
+func (c *flakyMinioClient) PutObject(bucketName, objectName string, reader io.Reader,
+	objectSize int64, opts minio.PutObjectOptions) (int64, error) {
+	if err := c.fail(); err != nil {
+		// Consume part of the content, as a failed upload does.
+		reader.Read(make([]byte, 1))
+		return 0, err
+	}
+	return c.FakeMinioClient.PutObject(bucketName, objectName, reader, objectSize, opts)
+}
+
+func (c *flakyMinioClient) GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error) {
+	if err := c.fail(); err != nil {
+		return nil, err
+	}
+	return c.FakeMinioClient.GetObject(bucketName, objectName, opts)
+}
+
+func unavailableResponse() error {
+	return minio.ErrorResponse{StatusCode: http.StatusServiceUnavailable, Code: "ServiceUnavailable"}
+}
+
+func newTestResilientMinioClient(client MinioClientInterface, resilience Resilience) (*ResilientMinioClient, *time.Time) {
+	now := time.Unix(1, 0)
+	resilientClient := NewResilientMinioClient(client, &resilience)
+	resilientClient.now = func() time.Time { return now }
+	resilientClient.sleep = func(time.Duration) {}
+	return resilientClient, &now
+}
+
+func TestResilience_Validate(t *testing.T) {
+	assert.Nil(t, (&Resilience{}).Validate())
+	assert.Nil(t, (&Resilience{
+		Timeout: time.Second, MaxRetries: 3, RetryInterval: time.Millisecond, FailureThreshold: 5, OpenDuration: time.Second,
+	}).Validate())
+	assert.NotNil(t, (&Resilience{Timeout: -time.Second}).Validate())
+	assert.NotNil(t, (&Resilience{MaxRetries: 3}).Validate())
+	assert.NotNil(t, (&Resilience{FailureThreshold: 5}).Validate())
+}
+
+func TestResilientMinioClient_RetriesTransientErrors(t *testing.T) {
+	flaky := &flakyMinioClient{FakeMinioClient: NewFakeMinioClient(), failures: []error{unavailableResponse(), unavailableResponse()}}
+	client, _ := newTestResilientMinioClient(flaky, Resilience{MaxRetries: 2, RetryInterval: time.Millisecond})
+
+	_, err := client.PutObject("bucket", "object", bytes.NewReader([]byte("content")), 7, minio.PutObjectOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, flaky.requests)
+	// Each attempt reads the content from the start.
+	assert.Equal(t, []byte("content"), flaky.minioClient["object"])
+}
+
+func TestResilientMinioClient_DoesNotRetryOtherErrors(t *testing.T) {
+	flaky := &flakyMinioClient{FakeMinioClient: NewFakeMinioClient(), failures: []error{errors.New("access denied")}}
+	client, _ := newTestResilientMinioClient(flaky, Resilience{MaxRetries: 2, RetryInterval: time.Millisecond})
+
+	_, err := client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.EqualError(t, err, "access denied")
+	assert.Equal(t, 1, flaky.requests)
+}
+
+func TestResilientMinioClient_ReportsUnavailableStore(t *testing.T) {
+	flaky := &flakyMinioClient{FakeMinioClient: NewFakeMinioClient(), failures: []error{unavailableResponse(), unavailableResponse()}}
+	client, _ := newTestResilientMinioClient(flaky, Resilience{MaxRetries: 1, RetryInterval: time.Millisecond})
+
+	_, err := client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.Unavailable, err.(*util.UserError).ExternalStatusCode())
+	assert.Equal(t, 2, flaky.requests)
+}
+
+func TestResilientMinioClient_Timeout(t *testing.T) {
+	flaky := &flakyMinioClient{FakeMinioClient: NewFakeMinioClient(), delay: 100 * time.Millisecond}
+	client, _ := newTestResilientMinioClient(flaky, Resilience{Timeout: 10 * time.Millisecond})
+
+	_, err := client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.Unavailable, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestResilientMinioClient_CircuitBreaker(t *testing.T) {
+	flaky := &flakyMinioClient{FakeMinioClient: NewFakeMinioClient(), failures: []error{
+		unavailableResponse(), unavailableResponse(), unavailableResponse()}}
+	client, now := newTestResilientMinioClient(flaky, Resilience{FailureThreshold: 2, OpenDuration: time.Minute})
+	flaky.FakeMinioClient.PutObject("bucket", "object", bytes.NewReader([]byte("content")), 7, minio.PutObjectOptions{})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetObject("bucket", "object", minio.GetObjectOptions{})
+		assert.NotNil(t, err)
+	}
+	// The open circuit fails the requests without sending them.
+	_, err := client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.Equal(t, codes.Unavailable, err.(*util.UserError).ExternalStatusCode())
+	assert.Equal(t, 2, flaky.requests)
+
+	// A failed probe keeps the circuit open.
+	*now = now.Add(time.Minute)
+	_, err = client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, flaky.requests)
+	_, err = client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, flaky.requests)
+
+	// A successful probe closes it.
+	*now = now.Add(time.Minute)
+	_, err = client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.Nil(t, err)
+	_, err = client.GetObject("bucket", "object", minio.GetObjectOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, flaky.requests)
+}
+
+func TestMinioObjectStore_UnavailableStore(t *testing.T) {
+	flaky := &flakyMinioClient{FakeMinioClient: NewFakeMinioClient(), failures: []error{unavailableResponse()}}
+	client, _ := newTestResilientMinioClient(flaky, Resilience{})
+	store := NewMinioObjectStore(client, "bucket", "pipelines", false)
+
+	_, err := store.GetFile("object")
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.Unavailable, err.(*util.UserError).ExternalStatusCode())
+}
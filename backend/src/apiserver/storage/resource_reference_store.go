@@ -99,6 +99,43 @@ func (s *ResourceReferenceStore) DeleteResourceReferences(tx *sql.Tx, id string,
 	return nil
 }
 
+// MoveResourceReferences points the references of the resources to another
+// resource of the reference type, e.g. moves runs to another experiment. The
+// payloads are regenerated, so the references are recreated.
+func (s *ResourceReferenceStore) MoveResourceReferences(tx *sql.Tx, resourceIds []string, resourceType model.ResourceType,
+	referenceType model.ResourceType, referenceId string, referenceName string) error {
+	where := sq.Eq{"ResourceUUID": resourceIds, "ResourceType": resourceType, "ReferenceType": referenceType}
+	selectSql, selectArgs, err := sq.Select(resourceReferenceColumns...).From("resource_references").Where(where).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to get the references to move")
+	}
+	rows, err := tx.Query(selectSql, selectArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to get the references to move")
+	}
+	references, err := s.scanRows(rows)
+	rows.Close()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to parse the references to move")
+	}
+	deleteSql, deleteArgs, err := sq.Delete("resource_references").Where(where).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the references to move")
+	}
+	if _, err = tx.Exec(deleteSql, deleteArgs...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete the references to move")
+	}
+	moved := make([]*model.ResourceReference, 0, len(references))
+	for i := range references {
+		reference := references[i]
+		reference.ReferenceUUID = referenceId
+		reference.ReferenceName = referenceName
+		reference.Payload = ""
+		moved = append(moved, &reference)
+	}
+	return s.CreateResourceReferences(tx, moved)
+}
+
 func (s *ResourceReferenceStore) GetResourceReference(resourceId string, resourceType model.ResourceType,
 	referenceType model.ResourceType) (*model.ResourceReference, error) {
 	sql, args, err := sq.Select(resourceReferenceColumns...).
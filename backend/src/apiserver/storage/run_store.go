@@ -17,11 +17,12 @@ package storage
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/golang/glog"
+	log "github.com/sirupsen/logrus"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
@@ -36,6 +37,31 @@ var runColumns = []string{"UUID", "ExperimentUUID", "DisplayName", "Name", "Stor
 	"WorkflowSpecManifest", "Parameters", "pipelineRuntimeManifest", "WorkflowRuntimeManifest",
 }
 
+var runMetricColumns = []string{"RunUUID", "NodeID", "Name", "NumberValue", "Format", "Payload"}
+
+// runTables are the tables the runs and their metrics are read from.
+type runTables struct {
+	runDetails string
+	runMetrics string
+}
+
+// The runs moved to the run archive are only listed when asked for, since MySQL
+// 5.7 materializes the union of both tables instead of pushing the filters down
+// to them. A run is read from the run archive when it isn't in run_details.
+var (
+	currentRunTables  = runTables{runDetails: "run_details", runMetrics: "run_metrics"}
+	archivedRunTables = runTables{runDetails: "run_details_archive AS run_details", runMetrics: "run_metrics_archive"}
+	allRunTables      = runTables{
+		runDetails: fmt.Sprintf("(SELECT %[1]s FROM run_details UNION ALL SELECT %[1]s FROM run_details_archive) AS run_details",
+			strings.Join(append(runColumns, "DeletedAtInSec"), ", ")),
+		runMetrics: fmt.Sprintf("(SELECT %[1]s FROM run_metrics UNION ALL SELECT %[1]s FROM run_metrics_archive)",
+			strings.Join(runMetricColumns, ", ")),
+	}
+)
+
+// runDetailsTables are the tables of the runs, moved to the run archive or not.
+var runDetailsTables = []string{"run_details", "run_details_archive"}
+
 type RunStoreInterface interface {
 	GetRun(runId string) (*model.RunDetail, error)
 
@@ -44,6 +70,24 @@ type RunStoreInterface interface {
 	// Create a run entry in the database
 	CreateRun(run *model.RunDetail) (*model.RunDetail, error)
 
+	// Create a run entry along with the outbox record of its PipelineRun
+	CreateRunWithSubmission(run *model.RunDetail, submission *model.RunSubmission, maxActiveRuns int) (*model.RunDetail, error)
+
+	// List up to limit run submissions created before the time, oldest first
+	ListRunSubmissions(createdBeforeInSec int64, limit int) ([]*model.RunSubmission, error)
+
+	// Count the run submissions of a namespace, whose PipelineRuns aren't created yet
+	CountRunSubmissions(namespace string) (int, error)
+
+	// Record a failed attempt to submit a run, to be retried
+	UpdateRunSubmission(runId string, lastError string) error
+
+	// Delete the run submission once the PipelineRun is created
+	DeleteRunSubmission(runId string) error
+
+	// Mark the run failed and delete its submission, which can't succeed
+	FailRunSubmission(runId string, finishedAtInSec int64) error
+
 	// Update run table. Only condition and runtime manifest is allowed to be updated.
 	UpdateRun(id string, condition string, finishedAtInSec int64, workflowRuntimeManifest string) (err error)
 
@@ -64,6 +108,27 @@ type RunStoreInterface interface {
 
 	// Terminate a run
 	TerminateRun(runId string) error
+
+	// Move up to limit finished runs created before the time to the run archive
+	MoveRunsToArchive(createdBeforeInSec int64, limit int) (int, error)
+
+	// Move the runs to another experiment, along with their resource references
+	MoveRunsToExperiment(runIds []string, experiment *model.Experiment) (int, error)
+
+	// Hide a run until it's restored or purged
+	SoftDeleteRun(id string, deletedAtInSec int64) error
+
+	// Restore a soft deleted run
+	UndeleteRun(id string) error
+
+	// List the runs soft deleted before the time
+	ListRunsDeletedBefore(deletedBeforeInSec int64) ([]*model.RunDetail, error)
+
+	// List the unfinished runs created after the first time, up to the second
+	ListUnfinishedRunsCreatedBetween(createdAfterInSec int64, createdBeforeInSec int64) ([]*model.RunDetail, error)
+
+	// Count the runs of an experiment and sum the durations of their finished tasks
+	GetExperimentUsage(experimentId string) (*model.ExperimentUsage, error)
 }
 
 type RunStore struct {
@@ -91,10 +156,11 @@ func (s *RunStore) ListRuns(
 		return errorF(err)
 	}
 
-	// Use a transaction to make sure we're returning the total_size of the same rows queried
-	tx, err := s.db.Begin()
+	// Use a transaction to make sure we're returning the total_size of the same rows queried.
+	// The rows may be read from a replica lagging slightly behind.
+	tx, err := s.db.Reader().Begin()
 	if err != nil {
-		glog.Error("Failed to start transaction to list runs")
+		log.Error("Failed to start transaction to list runs")
 		return errorF(err)
 	}
 
@@ -123,7 +189,7 @@ func (s *RunStore) ListRuns(
 
 	err = tx.Commit()
 	if err != nil {
-		glog.Error("Failed to commit transaction to list runs")
+		log.Error("Failed to commit transaction to list runs")
 		return errorF(err)
 	}
 
@@ -137,7 +203,7 @@ func (s *RunStore) ListRuns(
 		return runs, total_size, "", nil
 	}
 
-	npt, err := opts.NextPageToken(runs[opts.PageSize])
+	npt, err := opts.NextPageToken(runs[opts.PageSize-1])
 	return runs[:opts.PageSize], total_size, npt, err
 }
 
@@ -147,31 +213,35 @@ func (s *RunStore) buildSelectRunsQuery(selectCount bool, opts *list.Options,
 	var filteredSelectBuilder sq.SelectBuilder
 	var err error
 
+	tables := currentRunTables
+	if filterContext.IncludeRunArchive {
+		tables = allRunTables
+	}
 	refKey := filterContext.ReferenceKey
 	if refKey != nil && refKey.Type == common.Experiment {
 		// for performance reasons need to special treat experiment ID filter on runs
 		// currently only the run table have experiment UUID column
-		filteredSelectBuilder, err = list.FilterOnExperiment("run_details", runColumns,
+		filteredSelectBuilder, err = list.FilterOnExperiment(tables.runDetails, runColumns,
 			selectCount, refKey.ID)
 	} else if refKey != nil && refKey.Type == common.Namespace {
-		filteredSelectBuilder, err = list.FilterOnNamespace("run_details", runColumns,
+		filteredSelectBuilder, err = list.FilterOnNamespace(tables.runDetails, runColumns,
 			selectCount, refKey.ID)
 	} else {
-		filteredSelectBuilder, err = list.FilterOnResourceReference("run_details", runColumns,
+		filteredSelectBuilder, err = list.FilterOnResourceReference(tables.runDetails, runColumns,
 			common.Run, selectCount, filterContext)
 	}
 	if err != nil {
 		return "", nil, util.NewInternalServerError(err, "Failed to list runs: %v", err)
 	}
 
-	sqlBuilder := opts.AddFilterToSelect(filteredSelectBuilder)
+	sqlBuilder := opts.AddFilterToSelect(filteredSelectBuilder.Where(notDeleted))
 
 	// If we're not just counting, then also add select columns and perform a left join
 	// to get resource reference information. Also add pagination.
 	if !selectCount {
-		sqlBuilder = s.AddSortByRunMetricToSelect(sqlBuilder, opts)
+		sqlBuilder = s.AddSortByRunMetricToSelect(sqlBuilder, opts, tables)
 		sqlBuilder = opts.AddPaginationToSelect(sqlBuilder)
-		sqlBuilder = s.addMetricsAndResourceReferences(sqlBuilder, opts)
+		sqlBuilder = s.addMetricsAndResourceReferences(sqlBuilder, opts, tables)
 		sqlBuilder = opts.AddSortingToSelect(sqlBuilder)
 	}
 	sql, args, err := sqlBuilder.ToSql()
@@ -183,25 +253,12 @@ func (s *RunStore) buildSelectRunsQuery(selectCount bool, opts *list.Options,
 
 // GetRun Get the run manifest from Workflow CRD
 func (s *RunStore) GetRun(runId string) (*model.RunDetail, error) {
-	sql, args, err := s.addMetricsAndResourceReferences(
-		sq.Select(runColumns...).
-			From("run_details").
-			Where(sq.Eq{"UUID": runId}).
-			Limit(1), nil).
-		ToSql()
-
-	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to get run: %v", err.Error())
+	runs, err := s.getRun(runId, currentRunTables)
+	if err == nil && len(runs) == 0 {
+		runs, err = s.getRun(runId, archivedRunTables)
 	}
-	r, err := s.db.Query(sql, args...)
-	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to get run: %v", err.Error())
-	}
-	defer r.Close()
-	runs, err := s.scanRowsToRunDetails(r)
-
 	if err != nil || len(runs) > 1 {
-		return nil, util.NewInternalServerError(err, "Failed to get run: %v", err.Error())
+		return nil, util.NewInternalServerError(err, "Failed to get run: %v", err)
 	}
 	if len(runs) == 0 {
 		return nil, util.NewResourceNotFoundError("Run", fmt.Sprint(runId))
@@ -213,6 +270,25 @@ func (s *RunStore) GetRun(runId string) (*model.RunDetail, error) {
 	return runs[0], nil
 }
 
+func (s *RunStore) getRun(runId string, tables runTables) ([]*model.RunDetail, error) {
+	sql, args, err := s.addMetricsAndResourceReferences(
+		sq.Select(runColumns...).
+			From(tables.runDetails).
+			Where(sq.Eq{"UUID": runId}).
+			Where(notDeleted).
+			Limit(1), nil, tables).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return s.scanRowsToRunDetails(r)
+}
+
 // Apply func f to every string in a given string slice.
 func Map(vs []string, f func(string) string) []string {
 	vsm := make([]string, len(vs))
@@ -222,32 +298,35 @@ func Map(vs []string, f func(string) string) []string {
 	return vsm
 }
 
-func (s *RunStore) addMetricsAndResourceReferences(filteredSelectBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
+func (s *RunStore) addMetricsAndResourceReferences(filteredSelectBuilder sq.SelectBuilder, opts *list.Options,
+	tables runTables) sq.SelectBuilder {
 	var r model.Run
-	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("rr.Payload", ","), `"]"`}, "")
-	columnsAfterJoiningResourceReferences := append(
-		Map(runColumns, func(column string) string { return "rd." + column }), // Add prefix "rd." to runColumns
-		resourceRefConcatQuery+" AS refs")
+	resourceRefConcatQuery := s.db.Concat([]string{`'['`, s.db.GroupConcat("rr.Payload", ","), `']'`}, "")
+	rdColumns := Map(runColumns, func(column string) string { return "rd." + column }) // Add prefix "rd." to runColumns
+	subqColumns := append(
+		Map(runColumns, func(column string) string { return "subq." + column }), // Add prefix "subq." to runColumns
+		"subq.refs")
 	if opts != nil && !r.IsRegularField(opts.SortByFieldName) {
-		columnsAfterJoiningResourceReferences = append(columnsAfterJoiningResourceReferences, "rd."+opts.SortByFieldName)
+		rdColumns = append(rdColumns, "rd."+opts.SortByFieldName)
+		subqColumns = append(subqColumns, "subq."+opts.SortByFieldName)
 	}
 	subQ := sq.
-		Select(columnsAfterJoiningResourceReferences...).
+		Select(append(rdColumns, resourceRefConcatQuery+" AS refs")...).
 		FromSelect(filteredSelectBuilder, "rd").
 		LeftJoin("resource_references AS rr ON rr.ResourceType='Run' AND rd.UUID=rr.ResourceUUID").
-		GroupBy("rd.UUID")
+		GroupBy(s.db.GroupByColumns("rd.UUID", rdColumns)...)
 
 	// TODO(jingzhang36): address the case where some runs don't have the metric used in order by.
-	metricConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("rm.Payload", ","), `"]"`}, "")
+	metricConcatQuery := s.db.Concat([]string{`'['`, s.db.GroupConcat("rm.Payload", ","), `']'`}, "")
 	columnsAfterJoiningRunMetrics := append(
-		Map(runColumns, func(column string) string { return "subq." + column }), // Add prefix "subq." to runColumns
+		Map(runColumns, func(column string) string { return "subq." + column }),
 		"subq.refs",
 		metricConcatQuery+" AS metrics")
 	return sq.
 		Select(columnsAfterJoiningRunMetrics...).
 		FromSelect(subQ, "subq").
-		LeftJoin("run_metrics AS rm ON subq.UUID=rm.RunUUID").
-		GroupBy("subq.UUID")
+		LeftJoin(tables.runMetrics + " AS rm ON subq.UUID=rm.RunUUID").
+		GroupBy(s.db.GroupByColumns("subq.UUID", subqColumns)...)
 }
 
 func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, error) {
@@ -282,12 +361,12 @@ func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, err
 			&metricsInString,
 		)
 		if err != nil {
-			glog.Errorf("Failed to scan row: %v", err)
+			log.Errorf("Failed to scan row: %v", err)
 			return runs, nil
 		}
 		metrics, err := parseMetrics(metricsInString)
 		if err != nil {
-			glog.Errorf("Failed to parse metrics (%v) from DB: %v", metricsInString, err)
+			log.Errorf("Failed to parse metrics (%v) from DB: %v", metricsInString, err)
 			// Skip the error to allow user to get runs even when metrics data
 			// are invalid.
 			metrics = []*model.RunMetric{}
@@ -297,6 +376,11 @@ func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, err
 			// throw internal exception if failed to parse the resource reference.
 			return nil, util.NewInternalServerError(err, "Failed to parse resource reference.")
 		}
+		err = decryptStrings(s.db.Encryptor(), &pipelineSpecManifest, &workflowSpecManifest, &parameters,
+			&pipelineRuntimeManifest, &workflowRuntimeManifest)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to decrypt run %v", uuid)
+		}
 		runs = append(runs, &model.RunDetail{Run: model.Run{
 			UUID:               uuid,
 			ExperimentUUID:     experimentUUID,
@@ -350,6 +434,13 @@ func parseResourceReferences(resourceRefString sql.NullString) ([]*model.Resourc
 }
 
 func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
+	return s.CreateRunWithSubmission(r, nil, 0)
+}
+
+// CreateRunWithSubmission stores the run, and the submission of its PipelineRun
+// unless it's nil, in a single transaction. Unless maxActiveRuns is zero, the
+// run is rejected when its namespace already has as many unfinished runs.
+func (s *RunStore) CreateRunWithSubmission(r *model.RunDetail, submission *model.RunSubmission, maxActiveRuns int) (*model.RunDetail, error) {
 	if r.StorageState == "" {
 		r.StorageState = api.Run_STORAGESTATE_AVAILABLE.String()
 	} else if r.StorageState != api.Run_STORAGESTATE_AVAILABLE.String() &&
@@ -357,6 +448,14 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 		return nil, util.NewInvalidInputError("Invalid value for StorageState field: %q.", r.StorageState)
 	}
 
+	pipelineSpecManifest, workflowSpecManifest, parameters := r.PipelineSpecManifest, r.WorkflowSpecManifest, r.Parameters
+	pipelineRuntimeManifest, workflowRuntimeManifest := r.PipelineRuntimeManifest, r.WorkflowRuntimeManifest
+	err := encryptStrings(s.db.Encryptor(), &pipelineSpecManifest, &workflowSpecManifest, &parameters,
+		&pipelineRuntimeManifest, &workflowRuntimeManifest)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to encrypt run %v", r.Name)
+	}
+
 	runSql, runArgs, err := sq.
 		Insert("run_details").
 		SetMap(sq.Eq{
@@ -372,13 +471,13 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 			"ScheduledAtInSec":        r.ScheduledAtInSec,
 			"FinishedAtInSec":         r.FinishedAtInSec,
 			"Conditions":              r.Conditions,
-			"WorkflowRuntimeManifest": r.WorkflowRuntimeManifest,
-			"PipelineRuntimeManifest": r.PipelineRuntimeManifest,
+			"WorkflowRuntimeManifest": workflowRuntimeManifest,
+			"PipelineRuntimeManifest": pipelineRuntimeManifest,
 			"PipelineId":              r.PipelineId,
 			"PipelineName":            r.PipelineName,
-			"PipelineSpecManifest":    r.PipelineSpecManifest,
-			"WorkflowSpecManifest":    r.WorkflowSpecManifest,
-			"Parameters":              r.Parameters,
+			"PipelineSpecManifest":    pipelineSpecManifest,
+			"WorkflowSpecManifest":    workflowSpecManifest,
+			"Parameters":              parameters,
 		}).ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create query to store run to run table: '%v/%v",
@@ -390,6 +489,31 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to create run.")
 	}
+	// The run archive has no unique key shared with run_details, a run moved there
+	// is only updated.
+	archived, err := runExists(tx, "run_details_archive", r.UUID)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to check the run archive for run %v", r.Name)
+	}
+	if archived {
+		tx.Rollback()
+		return nil, util.NewAlreadyExistError("Run %v was moved to the run archive", r.UUID)
+	}
+	if maxActiveRuns > 0 {
+		active, err := countActiveRuns(tx, r.Namespace)
+		if err != nil {
+			tx.Rollback()
+			return nil, util.NewInternalServerError(err, "Failed to count the active runs of namespace %v", r.Namespace)
+		}
+		if active >= maxActiveRuns {
+			tx.Rollback()
+			return nil, util.NewResourceExhaustedError(
+				errors.New("Concurrent run limit reached"),
+				"Namespace %s already has %d active runs, the maximum allowed. Please retry once some of them finish.",
+				r.Namespace, active).WithReason(util.ReasonConcurrentRunLimit)
+		}
+	}
 	_, err = tx.Exec(runSql, runArgs...)
 	if err != nil {
 		tx.Rollback()
@@ -401,6 +525,12 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to store resource references to table for run %v ", r.Name)
 	}
+	if submission != nil {
+		if err := s.createRunSubmission(tx, submission); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -410,31 +540,19 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 }
 
 func (s *RunStore) UpdateRun(runID string, condition string, finishedAtInSec int64, workflowRuntimeManifest string) (err error) {
+	workflowRuntimeManifest, err = s.db.Encryptor().EncryptString(workflowRuntimeManifest)
+	if err != nil {
+		return util.Wrapf(err, "Failed to encrypt run %s", runID)
+	}
 	tx, err := s.db.DB.Begin()
 	if err != nil {
 		return util.NewInternalServerError(err, "transaction creation failed")
 	}
 
-	sql, args, err := sq.
-		Update("run_details").
-		SetMap(sq.Eq{
-			"Conditions":              condition,
-			"FinishedAtInSec":         finishedAtInSec,
-			"WorkflowRuntimeManifest": workflowRuntimeManifest}).
-		Where(sq.Eq{"UUID": runID}).
-		ToSql()
-	if err != nil {
-		tx.Rollback()
-		return util.NewInternalServerError(err,
-			"Failed to create query to update run %s. error: '%v'", runID, err.Error())
-	}
-	result, err := tx.Exec(sql, args...)
-	if err != nil {
-		tx.Rollback()
-		return util.NewInternalServerError(err,
-			"Failed to update run %s. error: '%v'", runID, err.Error())
-	}
-	r, err := result.RowsAffected()
+	r, err := updateRun(tx, runID, sq.Eq{
+		"Conditions":              condition,
+		"FinishedAtInSec":         finishedAtInSec,
+		"WorkflowRuntimeManifest": workflowRuntimeManifest}, nil)
 	if err != nil {
 		tx.Rollback()
 		return util.NewInternalServerError(err,
@@ -470,49 +588,90 @@ func (s *RunStore) CreateOrUpdateRun(runDetail *model.RunDetail) error {
 }
 
 func (s *RunStore) ArchiveRun(runId string) error {
-	sql, args, err := sq.
-		Update("run_details").
-		SetMap(sq.Eq{
-			"StorageState": api.Run_STORAGESTATE_ARCHIVED.String(),
-		}).
-		Where(sq.Eq{"UUID": runId}).
-		ToSql()
-
+	r, err := updateRun(s.db, runId, sq.Eq{"StorageState": api.Run_STORAGESTATE_ARCHIVED.String()}, nil)
 	if err != nil {
 		return util.NewInternalServerError(err,
-			"Failed to create query to archive run %s. error: '%v'", runId, err.Error())
+			"Failed to archive run %s. error: '%v'", runId, err.Error())
+	}
+	if r == 0 {
+		return util.Wrap(util.NewResourceNotFoundError("Run", runId), "Failed to archive run")
 	}
+	return nil
+}
 
-	_, err = s.db.Exec(sql, args...)
+func (s *RunStore) UnarchiveRun(runId string) error {
+	r, err := updateRun(s.db, runId, sq.Eq{"StorageState": api.Run_STORAGESTATE_AVAILABLE.String()}, nil)
 	if err != nil {
 		return util.NewInternalServerError(err,
-			"Failed to archive run %s. error: '%v'", runId, err.Error())
+			"Failed to unarchive run %s. error: '%v'", runId, err.Error())
+	}
+	if r == 0 {
+		return util.Wrap(util.NewResourceNotFoundError("Run", runId), "Failed to unarchive run")
 	}
-
 	return nil
 }
 
-func (s *RunStore) UnarchiveRun(runId string) error {
-	sql, args, err := sq.
-		Update("run_details").
-		SetMap(sq.Eq{
-			"StorageState": api.Run_STORAGESTATE_AVAILABLE.String(),
-		}).
-		Where(sq.Eq{"UUID": runId}).
-		ToSql()
+// sqlExecer runs the statements of a database or a transaction.
+type sqlExecer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
 
-	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to create query to unarchive run %s. error: '%v'", runId, err.Error())
+// updateRun updates the run matching the condition in run_details, or in the run
+// archive if it was moved there. It returns the number of updated rows, which
+// includes the rows that already had the values.
+func updateRun(db sqlExecer, runId string, values sq.Eq, condition sq.Sqlizer) (int64, error) {
+	for _, table := range runDetailsTables {
+		query := sq.Update(table).SetMap(values).Where(sq.Eq{"UUID": runId})
+		if condition != nil {
+			query = query.Where(condition)
+		}
+		updateSql, updateArgs, err := query.ToSql()
+		if err != nil {
+			return 0, err
+		}
+		result, err := db.Exec(updateSql, updateArgs...)
+		if err != nil {
+			return 0, err
+		}
+		r, err := result.RowsAffected()
+		if err != nil || r > 0 {
+			return r, err
+		}
 	}
+	return 0, nil
+}
 
-	_, err = s.db.Exec(sql, args...)
+// countActiveRuns returns the number of runs of the namespace which haven't
+// finished, including the runs whose PipelineRuns aren't submitted yet. The runs
+// are only moved to the run archive once finished.
+func countActiveRuns(db sqlExecer, namespace string) (int, error) {
+	countSql, countArgs, err := sq.
+		Select("COUNT(*)").
+		From("run_details").
+		Where(sq.And{sq.Eq{"Namespace": namespace}, sq.Eq{"FinishedAtInSec": 0}, notDeleted}).
+		ToSql()
 	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to unarchive run %s. error: '%v'", runId, err.Error())
+		return 0, err
+	}
+	var count int
+	if err := db.QueryRow(countSql, countArgs...).Scan(&count); err != nil {
+		return 0, err
 	}
+	return count, nil
+}
 
-	return nil
+// runExists returns whether the table holds the run.
+func runExists(db sqlExecer, table string, runId string) (bool, error) {
+	countSql, countArgs, err := sq.Select("COUNT(*)").From(table).Where(sq.Eq{"UUID": runId}).ToSql()
+	if err != nil {
+		return false, err
+	}
+	var count int
+	if err := db.QueryRow(countSql, countArgs...).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 func (s *RunStore) DeleteRun(id string) error {
@@ -531,6 +690,25 @@ func (s *RunStore) DeleteRun(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete run %s from table", id)
 	}
+	// The run may have been moved to the run archive, whose tables have no foreign
+	// keys, or not be submitted yet. Its tasks are kept when it's moved, they don't
+	// reference run_details either.
+	for _, archive := range []sq.DeleteBuilder{
+		sq.Delete("run_details_archive").Where(sq.Eq{"UUID": id}),
+		sq.Delete("run_metrics_archive").Where(sq.Eq{"RunUUID": id}),
+		sq.Delete("tasks").Where(sq.Eq{"RunUUID": id}),
+		sq.Delete("run_submissions").Where(sq.Eq{"RunUUID": id}),
+	} {
+		archiveSql, archiveArgs, err := archive.ToSql()
+		if err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to create query to delete archived run: %s", id)
+		}
+		if _, err := tx.Exec(archiveSql, archiveArgs...); err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to delete run %s from the run archive", id)
+		}
+	}
 	err = s.resourceReferenceStore.DeleteResourceReferences(tx, id, common.Run)
 	if err != nil {
 		tx.Rollback()
@@ -544,6 +722,227 @@ func (s *RunStore) DeleteRun(id string) error {
 	return nil
 }
 
+// MoveRunsToArchive moves up to limit finished runs created before the time, and
+// their metrics, to the run archive. The soft deleted runs are left to be purged,
+// and the tasks of the runs are kept. It returns the number of moved runs.
+func (s *RunStore) MoveRunsToArchive(createdBeforeInSec int64, limit int) (int, error) {
+	uuidsSql, uuidsArgs, err := sq.Select("UUID").
+		From("run_details").
+		Where(sq.And{sq.Lt{"CreatedAtInSec": createdBeforeInSec}, sq.Gt{"FinishedAtInSec": 0}, notDeleted}).
+		OrderBy("CreatedAtInSec").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to list the runs to archive")
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create a new transaction to archive runs")
+	}
+	rows, err := tx.Query(uuidsSql, uuidsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return 0, util.NewInternalServerError(err, "Failed to list the runs to archive")
+	}
+	var uuids []string
+	for rows.Next() {
+		var uuid string
+		if err := rows.Scan(&uuid); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return 0, util.NewInternalServerError(err, "Failed to scan the runs to archive")
+		}
+		uuids = append(uuids, uuid)
+	}
+	rows.Close()
+	if len(uuids) == 0 {
+		tx.Rollback()
+		return 0, nil
+	}
+
+	// The runs are copied to the archive before being deleted, with their metrics.
+	// SQLite doesn't enforce the foreign keys, the metrics are deleted explicitly.
+	steps := []struct {
+		prefix string
+		query  sq.Sqlizer
+	}{
+		{fmt.Sprintf("INSERT INTO run_details_archive (%s) ", strings.Join(runColumns, ", ")),
+			sq.Select(runColumns...).From("run_details").Where(sq.Eq{"UUID": uuids})},
+		{fmt.Sprintf("INSERT INTO run_metrics_archive (%s) ", strings.Join(runMetricColumns, ", ")),
+			sq.Select(runMetricColumns...).From("run_metrics").Where(sq.Eq{"RunUUID": uuids})},
+		{"", sq.Delete("run_metrics").Where(sq.Eq{"RunUUID": uuids})},
+		{"", sq.Delete("run_details").Where(sq.Eq{"UUID": uuids})},
+	}
+	for _, step := range steps {
+		stepSql, stepArgs, err := step.query.ToSql()
+		if err != nil {
+			tx.Rollback()
+			return 0, util.NewInternalServerError(err, "Failed to create query to archive runs")
+		}
+		if _, err := tx.Exec(step.prefix+stepSql, stepArgs...); err != nil {
+			tx.Rollback()
+			return 0, util.NewInternalServerError(err, "Failed to archive runs")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to commit the transaction to archive runs")
+	}
+	return len(uuids), nil
+}
+
+// MoveRunsToExperiment moves the runs, whether they were moved to the run
+// archive or not, to another experiment in a single transaction. None is moved
+// unless all of them exist. It returns the number of runs moved.
+func (s *RunStore) MoveRunsToExperiment(runIds []string, experiment *model.Experiment) (int, error) {
+	if len(runIds) == 0 {
+		return 0, nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create a new transaction to move runs to experiment %v", experiment.UUID)
+	}
+	// The runs are counted before any of them is updated, in either table.
+	found := 0
+	for _, table := range runDetailsTables {
+		countSql, countArgs, err := sq.
+			Select("COUNT(*)").
+			From(table).
+			Where(sq.Eq{"UUID": runIds}).
+			ToSql()
+		if err != nil {
+			tx.Rollback()
+			return 0, util.NewInternalServerError(err, "Failed to create query to count the runs to move")
+		}
+		var count int
+		if err := tx.QueryRow(countSql, countArgs...).Scan(&count); err != nil {
+			tx.Rollback()
+			return 0, util.NewInternalServerError(err, "Failed to count the runs to move")
+		}
+		found += count
+	}
+	if found != len(runIds) {
+		tx.Rollback()
+		return 0, util.NewResourcesNotFoundError("%d of the %d runs to move", len(runIds)-found, len(runIds))
+	}
+	for _, table := range runDetailsTables {
+		updateSql, updateArgs, err := sq.
+			Update(table).
+			Set("ExperimentUUID", experiment.UUID).
+			Where(sq.Eq{"UUID": runIds}).
+			ToSql()
+		if err != nil {
+			tx.Rollback()
+			return 0, util.NewInternalServerError(err, "Failed to create query to move runs to experiment %v", experiment.UUID)
+		}
+		if _, err := tx.Exec(updateSql, updateArgs...); err != nil {
+			tx.Rollback()
+			return 0, util.NewInternalServerError(err, "Failed to move runs to experiment %v", experiment.UUID)
+		}
+	}
+	err = s.resourceReferenceStore.MoveResourceReferences(
+		tx, runIds, common.Run, common.Experiment, experiment.UUID, experiment.Name)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to commit the transaction to move runs to experiment %v", experiment.UUID)
+	}
+	return len(runIds), nil
+}
+
+// SoftDeleteRun hides the run until it's restored or purged, whether it was moved
+// to the run archive or not.
+func (s *RunStore) SoftDeleteRun(id string, deletedAtInSec int64) error {
+	return s.setDeletedAt(id, deletedAtInSec, "Run")
+}
+
+// UndeleteRun restores the soft deleted run.
+func (s *RunStore) UndeleteRun(id string) error {
+	return s.setDeletedAt(id, 0, "Deleted run")
+}
+
+func (s *RunStore) setDeletedAt(id string, deletedAtInSec int64, resourceType string) error {
+	for _, table := range runDetailsTables {
+		found, err := setDeletedAt(s.db, table, id, deletedAtInSec)
+		if err != nil || found {
+			return err
+		}
+	}
+	return util.NewResourceNotFoundError(resourceType, id)
+}
+
+// ListRunsDeletedBefore returns the runs soft deleted before the time, to be purged.
+func (s *RunStore) ListRunsDeletedBefore(deletedBeforeInSec int64) ([]*model.RunDetail, error) {
+	sql, args, err := s.addMetricsAndResourceReferences(
+		sq.Select(runColumns...).From(allRunTables.runDetails).Where(deletedBefore(deletedBeforeInSec)), nil, allRunTables).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list deleted runs: %v", err.Error())
+	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list deleted runs: %v", err.Error())
+	}
+	defer r.Close()
+	return s.scanRowsToRunDetails(r)
+}
+
+// ListUnfinishedRunsCreatedBetween lists the runs which haven't finished yet,
+// created in the period. The runs are only moved to the run archive once
+// finished, so they're all in the run_details table.
+func (s *RunStore) ListUnfinishedRunsCreatedBetween(createdAfterInSec int64, createdBeforeInSec int64) ([]*model.RunDetail, error) {
+	sql, args, err := s.addMetricsAndResourceReferences(
+		sq.Select(runColumns...).From("run_details").Where(sq.And{
+			sq.Gt{"CreatedAtInSec": createdAfterInSec},
+			sq.LtOrEq{"CreatedAtInSec": createdBeforeInSec},
+			sq.Eq{"FinishedAtInSec": 0},
+			notDeleted,
+		}), nil, currentRunTables).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list unfinished runs: %v", err.Error())
+	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list unfinished runs: %v", err.Error())
+	}
+	defer r.Close()
+	return s.scanRowsToRunDetails(r)
+}
+
+// GetExperimentUsage returns what the runs of an experiment consumed. The
+// archived and the soft deleted runs count too, so that deleting runs doesn't
+// free up the budget of the experiment.
+func (s *RunStore) GetExperimentUsage(experimentId string) (*model.ExperimentUsage, error) {
+	usage := &model.ExperimentUsage{}
+	for _, table := range runDetailsTables {
+		runsSql, runsArgs, err := sq.Select("COUNT(*)").From(table).Where(sq.Eq{"ExperimentUUID": experimentId}).ToSql()
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to create query to count the runs of experiment %v", experimentId)
+		}
+		tasksSql, tasksArgs, err := sq.
+			Select("COALESCE(SUM(tasks.FinishedTimestamp - tasks.CreatedTimestamp), 0)").
+			From("tasks").
+			Join(table + " AS runs ON tasks.RunUUID = runs.UUID").
+			Where(sq.And{sq.Eq{"runs.ExperimentUUID": experimentId}, sq.Gt{"tasks.FinishedTimestamp": 0}}).
+			ToSql()
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to create query to sum the task durations of experiment %v", experimentId)
+		}
+		var runCount, taskSeconds int64
+		if err = s.db.QueryRow(runsSql, runsArgs...).Scan(&runCount); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to count the runs of experiment %v", experimentId)
+		}
+		if err = s.db.QueryRow(tasksSql, tasksArgs...).Scan(&taskSeconds); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to sum the task durations of experiment %v", experimentId)
+		}
+		usage.RunCount += runCount
+		usage.TaskSeconds += taskSeconds
+	}
+	return usage, nil
+}
+
 // ReportMetric inserts a new metric to run_metrics table. Conflicting metrics
 // are ignored.
 func (s *RunStore) ReportMetric(metric *model.RunMetric) (err error) {
@@ -569,7 +968,8 @@ func (s *RunStore) ReportMetric(metric *model.RunMetric) (err error) {
 	if err != nil {
 		if s.db.IsDuplicateError(err) {
 			return util.NewAlreadyExistError(
-				"same metric has been reported before: %s/%s", metric.NodeID, metric.Name)
+				"same metric has been reported before: %s/%s", metric.NodeID, metric.Name).
+				WithReason(util.ReasonRunMetricExists)
 		}
 		return util.NewInternalServerError(err, "failed to insert metric: %v", metric)
 	}
@@ -602,18 +1002,14 @@ func NewRunStore(db *DB, time util.TimeInterface) *RunStore {
 }
 
 func (s *RunStore) TerminateRun(runId string) error {
-	result, err := s.db.Exec(`
-		UPDATE run_details
-		SET Conditions = ?
-		WHERE UUID = ? AND (Conditions = ? OR Conditions = ? OR Conditions = ? OR Conditions = ?)`,
-		model.RunTerminatingConditions, runId, string("Running"), string("Pending"), "", string("PipelineRunStopping"))
-
+	r, err := updateRun(s.db, runId, sq.Eq{"Conditions": model.RunTerminatingConditions},
+		sq.Eq{"Conditions": []string{"Running", "Pending", "", "PipelineRunStopping"}})
 	if err != nil {
 		return util.NewInternalServerError(err,
 			"Failed to terminate run %s. error: '%v'", runId, err.Error())
 	}
 
-	if r, _ := result.RowsAffected(); r != 1 {
+	if r != 1 {
 		return util.NewInvalidInputError("Failed to terminate run %s. Row not found.", runId)
 	}
 
@@ -623,7 +1019,7 @@ func (s *RunStore) TerminateRun(runId string) error {
 // Add a metric as a new field to the select clause by join the passed-in SQL query with run_metrics table.
 // With the metric as a field in the select clause enable sorting on this metric afterwards.
 // TODO(jingzhang36): example of resulting SQL query and explanation for it.
-func (s *RunStore) AddSortByRunMetricToSelect(sqlBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
+func (s *RunStore) AddSortByRunMetricToSelect(sqlBuilder sq.SelectBuilder, opts *list.Options, tables runTables) sq.SelectBuilder {
 	var r model.Run
 	if r.IsRegularField(opts.SortByFieldName) {
 		return sqlBuilder
@@ -632,5 +1028,5 @@ func (s *RunStore) AddSortByRunMetricToSelect(sqlBuilder sq.SelectBuilder, opts
 	return sq.
 		Select("selected_runs.*, run_metrics.numbervalue as "+opts.SortByFieldName).
 		FromSelect(sqlBuilder, "selected_runs").
-		LeftJoin("run_metrics ON selected_runs.uuid=run_metrics.runuuid AND run_metrics.name='" + opts.SortByFieldName + "'")
+		LeftJoin(tables.runMetrics + " AS run_metrics ON selected_runs.uuid=run_metrics.runuuid AND run_metrics.name='" + opts.SortByFieldName + "'")
 }
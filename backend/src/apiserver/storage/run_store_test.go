@@ -1083,6 +1083,16 @@ func TestArchiveRun(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestArchiveRun_RunNotFound(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.ArchiveRun("unknown")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	err = runStore.UnarchiveRun("unknown")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestArchiveRun_InternalError(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
@@ -1211,3 +1221,306 @@ func TestDeleteRun_InternalError(t *testing.T) {
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode(),
 		"Expected delete run to return internal error")
 }
+
+func TestMoveRunsToArchive(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	taskStore := NewTaskStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeTaskId, nil))
+	_, err := taskStore.CreateTask(&model.Task{RunUUID: "1", MLMDExecutionID: "1", CreatedTimestamp: 1, FinishedTimestamp: 5})
+	assert.Nil(t, err)
+	assert.Nil(t, runStore.UpdateRun("1", "Succeeded", 5, "workflow1"))
+	assert.Nil(t, runStore.UpdateRun("2", "Succeeded", 0, "workflow1"))
+
+	// Run 2 isn't finished and run 3 is recent.
+	moved, err := runStore.MoveRunsToArchive(3, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, moved)
+	moved, err = runStore.MoveRunsToArchive(3, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, moved)
+	// The tasks of the run are kept.
+	_, err = taskStore.GetTask(defaultFakeTaskId)
+	assert.Nil(t, err)
+
+	// The archived run is still read with its metrics, and listed when asked for.
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Succeeded", runDetail.Conditions)
+	assert.Equal(t, "dummymetric", runDetail.Metrics[0].Name)
+	opts, _ := list.NewOptions(&model.Run{}, 4, "", nil)
+	runs, totalSize, _, err := runStore.ListRuns(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, "2", runs[0].UUID)
+	opts, _ = list.NewOptions(&model.Run{}, 4, "", nil)
+	runs, totalSize, _, err = runStore.ListRuns(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}, IncludeRunArchive: true}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Equal(t, []string{"1", "2"}, []string{runs[0].UUID, runs[1].UUID})
+
+	// The archived run is updated in the run archive, and isn't created again.
+	assert.Nil(t, runStore.ArchiveRun("1"))
+	assert.Nil(t, runStore.UpdateRun("1", "Failed", 6, "workflow1"))
+	runDetail, err = runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_ARCHIVED.String(), runDetail.StorageState)
+	assert.Equal(t, "Failed", runDetail.Conditions)
+	_, err = runStore.CreateRun(runDetail)
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+	assert.Nil(t, runStore.CreateOrUpdateRun(runDetail))
+
+	assert.Nil(t, runStore.DeleteRun("1"))
+	_, err = runStore.GetRun("1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Run 1 not found")
+	_, err = taskStore.GetTask(defaultFakeTaskId)
+	assert.NotNil(t, err)
+}
+
+func TestListUnfinishedRunsCreatedBetween(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	assert.Nil(t, runStore.UpdateRun("3", "Succeeded", 5, "workflow1"))
+
+	runs, err := runStore.ListUnfinishedRunsCreatedBetween(1, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(runs))
+	assert.Equal(t, "2", runs[0].UUID)
+	assert.Equal(t, defaultFakeExpId, runs[0].ResourceReferences[0].ReferenceUUID)
+
+	runs, err = runStore.ListUnfinishedRunsCreatedBetween(0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(runs))
+	assert.Equal(t, "1", runs[0].UUID)
+
+	assert.Nil(t, runStore.SoftDeleteRun("1", 10))
+	runs, err = runStore.ListUnfinishedRunsCreatedBetween(0, 1)
+	assert.Nil(t, err)
+	assert.Empty(t, runs)
+}
+
+func TestMoveRunsToExperiment(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	experiment := &model.Experiment{UUID: defaultFakeExpIdTwo, Name: "exp2"}
+	assert.Nil(t, runStore.UpdateRun("1", "Succeeded", 5, "workflow1"))
+	moved, err := runStore.MoveRunsToArchive(2, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, moved)
+
+	// The archived runs are moved too.
+	moved, err = runStore.MoveRunsToExperiment([]string{"1", "2"}, experiment)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, moved)
+	for _, id := range []string{"1", "2"} {
+		runDetail, err := runStore.GetRun(id)
+		assert.Nil(t, err)
+		assert.Equal(t, defaultFakeExpIdTwo, runDetail.ExperimentUUID)
+		assert.Equal(t, 1, len(runDetail.ResourceReferences))
+		assert.Equal(t, defaultFakeExpIdTwo, runDetail.ResourceReferences[0].ReferenceUUID)
+		assert.Equal(t, "exp2", runDetail.ResourceReferences[0].ReferenceName)
+	}
+	opts, _ := list.NewOptions(&model.Run{}, 4, "", nil)
+	runs, totalSize, _, err := runStore.ListRuns(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpIdTwo}, IncludeRunArchive: true}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.Equal(t, 3, len(runs))
+
+	// None of the runs is moved if one of them doesn't exist.
+	_, err = runStore.MoveRunsToExperiment([]string{"3", "unknown"}, &model.Experiment{UUID: defaultFakeExpId, Name: "exp1"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "1 of the 2 runs to move not found")
+	runDetail, err := runStore.GetRun("3")
+	assert.Nil(t, err)
+	assert.Equal(t, defaultFakeExpIdTwo, runDetail.ExperimentUUID)
+
+	// The runs already in the experiment are found too.
+	moved, err = runStore.MoveRunsToExperiment([]string{"1", "3"}, experiment)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, moved)
+}
+
+func TestGetExperimentUsage(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	taskStore := NewTaskStore(db, util.NewFakeTimeForEpoch(), nil)
+	tasks := []*model.Task{
+		{RunUUID: "1", MLMDExecutionID: "1", CreatedTimestamp: 10, FinishedTimestamp: 3610},
+		{RunUUID: "2", MLMDExecutionID: "2", CreatedTimestamp: 10, FinishedTimestamp: 1810},
+		// The unfinished tasks aren't counted.
+		{RunUUID: "2", MLMDExecutionID: "3", CreatedTimestamp: 10},
+		{RunUUID: "3", MLMDExecutionID: "4", CreatedTimestamp: 10, FinishedTimestamp: 100},
+	}
+	taskIds := []string{defaultFakeTaskId, defaultFakeTaskIdTwo, defaultFakeTaskIdThree, defaultFakeTaskIdFour}
+	for i, task := range tasks {
+		taskStore.uuid = util.NewFakeUUIDGeneratorOrFatal(taskIds[i], nil)
+		_, err := taskStore.CreateTask(task)
+		assert.Nil(t, err)
+	}
+
+	usage, err := runStore.GetExperimentUsage(defaultFakeExpId)
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentUsage{RunCount: 2, TaskSeconds: 5400}, usage)
+
+	// The archived and the soft deleted runs still count.
+	assert.Nil(t, runStore.UpdateRun("1", "Succeeded", 5, "workflow1"))
+	moved, err := runStore.MoveRunsToArchive(3, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, moved)
+	assert.Nil(t, runStore.SoftDeleteRun("2", 10))
+	usage, err = runStore.GetExperimentUsage(defaultFakeExpId)
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentUsage{RunCount: 2, TaskSeconds: 5400}, usage)
+
+	usage, err = runStore.GetExperimentUsage("unknown")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ExperimentUsage{}, usage)
+}
+
+func TestSoftDeleteRun(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	assert.Nil(t, runStore.SoftDeleteRun("1", 10))
+	_, err := runStore.GetRun("1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Run 1 not found")
+	opts, _ := list.NewOptions(&model.Run{}, 4, "", nil)
+	runs, totalSize, _, err := runStore.ListRuns(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, "2", runs[0].UUID)
+
+	// The run is purged once deleted before the purge window.
+	deleted, err := runStore.ListRunsDeletedBefore(10)
+	assert.Nil(t, err)
+	assert.Empty(t, deleted)
+	deleted, err = runStore.ListRunsDeletedBefore(11)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(deleted))
+	assert.Equal(t, "1", deleted[0].UUID)
+
+	assert.Nil(t, runStore.UndeleteRun("1"))
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", runDetail.UUID)
+	err = runStore.UndeleteRun("1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Deleted run 1 not found")
+}
+
+func TestRunSubmission(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	run := &model.RunDetail{
+		Run: model.Run{
+			UUID:             "3",
+			ExperimentUUID:   defaultFakeExpId,
+			Name:             "run3",
+			DisplayName:      "run3",
+			StorageState:     api.Run_STORAGESTATE_AVAILABLE.String(),
+			Namespace:        "n3",
+			CreatedAtInSec:   3,
+			ScheduledAtInSec: 3,
+			Conditions:       "Pending",
+		},
+	}
+	submission := &model.RunSubmission{RunUUID: "3", Namespace: "n3", Workflow: "workflow3", CreatedAtInSec: 3}
+	_, err := runStore.CreateRunWithSubmission(run, submission, 0)
+	assert.Nil(t, err)
+
+	submissions, err := runStore.ListRunSubmissions(3, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, submissions)
+	submissions, err = runStore.ListRunSubmissions(4, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunSubmission{submission}, submissions)
+	count, err := runStore.CountRunSubmissions("n3")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	count, err = runStore.CountRunSubmissions("n1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	assert.Nil(t, runStore.UpdateRunSubmission("3", "connection refused"))
+	submissions, err = runStore.ListRunSubmissions(4, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), submissions[0].Attempts)
+	assert.Equal(t, "connection refused", submissions[0].LastError)
+
+	// A run whose PipelineRun can't be created fails, and isn't submitted again.
+	assert.Nil(t, runStore.FailRunSubmission("3", 5))
+	runDetail, err := runStore.GetRun("3")
+	assert.Nil(t, err)
+	assert.Equal(t, "Failed", runDetail.Conditions)
+	assert.Equal(t, int64(5), runDetail.FinishedAtInSec)
+	submissions, err = runStore.ListRunSubmissions(4, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, submissions)
+}
+
+func TestCreateRunWithSubmission_MaxActiveRuns(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	newRun := func(id string) *model.RunDetail {
+		return &model.RunDetail{
+			Run: model.Run{
+				UUID:           id,
+				ExperimentUUID: defaultFakeExpId,
+				Name:           "run" + id,
+				StorageState:   api.Run_STORAGESTATE_AVAILABLE.String(),
+				Namespace:      "n3",
+				CreatedAtInSec: 3,
+			},
+		}
+	}
+	newSubmission := func(id string) *model.RunSubmission {
+		return &model.RunSubmission{RunUUID: id, Namespace: "n3", Workflow: "workflow" + id, CreatedAtInSec: 3}
+	}
+	_, err := runStore.CreateRunWithSubmission(newRun("3"), newSubmission("3"), 1)
+	assert.Nil(t, err)
+
+	_, err = runStore.CreateRunWithSubmission(newRun("4"), newSubmission("4"), 1)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Namespace n3 already has 1 active runs")
+	_, err = runStore.GetRun("4")
+	assert.NotNil(t, err)
+	submissions, err := runStore.ListRunSubmissions(4, 10)
+	assert.Nil(t, err)
+	assert.Len(t, submissions, 1)
+
+	// The finished runs aren't active.
+	assert.Nil(t, runStore.FailRunSubmission("3", 5))
+	_, err = runStore.CreateRunWithSubmission(newRun("4"), newSubmission("4"), 1)
+	assert.Nil(t, err)
+}
+
+func TestDeleteRun_DeletesSubmission(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	run := &model.RunDetail{
+		Run: model.Run{
+			UUID:           "3",
+			ExperimentUUID: defaultFakeExpId,
+			Name:           "run3",
+			StorageState:   api.Run_STORAGESTATE_AVAILABLE.String(),
+			Namespace:      "n3",
+			CreatedAtInSec: 3,
+		},
+	}
+	_, err := runStore.CreateRunWithSubmission(run, &model.RunSubmission{RunUUID: "3", Namespace: "n3", Workflow: "workflow3", CreatedAtInSec: 3}, 0)
+	assert.Nil(t, err)
+
+	assert.Nil(t, runStore.DeleteRun("3"))
+	submissions, err := runStore.ListRunSubmissions(4, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, submissions)
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The run submissions are the outbox of the run creation: they are stored in the
+// transaction storing their runs, and deleted once the PipelineRuns are created.
+
+var runSubmissionColumns = []string{"RunUUID", "Namespace", "Workflow", "CreatedAtInSec", "Attempts", "LastError"}
+
+// runFailedCondition is the condition of the runs whose PipelineRuns can't be
+// created, the reason of the failed PipelineRuns.
+const runFailedCondition = "Failed"
+
+func (s *RunStore) createRunSubmission(tx *sql.Tx, submission *model.RunSubmission) error {
+	// The workflow holds the parameters of the run.
+	workflow, err := s.db.Encryptor().EncryptString(submission.Workflow)
+	if err != nil {
+		return util.Wrapf(err, "Failed to encrypt the submission of run %v", submission.RunUUID)
+	}
+	sql, args, err := sq.
+		Insert("run_submissions").
+		SetMap(sq.Eq{
+			"RunUUID":        submission.RunUUID,
+			"Namespace":      submission.Namespace,
+			"Workflow":       workflow,
+			"CreatedAtInSec": submission.CreatedAtInSec,
+			"Attempts":       submission.Attempts,
+			"LastError":      submission.LastError,
+		}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store the submission of run %v", submission.RunUUID)
+	}
+	if _, err := tx.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to store the submission of run %v", submission.RunUUID)
+	}
+	return nil
+}
+
+func (s *RunStore) ListRunSubmissions(createdBeforeInSec int64, limit int) ([]*model.RunSubmission, error) {
+	sql, args, err := sq.
+		Select(runSubmissionColumns...).
+		From("run_submissions").
+		Where(sq.Lt{"CreatedAtInSec": createdBeforeInSec}).
+		OrderBy("CreatedAtInSec").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list run submissions")
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run submissions")
+	}
+	defer rows.Close()
+	var submissions []*model.RunSubmission
+	for rows.Next() {
+		var submission model.RunSubmission
+		err := rows.Scan(&submission.RunUUID, &submission.Namespace, &submission.Workflow,
+			&submission.CreatedAtInSec, &submission.Attempts, &submission.LastError)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to scan run submission")
+		}
+		if submission.Workflow, err = s.db.Encryptor().DecryptString(submission.Workflow); err != nil {
+			return nil, util.Wrapf(err, "Failed to decrypt the submission of run %v", submission.RunUUID)
+		}
+		submissions = append(submissions, &submission)
+	}
+	return submissions, rows.Err()
+}
+
+func (s *RunStore) CountRunSubmissions(namespace string) (int, error) {
+	sql, args, err := sq.Select("COUNT(*)").From("run_submissions").Where(sq.Eq{"Namespace": namespace}).ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to count the run submissions of namespace %v", namespace)
+	}
+	var count int
+	if err := s.db.QueryRow(sql, args...).Scan(&count); err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to count the run submissions of namespace %v", namespace)
+	}
+	return count, nil
+}
+
+func (s *RunStore) UpdateRunSubmission(runId string, lastError string) error {
+	sql, args, err := sq.
+		Update("run_submissions").
+		Set("Attempts", sq.Expr("Attempts + 1")).
+		Set("LastError", lastError).
+		Where(sq.Eq{"RunUUID": runId}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to update the submission of run %v", runId)
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to update the submission of run %v", runId)
+	}
+	return nil
+}
+
+func (s *RunStore) DeleteRunSubmission(runId string) error {
+	sql, args, err := sq.Delete("run_submissions").Where(sq.Eq{"RunUUID": runId}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete the submission of run %v", runId)
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete the submission of run %v", runId)
+	}
+	return nil
+}
+
+func (s *RunStore) FailRunSubmission(runId string, finishedAtInSec int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to fail run %v", runId)
+	}
+	statements := []sq.Sqlizer{
+		sq.Update("run_details").
+			SetMap(sq.Eq{"Conditions": runFailedCondition, "FinishedAtInSec": finishedAtInSec}).
+			Where(sq.Eq{"UUID": runId}),
+		sq.Delete("run_submissions").Where(sq.Eq{"RunUUID": runId}),
+	}
+	for _, statement := range statements {
+		sql, args, err := statement.ToSql()
+		if err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to create query to fail run %v", runId)
+		}
+		if _, err := tx.Exec(sql, args...); err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to fail run %v", runId)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to fail run %v", runId)
+	}
+	return nil
+}
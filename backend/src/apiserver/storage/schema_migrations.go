@@ -0,0 +1,488 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/pkg/errors"
+)
+
+// Migrations are the versions of the database schema, in order. Never change a
+// migration once released: add a new one instead.
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "Create the tables",
+		Up:          createTables,
+		Down:        dropTables,
+	},
+	{
+		Version:     2,
+		Description: "Create the run archive tables",
+		Up:          createRunArchiveTables,
+		Down:        dropRunArchiveTables,
+	},
+	{
+		Version:     3,
+		Description: "Add the soft delete columns",
+		Up:          addSoftDeleteColumns,
+		Down:        dropSoftDeleteColumns,
+	},
+	{
+		Version:     4,
+		Description: "Create the run submission outbox",
+		Up:          createRunSubmissionsTable,
+		Down:        dropRunSubmissionsTable,
+	},
+	{
+		Version:     5,
+		Description: "Create the webhook subscription tables",
+		Up:          createWebhookTables,
+		Down:        dropWebhookTables,
+	},
+	{
+		Version:     6,
+		Description: "Create the namespace default experiments table",
+		Up:          createNamespaceDefaultExperimentsTable,
+		Down:        dropNamespaceDefaultExperimentsTable,
+	},
+	{
+		Version:     7,
+		Description: "Create the experiment defaults table",
+		Up:          createExperimentDefaultsTable,
+		Down:        dropExperimentDefaultsTable,
+	},
+	{
+		Version:     8,
+		Description: "Create the experiment budgets table",
+		Up:          createExperimentBudgetsTable,
+		Down:        dropExperimentBudgetsTable,
+	},
+	{
+		Version:     9,
+		Description: "Keep the tasks of the runs moved to the run archive",
+		Up:          dropTasksForeignKey,
+		Down:        addTasksForeignKey,
+	},
+	{
+		Version:     10,
+		Description: "Keep the names of the soft deleted experiments and pipelines unique among the deleted ones",
+		Up:          addDeletedAtToNameIndexes,
+		Down:        dropDeletedAtFromNameIndexes,
+	},
+}
+
+var models = []interface{}{
+	&model.Experiment{},
+	&model.Job{},
+	&model.Pipeline{},
+	&model.PipelineVersion{},
+	&model.ResourceReference{},
+	&model.RunDetail{},
+	&model.RunMetric{},
+	&model.Task{},
+	&model.DBStatus{},
+	&model.DefaultExperiment{},
+	&model.AuditEvent{},
+	&model.Lease{},
+	&model.ArtifactBlob{},
+	&model.ArtifactReference{},
+	&model.UploadSession{},
+	&model.ArtifactMetadata{},
+}
+
+// createTables creates the tables of the first schema version. On MySQL, it also
+// upgrades the tables created by releases which predate the migrations.
+func createTables(db *DB) error {
+	switch db.SQLDialect.(type) {
+	case PostgreSQLDialect:
+		return execInTransaction(db, postgreSQLSchema)
+	case MySQLDialect:
+		return createMySQLTables(db)
+	default:
+		gormDB, err := openGorm(db)
+		if err != nil {
+			return err
+		}
+		return gormDB.AutoMigrate(models...).Error
+	}
+}
+
+// openGorm wraps the MySQL or SQLite connection in gorm, to create the tables of
+// the models.
+func openGorm(db *DB) (*gorm.DB, error) {
+	if _, ok := db.SQLDialect.(MySQLDialect); ok {
+		return gorm.Open("mysql", db.DB)
+	}
+	return gorm.Open("sqlite3", db.DB)
+}
+
+func createMySQLTables(db *DB) error {
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	if response := gormDB.AutoMigrate(models...); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create the tables")
+	}
+	steps := []struct {
+		description string
+		run         func() *gorm.DB
+	}{
+		// The unique keys on the names were replaced by unique keys on the names and namespaces.
+		{"drop unique key on experiment name", func() *gorm.DB {
+			return gormDB.Model(&model.Experiment{}).RemoveIndex("Name")
+		}},
+		{"drop unique key on pipeline name", func() *gorm.DB {
+			return gormDB.Model(&model.Pipeline{}).RemoveIndex("Name")
+		}},
+		{"update the resource reference payload type", func() *gorm.DB {
+			return gormDB.Model(&model.ResourceReference{}).ModifyColumn("Payload", "longtext not null")
+		}},
+		{"update the pipeline description type", func() *gorm.DB {
+			return gormDB.Model(&model.Pipeline{}).ModifyColumn("Description", "longtext not null")
+		}},
+		{"create index experimentuuid_createatinsec on run_details", func() *gorm.DB {
+			return gormDB.Model(&model.RunDetail{}).AddIndex("experimentuuid_createatinsec", "ExperimentUUID", "CreatedAtInSec")
+		}},
+		{"create index experimentuuid_conditions_finishedatinsec on run_details", func() *gorm.DB {
+			return gormDB.Model(&model.RunDetail{}).AddIndex("experimentuuid_conditions_finishedatinsec", "ExperimentUUID", "Conditions", "FinishedAtInSec")
+		}},
+		{"create index name_namespace_index on pipelines", func() *gorm.DB {
+			return gormDB.Model(&model.Pipeline{}).AddUniqueIndex("name_namespace_index", "Name", "Namespace")
+		}},
+		{"create a foreign key for RunUUID in run_metrics table", func() *gorm.DB {
+			return gormDB.Model(&model.RunMetric{}).
+				AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+		}},
+		{"create a foreign key for PipelineId in pipeline_versions table", func() *gorm.DB {
+			return gormDB.Model(&model.PipelineVersion{}).
+				AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+		}},
+		{"create a foreign key for RunUUID in task table", func() *gorm.DB {
+			return gormDB.Model(&model.Task{}).
+				AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+		}},
+	}
+	for _, step := range steps {
+		if response := step.run(); response.Error != nil {
+			return errors.Wrapf(response.Error, "Failed to %s", step.description)
+		}
+	}
+
+	// If the old unique index idx_pipeline_version_uuid_name on pipeline_versions exists, remove it.
+	rows, err := db.Query(`show index from pipeline_versions where Key_name='idx_pipeline_version_uuid_name'`)
+	if err != nil {
+		return errors.Wrap(err, "Failed to query pipeline_version table's indices")
+	}
+	defer rows.Close()
+	if rows.Next() {
+		if _, err := db.Exec(`drop index idx_pipeline_version_uuid_name on pipeline_versions`); err != nil {
+			return errors.Wrap(err, "Failed to drop index idx_pipeline_version_uuid_name")
+		}
+	}
+	return nil
+}
+
+// dropTables drops the tables of the first schema version, and the data with them.
+func dropTables(db *DB) error {
+	// The tables referencing others are dropped first.
+	tables := []string{
+		"run_metrics", "tasks", "pipeline_versions", "artifact_metadata", "upload_sessions", "artifact_references",
+		"artifact_blobs", "leases", "audit_events", "default_experiments", "db_statuses", "run_details",
+		"resource_references", "pipelines", "jobs", "experiments",
+	}
+	for _, table := range tables {
+		if _, err := db.Exec("DROP TABLE IF EXISTS " + table); err != nil {
+			return errors.Wrapf(err, "Failed to drop table %s", table)
+		}
+	}
+	return nil
+}
+
+// createRunArchiveTables creates the tables the old runs are moved to, which have
+// no foreign keys so that the runs can be moved independently of their tasks.
+func createRunArchiveTables(db *DB) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		return execInTransaction(db, postgreSQLRunArchiveSchema)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	if response := gormDB.AutoMigrate(&model.ArchivedRunDetail{}, &model.ArchivedRunMetric{}); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create the run archive tables")
+	}
+	response := gormDB.Model(&model.ArchivedRunDetail{}).
+		AddIndex("archive_experimentuuid_createatinsec", "ExperimentUUID", "CreatedAtInSec")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create index archive_experimentuuid_createatinsec on run_details_archive")
+	}
+	response = gormDB.Model(&model.ArchivedRunDetail{}).AddIndex("archive_createatinsec", "CreatedAtInSec")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create index archive_createatinsec on run_details_archive")
+	}
+	return nil
+}
+
+func dropRunArchiveTables(db *DB) error {
+	for _, table := range []string{"run_metrics_archive", "run_details_archive"} {
+		if _, err := db.Exec("DROP TABLE IF EXISTS " + table); err != nil {
+			return errors.Wrapf(err, "Failed to drop table %s", table)
+		}
+	}
+	return nil
+}
+
+// softDeleteTables are the tables of the resources which are soft deleted, then
+// purged after a while.
+var softDeleteTables = []string{"pipelines", "experiments", "jobs", "run_details", "run_details_archive"}
+
+func addSoftDeleteColumns(db *DB) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		var statements []string
+		for _, table := range softDeleteTables {
+			statements = append(statements,
+				fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS DeletedAtInSec bigint NOT NULL DEFAULT 0", table))
+		}
+		return execInTransaction(db, statements)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	response := gormDB.AutoMigrate(&model.Pipeline{}, &model.Experiment{}, &model.Job{}, &model.RunDetail{}, &model.ArchivedRunDetail{})
+	return errors.Wrap(response.Error, "Failed to add the soft delete columns")
+}
+
+// dropSoftDeleteColumns drops the soft delete columns, except on SQLite which
+// can't drop columns: the columns are dropped with their tables when reverting
+// the first migration.
+func dropSoftDeleteColumns(db *DB) error {
+	if _, ok := db.SQLDialect.(SQLiteDialect); ok {
+		return nil
+	}
+	for _, table := range softDeleteTables {
+		if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN DeletedAtInSec", table)); err != nil {
+			return errors.Wrapf(err, "Failed to drop the soft delete column of table %s", table)
+		}
+	}
+	return nil
+}
+
+func createRunSubmissionsTable(db *DB) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		return execInTransaction(db, postgreSQLRunSubmissionSchema)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	response := gormDB.AutoMigrate(&model.RunSubmission{})
+	return errors.Wrap(response.Error, "Failed to create the run submission outbox")
+}
+
+func dropRunSubmissionsTable(db *DB) error {
+	_, err := db.Exec("DROP TABLE IF EXISTS run_submissions")
+	return errors.Wrap(err, "Failed to drop table run_submissions")
+}
+
+func createWebhookTables(db *DB) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		return execInTransaction(db, postgreSQLWebhookSchema)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	response := gormDB.AutoMigrate(&model.WebhookSubscription{}, &model.WebhookDelivery{})
+	return errors.Wrap(response.Error, "Failed to create the webhook subscription tables")
+}
+
+func dropWebhookTables(db *DB) error {
+	for _, table := range []string{"webhook_deliveries", "webhook_subscriptions"} {
+		if _, err := db.Exec("DROP TABLE IF EXISTS " + table); err != nil {
+			return errors.Wrapf(err, "Failed to drop table %s", table)
+		}
+	}
+	return nil
+}
+
+func createNamespaceDefaultExperimentsTable(db *DB) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		return execInTransaction(db, postgreSQLNamespaceDefaultExperimentSchema)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	response := gormDB.AutoMigrate(&model.NamespaceDefaultExperiment{})
+	return errors.Wrap(response.Error, "Failed to create the namespace default experiments table")
+}
+
+func dropNamespaceDefaultExperimentsTable(db *DB) error {
+	_, err := db.Exec("DROP TABLE IF EXISTS namespace_default_experiments")
+	return errors.Wrap(err, "Failed to drop table namespace_default_experiments")
+}
+
+func createExperimentDefaultsTable(db *DB) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		return execInTransaction(db, postgreSQLExperimentDefaultsSchema)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	response := gormDB.AutoMigrate(&model.ExperimentDefaults{})
+	return errors.Wrap(response.Error, "Failed to create the experiment defaults table")
+}
+
+func dropExperimentDefaultsTable(db *DB) error {
+	_, err := db.Exec("DROP TABLE IF EXISTS experiment_defaults")
+	return errors.Wrap(err, "Failed to drop table experiment_defaults")
+}
+
+func createExperimentBudgetsTable(db *DB) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		return execInTransaction(db, postgreSQLExperimentBudgetSchema)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	response := gormDB.AutoMigrate(&model.ExperimentBudget{})
+	return errors.Wrap(response.Error, "Failed to create the experiment budgets table")
+}
+
+func dropExperimentBudgetsTable(db *DB) error {
+	_, err := db.Exec("DROP TABLE IF EXISTS experiment_budgets")
+	return errors.Wrap(err, "Failed to drop table experiment_budgets")
+}
+
+// dropTasksForeignKey drops the foreign key of the tasks on run_details, which
+// deleted the tasks of the runs moved to the run archive. SQLite doesn't enforce
+// it.
+func dropTasksForeignKey(db *DB) error {
+	switch db.SQLDialect.(type) {
+	case PostgreSQLDialect:
+		_, err := db.Exec("ALTER TABLE tasks DROP CONSTRAINT IF EXISTS tasks_runuuid_fkey")
+		return errors.Wrap(err, "Failed to drop the foreign key of the tasks")
+	case MySQLDialect:
+		gormDB, err := openGorm(db)
+		if err != nil {
+			return err
+		}
+		response := gormDB.Model(&model.Task{}).RemoveForeignKey("RunUUID", "run_details(UUID)")
+		return errors.Wrap(response.Error, "Failed to drop the foreign key of the tasks")
+	default:
+		return nil
+	}
+}
+
+// addTasksForeignKey adds back the foreign key of the tasks, once the tasks of
+// the runs moved to the run archive are deleted.
+func addTasksForeignKey(db *DB) error {
+	if _, ok := db.SQLDialect.(SQLiteDialect); ok {
+		return nil
+	}
+	if _, err := db.Exec("DELETE FROM tasks WHERE RunUUID NOT IN (SELECT UUID FROM run_details)"); err != nil {
+		return errors.Wrap(err, "Failed to delete the tasks of the archived runs")
+	}
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		_, err := db.Exec("ALTER TABLE tasks ADD CONSTRAINT tasks_runuuid_fkey FOREIGN KEY (RunUUID) " +
+			"REFERENCES run_details (UUID) ON DELETE CASCADE ON UPDATE CASCADE")
+		return errors.Wrap(err, "Failed to add the foreign key of the tasks")
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	response := gormDB.Model(&model.Task{}).
+		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+	return errors.Wrap(response.Error, "Failed to add the foreign key of the tasks")
+}
+
+// nameIndexes are the unique indexes on the names and namespaces of the resources
+// which are soft deleted.
+var nameIndexes = []struct {
+	model interface{}
+	table string
+	name  string
+}{
+	{&model.Experiment{}, "experiments", "idx_name_namespace"},
+	{&model.Pipeline{}, "pipelines", "name_namespace_index"},
+}
+
+// addDeletedAtToNameIndexes adds the deletion time to the unique indexes on the
+// names, so an experiment or pipeline can be created with the name of a soft
+// deleted one.
+func addDeletedAtToNameIndexes(db *DB) error {
+	return replaceNameIndexes(db, "Name", "Namespace", "DeletedAtInSec")
+}
+
+// dropDeletedAtFromNameIndexes restores the unique indexes on the names, which
+// fails while a soft deleted resource shares its name with another one.
+func dropDeletedAtFromNameIndexes(db *DB) error {
+	return replaceNameIndexes(db, "Name", "Namespace")
+}
+
+func replaceNameIndexes(db *DB, columns ...string) error {
+	if _, ok := db.SQLDialect.(PostgreSQLDialect); ok {
+		var statements []string
+		for _, index := range nameIndexes {
+			statements = append(statements,
+				fmt.Sprintf("DROP INDEX IF EXISTS %s", index.name),
+				fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s)", index.name, index.table, strings.Join(columns, ", ")))
+		}
+		return execInTransaction(db, statements)
+	}
+	gormDB, err := openGorm(db)
+	if err != nil {
+		return err
+	}
+	for _, index := range nameIndexes {
+		// SQLite has no unique index on the pipeline names.
+		if !gormDB.Dialect().HasIndex(index.table, index.name) {
+			continue
+		}
+		if response := gormDB.Model(index.model).RemoveIndex(index.name); response.Error != nil {
+			return errors.Wrapf(response.Error, "Failed to drop index %s on %s", index.name, index.table)
+		}
+		if response := gormDB.Model(index.model).AddUniqueIndex(index.name, columns...); response.Error != nil {
+			return errors.Wrapf(response.Error, "Failed to create index %s on %s", index.name, index.table)
+		}
+	}
+	return nil
+}
+
+// execInTransaction runs the statements in a single transaction, which reverts them
+// all on failure where the database supports transactional DDL.
+func execInTransaction(db *DB, statements []string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "Failed to start a transaction")
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			tx.Rollback()
+			return errors.Wrapf(err, "Failed to run statement %q", statement)
+		}
+	}
+	return errors.Wrap(tx.Commit(), "Failed to commit the transaction")
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/minio/minio-go/v6/pkg/encrypt"
+)
+
+const (
+	// SSES3 encrypts objects with keys managed by S3.
+	SSES3 = "SSE-S3"
+	// SSEKMS encrypts objects with keys managed by a KMS.
+	SSEKMS = "SSE-KMS"
+)
+
+// ServerSideEncryption configures how S3 encrypts the objects stored by KFP.
+type ServerSideEncryption struct {
+	// Type is SSES3, SSEKMS, or empty to leave the bucket default.
+	Type string
+	// KMSKeyID is the KMS key of the objects of the namespaces without a key of
+	// their own, and of the objects that belong to no namespace.
+	KMSKeyID string
+	// NamespaceKMSKeyIDs maps namespaces to the KMS key of their objects.
+	NamespaceKMSKeyIDs map[string]string
+}
+
+// Validate checks that every object can be given an encryption key.
+func (e *ServerSideEncryption) Validate() error {
+	switch e.Type {
+	case "", SSES3:
+		if e.KMSKeyID != "" || len(e.NamespaceKMSKeyIDs) > 0 {
+			return fmt.Errorf("KMS key IDs require the %s server side encryption", SSEKMS)
+		}
+	case SSEKMS:
+		if e.KMSKeyID == "" {
+			return fmt.Errorf("%s server side encryption requires a default KMS key ID", SSEKMS)
+		}
+		for namespace, keyID := range e.NamespaceKMSKeyIDs {
+			if keyID == "" {
+				return fmt.Errorf("empty KMS key ID for namespace %s", namespace)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported server side encryption %q, expected %s or %s", e.Type, SSES3, SSEKMS)
+	}
+	return nil
+}
+
+// ForNamespace returns the encryption of the objects of a namespace, or nil
+// when the bucket default applies.
+func (e *ServerSideEncryption) ForNamespace(namespace string) (encrypt.ServerSide, error) {
+	if e == nil {
+		return nil, nil
+	}
+	switch e.Type {
+	case SSES3:
+		return encrypt.NewSSE(), nil
+	case SSEKMS:
+		keyID := e.KMSKeyID
+		if namespaceKeyID, ok := e.NamespaceKMSKeyIDs[namespace]; ok {
+			keyID = namespaceKeyID
+		}
+		return encrypt.NewSSEKMS(keyID, nil)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/minio/minio-go/v6/pkg/encrypt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerSideEncryption_Validate(t *testing.T) {
+	assert.Nil(t, (&ServerSideEncryption{}).Validate())
+	assert.Nil(t, (&ServerSideEncryption{Type: SSES3}).Validate())
+	assert.Nil(t, (&ServerSideEncryption{Type: SSEKMS, KMSKeyID: "default-key"}).Validate())
+	assert.NotNil(t, (&ServerSideEncryption{Type: SSEKMS}).Validate())
+	assert.NotNil(t, (&ServerSideEncryption{Type: SSES3, KMSKeyID: "default-key"}).Validate())
+	assert.NotNil(t, (&ServerSideEncryption{
+		Type:               SSEKMS,
+		KMSKeyID:           "default-key",
+		NamespaceKMSKeyIDs: map[string]string{"ns1": ""},
+	}).Validate())
+	assert.NotNil(t, (&ServerSideEncryption{Type: "SSE-C"}).Validate())
+}
+
+func TestServerSideEncryption_ForNamespace(t *testing.T) {
+	var none *ServerSideEncryption
+	sse, err := none.ForNamespace("ns1")
+	assert.Nil(t, err)
+	assert.Nil(t, sse)
+
+	sse, err = (&ServerSideEncryption{Type: SSES3}).ForNamespace("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, encrypt.S3, sse.Type())
+
+	kms := &ServerSideEncryption{
+		Type:               SSEKMS,
+		KMSKeyID:           "default-key",
+		NamespaceKMSKeyIDs: map[string]string{"ns1": "ns1-key"},
+	}
+	expected, _ := encrypt.NewSSEKMS("ns1-key", nil)
+	sse, err = kms.ForNamespace("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, sse)
+	expected, _ = encrypt.NewSSEKMS("default-key", nil)
+	sse, err = kms.ForNamespace("ns2")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, sse)
+}
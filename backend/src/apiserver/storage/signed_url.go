@@ -0,0 +1,64 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// MaxSignedURLExpiry is the longest validity of a signed URL that S3 and GCS
+// accept.
+const MaxSignedURLExpiry = 7 * 24 * time.Hour
+
+// ErrURLSigningUnsupported is returned by the clients that don't hold the
+// credentials needed to sign URLs.
+var ErrURLSigningUnsupported = errors.New("the object store credentials can't sign URLs")
+
+// ErrComposeUnsupported is returned by the object stores that can't compose
+// files.
+var ErrComposeUnsupported = errors.New("the object store can't compose files")
+
+// SignedURL grants access to an object of the object store without credentials
+// until it expires. The request has to set Headers.
+type SignedURL struct {
+	URL     string
+	Headers map[string]string
+}
+
+// URLSigner signs the URLs of the objects of a bucket.
+type URLSigner interface {
+	SignURL(method, bucketName, objectName string, expiry time.Duration) (*SignedURL, error)
+}
+
+func validateSignedURLRequest(method string, expiry time.Duration) error {
+	if method != http.MethodGet && method != http.MethodPut {
+		return util.NewInvalidInputError("Signed URLs only support the GET and PUT methods, got %q", method)
+	}
+	if expiry <= 0 || expiry > MaxSignedURLExpiry {
+		return util.NewInvalidInputError("The expiry of signed URLs must be positive and at most %v, got %v", MaxSignedURLExpiry, expiry)
+	}
+	return nil
+}
+
+func signedURLError(err error, filePath string) error {
+	if errors.Is(err, ErrURLSigningUnsupported) {
+		return util.NewFailedPreconditionError(err, "Failed to sign a URL for %v", filePath)
+	}
+	return objectStoreError(err, "Failed to sign a URL for %v", filePath)
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The soft deleted pipelines, experiments, jobs and runs are hidden, until they're
+// either restored or purged. Their DeletedAtInSec column is zero unless they're
+// deleted.
+
+// notDeleted filters out the soft deleted rows.
+var notDeleted = sq.Eq{"DeletedAtInSec": 0}
+
+// setDeletedAt soft deletes the row of the table at the time, or restores it when
+// the time is zero. It returns whether the row was found in the expected state.
+func setDeletedAt(db *DB, table string, id string, deletedAtInSec int64) (bool, error) {
+	var state sq.Sqlizer = notDeleted
+	if deletedAtInSec == 0 {
+		state = sq.Gt{"DeletedAtInSec": 0}
+	}
+	sql, args, err := sq.
+		Update(table).
+		SetMap(sq.Eq{"DeletedAtInSec": deletedAtInSec}).
+		Where(sq.And{sq.Eq{"UUID": id}, state}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to create query to soft delete %s from table %s", id, table)
+	}
+	result, err := db.Exec(sql, args...)
+	if err != nil {
+		// The names of the experiments and pipelines are unique among the ones which
+		// aren't deleted, and among the ones deleted at the same time.
+		if db.IsDuplicateError(err) && deletedAtInSec == 0 {
+			return false, util.NewAlreadyExistError(
+				"Failed to restore %s from table %s. Its name is taken by another one, which must be renamed or deleted first.", id, table)
+		}
+		if db.IsDuplicateError(err) {
+			return false, util.NewAlreadyExistError(
+				"Failed to soft delete %s from table %s. Another one of the same name was deleted at the same time, retry later.", id, table)
+		}
+		return false, util.NewInternalServerError(err, "Failed to soft delete %s from table %s", id, table)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to soft delete %s from table %s", id, table)
+	}
+	return updated > 0, nil
+}
+
+// deletedBefore selects the rows soft deleted before the time.
+func deletedBefore(deletedBeforeInSec int64) sq.Sqlizer {
+	return sq.And{sq.Gt{"DeletedAtInSec": 0}, sq.Lt{"DeletedAtInSec": deletedBeforeInSec}}
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+)
+
+// SQLiteDriverName is the name of the database/sql driver for the SQLite
+// database of the standalone mode. Like the MySQL driver, it measures the
+// duration of the queries.
+const SQLiteDriverName = "kfp-sqlite3"
+
+// sqliteBusyTimeoutMs is how long a connection waits for the lock of the
+// database held by another connection.
+const sqliteBusyTimeoutMs = 10000
+
+func init() {
+	sql.Register(SQLiteDriverName, instrumentedDriver{&sqlite3.SQLiteDriver{}})
+}
+
+// SQLiteDSN returns the DSN of a SQLite database file. The transactions take the
+// write lock when they begin, waiting for it, so that the concurrent transactions
+// don't fail to upgrade their read lock.
+func SQLiteDSN(path string) string {
+	return fmt.Sprintf("file:%s?_busy_timeout=%d&_txlock=immediate", path, sqliteBusyTimeoutMs)
+}
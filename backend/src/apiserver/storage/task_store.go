@@ -4,11 +4,11 @@ import (
 	"database/sql"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
-	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	log "github.com/sirupsen/logrus"
 )
 
 const table_name = "tasks"
@@ -142,7 +142,7 @@ func (s *TaskStore) ListTasks(filterContext *common.FilterContext, opts *list.Op
 	// Use a transaction to make sure we're returning the total_size of the same rows queried
 	tx, err := s.db.Begin()
 	if err != nil {
-		glog.Errorf("Failed to start transaction to list tasks")
+		log.Errorf("Failed to start transaction to list tasks")
 		return errorF(err)
 	}
 
@@ -172,7 +172,7 @@ func (s *TaskStore) ListTasks(filterContext *common.FilterContext, opts *list.Op
 
 	err = tx.Commit()
 	if err != nil {
-		glog.Errorf("Failed to commit transaction to list experiments")
+		log.Errorf("Failed to commit transaction to list experiments")
 		return errorF(err)
 	}
 
@@ -180,7 +180,7 @@ func (s *TaskStore) ListTasks(filterContext *common.FilterContext, opts *list.Op
 		return exps, total_size, "", nil
 	}
 
-	npt, err := opts.NextPageToken(exps[opts.PageSize])
+	npt, err := opts.NextPageToken(exps[opts.PageSize-1])
 	return exps[:opts.PageSize], total_size, npt, err
 }
 
@@ -0,0 +1,157 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+var uploadSessionColumns = []string{"UUID", "Namespace", "RunUUID", "FileName", "Size", "UploadedSize", "CreatedAtInSec"}
+
+type UploadSessionStoreInterface interface {
+	CreateUploadSession(session *model.UploadSession) error
+	GetUploadSession(uuid string) (*model.UploadSession, error)
+	// AdvanceUploadSession records that a chunk was uploaded, moving the uploaded
+	// size from the offset of the chunk to its end. It returns false if the
+	// uploaded size is no longer the offset, since another chunk was uploaded
+	// concurrently.
+	AdvanceUploadSession(uuid string, offset int64, end int64) (bool, error)
+	DeleteUploadSession(uuid string) error
+	// ListUploadSessionsCreatedBefore lists the sessions created before a time,
+	// which have expired.
+	ListUploadSessionsCreatedBefore(createdAtInSec int64) ([]*model.UploadSession, error)
+}
+
+type UploadSessionStore struct {
+	db *DB
+}
+
+func (s *UploadSessionStore) CreateUploadSession(session *model.UploadSession) error {
+	sql, args, err := sq.
+		Insert("upload_sessions").
+		SetMap(sq.Eq{
+			"UUID":           session.UUID,
+			"Namespace":      session.Namespace,
+			"RunUUID":        session.RunUUID,
+			"FileName":       session.FileName,
+			"Size":           session.Size,
+			"UploadedSize":   session.UploadedSize,
+			"CreatedAtInSec": session.CreatedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to create upload session %v.", session.UUID)
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to create upload session %v.", session.UUID)
+	}
+	return nil
+}
+
+func (s *UploadSessionStore) GetUploadSession(uuid string) (*model.UploadSession, error) {
+	sql, args, err := sq.Select(uploadSessionColumns...).From("upload_sessions").Where(sq.Eq{"UUID": uuid}).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Error creating query to get upload session %v.", uuid)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get upload session %v.", uuid)
+	}
+	defer rows.Close()
+	sessions, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get upload session %v.", uuid)
+	}
+	if len(sessions) == 0 {
+		return nil, util.NewResourceNotFoundError("UploadSession", uuid)
+	}
+	return sessions[0], nil
+}
+
+func (s *UploadSessionStore) AdvanceUploadSession(uuid string, offset int64, end int64) (bool, error) {
+	sql, args, err := sq.
+		Update("upload_sessions").
+		Set("UploadedSize", end).
+		Where(sq.Eq{"UUID": uuid, "UploadedSize": offset}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Error creating query to advance upload session %v.", uuid)
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to advance upload session %v.", uuid)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to advance upload session %v.", uuid)
+	}
+	return updated > 0, nil
+}
+
+func (s *UploadSessionStore) DeleteUploadSession(uuid string) error {
+	sql, args, err := sq.Delete("upload_sessions").Where(sq.Eq{"UUID": uuid}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to delete upload session %v.", uuid)
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete upload session %v.", uuid)
+	}
+	return nil
+}
+
+func (s *UploadSessionStore) ListUploadSessionsCreatedBefore(createdAtInSec int64) ([]*model.UploadSession, error) {
+	sql, args, err := sq.
+		Select(uploadSessionColumns...).
+		From("upload_sessions").
+		Where(sq.Lt{"CreatedAtInSec": createdAtInSec}).
+		OrderBy("CreatedAtInSec").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Error creating query to list the expired upload sessions.")
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the expired upload sessions.")
+	}
+	defer rows.Close()
+	sessions, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the expired upload sessions.")
+	}
+	return sessions, nil
+}
+
+func (s *UploadSessionStore) scanRows(rows *sql.Rows) ([]*model.UploadSession, error) {
+	var sessions []*model.UploadSession
+	for rows.Next() {
+		var session model.UploadSession
+		err := rows.Scan(&session.UUID, &session.Namespace, &session.RunUUID, &session.FileName,
+			&session.Size, &session.UploadedSize, &session.CreatedAtInSec)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &session)
+	}
+	return sessions, rows.Err()
+}
+
+// factory function for upload session store
+func NewUploadSessionStore(db *DB) *UploadSessionStore {
+	return &UploadSessionStore{db: db}
+}
@@ -0,0 +1,56 @@
+// Copyright 2018 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUploadSessionStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewUploadSessionStore(db)
+
+	assert.Nil(t, store.CreateUploadSession(&model.UploadSession{
+		UUID: "upload1", Namespace: "ns1", FileName: "pipeline.yaml", Size: 10, CreatedAtInSec: 1}))
+	assert.Nil(t, store.CreateUploadSession(&model.UploadSession{
+		UUID: "upload2", Namespace: "ns1", RunUUID: "run1", FileName: "a.tgz", Size: 20, CreatedAtInSec: 2}))
+
+	advanced, err := store.AdvanceUploadSession("upload1", 0, 4)
+	assert.Nil(t, err)
+	assert.True(t, advanced)
+	// A chunk uploaded concurrently at the same offset doesn't advance it again.
+	advanced, err = store.AdvanceUploadSession("upload1", 0, 4)
+	assert.Nil(t, err)
+	assert.False(t, advanced)
+
+	session, err := store.GetUploadSession("upload1")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.UploadSession{
+		UUID: "upload1", Namespace: "ns1", FileName: "pipeline.yaml", Size: 10, UploadedSize: 4, CreatedAtInSec: 1}, session)
+
+	sessions, err := store.ListUploadSessionsCreatedBefore(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.UploadSession{session}, sessions)
+
+	assert.Nil(t, store.DeleteUploadSession("upload1"))
+	_, err = store.GetUploadSession("upload1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
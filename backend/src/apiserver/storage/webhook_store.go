@@ -0,0 +1,319 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+var webhookSubscriptionColumns = []string{"UUID", "Name", "Namespace", "URL", "Secret", "EventTypes", "ExperimentID",
+	"Enabled", "CreatedAtInSec", "UpdatedAtInSec"}
+
+var webhookDeliveryColumns = []string{"UUID", "SubscriptionUUID", "EventID", "EventType", "Payload", "Status",
+	"Attempts", "ResponseCode", "LastError", "NextAttemptAtInSec", "CreatedAtInSec", "DeliveredAtInSec"}
+
+type WebhookStoreInterface interface {
+	CreateWebhookSubscription(subscription *model.WebhookSubscription) error
+	GetWebhookSubscription(uuid string) (*model.WebhookSubscription, error)
+	// ListWebhookSubscriptions lists the subscriptions of a namespace, or all of
+	// them when the namespace is empty.
+	ListWebhookSubscriptions(namespace string) ([]*model.WebhookSubscription, error)
+	// ListEnabledWebhookSubscriptions lists the subscriptions the events are
+	// delivered to.
+	ListEnabledWebhookSubscriptions() ([]*model.WebhookSubscription, error)
+	UpdateWebhookSubscription(subscription *model.WebhookSubscription) error
+	// DeleteWebhookSubscription deletes a subscription and its deliveries.
+	DeleteWebhookSubscription(uuid string) error
+
+	CreateWebhookDeliveries(deliveries []*model.WebhookDelivery) error
+	// ListDueWebhookDeliveries lists up to limit pending deliveries whose next
+	// attempt is due at the time, the oldest first.
+	ListDueWebhookDeliveries(nowInSec int64, limit int) ([]*model.WebhookDelivery, error)
+	// UpdateWebhookDelivery records an attempt of a delivery.
+	UpdateWebhookDelivery(delivery *model.WebhookDelivery) error
+	// ListWebhookDeliveries lists up to limit deliveries of a subscription, in
+	// the status unless it's empty, the latest first.
+	ListWebhookDeliveries(subscriptionUUID string, status string, limit int) ([]*model.WebhookDelivery, error)
+	// DeleteWebhookDeliveriesCreatedBefore deletes the deliveries which are no
+	// longer pending, created before the time.
+	DeleteWebhookDeliveriesCreatedBefore(createdBeforeInSec int64) (int64, error)
+}
+
+type WebhookStore struct {
+	db *DB
+}
+
+func (s *WebhookStore) CreateWebhookSubscription(subscription *model.WebhookSubscription) error {
+	secret, err := s.db.Encryptor().EncryptString(subscription.Secret)
+	if err != nil {
+		return util.Wrapf(err, "Failed to encrypt the secret of webhook subscription %v", subscription.UUID)
+	}
+	sql, args, err := sq.
+		Insert("webhook_subscriptions").
+		SetMap(sq.Eq{
+			"UUID":           subscription.UUID,
+			"Name":           subscription.Name,
+			"Namespace":      subscription.Namespace,
+			"URL":            subscription.URL,
+			"Secret":         secret,
+			"EventTypes":     subscription.EventTypes,
+			"ExperimentID":   subscription.ExperimentID,
+			"Enabled":        subscription.Enabled,
+			"CreatedAtInSec": subscription.CreatedAtInSec,
+			"UpdatedAtInSec": subscription.UpdatedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to create webhook subscription %v.", subscription.UUID)
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to create webhook subscription %v.", subscription.UUID)
+	}
+	return nil
+}
+
+func (s *WebhookStore) GetWebhookSubscription(uuid string) (*model.WebhookSubscription, error) {
+	subscriptions, err := s.listWebhookSubscriptions(sq.Eq{"UUID": uuid})
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get webhook subscription %v", uuid)
+	}
+	if len(subscriptions) == 0 {
+		return nil, util.NewResourceNotFoundError("WebhookSubscription", uuid)
+	}
+	return subscriptions[0], nil
+}
+
+func (s *WebhookStore) ListWebhookSubscriptions(namespace string) ([]*model.WebhookSubscription, error) {
+	if namespace == "" {
+		return s.listWebhookSubscriptions(nil)
+	}
+	return s.listWebhookSubscriptions(sq.Eq{"Namespace": namespace})
+}
+
+func (s *WebhookStore) ListEnabledWebhookSubscriptions() ([]*model.WebhookSubscription, error) {
+	return s.listWebhookSubscriptions(sq.Eq{"Enabled": true})
+}
+
+// listWebhookSubscriptions lists the subscriptions matching the condition, or all
+// of them when it's nil.
+func (s *WebhookStore) listWebhookSubscriptions(where sq.Sqlizer) ([]*model.WebhookSubscription, error) {
+	query := sq.Select(webhookSubscriptionColumns...).From("webhook_subscriptions")
+	if where != nil {
+		query = query.Where(where)
+	}
+	sql, args, err := query.OrderBy("CreatedAtInSec", "UUID").ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Error creating query to list webhook subscriptions.")
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list webhook subscriptions.")
+	}
+	defer rows.Close()
+	subscriptions, err := s.scanSubscriptionRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list webhook subscriptions.")
+	}
+	return subscriptions, nil
+}
+
+func (s *WebhookStore) UpdateWebhookSubscription(subscription *model.WebhookSubscription) error {
+	secret, err := s.db.Encryptor().EncryptString(subscription.Secret)
+	if err != nil {
+		return util.Wrapf(err, "Failed to encrypt the secret of webhook subscription %v", subscription.UUID)
+	}
+	sql, args, err := sq.
+		Update("webhook_subscriptions").
+		SetMap(sq.Eq{
+			"Name":           subscription.Name,
+			"URL":            subscription.URL,
+			"Secret":         secret,
+			"EventTypes":     subscription.EventTypes,
+			"ExperimentID":   subscription.ExperimentID,
+			"Enabled":        subscription.Enabled,
+			"UpdatedAtInSec": subscription.UpdatedAtInSec,
+		}).
+		Where(sq.Eq{"UUID": subscription.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to update webhook subscription %v.", subscription.UUID)
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to update webhook subscription %v.", subscription.UUID)
+	}
+	return nil
+}
+
+func (s *WebhookStore) DeleteWebhookSubscription(uuid string) error {
+	deliverySql, deliveryArgs, err := sq.Delete("webhook_deliveries").Where(sq.Eq{"SubscriptionUUID": uuid}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to delete the deliveries of webhook subscription %v.", uuid)
+	}
+	subscriptionSql, subscriptionArgs, err := sq.Delete("webhook_subscriptions").Where(sq.Eq{"UUID": uuid}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to delete webhook subscription %v.", uuid)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to delete webhook subscription %v.", uuid)
+	}
+	if _, err = tx.Exec(deliverySql, deliveryArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete the deliveries of webhook subscription %v.", uuid)
+	}
+	if _, err = tx.Exec(subscriptionSql, subscriptionArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete webhook subscription %v.", uuid)
+	}
+	if err = tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete webhook subscription %v.", uuid)
+	}
+	return nil
+}
+
+func (s *WebhookStore) CreateWebhookDeliveries(deliveries []*model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	insert := sq.Insert("webhook_deliveries").Columns(webhookDeliveryColumns...)
+	for _, delivery := range deliveries {
+		insert = insert.Values(delivery.UUID, delivery.SubscriptionUUID, delivery.EventID, delivery.EventType,
+			delivery.Payload, delivery.Status, delivery.Attempts, delivery.ResponseCode, delivery.LastError,
+			delivery.NextAttemptAtInSec, delivery.CreatedAtInSec, delivery.DeliveredAtInSec)
+	}
+	sql, args, err := insert.ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to create webhook deliveries.")
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to create webhook deliveries.")
+	}
+	return nil
+}
+
+func (s *WebhookStore) ListDueWebhookDeliveries(nowInSec int64, limit int) ([]*model.WebhookDelivery, error) {
+	return s.listWebhookDeliveries(
+		sq.And{sq.Eq{"Status": model.WebhookDeliveryPending}, sq.LtOrEq{"NextAttemptAtInSec": nowInSec}},
+		"NextAttemptAtInSec", limit)
+}
+
+func (s *WebhookStore) ListWebhookDeliveries(subscriptionUUID string, status string, limit int) ([]*model.WebhookDelivery, error) {
+	where := sq.And{sq.Eq{"SubscriptionUUID": subscriptionUUID}}
+	if status != "" {
+		where = append(where, sq.Eq{"Status": status})
+	}
+	return s.listWebhookDeliveries(where, "CreatedAtInSec DESC", limit)
+}
+
+func (s *WebhookStore) listWebhookDeliveries(where sq.Sqlizer, orderBy string, limit int) ([]*model.WebhookDelivery, error) {
+	sql, args, err := sq.
+		Select(webhookDeliveryColumns...).
+		From("webhook_deliveries").
+		Where(where).
+		OrderBy(orderBy).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Error creating query to list webhook deliveries.")
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list webhook deliveries.")
+	}
+	defer rows.Close()
+	var deliveries []*model.WebhookDelivery
+	for rows.Next() {
+		var delivery model.WebhookDelivery
+		err := rows.Scan(&delivery.UUID, &delivery.SubscriptionUUID, &delivery.EventID, &delivery.EventType,
+			&delivery.Payload, &delivery.Status, &delivery.Attempts, &delivery.ResponseCode, &delivery.LastError,
+			&delivery.NextAttemptAtInSec, &delivery.CreatedAtInSec, &delivery.DeliveredAtInSec)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to list webhook deliveries.")
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list webhook deliveries.")
+	}
+	return deliveries, nil
+}
+
+func (s *WebhookStore) UpdateWebhookDelivery(delivery *model.WebhookDelivery) error {
+	sql, args, err := sq.
+		Update("webhook_deliveries").
+		SetMap(sq.Eq{
+			"Status":             delivery.Status,
+			"Attempts":           delivery.Attempts,
+			"ResponseCode":       delivery.ResponseCode,
+			"LastError":          delivery.LastError,
+			"NextAttemptAtInSec": delivery.NextAttemptAtInSec,
+			"DeliveredAtInSec":   delivery.DeliveredAtInSec,
+		}).
+		Where(sq.Eq{"UUID": delivery.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error creating query to update webhook delivery %v.", delivery.UUID)
+	}
+	if _, err = s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to update webhook delivery %v.", delivery.UUID)
+	}
+	return nil
+}
+
+func (s *WebhookStore) DeleteWebhookDeliveriesCreatedBefore(createdBeforeInSec int64) (int64, error) {
+	sql, args, err := sq.
+		Delete("webhook_deliveries").
+		Where(sq.And{sq.NotEq{"Status": model.WebhookDeliveryPending}, sq.Lt{"CreatedAtInSec": createdBeforeInSec}}).
+		ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Error creating query to delete the old webhook deliveries.")
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to delete the old webhook deliveries.")
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to delete the old webhook deliveries.")
+	}
+	return deleted, nil
+}
+
+func (s *WebhookStore) scanSubscriptionRows(rows *sql.Rows) ([]*model.WebhookSubscription, error) {
+	var subscriptions []*model.WebhookSubscription
+	for rows.Next() {
+		var subscription model.WebhookSubscription
+		err := rows.Scan(&subscription.UUID, &subscription.Name, &subscription.Namespace, &subscription.URL,
+			&subscription.Secret, &subscription.EventTypes, &subscription.ExperimentID, &subscription.Enabled,
+			&subscription.CreatedAtInSec, &subscription.UpdatedAtInSec)
+		if err != nil {
+			return nil, err
+		}
+		if subscription.Secret, err = s.db.Encryptor().DecryptString(subscription.Secret); err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, &subscription)
+	}
+	return subscriptions, rows.Err()
+}
+
+// factory function for webhook store
+func NewWebhookStore(db *DB) *WebhookStore {
+	return &WebhookStore{db: db}
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestWebhookStore_Subscriptions(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	kek, err := NewLocalKeyEncryptionKey(bytes.Repeat([]byte("k"), 32))
+	assert.Nil(t, err)
+	encryptor, err := NewEncryptor(kek)
+	assert.Nil(t, err)
+	db.SetEncryptor(encryptor)
+	store := NewWebhookStore(db)
+
+	subscription := &model.WebhookSubscription{UUID: "webhook1", Name: "ci", Namespace: "ns1",
+		URL: "https://ci.example.com/hook", Secret: "s3cr3t", EventTypes: "run.finished, run.created",
+		Enabled: true, CreatedAtInSec: 1, UpdatedAtInSec: 1}
+	assert.Nil(t, store.CreateWebhookSubscription(subscription))
+	assert.Nil(t, store.CreateWebhookSubscription(&model.WebhookSubscription{UUID: "webhook2", Name: "disabled",
+		Namespace: "ns2", URL: "https://other.example.com/hook", CreatedAtInSec: 2, UpdatedAtInSec: 2}))
+
+	// The secret is encrypted at rest.
+	var storedSecret string
+	assert.Nil(t, db.QueryRow("SELECT Secret FROM webhook_subscriptions WHERE UUID = 'webhook1'").Scan(&storedSecret))
+	assert.NotContains(t, storedSecret, "s3cr3t")
+
+	fetched, err := store.GetWebhookSubscription("webhook1")
+	assert.Nil(t, err)
+	assert.Equal(t, subscription, fetched)
+	assert.Equal(t, []string{"run.finished", "run.created"}, fetched.EventTypeList())
+
+	subscriptions, err := store.ListWebhookSubscriptions("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.WebhookSubscription{subscription}, subscriptions)
+	subscriptions, err = store.ListWebhookSubscriptions("")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(subscriptions))
+	subscriptions, err = store.ListEnabledWebhookSubscriptions()
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.WebhookSubscription{subscription}, subscriptions)
+
+	subscription.Enabled = false
+	subscription.UpdatedAtInSec = 3
+	assert.Nil(t, store.UpdateWebhookSubscription(subscription))
+	subscriptions, err = store.ListEnabledWebhookSubscriptions()
+	assert.Nil(t, err)
+	assert.Empty(t, subscriptions)
+
+	assert.Nil(t, store.DeleteWebhookSubscription("webhook1"))
+	_, err = store.GetWebhookSubscription("webhook1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestWebhookStore_Deliveries(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewWebhookStore(db)
+	assert.Nil(t, store.CreateWebhookSubscription(&model.WebhookSubscription{UUID: "webhook1", URL: "https://ci.example.com/hook"}))
+
+	assert.Nil(t, store.CreateWebhookDeliveries([]*model.WebhookDelivery{
+		{UUID: "delivery1", SubscriptionUUID: "webhook1", EventID: "event1", EventType: "run.created", Payload: "{}",
+			Status: model.WebhookDeliveryPending, NextAttemptAtInSec: 1, CreatedAtInSec: 1},
+		{UUID: "delivery2", SubscriptionUUID: "webhook1", EventID: "event2", EventType: "run.finished", Payload: "{}",
+			Status: model.WebhookDeliveryPending, NextAttemptAtInSec: 5, CreatedAtInSec: 2},
+	}))
+
+	due, err := store.ListDueWebhookDeliveries(3, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(due))
+	assert.Equal(t, "delivery1", due[0].UUID)
+
+	due[0].Status = model.WebhookDeliverySucceeded
+	due[0].Attempts = 1
+	due[0].ResponseCode = 200
+	due[0].DeliveredAtInSec = 3
+	assert.Nil(t, store.UpdateWebhookDelivery(due[0]))
+	due, err = store.ListDueWebhookDeliveries(5, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(due))
+	assert.Equal(t, "delivery2", due[0].UUID)
+
+	// The latest deliveries are listed first.
+	deliveries, err := store.ListWebhookDeliveries("webhook1", "", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"delivery2", "delivery1"}, []string{deliveries[0].UUID, deliveries[1].UUID})
+	assert.Equal(t, int64(200), deliveries[1].ResponseCode)
+	deliveries, err = store.ListWebhookDeliveries("webhook1", model.WebhookDeliverySucceeded, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(deliveries))
+
+	// The pending deliveries are kept.
+	deleted, err := store.DeleteWebhookDeliveriesCreatedBefore(10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	assert.Nil(t, store.DeleteWebhookSubscription("webhook1"))
+	deliveries, err = store.ListWebhookDeliveries("webhook1", "", 10)
+	assert.Nil(t, err)
+	assert.Empty(t, deliveries)
+}
@@ -0,0 +1,280 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+type WarningCode string
+
+const (
+	WarningUnboundParameter  WarningCode = "UNBOUND_PARAMETER"
+	WarningUndeclaredResult  WarningCode = "UNDECLARED_RESULT"
+	WarningMissingImage      WarningCode = "MISSING_IMAGE"
+	WarningDependencyCycle   WarningCode = "DEPENDENCY_CYCLE"
+	WarningUnknownDependency WarningCode = "UNKNOWN_DEPENDENCY"
+)
+
+// Warning is a problem found by static analysis of a template. Warnings don't
+// block pipeline creation, since the template may still run (e.g. the missing
+// value is provided by a custom task controller), but they are stored with the
+// pipeline version and reported when a run is created from it.
+type Warning struct {
+	Code    WarningCode `json:"code"`
+	Task    string      `json:"task,omitempty"`
+	Message string      `json:"message"`
+}
+
+var (
+	paramReferenceRegex  = regexp.MustCompile(`\$\((?:inputs\.)?params\.([^.)\[\]\s]+)`)
+	resultReferenceRegex = regexp.MustCompile(`\$\(tasks\.([^.)\s]+)\.results\.([^.)\[\]\s]+)`)
+)
+
+// MarshalWarnings encodes warnings for storage. No warnings is stored as an empty string.
+func MarshalWarnings(warnings []Warning) (string, error) {
+	if len(warnings) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(warnings)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// UnmarshalWarnings decodes warnings previously encoded by MarshalWarnings.
+func UnmarshalWarnings(warnings string) ([]Warning, error) {
+	if warnings == "" {
+		return nil, nil
+	}
+	var result []Warning
+	if err := json.Unmarshal([]byte(warnings), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// analyzePipelineRun flags unbound parameters, results referenced but not declared
+// by the producing task, steps without images and dependency cycles in an inline
+// pipeline spec. PipelineRuns that reference a Pipeline by name are not analyzed.
+func analyzePipelineRun(pr *workflowapi.PipelineRun) []Warning {
+	if pr == nil || pr.Spec.PipelineSpec == nil {
+		return nil
+	}
+	spec := pr.Spec.PipelineSpec
+	var warnings []Warning
+
+	suppliedParams := make(map[string]bool)
+	for _, param := range pr.Spec.Params {
+		suppliedParams[param.Name] = true
+	}
+	declaredParams := make(map[string]bool)
+	for _, param := range spec.Params {
+		declaredParams[param.Name] = true
+		if param.Default == nil && !suppliedParams[param.Name] {
+			warnings = append(warnings, Warning{
+				Code:    WarningUnboundParameter,
+				Message: fmt.Sprintf("pipeline parameter %q has no default value and is not set by the PipelineRun", param.Name),
+			})
+		}
+	}
+
+	tasks := make(map[string]*workflowapi.PipelineTask)
+	allTasks := append(append([]workflowapi.PipelineTask{}, spec.Tasks...), spec.Finally...)
+	for i := range allTasks {
+		tasks[allTasks[i].Name] = &allTasks[i]
+	}
+
+	dependencies := make(map[string][]string)
+	for i := range allTasks {
+		task := &allTasks[i]
+		references := referencesOf(task.Params, task.When)
+		for _, name := range paramReferenceRegex.FindAllStringSubmatch(references, -1) {
+			if !declaredParams[name[1]] {
+				warnings = append(warnings, Warning{
+					Code:    WarningUnboundParameter,
+					Task:    task.Name,
+					Message: fmt.Sprintf("parameter %q is referenced but not declared by the pipeline", name[1]),
+				})
+			}
+		}
+		for _, match := range resultReferenceRegex.FindAllStringSubmatch(references, -1) {
+			producer, result := match[1], match[2]
+			producerTask, ok := tasks[producer]
+			if !ok {
+				warnings = append(warnings, Warning{
+					Code:    WarningUndeclaredResult,
+					Task:    task.Name,
+					Message: fmt.Sprintf("result %q is referenced from task %q which does not exist", result, producer),
+				})
+				continue
+			}
+			if !declaresResult(producerTask, result) {
+				warnings = append(warnings, Warning{
+					Code:    WarningUndeclaredResult,
+					Task:    task.Name,
+					Message: fmt.Sprintf("result %q is not declared by task %q", result, producer),
+				})
+			}
+			dependencies[task.Name] = append(dependencies[task.Name], producer)
+		}
+		for _, upstream := range task.RunAfter {
+			if _, ok := tasks[upstream]; !ok {
+				warnings = append(warnings, Warning{
+					Code:    WarningUnknownDependency,
+					Task:    task.Name,
+					Message: fmt.Sprintf("runAfter references task %q which does not exist", upstream),
+				})
+				continue
+			}
+			dependencies[task.Name] = append(dependencies[task.Name], upstream)
+		}
+		warnings = append(warnings, analyzeEmbeddedTask(task)...)
+	}
+
+	if cycle := findCycle(allTasks, dependencies); cycle != nil {
+		warnings = append(warnings, Warning{
+			Code:    WarningDependencyCycle,
+			Task:    cycle[0],
+			Message: fmt.Sprintf("tasks form a dependency cycle: %v", cycle),
+		})
+	}
+	return warnings
+}
+
+// analyzeEmbeddedTask checks the steps of an inline task spec. Custom tasks and
+// tasks referenced by name are skipped since their specs aren't part of the template.
+func analyzeEmbeddedTask(task *workflowapi.PipelineTask) []Warning {
+	if task.TaskSpec == nil || task.TaskSpec.Kind != "" || len(task.TaskSpec.Steps) == 0 {
+		return nil
+	}
+	var warnings []Warning
+	defaultImage := ""
+	if task.TaskSpec.StepTemplate != nil {
+		defaultImage = task.TaskSpec.StepTemplate.Image
+	}
+	for _, step := range task.TaskSpec.Steps {
+		if step.Image == "" && defaultImage == "" {
+			warnings = append(warnings, Warning{
+				Code:    WarningMissingImage,
+				Task:    task.Name,
+				Message: fmt.Sprintf("step %q does not specify an image", step.Name),
+			})
+		}
+	}
+
+	declared := make(map[string]bool)
+	for _, param := range task.TaskSpec.Params {
+		declared[param.Name] = true
+	}
+	supplied := make(map[string]bool)
+	for _, param := range task.Params {
+		supplied[param.Name] = true
+	}
+	for _, param := range task.TaskSpec.Params {
+		if param.Default == nil && !supplied[param.Name] {
+			warnings = append(warnings, Warning{
+				Code:    WarningUnboundParameter,
+				Task:    task.Name,
+				Message: fmt.Sprintf("task parameter %q has no default value and is not set by the pipeline", param.Name),
+			})
+		}
+	}
+	seen := make(map[string]bool)
+	for _, name := range paramReferenceRegex.FindAllStringSubmatch(referencesOf(task.TaskSpec.Steps), -1) {
+		if !declared[name[1]] && !seen[name[1]] {
+			seen[name[1]] = true
+			warnings = append(warnings, Warning{
+				Code:    WarningUnboundParameter,
+				Task:    task.Name,
+				Message: fmt.Sprintf("parameter %q is referenced by a step but not declared by the task", name[1]),
+			})
+		}
+	}
+	return warnings
+}
+
+// declaresResult returns whether the task declares the result. Tasks whose specs
+// aren't embedded in the template are assumed to declare it.
+func declaresResult(task *workflowapi.PipelineTask, result string) bool {
+	if task.TaskSpec == nil || task.TaskSpec.Kind != "" {
+		return true
+	}
+	for _, declared := range task.TaskSpec.Results {
+		if declared.Name == result {
+			return true
+		}
+	}
+	return false
+}
+
+// referencesOf serializes the given fields so variable references can be found
+// regardless of where they appear (string, array or object values, when expressions).
+func referencesOf(fields ...interface{}) string {
+	bytes, err := json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+	return string(bytes)
+}
+
+// findCycle returns the tasks of the first dependency cycle found, or nil.
+func findCycle(tasks []workflowapi.PipelineTask, dependencies map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		path = append(path, name)
+		upstreams := append([]string{}, dependencies[name]...)
+		sort.Strings(upstreams)
+		for _, upstream := range upstreams {
+			switch state[upstream] {
+			case visiting:
+				for i := range path {
+					if path[i] == upstream {
+						return append(append([]string{}, path[i:]...), upstream)
+					}
+				}
+			case unvisited:
+				if cycle := visit(upstream); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, task := range tasks {
+		if state[task.Name] == unvisited {
+			if cycle := visit(task.Name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var analyzerTemplate = `
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: analyzer
+spec:
+  params:
+  - name: message
+    value: hello
+  pipelineSpec:
+    params:
+    - name: message
+    - name: unset
+    tasks:
+    - name: producer
+      params:
+      - name: text
+        value: $(params.message)
+      taskSpec:
+        params:
+        - name: text
+        results:
+        - name: output
+        steps:
+        - name: main
+          image: busybox
+          script: echo $(params.text) $(params.typo) > $(results.output.path)
+    - name: consumer
+      params:
+      - name: input
+        value: $(tasks.producer.results.missing)
+      - name: other
+        value: $(params.undeclared)
+      taskSpec:
+        params:
+        - name: input
+        - name: other
+        steps:
+        - name: main
+          script: echo $(params.input)
+`
+
+var cyclicTemplate = `
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: cyclic
+spec:
+  pipelineSpec:
+    tasks:
+    - name: a
+      runAfter: [c]
+      taskRef:
+        name: task
+    - name: b
+      runAfter: [a]
+      taskRef:
+        name: task
+    - name: c
+      params:
+      - name: input
+        value: $(tasks.b.results.output)
+      taskRef:
+        name: task
+    - name: d
+      runAfter: [unknown]
+      taskRef:
+        name: task
+`
+
+func TestAnalyze(t *testing.T) {
+	tmpl, err := New([]byte(analyzerTemplate))
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []Warning{
+		{Code: WarningUnboundParameter, Message: `pipeline parameter "unset" has no default value and is not set by the PipelineRun`},
+		{Code: WarningUnboundParameter, Task: "producer", Message: `parameter "typo" is referenced by a step but not declared by the task`},
+		{Code: WarningUndeclaredResult, Task: "consumer", Message: `result "missing" is not declared by task "producer"`},
+		{Code: WarningUnboundParameter, Task: "consumer", Message: `parameter "undeclared" is referenced but not declared by the pipeline`},
+		{Code: WarningMissingImage, Task: "consumer", Message: `step "main" does not specify an image`},
+	}, tmpl.Analyze())
+}
+
+func TestAnalyze_Cycle(t *testing.T) {
+	tmpl, err := New([]byte(cyclicTemplate))
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []Warning{
+		{Code: WarningUnknownDependency, Task: "d", Message: `runAfter references task "unknown" which does not exist`},
+		{Code: WarningDependencyCycle, Task: "a", Message: "tasks form a dependency cycle: [a c b a]"},
+	}, tmpl.Analyze())
+}
+
+func TestAnalyze_NoPipelineSpec(t *testing.T) {
+	tmpl, err := New([]byte("apiVersion: tekton.dev/v1\nkind: PipelineRun\nmetadata:\n  name: ref\nspec:\n  pipelineRef:\n    name: p\n"))
+	assert.Nil(t, err)
+	assert.Empty(t, tmpl.Analyze())
+}
+
+func TestMarshalWarnings(t *testing.T) {
+	warnings := []Warning{{Code: WarningMissingImage, Task: "t", Message: "m"}}
+	encoded, err := MarshalWarnings(warnings)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"code":"MISSING_IMAGE","task":"t","message":"m"}]`, encoded)
+	decoded, err := UnmarshalWarnings(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, warnings, decoded)
+
+	encoded, err = MarshalWarnings(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "", encoded)
+}
@@ -0,0 +1,459 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ArgoVersion     = "argoproj.io/v1alpha1"
+	ArgoK8sResource = "Workflow"
+
+	// The directory the input artifacts of compiled Argo templates are written to.
+	// Unlike the container's file system, it's shared by the steps of a task.
+	argoInputArtifactsDir = "/tekton/home/inputs"
+)
+
+var (
+	argoPlaceholderRegex = regexp.MustCompile(`\{\{[^}]*\}\}`)
+	argoConditionRegex   = regexp.MustCompile(`^(.+?)\s*(==|!=)\s*(.+)$`)
+	argoArtifactRefRegex = regexp.MustCompile(`^\{\{(?:tasks|steps)\.([^.}]+)\.outputs\.artifacts\.([^.}]+)\}\}$`)
+)
+
+// The subset of the Argo Workflow spec compiled to Tekton, i.e. the one of the
+// workflows compiled by the KFP v1 SDK. Only the fields read are declared, as the
+// server doesn't depend on Argo.
+type argoWorkflow struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        metav1.ObjectMeta `json:"metadata"`
+	Spec            struct {
+		Entrypoint         string          `json:"entrypoint"`
+		Arguments          argoArguments   `json:"arguments"`
+		Templates          []argoTemplate  `json:"templates"`
+		Volumes            []corev1.Volume `json:"volumes"`
+		ServiceAccountName string          `json:"serviceAccountName"`
+	} `json:"spec"`
+}
+
+type argoArguments struct {
+	Parameters []argoParameter `json:"parameters"`
+	Artifacts  []argoArtifact  `json:"artifacts"`
+}
+
+type argoParameter struct {
+	Name      string      `json:"name"`
+	Value     *argoString `json:"value"`
+	Default   *argoString `json:"default"`
+	ValueFrom *struct {
+		Path string `json:"path"`
+	} `json:"valueFrom"`
+}
+
+type argoArtifact struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	From string `json:"from"`
+}
+
+type argoTemplate struct {
+	Name      string            `json:"name"`
+	Inputs    argoArguments     `json:"inputs"`
+	Outputs   argoArguments     `json:"outputs"`
+	Container *corev1.Container `json:"container"`
+	Script    *struct {
+		corev1.Container `json:",inline"`
+		Source           string `json:"source"`
+	} `json:"script"`
+	DAG *struct {
+		Tasks []argoTask `json:"tasks"`
+	} `json:"dag"`
+	Steps    [][]argoTask `json:"steps"`
+	Metadata struct {
+		Annotations map[string]string `json:"annotations"`
+		Labels      map[string]string `json:"labels"`
+	} `json:"metadata"`
+}
+
+type argoTask struct {
+	Name         string          `json:"name"`
+	Template     string          `json:"template"`
+	Arguments    argoArguments   `json:"arguments"`
+	Dependencies []string        `json:"dependencies"`
+	When         string          `json:"when"`
+	WithItems    json.RawMessage `json:"withItems"`
+	WithParam    string          `json:"withParam"`
+}
+
+// argoString is a parameter value, which Argo allows to be a YAML number or bool.
+type argoString string
+
+func (s *argoString) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if str, ok := value.(string); ok {
+		*s = argoString(str)
+	} else {
+		*s = argoString(data)
+	}
+	return nil
+}
+
+// isArgoWorkflow returns whether template is an Argo Workflow.
+func isArgoWorkflow(template []byte) bool {
+	var meta metav1.TypeMeta
+	if err := yaml.Unmarshal(template, &meta); err != nil {
+		return false
+	}
+	return meta.APIVersion == ArgoVersion && meta.Kind == ArgoK8sResource
+}
+
+// compileArgo compiles an Argo Workflow to a Tekton PipelineRun. The tasks of the
+// entrypoint's DAG, or its steps, are compiled to pipeline tasks running the
+// container or script of their template. Output parameters are compiled to results,
+// output artifacts to results tracked by the artifact_items annotation, and input
+// artifacts to files written from params. Nested DAGs and loops aren't supported.
+func compileArgo(template []byte) (*workflowapi.PipelineRun, error) {
+	var wf argoWorkflow
+	if err := yaml.Unmarshal(template, &wf); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the Argo Workflow.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	templates := make(map[string]*argoTemplate)
+	for i := range wf.Spec.Templates {
+		templates[wf.Spec.Templates[i].Name] = &wf.Spec.Templates[i]
+	}
+	entrypoint, ok := templates[wf.Spec.Entrypoint]
+	if !ok {
+		return nil, util.NewInvalidInputError("The entrypoint %q of the Argo Workflow isn't a template.", wf.Spec.Entrypoint).WithReason(util.ReasonInvalidPipelineSpec)
+	}
+
+	name := wf.Metadata.Name
+	if name == "" {
+		name = wf.Metadata.GenerateName
+	}
+	pr := newCompiledPipelineRun(name)
+	pr.Spec.TaskRunTemplate.ServiceAccountName = wf.Spec.ServiceAccountName
+	for _, param := range wf.Spec.Arguments.Parameters {
+		addPipelineParam(pr, param.Name, (*string)(param.Value))
+	}
+
+	var tasks [][]argoTask
+	switch {
+	case entrypoint.DAG != nil:
+		tasks = [][]argoTask{entrypoint.DAG.Tasks}
+	case len(entrypoint.Steps) > 0:
+		tasks = entrypoint.Steps
+	default:
+		task := argoTask{Name: entrypoint.Name, Template: entrypoint.Name}
+		for _, param := range wf.Spec.Arguments.Parameters {
+			task.Arguments.Parameters = append(task.Arguments.Parameters, argoParameter{Name: param.Name, Value: argoStringPointer(fmt.Sprintf("{{workflow.parameters.%s}}", param.Name))})
+		}
+		tasks = [][]argoTask{{task}}
+	}
+
+	artifactItems := make(map[string][][]string)
+	var previous []string
+	for _, group := range tasks {
+		var names []string
+		for _, task := range group {
+			pipelineTask, artifacts, err := wf.compileTask(task, templates)
+			if err != nil {
+				return nil, util.Wrapf(err, "Failed to compile task %q", task.Name)
+			}
+			// Steps run after all the steps of the previous group.
+			if len(tasks) > 1 {
+				pipelineTask.RunAfter = append(pipelineTask.RunAfter, previous...)
+			}
+			if len(artifacts) > 0 {
+				artifactItems[pipelineTask.Name] = artifacts
+			}
+			pr.Spec.PipelineSpec.Tasks = append(pr.Spec.PipelineSpec.Tasks, *pipelineTask)
+			names = append(names, pipelineTask.Name)
+		}
+		previous = names
+	}
+	if len(artifactItems) > 0 {
+		items, err := json.Marshal(artifactItems)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to marshal the artifact items")
+		}
+		pr.Annotations = map[string]string{common.ArtifactItemsAnnotation: string(items)}
+	}
+	return pr, nil
+}
+
+// compileTask compiles a task of the entrypoint. It returns the pipeline task and
+// its output artifacts, as (name, path) pairs of the artifact_items annotation.
+func (wf *argoWorkflow) compileTask(task argoTask, templates map[string]*argoTemplate) (*workflowapi.PipelineTask, [][]string, error) {
+	if len(task.WithItems) > 0 || task.WithParam != "" {
+		return nil, nil, util.NewInvalidInputError("Loops aren't supported yet.")
+	}
+	tmpl, ok := templates[task.Template]
+	if !ok {
+		return nil, nil, util.NewInvalidInputError("Unknown template %q.", task.Template)
+	}
+	var container corev1.Container
+	var source string
+	switch {
+	case tmpl.Container != nil:
+		container = *tmpl.Container
+	case tmpl.Script != nil:
+		container, source = tmpl.Script.Container, tmpl.Script.Source
+	default:
+		return nil, nil, util.NewInvalidInputError("Template %q isn't supported, only container and script templates are.", tmpl.Name)
+	}
+
+	pipelineTask := &workflowapi.PipelineTask{
+		Name: tektonName(task.Name),
+		TaskSpec: &workflowapi.EmbeddedTask{
+			Metadata: workflowapi.PipelineTaskMetadata{Labels: tmpl.Metadata.Labels, Annotations: tmpl.Metadata.Annotations},
+		},
+	}
+	for _, dependency := range task.Dependencies {
+		pipelineTask.RunAfter = append(pipelineTask.RunAfter, tektonName(dependency))
+	}
+	for _, param := range task.Arguments.Parameters {
+		if param.Value == nil {
+			return nil, nil, util.NewInvalidInputError("Argument %q has no value.", param.Name)
+		}
+		value, err := resolveArgoPlaceholders(string(*param.Value))
+		if err != nil {
+			return nil, nil, err
+		}
+		pipelineTask.Params = append(pipelineTask.Params, stringParam(param.Name, value))
+	}
+	if task.When != "" {
+		when, err := argoWhenExpression(task.When)
+		if err != nil {
+			return nil, nil, err
+		}
+		pipelineTask.When = workflowapi.WhenExpressions{when}
+	}
+
+	taskSpec := &pipelineTask.TaskSpec.TaskSpec
+	for _, param := range tmpl.Inputs.Parameters {
+		defaultValue := param.Default
+		if param.Value != nil {
+			defaultValue = param.Value
+		}
+		taskSpec.Params = append(taskSpec.Params, paramSpec(param.Name, (*string)(defaultValue)))
+	}
+
+	// Paths in the container's file system are replaced with ones its steps share.
+	var replacements []string
+	var steps []workflowapi.Step
+	for _, artifact := range tmpl.Inputs.Artifacts {
+		var argument *argoArtifact
+		for i := range task.Arguments.Artifacts {
+			if task.Arguments.Artifacts[i].Name == artifact.Name {
+				argument = &task.Arguments.Artifacts[i]
+			}
+		}
+		if argument == nil {
+			return nil, nil, util.NewInvalidInputError("Input artifact %q has no argument.", artifact.Name)
+		}
+		match := argoArtifactRefRegex.FindStringSubmatch(argument.From)
+		if match == nil {
+			return nil, nil, util.NewInvalidInputError("Input artifact %q must be the output artifact of a task.", artifact.Name)
+		}
+		paramName := "artifact-" + artifact.Name
+		path := fmt.Sprintf("%s/%s", argoInputArtifactsDir, artifact.Name)
+		pipelineTask.Params = append(pipelineTask.Params, stringParam(paramName, fmt.Sprintf("$(tasks.%s.results.%s)", tektonName(match[1]), match[2])))
+		taskSpec.Params = append(taskSpec.Params, paramSpec(paramName, nil))
+		steps = append(steps, workflowapi.Step{
+			Name:    "copy-input-" + tektonName(artifact.Name),
+			Image:   common.GetMoveResultsImage(),
+			Command: []string{"sh", "-c"},
+			Args:    []string{`mkdir -p "$(dirname "$1")" && printf '%s' "$0" > "$1"`, fmt.Sprintf("$(params.%s)", paramName), path},
+		})
+		replacements = append(replacements, artifact.Path, path)
+	}
+	var artifacts [][]string
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
+			return nil, nil, util.NewInvalidInputError("Output parameter %q must be read from a path.", param.Name)
+		}
+		taskSpec.Results = append(taskSpec.Results, stringResult(param.Name))
+		replacements = append(replacements, param.ValueFrom.Path, fmt.Sprintf("$(results.%s.path)", param.Name))
+	}
+	for _, artifact := range tmpl.Outputs.Artifacts {
+		if declaresTaskResult(taskSpec, artifact.Name) {
+			continue
+		}
+		resultPath := fmt.Sprintf("$(results.%s.path)", artifact.Name)
+		taskSpec.Results = append(taskSpec.Results, stringResult(artifact.Name))
+		replacements = append(replacements, artifact.Path, resultPath)
+		artifacts = append(artifacts, []string{artifact.Name, resultPath})
+	}
+
+	step := workflowapi.Step{
+		Name:             compiledStepName,
+		Image:            container.Image,
+		Env:              container.Env,
+		EnvFrom:          container.EnvFrom,
+		WorkingDir:       container.WorkingDir,
+		ComputeResources: container.Resources,
+		VolumeMounts:     container.VolumeMounts,
+	}
+	replacer := strings.NewReplacer(replacements...)
+	var err error
+	if step.Command, err = resolveArgoContainerPlaceholders(container.Command, replacer); err != nil {
+		return nil, nil, err
+	}
+	if step.Args, err = resolveArgoContainerPlaceholders(container.Args, replacer); err != nil {
+		return nil, nil, err
+	}
+	if source != "" {
+		// Tekton runs scripts with their shebang instead of a command.
+		if !strings.HasPrefix(source, "#!") && len(step.Command) > 0 {
+			source = fmt.Sprintf("#!/usr/bin/env %s\n%s", strings.Join(step.Command, " "), source)
+		}
+		step.Command = nil
+		if step.Script, err = resolveArgoPlaceholders(replacer.Replace(source)); err != nil {
+			return nil, nil, err
+		}
+	}
+	taskSpec.Steps = append(steps, step)
+
+	for _, mount := range container.VolumeMounts {
+		found := false
+		for _, volume := range wf.Spec.Volumes {
+			if volume.Name == mount.Name {
+				taskSpec.Volumes = append(taskSpec.Volumes, volume)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, util.NewInvalidInputError("Volume %q isn't declared by the workflow.", mount.Name)
+		}
+	}
+
+	// The workflow parameters referenced by the template are passed as task params.
+	for _, name := range workflowParamReferences(taskSpec) {
+		if !declaresTaskParam(taskSpec, name) {
+			taskSpec.Params = append(taskSpec.Params, paramSpec(name, nil))
+			pipelineTask.Params = append(pipelineTask.Params, stringParam(name, fmt.Sprintf("$(params.%s)", name)))
+		}
+	}
+	return pipelineTask, artifacts, nil
+}
+
+// argoWhenExpression compiles a "==" or "!=" when condition.
+func argoWhenExpression(condition string) (workflowapi.WhenExpression, error) {
+	match := argoConditionRegex.FindStringSubmatch(strings.TrimSpace(condition))
+	if match == nil {
+		return workflowapi.WhenExpression{}, util.NewInvalidInputError("Unsupported when condition %q.", condition)
+	}
+	input, err := resolveArgoPlaceholders(strings.TrimSpace(match[1]))
+	if err != nil {
+		return workflowapi.WhenExpression{}, err
+	}
+	value, err := resolveArgoPlaceholders(strings.Trim(strings.TrimSpace(match[3]), `'"`))
+	if err != nil {
+		return workflowapi.WhenExpression{}, err
+	}
+	return whenExpression(input, match[2], value)
+}
+
+func resolveArgoContainerPlaceholders(values []string, replacer *strings.Replacer) ([]string, error) {
+	var resolved []string
+	for _, value := range values {
+		result, err := resolveArgoPlaceholders(replacer.Replace(value))
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, result)
+	}
+	return resolved, nil
+}
+
+// resolveArgoPlaceholders replaces the Argo variables of a value with their Tekton
+// variables. Workflow parameters are referenced as params, so they must be
+// declared by the tasks referencing them.
+func resolveArgoPlaceholders(value string) (string, error) {
+	var err error
+	result := argoPlaceholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+		variable := strings.TrimSpace(strings.Trim(placeholder, "{}"))
+		parts := strings.Split(variable, ".")
+		switch {
+		case len(parts) == 3 && (parts[0] == "inputs" || parts[0] == "workflow") && parts[1] == "parameters":
+			return fmt.Sprintf("$(params.%s)", parts[2])
+		case len(parts) == 5 && (parts[0] == "tasks" || parts[0] == "steps") && parts[2] == "outputs" && parts[3] == "parameters":
+			return fmt.Sprintf("$(tasks.%s.results.%s)", tektonName(parts[1]), parts[4])
+		case variable == "workflow.name":
+			return "$(context.pipelineRun.name)"
+		case variable == "workflow.namespace":
+			return "$(context.pipelineRun.namespace)"
+		case variable == "workflow.uid":
+			return "$(context.pipelineRun.uid)"
+		}
+		if err == nil {
+			err = util.NewInvalidInputError("Unsupported variable %q.", placeholder)
+		}
+		return placeholder
+	})
+	return result, err
+}
+
+// workflowParamReferences returns the params referenced by the steps of a task.
+func workflowParamReferences(taskSpec *workflowapi.TaskSpec) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, match := range paramReferenceRegex.FindAllStringSubmatch(referencesOf(taskSpec.Steps), -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func declaresTaskParam(taskSpec *workflowapi.TaskSpec, name string) bool {
+	for _, param := range taskSpec.Params {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func declaresTaskResult(taskSpec *workflowapi.TaskSpec, name string) bool {
+	for _, result := range taskSpec.Results {
+		if result.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func argoStringPointer(value string) *argoString {
+	s := argoString(value)
+	return &s
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+var argoConditionalWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: conditional-
+spec:
+  entrypoint: conditional
+  serviceAccountName: pipeline-runner
+  arguments:
+    parameters:
+    - name: seed
+      value: 42
+  templates:
+  - name: conditional
+    dag:
+      tasks:
+      - name: flip-coin
+        template: flip-coin
+        arguments:
+          parameters:
+          - name: seed
+            value: '{{workflow.parameters.seed}}'
+      - name: print
+        template: print
+        dependencies: [flip-coin]
+        when: '{{tasks.flip-coin.outputs.parameters.flip-coin-output}} == heads'
+        arguments:
+          artifacts:
+          - name: msg
+            from: '{{tasks.flip-coin.outputs.artifacts.flip-coin-msg}}'
+  - name: flip-coin
+    inputs:
+      parameters:
+      - name: seed
+    outputs:
+      parameters:
+      - name: flip-coin-output
+        valueFrom: {path: /tmp/outputs/output/data}
+      artifacts:
+      - name: flip-coin-output
+        path: /tmp/outputs/output/data
+      - name: flip-coin-msg
+        path: /tmp/outputs/msg/data
+    container:
+      image: python:3.9
+      command: [flip, --seed, '{{inputs.parameters.seed}}', --output, /tmp/outputs/output/data, --msg, /tmp/outputs/msg/data]
+  - name: print
+    inputs:
+      artifacts:
+      - name: msg
+        path: /tmp/inputs/msg/data
+    script:
+      image: python:3.9
+      command: [python]
+      source: |
+        print(open("/tmp/inputs/msg/data").read(), "{{workflow.name}}")
+`
+
+func TestCompile_Argo(t *testing.T) {
+	workflow, warnings, err := Compile([]byte(argoConditionalWorkflow))
+	require.Nil(t, err)
+	assert.Empty(t, warnings)
+
+	assert.Equal(t, TektonVersion, workflow.APIVersion)
+	assert.Equal(t, "conditional", workflow.Name)
+	assert.Equal(t, "pipeline-runner", workflow.Spec.TaskRunTemplate.ServiceAccountName)
+	assert.Equal(t, workflowapi.Params{stringParam("seed", "42")}, workflow.Spec.Params)
+	assert.Equal(t, `{"flip-coin":[["flip-coin-msg","$(results.flip-coin-msg.path)"]]}`, workflow.Annotations[common.ArtifactItemsAnnotation])
+	require.Len(t, workflow.Spec.PipelineSpec.Tasks, 2)
+
+	flip := workflow.Spec.PipelineSpec.Tasks[0]
+	assert.Equal(t, "flip-coin", flip.Name)
+	assert.Equal(t, workflowapi.Params{stringParam("seed", "$(params.seed)")}, flip.Params)
+	assert.Equal(t, []workflowapi.TaskResult{stringResult("flip-coin-output"), stringResult("flip-coin-msg")}, flip.TaskSpec.Results)
+	require.Len(t, flip.TaskSpec.Steps, 1)
+	assert.Equal(t, []string{"flip", "--seed", "$(params.seed)", "--output", "$(results.flip-coin-output.path)", "--msg", "$(results.flip-coin-msg.path)"},
+		flip.TaskSpec.Steps[0].Command)
+
+	printMsg := workflow.Spec.PipelineSpec.Tasks[1]
+	assert.Equal(t, "print", printMsg.Name)
+	assert.Equal(t, []string{"flip-coin"}, printMsg.RunAfter)
+	assert.Equal(t, workflowapi.Params{stringParam("artifact-msg", "$(tasks.flip-coin.results.flip-coin-msg)")}, printMsg.Params)
+	assert.Equal(t, workflowapi.WhenExpressions{{
+		Input:    "$(tasks.flip-coin.results.flip-coin-output)",
+		Operator: selection.In,
+		Values:   []string{"heads"},
+	}}, printMsg.When)
+	require.Len(t, printMsg.TaskSpec.Steps, 2)
+	assert.Equal(t, "copy-input-msg", printMsg.TaskSpec.Steps[0].Name)
+	assert.Equal(t, []string{"$(params.artifact-msg)", "/tekton/home/inputs/msg"}, printMsg.TaskSpec.Steps[0].Args[1:])
+	assert.Nil(t, printMsg.TaskSpec.Steps[1].Command)
+	assert.Equal(t, "#!/usr/bin/env python\nprint(open(\"/tekton/home/inputs/msg\").read(), \"$(context.pipelineRun.name)\")\n",
+		printMsg.TaskSpec.Steps[1].Script)
+}
+
+func TestCompile_ArgoSteps(t *testing.T) {
+	workflow, _, err := Compile([]byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: main
+    steps:
+    - - name: first
+        template: echo
+      - name: second
+        template: echo
+    - - name: third
+        template: echo
+  - name: echo
+    container:
+      image: alpine
+      command: [echo, '{{workflow.parameters.message}}']
+`))
+	require.Nil(t, err)
+	tasks := workflow.Spec.PipelineSpec.Tasks
+	require.Len(t, tasks, 3)
+	assert.Empty(t, tasks[0].RunAfter)
+	assert.Empty(t, tasks[1].RunAfter)
+	assert.Equal(t, []string{"first", "second"}, tasks[2].RunAfter)
+	// The referenced workflow parameter is passed to the task.
+	assert.Equal(t, workflowapi.Params{stringParam("message", "$(params.message)")}, tasks[2].Params)
+	assert.Equal(t, "message", tasks[2].TaskSpec.Params[0].Name)
+}
+
+func TestCompile_ArgoUnsupported(t *testing.T) {
+	_, _, err := Compile([]byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: loop
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: echo
+        template: echo
+        withItems: [1, 2]
+  - name: echo
+    container:
+      image: alpine
+`))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Loops aren't supported yet.")
+
+	_, _, err = Compile([]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// Cache is an LRU of parsed templates keyed by pipeline version ID and content
+// hash, so large manifests aren't parsed and validated on every run creation.
+// Cached templates are shared between callers and must not be mutated, e.g.
+// with OverrideV2PipelineName. Since entries are keyed by content, replicas
+// never serve stale templates when another replica updates a pipeline version.
+type Cache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key       string
+	versionId string
+	tmpl      Template
+}
+
+// NewCache creates a cache holding at most capacity templates. A capacity of
+// zero or less disables caching.
+func NewCache(capacity int) *Cache {
+	return &Cache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Get returns the parsed template for the manifest, parsing it on a miss. The
+// version ID is empty for manifests submitted inline with a run or job.
+func (c *Cache) Get(versionId string, bytes []byte) (Template, error) {
+	if c == nil || c.capacity <= 0 {
+		return New(bytes)
+	}
+	hash := sha256.Sum256(bytes)
+	key := versionId + "/" + hex.EncodeToString(hash[:])
+
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		c.mu.Unlock()
+		return element.Value.(*cacheEntry).tmpl, nil
+	}
+	c.mu.Unlock()
+
+	// Parse outside the lock; concurrent misses for the same key parse twice,
+	// which is cheaper than serializing all parsing.
+	tmpl, err := New(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		return element.Value.(*cacheEntry).tmpl, nil
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, versionId: versionId, tmpl: tmpl})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+	return tmpl, nil
+}
+
+// Invalidate drops all templates cached for the pipeline version.
+func (c *Cache) Invalidate(versionId string) {
+	if c == nil || versionId == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if entry := element.Value.(*cacheEntry); entry.versionId == versionId {
+			c.order.Remove(element)
+			delete(c.entries, entry.key)
+		}
+		element = next
+	}
+}
+
+// Len returns the number of cached templates.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
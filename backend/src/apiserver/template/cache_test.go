@@ -0,0 +1,63 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	cache := NewCache(2)
+	first, err := cache.Get("v1", []byte(analyzerTemplate))
+	assert.Nil(t, err)
+	cached, err := cache.Get("v1", []byte(analyzerTemplate))
+	assert.Nil(t, err)
+	assert.True(t, first == cached)
+
+	// Same version with different content is a separate entry.
+	_, err = cache.Get("v1", []byte(cyclicTemplate))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, cache.Len())
+
+	// Least recently used entry is evicted.
+	_, err = cache.Get("v2", []byte(analyzerTemplate))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, cache.Len())
+	reparsed, err := cache.Get("v1", []byte(analyzerTemplate))
+	assert.Nil(t, err)
+	assert.False(t, first == reparsed)
+
+	cache.Invalidate("v1")
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestCache_InvalidTemplate(t *testing.T) {
+	cache := NewCache(2)
+	_, err := cache.Get("v1", []byte("invalid"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCache_Disabled(t *testing.T) {
+	cache := NewCache(0)
+	first, err := cache.Get("v1", []byte(analyzerTemplate))
+	assert.Nil(t, err)
+	second, err := cache.Get("v1", []byte(analyzerTemplate))
+	assert.Nil(t, err)
+	assert.False(t, first == second)
+	assert.Equal(t, 0, cache.Len())
+}
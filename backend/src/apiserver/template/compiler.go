@@ -0,0 +1,93 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// The name of the step running the container of a compiled task.
+const compiledStepName = "main"
+
+var invalidNameCharsRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// newCompiledPipelineRun returns an empty PipelineRun with an inline pipeline spec,
+// named after the compiled pipeline.
+func newCompiledPipelineRun(name string) *workflowapi.PipelineRun {
+	return &workflowapi.PipelineRun{
+		TypeMeta:   metav1.TypeMeta{APIVersion: TektonVersion, Kind: TektonK8sResource},
+		ObjectMeta: metav1.ObjectMeta{Name: tektonName(name)},
+		Spec: workflowapi.PipelineRunSpec{
+			PipelineSpec: &workflowapi.PipelineSpec{},
+		},
+	}
+}
+
+// tektonName converts the name of a pipeline or task to a DNS-1123 label, e.g.
+// "Train_Model" to "train-model".
+func tektonName(name string) string {
+	name = invalidNameCharsRegex.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(name, "-")
+}
+
+// addPipelineParam declares a parameter of the compiled pipeline. Parameters with a
+// default value are also set by the PipelineRun, so they can be overridden by runs.
+func addPipelineParam(pr *workflowapi.PipelineRun, name string, defaultValue *string) {
+	pr.Spec.PipelineSpec.Params = append(pr.Spec.PipelineSpec.Params, paramSpec(name, defaultValue))
+	if defaultValue != nil {
+		pr.Spec.Params = append(pr.Spec.Params, stringParam(name, *defaultValue))
+	}
+}
+
+func paramSpec(name string, defaultValue *string) workflowapi.ParamSpec {
+	spec := workflowapi.ParamSpec{Name: name, Type: workflowapi.ParamTypeString}
+	if defaultValue != nil {
+		spec.Default = workflowapi.NewStructuredValues(*defaultValue)
+	}
+	return spec
+}
+
+func stringParam(name, value string) workflowapi.Param {
+	return workflowapi.Param{Name: name, Value: *workflowapi.NewStructuredValues(value)}
+}
+
+func stringResult(name string) workflowapi.TaskResult {
+	return workflowapi.TaskResult{Name: name, Type: workflowapi.ResultsTypeString}
+}
+
+// whenExpression returns the when expression of a "==" or "!=" comparison.
+func whenExpression(input, operator, value string) (workflowapi.WhenExpression, error) {
+	switch operator {
+	case "==":
+		return workflowapi.WhenExpression{Input: input, Operator: selection.In, Values: []string{value}}, nil
+	case "!=":
+		return workflowapi.WhenExpression{Input: input, Operator: selection.NotIn, Values: []string{value}}, nil
+	default:
+		return workflowapi.WhenExpression{}, util.NewInvalidInputError("Unsupported condition operator %q.", operator)
+	}
+}
+
+// jsonString encodes a string as a JSON string literal.
+func jsonString(value string) string {
+	bytes, _ := json.Marshal(value)
+	return string(bytes)
+}
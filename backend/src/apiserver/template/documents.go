@@ -0,0 +1,133 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// splitDocuments returns the non-empty documents of a multi-document YAML template.
+// JSON and single-document YAML templates are returned as a single document.
+func splitDocuments(template []byte) ([][]byte, error) {
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(template)))
+	var documents [][]byte
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		jsonDocument, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return nil, err
+		}
+		// Skip documents that are empty or only contain comments.
+		if trimmed := bytes.TrimSpace(jsonDocument); len(trimmed) == 0 || string(trimmed) == "null" {
+			continue
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
+
+// splitPipelineRun separates the PipelineRun of a multi-document template from the
+// auxiliary resources (e.g. Pipelines and Tasks) packaged with it.
+func splitPipelineRun(template []byte) ([]byte, []map[string]interface{}, error) {
+	documents, err := splitDocuments(template)
+	if err != nil {
+		return nil, nil, util.NewInvalidInputErrorWithDetails(err, "Failed to split the template into YAML documents.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	if len(documents) <= 1 {
+		return template, nil, nil
+	}
+	var pipelineRun []byte
+	var resources []map[string]interface{}
+	for _, document := range documents {
+		var meta metav1.TypeMeta
+		if err := yaml.Unmarshal(document, &meta); err != nil {
+			return nil, nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse a template document.").WithReason(util.ReasonInvalidPipelineSpec)
+		}
+		if strings.HasPrefix(meta.APIVersion, TektonGroup) && meta.Kind == TektonK8sResource {
+			if pipelineRun != nil {
+				return nil, nil, util.NewInvalidInputError("The template must contain exactly one PipelineRun.").WithReason(util.ReasonInvalidPipelineSpec)
+			}
+			pipelineRun = document
+			continue
+		}
+		resource, err := validateAuxiliaryResource(document)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, resource)
+	}
+	if pipelineRun == nil {
+		return nil, nil, util.NewInvalidInputError("The template must contain exactly one PipelineRun.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	return pipelineRun, resources, nil
+}
+
+// validateAuxiliaryResource checks that a document can be applied by applyCustomResources.
+func validateAuxiliaryResource(document []byte) (map[string]interface{}, error) {
+	var resource map[string]interface{}
+	if err := yaml.Unmarshal(document, &resource); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse a template document.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	if len(strings.Split(apiVersion, "/")) != 2 || kind == "" {
+		return nil, util.NewInvalidInputError("Unsupported resource in template: apiVersion %q, kind %q. Only namespaced custom resources are supported.", apiVersion, kind).WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	if name, _ := metadata["name"].(string); name == "" {
+		return nil, util.NewInvalidInputError("Resource of kind %q in template has no name.", kind).WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	return resource, nil
+}
+
+// addResourceTemplates appends the auxiliary resources to the resource templates
+// annotation, so they are created or refreshed when a run is created.
+func addResourceTemplates(workflow *util.Workflow, resources []map[string]interface{}) error {
+	if len(resources) == 0 {
+		return nil
+	}
+	var templates []interface{}
+	if existing, ok := workflow.Annotations[common.ResourceTemplatesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(existing), &templates); err != nil {
+			return util.NewInvalidInputErrorWithDetails(err, fmt.Sprintf("Failed to parse the %s annotation.", common.ResourceTemplatesAnnotation)).WithReason(util.ReasonInvalidPipelineSpec)
+		}
+	}
+	for _, resource := range resources {
+		templates = append(templates, resource)
+	}
+	templatesJSON, err := json.Marshal(templates)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal resource templates.")
+	}
+	workflow.SetAnnotations(common.ResourceTemplatesAnnotation, string(templatesJSON))
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var multiDocumentTemplate = `
+# A Task shared by the pipeline.
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: echo
+spec:
+  steps:
+  - name: main
+    image: busybox
+---
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: multi
+spec:
+  pipelineSpec:
+    tasks:
+    - name: echo
+      taskRef:
+        name: echo
+---
+# trailing comment only
+`
+
+func TestNew_MultiDocument(t *testing.T) {
+	tmpl, err := New([]byte(multiDocumentTemplate))
+	assert.Nil(t, err)
+	tekton := tmpl.(*Tekton)
+	assert.Equal(t, "multi", tekton.wf.Name)
+	assert.JSONEq(t,
+		`[{"apiVersion":"tekton.dev/v1","kind":"Task","metadata":{"name":"echo"},"spec":{"steps":[{"name":"main","image":"busybox"}]}}]`,
+		tekton.wf.Annotations[common.ResourceTemplatesAnnotation])
+}
+
+func TestNew_MultiDocumentWithoutPipelineRun(t *testing.T) {
+	_, err := ValidatePipelineRun([]byte("apiVersion: tekton.dev/v1\nkind: Task\nmetadata:\n  name: a\n---\napiVersion: tekton.dev/v1\nkind: Task\nmetadata:\n  name: b\n"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "exactly one PipelineRun")
+}
+
+func TestNew_MultiDocumentUnsupportedResource(t *testing.T) {
+	_, err := New([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: tekton.dev/v1\nkind: PipelineRun\nmetadata:\n  name: p\n"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unsupported resource")
+}
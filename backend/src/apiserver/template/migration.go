@@ -0,0 +1,89 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	workflowapiV1beta "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// convertV1beta1PipelineRun converts a tekton.dev/v1beta1 PipelineRun to v1. Tekton's
+// conversion moves renamed fields, e.g. serviceAccountName and podTemplate to
+// taskRunTemplate and the deprecated timeout to timeouts.pipeline.
+func convertV1beta1PipelineRun(template []byte) (*workflowapi.PipelineRun, error) {
+	var prV1beta1 workflowapiV1beta.PipelineRun
+	if err := yaml.Unmarshal(template, &prV1beta1); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the V1beta1 PipelineRun template.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	var pr workflowapi.PipelineRun
+	if err := prV1beta1.ConvertTo(context.Background(), &pr); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to convert the V1beta1 PipelineRun template to V1.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	pr.TypeMeta = metav1.TypeMeta{APIVersion: TektonVersion, Kind: TektonK8sResource}
+	return &pr, nil
+}
+
+// MigrateToV1 upgrades the tekton.dev/v1beta1 PipelineRun of a stored template to
+// tekton.dev/v1. Other documents of multi-document templates are kept as is. The
+// returned bool is false, and the template is returned unchanged, if there was
+// nothing to migrate.
+func MigrateToV1(template []byte) ([]byte, bool, error) {
+	if inferTemplateFormat(template) != V1 {
+		return template, false, nil
+	}
+	documents, err := splitDocuments(template)
+	if err != nil {
+		return nil, false, util.NewInvalidInputErrorWithDetails(err, "Failed to split the template into YAML documents.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	migrated := false
+	for i, document := range documents {
+		var meta metav1.TypeMeta
+		if err := yaml.Unmarshal(document, &meta); err != nil {
+			return nil, false, util.NewInvalidInputErrorWithDetails(err, "Failed to parse a template document.").WithReason(util.ReasonInvalidPipelineSpec)
+		}
+		if meta.APIVersion != TektonBetaGroup || meta.Kind != TektonK8sResource {
+			continue
+		}
+		pr, err := convertV1beta1PipelineRun(document)
+		if err != nil {
+			return nil, false, err
+		}
+		documents[i], err = yaml.Marshal(pr)
+		if err != nil {
+			return nil, false, util.NewInternalServerError(err, "Failed to marshal the migrated PipelineRun.")
+		}
+		migrated = true
+	}
+	if !migrated {
+		return template, false, nil
+	}
+	var buf bytes.Buffer
+	for i, document := range documents {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(document)
+		if !bytes.HasSuffix(document, []byte("\n")) {
+			buf.WriteString("\n")
+		}
+	}
+	return buf.Bytes(), true, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var v1beta1Template = `apiVersion: tekton.dev/v1beta1
+kind: PipelineRun
+metadata:
+  name: legacy
+spec:
+  serviceAccountName: pipeline-runner
+  timeout: 1h
+  pipelineSpec:
+    tasks:
+    - name: echo
+      taskSpec:
+        steps:
+        - name: main
+          image: busybox
+`
+
+func TestMigrateToV1(t *testing.T) {
+	migrated, ok, err := MigrateToV1([]byte(v1beta1Template))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	wf, err := ValidatePipelineRun(migrated)
+	assert.Nil(t, err)
+	assert.Equal(t, TektonVersion, wf.APIVersion)
+	assert.Equal(t, "pipeline-runner", wf.Spec.TaskRunTemplate.ServiceAccountName)
+	assert.Equal(t, time.Hour, wf.Spec.Timeouts.Pipeline.Duration)
+
+	// Already migrated templates are unchanged.
+	again, ok, err := MigrateToV1(migrated)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, migrated, again)
+}
+
+func TestMigrateToV1_MultiDocument(t *testing.T) {
+	template := "apiVersion: tekton.dev/v1\nkind: Task\nmetadata:\n  name: echo\n---\n" + v1beta1Template
+	migrated, ok, err := MigrateToV1([]byte(template))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Contains(t, string(migrated), "kind: Task\nmetadata:\n  name: echo\n---\n")
+
+	documents, err := splitDocuments(migrated)
+	assert.Nil(t, err)
+	assert.Len(t, documents, 2)
+}
+
+func TestMigrateToV1_V2Spec(t *testing.T) {
+	template := []byte(`{"pipelineInfo":{"name":"p"},"root":{}}`)
+	migrated, ok, err := MigrateToV1(template)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, template, migrated)
+}
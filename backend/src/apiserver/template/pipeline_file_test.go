@@ -0,0 +1,118 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pipelineFileContent = "kind: PipelineRun\n"
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		require.Nil(t, err)
+		_, err = file.Write([]byte(content))
+		require.Nil(t, err)
+	}
+	require.Nil(t, writer.Close())
+	return buf.Bytes()
+}
+
+func tarballArchive(t *testing.T, name string, content string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	writer := tar.NewWriter(gzipWriter)
+	require.Nil(t, writer.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))}))
+	_, err := writer.Write([]byte(content))
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+	require.Nil(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func TestReadPipelineFile_JSON(t *testing.T) {
+	file, err := ReadPipelineFile("pipeline.json", strings.NewReader(`{"pipelineInfo": {}}`), MaxFileLength)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"pipelineInfo": {}}`), file)
+}
+
+func TestReadPipelineFile_Zip(t *testing.T) {
+	archive := zipArchive(t, map[string]string{"pipeline.yaml": pipelineFileContent, "README.md": "readme"})
+	file, err := ReadPipelineFile("pipeline.zip", bytes.NewReader(archive), MaxFileLength)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(pipelineFileContent), file)
+}
+
+func TestReadPipelineFile_Tarball(t *testing.T) {
+	archive := tarballArchive(t, "my-pipeline.yaml", pipelineFileContent)
+	file, err := ReadPipelineFile("pipeline.tar.gz", bytes.NewReader(archive), MaxFileLength)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(pipelineFileContent), file)
+}
+
+func TestReadPipelineFile_UnknownFileFormat(t *testing.T) {
+	_, err := ReadPipelineFile("pipeline.foo", strings.NewReader("foo"), MaxFileLength)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unexpected pipeline file format")
+}
+
+func TestLoadFile(t *testing.T) {
+	file := "12345"
+	bytes, err := loadFile(strings.NewReader(file), 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(file), bytes)
+}
+
+func TestLoadFile_ExceedSizeLimit(t *testing.T) {
+	file := "12345"
+	_, err := loadFile(strings.NewReader(file), 4)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "File size too large")
+}
+
+func TestDecompressPipelineTarball_MalformattedTarball(t *testing.T) {
+	_, err := DecompressPipelineTarball([]byte("not a tarball"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Not a valid tarball file")
+}
+
+func TestDecompressPipelineTarball_NonYamlTarball(t *testing.T) {
+	_, err := DecompressPipelineTarball(tarballArchive(t, "pipeline.txt", pipelineFileContent))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Expecting a pipeline.yaml file inside the tarball")
+}
+
+func TestDecompressPipelineZip_MalformattedZip(t *testing.T) {
+	_, err := DecompressPipelineZip([]byte("not a zip"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Not a valid zip file")
+}
+
+func TestDecompressPipelineZip_NonYamlZip(t *testing.T) {
+	_, err := DecompressPipelineZip(zipArchive(t, map[string]string{"pipeline.txt": pipelineFileContent}))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Expecting a pipeline.yaml file inside the zip")
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"bytes"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"sigs.k8s.io/yaml"
+)
+
+// ReferenceMapping renames the service accounts and secrets referenced by the
+// manifests moved to another namespace or cluster.
+type ReferenceMapping struct {
+	ServiceAccounts map[string]string
+	Secrets         map[string]string
+}
+
+// ServiceAccount returns the new name of a service account.
+func (m *ReferenceMapping) ServiceAccount(name string) string {
+	if newName, ok := m.ServiceAccounts[name]; ok {
+		return newName
+	}
+	return name
+}
+
+// Secret returns the new name of a secret.
+func (m *ReferenceMapping) Secret(name string) string {
+	if newName, ok := m.Secrets[name]; ok {
+		return newName
+	}
+	return name
+}
+
+func (m *ReferenceMapping) IsEmpty() bool {
+	return len(m.ServiceAccounts) == 0 && len(m.Secrets) == 0
+}
+
+// RemapReferences renames the service accounts and secrets referenced anywhere in
+// the documents of a manifest, e.g. by serviceAccountName, secretKeyRef, secret
+// volumes and imagePullSecrets. The returned bool is false, and the manifest is
+// returned unchanged, if nothing was renamed.
+func RemapReferences(manifest []byte, mapping *ReferenceMapping) ([]byte, bool, error) {
+	if len(bytes.TrimSpace(manifest)) == 0 || mapping.IsEmpty() {
+		return manifest, false, nil
+	}
+	documents, err := splitDocuments(manifest)
+	if err != nil {
+		return nil, false, util.NewInvalidInputErrorWithDetails(err, "Failed to split the manifest into YAML documents.")
+	}
+	remapped := false
+	for i, document := range documents {
+		var value interface{}
+		if err := yaml.Unmarshal(document, &value); err != nil {
+			return nil, false, util.NewInvalidInputErrorWithDetails(err, "Failed to parse a manifest document.")
+		}
+		if !remapValue(value, mapping) {
+			continue
+		}
+		documents[i], err = yaml.Marshal(value)
+		if err != nil {
+			return nil, false, util.NewInternalServerError(err, "Failed to marshal the remapped manifest.")
+		}
+		remapped = true
+	}
+	if !remapped {
+		return manifest, false, nil
+	}
+	var buf bytes.Buffer
+	for i, document := range documents {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(document)
+		if !bytes.HasSuffix(document, []byte("\n")) {
+			buf.WriteString("\n")
+		}
+	}
+	return buf.Bytes(), true, nil
+}
+
+// remapValue renames the references found in a decoded YAML value, in place, and
+// returns whether any was renamed.
+func remapValue(value interface{}, mapping *ReferenceMapping) bool {
+	remapped := false
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			switch key {
+			case "serviceAccountName", "serviceAccount":
+				remapped = remapString(value, key, mapping.ServiceAccount) || remapped
+				continue
+			case "secretName":
+				remapped = remapString(value, key, mapping.Secret) || remapped
+				continue
+			case "secretKeyRef", "secretRef":
+				if ref, ok := field.(map[string]interface{}); ok {
+					remapped = remapString(ref, "name", mapping.Secret) || remapped
+				}
+			case "imagePullSecrets":
+				if refs, ok := field.([]interface{}); ok {
+					for _, ref := range refs {
+						if ref, ok := ref.(map[string]interface{}); ok {
+							remapped = remapString(ref, "name", mapping.Secret) || remapped
+						}
+					}
+				}
+				continue
+			}
+			remapped = remapValue(field, mapping) || remapped
+		}
+	case []interface{}:
+		for _, item := range value {
+			remapped = remapValue(item, mapping) || remapped
+		}
+	}
+	return remapped
+}
+
+func remapString(object map[string]interface{}, key string, rename func(string) string) bool {
+	name, ok := object[key].(string)
+	if !ok || rename(name) == name {
+		return false
+	}
+	object[key] = rename(name)
+	return true
+}
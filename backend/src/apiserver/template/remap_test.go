@@ -0,0 +1,88 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+var remapTemplate = `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: echo
+spec:
+  steps:
+  - name: main
+    image: busybox
+    env:
+    - name: TOKEN
+      valueFrom:
+        secretKeyRef:
+          name: team-a-token
+          key: token
+---
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: remap
+spec:
+  taskRunTemplate:
+    serviceAccountName: team-a-runner
+    podTemplate:
+      imagePullSecrets:
+      - name: team-a-registry
+  workspaces:
+  - name: creds
+    secret:
+      secretName: team-a-creds
+`
+
+func TestRemapReferences(t *testing.T) {
+	mapping := &ReferenceMapping{
+		ServiceAccounts: map[string]string{"team-a-runner": "team-b-runner"},
+		Secrets:         map[string]string{"team-a-token": "team-b-token", "team-a-registry": "team-b-registry", "team-a-creds": "team-b-creds"},
+	}
+	remapped, ok, err := RemapReferences([]byte(remapTemplate), mapping)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	documents, err := splitDocuments(remapped)
+	assert.Nil(t, err)
+	assert.Len(t, documents, 2)
+	json, err := yaml.YAMLToJSON(documents[0])
+	assert.Nil(t, err)
+	assert.Contains(t, string(json), `"secretKeyRef":{"key":"token","name":"team-b-token"}`)
+	json, err = yaml.YAMLToJSON(documents[1])
+	assert.Nil(t, err)
+	assert.Contains(t, string(json), `"serviceAccountName":"team-b-runner"`)
+	assert.Contains(t, string(json), `"imagePullSecrets":[{"name":"team-b-registry"}]`)
+	assert.Contains(t, string(json), `"secretName":"team-b-creds"`)
+}
+
+func TestRemapReferences_Unchanged(t *testing.T) {
+	mapping := &ReferenceMapping{ServiceAccounts: map[string]string{"other": "team-b-runner"}}
+	remapped, ok, err := RemapReferences([]byte(remapTemplate), mapping)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, remapTemplate, string(remapped))
+
+	remapped, ok, err = RemapReferences([]byte(remapTemplate), &ReferenceMapping{})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, remapTemplate, string(remapped))
+}
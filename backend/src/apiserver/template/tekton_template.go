@@ -7,11 +7,11 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
-	workflowapiV1beta "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"sigs.k8s.io/yaml"
 
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
@@ -36,8 +36,12 @@ func (t *Tekton) RunWorkflow(apiRun *api.Run, options RunWorkflowOptions, namesp
 		workflow.SetLabels(util.LabelKeyCacheEnabled, common.IsCacheEnabledDeprecated())
 	}
 
-	if strings.ToLower(common.IsCacheEnabled()) != "true" {
-		workflow.SetLabels(util.LabelKeyCacheEnabled, common.IsCacheEnabled())
+	if !common.IsFeatureEnabled(common.FeatureCaching) {
+		workflow.SetLabels(util.LabelKeyCacheEnabled, "false")
+	}
+	// The run may force its steps to be executed again, without editing the pipeline.
+	if apiRun.GetDisableCache() {
+		workflow.SetAnnotations(util.AnnotationKeyDisableCache, "true")
 	}
 
 	parameters := toParametersMap(apiRun.GetPipelineSpec().GetParameters())
@@ -67,24 +71,25 @@ func (t *Tekton) RunWorkflow(apiRun *api.Run, options RunWorkflowOptions, namesp
 	workflow.SetLabels(util.LabelKeyWorkflowRunId, options.RunId)
 	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
 	workflow.SetAnnotations(util.AnnotationKeyRunName, apiRun.Name)
-	// Replace {{workflow.uid}} and $(context.pipelineRun.uid) with runId
-	err = workflow.ReplaceUID(options.RunId)
-	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to replace workflow ID")
-	}
 	workflow.Name = workflow.Name + "-" + options.RunId[0:5]
 
 	// Add original label to the workflow so it can be persisted by persistent agent later.
 	workflow.SetLabels(util.LabelOriginalPipelineRunName, workflow.Name)
-	err = workflow.ReplaceOrignalPipelineRunName(workflow.Name)
+	err = workflow.ReplaceContextVariables(util.ContextVariables{
+		RunId:           options.RunId,
+		RunName:         apiRun.Name,
+		PipelineRunName: workflow.Name,
+		Namespace:       namespace,
+		ExperimentId:    getExperimentId(apiRun.GetResourceReferences()),
+	})
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to replace workflow original pipelineRun name")
+		return nil, util.NewInternalServerError(err, "Failed to replace context variables")
 	}
 
 	// Predefine custom resource if resource_templates are provided and feature flag
 	// is enabled.
 	if strings.ToLower(common.IsApplyTektonCustomResource()) == "true" {
-		if tektonTemplates, ok := workflow.Annotations["tekton.dev/resource_templates"]; ok {
+		if tektonTemplates, ok := workflow.Annotations[common.ResourceTemplatesAnnotation]; ok {
 			err = t.applyCustomResources(*workflow, tektonTemplates, namespace)
 			if err != nil {
 				return nil, util.NewInternalServerError(err, "Apply Tekton Custom resource Failed")
@@ -92,7 +97,7 @@ func (t *Tekton) RunWorkflow(apiRun *api.Run, options RunWorkflowOptions, namesp
 		}
 	}
 
-	err = t.tektonPreprocessing(*workflow)
+	err = t.tektonPreprocessing(*workflow, namespace)
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Tekton Preprocessing Failed")
 	}
@@ -115,8 +120,8 @@ func (t *Tekton) ScheduledWorkflow(apiJob *api.Job, namespace string) (*schedule
 		workflow.SetLabels(util.LabelKeyCacheEnabled, common.IsCacheEnabledDeprecated())
 	}
 
-	if strings.ToLower(common.IsCacheEnabled()) != "true" {
-		workflow.SetLabels(util.LabelKeyCacheEnabled, common.IsCacheEnabled())
+	if !common.IsFeatureEnabled(common.FeatureCaching) {
+		workflow.SetLabels(util.LabelKeyCacheEnabled, "false")
 	}
 
 	parameters := toParametersMap(apiJob.GetPipelineSpec().GetParameters())
@@ -136,7 +141,7 @@ func (t *Tekton) ScheduledWorkflow(apiJob *api.Job, namespace string) (*schedule
 	// Predefine custom resource if resource_templates are provided and feature flag
 	// is enabled.
 	if strings.ToLower(common.IsApplyTektonCustomResource()) == "true" {
-		if tektonTemplates, ok := workflow.Annotations["tekton.dev/resource_templates"]; ok {
+		if tektonTemplates, ok := workflow.Annotations[common.ResourceTemplatesAnnotation]; ok {
 			err = t.applyCustomResources(*workflow, tektonTemplates, namespace)
 			if err != nil {
 				return nil, util.NewInternalServerError(err, "Apply Tekton Custom resource Failed")
@@ -144,7 +149,7 @@ func (t *Tekton) ScheduledWorkflow(apiJob *api.Job, namespace string) (*schedule
 		}
 	}
 
-	err = t.tektonPreprocessing(*workflow)
+	err = t.tektonPreprocessing(*workflow, namespace)
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Tekton Preprocessing Failed")
 	}
@@ -172,6 +177,13 @@ func (t *Tekton) GetTemplateType() TemplateType {
 	return V1
 }
 
+func (t *Tekton) Analyze() []Warning {
+	if t == nil {
+		return nil
+	}
+	return analyzePipelineRun(t.wf.PipelineRun)
+}
+
 func NewTektonTemplate(bytes []byte) (*Tekton, error) {
 	wf, err := ValidatePipelineRun(bytes)
 	if err != nil {
@@ -232,35 +244,45 @@ func NewTektonTemplateFromWorkflow(wf *workflowapi.PipelineRun) (*Tekton, error)
 }
 
 func ValidatePipelineRun(template []byte) (*util.Workflow, error) {
+	template, resources, err := splitPipelineRun(template)
+	if err != nil {
+		return nil, err
+	}
 	var pr workflowapi.PipelineRun
-	err := yaml.Unmarshal(template, &pr)
+	err = yaml.Unmarshal(template, &pr)
 	if err != nil {
-		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the PipelineRun template.")
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the PipelineRun template.").WithReason(util.ReasonInvalidPipelineSpec)
 	}
 	if pr.APIVersion == TektonBetaGroup {
-		var prV1beta1 workflowapiV1beta.PipelineRun
-		err := yaml.Unmarshal(template, &prV1beta1)
+		converted, err := convertV1beta1PipelineRun(template)
 		if err != nil {
-			return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the V1beta1 PipelineRun template.")
+			return nil, err
 		}
-		ctx := context.Background()
-		prV1beta1.ConvertTo(ctx, &pr)
-		pr.APIVersion = TektonVersion
+		pr = *converted
 	}
 	if pr.APIVersion != TektonVersion {
-		return nil, util.NewInvalidInputError("Unsupported argo or old Tekton version. Expected: %v. Received: %v", TektonVersion, pr.APIVersion)
+		return nil, util.NewInvalidInputError("Unsupported argo or old Tekton version. Expected: %v. Received: %v", TektonVersion, pr.APIVersion).WithReason(util.ReasonInvalidPipelineSpec)
 	}
 	if pr.Kind != TektonK8sResource {
-		return nil, util.NewInvalidInputError("Unexpected resource type. Expected: %v. Received: %v", TektonK8sResource, pr.Kind)
+		return nil, util.NewInvalidInputError("Unexpected resource type. Expected: %v. Received: %v", TektonK8sResource, pr.Kind).WithReason(util.ReasonInvalidPipelineSpec)
 	}
 	// TODO: Add Tekton validate
-	return util.NewWorkflow(&pr), nil
+	workflow := util.NewWorkflow(&pr)
+	if err := addResourceTemplates(workflow, resources); err != nil {
+		return nil, err
+	}
+	return workflow, nil
 }
 
 // tektonPreprocessing injects artifacts and logging steps if it's enabled
-func (t *Tekton) tektonPreprocessing(workflow util.Workflow) error {
-	// Tekton: Update artifact cred using the KFP Tekton configmap
-	workflow.SetAnnotations(common.ArtifactBucketAnnotation, common.GetArtifactBucket())
+func (t *Tekton) tektonPreprocessing(workflow util.Workflow, namespace string) error {
+	if strings.ToLower(workflow.Annotations[common.DisableInjectionPolicyAnnotation]) != "true" {
+		t.injectPolicy(workflow, common.GetInjectionPolicy())
+	}
+
+	// Tekton: Update artifact cred using the KFP Tekton configmap. The namespaces
+	// with an isolated object store push to their own bucket or prefix.
+	workflow.SetAnnotations(common.ArtifactBucketAnnotation, common.GetArtifactBucketForNamespace(namespace))
 	workflow.SetAnnotations(common.ArtifactEndpointAnnotation, common.GetArtifactEndpoint())
 	workflow.SetAnnotations(common.ArtifactEndpointSchemeAnnotation, common.GetArtifactEndpointScheme())
 
@@ -273,12 +295,12 @@ func (t *Tekton) tektonPreprocessing(workflow util.Workflow) error {
 		if err := json.Unmarshal([]byte(artifactItems), &artifactItemsJSON); err != nil {
 			return err
 		}
-		t.injectArchivalStep(workflow, artifactItemsJSON)
+		t.injectArchivalStep(workflow, artifactItemsJSON, common.GetArtifactCredentialsSecretForNamespace(namespace))
 	}
 	return nil
 }
 
-func (t *Tekton) injectArchivalStep(workflow util.Workflow, artifactItemsJSON map[string][][]interface{}) {
+func (t *Tekton) injectArchivalStep(workflow util.Workflow, artifactItemsJSON map[string][][]interface{}, credentialsSecret string) {
 	for _, task := range workflow.Spec.PipelineSpec.Tasks {
 		artifacts, hasArtifacts := artifactItemsJSON[task.Name]
 		archiveLogs := common.IsArchiveLogs()
@@ -365,8 +387,8 @@ func (t *Tekton) injectArchivalStep(workflow util.Workflow, artifactItemsJSON ma
 					t.getObjectFieldSelector("PIPELINERUN", "metadata.labels['tekton.dev/pipelineRun']"),
 					t.getObjectFieldSelector("PODNAME", "metadata.name"),
 					t.getObjectFieldSelector("NAMESPACE", "metadata.namespace"),
-					t.getSecretKeySelector("AWS_ACCESS_KEY_ID", "mlpipeline-minio-artifact", "accesskey"),
-					t.getSecretKeySelector("AWS_SECRET_ACCESS_KEY", "mlpipeline-minio-artifact", "secretkey"),
+					t.getSecretKeySelector("AWS_ACCESS_KEY_ID", credentialsSecret, "accesskey"),
+					t.getSecretKeySelector("AWS_SECRET_ACCESS_KEY", credentialsSecret, "secretkey"),
 					t.getEnvVar("ARCHIVE_LOGS", strconv.FormatBool(archiveLogs)),
 					t.getEnvVar("TRACK_ARTIFACTS", strconv.FormatBool(trackArtifacts)),
 					t.getEnvVar("STRIP_EOF", strconv.FormatBool(stripEOF)),
@@ -380,6 +402,58 @@ func (t *Tekton) injectArchivalStep(workflow util.Workflow, artifactItemsJSON ma
 	}
 }
 
+// injectPolicy adds the policy's environment to the steps of every embedded task and
+// its pull secrets to the pod template. Settings already defined by the pipeline win.
+func (t *Tekton) injectPolicy(workflow util.Workflow, policy *common.InjectionPolicy) {
+	if workflow.Spec.PipelineSpec == nil {
+		return
+	}
+	tasks := append(workflow.Spec.PipelineSpec.Tasks, workflow.Spec.PipelineSpec.Finally...)
+	for _, task := range tasks {
+		if task.TaskSpec == nil {
+			continue
+		}
+		for i := range task.TaskSpec.Steps {
+			step := &task.TaskSpec.Steps[i]
+			for _, env := range policy.Env {
+				if !hasEnvVar(step.Env, env.Name) {
+					step.Env = append(step.Env, env)
+				}
+			}
+			step.EnvFrom = append(step.EnvFrom, policy.EnvFrom...)
+		}
+	}
+
+	if len(policy.ImagePullSecrets) == 0 {
+		return
+	}
+	if workflow.Spec.TaskRunTemplate.PodTemplate == nil {
+		workflow.Spec.TaskRunTemplate.PodTemplate = &pod.PodTemplate{}
+	}
+	podTemplate := workflow.Spec.TaskRunTemplate.PodTemplate
+	for _, secret := range policy.ImagePullSecrets {
+		found := false
+		for _, existing := range podTemplate.ImagePullSecrets {
+			if existing.Name == secret.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			podTemplate.ImagePullSecrets = append(podTemplate.ImagePullSecrets, secret)
+		}
+	}
+}
+
+func hasEnvVar(envs []corev1.EnvVar, name string) bool {
+	for _, env := range envs {
+		if env.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *Tekton) injectDefaultScript(workflow util.Workflow, artifactScript string,
 	artifacts [][]interface{}, hasArtifacts, archiveLogs, trackArtifacts, stripEOF, hasMoveStep bool) string {
 	// Need to represent as Raw String Literals
@@ -400,7 +474,7 @@ func (t *Tekton) injectDefaultScript(workflow util.Workflow, artifactScript stri
 				artifactScript += fmt.Sprintf("push_artifact \"%s\" %s\n",
 					artifact[0], artifactPathName)
 			} else {
-				glog.Warningf("Artifact annotations are missing for run %v.", workflow.Name)
+				log.Warningf("Artifact annotations are missing for run %v.", workflow.Name)
 			}
 		}
 	}
@@ -412,7 +486,7 @@ func (t *Tekton) injectDefaultScript(workflow util.Workflow, artifactScript stri
 				// The below solution is in experimental stage and didn't cover all edge cases.
 				artifactScript += fmt.Sprintf("strip_eof %s %s\n", artifact[0], artifact[1])
 			} else {
-				glog.Warningf("Artifact annotations are missing for run %v.", workflow.Name)
+				log.Warningf("Artifact annotations are missing for run %v.", workflow.Name)
 			}
 		}
 	}
@@ -467,31 +541,31 @@ func (t *Tekton) applyCustomResources(workflow util.Workflow, tektonTemplates st
 	var config *rest.Config
 	config, err := rest.InClusterConfig()
 	if err != nil {
-		glog.Errorf("error creating client configuration: %v", err)
+		log.Errorf("error creating client configuration: %v", err)
 		return err
 	}
 	kubeClient, err := kubernetes.NewForConfig(config)
 	if err != nil {
-		glog.Errorf("Failed to create client: %v", err)
+		log.Errorf("Failed to create client: %v", err)
 		return err
 	}
 	var templates []interface{}
 	// Decode metadata into JSON payload.
 	err = json.Unmarshal([]byte(tektonTemplates), &templates)
 	if err != nil {
-		glog.Errorf("Failed to Unmarshal custom task CRD: %v", err)
+		log.Errorf("Failed to Unmarshal custom task CRD: %v", err)
 		return err
 	}
 	for i := range templates {
 		template := templates[i]
 		apiVersion, ok := template.(map[string]interface{})["apiVersion"].(string)
 		if !ok {
-			glog.Errorf("Failed to get Tekton custom task apiVersion")
+			log.Errorf("Failed to get Tekton custom task apiVersion")
 			return errors.New("Failed to get Tekton custom task apiVersion")
 		}
 		singlarKind, ok := template.(map[string]interface{})["kind"].(string)
 		if !ok {
-			glog.Errorf("Failed to get Tekton custom task kind")
+			log.Errorf("Failed to get Tekton custom task kind")
 			return errors.New("Failed to get Tekton custom task kind")
 		}
 		api := strings.Split(apiVersion, "/")[0]
@@ -499,12 +573,12 @@ func (t *Tekton) applyCustomResources(workflow util.Workflow, tektonTemplates st
 		resource := strings.ToLower(singlarKind) + "s"
 		name, ok := template.(map[string]interface{})["metadata"].(map[string]interface{})["name"].(string)
 		if !ok {
-			glog.Errorf("Failed to get Tekton custom task name")
+			log.Errorf("Failed to get Tekton custom task name")
 			return errors.New("Failed to get Tekton custom task name")
 		}
 		body, err := json.Marshal(template)
 		if err != nil {
-			glog.Errorf("Failed to convert to JSON: %v", err)
+			log.Errorf("Failed to convert to JSON: %v", err)
 			return err
 		}
 		// Check whether the resource is exist, if yes do a patch
@@ -524,7 +598,7 @@ func (t *Tekton) applyCustomResources(workflow util.Workflow, tektonTemplates st
 				Body(body).
 				DoRaw(context.Background())
 			if err != nil {
-				glog.Errorf("Failed to create resource for pipeline: %s, %v", workflow.Name, err)
+				log.Errorf("Failed to create resource for pipeline: %s, %v", workflow.Name, err)
 				return err
 			}
 		} else {
@@ -536,7 +610,7 @@ func (t *Tekton) applyCustomResources(workflow util.Workflow, tektonTemplates st
 				Body(body).
 				DoRaw(context.Background())
 			if err != nil {
-				glog.Errorf("Failed to patch resource for pipeline: %s, %v", workflow.Name, err)
+				log.Errorf("Failed to patch resource for pipeline: %s, %v", workflow.Name, err)
 				return err
 			}
 		}
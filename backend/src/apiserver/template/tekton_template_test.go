@@ -0,0 +1,146 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var injectionTemplate = `
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: injection
+spec:
+  pipelineSpec:
+    tasks:
+    - name: task
+      taskSpec:
+        steps:
+        - name: main
+          image: busybox
+          env:
+          - name: HTTP_PROXY
+            value: http://pipeline-proxy
+    finally:
+    - name: cleanup
+      taskSpec:
+        steps:
+        - name: main
+          image: busybox
+`
+
+func TestInjectPolicy(t *testing.T) {
+	tmpl, err := NewTektonTemplate([]byte(injectionTemplate))
+	assert.Nil(t, err)
+	policy := &common.InjectionPolicy{
+		Env: []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://cluster-proxy"},
+			{Name: "NO_PROXY", Value: ".svc"},
+		},
+		EnvFrom: []corev1.EnvFromSource{
+			{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "common"}}},
+		},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+	}
+
+	tmpl.injectPolicy(*tmpl.wf, policy)
+
+	steps := tmpl.wf.Spec.PipelineSpec.Tasks[0].TaskSpec.Steps
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: "http://pipeline-proxy"},
+		{Name: "NO_PROXY", Value: ".svc"},
+	}, steps[0].Env)
+	assert.Equal(t, policy.EnvFrom, steps[0].EnvFrom)
+	finallySteps := tmpl.wf.Spec.PipelineSpec.Finally[0].TaskSpec.Steps
+	assert.Equal(t, policy.Env, finallySteps[0].Env)
+	assert.Equal(t, policy.ImagePullSecrets, tmpl.wf.Spec.TaskRunTemplate.PodTemplate.ImagePullSecrets)
+
+	// Injecting twice doesn't duplicate pull secrets.
+	tmpl.injectPolicy(*tmpl.wf, policy)
+	assert.Equal(t, policy.ImagePullSecrets, tmpl.wf.Spec.TaskRunTemplate.PodTemplate.ImagePullSecrets)
+}
+
+func TestInjectPolicy_Empty(t *testing.T) {
+	tmpl, err := NewTektonTemplate([]byte(injectionTemplate))
+	assert.Nil(t, err)
+
+	tmpl.injectPolicy(*tmpl.wf, &common.InjectionPolicy{})
+
+	assert.Len(t, tmpl.wf.Spec.PipelineSpec.Tasks[0].TaskSpec.Steps[0].Env, 1)
+	assert.Nil(t, tmpl.wf.Spec.TaskRunTemplate.PodTemplate)
+}
+
+var artifactTemplate = `
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: artifacts
+  annotations:
+    tekton.dev/artifact_items: '{"task": [["out", "$(results.out.path)"]]}'
+    tekton.dev/track_artifact: "true"
+spec:
+  pipelineSpec:
+    tasks:
+    - name: task
+      taskSpec:
+        results:
+        - name: out
+        steps:
+        - name: main
+          image: busybox
+`
+
+func TestTektonPreprocessing_NamespaceIsolation(t *testing.T) {
+	viper.Set(common.ObjectStoreNamespacesConfig, map[string]interface{}{
+		"ns1": map[string]interface{}{"Prefix": "tenants/ns1", "CredentialsSecret": "ns1-s3"},
+	})
+	defer viper.Set(common.ObjectStoreNamespacesConfig, nil)
+	tmpl, err := NewTektonTemplate([]byte(artifactTemplate))
+	assert.Nil(t, err)
+
+	assert.Nil(t, tmpl.tektonPreprocessing(*tmpl.wf, "ns1"))
+
+	assert.Equal(t, "mlpipeline/tenants/ns1", tmpl.wf.Annotations[common.ArtifactBucketAnnotation])
+	steps := tmpl.wf.Spec.PipelineSpec.Tasks[0].TaskSpec.Steps
+	secrets := map[string]string{}
+	for _, env := range steps[len(steps)-1].Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+			secrets[env.Name] = env.ValueFrom.SecretKeyRef.Name
+		}
+	}
+	assert.Equal(t, map[string]string{"AWS_ACCESS_KEY_ID": "ns1-s3", "AWS_SECRET_ACCESS_KEY": "ns1-s3"}, secrets)
+}
+
+func TestRunWorkflow_DisableCache(t *testing.T) {
+	tmpl, err := NewTektonTemplate([]byte(injectionTemplate))
+	assert.Nil(t, err)
+	options := RunWorkflowOptions{RunId: "123e4567-e89b-12d3-a456-426655440000", RunAt: 0}
+
+	workflow, err := tmpl.RunWorkflow(&api.Run{Name: "run1"}, options, "ns1")
+	assert.Nil(t, err)
+	assert.NotContains(t, workflow.Annotations, util.AnnotationKeyDisableCache)
+
+	workflow, err = tmpl.RunWorkflow(&api.Run{Name: "run1", DisableCache: true}, options, "ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, "true", workflow.Annotations[util.AnnotationKeyDisableCache])
+}
@@ -94,14 +94,23 @@ func inferTemplateFormat(template []byte) TemplateType {
 	}
 }
 
-// isTektonWorkflow returns whether template is in argo workflow spec format.
+// isTektonWorkflow returns whether template is in Tekton PipelineRun format. For
+// multi-document templates, any of the documents can be the PipelineRun.
 func isTektonWorkflow(template []byte) bool {
-	var meta metav1.TypeMeta
-	err := yaml.Unmarshal(template, &meta)
+	documents, err := splitDocuments(template)
 	if err != nil {
 		return false
 	}
-	return strings.HasPrefix(meta.APIVersion, TektonGroup) && meta.Kind == TektonK8sResource
+	for _, document := range documents {
+		var meta metav1.TypeMeta
+		if err := yaml.Unmarshal(document, &meta); err != nil {
+			continue
+		}
+		if strings.HasPrefix(meta.APIVersion, TektonGroup) && meta.Kind == TektonK8sResource {
+			return true
+		}
+	}
+	return false
 }
 
 // isPipelineSpec returns whether template is in KFP api/v2alpha1/PipelineSpec format.
@@ -124,6 +133,8 @@ type Template interface {
 	// Get bytes content.
 	Bytes() []byte
 	GetTemplateType() TemplateType
+	// Statically analyzes the template for common failure modes.
+	Analyze() []Warning
 
 	//Get workflow
 	RunWorkflow(apiRun *api.Run, options RunWorkflowOptions, namespace string) (*util.Workflow, error)
@@ -144,10 +155,43 @@ func New(bytes []byte) (Template, error) {
 	case V2:
 		return NewV2SpecTemplate(bytes)
 	default:
-		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "unknown template format")
+		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "unknown template format").WithReason(util.ReasonInvalidPipelineSpec)
 	}
 }
 
+// Compile returns the PipelineRun the server would store for the template, e.g.
+// converted to tekton.dev/v1 and with auxiliary documents folded in, along with the
+// template's analysis warnings. v2 pipeline specs and Argo Workflows are compiled to
+// Tekton. No pipeline is created.
+func Compile(bytes []byte) (*util.Workflow, []Warning, error) {
+	var tekton *Tekton
+	if isArgoWorkflow(bytes) {
+		pr, err := compileArgo(bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		tekton, _ = NewTektonTemplateFromWorkflow(pr)
+	} else {
+		tmpl, err := New(bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch t := tmpl.(type) {
+		case *Tekton:
+			tekton = t
+		case *V2Spec:
+			pr, err := compileV2(t.spec)
+			if err != nil {
+				return nil, nil, err
+			}
+			tekton, _ = NewTektonTemplateFromWorkflow(pr)
+		default:
+			return nil, nil, util.NewInvalidInputError("Compiling %v templates is not supported.", tmpl.GetTemplateType())
+		}
+	}
+	return util.NewWorkflow(tekton.wf.PipelineRun.DeepCopy()), tekton.Analyze(), nil
+}
+
 func toParametersMap(apiParams []*api.Parameter) map[string]string {
 	// Preprocess workflow by appending parameter and add pipeline specific labels
 	desiredParamsMap := make(map[string]string)
@@ -157,6 +201,16 @@ func toParametersMap(apiParams []*api.Parameter) map[string]string {
 	return desiredParamsMap
 }
 
+// getExperimentId returns the ID of the experiment owning the run or job, if any.
+func getExperimentId(references []*api.ResourceReference) string {
+	for _, ref := range references {
+		if ref.GetKey().GetType() == api.ResourceType_EXPERIMENT && ref.GetRelationship() == api.Relationship_OWNER {
+			return ref.GetKey().GetId()
+		}
+	}
+	return ""
+}
+
 // Patch the system-specified default parameters if available.
 func OverrideParameterWithSystemDefault(workflow *util.Workflow) error {
 	if common.GetBoolConfigWithDefault(common.HasDefaultBucketEnvVar, false) {
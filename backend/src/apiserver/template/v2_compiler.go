@@ -0,0 +1,567 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// The file the executor input's outputs.outputFile points to. Components write
+	// their executor output to it.
+	v2ExecutorOutputFile = "/tekton/home/kfp/output_metadata.json"
+
+	// The script of the metadata writer image registering importer artifacts.
+	v2ImporterScript = "/kfp/metadata_writer/importer.py"
+	// The schema of imported artifacts without a type schema.
+	v2DefaultArtifactSchema = "system.Artifact"
+)
+
+var (
+	v2PlaceholderRegex      = regexp.MustCompile(`\{\{\$[^}]*\}\}`)
+	v2InputParameterRegex   = regexp.MustCompile(`^\{\{\$\.inputs\.parameters\['([^']+)'\]\}\}$`)
+	v2OutputParameterRegex  = regexp.MustCompile(`^\{\{\$\.outputs\.parameters\['([^']+)'\]\.output_file\}\}$`)
+	v2InputArtifactRegex    = regexp.MustCompile(`^\{\{\$\.inputs\.artifacts\['([^']+)'\]\.uri\}\}$`)
+	v2ConditionRegex        = regexp.MustCompile(`^inputs\.(?:parameter_values|parameters)\['([^']+)'\](?:\.(?:string_value|int_value|double_value))?\s*(==|!=)\s*(.+)$`)
+	v2ContextPlaceholderMap = map[string]string{
+		"{{$.pipeline_job_name}}": "$(context.pipelineRun.name)",
+		"{{$.pipeline_job_uuid}}": "$(context.pipelineRun.uid)",
+	}
+)
+
+// The subset of the v2 IR (the KFP PipelineSpec) compiled to Tekton. The IR is
+// decoded from its JSON form, so the compiler doesn't depend on the version of the
+// pipelinespec protos, e.g. both the deprecated parameter types and the
+// parameterType field are read.
+type irPipelineSpec struct {
+	PipelineInfo struct {
+		Name string `json:"name"`
+	} `json:"pipelineInfo"`
+	Root           irComponent            `json:"root"`
+	Components     map[string]irComponent `json:"components"`
+	DeploymentSpec struct {
+		Executors map[string]irExecutor `json:"executors"`
+	} `json:"deploymentSpec"`
+}
+
+type irComponent struct {
+	InputDefinitions  irDefinitions `json:"inputDefinitions"`
+	OutputDefinitions irDefinitions `json:"outputDefinitions"`
+	Dag               *struct {
+		Tasks map[string]irTask `json:"tasks"`
+	} `json:"dag"`
+	ExecutorLabel string `json:"executorLabel"`
+}
+
+type irDefinitions struct {
+	Parameters map[string]irParameterSpec `json:"parameters"`
+	Artifacts  map[string]irArtifactSpec  `json:"artifacts"`
+}
+
+type irParameterSpec struct {
+	// Type is the deprecated PrimitiveType of the parameter.
+	Type          string          `json:"type"`
+	ParameterType string          `json:"parameterType"`
+	DefaultValue  json.RawMessage `json:"defaultValue"`
+}
+
+type irArtifactSpec struct {
+	ArtifactType irArtifactType `json:"artifactType"`
+}
+
+type irArtifactType struct {
+	SchemaTitle string `json:"schemaTitle"`
+}
+
+type irTask struct {
+	ComponentRef struct {
+		Name string `json:"name"`
+	} `json:"componentRef"`
+	DependentTasks []string `json:"dependentTasks"`
+	Inputs         struct {
+		Parameters map[string]irParameterInput `json:"parameters"`
+		Artifacts  map[string]irArtifactInput  `json:"artifacts"`
+	} `json:"inputs"`
+	TriggerPolicy struct {
+		Condition string `json:"condition"`
+	} `json:"triggerPolicy"`
+	RetryPolicy struct {
+		MaxRetryCount int `json:"maxRetryCount"`
+	} `json:"retryPolicy"`
+}
+
+type irParameterInput struct {
+	ComponentInputParameter string `json:"componentInputParameter"`
+	TaskOutputParameter     *struct {
+		ProducerTask       string `json:"producerTask"`
+		OutputParameterKey string `json:"outputParameterKey"`
+	} `json:"taskOutputParameter"`
+	RuntimeValue *irValueOrRuntimeParameter `json:"runtimeValue"`
+}
+
+type irArtifactInput struct {
+	TaskOutputArtifact *struct {
+		ProducerTask      string `json:"producerTask"`
+		OutputArtifactKey string `json:"outputArtifactKey"`
+	} `json:"taskOutputArtifact"`
+}
+
+// irValueOrRuntimeParameter is either a constant, in its current (constant) or
+// deprecated (constantValue) form, or the name of an input parameter of the task.
+type irValueOrRuntimeParameter struct {
+	Constant         json.RawMessage `json:"constant"`
+	ConstantValue    json.RawMessage `json:"constantValue"`
+	RuntimeParameter string          `json:"runtimeParameter"`
+}
+
+type irExecutor struct {
+	Container *struct {
+		Image   string   `json:"image"`
+		Command []string `json:"command"`
+		Args    []string `json:"args"`
+		Env     []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"env"`
+	} `json:"container"`
+	Importer *irImporter `json:"importer"`
+}
+
+// irImporter registers an external artifact, e.g. a dataset in object storage, so
+// it can be consumed by the tasks of the pipeline.
+type irImporter struct {
+	ArtifactURI irValueOrRuntimeParameter `json:"artifactUri"`
+	TypeSchema  irArtifactType            `json:"typeSchema"`
+	Metadata    map[string]interface{}    `json:"metadata"`
+	Reimport    bool                      `json:"reimport"`
+}
+
+// compileV2 compiles the v2 IR of a pipeline to a Tekton PipelineRun. The tasks of
+// the root DAG are compiled to pipeline tasks running the container of their
+// component's executor, or registering the artifact of their importer in ML
+// Metadata. Parameters, and the URIs of artifacts, are passed as Tekton params and
+// results. Sub-DAGs and the output artifacts of containers aren't supported yet.
+func compileV2(spec *pipelinespec.PipelineSpec) (*workflowapi.PipelineRun, error) {
+	data, err := protojson.Marshal(spec)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to marshal the v2 pipeline spec")
+	}
+	var ir irPipelineSpec
+	if err := json.Unmarshal(data, &ir); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the v2 pipeline spec.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+	if ir.Root.Dag == nil {
+		return nil, util.NewInvalidInputError("The root component of the v2 pipeline spec must be a DAG.").WithReason(util.ReasonInvalidPipelineSpec)
+	}
+
+	pr := newCompiledPipelineRun(ir.PipelineInfo.Name)
+	for _, name := range sortedParameterSpecNames(ir.Root.InputDefinitions.Parameters) {
+		defaultValue, err := irDefaultValue(ir.Root.InputDefinitions.Parameters[name])
+		if err != nil {
+			return nil, util.Wrapf(err, "Invalid default value of pipeline parameter %q", name)
+		}
+		addPipelineParam(pr, name, defaultValue)
+	}
+	taskNames := make([]string, 0, len(ir.Root.Dag.Tasks))
+	for name := range ir.Root.Dag.Tasks {
+		taskNames = append(taskNames, name)
+	}
+	sort.Strings(taskNames)
+	for _, name := range taskNames {
+		task, err := ir.compileTask(name, ir.Root.Dag.Tasks[name])
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to compile task %q", name)
+		}
+		pr.Spec.PipelineSpec.Tasks = append(pr.Spec.PipelineSpec.Tasks, *task)
+	}
+	return pr, nil
+}
+
+func (ir *irPipelineSpec) compileTask(name string, task irTask) (*workflowapi.PipelineTask, error) {
+	component, ok := ir.Components[task.ComponentRef.Name]
+	if !ok {
+		return nil, util.NewInvalidInputError("Unknown component %q.", task.ComponentRef.Name)
+	}
+	if component.Dag != nil {
+		return nil, util.NewInvalidInputError("Sub-DAG components aren't supported yet.")
+	}
+	executor, ok := ir.DeploymentSpec.Executors[component.ExecutorLabel]
+	if !ok {
+		return nil, util.NewInvalidInputError("Unknown executor %q.", component.ExecutorLabel)
+	}
+	if executor.Container == nil && executor.Importer == nil {
+		return nil, util.NewInvalidInputError("Executor %q isn't supported, only container and importer executors are.", component.ExecutorLabel)
+	}
+	if executor.Container != nil && len(component.OutputDefinitions.Artifacts) > 0 {
+		return nil, util.NewInvalidInputError("Output artifacts of container components aren't supported yet.")
+	}
+
+	pipelineTask := &workflowapi.PipelineTask{
+		Name:     tektonName(name),
+		TaskSpec: &workflowapi.EmbeddedTask{},
+		Retries:  task.RetryPolicy.MaxRetryCount,
+	}
+	for _, dependency := range task.DependentTasks {
+		pipelineTask.RunAfter = append(pipelineTask.RunAfter, tektonName(dependency))
+	}
+
+	inputs := make(map[string]string)
+	for _, input := range sortedParameterInputNames(task.Inputs.Parameters) {
+		value, err := irParameterValue(task.Inputs.Parameters[input])
+		if err != nil {
+			return nil, util.Wrapf(err, "Invalid input parameter %q", input)
+		}
+		inputs[input] = value
+		pipelineTask.Params = append(pipelineTask.Params, stringParam(input, value))
+	}
+	for _, input := range sortedArtifactInputNames(task.Inputs.Artifacts) {
+		artifact := task.Inputs.Artifacts[input]
+		if artifact.TaskOutputArtifact == nil {
+			return nil, util.NewInvalidInputError("Input artifact %q must be the output artifact of a task.", input)
+		}
+		value := fmt.Sprintf("$(tasks.%s.results.%s)", tektonName(artifact.TaskOutputArtifact.ProducerTask), artifact.TaskOutputArtifact.OutputArtifactKey)
+		pipelineTask.Params = append(pipelineTask.Params, stringParam(input, value))
+	}
+	if condition := task.TriggerPolicy.Condition; condition != "" {
+		when, err := irWhenExpressions(condition, inputs)
+		if err != nil {
+			return nil, err
+		}
+		pipelineTask.When = when
+	}
+
+	taskSpec := &pipelineTask.TaskSpec.TaskSpec
+	for _, input := range sortedParameterSpecNames(component.InputDefinitions.Parameters) {
+		defaultValue, err := irDefaultValue(component.InputDefinitions.Parameters[input])
+		if err != nil {
+			return nil, util.Wrapf(err, "Invalid default value of input parameter %q", input)
+		}
+		taskSpec.Params = append(taskSpec.Params, paramSpec(input, defaultValue))
+	}
+	for _, input := range sortedArtifactSpecNames(component.InputDefinitions.Artifacts) {
+		taskSpec.Params = append(taskSpec.Params, paramSpec(input, nil))
+	}
+	// Inputs the component doesn't declare, e.g. the URI of importers, are declared too.
+	for _, param := range pipelineTask.Params {
+		if !declaresTaskParam(taskSpec, param.Name) {
+			taskSpec.Params = append(taskSpec.Params, paramSpec(param.Name, nil))
+		}
+	}
+	for _, output := range sortedParameterSpecNames(component.OutputDefinitions.Parameters) {
+		taskSpec.Results = append(taskSpec.Results, stringResult(output))
+	}
+
+	if executor.Importer != nil {
+		step, output, err := importerStep(executor.Importer, component)
+		if err != nil {
+			return nil, err
+		}
+		// The result of the importer is the URI of its artifact.
+		taskSpec.Results = append(taskSpec.Results, stringResult(output))
+		taskSpec.Steps = []workflowapi.Step{*step}
+		return pipelineTask, nil
+	}
+
+	step := workflowapi.Step{Name: compiledStepName, Image: executor.Container.Image}
+	var err error
+	if step.Command, err = resolveV2Placeholders(executor.Container.Command, component); err != nil {
+		return nil, err
+	}
+	if step.Args, err = resolveV2Placeholders(executor.Container.Args, component); err != nil {
+		return nil, err
+	}
+	for _, env := range executor.Container.Env {
+		step.Env = append(step.Env, corev1.EnvVar{Name: env.Name, Value: env.Value})
+	}
+	taskSpec.Steps = []workflowapi.Step{step}
+	return pipelineTask, nil
+}
+
+// importerStep returns the step registering the artifact of an importer in ML
+// Metadata, unless an artifact of the same URI and type exists and reimport isn't
+// set, and the name of the output artifact, whose URI the step writes to the
+// result of the same name. The step runs a script of the metadata writer image.
+func importerStep(importer *irImporter, component irComponent) (*workflowapi.Step, string, error) {
+	outputs := sortedArtifactSpecNames(component.OutputDefinitions.Artifacts)
+	if len(outputs) != 1 {
+		return nil, "", util.NewInvalidInputError("Importers must have exactly one output artifact, got %d.", len(outputs))
+	}
+	output := outputs[0]
+	uri, err := irConstant(importer.ArtifactURI)
+	if err != nil {
+		return nil, "", util.Wrap(err, "Invalid artifact URI of the importer")
+	}
+	metadata := "{}"
+	if len(importer.Metadata) > 0 {
+		bytes, err := json.Marshal(importer.Metadata)
+		if err != nil {
+			return nil, "", util.NewInvalidInputErrorWithDetails(err, "Failed to encode the metadata of the importer.")
+		}
+		metadata = string(bytes)
+	}
+	schema := importer.TypeSchema.SchemaTitle
+	if schema == "" {
+		schema = component.OutputDefinitions.Artifacts[output].ArtifactType.SchemaTitle
+	}
+	if schema == "" {
+		schema = v2DefaultArtifactSchema
+	}
+
+	args, err := resolveV2Placeholders([]string{
+		"--uri", uri,
+		"--type-name", schema,
+		"--metadata", metadata,
+		"--run-id", "$(context.pipelineRun.name)",
+		"--output-name", output,
+		"--uri-path", fmt.Sprintf("$(results.%s.path)", output),
+	}, component)
+	if err != nil {
+		return nil, "", err
+	}
+	if importer.Reimport {
+		args = append(args, "--reimport")
+	}
+	return &workflowapi.Step{
+		Name:    compiledStepName,
+		Image:   common.GetImporterImage(),
+		Command: []string{"python3", "-u", v2ImporterScript},
+		Args:    args,
+		Env: []corev1.EnvVar{
+			{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			{Name: "METADATA_GRPC_SERVICE_SERVICE_HOST", Value: common.GetImporterMetadataHost()},
+			{Name: "METADATA_GRPC_SERVICE_SERVICE_PORT", Value: common.GetImporterMetadataPort()},
+		},
+	}, output, nil
+}
+
+// irParameterValue returns the Tekton value of a task's input parameter.
+func irParameterValue(input irParameterInput) (string, error) {
+	switch {
+	case input.ComponentInputParameter != "":
+		return fmt.Sprintf("$(params.%s)", input.ComponentInputParameter), nil
+	case input.TaskOutputParameter != nil:
+		return fmt.Sprintf("$(tasks.%s.results.%s)", tektonName(input.TaskOutputParameter.ProducerTask), input.TaskOutputParameter.OutputParameterKey), nil
+	case input.RuntimeValue != nil:
+		return irConstant(*input.RuntimeValue)
+	default:
+		return "", util.NewInvalidInputError("The parameter has no value.")
+	}
+}
+
+// irConstant returns the string form of a constant, or the reference to the
+// pipeline parameter of a runtime parameter.
+func irConstant(value irValueOrRuntimeParameter) (string, error) {
+	switch {
+	case value.RuntimeParameter != "":
+		return fmt.Sprintf("$(params.%s)", value.RuntimeParameter), nil
+	case len(value.Constant) > 0:
+		return irValueString(value.Constant)
+	case len(value.ConstantValue) > 0:
+		return irValueString(value.ConstantValue)
+	default:
+		return "", util.NewInvalidInputError("The value is empty.")
+	}
+}
+
+// irDefaultValue returns the string form of the default value of a parameter, or
+// nil if it has none.
+func irDefaultValue(spec irParameterSpec) (*string, error) {
+	if len(spec.DefaultValue) == 0 {
+		return nil, nil
+	}
+	value, err := irValueString(spec.DefaultValue)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// irValueString returns the string form of a value, either a google.protobuf.Value
+// or a deprecated pipelinespec Value, e.g. {"intValue": "3"}.
+func irValueString(raw json.RawMessage) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", util.NewInvalidInputErrorWithDetails(err, "Failed to parse the value.")
+	}
+	if fields, ok := value.(map[string]interface{}); ok && len(fields) == 1 {
+		for _, key := range []string{"stringValue", "intValue", "doubleValue"} {
+			if field, ok := fields[key]; ok {
+				value = field
+			}
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		bytes, err := json.Marshal(v)
+		if err != nil {
+			return "", util.NewInvalidInputErrorWithDetails(err, "Failed to encode the value.")
+		}
+		return string(bytes), nil
+	}
+}
+
+// irWhenExpressions compiles a trigger condition, e.g.
+// "inputs.parameter_values['flip'] == 'heads'". Conditions joined by "&&" are
+// compiled to several when expressions, which Tekton ANDs.
+func irWhenExpressions(condition string, inputs map[string]string) (workflowapi.WhenExpressions, error) {
+	var expressions workflowapi.WhenExpressions
+	for _, clause := range strings.Split(condition, "&&") {
+		match := v2ConditionRegex.FindStringSubmatch(strings.TrimSpace(clause))
+		if match == nil {
+			return nil, util.NewInvalidInputError("Unsupported trigger condition %q.", condition)
+		}
+		input, ok := inputs[match[1]]
+		if !ok {
+			return nil, util.NewInvalidInputError("The trigger condition refers to unknown input %q.", match[1])
+		}
+		expression, err := whenExpression(input, match[2], strings.Trim(strings.TrimSpace(match[3]), `'"`))
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expression)
+	}
+	return expressions, nil
+}
+
+// resolveV2Placeholders replaces the IR placeholders of a container's command or
+// arguments with their Tekton variables.
+func resolveV2Placeholders(values []string, component irComponent) ([]string, error) {
+	var resolved []string
+	for _, value := range values {
+		var err error
+		result := v2PlaceholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+			if placeholder == "{{$}}" {
+				return v2ExecutorInput(component)
+			}
+			if match := v2InputParameterRegex.FindStringSubmatch(placeholder); match != nil {
+				return fmt.Sprintf("$(params.%s)", match[1])
+			}
+			if match := v2OutputParameterRegex.FindStringSubmatch(placeholder); match != nil {
+				return fmt.Sprintf("$(results.%s.path)", match[1])
+			}
+			if match := v2InputArtifactRegex.FindStringSubmatch(placeholder); match != nil {
+				return fmt.Sprintf("$(params.%s)", match[1])
+			}
+			if variable, ok := v2ContextPlaceholderMap[placeholder]; ok {
+				return variable
+			}
+			if err == nil {
+				err = util.NewInvalidInputError("Unsupported placeholder %q.", placeholder)
+			}
+			return placeholder
+		})
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, result)
+	}
+	return resolved, nil
+}
+
+// v2ExecutorInput returns the executor input of the {{$}} placeholder, the JSON
+// the KFP SDK's executor reads the input values, the URIs of the input artifacts and
+// the output files from. The values of string parameters are quoted, so they must
+// not contain quotes.
+func v2ExecutorInput(component irComponent) string {
+	var values []string
+	for _, name := range sortedParameterSpecNames(component.InputDefinitions.Parameters) {
+		value := fmt.Sprintf("$(params.%s)", name)
+		if isStringParameter(component.InputDefinitions.Parameters[name]) {
+			value = jsonString(value)
+		}
+		values = append(values, fmt.Sprintf("%s:%s", jsonString(name), value))
+	}
+	inputs := fmt.Sprintf(`"parameterValues":{%s}`, strings.Join(values, ","))
+	var artifacts []string
+	for _, name := range sortedArtifactSpecNames(component.InputDefinitions.Artifacts) {
+		schema := component.InputDefinitions.Artifacts[name].ArtifactType.SchemaTitle
+		artifacts = append(artifacts, fmt.Sprintf(`%s:{"artifacts":[{"uri":%s,"type":{"schemaTitle":%s}}]}`,
+			jsonString(name), jsonString(fmt.Sprintf("$(params.%s)", name)), jsonString(schema)))
+	}
+	if len(artifacts) > 0 {
+		inputs += fmt.Sprintf(`,"artifacts":{%s}`, strings.Join(artifacts, ","))
+	}
+	var outputs []string
+	for _, name := range sortedParameterSpecNames(component.OutputDefinitions.Parameters) {
+		outputs = append(outputs, fmt.Sprintf(`%s:{"outputFile":%s}`, jsonString(name), jsonString(fmt.Sprintf("$(results.%s.path)", name))))
+	}
+	return fmt.Sprintf(`{"inputs":{%s},"outputs":{"parameters":{%s},"outputFile":%s}}`,
+		inputs, strings.Join(outputs, ","), jsonString(v2ExecutorOutputFile))
+}
+
+func isStringParameter(spec irParameterSpec) bool {
+	switch {
+	case spec.ParameterType != "":
+		return spec.ParameterType == "STRING"
+	case spec.Type != "":
+		return spec.Type == "STRING"
+	default:
+		return true
+	}
+}
+
+func sortedParameterSpecNames(parameters map[string]irParameterSpec) []string {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedParameterInputNames(parameters map[string]irParameterInput) []string {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedArtifactSpecNames(artifacts map[string]irArtifactSpec) []string {
+	names := make([]string, 0, len(artifacts))
+	for name := range artifacts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedArtifactInputNames(artifacts map[string]irArtifactInput) []string {
+	names := make([]string, 0, len(artifacts))
+	for name := range artifacts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+var v2SpecFlipCoin = `
+{
+  "pipelineInfo": {"name": "flip-coin"},
+  "root": {
+    "inputDefinitions": {"parameters": {"seed": {"type": "INT"}}},
+    "dag": {
+      "tasks": {
+        "flip-coin": {
+          "taskInfo": {"name": "flip-coin"},
+          "componentRef": {"name": "comp-flip-coin"},
+          "inputs": {"parameters": {"seed": {"componentInputParameter": "seed"}}}
+        },
+        "print-msg": {
+          "taskInfo": {"name": "print-msg"},
+          "componentRef": {"name": "comp-print-msg"},
+          "dependentTasks": ["flip-coin"],
+          "inputs": {
+            "parameters": {
+              "msg": {"runtimeValue": {"constantValue": {"stringValue": "heads!"}}},
+              "pipelinechannel--flip-coin-Output": {"taskOutputParameter": {"producerTask": "flip-coin", "outputParameterKey": "Output"}}
+            }
+          },
+          "triggerPolicy": {"condition": "inputs.parameters['pipelinechannel--flip-coin-Output'].string_value == 'heads'"}
+        }
+      }
+    }
+  },
+  "components": {
+    "comp-flip-coin": {
+      "executorLabel": "exec-flip-coin",
+      "inputDefinitions": {"parameters": {"seed": {"type": "INT"}}},
+      "outputDefinitions": {"parameters": {"Output": {"type": "STRING"}}}
+    },
+    "comp-print-msg": {
+      "executorLabel": "exec-print-msg",
+      "inputDefinitions": {"parameters": {"msg": {"type": "STRING"}, "pipelinechannel--flip-coin-Output": {"type": "STRING"}}}
+    }
+  },
+  "deploymentSpec": {
+    "executors": {
+      "exec-flip-coin": {
+        "container": {
+          "image": "python:3.9",
+          "command": ["flip"],
+          "args": ["--seed", "{{$.inputs.parameters['seed']}}", "--output", "{{$.outputs.parameters['Output'].output_file}}"]
+        }
+      },
+      "exec-print-msg": {
+        "container": {"image": "alpine", "command": ["echo"], "args": ["{{$.inputs.parameters['msg']}}"]}
+      }
+    }
+  },
+  "schemaVersion": "2.0.0",
+  "sdkVersion": "kfp-1.8.0"
+}
+`
+
+func TestCompileV2(t *testing.T) {
+	tmpl, err := NewV2SpecTemplate([]byte(v2SpecFlipCoin))
+	require.Nil(t, err)
+	pr, err := compileV2(tmpl.spec)
+	require.Nil(t, err)
+
+	assert.Equal(t, TektonVersion, pr.APIVersion)
+	assert.Equal(t, TektonK8sResource, pr.Kind)
+	assert.Equal(t, "flip-coin", pr.Name)
+	require.Len(t, pr.Spec.PipelineSpec.Params, 1)
+	assert.Equal(t, "seed", pr.Spec.PipelineSpec.Params[0].Name)
+	require.Len(t, pr.Spec.PipelineSpec.Tasks, 2)
+
+	flip := pr.Spec.PipelineSpec.Tasks[0]
+	assert.Equal(t, "flip-coin", flip.Name)
+	assert.Equal(t, workflowapi.Params{stringParam("seed", "$(params.seed)")}, flip.Params)
+	assert.Equal(t, []workflowapi.TaskResult{stringResult("Output")}, flip.TaskSpec.Results)
+	require.Len(t, flip.TaskSpec.Steps, 1)
+	assert.Equal(t, "python:3.9", flip.TaskSpec.Steps[0].Image)
+	assert.Equal(t, []string{"--seed", "$(params.seed)", "--output", "$(results.Output.path)"}, flip.TaskSpec.Steps[0].Args)
+
+	printMsg := pr.Spec.PipelineSpec.Tasks[1]
+	assert.Equal(t, "print-msg", printMsg.Name)
+	assert.Equal(t, []string{"flip-coin"}, printMsg.RunAfter)
+	assert.Equal(t, workflowapi.Params{
+		stringParam("msg", "heads!"),
+		stringParam("pipelinechannel--flip-coin-Output", "$(tasks.flip-coin.results.Output)"),
+	}, printMsg.Params)
+	assert.Equal(t, workflowapi.WhenExpressions{{
+		Input:    "$(tasks.flip-coin.results.Output)",
+		Operator: selection.In,
+		Values:   []string{"heads"},
+	}}, printMsg.When)
+	assert.Equal(t, []string{"$(params.msg)"}, printMsg.TaskSpec.Steps[0].Args)
+
+	// The pipeline parameter has no default value.
+	warnings := analyzePipelineRun(pr)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, WarningUnboundParameter, warnings[0].Code)
+}
+
+func TestCompileV2_UnknownComponent(t *testing.T) {
+	ir := &irPipelineSpec{}
+	_, err := ir.compileTask("task", irTask{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unknown component")
+}
+
+func TestResolveV2Placeholders(t *testing.T) {
+	component := irComponent{
+		InputDefinitions: irDefinitions{Parameters: map[string]irParameterSpec{
+			"text":  {Type: "STRING"},
+			"count": {ParameterType: "NUMBER_INTEGER"},
+		}},
+		OutputDefinitions: irDefinitions{Parameters: map[string]irParameterSpec{"Output": {Type: "STRING"}}},
+	}
+	resolved, err := resolveV2Placeholders([]string{"--executor_input", "{{$}}", "--run={{$.pipeline_job_name}}"}, component)
+	require.Nil(t, err)
+	assert.Equal(t, []string{
+		"--executor_input",
+		`{"inputs":{"parameterValues":{"count":$(params.count),"text":"$(params.text)"}},"outputs":{"parameters":{"Output":{"outputFile":"$(results.Output.path)"}},"outputFile":"/tekton/home/kfp/output_metadata.json"}}`,
+		"--run=$(context.pipelineRun.name)",
+	}, resolved)
+
+	_, err = resolveV2Placeholders([]string{"{{$.inputs.artifacts['data'].path}}"}, component)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unsupported placeholder")
+}
+
+func TestIrValueString(t *testing.T) {
+	for raw, expected := range map[string]string{
+		`"text"`:                 "text",
+		`3`:                      "3",
+		`1.5`:                    "1.5",
+		`true`:                   "true",
+		`[1,2]`:                  "[1,2]",
+		`{"intValue": "7"}`:      "7",
+		`{"stringValue": "abc"}`: "abc",
+	} {
+		value, err := irValueString([]byte(raw))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, value, raw)
+	}
+}
+
+func TestCompileV2_Importer(t *testing.T) {
+	tmpl, err := NewV2SpecTemplate([]byte(`
+{
+  "pipelineInfo": {"name": "importer"},
+  "root": {
+    "inputDefinitions": {"parameters": {"dataset": {"type": "STRING"}}},
+    "dag": {
+      "tasks": {
+        "importer": {
+          "taskInfo": {"name": "importer"},
+          "componentRef": {"name": "comp-importer"},
+          "inputs": {"parameters": {"uri": {"componentInputParameter": "dataset"}}}
+        },
+        "train": {
+          "taskInfo": {"name": "train"},
+          "componentRef": {"name": "comp-train"},
+          "dependentTasks": ["importer"],
+          "inputs": {"artifacts": {"data": {"taskOutputArtifact": {"producerTask": "importer", "outputArtifactKey": "artifact"}}}}
+        }
+      }
+    }
+  },
+  "components": {
+    "comp-importer": {
+      "executorLabel": "exec-importer",
+      "inputDefinitions": {"parameters": {"uri": {"type": "STRING"}}},
+      "outputDefinitions": {"artifacts": {"artifact": {"artifactType": {"schemaTitle": "system.Dataset"}}}}
+    },
+    "comp-train": {
+      "executorLabel": "exec-train",
+      "inputDefinitions": {"artifacts": {"data": {"artifactType": {"schemaTitle": "system.Dataset"}}}}
+    }
+  },
+  "deploymentSpec": {
+    "executors": {
+      "exec-importer": {
+        "importer": {
+          "artifactUri": {"runtimeParameter": "uri"},
+          "typeSchema": {"schemaTitle": "system.Dataset"},
+          "metadata": {"source": "gcs"}
+        }
+      },
+      "exec-train": {
+        "container": {"image": "python:3.9", "command": ["train"], "args": ["--data", "{{$.inputs.artifacts['data'].uri}}"]}
+      }
+    }
+  },
+  "schemaVersion": "2.0.0",
+  "sdkVersion": "kfp-1.8.0"
+}
+`))
+	require.Nil(t, err)
+	pr, err := compileV2(tmpl.spec)
+	require.Nil(t, err)
+	require.Len(t, pr.Spec.PipelineSpec.Tasks, 2)
+
+	importer := pr.Spec.PipelineSpec.Tasks[0]
+	assert.Equal(t, "importer", importer.Name)
+	assert.Equal(t, workflowapi.Params{stringParam("uri", "$(params.dataset)")}, importer.Params)
+	assert.Equal(t, []workflowapi.TaskResult{stringResult("artifact")}, importer.TaskSpec.Results)
+	require.Len(t, importer.TaskSpec.Steps, 1)
+	step := importer.TaskSpec.Steps[0]
+	assert.Equal(t, common.GetImporterImage(), step.Image)
+	assert.Equal(t, []string{"python3", "-u", v2ImporterScript}, step.Command)
+	assert.Equal(t, []string{
+		"--uri", "$(params.uri)",
+		"--type-name", "system.Dataset",
+		"--metadata", `{"source":"gcs"}`,
+		"--run-id", "$(context.pipelineRun.name)",
+		"--output-name", "artifact",
+		"--uri-path", "$(results.artifact.path)",
+	}, step.Args)
+
+	train := pr.Spec.PipelineSpec.Tasks[1]
+	assert.Equal(t, workflowapi.Params{stringParam("data", "$(tasks.importer.results.artifact)")}, train.Params)
+	assert.Equal(t, "data", train.TaskSpec.Params[0].Name)
+	assert.Equal(t, []string{"--data", "$(params.data)"}, train.TaskSpec.Steps[0].Args)
+}
+
+func TestCompileV2_ContainerOutputArtifact(t *testing.T) {
+	var ir irPipelineSpec
+	require.Nil(t, json.Unmarshal([]byte(`{
+  "components": {"comp": {"executorLabel": "exec", "outputDefinitions": {"artifacts": {"model": {}}}}},
+  "deploymentSpec": {"executors": {"exec": {"container": {"image": "alpine"}}}}
+}`), &ir))
+	var task irTask
+	task.ComponentRef.Name = "comp"
+	_, err := ir.compileTask("task", task)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Output artifacts of container components aren't supported yet.")
+}
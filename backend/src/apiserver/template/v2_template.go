@@ -63,21 +63,26 @@ func (t *V2Spec) GetTemplateType() TemplateType {
 	return V2
 }
 
+// TODO(v2): analyze the IR once v2 templates are compiled to PipelineRuns.
+func (t *V2Spec) Analyze() []Warning {
+	return nil
+}
+
 func NewV2SpecTemplate(template []byte) (*V2Spec, error) {
 	var spec pipelinespec.PipelineSpec
 	err := protojson.Unmarshal(template, &spec)
 	if err != nil {
-		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, fmt.Sprintf("invalid v2 pipeline spec: %s", err.Error()))
+		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, fmt.Sprintf("invalid v2 pipeline spec: %s", err.Error())).WithReason(util.ReasonInvalidPipelineSpec)
 	}
 	if spec.GetPipelineInfo().GetName() == "" {
-		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "invalid v2 pipeline spec: name is empty")
+		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "invalid v2 pipeline spec: name is empty").WithReason(util.ReasonInvalidPipelineSpec)
 	}
 	match, _ := regexp.MatchString("[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*", spec.GetPipelineInfo().GetName())
 	if !match {
-		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "invalid v2 pipeline spec: name should consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")
+		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "invalid v2 pipeline spec: name should consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character").WithReason(util.ReasonInvalidPipelineSpec)
 	}
 	if spec.GetRoot() == nil {
-		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "invalid v2 pipeline spec: root component is empty")
+		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "invalid v2 pipeline spec: root component is empty").WithReason(util.ReasonInvalidPipelineSpec)
 	}
 	return &V2Spec{spec: &spec}, nil
 }
@@ -0,0 +1,161 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// blockedNetworks are the addresses the webhooks can't target unless their host
+// is allowed: the loopback, private, link-local (e.g. the cloud metadata
+// servers), shared, multicast and reserved ranges.
+var blockedNetworks = parseNetworks(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+	"192.0.0.0/24", "192.168.0.0/16", "198.18.0.0/15", "224.0.0.0/4", "240.0.0.0/4",
+	"::/128", "::1/128", "fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// HostPolicy restricts the hosts the webhooks target, so the subscriptions can't
+// reach the cluster network or the metadata servers. The denied hosts are never
+// targeted. When there are allowed hosts, they're the only ones targeted, and they
+// may resolve to any address, e.g. a receiver in the cluster. Otherwise, the hosts
+// are targeted only if they resolve to public addresses.
+type HostPolicy struct {
+	allowedHosts map[string]bool
+	deniedHosts  map[string]bool
+	// lookupIP resolves the hosts, it's replaced in the tests.
+	lookupIP func(ctx context.Context, host string) ([]net.IP, error)
+}
+
+func NewHostPolicy(allowedHosts []string, deniedHosts []string) *HostPolicy {
+	policy := &HostPolicy{
+		allowedHosts: map[string]bool{},
+		deniedHosts:  map[string]bool{},
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+			if err != nil {
+				return nil, err
+			}
+			ips := make([]net.IP, 0, len(addrs))
+			for _, addr := range addrs {
+				ips = append(ips, addr.IP)
+			}
+			return ips, nil
+		},
+	}
+	for _, host := range allowedHosts {
+		policy.allowedHosts[normalizeHost(host)] = true
+	}
+	for _, host := range deniedHosts {
+		policy.deniedHosts[normalizeHost(host)] = true
+	}
+	return policy
+}
+
+// CheckHost returns an error if the webhooks can't target the host, which is
+// resolved unless it's allowed.
+func (p *HostPolicy) CheckHost(ctx context.Context, host string) error {
+	_, err := p.resolve(ctx, host)
+	return err
+}
+
+// resolve returns the addresses of a host the webhooks can target, or nil if
+// the host is allowed and is dialed as it is.
+func (p *HostPolicy) resolve(ctx context.Context, host string) ([]net.IP, error) {
+	host = normalizeHost(host)
+	if p.deniedHosts[host] {
+		return nil, util.NewInvalidInputError("The webhooks can't target host %s, which is denied", host)
+	}
+	if len(p.allowedHosts) > 0 {
+		if !p.allowedHosts[host] {
+			return nil, util.NewInvalidInputError("The webhooks can't target host %s, which isn't allowed", host)
+		}
+		return nil, nil
+	}
+	var ips []net.IP
+	var err error
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else if ips, err = p.lookupIP(ctx, host); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, fmt.Sprintf("Failed to resolve webhook host %s", host))
+	}
+	if len(ips) == 0 {
+		return nil, util.NewInvalidInputError("Webhook host %s has no address", host)
+	}
+	for _, ip := range ips {
+		if isBlockedIP(ip) {
+			return nil, util.NewInvalidInputError("The webhooks can't target host %s, which resolves to the non-public address %s", host, ip)
+		}
+	}
+	return ips, nil
+}
+
+// DialContext connects to an address the webhooks can target. The host is
+// resolved once, so that it can't resolve to another address between the check
+// and the connection.
+func (p *HostPolicy) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := p.resolve(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{}
+	if ips == nil {
+		return dialer.DialContext(ctx, network, address)
+	}
+	for _, ip := range ips {
+		var conn net.Conn
+		if conn, err = dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port)); err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+func isBlockedIP(ip net.IP) bool {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		ip = ipv4
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
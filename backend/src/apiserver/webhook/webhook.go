@@ -0,0 +1,143 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The headers of the webhook deliveries.
+const (
+	HeaderWebhookID  = "X-KFP-Webhook-Id"
+	HeaderDeliveryID = "X-KFP-Delivery-Id"
+	HeaderEventType  = "X-KFP-Event-Type"
+	HeaderTimestamp  = "X-KFP-Timestamp"
+	// HeaderSignature is the HMAC of the timestamp and the body, set when the
+	// subscription has a secret.
+	HeaderSignature = "X-KFP-Signature"
+)
+
+// Matches reports whether the event is delivered to the subscription.
+func Matches(subscription *model.WebhookSubscription, event *eventbus.Event) bool {
+	if subscription.Namespace != "" && subscription.Namespace != event.Data.Namespace {
+		return false
+	}
+	if subscription.ExperimentID != "" && subscription.ExperimentID != event.Data.ExperimentID {
+		return false
+	}
+	return eventbus.NewEventFilter(subscription.EventTypeList(), nil).Matches(event)
+}
+
+// ValidateSubscription checks the URL and the event types of a subscription. The
+// host of the URL must be one the policy lets the webhooks target.
+func ValidateSubscription(subscription *model.WebhookSubscription, policy *HostPolicy) error {
+	if subscription.Name == "" {
+		return util.NewInvalidInputError("The name of the webhook subscription is empty")
+	}
+	target, err := url.Parse(subscription.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return util.NewInvalidInputError("Invalid webhook URL %q, an http or https URL is expected", subscription.URL)
+	}
+	if err := policy.CheckHost(context.Background(), target.Hostname()); err != nil {
+		return err
+	}
+	for _, eventType := range subscription.EventTypeList() {
+		if !isKnownEventType(eventType) {
+			return util.NewInvalidInputError("Event type %q is not supported", eventType)
+		}
+	}
+	return nil
+}
+
+// isKnownEventType reports whether the type, without the prefix, is the type of
+// an event, or a wildcard matching some of them.
+func isKnownEventType(eventType string) bool {
+	prefix := strings.TrimSuffix(eventType, "*")
+	for _, known := range eventbus.EventTypes {
+		shortType := strings.TrimPrefix(known, eventbus.EventTypePrefix)
+		if shortType == eventType || (prefix != eventType && strings.HasPrefix(shortType, prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign returns the signature of a body sent at the time, the hex encoded
+// HMAC-SHA256 of the timestamp, a dot and the body, keyed by the secret. The
+// receivers reject the old timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sender posts the deliveries to the subscriptions. It only connects to the
+// hosts the policy lets the webhooks target, including when it's redirected,
+// and it doesn't go through the proxy of the environment.
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(timeout time.Duration, policy *HostPolicy) *Sender {
+	return &Sender{client: &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DialContext: policy.DialContext},
+	}}
+}
+
+// Send posts the payload of the delivery to the subscription at the time, and
+// returns the status code of the response, zero if there was none. The body of
+// the response isn't read, since the receiver may be on another network.
+func (s *Sender) Send(subscription *model.WebhookSubscription, delivery *model.WebhookDelivery, now time.Time) (int, error) {
+	body := []byte(delivery.Payload)
+	request, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create the request of delivery %s", delivery.UUID)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderWebhookID, subscription.UUID)
+	request.Header.Set(HeaderDeliveryID, delivery.UUID)
+	request.Header.Set(HeaderEventType, delivery.EventType)
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	if subscription.Secret != "" {
+		request.Header.Set(HeaderSignature, Sign(subscription.Secret, now.Unix(), body))
+	}
+	resp, err := s.client.Do(request)
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to send delivery %s to %s", delivery.UUID, subscription.URL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, util.NewInternalServerError(
+			fmt.Errorf("unexpected status code %d", resp.StatusCode),
+			"Failed to send delivery %s to %s", delivery.UUID, subscription.URL)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/eventbus"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatches(t *testing.T) {
+	event := eventbus.NewEvent("event1", eventbus.EventTypeRunFinished, time.Unix(1, 0), eventbus.EventData{
+		ResourceType: eventbus.ResourceTypeRun, ResourceID: "run1", Namespace: "ns1", ExperimentID: "exp1"})
+
+	assert.True(t, Matches(&model.WebhookSubscription{}, event))
+	assert.True(t, Matches(&model.WebhookSubscription{Namespace: "ns1", ExperimentID: "exp1", EventTypes: "run.*"}, event))
+	assert.False(t, Matches(&model.WebhookSubscription{Namespace: "ns2"}, event))
+	assert.False(t, Matches(&model.WebhookSubscription{ExperimentID: "exp2"}, event))
+	assert.False(t, Matches(&model.WebhookSubscription{EventTypes: "run.created,job.*"}, event))
+}
+
+func TestValidateSubscription(t *testing.T) {
+	policy := NewHostPolicy([]string{"ci.example.com"}, nil)
+	assert.Nil(t, ValidateSubscription(&model.WebhookSubscription{Name: "ci", URL: "https://ci.example.com/hook",
+		EventTypes: "run.finished,pipeline_version.*,*"}, policy))
+
+	err := ValidateSubscription(&model.WebhookSubscription{URL: "https://ci.example.com/hook"}, policy)
+	assert.Contains(t, err.Error(), "name of the webhook subscription is empty")
+	err = ValidateSubscription(&model.WebhookSubscription{Name: "ci", URL: "ftp://ci.example.com/hook"}, policy)
+	assert.Contains(t, err.Error(), `Invalid webhook URL "ftp://ci.example.com/hook"`)
+	err = ValidateSubscription(&model.WebhookSubscription{Name: "ci", URL: "https://ci.example.com/hook",
+		EventTypes: "run.started"}, policy)
+	assert.Contains(t, err.Error(), `Event type "run.started" is not supported`)
+	err = ValidateSubscription(&model.WebhookSubscription{Name: "ci", URL: "https://ci.example.com/hook",
+		EventTypes: "experiment.created"}, policy)
+	assert.Contains(t, err.Error(), `Event type "experiment.created" is not supported`)
+	err = ValidateSubscription(&model.WebhookSubscription{Name: "ci", URL: "http://169.254.169.254/latest"}, policy)
+	assert.Contains(t, err.Error(), "can't target host 169.254.169.254, which isn't allowed")
+}
+
+func TestHostPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := NewHostPolicy(nil, []string{"Denied.example.com."})
+	policy.lookupIP = func(ctx context.Context, host string) ([]net.IP, error) {
+		switch host {
+		case "public.example.com":
+			return []net.IP{net.ParseIP("93.184.216.34")}, nil
+		case "private.example.com":
+			return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("10.0.0.1")}, nil
+		case "mapped.example.com":
+			return []net.IP{net.ParseIP("::ffff:127.0.0.1")}, nil
+		}
+		return nil, fmt.Errorf("no such host")
+	}
+
+	assert.Nil(t, policy.CheckHost(ctx, "public.example.com"))
+	assert.Nil(t, policy.CheckHost(ctx, "93.184.216.34"))
+	for _, host := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254",
+		"100.64.0.1", "::1", "fd00::1", "private.example.com", "mapped.example.com"} {
+		err := policy.CheckHost(ctx, host)
+		assert.Contains(t, err.Error(), "which resolves to the non-public address", host)
+	}
+	err := policy.CheckHost(ctx, "denied.example.com")
+	assert.Contains(t, err.Error(), "can't target host denied.example.com, which is denied")
+	err = policy.CheckHost(ctx, "missing.example.com")
+	assert.Contains(t, err.Error(), "Failed to resolve webhook host missing.example.com")
+
+	// The allowed hosts are the only ones targeted, whatever their addresses.
+	policy = NewHostPolicy([]string{"receiver.kubeflow.svc", "127.0.0.1"}, []string{"127.0.0.1"})
+	assert.Nil(t, policy.CheckHost(ctx, "receiver.kubeflow.svc"))
+	err = policy.CheckHost(ctx, "public.example.com")
+	assert.Contains(t, err.Error(), "can't target host public.example.com, which isn't allowed")
+	err = policy.CheckHost(ctx, "127.0.0.1")
+	assert.Contains(t, err.Error(), "can't target host 127.0.0.1, which is denied")
+}
+
+func TestSender_Send(t *testing.T) {
+	policy := NewHostPolicy([]string{"127.0.0.1"}, nil)
+	var headers http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	subscription := &model.WebhookSubscription{UUID: "webhook1", URL: server.URL, Secret: "s3cr3t"}
+	delivery := &model.WebhookDelivery{UUID: "delivery1", EventType: eventbus.EventTypeRunCreated, Payload: `{"id":"event1"}`}
+
+	code, err := NewSender(time.Second, policy).Send(subscription, delivery, time.Unix(100, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Equal(t, `{"id":"event1"}`, string(body))
+	assert.Equal(t, "application/json", headers.Get("Content-Type"))
+	assert.Equal(t, "webhook1", headers.Get(HeaderWebhookID))
+	assert.Equal(t, "delivery1", headers.Get(HeaderDeliveryID))
+	assert.Equal(t, "org.kubeflow.pipelines.run.created", headers.Get(HeaderEventType))
+	assert.Equal(t, "100", headers.Get(HeaderTimestamp))
+	assert.Equal(t, Sign("s3cr3t", 100, body), headers.Get(HeaderSignature))
+	assert.NotEqual(t, Sign("s3cr3t", 101, body), headers.Get(HeaderSignature))
+
+	// The deliveries aren't signed without a secret.
+	subscription.Secret = ""
+	_, err = NewSender(time.Second, policy).Send(subscription, delivery, time.Unix(100, 0))
+	assert.Nil(t, err)
+	assert.Empty(t, headers.Get(HeaderSignature))
+}
+
+func TestSender_SendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("upstream down"))
+	}))
+	defer server.Close()
+
+	sender := NewSender(time.Second, NewHostPolicy([]string{"127.0.0.1"}, nil))
+	code, err := sender.Send(&model.WebhookSubscription{URL: server.URL},
+		&model.WebhookDelivery{UUID: "delivery1", Payload: "{}"}, time.Unix(100, 0))
+	assert.Equal(t, http.StatusBadGateway, code)
+	assert.Contains(t, err.Error(), "unexpected status code 502")
+	assert.NotContains(t, err.Error(), "upstream down")
+
+	// The hosts which aren't allowed aren't connected to.
+	sender = NewSender(time.Second, NewHostPolicy(nil, nil))
+	code, err = sender.Send(&model.WebhookSubscription{URL: server.URL},
+		&model.WebhookDelivery{UUID: "delivery1", Payload: "{}"}, time.Unix(100, 0))
+	assert.Equal(t, 0, code)
+	assert.Contains(t, err.Error(), "which resolves to the non-public address 127.0.0.1")
+}
@@ -0,0 +1,243 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	dockerHubRegistry = "registry-1.docker.io"
+	// The digests resolved are kept for a while, so the pods of a run don't
+	// each query the registry. A tag pushed again is seen after the TTL.
+	imageDigestTTL = time.Minute
+)
+
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+type ImageResolverInterface interface {
+	// ResolveDigest returns the digest of the manifest an image reference points to.
+	ResolveDigest(ctx context.Context, image string) (string, error)
+}
+
+// ImageResolver resolves the tags of the images to their digests with the
+// distribution API of their registries. It only accesses the registries
+// anonymously, which the private registries usually refuse.
+type ImageResolver struct {
+	httpClient *http.Client
+
+	mutex   sync.Mutex
+	digests map[string]resolvedDigest
+}
+
+type resolvedDigest struct {
+	digest     string
+	err        error
+	resolvedAt time.Time
+}
+
+func NewImageResolver(timeout time.Duration) *ImageResolver {
+	return &ImageResolver{
+		httpClient: &http.Client{Timeout: timeout},
+		digests:    map[string]resolvedDigest{},
+	}
+}
+
+func (r *ImageResolver) ResolveDigest(ctx context.Context, image string) (string, error) {
+	reference := ParseImageReference(image)
+	if reference.Digest != "" {
+		return reference.Digest, nil
+	}
+
+	r.mutex.Lock()
+	resolved, ok := r.digests[image]
+	r.mutex.Unlock()
+	if ok && time.Since(resolved.resolvedAt) < imageDigestTTL {
+		return resolved.digest, resolved.err
+	}
+
+	// The failures are kept too, so an unreachable registry doesn't slow down
+	// the admission of every pod.
+	digest, err := r.resolve(ctx, reference)
+	r.mutex.Lock()
+	r.digests[image] = resolvedDigest{digest: digest, err: err, resolvedAt: time.Now()}
+	r.mutex.Unlock()
+	return digest, err
+}
+
+func (r *ImageResolver) resolve(ctx context.Context, reference ImageReference) (string, error) {
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", reference.Registry, reference.Repository, reference.Tag)
+	resp, err := r.headManifest(ctx, manifestURL, "")
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		token, err := r.anonymousToken(ctx, resp.Header.Get("WWW-Authenticate"), reference.Repository)
+		if err != nil {
+			return "", err
+		}
+		if resp, err = r.headManifest(ctx, manifestURL, token); err != nil {
+			return "", err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to resolve image %s: %s", reference, resp.Status)
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("Failed to resolve image %s: the registry returned no digest", reference)
+	}
+	return digest, nil
+}
+
+func (r *ImageResolver) headManifest(ctx context.Context, manifestURL string, token string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodHead, manifestURL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create the request of %s", manifestURL)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := r.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to get %s", manifestURL)
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// anonymousToken gets a pull token of the repository from the token service of
+// a bearer challenge, as registries such as Docker Hub ask even for public images.
+func (r *ImageResolver) anonymousToken(ctx context.Context, challenge string, repository string) (string, error) {
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return "", fmt.Errorf("Failed to authenticate to the registry: unsupported challenge %q", challenge)
+	}
+	params := parseChallengeParams(challenge[len("bearer "):])
+	realm := params["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("Failed to authenticate to the registry: no realm in challenge %q", challenge)
+	}
+	query := url.Values{}
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", repository))
+	req, err := http.NewRequest(http.MethodGet, realm+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to create the token request")
+	}
+	resp, err := r.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to get a token from %s", realm)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to get a token from %s: %s", realm, resp.Status)
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", errors.Wrapf(err, "Failed to parse the token from %s", realm)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
+
+// parseChallengeParams parses the comma separated key="value" parameters of a
+// WWW-Authenticate challenge.
+func parseChallengeParams(params string) map[string]string {
+	result := map[string]string{}
+	for _, param := range strings.Split(params, ",") {
+		parts := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(parts) == 2 {
+			result[strings.ToLower(parts[0])] = strings.Trim(parts[1], `"`)
+		}
+	}
+	return result
+}
+
+// ImageReference is a parsed image reference, such as
+// registry.example.com/team/image:tag or image@sha256:...
+type ImageReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func ParseImageReference(image string) ImageReference {
+	reference := ImageReference{}
+	if i := strings.Index(image, "@"); i >= 0 {
+		reference.Digest = image[i+1:]
+		image = image[:i]
+	}
+	// The tag follows the last colon, unless it is the port of the registry.
+	if i := strings.LastIndex(image, ":"); i >= 0 && !strings.Contains(image[i:], "/") {
+		reference.Tag = image[i+1:]
+		image = image[:i]
+	}
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		reference.Registry, reference.Repository = parts[0], parts[1]
+	} else {
+		reference.Registry, reference.Repository = dockerHubRegistry, image
+	}
+	if reference.Registry == "docker.io" || reference.Registry == "index.docker.io" {
+		reference.Registry = dockerHubRegistry
+	}
+	if reference.Registry == dockerHubRegistry && !strings.Contains(reference.Repository, "/") {
+		reference.Repository = "library/" + reference.Repository
+	}
+	if reference.Tag == "" && reference.Digest == "" {
+		reference.Tag = "latest"
+	}
+	return reference
+}
+
+// IsMutable returns whether the image may be pushed again under the same
+// reference, i.e. whether it isn't pinned by digest and its tag is latest.
+func (r ImageReference) IsMutable() bool {
+	return r.Digest == "" && r.Tag == "latest"
+}
+
+func (r ImageReference) String() string {
+	image := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		image += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		image += "@" + r.Digest
+	}
+	return image
+}
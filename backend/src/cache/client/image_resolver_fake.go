@@ -0,0 +1,41 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// FakeImageResolver resolves the images to the digests set, and fails for the
+// other images which aren't pinned by digest.
+type FakeImageResolver struct {
+	Digests map[string]string
+}
+
+func NewFakeImageResolver() *FakeImageResolver {
+	return &FakeImageResolver{Digests: map[string]string{}}
+}
+
+func (r *FakeImageResolver) ResolveDigest(ctx context.Context, image string) (string, error) {
+	if digest := ParseImageReference(image).Digest; digest != "" {
+		return digest, nil
+	}
+	digest, ok := r.Digests[image]
+	if !ok {
+		return "", fmt.Errorf("Failed to resolve image %s", image)
+	}
+	return digest, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageReference(t *testing.T) {
+	tests := []struct {
+		image     string
+		reference ImageReference
+		mutable   bool
+	}{
+		{"python", ImageReference{Registry: dockerHubRegistry, Repository: "library/python", Tag: "latest"}, true},
+		{"python:3.7", ImageReference{Registry: dockerHubRegistry, Repository: "library/python", Tag: "3.7"}, false},
+		{"docker.io/team/image:latest", ImageReference{Registry: dockerHubRegistry, Repository: "team/image", Tag: "latest"}, true},
+		{"localhost:5000/image", ImageReference{Registry: "localhost:5000", Repository: "image", Tag: "latest"}, true},
+		{"quay.io/team/image@sha256:1a2b3c", ImageReference{Registry: "quay.io", Repository: "team/image", Digest: "sha256:1a2b3c"}, false},
+		{"quay.io/team/image:latest@sha256:1a2b3c",
+			ImageReference{Registry: "quay.io", Repository: "team/image", Tag: "latest", Digest: "sha256:1a2b3c"}, false},
+	}
+	for _, test := range tests {
+		reference := ParseImageReference(test.image)
+		assert.Equal(t, test.reference, reference, test.image)
+		assert.Equal(t, test.mutable, reference.IsMutable(), test.image)
+	}
+}
+
+func TestImageResolver_ResolveDigest(t *testing.T) {
+	requests := 0
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			assert.Equal(t, "repository:team/image:pull", r.URL.Query().Get("scope"))
+			fmt.Fprint(w, `{"token": "anonymous"}`)
+		case "/v2/team/image/manifests/latest":
+			requests++
+			if r.Header.Get("Authorization") != "Bearer anonymous" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Docker-Content-Digest", "sha256:1a2b3c")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewImageResolver(0)
+	resolver.httpClient = server.Client()
+	registry := strings.TrimPrefix(server.URL, "https://")
+
+	digest, err := resolver.ResolveDigest(context.Background(), registry+"/team/image")
+	require.Nil(t, err)
+	assert.Equal(t, "sha256:1a2b3c", digest)
+	assert.Equal(t, 2, requests)
+
+	// The digest is kept for a while.
+	digest, err = resolver.ResolveDigest(context.Background(), registry+"/team/image")
+	require.Nil(t, err)
+	assert.Equal(t, "sha256:1a2b3c", digest)
+	assert.Equal(t, 2, requests)
+
+	_, err = resolver.ResolveDigest(context.Background(), registry+"/team/missing:1.0")
+	assert.NotNil(t, err)
+
+	digest, err = resolver.ResolveDigest(context.Background(), registry+"/team/image@sha256:4d5e6f")
+	require.Nil(t, err)
+	assert.Equal(t, "sha256:4d5e6f", digest)
+}
@@ -15,13 +15,16 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"encoding/json"
 	"github.com/cenkalti/backoff"
+	"github.com/go-redis/redis/v8"
 	"github.com/golang/glog"
 	"github.com/jinzhu/gorm"
 	"github.com/kubeflow/pipelines/backend/src/cache/client"
@@ -32,13 +35,16 @@ import (
 
 const (
 	DefaultConnectionTimeout = "6m"
+	ImageRegistryTimeout     = 3 * time.Second
 )
 
 type ClientManager struct {
 	db            *storage.DB
+	redisClient   redis.UniversalClient
 	cacheStore    storage.ExecutionCacheStoreInterface
 	k8sCoreClient client.KubernetesCoreInterface
 	tektonClient  client.TektonInterface
+	imageResolver client.ImageResolverInterface
 	time          util.TimeInterface
 }
 
@@ -54,19 +60,32 @@ func (c *ClientManager) TektonClient() client.TektonInterface {
 	return c.tektonClient
 }
 
+func (c *ClientManager) ImageResolver() client.ImageResolverInterface {
+	return c.imageResolver
+}
+
 func (c *ClientManager) Close() {
-	c.db.Close()
+	if c.db != nil {
+		c.db.Close()
+	}
+	if c.redisClient != nil {
+		c.redisClient.Close()
+	}
 }
 
 func (c *ClientManager) init(params WhSvrDBParameters, clientParams util.ClientParameters) {
 	timeoutDuration, _ := time.ParseDuration(DefaultConnectionTimeout)
-	db := initDBClient(params, timeoutDuration)
-
 	c.time = util.NewRealTime()
-	c.db = db
-	c.cacheStore = storage.NewExecutionCacheStore(db, c.time)
+	if params.dbDriver == redisDBDriver {
+		c.redisClient = initRedisClient(params, timeoutDuration)
+		c.cacheStore = storage.NewRedisExecutionCacheStore(c.redisClient, params.redisKeyPrefix, c.time)
+	} else {
+		c.db = initDBClient(params, timeoutDuration)
+		c.cacheStore = storage.NewExecutionCacheStore(c.db, c.time)
+	}
 	c.k8sCoreClient = client.CreateKubernetesCoreOrFatal(timeoutDuration, clientParams)
 	c.tektonClient = client.CreateTektonClientOrFatal(timeoutDuration)
+	c.imageResolver = client.NewImageResolver(ImageRegistryTimeout)
 }
 
 func initDBClient(params WhSvrDBParameters, initConnectionTimeout time.Duration) *storage.DB {
@@ -109,6 +128,41 @@ func initDBClient(params WhSvrDBParameters, initConnectionTimeout time.Duration)
 	return storage.NewDB(db)
 }
 
+func initRedisClient(params WhSvrDBParameters, initConnectionTimeout time.Duration) redis.UniversalClient {
+	var addresses []string
+	for _, address := range strings.Split(params.redisAddresses, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		glog.Fatalf("No Redis address set.")
+	}
+
+	var redisClient redis.UniversalClient
+	if params.redisClusterMode {
+		redisClient = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    addresses,
+			Password: params.redisPassword,
+		})
+	} else {
+		redisClient = redis.NewClient(&redis.Options{
+			Addr:     addresses[0],
+			Password: params.redisPassword,
+			DB:       params.redisDB,
+		})
+	}
+
+	operation := func() error {
+		return redisClient.Ping(context.Background()).Err()
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err := backoff.Retry(operation, b)
+	util.TerminateIfError(err)
+	return redisClient
+}
+
 func initMysql(params WhSvrDBParameters, initConnectionTimeout time.Duration) string {
 
 	var mysqlExtraParams = map[string]string{}
@@ -22,7 +22,9 @@ import (
 	"path/filepath"
 
 	"github.com/kubeflow/pipelines/backend/src/cache/server"
+	"github.com/kubeflow/pipelines/backend/src/cache/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 const (
@@ -43,6 +45,7 @@ const (
 	mysqlDBHostDefault              = "mysql"
 	mysqlDBPortDefault              = "3306"
 	mysqlDBGroupConcatMaxLenDefault = "4194304"
+	redisDBDriver                   = "redis"
 )
 
 type WhSvrDBParameters struct {
@@ -55,6 +58,11 @@ type WhSvrDBParameters struct {
 	dbGroupConcatMaxLen string
 	dbExtraParams       string
 	namespaceToWatch    string
+	redisAddresses      string
+	redisPassword       string
+	redisDB             int
+	redisClusterMode    bool
+	redisKeyPrefix      string
 }
 
 func main() {
@@ -62,8 +70,9 @@ func main() {
 	var clientParams util.ClientParameters
 	var certFile string
 	var keyFile string
+	var metricsAddress string
 
-	flag.StringVar(&params.dbDriver, "db_driver", mysqlDBDriverDefault, "Database driver name, mysql is the default value")
+	flag.StringVar(&params.dbDriver, "db_driver", mysqlDBDriverDefault, "Database driver name, mysql is the default value. Set redis to store the cache in Redis.")
 	flag.StringVar(&params.dbHost, "db_host", mysqlDBHostDefault, "Database host name.")
 	flag.StringVar(&params.dbPort, "db_port", mysqlDBPortDefault, "Database port number.")
 	flag.StringVar(&params.dbName, "db_name", "cachedb", "Database name.")
@@ -72,6 +81,11 @@ func main() {
 	flag.StringVar(&params.dbGroupConcatMaxLen, "db_group_concat_max_len", mysqlDBGroupConcatMaxLenDefault, "Database group concat max length.")
 	flag.StringVar(&params.dbExtraParams, "db_extra_params", "", "Database extra parameters.")
 	flag.StringVar(&params.namespaceToWatch, "namespace_to_watch", "kubeflow", "Namespace to watch.")
+	flag.StringVar(&params.redisAddresses, "redis_addresses", "redis:6379", "Comma separated addresses of Redis, with the redis driver.")
+	flag.StringVar(&params.redisPassword, "redis_password", "", "Redis password.")
+	flag.IntVar(&params.redisDB, "redis_db", 0, "Redis database number, without the cluster mode.")
+	flag.BoolVar(&params.redisClusterMode, "redis_cluster_mode", false, "Whether the Redis addresses are the nodes of a Redis cluster.")
+	flag.StringVar(&params.redisKeyPrefix, "redis_key_prefix", storage.DefaultRedisKeyPrefix, "The prefix of the Redis keys of the cache.")
 	// Use default value of client QPS (5) & burst (10) defined in
 	// k8s.io/client-go/rest/config.go#RESTClientFor
 	flag.Float64Var(&clientParams.QPS, "kube_client_qps", 5, "The maximum QPS to the master from this client.")
@@ -81,18 +95,26 @@ func main() {
 	flag.StringVar(&certFile, "tls_cert_filename", TLSCertFileDefault, "The TLS certificate filename.")
 	flag.StringVar(&keyFile, "tls_key_filename", TLSKeyFileDefault, "The TLS key filename.")
 
+	flag.StringVar(&metricsAddress, "metrics_address", ":9090", "The address to serve Prometheus metrics on. Empty disables them.")
+
 	flag.Parse()
 
 	log.Println("Initing client manager....")
 	clientManager := NewClientManager(params, clientParams)
 	ctx := context.Background()
 	go server.WatchPods(ctx, params.namespaceToWatch, &clientManager)
+	if metricsAddress != "" {
+		go serveMetrics(metricsAddress)
+	}
 
 	certPath := filepath.Join(TLSDir, certFile)
 	keyPath := filepath.Join(TLSDir, keyFile)
 
 	mux := http.NewServeMux()
 	mux.Handle(MutateAPI, server.AdmitFuncHandler(server.MutatePodIfCached, &clientManager))
+	mux.Handle(server.CacheEntriesAPI, server.CacheEntriesHandler(&clientManager))
+	mux.Handle(server.CacheEntriesAPI+"/", server.CacheEntriesHandler(&clientManager))
+	mux.Handle(server.CacheReportAPI, server.CacheReportHandler(&clientManager))
 	server := &http.Server{
 		// We listen on port 8443 such that we do not need root privileges or extra capabilities for this server.
 		// The Service object will take care of mapping this port to the HTTPS port 443.
@@ -101,3 +123,14 @@ func main() {
 	}
 	log.Fatal(server.ListenAndServeTLS(certPath, keyPath))
 }
+
+// serveMetrics serves the metrics over plain HTTP, apart from the webhook which
+// must be served over TLS.
+func serveMetrics(address string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	log.Printf("Serving metrics on %s", address)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		log.Fatalf("Error serving metrics: %v", err)
+	}
+}
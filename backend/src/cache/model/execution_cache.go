@@ -22,6 +22,14 @@ type ExecutionCache struct {
 	MaxCacheStaleness int64  `gorm:"column:MaxCacheStaleness; not null"`
 	StartedAtInSec    int64  `gorm:"column:StartedAtInSec; not null"`
 	EndedAtInSec      int64  `gorm:"column:EndedAtInSec; not null"`
+	// The pipeline and the task of the execution, to find the entries to delete.
+	PipelineName string `gorm:"column:PipelineName; not null; default:''"`
+	TaskName     string `gorm:"column:TaskName; not null; default:''"`
+	// The version of the pipeline, i.e. its generation, and how long the
+	// execution ran and how many times it was reused, to report the time saved.
+	Generation             string `gorm:"column:Generation; not null; default:''"`
+	ExecutionDurationInSec int64  `gorm:"column:ExecutionDurationInSec; not null; default:0"`
+	HitCount               int64  `gorm:"column:HitCount; not null; default:0"`
 }
 
 // GetValueOfPrimaryKey returns the value of ExecutionCacheKey.
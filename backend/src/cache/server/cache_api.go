@@ -0,0 +1,238 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/cache/model"
+	"github.com/kubeflow/pipelines/backend/src/cache/storage"
+)
+
+const (
+	CacheEntriesAPI string = "/apis/v1/cache/entries"
+	CacheReportAPI  string = "/apis/v1/cache/report"
+
+	defaultCacheEntriesPageSize = 100
+	maxCacheEntriesPageSize     = 1000
+)
+
+var cacheKeyPrefixPattern = regexp.MustCompile(`^[0-9a-f]*$`)
+
+// CacheEntry is a cache entry returned by the cache entries API.
+type CacheEntry struct {
+	ID                int64     `json:"id"`
+	CacheKey          string    `json:"cache_key"`
+	PipelineName      string    `json:"pipeline_name"`
+	TaskName          string    `json:"task_name"`
+	CreatedAt         time.Time `json:"created_at"`
+	MaxCacheStaleness int64     `json:"max_cache_staleness"`
+}
+
+type listCacheEntriesResponse struct {
+	Entries       []*CacheEntry `json:"entries"`
+	NextPageToken string        `json:"next_page_token,omitempty"`
+}
+
+type deleteCacheEntriesResponse struct {
+	Deleted int64 `json:"deleted"`
+}
+
+// PipelineCacheReport reports how effective the cache is for a pipeline version.
+// The executions stored in the cache were missed, so the hit ratio is the
+// ratio of the hits to the hits and the entries.
+type PipelineCacheReport struct {
+	PipelineName    string  `json:"pipeline_name"`
+	PipelineVersion string  `json:"pipeline_version"`
+	Entries         int64   `json:"entries"`
+	Hits            int64   `json:"hits"`
+	HitRatio        float64 `json:"hit_ratio"`
+	SavedSeconds    int64   `json:"saved_seconds"`
+}
+
+type cacheReportResponse struct {
+	Pipelines []*PipelineCacheReport `json:"pipelines"`
+}
+
+// CacheEntriesHandler serves the API listing and deleting the cache entries, so
+// the entries of poisoned executions can be purged:
+//
+//	GET    /apis/v1/cache/entries?pipeline_name=&task_name=&key_prefix=&page_size=&page_token=
+//	DELETE /apis/v1/cache/entries?pipeline_name=&task_name=&key_prefix=  (or all=true)
+//	DELETE /apis/v1/cache/entries/{id}
+func CacheEntriesHandler(clientMgr ClientManagerInterface) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.Trim(strings.TrimPrefix(r.URL.Path, CacheEntriesAPI), "/")
+		switch {
+		case id != "" && r.Method == http.MethodDelete:
+			deleteCacheEntry(w, id, clientMgr)
+		case id == "" && r.Method == http.MethodGet:
+			listCacheEntries(w, r, clientMgr)
+		case id == "" && r.Method == http.MethodDelete:
+			deleteCacheEntries(w, r, clientMgr)
+		default:
+			writeCacheAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("Invalid method %q", r.Method))
+		}
+	})
+}
+
+// CacheReportHandler serves the report of the cache by pipeline version, of the
+// pipeline if it is set:
+//
+//	GET /apis/v1/cache/report?pipeline_name=
+func CacheReportHandler(clientMgr ClientManagerInterface) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeCacheAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("Invalid method %q", r.Method))
+			return
+		}
+		stats, err := clientMgr.CacheStore().ListPipelineCacheStats(r.URL.Query().Get("pipeline_name"))
+		if err != nil {
+			writeCacheAPIError(w, http.StatusInternalServerError, err)
+			return
+		}
+		response := cacheReportResponse{Pipelines: []*PipelineCacheReport{}}
+		for _, stat := range stats {
+			report := &PipelineCacheReport{
+				PipelineName:    stat.PipelineName,
+				PipelineVersion: stat.PipelineVersion,
+				Entries:         stat.Entries,
+				Hits:            stat.Hits,
+				SavedSeconds:    stat.SavedSeconds,
+			}
+			if stat.Hits+stat.Entries > 0 {
+				report.HitRatio = float64(stat.Hits) / float64(stat.Hits+stat.Entries)
+			}
+			response.Pipelines = append(response.Pipelines, report)
+		}
+		writeCacheAPIResponse(w, http.StatusOK, response)
+	})
+}
+
+func listCacheEntries(w http.ResponseWriter, r *http.Request, clientMgr ClientManagerInterface) {
+	filter, err := cacheEntriesFilter(r)
+	if err != nil {
+		writeCacheAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	pageSize := defaultCacheEntriesPageSize
+	if value := r.URL.Query().Get("page_size"); value != "" {
+		if pageSize, err = strconv.Atoi(value); err != nil || pageSize <= 0 || pageSize > maxCacheEntriesPageSize {
+			writeCacheAPIError(w, http.StatusBadRequest,
+				fmt.Errorf("Invalid page_size %q, expected a number between 1 and %d", value, maxCacheEntriesPageSize))
+			return
+		}
+	}
+	// The page token is the ID of the last entry of the previous page.
+	var afterID int64
+	if value := r.URL.Query().Get("page_token"); value != "" {
+		if afterID, err = strconv.ParseInt(value, 10, 64); err != nil {
+			writeCacheAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid page_token %q", value))
+			return
+		}
+	}
+
+	executionCaches, err := clientMgr.CacheStore().ListExecutionCaches(filter, afterID, pageSize)
+	if err != nil {
+		writeCacheAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	response := listCacheEntriesResponse{Entries: []*CacheEntry{}}
+	for _, executionCache := range executionCaches {
+		response.Entries = append(response.Entries, toCacheEntry(executionCache))
+	}
+	if len(executionCaches) == pageSize {
+		response.NextPageToken = strconv.FormatInt(executionCaches[len(executionCaches)-1].ID, 10)
+	}
+	writeCacheAPIResponse(w, http.StatusOK, response)
+}
+
+func deleteCacheEntries(w http.ResponseWriter, r *http.Request, clientMgr ClientManagerInterface) {
+	filter, err := cacheEntriesFilter(r)
+	if err != nil {
+		writeCacheAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	// Purging the whole cache must be explicit.
+	if filter.IsEmpty() && r.URL.Query().Get("all") != "true" {
+		writeCacheAPIError(w, http.StatusBadRequest,
+			fmt.Errorf("Set pipeline_name, task_name or key_prefix, or all=true to delete all the cache entries"))
+		return
+	}
+	deleted, err := clientMgr.CacheStore().DeleteExecutionCaches(filter)
+	if err != nil {
+		writeCacheAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	log.Printf("Deleted %d cache entries matching %+v", deleted, *filter)
+	writeCacheAPIResponse(w, http.StatusOK, deleteCacheEntriesResponse{Deleted: deleted})
+}
+
+func deleteCacheEntry(w http.ResponseWriter, id string, clientMgr ClientManagerInterface) {
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		writeCacheAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid cache entry id %q", id))
+		return
+	}
+	if err := clientMgr.CacheStore().DeleteExecutionCache(id); err != nil {
+		writeCacheAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	log.Printf("Deleted cache entry %s", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func cacheEntriesFilter(r *http.Request) (*storage.ExecutionCacheFilter, error) {
+	query := r.URL.Query()
+	filter := &storage.ExecutionCacheFilter{
+		PipelineName: query.Get("pipeline_name"),
+		TaskName:     query.Get("task_name"),
+		KeyPrefix:    strings.ToLower(query.Get("key_prefix")),
+	}
+	if !cacheKeyPrefixPattern.MatchString(filter.KeyPrefix) {
+		return nil, fmt.Errorf("Invalid key_prefix %q, expected a hexadecimal prefix", filter.KeyPrefix)
+	}
+	return filter, nil
+}
+
+func toCacheEntry(executionCache *model.ExecutionCache) *CacheEntry {
+	return &CacheEntry{
+		ID:                executionCache.ID,
+		CacheKey:          executionCache.ExecutionCacheKey,
+		PipelineName:      executionCache.PipelineName,
+		TaskName:          executionCache.TaskName,
+		CreatedAt:         time.Unix(executionCache.StartedAtInSec, 0).UTC(),
+		MaxCacheStaleness: executionCache.MaxCacheStaleness,
+	}
+}
+
+func writeCacheAPIResponse(w http.ResponseWriter, code int, response interface{}) {
+	w.Header().Set(ContentType, JsonContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("Could not write response: %v", err)
+	}
+}
+
+func writeCacheAPIError(w http.ResponseWriter, code int, err error) {
+	log.Printf("Error handling cache entries request: %v", err)
+	writeCacheAPIResponse(w, code, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/cache/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveCacheEntriesRequest(clientMgr ClientManagerInterface, method string, url string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	CacheEntriesHandler(clientMgr).ServeHTTP(recorder, httptest.NewRequest(method, url, nil))
+	return recorder
+}
+
+func TestCacheEntriesHandler(t *testing.T) {
+	clientMgr := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientMgr.Close()
+	for _, key := range []string{"aa01", "aa02", "bb01"} {
+		_, err := clientMgr.CacheStore().CreateExecutionCache(&model.ExecutionCache{
+			ExecutionCacheKey: key,
+			ExecutionTemplate: "testTemplate",
+			ExecutionOutput:   "testOutput",
+			MaxCacheStaleness: -1,
+			PipelineName:      "pipeline1",
+			TaskName:          "train",
+		})
+		require.Nil(t, err)
+	}
+
+	recorder := serveCacheEntriesRequest(clientMgr, http.MethodGet, CacheEntriesAPI+"?pipeline_name=pipeline1&page_size=2")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response listCacheEntriesResponse
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Len(t, response.Entries, 2)
+	assert.Equal(t, "aa01", response.Entries[0].CacheKey)
+	assert.Equal(t, "train", response.Entries[0].TaskName)
+	assert.Equal(t, "2", response.NextPageToken)
+
+	recorder = serveCacheEntriesRequest(clientMgr, http.MethodGet, CacheEntriesAPI+"?key_prefix=zz")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// Purging the whole cache must be explicit.
+	recorder = serveCacheEntriesRequest(clientMgr, http.MethodDelete, CacheEntriesAPI)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = serveCacheEntriesRequest(clientMgr, http.MethodDelete, CacheEntriesAPI+"?key_prefix=aa")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var deleteResponse deleteCacheEntriesResponse
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &deleteResponse))
+	assert.Equal(t, int64(2), deleteResponse.Deleted)
+
+	recorder = serveCacheEntriesRequest(clientMgr, http.MethodDelete, CacheEntriesAPI+"/3")
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = serveCacheEntriesRequest(clientMgr, http.MethodGet, CacheEntriesAPI)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	response = listCacheEntriesResponse{}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Empty(t, response.Entries)
+	assert.Empty(t, response.NextPageToken)
+}
+
+func TestCacheReportHandler(t *testing.T) {
+	clientMgr := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientMgr.Close()
+	executionCache, err := clientMgr.CacheStore().CreateExecutionCache(&model.ExecutionCache{
+		ExecutionCacheKey:      "aa01",
+		ExecutionTemplate:      "testTemplate",
+		ExecutionOutput:        "testOutput",
+		MaxCacheStaleness:      -1,
+		PipelineName:           "pipeline1",
+		Generation:             "0",
+		ExecutionDurationInSec: 60,
+	})
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		require.Nil(t, clientMgr.CacheStore().RecordExecutionCacheHit(executionCache.ID))
+	}
+
+	recorder := httptest.NewRecorder()
+	CacheReportHandler(clientMgr).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, CacheReportAPI, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response cacheReportResponse
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, []*PipelineCacheReport{{
+		PipelineName:    "pipeline1",
+		PipelineVersion: "0",
+		Entries:         1,
+		Hits:            3,
+		HitRatio:        0.75,
+		SavedSeconds:    180,
+	}}, response.Pipelines)
+}
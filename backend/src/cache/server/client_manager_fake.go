@@ -26,6 +26,7 @@ type FakeClientManager struct {
 	cacheStore        storage.ExecutionCacheStoreInterface
 	k8sCoreClientFake *client.FakeKuberneteCoreClient
 	tektonClientFake  *client.FakeTektonClient
+	imageResolverFake *client.FakeImageResolver
 	time              util.TimeInterface
 }
 
@@ -44,6 +45,7 @@ func NewFakeClientManager(time util.TimeInterface) (*FakeClientManager, error) {
 		cacheStore:        storage.NewExecutionCacheStore(db, time),
 		k8sCoreClientFake: client.NewFakeKuberneteCoresClient(),
 		tektonClientFake:  client.NewFakeTektonClient(),
+		imageResolverFake: client.NewFakeImageResolver(),
 		time:              time,
 	}, nil
 }
@@ -79,3 +81,7 @@ func (f *FakeClientManager) KubernetesCoreClient() client.KubernetesCoreInterfac
 func (c *FakeClientManager) TektonClient() client.TektonInterface {
 	return c.tektonClientFake
 }
+
+func (c *FakeClientManager) ImageResolver() client.ImageResolverInterface {
+	return c.imageResolverFake
+}
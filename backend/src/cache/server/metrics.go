@@ -0,0 +1,51 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metric variables. Please prefix the metric names with cache_server_.
+var (
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_server_lookups",
+		Help: "The number of executions looked up in the cache, by result of the lookup",
+	}, []string{"result"})
+
+	cacheLookupDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "cache_server_lookup_duration_seconds",
+		Help:    "The time taken to calculate the cache key of an execution and look it up",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+
+	cacheSavedSeconds = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cache_server_saved_seconds",
+		Help: "The execution time of the steps reused from the cache",
+	})
+
+	cacheEntriesCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cache_server_entries_created",
+		Help: "The number of executions stored in the cache",
+	})
+)
+
+const (
+	lookupResultHit  = "hit"
+	lookupResultMiss = "miss"
+	// The lookups skipped for the runs which disable the cache.
+	lookupResultDisabled = "disabled"
+)
@@ -15,6 +15,7 @@
 package server
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -23,6 +24,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/cache/client"
 	"github.com/kubeflow/pipelines/backend/src/cache/model"
@@ -61,6 +63,8 @@ const (
 	Generation                 string = "pipelines.kubeflow.org/generation"
 	PipelineRun                string = "tekton.dev/pipelineRun"
 	CachedPipeline             string = "pipelines.kubeflow.org/cached_pipeline_run"
+	InputArtifactHashesKey     string = "pipelines.kubeflow.org/input_artifact_hashes"
+	DisableCacheKey            string = "pipelines.kubeflow.org/disable_cache"
 
 	TektonBetaGroup    string = "tekton.dev/v1beta1"
 	TektonGroup        string = "tekton.dev/v1"
@@ -72,17 +76,34 @@ var (
 	podResource = metav1.GroupVersionResource{Version: "v1", Resource: "pods"}
 )
 
+// The admission of the pods waits for their images to be resolved, so the
+// resolution must be quick.
+const imageResolveTimeout = 3 * time.Second
+
 type ClientManagerInterface interface {
 	CacheStore() storage.ExecutionCacheStoreInterface
 	KubernetesCoreClient() client.KubernetesCoreInterface
 	TektonClient() client.TektonInterface
+	ImageResolver() client.ImageResolverInterface
 }
 
+// Template is what the cache key of an execution is calculated from. The fields
+// added later are omitted when empty, so they don't change the other keys.
 type Template struct {
 	Spec         tektonv1.TaskRunSpec
 	TaskName     string
 	PipelineName string
 	Generation   string
+	// ImageDigests are the digests of the images of the containers, so the
+	// executions aren't reused once a tag such as latest is pushed again.
+	ImageDigests map[string]string `json:",omitempty"`
+	// Env are the environment variables of the containers, which may be set
+	// outside of the TaskRun, e.g. by a pod template.
+	Env map[string][]corev1.EnvVar `json:",omitempty"`
+	// InputArtifactHashes are the hashes of the input artifacts set in the
+	// pipelines.kubeflow.org/input_artifact_hashes annotation, as the artifacts
+	// passed by path don't change the TaskRun.
+	InputArtifactHashes map[string]string `json:",omitempty"`
 }
 
 // MutatePodIfCached will check whether the execution has already been run before from MLMD
@@ -159,7 +180,8 @@ func MutatePodIfCached(req *v1beta1.AdmissionRequest, clientMgr ClientManagerInt
 	}
 
 	// Generate the executionHashKey based on Taskrun.status.taskspec and the name of task
-	executionHashKey, template, err := generateCacheKeyFromTemplate(tr, &pod)
+	lookupStart := time.Now()
+	executionHashKey, template, err := generateCacheKeyFromTemplate(tr, &pod, clientMgr.ImageResolver(), logger)
 	if err != nil {
 		logger.Errorf("Unable to generate cache key for pod %s : %v", pod.ObjectMeta.Name, err)
 		return patches, nil
@@ -175,9 +197,20 @@ func MutatePodIfCached(req *v1beta1.AdmissionRequest, clientMgr ClientManagerInt
 	}
 
 	var cachedExecution *model.ExecutionCache
-	cachedExecution, err = clientMgr.CacheStore().GetExecutionCache(executionHashKey, maxCacheStalenessInSeconds)
-	if err != nil {
-		logger.Warnf("Failed when try to get cache from storage: %v", err)
+	if annotations[DisableCacheKey] == "true" {
+		// The run forces the steps to be executed, but their results are still
+		// cached for the next runs.
+		logger.Infof("The cache is disabled for the run of pod %s.", pod.ObjectMeta.Name)
+		cacheLookups.WithLabelValues(lookupResultDisabled).Inc()
+	} else {
+		cachedExecution, err = clientMgr.CacheStore().GetExecutionCache(executionHashKey, maxCacheStalenessInSeconds)
+		if err != nil {
+			logger.Warnf("Failed when try to get cache from storage: %v", err)
+		}
+		cacheLookupDuration.Observe(time.Since(lookupStart).Seconds())
+		if cachedExecution == nil {
+			cacheLookups.WithLabelValues(lookupResultMiss).Inc()
+		}
 	}
 	// Found cached execution, add cached output and cache_id and replace container images.
 	if cachedExecution != nil {
@@ -237,6 +270,12 @@ func MutatePodIfCached(req *v1beta1.AdmissionRequest, clientMgr ClientManagerInt
 				Value: dummyInitContainers,
 			})
 		}
+
+		cacheLookups.WithLabelValues(lookupResultHit).Inc()
+		cacheSavedSeconds.Add(float64(cachedExecution.ExecutionDurationInSec))
+		if err := clientMgr.CacheStore().RecordExecutionCacheHit(cachedExecution.ID); err != nil {
+			logger.Warnf("Failed to record the cache hit of pod %s: %v", pod.ObjectMeta.Name, err)
+		}
 	}
 
 	// Add executionKey to pod.metadata.annotations
@@ -339,7 +378,8 @@ func unmarshalResult(taskResult string) (map[string][]*tektonv1.TaskRunResult, e
 	return results, nil
 }
 
-func generateCacheKeyFromTemplate(taskRun *tektonv1.TaskRun, pod *corev1.Pod) (string, string, error) {
+func generateCacheKeyFromTemplate(taskRun *tektonv1.TaskRun, pod *corev1.Pod, resolver client.ImageResolverInterface,
+	logger *zap.SugaredLogger) (string, string, error) {
 	template := Template{}
 	template.Spec = taskRun.Spec
 	template.Spec.Timeout = nil //clear timeout
@@ -347,6 +387,25 @@ func generateCacheKeyFromTemplate(taskRun *tektonv1.TaskRun, pod *corev1.Pod) (s
 	template.PipelineName = pod.ObjectMeta.Labels[PipelineName]
 	template.Generation = pod.ObjectMeta.Labels[Generation]
 
+	imageDigests, err := resolveImageDigests(pod, resolver, logger)
+	if err != nil {
+		return "", "", err
+	}
+	template.ImageDigests = imageDigests
+	for _, container := range pod.Spec.Containers {
+		if len(container.Env) != 0 {
+			if template.Env == nil {
+				template.Env = map[string][]corev1.EnvVar{}
+			}
+			template.Env[container.Name] = container.Env
+		}
+	}
+	if hashes, exists := pod.ObjectMeta.Annotations[InputArtifactHashesKey]; exists {
+		if err := json.Unmarshal([]byte(hashes), &template.InputArtifactHashes); err != nil {
+			return "", "", fmt.Errorf("invalid annotation %s: %v", InputArtifactHashesKey, err)
+		}
+	}
+
 	b, err := json.Marshal(template)
 	if err != nil {
 		return "", "", err
@@ -359,6 +418,31 @@ func generateCacheKeyFromTemplate(taskRun *tektonv1.TaskRun, pod *corev1.Pod) (s
 	return executionHashKey, string(b), nil
 }
 
+// resolveImageDigests returns the digests of the images of the containers. The
+// images whose tags can't be resolved are keyed by their tags, except the
+// mutable ones, which fail so their executions are neither cached nor reused.
+func resolveImageDigests(pod *corev1.Pod, resolver client.ImageResolverInterface, logger *zap.SugaredLogger) (
+	map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), imageResolveTimeout)
+	defer cancel()
+	imageDigests := map[string]string{}
+	for _, container := range pod.Spec.Containers {
+		digest, err := resolver.ResolveDigest(ctx, container.Image)
+		if err != nil {
+			if client.ParseImageReference(container.Image).IsMutable() {
+				return nil, fmt.Errorf("could not resolve the digest of mutable image %s: %v", container.Image, err)
+			}
+			logger.Warnf("Unable to resolve the digest of image %s, keying it by its tag: %v", container.Image, err)
+			continue
+		}
+		imageDigests[container.Name] = digest
+	}
+	if len(imageDigests) == 0 {
+		return nil, nil
+	}
+	return imageDigests, nil
+}
+
 func getValueFromSerializedMap(serializedMap string, key string) string {
 	var outputMap map[string]interface{}
 	b := []byte(serializedMap)
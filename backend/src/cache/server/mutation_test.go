@@ -20,15 +20,19 @@ import (
 	"os"
 	"testing"
 
+	"github.com/kubeflow/pipelines/backend/src/cache/client"
 	"github.com/kubeflow/pipelines/backend/src/cache/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"k8s.io/api/admission/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const fakeImageDigest = "sha256:1a2b3c"
+
 var (
 	fakePod = &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
@@ -98,6 +102,7 @@ func GetFakeRequestFromPod(pod *corev1.Pod) *v1beta1.AdmissionRequest {
 }
 
 func TestMain(m *testing.M) {
+	fakeClientManager.imageResolverFake.Digests["test_image"] = fakeImageDigest
 	os.Setenv("CACHE_NODE_RESTRICTIONS", "true")
 	defer os.Unsetenv("CACHE_NODE_RESTRICTIONS")
 	code := m.Run()
@@ -173,9 +178,9 @@ func TestMutatePodIfCached(t *testing.T) {
 
 func TestMutatePodIfCachedWithCacheEntryExist(t *testing.T) {
 	executionCache := &model.ExecutionCache{
-		ExecutionCacheKey: "8c623f608410644024522153da8c8bffd5a801ceecacb12cd582b4cb0e1b3e76",
+		ExecutionCacheKey: "7515b2de2b55de5025339dd0d1c7663769cf252ecb6215546a71d3950173acb5",
 		ExecutionOutput:   `{"pipelines.kubeflow.org/metadata_execution_id": "8c623f608410644024522153da8c8bffd5a801ceecacb12cd582b4cb0e1b3e76", "tekton.dev/outputs": "{\"main\":[{\"name\":\"test\",\"value\":\"test\"}]}"}`,
-		ExecutionTemplate: `{"Spec":{"serviceAccountName":"","status":"TaskRunCancelled"},"TaskName":"","PipelineName":"test-pipelinerun","Generation":"0"}`,
+		ExecutionTemplate: `{"Spec":{"serviceAccountName":"","status":"TaskRunCancelled"},"TaskName":"","PipelineName":"test-pipelinerun","Generation":"0","ImageDigests":{"main":"sha256:1a2b3c"}}`,
 		MaxCacheStaleness: -1,
 	}
 	fakeClientManager.CacheStore().CreateExecutionCache(executionCache)
@@ -190,9 +195,31 @@ func TestMutatePodIfCachedWithCacheEntryExist(t *testing.T) {
 	require.Equal(t, patchOperation[2].Op, OperationTypeAdd)
 }
 
+func TestMutatePodIfCachedWithCacheDisabledRun(t *testing.T) {
+	executionCache := &model.ExecutionCache{
+		ExecutionCacheKey: "7515b2de2b55de5025339dd0d1c7663769cf252ecb6215546a71d3950173acb5",
+		ExecutionOutput:   "testOutput",
+		ExecutionTemplate: `{"Spec":{"serviceAccountName":"","status":"TaskRunCancelled"},"TaskName":"","PipelineName":"test-pipelinerun","Generation":"0","ImageDigests":{"main":"sha256:1a2b3c"}}`,
+		MaxCacheStaleness: -1,
+	}
+	fakeClientManager.CacheStore().CreateExecutionCache(executionCache)
+
+	pod := *fakePod.DeepCopy()
+	pod.ObjectMeta.Annotations[DisableCacheKey] = "true"
+	patchOperation, err := MutatePodIfCached(GetFakeRequestFromPod(&pod), fakeClientManager)
+	assert.Nil(t, err)
+
+	// The step is executed again, and its result is cached.
+	require.Equal(t, 2, len(patchOperation))
+	require.Equal(t, patchOperation[0].Op, OperationTypeAdd)
+	require.Equal(t, patchOperation[1].Op, OperationTypeAdd)
+	annotations := patchOperation[0].Value.(map[string]string)
+	assert.Equal(t, executionCache.ExecutionCacheKey, annotations[ExecutionKey])
+}
+
 func TestDefaultImage(t *testing.T) {
 	executionCache := &model.ExecutionCache{
-		ExecutionCacheKey: "8c623f608410644024522153da8c8bffd5a801ceecacb12cd582b4cb0e1b3e76",
+		ExecutionCacheKey: "7515b2de2b55de5025339dd0d1c7663769cf252ecb6215546a71d3950173acb5",
 		ExecutionOutput:   `{"tekton.dev/outputs":"{\"main\":[{\"name\":\"test\",\"value\":\"test\"}]}"}`,
 		ExecutionTemplate: `{"container":{"name":"main","command":["echo", "Hello"],"image":"python:3.7"}}`,
 		MaxCacheStaleness: -1,
@@ -271,3 +298,53 @@ func TestMutatePodIfCachedWithTeamplateCleanup(t *testing.T) {
 	require.Equal(t, patchOperation[1].Op, OperationTypeAdd)
 	require.Equal(t, patchOperation[2].Op, OperationTypeAdd)
 }
+
+func TestMutatePodIfCachedWithUnresolvedMutableImage(t *testing.T) {
+	pod := *fakePod.DeepCopy()
+	pod.Spec.Containers[0].Image = "unresolved_image:latest"
+	patchOperation, err := MutatePodIfCached(GetFakeRequestFromPod(&pod), fakeClientManager)
+	assert.Nil(t, err)
+	assert.Empty(t, patchOperation)
+}
+
+func TestGenerateCacheKeyFromTemplate(t *testing.T) {
+	taskRun, err := fakeClientManager.TektonClient().GetTaskRun("", "", metav1.GetOptions{})
+	require.Nil(t, err)
+	resolver := client.NewFakeImageResolver()
+	resolver.Digests["test_image"] = fakeImageDigest
+	logger := zap.NewNop().Sugar()
+	key, template, err := generateCacheKeyFromTemplate(taskRun, fakePod, resolver, logger)
+	require.Nil(t, err)
+	assert.Equal(t, "7515b2de2b55de5025339dd0d1c7663769cf252ecb6215546a71d3950173acb5", key)
+	assert.Contains(t, template, fakeImageDigest)
+
+	// The image pushed again under the same tag.
+	resolver.Digests["test_image"] = "sha256:4d5e6f"
+	pushedKey, _, err := generateCacheKeyFromTemplate(taskRun, fakePod, resolver, logger)
+	require.Nil(t, err)
+	assert.NotEqual(t, key, pushedKey)
+
+	// A tag which isn't resolved is keyed by its name, as it is in the spec.
+	pod := fakePod.DeepCopy()
+	pod.Spec.Containers[0].Image = "unresolved_image:1.0"
+	_, template, err = generateCacheKeyFromTemplate(taskRun, pod, resolver, logger)
+	require.Nil(t, err)
+	assert.NotContains(t, template, "ImageDigests")
+
+	pod = fakePod.DeepCopy()
+	pod.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "MODE", Value: "fast"}}
+	envKey, _, err := generateCacheKeyFromTemplate(taskRun, pod, resolver, logger)
+	require.Nil(t, err)
+	assert.NotEqual(t, pushedKey, envKey)
+
+	pod = fakePod.DeepCopy()
+	pod.Annotations[InputArtifactHashesKey] = `{"dataset": "sha256:7a8b9c"}`
+	artifactKey, template, err := generateCacheKeyFromTemplate(taskRun, pod, resolver, logger)
+	require.Nil(t, err)
+	assert.NotEqual(t, pushedKey, artifactKey)
+	assert.Contains(t, template, "sha256:7a8b9c")
+
+	pod.Annotations[InputArtifactHashesKey] = "invalid"
+	_, _, err = generateCacheKeyFromTemplate(taskRun, pod, resolver, logger)
+	assert.NotNil(t, err)
+}
@@ -26,6 +26,7 @@ const (
 	ArgoCompleteLabelKey       string = "workflows.argoproj.io/completed"
 	MetadataExecutionIDKey     string = "pipelines.kubeflow.org/metadata_execution_id"
 	MaxCacheStalenessKey       string = "pipelines.kubeflow.org/max_cache_staleness"
+	CacheTTLKey                string = "pipelines.kubeflow.org/cache_ttl"
 )
 
 func WatchPods(ctx context.Context, namespaceToWatch string, clientManager ClientManagerInterface) {
@@ -87,7 +88,12 @@ func WatchPods(ctx context.Context, namespaceToWatch string, clientManager Clien
 			executionOutputMap[CachedPipeline] = pod.ObjectMeta.Labels[PipelineRun]
 			executionOutputJSON, _ := json.Marshal(executionOutputMap)
 
-			executionMaxCacheStaleness, exists := pod.ObjectMeta.Annotations[MaxCacheStalenessKey]
+			// The entry expires after the TTL of the step, by default its max
+			// staleness.
+			executionMaxCacheStaleness, exists := pod.ObjectMeta.Annotations[CacheTTLKey]
+			if !exists {
+				executionMaxCacheStaleness, exists = pod.ObjectMeta.Annotations[MaxCacheStalenessKey]
+			}
 			var maxCacheStalenessInSeconds int64 = -1
 			if exists {
 				maxCacheStalenessInSeconds = getMaxCacheStaleness(executionMaxCacheStaleness)
@@ -95,10 +101,14 @@ func WatchPods(ctx context.Context, namespaceToWatch string, clientManager Clien
 
 			executionTemplate := pod.ObjectMeta.Annotations[TektonTaskrunTemplate]
 			executionToPersist := model.ExecutionCache{
-				ExecutionCacheKey: executionKey,
-				ExecutionTemplate: executionTemplate,
-				ExecutionOutput:   string(executionOutputJSON),
-				MaxCacheStaleness: maxCacheStalenessInSeconds,
+				ExecutionCacheKey:      executionKey,
+				ExecutionTemplate:      executionTemplate,
+				ExecutionOutput:        string(executionOutputJSON),
+				MaxCacheStaleness:      maxCacheStalenessInSeconds,
+				PipelineName:           pod.ObjectMeta.Labels[PipelineName],
+				TaskName:               pod.ObjectMeta.Labels[TaskName],
+				Generation:             pod.ObjectMeta.Labels[Generation],
+				ExecutionDurationInSec: getExecutionDuration(pod),
 			}
 
 			cacheEntryCreated, err := clientManager.CacheStore().CreateExecutionCache(&executionToPersist)
@@ -107,6 +117,8 @@ func WatchPods(ctx context.Context, namespaceToWatch string, clientManager Clien
 				continue
 			}
 
+			cacheEntriesCreated.Inc()
+
 			err = patchCacheID(ctx, k8sCore, pod, namespaceToWatch, cacheEntryCreated.ID, logger)
 			if err != nil {
 				logger.Errorf("Patch Pod: %s failed", pod.ObjectMeta.Name)
@@ -160,6 +172,24 @@ func parseResult(pod *corev1.Pod, logger *zap.SugaredLogger) (string, error) {
 	return string(b), nil
 }
 
+// getExecutionDuration returns how long the pod ran, from its start to the end
+// of its last container, in seconds.
+func getExecutionDuration(pod *corev1.Pod) int64 {
+	if pod.Status.StartTime == nil {
+		return 0
+	}
+	var finishedAt time.Time
+	for _, state := range pod.Status.ContainerStatuses {
+		if state.State.Terminated != nil && state.State.Terminated.FinishedAt.Time.After(finishedAt) {
+			finishedAt = state.State.Terminated.FinishedAt.Time
+		}
+	}
+	if finishedAt.Before(pod.Status.StartTime.Time) {
+		return 0
+	}
+	return int64(finishedAt.Sub(pod.Status.StartTime.Time) / time.Second)
+}
+
 func isPodCompletedAndSucceeded(pod *corev1.Pod) bool {
 	return pod.Status.Phase == corev1.PodSucceeded
 }
@@ -195,11 +225,13 @@ func patchCacheID(ctx context.Context, k8sCore client.KubernetesCoreInterface, p
 	return nil
 }
 
-// Convert RFC3339 Duration(Eg. "P1DT30H4S") to int64 seconds.
+// Convert RFC3339 Duration(Eg. "P1DT30H4S") or Go duration(Eg. "24h") to int64 seconds.
 func getMaxCacheStaleness(maxCacheStaleness string) int64 {
 	var seconds int64 = -1
 	if d, err := duration.Parse(maxCacheStaleness); err == nil {
 		seconds = int64(d / time.Second)
+	} else if d, err := time.ParseDuration(maxCacheStaleness); err == nil {
+		seconds = int64(d / time.Second)
 	}
 	return seconds
 }
@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
+	"github.com/jinzhu/gorm"
 	model "github.com/kubeflow/pipelines/backend/src/cache/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 )
@@ -28,8 +30,60 @@ type ExecutionCacheStoreInterface interface {
 	GetExecutionCache(executionCacheKey string, maxCacheStaleness int64) (*model.ExecutionCache, error)
 	CreateExecutionCache(*model.ExecutionCache) (*model.ExecutionCache, error)
 	DeleteExecutionCache(executionCacheKey string) error
+	ListExecutionCaches(filter *ExecutionCacheFilter, afterID int64, limit int) ([]*model.ExecutionCache, error)
+	DeleteExecutionCaches(filter *ExecutionCacheFilter) (int64, error)
+	RecordExecutionCacheHit(executionCacheID int64) error
+	ListPipelineCacheStats(pipelineName string) ([]*PipelineCacheStats, error)
 }
 
+// PipelineCacheStats are the statistics of the cache entries of a pipeline
+// version: how many executions were stored, and how many times and how long
+// they were reused.
+type PipelineCacheStats struct {
+	PipelineName    string
+	PipelineVersion string
+	Entries         int64
+	Hits            int64
+	SavedSeconds    int64
+}
+
+// ExecutionCacheFilter selects the cache entries of a pipeline, a task, or whose
+// keys start with a prefix. The empty fields match all the entries.
+type ExecutionCacheFilter struct {
+	PipelineName string
+	TaskName     string
+	KeyPrefix    string
+}
+
+func (f *ExecutionCacheFilter) IsEmpty() bool {
+	return f.PipelineName == "" && f.TaskName == "" && f.KeyPrefix == ""
+}
+
+func (f *ExecutionCacheFilter) apply(db *gorm.DB) *gorm.DB {
+	if f.PipelineName != "" {
+		db = db.Where("PipelineName = ?", f.PipelineName)
+	}
+	if f.TaskName != "" {
+		db = db.Where("TaskName = ?", f.TaskName)
+	}
+	if f.KeyPrefix != "" {
+		// The keys are hex, so the prefix has no wildcard to escape once validated.
+		db = db.Where("ExecutionCacheKey LIKE ?", f.KeyPrefix+"%")
+	}
+	return db
+}
+
+// matches applies the filter to an entry, for the stores which can't query.
+func (f *ExecutionCacheFilter) matches(executionCache *model.ExecutionCache) bool {
+	return (f.PipelineName == "" || executionCache.PipelineName == f.PipelineName) &&
+		(f.TaskName == "" || executionCache.TaskName == f.TaskName) &&
+		strings.HasPrefix(executionCache.ExecutionCacheKey, f.KeyPrefix)
+}
+
+// The columns scanned by scanRows, in order.
+const executionCacheColumns = "ID, ExecutionCacheKey, ExecutionTemplate, ExecutionOutput, MaxCacheStaleness, StartedAtInSec, EndedAtInSec, " +
+	"ExecutionDurationInSec"
+
 type ExecutionCacheStore struct {
 	db   *DB
 	time util.TimeInterface
@@ -39,7 +93,7 @@ func (s *ExecutionCacheStore) GetExecutionCache(executionCacheKey string, maxCac
 	if maxCacheStaleness == 0 {
 		return nil, fmt.Errorf("MaxCacheStaleness=0, Cache is disabled.")
 	}
-	r, err := s.db.Table("execution_caches").Where("ExecutionCacheKey = ?", executionCacheKey).Rows()
+	r, err := s.db.Table("execution_caches").Select(executionCacheColumns).Where("ExecutionCacheKey = ?", executionCacheKey).Rows()
 	if err != nil {
 		return nil, fmt.Errorf("Failed to get execution cache: %q", executionCacheKey)
 	}
@@ -62,7 +116,7 @@ func (s *ExecutionCacheStore) scanRows(rows *sql.Rows, podMaxCacheStaleness int6
 	var executionCaches []*model.ExecutionCache
 	for rows.Next() {
 		var executionCacheKey, executionTemplate, executionOutput string
-		var id, maxCacheStaleness, startedAtInSec, endedAtInSec int64
+		var id, maxCacheStaleness, startedAtInSec, endedAtInSec, executionDurationInSec int64
 		err := rows.Scan(
 			&id,
 			&executionCacheKey,
@@ -70,28 +124,39 @@ func (s *ExecutionCacheStore) scanRows(rows *sql.Rows, podMaxCacheStaleness int6
 			&executionOutput,
 			&maxCacheStaleness,
 			&startedAtInSec,
-			&endedAtInSec)
+			&endedAtInSec,
+			&executionDurationInSec)
 		if err != nil {
 			return executionCaches, nil
 		}
 		log.Println("Get id: " + strconv.FormatInt(id, 10))
 		log.Println("Get template: " + executionTemplate)
-		if maxCacheStaleness == -1 || s.time.Now().UTC().Unix()-startedAtInSec <= podMaxCacheStaleness {
-			executionCaches = append(executionCaches, &model.ExecutionCache{
-				ID:                id,
-				ExecutionCacheKey: executionCacheKey,
-				ExecutionTemplate: executionTemplate,
-				ExecutionOutput:   executionOutput,
-				MaxCacheStaleness: maxCacheStaleness,
-				StartedAtInSec:    startedAtInSec,
-				EndedAtInSec:      endedAtInSec,
-			})
+		executionCache := &model.ExecutionCache{
+			ID:                     id,
+			ExecutionCacheKey:      executionCacheKey,
+			ExecutionTemplate:      executionTemplate,
+			ExecutionOutput:        executionOutput,
+			MaxCacheStaleness:      maxCacheStaleness,
+			StartedAtInSec:         startedAtInSec,
+			EndedAtInSec:           endedAtInSec,
+			ExecutionDurationInSec: executionDurationInSec,
+		}
+		if isExecutionCacheFresh(executionCache, s.time.Now().UTC().Unix(), podMaxCacheStaleness) {
+			executionCaches = append(executionCaches, executionCache)
 		}
 
 	}
 	return executionCaches, nil
 }
 
+// isExecutionCacheFresh returns whether the entry is within both its own TTL and
+// the max staleness of the pod looking it up, a negative one meaning no limit.
+func isExecutionCacheFresh(executionCache *model.ExecutionCache, now int64, podMaxCacheStaleness int64) bool {
+	age := now - executionCache.StartedAtInSec
+	return (executionCache.MaxCacheStaleness < 0 || age <= executionCache.MaxCacheStaleness) &&
+		(podMaxCacheStaleness < 0 || age <= podMaxCacheStaleness)
+}
+
 // Demo version will return the latest cache entry within same cache key. MaxCacheStaleness will
 // be taken into consideration in the future.
 func getLatestCacheEntry(executionCaches []*model.ExecutionCache) (*model.ExecutionCache, error) {
@@ -142,6 +207,62 @@ func (s *ExecutionCacheStore) DeleteExecutionCache(executionCacheID string) erro
 	return nil
 }
 
+// ListExecutionCaches lists the entries matching the filter with IDs greater
+// than afterID, by ID.
+func (s *ExecutionCacheStore) ListExecutionCaches(filter *ExecutionCacheFilter, afterID int64, limit int) (
+	[]*model.ExecutionCache, error) {
+	var executionCaches []*model.ExecutionCache
+	db := filter.apply(s.db.DB).Where("ID > ?", afterID).Order("ID").Limit(limit).Find(&executionCaches)
+	if db.Error != nil {
+		return nil, fmt.Errorf("Failed to list execution caches: %v", db.Error)
+	}
+	return executionCaches, nil
+}
+
+// DeleteExecutionCaches deletes the entries matching the filter, and returns
+// their number.
+func (s *ExecutionCacheStore) DeleteExecutionCaches(filter *ExecutionCacheFilter) (int64, error) {
+	db := filter.apply(s.db.DB).Delete(&model.ExecutionCache{})
+	if db.Error != nil {
+		return 0, fmt.Errorf("Failed to delete execution caches: %v", db.Error)
+	}
+	return db.RowsAffected, nil
+}
+
+// RecordExecutionCacheHit counts a reuse of the entry.
+func (s *ExecutionCacheStore) RecordExecutionCacheHit(executionCacheID int64) error {
+	db := s.db.Model(&model.ExecutionCache{}).Where("ID = ?", executionCacheID).
+		UpdateColumn("HitCount", gorm.Expr("HitCount + 1"))
+	if db.Error != nil {
+		return fmt.Errorf("Failed to record a hit of execution cache %d: %v", executionCacheID, db.Error)
+	}
+	return nil
+}
+
+// ListPipelineCacheStats returns the statistics of the entries by pipeline
+// version, of the pipeline if it is set. The entries deleted don't count.
+func (s *ExecutionCacheStore) ListPipelineCacheStats(pipelineName string) ([]*PipelineCacheStats, error) {
+	db := s.db.Table("execution_caches").
+		Select("PipelineName, Generation, COUNT(*), SUM(HitCount), SUM(HitCount * ExecutionDurationInSec)")
+	if pipelineName != "" {
+		db = db.Where("PipelineName = ?", pipelineName)
+	}
+	rows, err := db.Group("PipelineName, Generation").Order("PipelineName, Generation").Rows()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list pipeline cache stats: %v", err)
+	}
+	defer rows.Close()
+	stats := []*PipelineCacheStats{}
+	for rows.Next() {
+		var stat PipelineCacheStats
+		if err := rows.Scan(&stat.PipelineName, &stat.PipelineVersion, &stat.Entries, &stat.Hits, &stat.SavedSeconds); err != nil {
+			return nil, fmt.Errorf("Failed to list pipeline cache stats: %v", err)
+		}
+		stats = append(stats, &stat)
+	}
+	return stats, nil
+}
+
 // factory function for execution cache store
 func NewExecutionCacheStore(db *DB, time util.TimeInterface) *ExecutionCacheStore {
 	return &ExecutionCacheStore{
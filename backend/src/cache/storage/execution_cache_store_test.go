@@ -152,6 +152,44 @@ func TestGetExecutionCacheWithExpiredMaxCacheStaleness(t *testing.T) {
 	require.Nil(t, executionCache)
 }
 
+func TestGetExecutionCacheWithPodMaxCacheStaleness(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	executionCacheStore := NewExecutionCacheStore(db, util.NewFakeTimeForEpoch())
+	// The entry never expires, but is older than the max staleness of the pod.
+	executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput"))
+
+	executionCache, err := executionCacheStore.GetExecutionCache("testKey", 1)
+	require.Nil(t, err)
+	require.NotNil(t, executionCache)
+
+	executionCache, err = executionCacheStore.GetExecutionCache("testKey", 1)
+	require.Contains(t, err.Error(), "Execution cache not found")
+	require.Nil(t, executionCache)
+}
+
+func TestGetExecutionCacheWithCacheTTL(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	executionCacheStore := NewExecutionCacheStore(db, util.NewFakeTimeForEpoch())
+	executionCacheToPersist := createExecutionCache("testKey", "testOutput")
+	executionCacheToPersist.MaxCacheStaleness = 2
+	executionCacheStore.CreateExecutionCache(executionCacheToPersist)
+
+	executionCache, err := executionCacheStore.GetExecutionCache("testKey", -1)
+	require.Nil(t, err)
+	require.NotNil(t, executionCache)
+
+	executionCache, err = executionCacheStore.GetExecutionCache("testKey", -1)
+	require.Nil(t, err)
+	require.NotNil(t, executionCache)
+
+	// The entry expired, though the pod doesn't limit the staleness.
+	executionCache, err = executionCacheStore.GetExecutionCache("testKey", -1)
+	require.Contains(t, err.Error(), "Execution cache not found")
+	require.Nil(t, executionCache)
+}
+
 func TestDeleteExecutionCache(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	defer db.Close()
@@ -167,3 +205,87 @@ func TestDeleteExecutionCache(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "not found")
 }
+
+func TestListAndDeleteExecutionCaches(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	executionCacheStore := NewExecutionCacheStore(db, util.NewFakeTimeForEpoch())
+	for _, entry := range []struct{ key, pipeline, task string }{
+		{"aa01", "pipeline1", "train"},
+		{"aa02", "pipeline1", "eval"},
+		{"bb01", "pipeline2", "train"},
+	} {
+		executionCache := createExecutionCache(entry.key, "testOutput")
+		executionCache.PipelineName = entry.pipeline
+		executionCache.TaskName = entry.task
+		executionCacheStore.CreateExecutionCache(executionCache)
+	}
+
+	executionCaches, err := executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, 0, 2)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 2)
+	assert.Equal(t, "aa01", executionCaches[0].ExecutionCacheKey)
+	assert.Equal(t, "pipeline1", executionCaches[0].PipelineName)
+	executionCaches, err = executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, executionCaches[1].ID, 2)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 1)
+	assert.Equal(t, "bb01", executionCaches[0].ExecutionCacheKey)
+
+	executionCaches, err = executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{TaskName: "train"}, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 2)
+	executionCaches, err = executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{KeyPrefix: "aa"}, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 2)
+
+	deleted, err := executionCacheStore.DeleteExecutionCaches(&ExecutionCacheFilter{PipelineName: "pipeline1", TaskName: "train"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+	executionCaches, err = executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 2)
+
+	deleted, err = executionCacheStore.DeleteExecutionCaches(&ExecutionCacheFilter{})
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
+
+func TestListPipelineCacheStats(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	executionCacheStore := NewExecutionCacheStore(db, util.NewFakeTimeForEpoch())
+	var ids []int64
+	for _, entry := range []struct {
+		key, pipeline, generation string
+		duration                  int64
+	}{
+		{"aa01", "pipeline1", "0", 60},
+		{"aa02", "pipeline1", "0", 30},
+		{"aa03", "pipeline1", "1", 60},
+		{"bb01", "pipeline2", "0", 10},
+	} {
+		executionCache := createExecutionCache(entry.key, "testOutput")
+		executionCache.PipelineName = entry.pipeline
+		executionCache.Generation = entry.generation
+		executionCache.ExecutionDurationInSec = entry.duration
+		executionCacheCreated, err := executionCacheStore.CreateExecutionCache(executionCache)
+		require.Nil(t, err)
+		ids = append(ids, executionCacheCreated.ID)
+	}
+	for _, id := range []int64{ids[0], ids[0], ids[1], ids[3]} {
+		require.Nil(t, executionCacheStore.RecordExecutionCacheHit(id))
+	}
+
+	stats, err := executionCacheStore.ListPipelineCacheStats("")
+	require.Nil(t, err)
+	assert.Equal(t, []*PipelineCacheStats{
+		{PipelineName: "pipeline1", PipelineVersion: "0", Entries: 2, Hits: 3, SavedSeconds: 150},
+		{PipelineName: "pipeline1", PipelineVersion: "1", Entries: 1, Hits: 0, SavedSeconds: 0},
+		{PipelineName: "pipeline2", PipelineVersion: "0", Entries: 1, Hits: 1, SavedSeconds: 10},
+	}, stats)
+
+	stats, err = executionCacheStore.ListPipelineCacheStats("pipeline2")
+	require.Nil(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, "pipeline2", stats[0].PipelineName)
+}
@@ -0,0 +1,367 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	model "github.com/kubeflow/pipelines/backend/src/cache/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	DefaultRedisKeyPrefix = "kfp:cache:"
+
+	// The number of entries read at a time when scanning all the entries.
+	redisScanBatchSize = 500
+)
+
+// Adds an entry to the index of its cache key. The index expires with the last
+// of its entries, and never if one of them never expires. It only touches the
+// index, so it works in cluster mode.
+var indexExecutionCacheScript = redis.NewScript(`
+local existed = redis.call("EXISTS", KEYS[1])
+local previousTTL = redis.call("TTL", KEYS[1])
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+local ttl = tonumber(ARGV[3])
+if ttl <= 0 then
+	redis.call("PERSIST", KEYS[1])
+elseif existed == 0 or (previousTTL >= 0 and previousTTL < ttl) then
+	redis.call("EXPIRE", KEYS[1], ttl)
+end
+return 1
+`)
+
+// Counts a hit of an entry, unless it expired meanwhile.
+var recordExecutionCacheHitScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HINCRBY", KEYS[1], "HitCount", 1)
+end
+return 0
+`)
+
+// RedisExecutionCacheStore stores the cache entries in Redis, for the
+// deployments where the lookups at the admission of every pod must be quick.
+// The entries are hashes which expire after their max cache staleness, indexed
+// by their cache keys, and by their IDs in a sorted set for the listings. It
+// works with a single Redis or a cluster.
+type RedisExecutionCacheStore struct {
+	client    redis.UniversalClient
+	keyPrefix string
+	time      util.TimeInterface
+}
+
+func NewRedisExecutionCacheStore(client redis.UniversalClient, keyPrefix string, time util.TimeInterface) *RedisExecutionCacheStore {
+	return &RedisExecutionCacheStore{
+		client:    client,
+		keyPrefix: keyPrefix,
+		time:      time,
+	}
+}
+
+func (s *RedisExecutionCacheStore) idKey() string {
+	return s.keyPrefix + "id"
+}
+
+func (s *RedisExecutionCacheStore) entriesKey() string {
+	return s.keyPrefix + "entries"
+}
+
+func (s *RedisExecutionCacheStore) entryKey(id string) string {
+	return s.keyPrefix + "entry:" + id
+}
+
+func (s *RedisExecutionCacheStore) cacheKeyIndexKey(executionCacheKey string) string {
+	return s.keyPrefix + "key:" + executionCacheKey
+}
+
+func (s *RedisExecutionCacheStore) GetExecutionCache(executionCacheKey string, maxCacheStaleness int64) (*model.ExecutionCache, error) {
+	if maxCacheStaleness == 0 {
+		return nil, fmt.Errorf("MaxCacheStaleness=0, Cache is disabled.")
+	}
+	ctx := context.Background()
+	// The latest entries first.
+	ids, err := s.client.ZRevRange(ctx, s.cacheKeyIndexKey(executionCacheKey), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get execution cache: %q", executionCacheKey)
+	}
+	executionCaches, err := s.getEntries(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get execution cache: %q", executionCacheKey)
+	}
+	now := s.time.Now().UTC().Unix()
+	for _, executionCache := range executionCaches {
+		if executionCache != nil && isExecutionCacheFresh(executionCache, now, maxCacheStaleness) {
+			return executionCache, nil
+		}
+	}
+	return nil, fmt.Errorf("Execution cache not found with cache key: %q", executionCacheKey)
+}
+
+func (s *RedisExecutionCacheStore) CreateExecutionCache(executionCache *model.ExecutionCache) (*model.ExecutionCache, error) {
+	ctx := context.Background()
+	newExecutionCache := *executionCache
+	now := s.time.Now().UTC().Unix()
+	newExecutionCache.StartedAtInSec = now
+	newExecutionCache.EndedAtInSec = now
+	newExecutionCache.HitCount = 0
+
+	id, err := s.client.Incr(ctx, s.idKey()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create a new execution cache: %v", err)
+	}
+	newExecutionCache.ID = id
+	idString := strconv.FormatInt(id, 10)
+
+	entryKey := s.entryKey(idString)
+	pipe := s.client.Pipeline()
+	pipe.HSet(ctx, entryKey, executionCacheToHash(&newExecutionCache))
+	if newExecutionCache.MaxCacheStaleness > 0 {
+		pipe.Expire(ctx, entryKey, time.Duration(newExecutionCache.MaxCacheStaleness)*time.Second)
+	}
+	pipe.ZAdd(ctx, s.entriesKey(), &redis.Z{Score: float64(id), Member: idString})
+	// Scripts are evaluated rather than run by SHA in pipelines, whose errors
+	// come too late to load them.
+	indexExecutionCacheScript.Eval(ctx, pipe, []string{s.cacheKeyIndexKey(newExecutionCache.ExecutionCacheKey)},
+		now, idString, newExecutionCache.MaxCacheStaleness)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("Failed to create a new execution cache: %v", err)
+	}
+	return &newExecutionCache, nil
+}
+
+func (s *RedisExecutionCacheStore) DeleteExecutionCache(executionCacheID string) error {
+	ctx := context.Background()
+	executionCacheKey, err := s.client.HGet(ctx, s.entryKey(executionCacheID), "ExecutionCacheKey").Result()
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("Failed to delete execution cache %s: %v", executionCacheID, err)
+	}
+	pipe := s.client.Pipeline()
+	s.deleteEntry(ctx, pipe, executionCacheID, executionCacheKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("Failed to delete execution cache %s: %v", executionCacheID, err)
+	}
+	return nil
+}
+
+// ListExecutionCaches lists the entries matching the filter with IDs greater
+// than afterID, by ID.
+func (s *RedisExecutionCacheStore) ListExecutionCaches(filter *ExecutionCacheFilter, afterID int64, limit int) (
+	[]*model.ExecutionCache, error) {
+	executionCaches := []*model.ExecutionCache{}
+	err := s.scanEntries(context.Background(), afterID, func(executionCache *model.ExecutionCache) bool {
+		if filter.matches(executionCache) {
+			executionCaches = append(executionCaches, executionCache)
+		}
+		return len(executionCaches) < limit
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list execution caches: %v", err)
+	}
+	return executionCaches, nil
+}
+
+// DeleteExecutionCaches deletes the entries matching the filter, and returns
+// their number.
+func (s *RedisExecutionCacheStore) DeleteExecutionCaches(filter *ExecutionCacheFilter) (int64, error) {
+	ctx := context.Background()
+	var executionCaches []*model.ExecutionCache
+	err := s.scanEntries(ctx, 0, func(executionCache *model.ExecutionCache) bool {
+		if filter.matches(executionCache) {
+			executionCaches = append(executionCaches, executionCache)
+		}
+		return true
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Failed to delete execution caches: %v", err)
+	}
+	pipe := s.client.Pipeline()
+	for _, executionCache := range executionCaches {
+		s.deleteEntry(ctx, pipe, strconv.FormatInt(executionCache.ID, 10), executionCache.ExecutionCacheKey)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("Failed to delete execution caches: %v", err)
+	}
+	return int64(len(executionCaches)), nil
+}
+
+// RecordExecutionCacheHit counts a reuse of the entry.
+func (s *RedisExecutionCacheStore) RecordExecutionCacheHit(executionCacheID int64) error {
+	entryKey := s.entryKey(strconv.FormatInt(executionCacheID, 10))
+	if err := recordExecutionCacheHitScript.Run(context.Background(), s.client, []string{entryKey}).Err(); err != nil {
+		return fmt.Errorf("Failed to record a hit of execution cache %d: %v", executionCacheID, err)
+	}
+	return nil
+}
+
+// ListPipelineCacheStats returns the statistics of the entries by pipeline
+// version, of the pipeline if it is set. The entries expired or deleted don't
+// count.
+func (s *RedisExecutionCacheStore) ListPipelineCacheStats(pipelineName string) ([]*PipelineCacheStats, error) {
+	statsByVersion := map[[2]string]*PipelineCacheStats{}
+	err := s.scanEntries(context.Background(), 0, func(executionCache *model.ExecutionCache) bool {
+		if pipelineName != "" && executionCache.PipelineName != pipelineName {
+			return true
+		}
+		version := [2]string{executionCache.PipelineName, executionCache.Generation}
+		stat, ok := statsByVersion[version]
+		if !ok {
+			stat = &PipelineCacheStats{PipelineName: executionCache.PipelineName, PipelineVersion: executionCache.Generation}
+			statsByVersion[version] = stat
+		}
+		stat.Entries++
+		stat.Hits += executionCache.HitCount
+		stat.SavedSeconds += executionCache.HitCount * executionCache.ExecutionDurationInSec
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list pipeline cache stats: %v", err)
+	}
+	stats := []*PipelineCacheStats{}
+	for _, stat := range statsByVersion {
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].PipelineName != stats[j].PipelineName {
+			return stats[i].PipelineName < stats[j].PipelineName
+		}
+		return stats[i].PipelineVersion < stats[j].PipelineVersion
+	})
+	return stats, nil
+}
+
+// scanEntries calls visit with the entries with IDs greater than afterID, by
+// ID, until it returns false.
+func (s *RedisExecutionCacheStore) scanEntries(ctx context.Context, afterID int64,
+	visit func(executionCache *model.ExecutionCache) bool) error {
+	for {
+		ids, err := s.client.ZRangeByScore(ctx, s.entriesKey(), &redis.ZRangeBy{
+			Min:   "(" + strconv.FormatInt(afterID, 10),
+			Max:   "+inf",
+			Count: redisScanBatchSize,
+		}).Result()
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		executionCaches, err := s.getEntries(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, executionCache := range executionCaches {
+			if executionCache != nil && !visit(executionCache) {
+				return nil
+			}
+		}
+		if afterID, err = strconv.ParseInt(ids[len(ids)-1], 10, 64); err != nil {
+			return err
+		}
+	}
+}
+
+// getEntries gets the entries of the IDs, nil for those which expired, which
+// it removes from the indexes.
+func (s *RedisExecutionCacheStore) getEntries(ctx context.Context, ids []string) ([]*model.ExecutionCache, error) {
+	pipe := s.client.Pipeline()
+	cmds := make([]*redis.StringStringMapCmd, len(ids))
+	for i, id := range ids {
+		cmds[i] = pipe.HGetAll(ctx, s.entryKey(id))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	executionCaches := make([]*model.ExecutionCache, len(ids))
+	var expiredIDs []interface{}
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			expiredIDs = append(expiredIDs, ids[i])
+			continue
+		}
+		executionCache, err := executionCacheFromHash(ids[i], fields)
+		if err != nil {
+			return nil, err
+		}
+		executionCaches[i] = executionCache
+	}
+	if len(expiredIDs) != 0 {
+		// The index of the cache key expires on its own.
+		if err := s.client.ZRem(ctx, s.entriesKey(), expiredIDs...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return executionCaches, nil
+}
+
+func (s *RedisExecutionCacheStore) deleteEntry(ctx context.Context, pipe redis.Pipeliner, id string, executionCacheKey string) {
+	pipe.Del(ctx, s.entryKey(id))
+	pipe.ZRem(ctx, s.entriesKey(), id)
+	if executionCacheKey != "" {
+		pipe.ZRem(ctx, s.cacheKeyIndexKey(executionCacheKey), id)
+	}
+}
+
+func executionCacheToHash(executionCache *model.ExecutionCache) map[string]interface{} {
+	return map[string]interface{}{
+		"ExecutionCacheKey":      executionCache.ExecutionCacheKey,
+		"ExecutionTemplate":      executionCache.ExecutionTemplate,
+		"ExecutionOutput":        executionCache.ExecutionOutput,
+		"MaxCacheStaleness":      executionCache.MaxCacheStaleness,
+		"StartedAtInSec":         executionCache.StartedAtInSec,
+		"EndedAtInSec":           executionCache.EndedAtInSec,
+		"PipelineName":           executionCache.PipelineName,
+		"TaskName":               executionCache.TaskName,
+		"Generation":             executionCache.Generation,
+		"ExecutionDurationInSec": executionCache.ExecutionDurationInSec,
+		"HitCount":               executionCache.HitCount,
+	}
+}
+
+func executionCacheFromHash(id string, fields map[string]string) (*model.ExecutionCache, error) {
+	executionCache := &model.ExecutionCache{
+		ExecutionCacheKey: fields["ExecutionCacheKey"],
+		ExecutionTemplate: fields["ExecutionTemplate"],
+		ExecutionOutput:   fields["ExecutionOutput"],
+		PipelineName:      fields["PipelineName"],
+		TaskName:          fields["TaskName"],
+		Generation:        fields["Generation"],
+	}
+	var err error
+	if executionCache.ID, err = strconv.ParseInt(id, 10, 64); err != nil {
+		return nil, fmt.Errorf("Invalid execution cache id %q", id)
+	}
+	for field, value := range map[string]*int64{
+		"MaxCacheStaleness":      &executionCache.MaxCacheStaleness,
+		"StartedAtInSec":         &executionCache.StartedAtInSec,
+		"EndedAtInSec":           &executionCache.EndedAtInSec,
+		"ExecutionDurationInSec": &executionCache.ExecutionDurationInSec,
+		"HitCount":               &executionCache.HitCount,
+	} {
+		if fields[field] == "" {
+			continue
+		}
+		if *value, err = strconv.ParseInt(fields[field], 10, 64); err != nil {
+			return nil, fmt.Errorf("Invalid field %s of execution cache %s: %q", field, id, fields[field])
+		}
+	}
+	return executionCache, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/kubeflow/pipelines/backend/src/cache/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeRedisExecutionCacheStore(t *testing.T) (*RedisExecutionCacheStore, *miniredis.Miniredis) {
+	server, err := miniredis.Run()
+	require.Nil(t, err)
+	t.Cleanup(server.Close)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return NewRedisExecutionCacheStore(client, DefaultRedisKeyPrefix, util.NewFakeTimeForEpoch()), server
+}
+
+func TestRedisCreateAndGetExecutionCache(t *testing.T) {
+	executionCacheStore, _ := newFakeRedisExecutionCacheStore(t)
+	executionCacheExpected := model.ExecutionCache{
+		ID:                1,
+		ExecutionCacheKey: "testKey",
+		ExecutionTemplate: "testTemplate",
+		ExecutionOutput:   "testOutput",
+		MaxCacheStaleness: -1,
+		StartedAtInSec:    1,
+		EndedAtInSec:      1,
+	}
+	executionCache, err := executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput"))
+	require.Nil(t, err)
+	require.Equal(t, executionCacheExpected, *executionCache)
+
+	executionCache, err = executionCacheStore.GetExecutionCache("testKey", -1)
+	require.Nil(t, err)
+	require.Equal(t, &executionCacheExpected, executionCache)
+
+	executionCache, err = executionCacheStore.GetExecutionCache("wrongKey", -1)
+	require.Nil(t, executionCache)
+	require.Contains(t, err.Error(), `Execution cache not found with cache key: "wrongKey"`)
+}
+
+func TestRedisGetExecutionCacheWithLatestCacheEntry(t *testing.T) {
+	executionCacheStore, _ := newFakeRedisExecutionCacheStore(t)
+	executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput"))
+	executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput2"))
+
+	executionCache, err := executionCacheStore.GetExecutionCache("testKey", -1)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), executionCache.ID)
+	assert.Equal(t, "testOutput2", executionCache.ExecutionOutput)
+}
+
+func TestRedisGetExecutionCacheWithPodMaxCacheStaleness(t *testing.T) {
+	executionCacheStore, _ := newFakeRedisExecutionCacheStore(t)
+	executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput"))
+
+	executionCache, err := executionCacheStore.GetExecutionCache("testKey", 1)
+	require.Nil(t, err)
+	require.NotNil(t, executionCache)
+
+	executionCache, err = executionCacheStore.GetExecutionCache("testKey", 1)
+	require.Contains(t, err.Error(), "Execution cache not found")
+	require.Nil(t, executionCache)
+
+	_, err = executionCacheStore.GetExecutionCache("testKey", 0)
+	require.Contains(t, err.Error(), "Cache is disabled")
+}
+
+func TestRedisExecutionCacheExpires(t *testing.T) {
+	executionCacheStore, server := newFakeRedisExecutionCacheStore(t)
+	executionCacheToPersist := createExecutionCache("testKey", "testOutput")
+	executionCacheToPersist.MaxCacheStaleness = 60
+	executionCacheStore.CreateExecutionCache(executionCacheToPersist)
+	assert.Equal(t, 60*time.Second, server.TTL(DefaultRedisKeyPrefix+"entry:1"))
+	assert.Equal(t, 60*time.Second, server.TTL(DefaultRedisKeyPrefix+"key:testKey"))
+
+	// An entry which never expires keeps the index of its key.
+	executionCacheStore.CreateExecutionCache(createExecutionCache("testKey", "testOutput2"))
+	assert.Equal(t, time.Duration(0), server.TTL(DefaultRedisKeyPrefix+"key:testKey"))
+
+	server.FastForward(61 * time.Second)
+	executionCaches, err := executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 1)
+	assert.Equal(t, int64(2), executionCaches[0].ID)
+	members, err := server.ZMembers(DefaultRedisKeyPrefix + "entries")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"2"}, members)
+}
+
+func TestRedisListAndDeleteExecutionCaches(t *testing.T) {
+	executionCacheStore, server := newFakeRedisExecutionCacheStore(t)
+	for _, entry := range []struct{ key, pipeline, task string }{
+		{"aa01", "pipeline1", "train"},
+		{"aa02", "pipeline1", "eval"},
+		{"bb01", "pipeline2", "train"},
+	} {
+		executionCache := createExecutionCache(entry.key, "testOutput")
+		executionCache.PipelineName = entry.pipeline
+		executionCache.TaskName = entry.task
+		executionCacheStore.CreateExecutionCache(executionCache)
+	}
+
+	executionCaches, err := executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, 0, 2)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 2)
+	assert.Equal(t, "aa01", executionCaches[0].ExecutionCacheKey)
+	executionCaches, err = executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, executionCaches[1].ID, 2)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 1)
+	assert.Equal(t, "bb01", executionCaches[0].ExecutionCacheKey)
+
+	executionCaches, err = executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{TaskName: "train"}, 0, 10)
+	require.Nil(t, err)
+	require.Len(t, executionCaches, 2)
+
+	deleted, err := executionCacheStore.DeleteExecutionCaches(&ExecutionCacheFilter{KeyPrefix: "aa"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+	assert.False(t, server.Exists(DefaultRedisKeyPrefix+"entry:1"))
+	assert.False(t, server.Exists(DefaultRedisKeyPrefix+"key:aa01"))
+
+	require.Nil(t, executionCacheStore.DeleteExecutionCache("3"))
+	executionCaches, err = executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, 0, 10)
+	require.Nil(t, err)
+	assert.Empty(t, executionCaches)
+	_, err = executionCacheStore.GetExecutionCache("bb01", -1)
+	require.Contains(t, err.Error(), "Execution cache not found")
+}
+
+func TestRedisListPipelineCacheStats(t *testing.T) {
+	executionCacheStore, _ := newFakeRedisExecutionCacheStore(t)
+	for _, entry := range []struct {
+		key, pipeline, generation string
+		duration                  int64
+	}{
+		{"aa01", "pipeline1", "0", 60},
+		{"aa02", "pipeline1", "0", 30},
+		{"bb01", "pipeline2", "0", 10},
+	} {
+		executionCache := createExecutionCache(entry.key, "testOutput")
+		executionCache.PipelineName = entry.pipeline
+		executionCache.Generation = entry.generation
+		executionCache.ExecutionDurationInSec = entry.duration
+		executionCacheStore.CreateExecutionCache(executionCache)
+	}
+	for _, id := range []int64{1, 1, 2, 3} {
+		require.Nil(t, executionCacheStore.RecordExecutionCacheHit(id))
+	}
+	// The hits of the entries gone aren't recorded.
+	require.Nil(t, executionCacheStore.RecordExecutionCacheHit(4))
+
+	stats, err := executionCacheStore.ListPipelineCacheStats("")
+	require.Nil(t, err)
+	assert.Equal(t, []*PipelineCacheStats{
+		{PipelineName: "pipeline1", PipelineVersion: "0", Entries: 2, Hits: 3, SavedSeconds: 150},
+		{PipelineName: "pipeline2", PipelineVersion: "0", Entries: 1, Hits: 1, SavedSeconds: 10},
+	}, stats)
+	executionCaches, err := executionCacheStore.ListExecutionCaches(&ExecutionCacheFilter{}, 0, 10)
+	require.Nil(t, err)
+	assert.Len(t, executionCaches, 3)
+}
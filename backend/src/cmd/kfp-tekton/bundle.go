@@ -0,0 +1,200 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_model"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_model"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// bundleManifestFile is the file of a bundle listing its resources.
+	bundleManifestFile = "bundle.yaml"
+	bundleVersion      = "v1"
+)
+
+// Bundle is the resources exported from a cluster, to be imported in another.
+// The templates of the pipeline versions are files of the bundle.
+type Bundle struct {
+	Version     string                          `json:"version"`
+	Experiments []*experimentmodel.V1Experiment `json:"experiments,omitempty"`
+	Pipelines   []*BundlePipeline               `json:"pipelines,omitempty"`
+	Jobs        []*jobmodel.V1Job               `json:"jobs,omitempty"`
+}
+
+// BundlePipeline is a pipeline with all its versions, the oldest first.
+type BundlePipeline struct {
+	Pipeline *pipelinemodel.V1Pipeline `json:"pipeline"`
+	Versions []*BundlePipelineVersion  `json:"versions"`
+}
+
+type BundlePipelineVersion struct {
+	Version *pipelinemodel.V1PipelineVersion `json:"version"`
+	// Template is the path of the file of the template in the bundle.
+	Template string `json:"template"`
+}
+
+// bundleFiles are the files of a bundle by their slash separated paths.
+type bundleFiles map[string][]byte
+
+func templatePath(pipelineID string, versionID string) string {
+	return path.Join("pipelines", pipelineID, versionID+".yaml")
+}
+
+func isArchive(bundlePath string) bool {
+	return strings.HasSuffix(bundlePath, ".tar.gz") || strings.HasSuffix(bundlePath, ".tgz")
+}
+
+func encodeBundle(bundle *Bundle, files bundleFiles) error {
+	content, err := yaml.Marshal(bundle)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to marshal the bundle")
+	}
+	files[bundleManifestFile] = content
+	return nil
+}
+
+func decodeBundle(files bundleFiles) (*Bundle, error) {
+	content, ok := files[bundleManifestFile]
+	if !ok {
+		return nil, fmt.Errorf("The bundle has no %s", bundleManifestFile)
+	}
+	bundle := &Bundle{}
+	if err := yaml.Unmarshal(content, bundle); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the bundle")
+	}
+	if bundle.Version != bundleVersion {
+		return nil, fmt.Errorf("Unsupported bundle version '%s', expected %s", bundle.Version, bundleVersion)
+	}
+	for _, pipeline := range bundle.Pipelines {
+		for _, version := range pipeline.Versions {
+			if _, ok := files[version.Template]; !ok {
+				return nil, fmt.Errorf("The bundle has no template '%s'", version.Template)
+			}
+		}
+	}
+	return bundle, nil
+}
+
+// writeBundleFiles writes the files of a bundle to a directory, or to a
+// .tar.gz archive.
+func writeBundleFiles(bundlePath string, files bundleFiles) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if !isArchive(bundlePath) {
+		for _, name := range names {
+			filePath := filepath.Join(bundlePath, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+				return errors.Wrapf(err, "Failed to create the directory of '%s'", filePath)
+			}
+			if err := ioutil.WriteFile(filePath, files[name], 0644); err != nil {
+				return errors.Wrapf(err, "Failed to write '%s'", filePath)
+			}
+		}
+		return nil
+	}
+
+	f, err := os.Create(bundlePath)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create the archive '%s'", bundlePath)
+	}
+	defer f.Close()
+	gzipWriter := gzip.NewWriter(f)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range names {
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), Typeflag: tar.TypeReg}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return errors.Wrapf(err, "Failed to write the archive '%s'", bundlePath)
+		}
+		if _, err := tarWriter.Write(files[name]); err != nil {
+			return errors.Wrapf(err, "Failed to write the archive '%s'", bundlePath)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return errors.Wrapf(err, "Failed to write the archive '%s'", bundlePath)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return errors.Wrapf(err, "Failed to write the archive '%s'", bundlePath)
+	}
+	return f.Close()
+}
+
+// readBundleFiles reads the files of a bundle from a directory, or from a
+// .tar.gz archive.
+func readBundleFiles(bundlePath string) (bundleFiles, error) {
+	files := bundleFiles{}
+	if !isArchive(bundlePath) {
+		err := filepath.Walk(bundlePath, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			name, err := filepath.Rel(bundlePath, filePath)
+			if err != nil {
+				return err
+			}
+			if files[filepath.ToSlash(name)], err = ioutil.ReadFile(filePath); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to read the bundle '%s'", bundlePath)
+		}
+		return files, nil
+	}
+
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to open the archive '%s'", bundlePath)
+	}
+	defer f.Close()
+	gzipReader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read the archive '%s'", bundlePath)
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to read the archive '%s'", bundlePath)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if files[path.Clean(header.Name)], err = ioutil.ReadAll(tarReader); err != nil {
+			return nil, errors.Wrapf(err, "Failed to read the archive '%s'", bundlePath)
+		}
+	}
+}
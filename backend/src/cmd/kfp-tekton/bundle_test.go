@@ -0,0 +1,72 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_model"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBundle() (*Bundle, bundleFiles) {
+	bundle := &Bundle{
+		Version:     bundleVersion,
+		Experiments: []*experimentmodel.V1Experiment{{ID: "experiment-1", Name: "training"}},
+		Pipelines: []*BundlePipeline{{
+			Pipeline: &pipelinemodel.V1Pipeline{ID: "pipeline-1", Name: "echo"},
+			Versions: []*BundlePipelineVersion{{
+				Version:  &pipelinemodel.V1PipelineVersion{ID: "version-1", Name: "echo"},
+				Template: templatePath("pipeline-1", "version-1"),
+			}},
+		}},
+	}
+	return bundle, bundleFiles{templatePath("pipeline-1", "version-1"): []byte(pipelineTemplate)}
+}
+
+func TestBundleFiles(t *testing.T) {
+	for _, name := range []string{"bundle", "bundle.tar.gz"} {
+		t.Run(name, func(t *testing.T) {
+			bundle, files := testBundle()
+			require.Nil(t, encodeBundle(bundle, files))
+			bundlePath := filepath.Join(t.TempDir(), name)
+			require.Nil(t, writeBundleFiles(bundlePath, files))
+
+			read, err := readBundleFiles(bundlePath)
+			require.Nil(t, err)
+			assert.Equal(t, files, read)
+			decoded, err := decodeBundle(read)
+			require.Nil(t, err)
+			assert.Equal(t, bundle, decoded)
+		})
+	}
+}
+
+func TestDecodeBundle_Invalid(t *testing.T) {
+	_, err := decodeBundle(bundleFiles{})
+	assert.Contains(t, err.Error(), "The bundle has no bundle.yaml")
+
+	_, err = decodeBundle(bundleFiles{bundleManifestFile: []byte("version: v2\n")})
+	assert.Contains(t, err.Error(), "Unsupported bundle version 'v2'")
+
+	bundle, files := testBundle()
+	require.Nil(t, encodeBundle(bundle, files))
+	delete(files, templatePath("pipeline-1", "version-1"))
+	_, err = decodeBundle(files)
+	assert.Contains(t, err.Error(), "The bundle has no template 'pipelines/pipeline-1/version-1.yaml'")
+}
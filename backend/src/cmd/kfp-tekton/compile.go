@@ -0,0 +1,109 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// The name of the pipeline file read from the standard input.
+const stdinFileName = "stdin.yaml"
+
+func newCompileCommand(options *rootOptions) *cobra.Command {
+	var file string
+	var outputFile string
+	var strict bool
+	command := &cobra.Command{
+		Use:   "compile",
+		Short: "Compile a pipeline to the PipelineRun the API server stores, without a cluster",
+		Long: "Compile a pipeline file, as uploaded to the API server, to the PipelineRun the API server stores for it. " +
+			"The file is a Tekton PipelineRun, a v2 pipeline spec (the IR of the KFP SDK) or an Argo Workflow. " +
+			"The warnings of the static analysis of the pipeline are printed to the standard error.",
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			manifest, warnings, err := compilePipeline(file, command.InOrStdin())
+			if err != nil {
+				return err
+			}
+			for _, warning := range warnings {
+				fmt.Fprintf(command.ErrOrStderr(), "Warning: %s\n", formatWarning(warning))
+			}
+			if outputFile == "" || outputFile == "-" {
+				_, err = command.OutOrStdout().Write(manifest)
+			} else {
+				err = ioutil.WriteFile(outputFile, manifest, 0644)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "Failed to write the PipelineRun")
+			}
+			if strict && len(warnings) > 0 {
+				return fmt.Errorf("The pipeline has %d warnings", len(warnings))
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "",
+		"The pipeline file: YAML, JSON, or a .zip or .tar.gz archive of one. - reads the standard input.")
+	command.Flags().StringVarP(&outputFile, "output", "o", "", "The file of the PipelineRun, the standard output by default.")
+	command.Flags().BoolVar(&strict, "strict", false, "Fail if the analysis of the pipeline has warnings.")
+	command.MarkFlagRequired("file")
+	return command
+}
+
+// compilePipeline compiles a pipeline file like the compile endpoint of the API
+// server, and returns the YAML manifest of its PipelineRun and its warnings.
+func compilePipeline(file string, stdin io.Reader) ([]byte, []template.Warning, error) {
+	fileName, reader := filepath.Base(file), stdin
+	if file == "-" {
+		fileName = stdinFileName
+	} else {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "Failed to open the pipeline file '%s'", file)
+		}
+		defer f.Close()
+		reader = f
+	}
+	pipelineFile, err := template.ReadPipelineFile(fileName, reader, common.GetMaxManifestSize())
+	if err != nil {
+		return nil, nil, err
+	}
+	workflow, warnings, err := template.Compile(pipelineFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest, err := yaml.Marshal(workflow.PipelineRun)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Failed to marshal the PipelineRun")
+	}
+	return manifest, warnings, nil
+}
+
+func formatWarning(warning template.Warning) string {
+	if warning.Task == "" {
+		return fmt.Sprintf("%s: %s", warning.Code, warning.Message)
+	}
+	return fmt.Sprintf("%s (task %s): %s", warning.Code, warning.Task, warning.Message)
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pipelineTemplate = `
+apiVersion: tekton.dev/v1beta1
+kind: PipelineRun
+metadata:
+  name: echo
+spec:
+  pipelineSpec:
+    tasks:
+    - name: echo
+      taskSpec:
+        steps:
+        - name: main
+          image: busybox
+          script: echo $(params.message)
+`
+
+func TestCompilePipeline(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "pipeline.yaml")
+	require.Nil(t, ioutil.WriteFile(file, []byte(pipelineTemplate), 0644))
+
+	manifest, warnings, err := compilePipeline(file, nil)
+	require.Nil(t, err)
+	assert.Contains(t, string(manifest), "apiVersion: tekton.dev/v1\n")
+	assert.Contains(t, string(manifest), "name: echo\n")
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "UNBOUND_PARAMETER (task echo): "+warnings[0].Message, formatWarning(warnings[0]))
+
+	// The pipeline is read from the standard input.
+	stdinManifest, _, err := compilePipeline("-", strings.NewReader(pipelineTemplate))
+	require.Nil(t, err)
+	assert.Equal(t, manifest, stdinManifest)
+}
+
+func TestCompilePipeline_Invalid(t *testing.T) {
+	_, _, err := compilePipeline("-", strings.NewReader("apiVersion: argoproj.io/v1alpha1\nkind: Workflow\n"))
+	assert.NotNil(t, err)
+
+	_, _, err = compilePipeline(filepath.Join(t.TempDir(), "missing.yaml"), nil)
+	assert.Contains(t, err.Error(), "Failed to open the pipeline file")
+}
+
+func TestCompilePipeline_Argo(t *testing.T) {
+	manifest, _, err := compilePipeline("-", strings.NewReader(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: echo
+spec:
+  entrypoint: echo
+  templates:
+  - name: echo
+    container:
+      image: alpine
+      command: [echo, hello]
+`))
+	require.Nil(t, err)
+	assert.Contains(t, string(manifest), "apiVersion: tekton.dev/v1\n")
+	assert.Contains(t, string(manifest), "image: alpine\n")
+}
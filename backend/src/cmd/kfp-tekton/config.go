@@ -0,0 +1,237 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// The config file is set with the environment variable, or is in the home
+	// directory.
+	configEnvVar      = "KFP_TEKTON_CONFIG"
+	defaultConfigFile = ".kfp-tekton/config"
+	// The namespace of the API server when the context doesn't set it.
+	defaultNamespace = "kubeflow"
+)
+
+// Config is the configuration of the CLI. Like a kubeconfig, it names the
+// contexts of the clusters the API server is reached in, one of them current.
+type Config struct {
+	CurrentContext string          `json:"current-context,omitempty"`
+	Contexts       []*NamedContext `json:"contexts,omitempty"`
+}
+
+type NamedContext struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+// Context is how the API server of a cluster is reached: through the
+// Kubernetes API server of a kubeconfig context, proxied to the ml-pipeline
+// service of the namespace, or directly at an endpoint. In a pod, a context
+// without a kubeconfig connects directly to the service of the namespace.
+type Context struct {
+	// Kubeconfig is the path of the kubeconfig, by default the one kubectl uses.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	// KubeContext is the context of the kubeconfig, by default its current one.
+	KubeContext string `json:"kube-context,omitempty"`
+	// Namespace is where the API server is deployed, kubeflow by default.
+	Namespace string `json:"namespace,omitempty"`
+	// TokenFile is the path of a file with the bearer token of the requests,
+	// read again as it is rotated.
+	TokenFile string `json:"token-file,omitempty"`
+	// Endpoint is the URL of the API server, connected to directly instead of
+	// through the Kubernetes API server.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// inCluster is whether the CLI runs in a pod, replaced by the tests.
+var inCluster = api_server.InCluster
+
+func defaultConfigPath() string {
+	if path := os.Getenv(configEnvVar); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return defaultConfigFile
+	}
+	return filepath.Join(home, defaultConfigFile)
+}
+
+// LoadConfig reads the config file, or returns an empty config if it doesn't
+// exist.
+func LoadConfig(path string) (*Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read the config '%s'", path)
+	}
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(content, config); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the config '%s'", path)
+	}
+	return config, nil
+}
+
+// Save writes the config file, creating its directory if needed.
+func (c *Config) Save(path string) error {
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to marshal the config")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "Failed to create the directory of the config '%s'", path)
+	}
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		return errors.Wrapf(err, "Failed to write the config '%s'", path)
+	}
+	return nil
+}
+
+// Context returns the context of the name, or the current context if the name
+// is empty. Without any context, the default kubeconfig is used.
+func (c *Config) Context(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		if len(c.Contexts) > 0 {
+			return nil, fmt.Errorf("No current context, set one with 'kfp-tekton config use-context'")
+		}
+		return &Context{}, nil
+	}
+	if named := c.find(name); named != nil {
+		return &named.Context, nil
+	}
+	return nil, fmt.Errorf("Context '%s' not found", name)
+}
+
+// SetContext adds or replaces a context.
+func (c *Config) SetContext(name string, context Context) {
+	if named := c.find(name); named != nil {
+		named.Context = context
+		return
+	}
+	c.Contexts = append(c.Contexts, &NamedContext{Name: name, Context: context})
+	sort.Slice(c.Contexts, func(i, j int) bool { return c.Contexts[i].Name < c.Contexts[j].Name })
+}
+
+// DeleteContext deletes a context, which is no longer current.
+func (c *Config) DeleteContext(name string) error {
+	for i, named := range c.Contexts {
+		if named.Name == name {
+			c.Contexts = append(c.Contexts[:i], c.Contexts[i+1:]...)
+			if c.CurrentContext == name {
+				c.CurrentContext = ""
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("Context '%s' not found", name)
+}
+
+// UseContext makes a context current.
+func (c *Config) UseContext(name string) error {
+	if c.find(name) == nil {
+		return fmt.Errorf("Context '%s' not found", name)
+	}
+	c.CurrentContext = name
+	return nil
+}
+
+func (c *Config) find(name string) *NamedContext {
+	for _, named := range c.Contexts {
+		if named.Name == name {
+			return named
+		}
+	}
+	return nil
+}
+
+func (c *Context) namespace() string {
+	if c.Namespace == "" {
+		return defaultNamespace
+	}
+	return c.Namespace
+}
+
+// ClientConfig returns the client config of the kubeconfig context, in the
+// namespace of the API server.
+func (c *Context) ClientConfig() clientcmd.ClientConfig {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if c.Kubeconfig != "" {
+		loadingRules.ExplicitPath = expandHome(c.Kubeconfig)
+	}
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: c.KubeContext,
+		Context:        clientcmdapi.Context{Namespace: c.namespace()},
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+}
+
+// endpoint returns the endpoint the API server is connected to directly, or an
+// empty string if it is proxied by the Kubernetes API server.
+func (c *Context) endpoint() string {
+	if c.Endpoint != "" {
+		return c.Endpoint
+	}
+	if c.Kubeconfig == "" && c.KubeContext == "" && inCluster() {
+		return api_server.InClusterEndpoint(c.namespace())
+	}
+	return ""
+}
+
+// ClientOptions returns the options of the clients of the API server. The
+// requests sent directly to the API server in a pod are authenticated with the
+// token of its service account, unless the token file is set.
+func (c *Context) ClientOptions() []api_server.ClientOption {
+	opts := []api_server.ClientOption{api_server.WithUserAgent("kfp-tekton-cli")}
+	endpoint := c.endpoint()
+	if endpoint != "" {
+		opts = append(opts, api_server.WithEndpoint(endpoint))
+	}
+	if c.TokenFile != "" {
+		opts = append(opts, api_server.WithTokenProvider(api_server.NewServiceAccountTokenProvider(expandHome(c.TokenFile))))
+	} else if endpoint != "" && c.Endpoint == "" {
+		opts = append(opts, api_server.WithTokenProvider(api_server.NewServiceAccountTokenProvider("")))
+	}
+	return opts
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newConfigCommand(options *rootOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the contexts of the clusters",
+	}
+	command.AddCommand(
+		newGetContextsCommand(options),
+		newCurrentContextCommand(options),
+		newUseContextCommand(options),
+		newSetContextCommand(options),
+		newDeleteContextCommand(options),
+	)
+	return command
+}
+
+func newGetContextsCommand(options *rootOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List the contexts",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			config, err := options.loadConfig()
+			if err != nil {
+				return err
+			}
+			t := &table{header: []string{"CURRENT", "NAME", "KUBE CONTEXT", "NAMESPACE"}}
+			for _, named := range config.Contexts {
+				current := ""
+				if named.Name == config.CurrentContext {
+					current = "*"
+				}
+				t.rows = append(t.rows, []string{current, named.Name, named.Context.KubeContext, named.Context.namespace()})
+			}
+			return printOutput(command.OutOrStdout(), options.output, config.Contexts, t)
+		},
+	}
+	addOutputFlag(command, options)
+	return command
+}
+
+func newCurrentContextCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "current-context",
+		Short: "Print the current context",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			config, err := options.loadConfig()
+			if err != nil {
+				return err
+			}
+			if config.CurrentContext == "" {
+				return fmt.Errorf("No current context")
+			}
+			fmt.Fprintln(command.OutOrStdout(), config.CurrentContext)
+			return nil
+		},
+	}
+}
+
+func newUseContextCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Make a context current",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			return updateConfig(options, func(config *Config) error {
+				return config.UseContext(args[0])
+			})
+		},
+	}
+}
+
+func newSetContextCommand(options *rootOptions) *cobra.Command {
+	context := Context{}
+	var current bool
+	command := &cobra.Command{
+		Use:   "set-context NAME",
+		Short: "Add or replace a context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			return updateConfig(options, func(config *Config) error {
+				config.SetContext(args[0], context)
+				// The first context is current.
+				if current || config.CurrentContext == "" {
+					config.CurrentContext = args[0]
+				}
+				return nil
+			})
+		},
+	}
+	command.Flags().StringVar(&context.Kubeconfig, "kubeconfig", "", "The kubeconfig of the cluster, by default the one kubectl uses.")
+	command.Flags().StringVar(&context.KubeContext, "kube-context", "", "The context of the kubeconfig, by default its current one.")
+	command.Flags().StringVar(&context.Namespace, "namespace", "", "The namespace of the API server, "+defaultNamespace+" by default.")
+	command.Flags().StringVar(&context.TokenFile, "token-file", "", "A file with the bearer token of the requests.")
+	command.Flags().StringVar(&context.Endpoint, "endpoint", "",
+		"The URL of the API server, connected to directly instead of through the Kubernetes API server.")
+	command.Flags().BoolVar(&current, "current", false, "Make the context current.")
+	return command
+}
+
+func newDeleteContextCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-context NAME",
+		Short: "Delete a context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			return updateConfig(options, func(config *Config) error {
+				return config.DeleteContext(args[0])
+			})
+		},
+	}
+}
+
+func updateConfig(options *rootOptions, update func(*Config) error) error {
+	config, err := options.loadConfig()
+	if err != nil {
+		return err
+	}
+	if err := update(config); err != nil {
+		return err
+	}
+	return config.Save(options.configPath)
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_NotExist(t *testing.T) {
+	config, err := LoadConfig(filepath.Join(t.TempDir(), "config"))
+	require.Nil(t, err)
+	assert.Equal(t, &Config{}, config)
+
+	// The default kubeconfig is used without any context.
+	context, err := config.Context("")
+	require.Nil(t, err)
+	assert.Equal(t, &Context{}, context)
+	namespace, _, err := context.ClientConfig().Namespace()
+	require.Nil(t, err)
+	assert.Equal(t, defaultNamespace, namespace)
+}
+
+func TestConfig_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kfp-tekton", "config")
+	config := &Config{}
+	config.SetContext("prod", Context{KubeContext: "prod-cluster", Namespace: "kfp"})
+	config.SetContext("dev", Context{Kubeconfig: "~/.kube/dev", TokenFile: "/tmp/token"})
+	require.Nil(t, config.UseContext("prod"))
+	require.Nil(t, config.Save(path))
+
+	loaded, err := LoadConfig(path)
+	require.Nil(t, err)
+	assert.Equal(t, config, loaded)
+	assert.Equal(t, "dev", loaded.Contexts[0].Name)
+	info, err := os.Stat(path)
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	context, err := loaded.Context("")
+	require.Nil(t, err)
+	assert.Equal(t, "prod-cluster", context.KubeContext)
+	context, err = loaded.Context("dev")
+	require.Nil(t, err)
+	assert.Len(t, context.ClientOptions(), 2)
+	_, err = loaded.Context("staging")
+	assert.Contains(t, err.Error(), "Context 'staging' not found")
+}
+
+func TestConfig_DeleteContext(t *testing.T) {
+	config := &Config{}
+	config.SetContext("dev", Context{})
+	config.SetContext("prod", Context{})
+	require.Nil(t, config.UseContext("prod"))
+
+	require.Nil(t, config.DeleteContext("prod"))
+	assert.Equal(t, "", config.CurrentContext)
+	assert.Len(t, config.Contexts, 1)
+	_, err := config.Context("")
+	assert.Contains(t, err.Error(), "No current context")
+	assert.NotNil(t, config.DeleteContext("prod"))
+	assert.NotNil(t, config.UseContext("prod"))
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	require.Nil(t, ioutil.WriteFile(path, []byte("current-context: dev\nclusters: []\n"), 0600))
+	_, err := LoadConfig(path)
+	assert.Contains(t, err.Error(), "Failed to parse the config")
+}
+
+func TestContext_Endpoint(t *testing.T) {
+	defer func(previous func() bool) { inCluster = previous }(inCluster)
+
+	inCluster = func() bool { return false }
+	assert.Equal(t, "", (&Context{}).endpoint())
+	assert.Equal(t, "https://kfp.example.com", (&Context{Endpoint: "https://kfp.example.com"}).endpoint())
+
+	// In a pod, the service of the API server is connected to directly with
+	// the token of the service account, unless a kubeconfig is set.
+	inCluster = func() bool { return true }
+	assert.Equal(t, "http://ml-pipeline.kfp.svc:8888", (&Context{Namespace: "kfp"}).endpoint())
+	assert.Len(t, (&Context{}).ClientOptions(), 3)
+	assert.Len(t, (&Context{TokenFile: "/tmp/token"}).ClientOptions(), 3)
+	assert.Equal(t, "", (&Context{KubeContext: "prod-cluster"}).endpoint())
+	assert.Len(t, (&Context{KubeContext: "prod-cluster"}).ClientOptions(), 1)
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+// diffLines returns the shortest edit script from the lines a to the lines b,
+// with the algorithm of Myers.
+func diffLines(a []string, b []string) []diffLine {
+	n, m := len(a), len(b)
+	offset := n + m
+	v := make([]int, 2*offset+2)
+	// trace holds v before each round d, to backtrack the edits.
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackDiff(a, b, trace, offset)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrackDiff(a []string, b []string, trace [][]int, offset int) []diffLine {
+	x, y := len(a), len(b)
+	var reversed []diffLine
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		previousK := k - 1
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			previousK = k + 1
+		}
+		previousX := v[offset+previousK]
+		previousY := previousX - previousK
+		for x > previousX && y > previousY {
+			reversed = append(reversed, diffLine{diffEqual, a[x-1]})
+			x--
+			y--
+		}
+		if x == previousX {
+			reversed = append(reversed, diffLine{diffInsert, b[y-1]})
+		} else {
+			reversed = append(reversed, diffLine{diffDelete, a[x-1]})
+		}
+		x, y = previousX, previousY
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, diffLine{diffEqual, a[x-1]})
+		x--
+		y--
+	}
+
+	lines := make([]diffLine, len(reversed))
+	for i, line := range reversed {
+		lines[len(reversed)-1-i] = line
+	}
+	return lines
+}
+
+// unifiedDiff returns the unified diff of two texts with the lines of context
+// around the changes, or an empty string if they are equal.
+func unifiedDiff(fromName string, from string, toName string, to string, context int) string {
+	lines := diffLines(splitLines(from), splitLines(to))
+	var changes []int
+	for i, line := range lines {
+		if line.op != diffEqual {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "--- %s\n+++ %s\n", fromName, toName)
+	for first := 0; first < len(changes); {
+		// The changes separated by at most twice the context are in the same hunk.
+		last := first
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*context+1 {
+			last++
+		}
+		start := changes[first] - context
+		if start < 0 {
+			start = 0
+		}
+		end := changes[last] + context + 1
+		if end > len(lines) {
+			end = len(lines)
+		}
+		writeHunk(&builder, lines, start, end)
+		first = last + 1
+	}
+	return builder.String()
+}
+
+func writeHunk(builder *strings.Builder, lines []diffLine, start int, end int) {
+	fromStart, toStart := 1, 1
+	for _, line := range lines[:start] {
+		if line.op != diffInsert {
+			fromStart++
+		}
+		if line.op != diffDelete {
+			toStart++
+		}
+	}
+	fromCount, toCount := 0, 0
+	for _, line := range lines[start:end] {
+		if line.op != diffInsert {
+			fromCount++
+		}
+		if line.op != diffDelete {
+			toCount++
+		}
+	}
+	// An empty range starts at the line before it.
+	if fromCount == 0 {
+		fromStart--
+	}
+	if toCount == 0 {
+		toStart--
+	}
+	fmt.Fprintf(builder, "@@ -%d,%d +%d,%d @@\n", fromStart, fromCount, toStart, toCount)
+	for _, line := range lines[start:end] {
+		prefix := " "
+		switch line.op {
+		case diffDelete:
+			prefix = "-"
+		case diffInsert:
+			prefix = "+"
+		}
+		builder.WriteString(prefix + line.text + "\n")
+	}
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	from := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+	to := "1\n2\nx\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n"
+	assert.Equal(t, "--- a\n+++ b\n"+
+		"@@ -1,5 +1,5 @@\n 1\n 2\n-3\n+x\n 4\n 5\n"+
+		"@@ -11,2 +11,3 @@\n 11\n 12\n+13\n", unifiedDiff("a", from, "b", to, 2))
+
+	// The changes close to each other are in the same hunk.
+	assert.Equal(t, 1, strings.Count(unifiedDiff("a", from, "b", to, 5), "@@ -"))
+
+	assert.Equal(t, "--- a\n+++ b\n@@ -0,0 +1,1 @@\n+x\n", unifiedDiff("a", "", "b", "x\n", 3))
+	assert.Equal(t, "", unifiedDiff("a", from, "b", from, 3))
+}
+
+func TestPrintPipelineDiff(t *testing.T) {
+	from := &diffTemplate{name: "version 1", content: []byte(`{"apiVersion":"tekton.dev/v1","kind":"PipelineRun",` +
+		`"spec":{"pipelineSpec":{"params":[{"name":"message"}],"tasks":[` +
+		`{"name":"echo","taskSpec":{"steps":[{"name":"main","image":"busybox"}]}},` +
+		`{"name":"cleanup","taskSpec":{"steps":[{"name":"main","image":"busybox"}]}}]}}}`)}
+	to := &diffTemplate{name: "version 2", content: []byte(`
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+spec:
+  pipelineSpec:
+    params:
+    - name: message
+    - name: count
+    tasks:
+    - name: echo
+      taskSpec:
+        steps:
+        - name: main
+          image: alpine
+`)}
+
+	var output bytes.Buffer
+	require.Nil(t, printPipelineDiff(&output, from, to, 1, true))
+	assert.Equal(t, "Parameters added: count\nTasks removed: cleanup\nTasks changed: echo\n", output.String())
+
+	output.Reset()
+	require.Nil(t, printPipelineDiff(&output, from, to, 1, false))
+	assert.Contains(t, output.String(), "Tasks changed: echo\n\n--- version 1\n+++ version 2\n")
+	assert.Contains(t, output.String(), "-        - image: busybox\n+        - image: alpine\n")
+
+	// The same templates in JSON and YAML are equal.
+	output.Reset()
+	require.Nil(t, printPipelineDiff(&output, to, &diffTemplate{name: "version 3", content: []byte(
+		`{"kind":"PipelineRun","apiVersion":"tekton.dev/v1","spec":{"pipelineSpec":{"params":[{"name":"message"},{"name":"count"}],` +
+			`"tasks":[{"name":"echo","taskSpec":{"steps":[{"name":"main","image":"alpine"}]}}]}}}`)}, 3, false))
+	assert.Equal(t, "", output.String())
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	experimentparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_client/experiment_service"
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_model"
+	jobparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_client/job_service"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	pipelineparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_client/pipeline_service"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_model"
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/kubeflow/pipelines/backend/src/common/client/kfpclient"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/cobra"
+)
+
+func newExportCommand(options *rootOptions) *cobra.Command {
+	var namespace string
+	command := &cobra.Command{
+		Use:   "export PATH",
+		Short: "Export the experiments, pipelines and recurring jobs to a directory or a .tar.gz archive",
+		Long: "Export the experiments, the pipelines with all their versions, and the recurring jobs to a directory, " +
+			"or to a .tar.gz archive if the path ends with .tar.gz or .tgz, to be imported in another cluster. " +
+			"The runs aren't exported.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			bundle, files, err := exportBundle(command.Context(), client, namespace)
+			if err != nil {
+				return err
+			}
+			if err := writeBundleFiles(args[0], files); err != nil {
+				return err
+			}
+			versions := 0
+			for _, pipeline := range bundle.Pipelines {
+				versions += len(pipeline.Versions)
+			}
+			fmt.Fprintf(command.OutOrStdout(), "Exported %d experiments, %d pipelines (%d versions) and %d jobs to %s\n",
+				len(bundle.Experiments), len(bundle.Pipelines), versions, len(bundle.Jobs), args[0])
+			return nil
+		},
+	}
+	command.Flags().StringVar(&namespace, "namespace", "",
+		"The namespace of the experiments and jobs exported, in multi-user mode. The pipelines are all exported.")
+	return command
+}
+
+// exportBundle exports the resources of the API server, of the experiments and
+// jobs of the namespace if it is set.
+func exportBundle(ctx context.Context, client *kfpclient.Client, namespace string) (*Bundle, bundleFiles, error) {
+	bundle := &Bundle{Version: bundleVersion}
+	files := bundleFiles{}
+
+	experimentParameters := &experimentparams.ListExperimentParams{}
+	if namespace != "" {
+		experimentParameters.ResourceReferenceKeyType = util.StringPointer(string(experimentmodel.V1ResourceTypeNAMESPACE))
+		experimentParameters.ResourceReferenceKeyID = util.StringPointer(namespace)
+	}
+	experiments := api_server.NewExperimentIterator(ctx, client.Experiments(), experimentParameters)
+	for {
+		experiment, err := experiments.Next()
+		if err == api_server.Done {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		bundle.Experiments = append(bundle.Experiments, experiment)
+	}
+
+	pipelines := client.ListPipelines(ctx)
+	for {
+		pipeline, err := pipelines.Next()
+		if err == api_server.Done {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		versions, err := listPipelineVersions(ctx, client.Pipelines(), pipeline.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		bundlePipeline := &BundlePipeline{Pipeline: pipeline}
+		for _, version := range versions {
+			tmpl, err := client.Pipelines().GetPipelineVersionTemplate(ctx,
+				&pipelineparams.GetPipelineVersionTemplateParams{VersionID: version.ID})
+			if err != nil {
+				return nil, nil, err
+			}
+			exported := &BundlePipelineVersion{Version: version, Template: templatePath(pipeline.ID, version.ID)}
+			files[exported.Template] = tmpl.Bytes()
+			bundlePipeline.Versions = append(bundlePipeline.Versions, exported)
+		}
+		bundle.Pipelines = append(bundle.Pipelines, bundlePipeline)
+	}
+
+	jobParameters := &jobparams.ListJobsParams{}
+	if namespace != "" {
+		jobParameters.ResourceReferenceKeyType = util.StringPointer(string(jobmodel.V1ResourceTypeNAMESPACE))
+		jobParameters.ResourceReferenceKeyID = util.StringPointer(namespace)
+	}
+	jobs := api_server.NewJobIterator(ctx, client.Jobs(), jobParameters)
+	for {
+		job, err := jobs.Next()
+		if err == api_server.Done {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		bundle.Jobs = append(bundle.Jobs, job)
+	}
+
+	if err := encodeBundle(bundle, files); err != nil {
+		return nil, nil, err
+	}
+	return bundle, files, nil
+}
+
+// listPipelineVersions returns all the versions of a pipeline, the oldest first.
+func listPipelineVersions(ctx context.Context, client *api_server.PipelineClient, pipelineID string) (
+	[]*pipelinemodel.V1PipelineVersion, error) {
+	parameters := &pipelineparams.ListPipelineVersionsParams{
+		ResourceKeyType: util.StringPointer(string(pipelinemodel.V1ResourceTypePIPELINE)),
+		ResourceKeyID:   util.StringPointer(pipelineID),
+		SortBy:          util.StringPointer("created_at"),
+	}
+	var versions []*pipelinemodel.V1PipelineVersion
+	for {
+		page, _, nextPageToken, err := client.ListPipelineVersions(ctx, parameters)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, page...)
+		if nextPageToken == "" {
+			return versions, nil
+		}
+		parameters.PageToken = util.StringPointer(nextPageToken)
+	}
+}
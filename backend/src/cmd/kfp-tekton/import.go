@@ -0,0 +1,351 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/go-openapi/strfmt"
+	experimentparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_client/experiment_service"
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_model"
+	jobparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_client/job_service"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	pipelineparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_client/pipeline_service"
+	uploadparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/kubeflow/pipelines/backend/src/common/client/kfpclient"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/cobra"
+)
+
+func newImportCommand(options *rootOptions) *cobra.Command {
+	var namespace string
+	var disableJobs bool
+	command := &cobra.Command{
+		Use:   "import PATH",
+		Short: "Import the experiments, pipelines and recurring jobs exported to a directory or a .tar.gz archive",
+		Long: "Import a bundle written by export. The experiments and pipelines of the same name as existing ones " +
+			"aren't created again, only the missing pipeline versions are uploaded, and the jobs of the same name " +
+			"as existing ones are skipped, so that an import can be resumed. The references of the jobs are " +
+			"remapped to the IDs of the resources in the cluster.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			files, err := readBundleFiles(args[0])
+			if err != nil {
+				return err
+			}
+			bundle, err := decodeBundle(files)
+			if err != nil {
+				return err
+			}
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			i := &importer{
+				client:      client,
+				namespace:   namespace,
+				disableJobs: disableJobs,
+				out:         command.OutOrStdout(),
+				ids:         map[string]string{},
+			}
+			return i.importBundle(command.Context(), bundle, files)
+		},
+	}
+	command.Flags().StringVar(&namespace, "namespace", "",
+		"The namespace of the experiments and jobs imported, in multi-user mode, by default the namespace they were exported from.")
+	command.Flags().BoolVar(&disableJobs, "disable-jobs", false, "Import the recurring jobs disabled.")
+	return command
+}
+
+// importer imports a bundle, mapping the IDs of the exported resources to the
+// IDs of the imported ones.
+type importer struct {
+	client      *kfpclient.Client
+	namespace   string
+	disableJobs bool
+	out         io.Writer
+	ids         map[string]string
+}
+
+func (i *importer) importBundle(ctx context.Context, bundle *Bundle, files bundleFiles) error {
+	if err := i.importExperiments(ctx, bundle.Experiments); err != nil {
+		return err
+	}
+	for _, pipeline := range bundle.Pipelines {
+		if err := i.importPipeline(ctx, pipeline, files); err != nil {
+			return err
+		}
+	}
+	return i.importJobs(ctx, bundle.Jobs)
+}
+
+func (i *importer) importExperiments(ctx context.Context, experiments []*experimentmodel.V1Experiment) error {
+	parameters := &experimentparams.ListExperimentParams{}
+	if i.namespace != "" {
+		parameters.ResourceReferenceKeyType = util.StringPointer(string(experimentmodel.V1ResourceTypeNAMESPACE))
+		parameters.ResourceReferenceKeyID = util.StringPointer(i.namespace)
+	}
+	existing, err := experimentsByName(ctx, i.client.Experiments(), parameters)
+	if err != nil {
+		return err
+	}
+	for _, experiment := range experiments {
+		if found, ok := existing[experiment.Name]; ok {
+			i.ids[experiment.ID] = found.ID
+			fmt.Fprintf(i.out, "Experiment %s exists\n", experiment.Name)
+			continue
+		}
+		created, err := i.client.Experiments().Create(ctx, &experimentparams.CreateExperimentParams{
+			Body: &experimentmodel.V1Experiment{
+				Name:               experiment.Name,
+				Description:        experiment.Description,
+				ResourceReferences: i.experimentReferences(experiment),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		i.ids[experiment.ID] = created.ID
+		fmt.Fprintf(i.out, "Experiment %s created\n", experiment.Name)
+	}
+	return nil
+}
+
+// experimentReferences returns the namespace reference of an imported
+// experiment, the one it was exported from unless the namespace is overridden.
+func (i *importer) experimentReferences(experiment *experimentmodel.V1Experiment) []*experimentmodel.V1ResourceReference {
+	namespace := i.namespace
+	for _, reference := range experiment.ResourceReferences {
+		if namespace == "" && reference.Key != nil && reference.Key.Type == experimentmodel.V1ResourceTypeNAMESPACE {
+			namespace = reference.Key.ID
+		}
+	}
+	if namespace == "" {
+		return nil
+	}
+	return []*experimentmodel.V1ResourceReference{{
+		Key:          &experimentmodel.V1ResourceKey{Type: experimentmodel.V1ResourceTypeNAMESPACE, ID: namespace},
+		Relationship: experimentmodel.V1RelationshipOWNER,
+	}}
+}
+
+func (i *importer) importPipeline(ctx context.Context, pipeline *BundlePipeline, files bundleFiles) error {
+	if len(pipeline.Versions) == 0 {
+		return nil
+	}
+	pipelineID, err := i.findPipeline(ctx, pipeline.Pipeline.Name)
+	if err != nil {
+		return err
+	}
+	existingVersions := map[string]string{}
+	versions := pipeline.Versions
+	if pipelineID == "" {
+		// Uploading the pipeline uploads its first version.
+		first := versions[0]
+		uploaded, err := i.client.PipelineUploads().UploadReader(ctx, bytes.NewReader(files[first.Template]),
+			path.Base(first.Template), "", &uploadparams.UploadPipelineParams{
+				Name:        util.StringPointer(pipeline.Pipeline.Name),
+				Description: util.StringPointer(pipeline.Pipeline.Description),
+			})
+		if err != nil {
+			return err
+		}
+		created, err := i.client.Pipelines().Get(ctx, &pipelineparams.GetPipelineParams{ID: uploaded.ID})
+		if err != nil {
+			return err
+		}
+		pipelineID = created.ID
+		if created.DefaultVersion != nil {
+			i.ids[first.Version.ID] = created.DefaultVersion.ID
+		}
+		versions = versions[1:]
+		fmt.Fprintf(i.out, "Pipeline %s created\n", pipeline.Pipeline.Name)
+	} else {
+		existing, err := listPipelineVersions(ctx, i.client.Pipelines(), pipelineID)
+		if err != nil {
+			return err
+		}
+		for _, version := range existing {
+			existingVersions[version.Name] = version.ID
+		}
+		fmt.Fprintf(i.out, "Pipeline %s exists\n", pipeline.Pipeline.Name)
+	}
+	i.ids[pipeline.Pipeline.ID] = pipelineID
+
+	for _, version := range versions {
+		if id, ok := existingVersions[version.Version.Name]; ok {
+			i.ids[version.Version.ID] = id
+			continue
+		}
+		uploaded, err := i.client.PipelineUploads().UploadPipelineVersionReader(ctx,
+			bytes.NewReader(files[version.Template]), path.Base(version.Template), "",
+			&uploadparams.UploadPipelineVersionParams{
+				Name:        util.StringPointer(version.Version.Name),
+				Description: util.StringPointer(version.Version.Description),
+				Pipelineid:  util.StringPointer(pipelineID),
+			})
+		if err != nil {
+			return err
+		}
+		i.ids[version.Version.ID] = uploaded.ID
+		fmt.Fprintf(i.out, "Pipeline version %s/%s uploaded\n", pipeline.Pipeline.Name, version.Version.Name)
+	}
+
+	if pipeline.Pipeline.DefaultVersion == nil {
+		return nil
+	}
+	defaultVersionID, ok := i.ids[pipeline.Pipeline.DefaultVersion.ID]
+	if !ok {
+		return nil
+	}
+	return i.client.Pipelines().UpdateDefaultVersion(ctx, &pipelineparams.UpdatePipelineDefaultVersionParams{
+		PipelineID: pipelineID,
+		VersionID:  defaultVersionID,
+	})
+}
+
+// findPipeline returns the ID of the pipeline of the name, or an empty string
+// if there is none.
+func (i *importer) findPipeline(ctx context.Context, name string) (string, error) {
+	pipelines := i.client.ListPipelines(ctx)
+	for {
+		pipeline, err := pipelines.Next()
+		if err == api_server.Done {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if pipeline.Name == name {
+			return pipeline.ID, nil
+		}
+	}
+}
+
+func (i *importer) importJobs(ctx context.Context, jobs []*jobmodel.V1Job) error {
+	parameters := &jobparams.ListJobsParams{}
+	if i.namespace != "" {
+		parameters.ResourceReferenceKeyType = util.StringPointer(string(jobmodel.V1ResourceTypeNAMESPACE))
+		parameters.ResourceReferenceKeyID = util.StringPointer(i.namespace)
+	}
+	existing := map[string]bool{}
+	iterator := api_server.NewJobIterator(ctx, i.client.Jobs(), parameters)
+	for {
+		job, err := iterator.Next()
+		if err == api_server.Done {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		existing[job.Name] = true
+	}
+
+	for _, job := range jobs {
+		if existing[job.Name] {
+			fmt.Fprintf(i.out, "Job %s exists\n", job.Name)
+			continue
+		}
+		remapped, err := remapJob(job, i.ids, i.namespace, i.disableJobs)
+		if err != nil {
+			return err
+		}
+		if _, err := i.client.Jobs().Create(ctx, &jobparams.CreateJobParams{Body: remapped}); err != nil {
+			return err
+		}
+		fmt.Fprintf(i.out, "Job %s created\n", job.Name)
+	}
+	return nil
+}
+
+func experimentsByName(ctx context.Context, client *api_server.ExperimentClient,
+	parameters *experimentparams.ListExperimentParams) (map[string]*experimentmodel.V1Experiment, error) {
+	experiments := map[string]*experimentmodel.V1Experiment{}
+	iterator := api_server.NewExperimentIterator(ctx, client, parameters)
+	for {
+		experiment, err := iterator.Next()
+		if err == api_server.Done {
+			return experiments, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		experiments[experiment.Name] = experiment
+	}
+}
+
+// remapJob returns the job to create for an exported job, referencing the
+// imported experiment, pipeline and pipeline version of the IDs. A job
+// referencing a pipeline version is created from the version rather than from
+// its exported manifest.
+func remapJob(job *jobmodel.V1Job, ids map[string]string, namespace string, disable bool) (*jobmodel.V1Job, error) {
+	remapped := *job
+	remapped.ID = ""
+	remapped.Status = ""
+	remapped.Error = ""
+	remapped.CreatedAt = strfmt.DateTime{}
+	remapped.UpdatedAt = strfmt.DateTime{}
+	if disable {
+		remapped.Enabled = false
+	}
+
+	hasVersion := false
+	remapped.ResourceReferences = nil
+	for _, reference := range job.ResourceReferences {
+		if reference.Key == nil {
+			continue
+		}
+		key := *reference.Key
+		switch key.Type {
+		case jobmodel.V1ResourceTypeEXPERIMENT, jobmodel.V1ResourceTypePIPELINE, jobmodel.V1ResourceTypePIPELINEVERSION:
+			id, ok := ids[key.ID]
+			if !ok {
+				return nil, fmt.Errorf("The %s '%s' of the job '%s' isn't in the bundle",
+					key.Type, key.ID, job.Name)
+			}
+			key.ID = id
+			hasVersion = hasVersion || key.Type == jobmodel.V1ResourceTypePIPELINEVERSION
+		case jobmodel.V1ResourceTypeNAMESPACE:
+			if namespace != "" {
+				key.ID = namespace
+			}
+		}
+		remapped.ResourceReferences = append(remapped.ResourceReferences,
+			&jobmodel.V1ResourceReference{Key: &key, Name: reference.Name, Relationship: reference.Relationship})
+	}
+
+	if job.PipelineSpec != nil {
+		spec := *job.PipelineSpec
+		if hasVersion {
+			spec.PipelineID = ""
+			spec.PipelineName = ""
+			spec.PipelineManifest = ""
+			spec.WorkflowManifest = ""
+		} else if spec.PipelineID != "" {
+			id, ok := ids[spec.PipelineID]
+			if !ok {
+				return nil, fmt.Errorf("The pipeline '%s' of the job '%s' isn't in the bundle", spec.PipelineID, job.Name)
+			}
+			spec.PipelineID = id
+		}
+		remapped.PipelineSpec = &spec
+	}
+	return &remapped, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func reference(resourceType jobmodel.V1ResourceType, id string, relationship jobmodel.V1Relationship) *jobmodel.V1ResourceReference {
+	return &jobmodel.V1ResourceReference{
+		Key:          &jobmodel.V1ResourceKey{Type: resourceType, ID: id},
+		Relationship: relationship,
+	}
+}
+
+func TestRemapJob(t *testing.T) {
+	job := &jobmodel.V1Job{
+		ID:      "job-1",
+		Name:    "nightly",
+		Enabled: true,
+		Status:  "Enabled",
+		PipelineSpec: &jobmodel.V1PipelineSpec{
+			PipelineID:       "pipeline-1",
+			PipelineName:     "echo",
+			WorkflowManifest: "kind: PipelineRun",
+			Parameters:       []*jobmodel.V1Parameter{{Name: "message", Value: "hello"}},
+		},
+		ResourceReferences: []*jobmodel.V1ResourceReference{
+			reference(jobmodel.V1ResourceTypeEXPERIMENT, "experiment-1", jobmodel.V1RelationshipOWNER),
+			reference(jobmodel.V1ResourceTypePIPELINEVERSION, "version-1", jobmodel.V1RelationshipCREATOR),
+			reference(jobmodel.V1ResourceTypeNAMESPACE, "team-a", jobmodel.V1RelationshipOWNER),
+		},
+	}
+	ids := map[string]string{"experiment-1": "experiment-2", "pipeline-1": "pipeline-2", "version-1": "version-2"}
+
+	remapped, err := remapJob(job, ids, "team-b", true)
+	require.Nil(t, err)
+	assert.Equal(t, &jobmodel.V1Job{
+		Name: "nightly",
+		PipelineSpec: &jobmodel.V1PipelineSpec{
+			Parameters: []*jobmodel.V1Parameter{{Name: "message", Value: "hello"}},
+		},
+		ResourceReferences: []*jobmodel.V1ResourceReference{
+			reference(jobmodel.V1ResourceTypeEXPERIMENT, "experiment-2", jobmodel.V1RelationshipOWNER),
+			reference(jobmodel.V1ResourceTypePIPELINEVERSION, "version-2", jobmodel.V1RelationshipCREATOR),
+			reference(jobmodel.V1ResourceTypeNAMESPACE, "team-b", jobmodel.V1RelationshipOWNER),
+		},
+	}, remapped)
+	// The exported job is unchanged.
+	assert.Equal(t, "experiment-1", job.ResourceReferences[0].Key.ID)
+	assert.Equal(t, "pipeline-1", job.PipelineSpec.PipelineID)
+
+	// Without a pipeline version, the pipeline of the spec is remapped.
+	job.ResourceReferences = job.ResourceReferences[:1]
+	remapped, err = remapJob(job, ids, "", false)
+	require.Nil(t, err)
+	assert.True(t, remapped.Enabled)
+	assert.Equal(t, "pipeline-2", remapped.PipelineSpec.PipelineID)
+	assert.Equal(t, "kind: PipelineRun", remapped.PipelineSpec.WorkflowManifest)
+}
+
+func TestRemapJob_Unmapped(t *testing.T) {
+	job := &jobmodel.V1Job{
+		Name: "nightly",
+		ResourceReferences: []*jobmodel.V1ResourceReference{
+			reference(jobmodel.V1ResourceTypeEXPERIMENT, "experiment-1", jobmodel.V1RelationshipOWNER),
+		},
+	}
+	_, err := remapJob(job, map[string]string{}, "", false)
+	assert.Contains(t, err.Error(), "The EXPERIMENT 'experiment-1' of the job 'nightly' isn't in the bundle")
+}
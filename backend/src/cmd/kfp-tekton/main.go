@@ -0,0 +1,76 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// kfp-tekton is the command line client of the Kubeflow Pipelines API server,
+// submitting and managing the runs in the clusters of its contexts.
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/kubeflow/pipelines/backend/src/common/client/kfpclient"
+	"github.com/spf13/cobra"
+)
+
+// rootOptions are the flags of all the commands.
+type rootOptions struct {
+	configPath string
+	context    string
+	output     string
+}
+
+func (o *rootOptions) loadConfig() (*Config, error) {
+	return LoadConfig(o.configPath)
+}
+
+// newClient returns the client of the API server of the context.
+func (o *rootOptions) newClient() (*kfpclient.Client, error) {
+	config, err := o.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	context, err := config.Context(o.context)
+	if err != nil {
+		return nil, err
+	}
+	return kfpclient.New(context.ClientConfig(), context.ClientOptions()...)
+}
+
+func newRootCommand() *cobra.Command {
+	options := &rootOptions{}
+	command := &cobra.Command{
+		Use:          "kfp-tekton",
+		Short:        "Submit and manage the runs of Kubeflow Pipelines on Tekton",
+		SilenceUsage: true,
+	}
+	command.PersistentFlags().StringVar(&options.configPath, "config", defaultConfigPath(),
+		"The config file of the contexts, also set with $"+configEnvVar+".")
+	command.PersistentFlags().StringVar(&options.context, "context", "",
+		"The context of the cluster, by default the current context.")
+
+	command.AddCommand(newRunCommand(options), newConfigCommand(options), newCompileCommand(options),
+		newPipelineCommand(options), newExportCommand(options), newImportCommand(options))
+	return command
+}
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		var exitErr *exitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
+		os.Exit(exitCodeError)
+	}
+}
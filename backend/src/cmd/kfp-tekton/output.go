@@ -0,0 +1,81 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// table is the rows of an object printed in the table format.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// printOutput prints an object in the format, the table being printed for the
+// table format.
+func printOutput(w io.Writer, format string, object interface{}, t *table) error {
+	switch format {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(object)
+	case outputYAML:
+		content, err := yaml.Marshal(object)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(content)
+		return err
+	case outputTable:
+		writer := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(writer, strings.Join(t.header, "\t"))
+		for _, row := range t.rows {
+			fmt.Fprintln(writer, strings.Join(row, "\t"))
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("Invalid output format '%s', expected table, json or yaml", format)
+	}
+}
+
+// addOutputFlag adds the flag of the output format to a command printing objects.
+func addOutputFlag(command *cobra.Command, options *rootOptions) {
+	command.Flags().StringVarP(&options.output, "output", "o", outputTable, "The output format: table, json or yaml.")
+}
+
+// formatTime formats the times of the API server, which are zero when unset.
+func formatTime(dateTime strfmt.DateTime) string {
+	t := time.Time(dateTime)
+	if t.IsZero() || t.Unix() <= 0 {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
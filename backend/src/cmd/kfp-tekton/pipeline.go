@@ -0,0 +1,211 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	pipelineparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_client/pipeline_service"
+	"github.com/kubeflow/pipelines/backend/src/common/client/kfpclient"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const defaultDiffContext = 3
+
+func newPipelineCommand(options *rootOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "pipeline",
+		Short: "Inspect pipelines",
+	}
+	command.AddCommand(newPipelineDiffCommand(options))
+	return command
+}
+
+func newPipelineDiffCommand(options *rootOptions) *cobra.Command {
+	var file string
+	var contextLines int
+	var summary bool
+	command := &cobra.Command{
+		Use:   "diff [FROM_VERSION_ID] TO_VERSION_ID",
+		Short: "Compare two pipeline versions, or a pipeline file and a pipeline version",
+		Long: "Compare the templates of two pipeline versions, or of a pipeline file compiled like compile does and " +
+			"a pipeline version. The changes of the parameters and tasks are summarized, followed by the unified diff " +
+			"of the templates in YAML. Nothing is printed if the templates are the same.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(command *cobra.Command, args []string) error {
+			if (file == "") == (len(args) == 1) {
+				return errors.New("Either two pipeline versions or a pipeline file and a pipeline version are compared")
+			}
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			ctx := command.Context()
+			var from *diffTemplate
+			if file != "" {
+				manifest, _, err := compilePipeline(file, command.InOrStdin())
+				if err != nil {
+					return err
+				}
+				from = &diffTemplate{name: file, content: manifest}
+			} else if from, err = versionTemplate(ctx, client, args[0]); err != nil {
+				return err
+			}
+			to, err := versionTemplate(ctx, client, args[len(args)-1])
+			if err != nil {
+				return err
+			}
+			return printPipelineDiff(command.OutOrStdout(), from, to, contextLines, summary)
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "",
+		"The pipeline file compared to the pipeline version, as compile reads it. - reads the standard input.")
+	command.Flags().IntVarP(&contextLines, "unified", "U", defaultDiffContext, "The lines of context of the unified diff.")
+	command.Flags().BoolVar(&summary, "summary", false, "Only print the summary of the changes.")
+	return command
+}
+
+// diffTemplate is a template compared by pipeline diff, and the name it is
+// shown with.
+type diffTemplate struct {
+	name    string
+	content []byte
+}
+
+func versionTemplate(ctx context.Context, client *kfpclient.Client, versionID string) (*diffTemplate, error) {
+	tmpl, err := client.Pipelines().GetPipelineVersionTemplate(ctx,
+		&pipelineparams.GetPipelineVersionTemplateParams{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	return &diffTemplate{name: "version " + versionID, content: tmpl.Bytes()}, nil
+}
+
+func printPipelineDiff(w io.Writer, from *diffTemplate, to *diffTemplate, contextLines int, summaryOnly bool) error {
+	fromYAML, err := normalizeTemplate(from)
+	if err != nil {
+		return err
+	}
+	toYAML, err := normalizeTemplate(to)
+	if err != nil {
+		return err
+	}
+	summary, err := summarizeTemplateChanges(fromYAML, toYAML)
+	if err != nil {
+		return err
+	}
+	for _, line := range summary {
+		fmt.Fprintln(w, line)
+	}
+	if summaryOnly {
+		return nil
+	}
+	diff := unifiedDiff(from.name, string(fromYAML), to.name, string(toYAML), contextLines)
+	if len(summary) > 0 && diff != "" {
+		fmt.Fprintln(w)
+	}
+	_, err = io.WriteString(w, diff)
+	return err
+}
+
+// normalizeTemplate returns a template as YAML with sorted keys, so that the
+// templates stored as JSON and the files compiled as YAML compare line by line.
+func normalizeTemplate(template *diffTemplate) ([]byte, error) {
+	content, err := yaml.YAMLToJSON(template.content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the template of %s", template.name)
+	}
+	content, err = yaml.JSONToYAML(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the template of %s", template.name)
+	}
+	return content, nil
+}
+
+// summarizeTemplateChanges returns the parameters and tasks added, removed and
+// changed between two PipelineRuns.
+func summarizeTemplateChanges(from []byte, to []byte) ([]string, error) {
+	var fromRun, toRun workflowapi.PipelineRun
+	if err := yaml.Unmarshal(from, &fromRun); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the PipelineRun")
+	}
+	if err := yaml.Unmarshal(to, &toRun); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse the PipelineRun")
+	}
+	fromSpec, toSpec := fromRun.Spec.PipelineSpec, toRun.Spec.PipelineSpec
+	if fromSpec == nil {
+		fromSpec = &workflowapi.PipelineSpec{}
+	}
+	if toSpec == nil {
+		toSpec = &workflowapi.PipelineSpec{}
+	}
+
+	fromParams, toParams := map[string]interface{}{}, map[string]interface{}{}
+	for _, param := range fromSpec.Params {
+		fromParams[param.Name] = param
+	}
+	for _, param := range toSpec.Params {
+		toParams[param.Name] = param
+	}
+	fromTasks, toTasks := map[string]interface{}{}, map[string]interface{}{}
+	for _, task := range append(fromSpec.Tasks, fromSpec.Finally...) {
+		fromTasks[task.Name] = task
+	}
+	for _, task := range append(toSpec.Tasks, toSpec.Finally...) {
+		toTasks[task.Name] = task
+	}
+
+	var summary []string
+	summary = append(summary, summarizeChanges("Parameters", fromParams, toParams)...)
+	summary = append(summary, summarizeChanges("Tasks", fromTasks, toTasks)...)
+	return summary, nil
+}
+
+func summarizeChanges(kind string, from map[string]interface{}, to map[string]interface{}) []string {
+	var added, removed, changed []string
+	for name, value := range to {
+		if fromValue, ok := from[name]; !ok {
+			added = append(added, name)
+		} else if !reflect.DeepEqual(fromValue, value) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range from {
+		if _, ok := to[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	var summary []string
+	for _, names := range []struct {
+		change string
+		names  []string
+	}{{"added", added}, {"removed", removed}, {"changed", changed}} {
+		if len(names.names) > 0 {
+			sort.Strings(names.names)
+			summary = append(summary, fmt.Sprintf("%s %s: %s", kind, names.change,
+				strings.Join(names.names, ", ")))
+		}
+	}
+	return summary
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/kubeflow/pipelines/backend/src/common/client/kfpclient"
+	"github.com/spf13/cobra"
+)
+
+const defaultMaxRuns = 100
+
+func newRunCommand(options *rootOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "run",
+		Short: "Submit and manage runs",
+	}
+	command.AddCommand(
+		newRunSubmitCommand(options),
+		newRunListCommand(options),
+		newRunGetCommand(options),
+		newRunTerminateCommand(options),
+		newRunWatchCommand(options),
+	)
+	return command
+}
+
+func newRunSubmitCommand(options *rootOptions) *cobra.Command {
+	request := &kfpclient.RunRequest{}
+	var parameters []string
+	var wait bool
+	var timeout time.Duration
+	command := &cobra.Command{
+		Use:   "submit",
+		Short: "Submit a run of a pipeline version",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			var err error
+			if request.Parameters, err = parseParameters(parameters); err != nil {
+				return err
+			}
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			ctx := command.Context()
+			run, err := client.CreateRun(ctx, request)
+			if err != nil {
+				return err
+			}
+			if wait {
+				if timeout > 0 {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithTimeout(ctx, timeout)
+					defer cancel()
+				}
+				if run, err = client.WaitForRun(ctx, run.Run.ID, kfpclient.DefaultPollInterval); err != nil {
+					return err
+				}
+				if err := printRunDetail(command, options, run); err != nil {
+					return err
+				}
+				return runResult(run.Run)
+			}
+			return printRunDetail(command, options, run)
+		},
+	}
+	command.Flags().StringVar(&request.Name, "name", "", "The name of the run.")
+	command.Flags().StringVar(&request.Description, "description", "", "The description of the run.")
+	command.Flags().StringVar(&request.ExperimentID, "experiment-id", "", "The experiment of the run.")
+	command.Flags().StringVar(&request.PipelineVersionID, "pipeline-version-id", "", "The pipeline version to run.")
+	command.Flags().StringArrayVarP(&parameters, "param", "p", nil,
+		"A parameter of the pipeline, as name=value. Repeat it for each parameter.")
+	command.Flags().StringVar(&request.ServiceAccount, "service-account", "", "The service account of the run.")
+	command.Flags().BoolVar(&request.DisableCache, "disable-cache", false,
+		"Execute all the steps, instead of reusing the cached executions.")
+	command.Flags().BoolVar(&wait, "wait", false, "Wait for the run to finish, and exit with 2 if it didn't succeed.")
+	command.Flags().DurationVar(&timeout, "timeout", 0, "How long to wait for the run, without limit by default.")
+	command.MarkFlagRequired("name")
+	command.MarkFlagRequired("experiment-id")
+	command.MarkFlagRequired("pipeline-version-id")
+	addOutputFlag(command, options)
+	return command
+}
+
+func newRunListCommand(options *rootOptions) *cobra.Command {
+	var experimentID string
+	var maxRuns int
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List the runs, in an experiment if it is set",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			runs := []*runmodel.V1Run{}
+			it := client.ListRuns(command.Context(), experimentID)
+			for len(runs) < maxRuns {
+				run, err := it.Next()
+				if err == api_server.Done {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				runs = append(runs, run)
+			}
+			return printRuns(command, options, runs)
+		},
+	}
+	command.Flags().StringVar(&experimentID, "experiment-id", "", "The experiment of the runs.")
+	command.Flags().IntVar(&maxRuns, "max", defaultMaxRuns, "The maximum number of runs listed.")
+	addOutputFlag(command, options)
+	return command
+}
+
+func newRunGetCommand(options *rootOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "get RUN_ID",
+		Short: "Get a run",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			run, err := client.GetRun(command.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return printRunDetail(command, options, run)
+		},
+	}
+	addOutputFlag(command, options)
+	return command
+}
+
+func newRunTerminateCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "terminate RUN_ID",
+		Short: "Terminate a run",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			if err := client.TerminateRun(command.Context(), args[0]); err != nil {
+				return err
+			}
+			fmt.Fprintf(command.OutOrStdout(), "Run %s is terminating\n", args[0])
+			return nil
+		},
+	}
+}
+
+// parseParameters parses the name=value parameters of a run.
+func parseParameters(parameters []string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, parameter := range parameters {
+		parts := strings.SplitN(parameter, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("Invalid parameter '%s', expected name=value", parameter)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
+}
+
+func printRunDetail(command *cobra.Command, options *rootOptions, run *runmodel.V1RunDetail) error {
+	return printOutput(command.OutOrStdout(), options.output, run, runsTable([]*runmodel.V1Run{run.Run}))
+}
+
+func printRuns(command *cobra.Command, options *rootOptions, runs []*runmodel.V1Run) error {
+	return printOutput(command.OutOrStdout(), options.output, runs, runsTable(runs))
+}
+
+func runsTable(runs []*runmodel.V1Run) *table {
+	t := &table{header: []string{"ID", "NAME", "STATUS", "CREATED AT", "FINISHED AT"}}
+	for _, run := range runs {
+		t.rows = append(t.rows, []string{run.ID, run.Name, run.Status, formatTime(run.CreatedAt), formatTime(run.FinishedAt)})
+	}
+	return t
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseParameters(t *testing.T) {
+	parameters, err := parseParameters([]string{"epochs=10", "query=a=b", "empty="})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"epochs": "10", "query": "a=b", "empty": ""}, parameters)
+
+	_, err = parseParameters([]string{"epochs"})
+	assert.Contains(t, err.Error(), "Invalid parameter 'epochs'")
+	_, err = parseParameters([]string{"=10"})
+	assert.NotNil(t, err)
+}
+
+func TestPrintRuns(t *testing.T) {
+	runs := []*runmodel.V1Run{{
+		ID:        "run-1",
+		Name:      "training",
+		Status:    "Succeeded",
+		CreatedAt: strfmt.DateTime(time.Unix(1600000000, 0)),
+	}}
+
+	var output bytes.Buffer
+	require.Nil(t, printOutput(&output, outputTable, runs, runsTable(runs)))
+	assert.Equal(t, "ID     NAME      STATUS     CREATED AT            FINISHED AT\n"+
+		"run-1  training  Succeeded  2020-09-13T12:26:40Z  \n", output.String())
+
+	output.Reset()
+	require.Nil(t, printOutput(&output, outputJSON, runs, runsTable(runs)))
+	assert.Contains(t, output.String(), `"id": "run-1"`)
+
+	assert.NotNil(t, printOutput(&output, "xml", runs, runsTable(runs)))
+}
@@ -0,0 +1,213 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/kubeflow/pipelines/backend/src/common/client/kfpclient"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/cobra"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// The exit codes of the commands, so the CI gates can tell a run which
+	// didn't succeed from a failure of the CLI.
+	exitCodeError     = 1
+	exitCodeRunFailed = 2
+
+	taskStatusPending = "Pending"
+)
+
+// The statuses of the runs which succeeded.
+var succeededRunStatuses = map[string]bool{"Succeeded": true, "Completed": true}
+
+// exitError is an error exiting the CLI with its code.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+func newRunWatchCommand(options *rootOptions) *cobra.Command {
+	var interval time.Duration
+	var timeout time.Duration
+	var logs bool
+	command := &cobra.Command{
+		Use:   "watch RUN_ID",
+		Short: "Watch a run until it finishes, and exit with a code reflecting its status",
+		Long: "Watch a run until it finishes, printing the transitions of its status and of the status of its tasks. " +
+			"The command exits with 0 if the run succeeded, and with 2 if it didn't.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			ctx := command.Context()
+			watcher := newRunWatcher(command.OutOrStdout())
+			if logs {
+				watcher.onTaskFinished = func(task *taskProgress) {
+					printTaskLog(ctx, client, command.OutOrStdout(), command.ErrOrStderr(), args[0], task)
+				}
+			}
+			run, _, err := client.Runs().WaitForRunCompletion(ctx, args[0],
+				api_server.WithPollInterval(interval),
+				api_server.WithWaitTimeout(timeout),
+				api_server.WithProgress(watcher.update))
+			if err != nil {
+				return err
+			}
+			return runResult(run.Run)
+		},
+	}
+	command.Flags().DurationVar(&interval, "interval", kfpclient.DefaultPollInterval, "How often the run is polled.")
+	command.Flags().DurationVar(&timeout, "timeout", 0, "How long to wait for the run, without limit by default.")
+	command.Flags().BoolVar(&logs, "logs", false, "Print the log of each task once it finishes.")
+	return command
+}
+
+// runResult returns an exitError if the run didn't succeed.
+func runResult(run *runmodel.V1Run) error {
+	if succeededRunStatuses[run.Status] {
+		return nil
+	}
+	message := fmt.Sprintf("Run %s finished with status %s", run.ID, run.Status)
+	if run.Error != "" {
+		message += ": " + run.Error
+	}
+	return &exitError{code: exitCodeRunFailed, err: errors.New(message)}
+}
+
+// taskProgress is the status of a task of a run.
+type taskProgress struct {
+	TaskRunName      string
+	PipelineTaskName string
+	PodName          string
+	Status           string
+	Finished         bool
+}
+
+// runWatcher prints the transitions of the status of a run and of its tasks,
+// as the run is polled.
+type runWatcher struct {
+	out            io.Writer
+	polled         bool
+	status         string
+	tasks          map[string]string
+	onTaskFinished func(task *taskProgress)
+}
+
+func newRunWatcher(out io.Writer) *runWatcher {
+	return &runWatcher{out: out, tasks: map[string]string{}}
+}
+
+func (w *runWatcher) update(run *runmodel.V1RunDetail) {
+	if run.Run != nil && (!w.polled || run.Run.Status != w.status) {
+		w.polled = true
+		w.status = run.Run.Status
+		status := w.status
+		if status == "" {
+			status = taskStatusPending
+		}
+		fmt.Fprintf(w.out, "run %s: %s\n", run.Run.Name, status)
+	}
+	tasks, err := taskProgresses(run)
+	if err != nil {
+		fmt.Fprintf(w.out, "Failed to read the status of the tasks: %v\n", err)
+		return
+	}
+	for _, task := range tasks {
+		previous, seen := w.tasks[task.TaskRunName]
+		if seen && previous == task.Status {
+			continue
+		}
+		w.tasks[task.TaskRunName] = task.Status
+		fmt.Fprintf(w.out, "task %s: %s\n", task.PipelineTaskName, task.Status)
+		if task.Finished && w.onTaskFinished != nil {
+			w.onTaskFinished(task)
+		}
+	}
+}
+
+// taskProgresses returns the status of the tasks of a run, from the statuses of
+// its TaskRuns recorded in its workflow, sorted by name.
+func taskProgresses(run *runmodel.V1RunDetail) ([]*taskProgress, error) {
+	if run.PipelineRuntime == nil || run.PipelineRuntime.WorkflowManifest == "" {
+		return nil, nil
+	}
+	var workflow workflowapi.PipelineRun
+	if err := yaml.Unmarshal([]byte(run.PipelineRuntime.WorkflowManifest), &workflow); err != nil {
+		return nil, err
+	}
+	statusesJSON, ok := workflow.Annotations[util.AnnotationKeyTaskRunStatuses]
+	if !ok {
+		return nil, nil
+	}
+	statuses := make(map[string]*workflowapi.PipelineRunTaskRunStatus)
+	if err := json.Unmarshal([]byte(statusesJSON), &statuses); err != nil {
+		return nil, err
+	}
+	tasks := []*taskProgress{}
+	for name, status := range statuses {
+		if status == nil {
+			continue
+		}
+		task := &taskProgress{TaskRunName: name, PipelineTaskName: status.PipelineTaskName, Status: taskStatusPending}
+		if task.PipelineTaskName == "" {
+			task.PipelineTaskName = name
+		}
+		if status.Status != nil {
+			task.PodName = status.Status.PodName
+			task.Finished = status.Status.CompletionTime != nil
+			if len(status.Status.Conditions) > 0 && status.Status.Conditions[0].Reason != "" {
+				task.Status = status.Status.Conditions[0].Reason
+			}
+		}
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskRunName < tasks[j].TaskRunName })
+	return tasks, nil
+}
+
+// printTaskLog prints the log of a finished task, or why it couldn't be read.
+func printTaskLog(ctx context.Context, client *kfpclient.Client, out io.Writer, errOut io.Writer, runID string,
+	task *taskProgress) {
+	if task.PodName == "" {
+		return
+	}
+	log, err := client.GetRunLogs(ctx, runID, task.PodName)
+	if err != nil {
+		fmt.Fprintf(errOut, "Failed to read the log of task %s: %v\n", task.PipelineTaskName, err)
+		return
+	}
+	fmt.Fprintf(out, "==> %s <==\n%s", task.PipelineTaskName, log)
+	if log != "" && log[len(log)-1] != '\n' {
+		fmt.Fprintln(out)
+	}
+}
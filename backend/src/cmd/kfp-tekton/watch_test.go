@@ -0,0 +1,76 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeRunDetail(status string, taskRunStatuses string) *runmodel.V1RunDetail {
+	return &runmodel.V1RunDetail{
+		Run: &runmodel.V1Run{ID: "run-1", Name: "training", Status: status},
+		PipelineRuntime: &runmodel.V1PipelineRuntime{WorkflowManifest: `
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: training-12345
+  annotations:
+    taskrunStatuses: '` + taskRunStatuses + `'
+`},
+	}
+}
+
+func TestRunWatcher(t *testing.T) {
+	var output bytes.Buffer
+	watcher := newRunWatcher(&output)
+	var finished []string
+	watcher.onTaskFinished = func(task *taskProgress) {
+		finished = append(finished, task.PodName)
+	}
+
+	watcher.update(fakeRunDetail("", `{}`))
+	watcher.update(fakeRunDetail("Running",
+		`{"training-12345-train":{"pipelineTaskName":"train","status":{"podName":"train-pod","conditions":[{"type":"Succeeded","status":"Unknown","reason":"Running"}]}}}`))
+	watcher.update(fakeRunDetail("Running",
+		`{"training-12345-train":{"pipelineTaskName":"train","status":{"podName":"train-pod","conditions":[{"type":"Succeeded","status":"Unknown","reason":"Running"}]}}}`))
+	watcher.update(fakeRunDetail("Succeeded",
+		`{"training-12345-train":{"pipelineTaskName":"train","status":{"podName":"train-pod","completionTime":"2023-01-01T00:00:00Z","conditions":[{"type":"Succeeded","status":"True","reason":"Succeeded"}]}},`+
+			`"training-12345-eval":{"pipelineTaskName":"eval","status":{"podName":"eval-pod"}}}`))
+
+	assert.Equal(t, "run training: Pending\n"+
+		"run training: Running\n"+
+		"task train: Running\n"+
+		"run training: Succeeded\n"+
+		"task eval: Pending\n"+
+		"task train: Succeeded\n", output.String())
+	assert.Equal(t, []string{"train-pod"}, finished)
+}
+
+func TestRunResult(t *testing.T) {
+	assert.Nil(t, runResult(&runmodel.V1Run{ID: "run-1", Status: "Succeeded"}))
+	assert.Nil(t, runResult(&runmodel.V1Run{ID: "run-1", Status: "Completed"}))
+
+	err := runResult(&runmodel.V1Run{ID: "run-1", Status: "Failed", Error: "step train failed"})
+	var exitErr *exitError
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, exitCodeRunFailed, exitErr.code)
+	assert.Equal(t, "Run run-1 finished with status Failed: step train failed", err.Error())
+}
@@ -0,0 +1,254 @@
+package api_server
+
+import (
+	"fmt"
+	"strconv"
+
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_model"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	pipelinemodelv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/pipeline_model"
+	recurringrunmodel "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/recurring_run_model"
+	runmodelv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+	"sigs.k8s.io/yaml"
+)
+
+// The helpers below convert the v1 models to the v2beta1 ones, so the automation
+// building v1 runs, jobs and pipelines can send them to the v2beta1 API. The
+// runs and jobs of v1 pipelines, whose spec is a workflow manifest, can only be
+// converted when they reference their pipeline, since the v2beta1 API only takes
+// the pipeline specs of the KFP v2 SDK.
+
+// ToV2beta1Run converts a v1 run. Its status isn't converted, since the v1
+// statuses are those of the underlying pipeline runs; the v2beta1 API sets the
+// state of the runs it returns.
+func ToV2beta1Run(run *runmodel.V1Run) (*runmodelv2beta1.V2beta1Run, error) {
+	references := make(map[runmodel.V1ResourceType]string)
+	for _, reference := range run.ResourceReferences {
+		if reference.Key != nil {
+			references[reference.Key.Type] = reference.Key.ID
+		}
+	}
+	apiRun := &runmodelv2beta1.V2beta1Run{
+		RunID:          run.ID,
+		DisplayName:    run.Name,
+		Description:    run.Description,
+		ExperimentID:   references[runmodel.V1ResourceTypeEXPERIMENT],
+		RecurringRunID: references[runmodel.V1ResourceTypeJOB],
+		ServiceAccount: run.ServiceAccount,
+		CreatedAt:      run.CreatedAt,
+		ScheduledAt:    run.ScheduledAt,
+		FinishedAt:     run.FinishedAt,
+	}
+	switch run.StorageState {
+	case runmodel.V1RunStorageStateSTORAGESTATEAVAILABLE:
+		apiRun.StorageState = runmodelv2beta1.RunStorageStateAVAILABLE
+	case runmodel.V1RunStorageStateSTORAGESTATEARCHIVED:
+		apiRun.StorageState = runmodelv2beta1.RunStorageStateARCHIVED
+	}
+	if run.Error != "" {
+		apiRun.Error = &runmodelv2beta1.GooglerpcStatus{Code: int32(codes.Unknown), Message: run.Error}
+	}
+
+	spec := run.PipelineSpec
+	if spec == nil {
+		spec = &runmodel.V1PipelineSpec{}
+	}
+	versionID := references[runmodel.V1ResourceTypePIPELINEVERSION]
+	switch {
+	case spec.PipelineID != "" || versionID != "":
+		apiRun.PipelineVersionReference = &runmodelv2beta1.V2beta1PipelineVersionReference{
+			PipelineID:        spec.PipelineID,
+			PipelineVersionID: versionID,
+		}
+	case spec.PipelineManifest != "":
+		pipelineSpec, err := toV2beta1PipelineSpec(spec.PipelineManifest)
+		if err != nil {
+			return nil, err
+		}
+		apiRun.PipelineSpec = pipelineSpec
+	case spec.WorkflowManifest != "":
+		return nil, util.NewInvalidInputError("Run %v of a v1 pipeline can't be converted without a pipeline reference.", run.Name)
+	}
+
+	parameters := make(map[string]interface{})
+	for _, parameter := range spec.Parameters {
+		parameters[parameter.Name] = parameter.Value
+	}
+	pipelineRoot := ""
+	if spec.RuntimeConfig != nil {
+		for name, value := range spec.RuntimeConfig.Parameters {
+			parameter, err := toV2beta1Parameter(name, value.IntValue, value.DoubleValue, value.StringValue)
+			if err != nil {
+				return nil, err
+			}
+			parameters[name] = parameter
+		}
+		pipelineRoot = spec.RuntimeConfig.PipelineRoot
+	}
+	if len(parameters) > 0 || pipelineRoot != "" {
+		apiRun.RuntimeConfig = &runmodelv2beta1.V2beta1RuntimeConfig{Parameters: parameters, PipelineRoot: pipelineRoot}
+	}
+	return apiRun, nil
+}
+
+// ToV2beta1RecurringRun converts a v1 job. The recurring run is enabled if the
+// job is.
+func ToV2beta1RecurringRun(job *jobmodel.V1Job) (*recurringrunmodel.V2beta1RecurringRun, error) {
+	references := make(map[jobmodel.V1ResourceType]string)
+	for _, reference := range job.ResourceReferences {
+		if reference.Key != nil {
+			references[reference.Key.Type] = reference.Key.ID
+		}
+	}
+	recurringRun := &recurringrunmodel.V2beta1RecurringRun{
+		RecurringRunID: job.ID,
+		DisplayName:    job.Name,
+		Description:    job.Description,
+		ExperimentID:   references[jobmodel.V1ResourceTypeEXPERIMENT],
+		Namespace:      references[jobmodel.V1ResourceTypeNAMESPACE],
+		ServiceAccount: job.ServiceAccount,
+		MaxConcurrency: job.MaxConcurrency,
+		NoCatchup:      job.NoCatchup,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+		Mode:           recurringrunmodel.RecurringRunModeDISABLE,
+		Status:         recurringrunmodel.V2beta1RecurringRunStatusDISABLED,
+	}
+	if job.Enabled {
+		recurringRun.Mode = recurringrunmodel.RecurringRunModeENABLE
+		recurringRun.Status = recurringrunmodel.V2beta1RecurringRunStatusENABLED
+	}
+	if job.Error != "" {
+		recurringRun.Error = &recurringrunmodel.GooglerpcStatus{Code: int32(codes.Unknown), Message: job.Error}
+	}
+	if job.Trigger != nil {
+		recurringRun.Trigger = &recurringrunmodel.V2beta1Trigger{}
+		if schedule := job.Trigger.CronSchedule; schedule != nil {
+			recurringRun.Trigger.CronSchedule = &recurringrunmodel.V2beta1CronSchedule{
+				Cron:      schedule.Cron,
+				StartTime: schedule.StartTime,
+				EndTime:   schedule.EndTime,
+			}
+		}
+		if schedule := job.Trigger.PeriodicSchedule; schedule != nil {
+			recurringRun.Trigger.PeriodicSchedule = &recurringrunmodel.V2beta1PeriodicSchedule{
+				IntervalSecond: schedule.IntervalSecond,
+				StartTime:      schedule.StartTime,
+				EndTime:        schedule.EndTime,
+			}
+		}
+	}
+
+	spec := job.PipelineSpec
+	if spec == nil {
+		spec = &jobmodel.V1PipelineSpec{}
+	}
+	versionID := references[jobmodel.V1ResourceTypePIPELINEVERSION]
+	switch {
+	case spec.PipelineID != "" || versionID != "":
+		recurringRun.PipelineVersionReference = &recurringrunmodel.V2beta1PipelineVersionReference{
+			PipelineID:        spec.PipelineID,
+			PipelineVersionID: versionID,
+		}
+	case spec.PipelineManifest != "":
+		pipelineSpec, err := toV2beta1PipelineSpec(spec.PipelineManifest)
+		if err != nil {
+			return nil, err
+		}
+		recurringRun.PipelineSpec = pipelineSpec
+	case spec.WorkflowManifest != "":
+		return nil, util.NewInvalidInputError("Job %v of a v1 pipeline can't be converted without a pipeline reference.", job.Name)
+	}
+
+	parameters := make(map[string]interface{})
+	for _, parameter := range spec.Parameters {
+		parameters[parameter.Name] = parameter.Value
+	}
+	pipelineRoot := ""
+	if spec.RuntimeConfig != nil {
+		for name, value := range spec.RuntimeConfig.Parameters {
+			parameter, err := toV2beta1Parameter(name, value.IntValue, value.DoubleValue, value.StringValue)
+			if err != nil {
+				return nil, err
+			}
+			parameters[name] = parameter
+		}
+		pipelineRoot = spec.RuntimeConfig.PipelineRoot
+	}
+	if len(parameters) > 0 || pipelineRoot != "" {
+		recurringRun.RuntimeConfig = &recurringrunmodel.V2beta1RuntimeConfig{Parameters: parameters, PipelineRoot: pipelineRoot}
+	}
+	return recurringRun, nil
+}
+
+// ToV2beta1Pipeline converts a v1 pipeline. Its default version isn't converted,
+// the versions are got separately in the v2beta1 API.
+func ToV2beta1Pipeline(pipeline *pipelinemodel.V1Pipeline) *pipelinemodelv2beta1.V2beta1Pipeline {
+	apiPipeline := &pipelinemodelv2beta1.V2beta1Pipeline{
+		PipelineID:  pipeline.ID,
+		DisplayName: pipeline.Name,
+		Description: pipeline.Description,
+		CreatedAt:   pipeline.CreatedAt,
+	}
+	for _, reference := range pipeline.ResourceReferences {
+		if reference.Key != nil && reference.Key.Type == pipelinemodel.V1ResourceTypeNAMESPACE {
+			apiPipeline.Namespace = reference.Key.ID
+		}
+	}
+	if pipeline.Error != "" {
+		apiPipeline.Error = &pipelinemodelv2beta1.GooglerpcStatus{Code: int32(codes.Unknown), Message: pipeline.Error}
+	}
+	return apiPipeline
+}
+
+// ToV2beta1PipelineVersion converts a v1 pipeline version, which belongs to the
+// pipeline it references.
+func ToV2beta1PipelineVersion(version *pipelinemodel.V1PipelineVersion) *pipelinemodelv2beta1.V2beta1PipelineVersion {
+	apiVersion := &pipelinemodelv2beta1.V2beta1PipelineVersion{
+		PipelineVersionID: version.ID,
+		DisplayName:       version.Name,
+		Description:       version.Description,
+		CodeSourceURL:     version.CodeSourceURL,
+		CreatedAt:         version.CreatedAt,
+	}
+	for _, reference := range version.ResourceReferences {
+		if reference.Key != nil && reference.Key.Type == pipelinemodel.V1ResourceTypePIPELINE {
+			apiVersion.PipelineID = reference.Key.ID
+		}
+	}
+	if version.PackageURL != nil {
+		apiVersion.PackageURL = &pipelinemodelv2beta1.V2beta1URL{PipelineURL: version.PackageURL.PipelineURL}
+	}
+	return apiVersion
+}
+
+// toV2beta1PipelineSpec parses a pipeline spec of the KFP v2 SDK, in YAML or JSON.
+func toV2beta1PipelineSpec(manifest string) (interface{}, error) {
+	var pipelineSpec map[string]interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &pipelineSpec); err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the pipeline manifest.")
+	}
+	return pipelineSpec, nil
+}
+
+// toV2beta1Parameter converts the value of a v1 runtime parameter. The v1 values
+// omit their zero fields, so a zero double can't be told from an empty string and
+// is converted to the latter.
+func toV2beta1Parameter(name string, intValue string, doubleValue float64, stringValue string) (interface{}, error) {
+	switch {
+	case intValue != "":
+		value, err := strconv.ParseInt(intValue, 10, 64)
+		if err != nil {
+			return nil, util.NewInvalidInputErrorWithDetails(err,
+				fmt.Sprintf("Parameter %v has an invalid integer value %v.", name, intValue))
+		}
+		return value, nil
+	case doubleValue != 0:
+		return doubleValue, nil
+	default:
+		return stringValue, nil
+	}
+}
@@ -0,0 +1,162 @@
+package api_server
+
+import (
+	"testing"
+
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_model"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	pipelinemodelv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/pipeline_model"
+	recurringrunmodel "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/recurring_run_model"
+	runmodelv2beta1 "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestToV2beta1Run(t *testing.T) {
+	run, err := ToV2beta1Run(&runmodel.V1Run{
+		ID:           "run1",
+		Name:         "run",
+		StorageState: runmodel.V1RunStorageStateSTORAGESTATEARCHIVED,
+		Error:        "failed",
+		PipelineSpec: &runmodel.V1PipelineSpec{
+			PipelineManifest: "pipelineInfo:\n  name: hello-world\n",
+			RuntimeConfig: &runmodel.PipelineSpecRuntimeConfig{
+				Parameters: map[string]runmodel.V1Value{
+					"text":  {StringValue: "hello"},
+					"count": {IntValue: "3"},
+					"ratio": {DoubleValue: 0.5},
+				},
+				PipelineRoot: "minio://bucket/root",
+			},
+		},
+		ResourceReferences: []*runmodel.V1ResourceReference{
+			{
+				Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypeEXPERIMENT, ID: "exp1"},
+				Relationship: runmodel.V1RelationshipOWNER,
+			},
+			{
+				Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypeJOB, ID: "job1"},
+				Relationship: runmodel.V1RelationshipCREATOR,
+			},
+		},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, &runmodelv2beta1.V2beta1Run{
+		RunID:          "run1",
+		DisplayName:    "run",
+		ExperimentID:   "exp1",
+		RecurringRunID: "job1",
+		StorageState:   runmodelv2beta1.RunStorageStateARCHIVED,
+		Error:          &runmodelv2beta1.GooglerpcStatus{Code: int32(codes.Unknown), Message: "failed"},
+		PipelineSpec:   map[string]interface{}{"pipelineInfo": map[string]interface{}{"name": "hello-world"}},
+		RuntimeConfig: &runmodelv2beta1.V2beta1RuntimeConfig{
+			Parameters:   map[string]interface{}{"text": "hello", "count": int64(3), "ratio": 0.5},
+			PipelineRoot: "minio://bucket/root",
+		},
+	}, run)
+}
+
+func TestToV2beta1Run_PipelineReference(t *testing.T) {
+	run, err := ToV2beta1Run(&runmodel.V1Run{
+		Name: "run",
+		PipelineSpec: &runmodel.V1PipelineSpec{
+			PipelineID: "pipeline1",
+			Parameters: []*runmodel.V1Parameter{{Name: "text", Value: "hello"}},
+		},
+		ResourceReferences: []*runmodel.V1ResourceReference{{
+			Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypePIPELINEVERSION, ID: "version1"},
+			Relationship: runmodel.V1RelationshipCREATOR,
+		}},
+	})
+	require.Nil(t, err)
+	assert.Nil(t, run.PipelineSpec)
+	assert.Equal(t, &runmodelv2beta1.V2beta1PipelineVersionReference{PipelineID: "pipeline1", PipelineVersionID: "version1"},
+		run.PipelineVersionReference)
+	assert.Equal(t, map[string]interface{}{"text": "hello"}, run.RuntimeConfig.Parameters)
+}
+
+func TestToV2beta1Run_Errors(t *testing.T) {
+	// The workflows of the v1 pipelines can't be sent to the v2beta1 API.
+	_, err := ToV2beta1Run(&runmodel.V1Run{PipelineSpec: &runmodel.V1PipelineSpec{WorkflowManifest: "{}"}})
+	assert.NotNil(t, err)
+
+	_, err = ToV2beta1Run(&runmodel.V1Run{PipelineSpec: &runmodel.V1PipelineSpec{
+		PipelineManifest: "{}",
+		RuntimeConfig: &runmodel.PipelineSpecRuntimeConfig{
+			Parameters: map[string]runmodel.V1Value{"count": {IntValue: "three"}},
+		},
+	}})
+	assert.Contains(t, err.Error(), "count")
+}
+
+func TestToV2beta1RecurringRun(t *testing.T) {
+	recurringRun, err := ToV2beta1RecurringRun(&jobmodel.V1Job{
+		ID:             "job1",
+		Name:           "job",
+		Enabled:        true,
+		MaxConcurrency: 2,
+		NoCatchup:      true,
+		Trigger: &jobmodel.V1Trigger{PeriodicSchedule: &jobmodel.V1PeriodicSchedule{
+			IntervalSecond: 60,
+		}},
+		PipelineSpec: &jobmodel.V1PipelineSpec{PipelineID: "pipeline1"},
+		ResourceReferences: []*jobmodel.V1ResourceReference{{
+			Key:          &jobmodel.V1ResourceKey{Type: jobmodel.V1ResourceTypeNAMESPACE, ID: "ns1"},
+			Relationship: jobmodel.V1RelationshipOWNER,
+		}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, &recurringrunmodel.V2beta1RecurringRun{
+		RecurringRunID: "job1",
+		DisplayName:    "job",
+		Namespace:      "ns1",
+		MaxConcurrency: 2,
+		NoCatchup:      true,
+		Mode:           recurringrunmodel.RecurringRunModeENABLE,
+		Status:         recurringrunmodel.V2beta1RecurringRunStatusENABLED,
+		Trigger: &recurringrunmodel.V2beta1Trigger{PeriodicSchedule: &recurringrunmodel.V2beta1PeriodicSchedule{
+			IntervalSecond: 60,
+		}},
+		PipelineVersionReference: &recurringrunmodel.V2beta1PipelineVersionReference{PipelineID: "pipeline1"},
+	}, recurringRun)
+
+	recurringRun, err = ToV2beta1RecurringRun(&jobmodel.V1Job{Name: "job"})
+	require.Nil(t, err)
+	assert.Equal(t, recurringrunmodel.RecurringRunModeDISABLE, recurringRun.Mode)
+}
+
+func TestToV2beta1Pipeline(t *testing.T) {
+	pipeline := ToV2beta1Pipeline(&pipelinemodel.V1Pipeline{
+		ID:          "pipeline1",
+		Name:        "pipeline",
+		Description: "says hello",
+		ResourceReferences: []*pipelinemodel.V1ResourceReference{{
+			Key:          &pipelinemodel.V1ResourceKey{Type: pipelinemodel.V1ResourceTypeNAMESPACE, ID: "ns1"},
+			Relationship: pipelinemodel.V1RelationshipOWNER,
+		}},
+	})
+	assert.Equal(t, &pipelinemodelv2beta1.V2beta1Pipeline{
+		PipelineID:  "pipeline1",
+		DisplayName: "pipeline",
+		Description: "says hello",
+		Namespace:   "ns1",
+	}, pipeline)
+
+	version := ToV2beta1PipelineVersion(&pipelinemodel.V1PipelineVersion{
+		ID:         "version1",
+		Name:       "version",
+		PackageURL: &pipelinemodel.V1URL{PipelineURL: "https://example.com/pipeline.yaml"},
+		ResourceReferences: []*pipelinemodel.V1ResourceReference{{
+			Key:          &pipelinemodel.V1ResourceKey{Type: pipelinemodel.V1ResourceTypePIPELINE, ID: "pipeline1"},
+			Relationship: pipelinemodel.V1RelationshipOWNER,
+		}},
+	})
+	assert.Equal(t, &pipelinemodelv2beta1.V2beta1PipelineVersion{
+		PipelineID:        "pipeline1",
+		PipelineVersionID: "version1",
+		DisplayName:       "version",
+		PackageURL:        &pipelinemodelv2beta1.V2beta1URL{PipelineURL: "https://example.com/pipeline.yaml"},
+	}, version)
+}
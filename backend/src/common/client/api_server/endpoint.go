@@ -0,0 +1,89 @@
+package api_server
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// The API server is reached through the proxy of the Kubernetes API server
+	// to its service, unless the clients connect to it directly.
+	apiServerBasePath       = "/api/v1/namespaces/%s/services/ml-pipeline:8888/proxy/"
+	inClusterEndpointFormat = "http://ml-pipeline.%s.svc:8888"
+	// The Kubernetes API server sets the variable in all the pods.
+	kubernetesServiceHostEnvVar = "KUBERNETES_SERVICE_HOST"
+)
+
+// WithEndpoint connects a client to the API server at the endpoint directly,
+// e.g. InClusterEndpoint(namespace), instead of through the proxy of the
+// Kubernetes API server of the client config, which is then ignored. The
+// requests are only authenticated by the token provider of the client.
+func WithEndpoint(endpoint string) ClientOption {
+	return func(o *clientOptions) {
+		o.endpoint = endpoint
+	}
+}
+
+// InClusterEndpoint returns the endpoint of the service of the API server
+// deployed in the namespace, reachable from the pods of the cluster.
+func InClusterEndpoint(namespace string) string {
+	return fmt.Sprintf(inClusterEndpointFormat, namespace)
+}
+
+// InCluster returns whether the process runs in a pod with the token of its
+// service account mounted, so it can connect to the API server directly.
+func InCluster() bool {
+	if os.Getenv(kubernetesServiceHostEnvVar) == "" {
+		return false
+	}
+	_, err := os.Stat(DefaultServiceAccountTokenPath)
+	return err == nil
+}
+
+// apiEndpoint is where the requests to the API server are sent.
+type apiEndpoint struct {
+	// baseURL is the URL the paths of the requests are relative to.
+	baseURL string
+	// host and basePath split the base URL for the generated clients, which
+	// pick the scheme of the request among the schemes if they are set.
+	host     string
+	basePath string
+	schemes  []string
+}
+
+// newEndpoint returns the endpoint of the API server and the config of the
+// connections to it, proxied by the Kubernetes API server of the client config
+// unless the options set the endpoint.
+func newEndpoint(clientConfig clientcmd.ClientConfig, options *clientOptions) (*apiEndpoint, *rest.Config, error) {
+	if options.endpoint == "" {
+		_, config, namespace, err := util.GetKubernetesClientFromClientConfig(clientConfig)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "Error while creating K8 client")
+		}
+		basePath := fmt.Sprintf(apiServerBasePath, namespace)
+		return &apiEndpoint{
+			baseURL:  strings.TrimSuffix(config.Host, "/") + basePath,
+			host:     util.ExtractMasterIPAndPort(config),
+			basePath: basePath,
+		}, rest.CopyConfig(config), nil
+	}
+
+	endpointURL, err := url.Parse(options.endpoint)
+	if err != nil || (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+		return nil, nil, fmt.Errorf("Invalid endpoint '%s', expected an http or https URL", options.endpoint)
+	}
+	basePath := strings.TrimSuffix(endpointURL.Path, "/") + "/"
+	return &apiEndpoint{
+		baseURL:  endpointURL.Scheme + "://" + endpointURL.Host + basePath,
+		host:     endpointURL.Host,
+		basePath: basePath,
+		schemes:  []string{endpointURL.Scheme},
+	}, &rest.Config{Host: endpointURL.Scheme + "://" + endpointURL.Host}, nil
+}
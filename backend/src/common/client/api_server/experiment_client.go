@@ -3,6 +3,7 @@ package api_server
 import (
 	"fmt"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	apiclient "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_client"
 	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_client/experiment_service"
@@ -14,43 +15,41 @@ import (
 )
 
 type ExperimentInterface interface {
-	Create(params *params.CreateExperimentParams) (*model.V1Experiment, error)
-	Get(params *params.GetExperimentParams) (*model.V1Experiment, error)
-	List(params *params.ListExperimentParams) ([]*model.V1Experiment, int, string, error)
-	ListAll(params *params.ListExperimentParams, maxResultSize int) ([]*model.V1Experiment, error)
-	Archive(params *params.ArchiveExperimentParams) error
-	Unarchive(params *params.UnarchiveExperimentParams) error
+	Create(ctx context.Context, params *params.CreateExperimentParams) (*model.V1Experiment, error)
+	Get(ctx context.Context, params *params.GetExperimentParams) (*model.V1Experiment, error)
+	List(ctx context.Context, params *params.ListExperimentParams) ([]*model.V1Experiment, int, string, error)
+	ListAll(ctx context.Context, params *params.ListExperimentParams, maxResultSize int) ([]*model.V1Experiment, error)
+	Archive(ctx context.Context, params *params.ArchiveExperimentParams) error
+	Unarchive(ctx context.Context, params *params.UnarchiveExperimentParams) error
 }
 
 type ExperimentClient struct {
 	apiClient *apiclient.Experiment
+	authInfo  runtime.ClientAuthInfoWriter
 }
 
-func NewExperimentClient(clientConfig clientcmd.ClientConfig, debug bool) (
+func NewExperimentClient(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
 	*ExperimentClient, error) {
 
-	runtime, err := NewHTTPRuntime(clientConfig, debug)
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	apiClient := apiclient.New(runtime, strfmt.Default)
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
 
 	// Creating upload client
 	return &ExperimentClient{
 		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
 	}, nil
 }
 
-func (c *ExperimentClient) Create(parameters *params.CreateExperimentParams) (*model.V1Experiment,
+func (c *ExperimentClient) Create(ctx context.Context, parameters *params.CreateExperimentParams) (*model.V1Experiment,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.ExperimentService.CreateExperiment(parameters, PassThroughAuth)
+	response, err := c.apiClient.ExperimentService.CreateExperiment(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.CreateExperimentDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -66,15 +65,11 @@ func (c *ExperimentClient) Create(parameters *params.CreateExperimentParams) (*m
 	return response.Payload, nil
 }
 
-func (c *ExperimentClient) Get(parameters *params.GetExperimentParams) (*model.V1Experiment,
+func (c *ExperimentClient) Get(ctx context.Context, parameters *params.GetExperimentParams) (*model.V1Experiment,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.ExperimentService.GetExperiment(parameters, PassThroughAuth)
+	response, err := c.apiClient.ExperimentService.GetExperiment(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetExperimentDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -90,15 +85,11 @@ func (c *ExperimentClient) Get(parameters *params.GetExperimentParams) (*model.V
 	return response.Payload, nil
 }
 
-func (c *ExperimentClient) List(parameters *params.ListExperimentParams) (
+func (c *ExperimentClient) List(ctx context.Context, parameters *params.ListExperimentParams) (
 	[]*model.V1Experiment, int, string, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.ExperimentService.ListExperiment(parameters, PassThroughAuth)
+	response, err := c.apiClient.ExperimentService.ListExperiment(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.ListExperimentDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -114,14 +105,10 @@ func (c *ExperimentClient) List(parameters *params.ListExperimentParams) (
 	return response.Payload.Experiments, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
 }
 
-func (c *ExperimentClient) Delete(parameters *params.DeleteExperimentParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *ExperimentClient) Delete(ctx context.Context, parameters *params.DeleteExperimentParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.ExperimentService.DeleteExperiment(parameters, PassThroughAuth)
+	_, err := c.apiClient.ExperimentService.DeleteExperiment(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.DeleteExperimentDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -137,44 +124,71 @@ func (c *ExperimentClient) Delete(parameters *params.DeleteExperimentParams) err
 	return nil
 }
 
-func (c *ExperimentClient) ListAll(parameters *params.ListExperimentParams, maxResultSize int) (
+func (c *ExperimentClient) ListAll(ctx context.Context, parameters *params.ListExperimentParams, maxResultSize int) (
 	[]*model.V1Experiment, error) {
-	return listAllForExperiment(c, parameters, maxResultSize)
+	return listAllForExperiment(ctx, c, parameters, maxResultSize)
 }
 
-func listAllForExperiment(client ExperimentInterface, parameters *params.ListExperimentParams,
+func listAllForExperiment(ctx context.Context, client ExperimentInterface, parameters *params.ListExperimentParams,
 	maxResultSize int) ([]*model.V1Experiment, error) {
 	if maxResultSize < 0 {
 		maxResultSize = 0
 	}
 
 	allResults := make([]*model.V1Experiment, 0)
-	firstCall := true
-	for (firstCall || (parameters.PageToken != nil && *parameters.PageToken != "")) &&
-		(len(allResults) < maxResultSize) {
-		results, _, pageToken, err := client.List(parameters)
+	it := NewExperimentIterator(ctx, client, parameters)
+	for len(allResults) < maxResultSize {
+		result, err := it.Next()
+		if err == Done {
+			break
+		}
 		if err != nil {
 			return nil, err
 		}
-		allResults = append(allResults, results...)
-		parameters.PageToken = util.StringPointer(pageToken)
-		firstCall = false
-	}
-	if len(allResults) > maxResultSize {
-		allResults = allResults[0:maxResultSize]
+		allResults = append(allResults, result)
 	}
 
 	return allResults, nil
 }
 
-func (c *ExperimentClient) Archive(parameters *params.ArchiveExperimentParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
+// ExperimentIterator walks the experiments of a list request, fetching the pages lazily.
+type ExperimentIterator struct {
+	pageIterator
+	page []*model.V1Experiment
+}
 
+// NewExperimentIterator creates an iterator over the experiments matching the parameters,
+// starting from their page token.
+func NewExperimentIterator(ctx context.Context, client ExperimentInterface, parameters *params.ListExperimentParams) *ExperimentIterator {
+	it := &ExperimentIterator{}
+	if parameters.PageToken != nil {
+		it.pageToken = *parameters.PageToken
+	}
+	it.fetch = func(pageToken string) (int, string, error) {
+		parameters.PageToken = util.StringPointer(pageToken)
+		results, _, nextPageToken, err := client.List(ctx, parameters)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = results
+		return len(results), nextPageToken, nil
+	}
+	return it
+}
+
+// Next returns the next experiment, or Done once all were walked.
+func (it *ExperimentIterator) Next() (*model.V1Experiment, error) {
+	index, err := it.next()
+	if err != nil {
+		return nil, err
+	}
+	return it.page[index], nil
+}
+
+func (c *ExperimentClient) Archive(ctx context.Context, parameters *params.ArchiveExperimentParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.ExperimentService.ArchiveExperiment(parameters, PassThroughAuth)
+	_, err := c.apiClient.ExperimentService.ArchiveExperiment(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.ArchiveExperimentDefault); ok {
@@ -191,14 +205,10 @@ func (c *ExperimentClient) Archive(parameters *params.ArchiveExperimentParams) e
 	return nil
 }
 
-func (c *ExperimentClient) Unarchive(parameters *params.UnarchiveExperimentParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *ExperimentClient) Unarchive(ctx context.Context, parameters *params.UnarchiveExperimentParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.ExperimentService.UnarchiveExperiment(parameters, PassThroughAuth)
+	_, err := c.apiClient.ExperimentService.UnarchiveExperiment(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.UnarchiveExperimentDefault); ok {
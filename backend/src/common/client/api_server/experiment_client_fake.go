@@ -1,6 +1,7 @@
 package api_server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -28,7 +29,7 @@ func NewExperimentClientFake() *ExperimentClientFake {
 	return &ExperimentClientFake{}
 }
 
-func (c *ExperimentClientFake) Create(params *experimentparams.CreateExperimentParams) (
+func (c *ExperimentClientFake) Create(ctx context.Context, params *experimentparams.CreateExperimentParams) (
 	*experimentmodel.V1Experiment, error) {
 	switch params.Body.Name {
 	case ExperimentForClientErrorTest:
@@ -38,7 +39,7 @@ func (c *ExperimentClientFake) Create(params *experimentparams.CreateExperimentP
 	}
 }
 
-func (c *ExperimentClientFake) Get(params *experimentparams.GetExperimentParams) (
+func (c *ExperimentClientFake) Get(ctx context.Context, params *experimentparams.GetExperimentParams) (
 	*experimentmodel.V1Experiment, error) {
 	switch params.ID {
 	case ExperimentForClientErrorTest:
@@ -48,7 +49,7 @@ func (c *ExperimentClientFake) Get(params *experimentparams.GetExperimentParams)
 	}
 }
 
-func (c *ExperimentClientFake) List(params *experimentparams.ListExperimentParams) (
+func (c *ExperimentClientFake) List(ctx context.Context, params *experimentparams.ListExperimentParams) (
 	[]*experimentmodel.V1Experiment, int, string, error) {
 	const (
 		FirstToken  = ""
@@ -76,15 +77,15 @@ func (c *ExperimentClientFake) List(params *experimentparams.ListExperimentParam
 	}
 }
 
-func (c *ExperimentClientFake) ListAll(params *experimentparams.ListExperimentParams,
+func (c *ExperimentClientFake) ListAll(ctx context.Context, params *experimentparams.ListExperimentParams,
 	maxResultSize int) ([]*experimentmodel.V1Experiment, error) {
-	return listAllForExperiment(c, params, maxResultSize)
+	return listAllForExperiment(ctx, c, params, maxResultSize)
 }
 
-func (c *ExperimentClientFake) Archive(params *experimentparams.ArchiveExperimentParams) error {
+func (c *ExperimentClientFake) Archive(ctx context.Context, params *experimentparams.ArchiveExperimentParams) error {
 	return nil
 }
 
-func (c *ExperimentClientFake) Unarchive(params *experimentparams.UnarchiveExperimentParams) error {
+func (c *ExperimentClientFake) Unarchive(ctx context.Context, params *experimentparams.UnarchiveExperimentParams) error {
 	return nil
 }
@@ -0,0 +1,132 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	experimentparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_client/experiment_service"
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_model"
+	"google.golang.org/grpc/codes"
+)
+
+// InMemoryExperimentClientFake is an ExperimentInterface storing the experiments
+// in memory. Like the API server, it rejects the experiments whose names are taken.
+type InMemoryExperimentClientFake struct {
+	FakeBehaviors
+	mutex       sync.Mutex
+	experiments []*experimentmodel.V1Experiment
+	nextID      int
+}
+
+func NewInMemoryExperimentClientFake() *InMemoryExperimentClientFake {
+	return &InMemoryExperimentClientFake{}
+}
+
+func (c *InMemoryExperimentClientFake) Create(ctx context.Context, params *experimentparams.CreateExperimentParams) (
+	*experimentmodel.V1Experiment, error) {
+	if err := c.failure("Create"); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, experiment := range c.experiments {
+		if experiment.Name == params.Body.Name {
+			return nil, NewFakeStatusError(codes.AlreadyExists, "Experiment %s already exists", params.Body.Name)
+		}
+	}
+	c.nextID++
+	experiment := *params.Body
+	experiment.ID = fmt.Sprintf("experiment-%d", c.nextID)
+	experiment.CreatedAt = strfmt.NewDateTime()
+	experiment.StorageState = experimentmodel.V1ExperimentStorageStateSTORAGESTATEAVAILABLE
+	c.experiments = append(c.experiments, &experiment)
+	result := experiment
+	return &result, nil
+}
+
+func (c *InMemoryExperimentClientFake) Get(ctx context.Context, params *experimentparams.GetExperimentParams) (
+	*experimentmodel.V1Experiment, error) {
+	if err := c.failure("Get"); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, experiment, err := c.getLocked(params.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := *experiment
+	return &result, nil
+}
+
+func (c *InMemoryExperimentClientFake) Delete(ctx context.Context, params *experimentparams.DeleteExperimentParams) error {
+	if err := c.failure("Delete"); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i, _, err := c.getLocked(params.ID)
+	if err != nil {
+		return err
+	}
+	c.experiments = append(c.experiments[:i], c.experiments[i+1:]...)
+	return nil
+}
+
+func (c *InMemoryExperimentClientFake) List(ctx context.Context, params *experimentparams.ListExperimentParams) (
+	[]*experimentmodel.V1Experiment, int, string, error) {
+	if err := c.failure("List"); err != nil {
+		return nil, 0, "", err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	experiments := make([]*experimentmodel.V1Experiment, 0, len(c.experiments))
+	for _, experiment := range c.experiments {
+		result := *experiment
+		experiments = append(experiments, &result)
+	}
+	start, end, nextPageToken, err := fakePage(len(experiments), params.PageSize, params.PageToken)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return experiments[start:end], len(experiments), nextPageToken, nil
+}
+
+func (c *InMemoryExperimentClientFake) ListAll(ctx context.Context, params *experimentparams.ListExperimentParams,
+	maxResultSize int) ([]*experimentmodel.V1Experiment, error) {
+	return listAllForExperiment(ctx, c, params, maxResultSize)
+}
+
+func (c *InMemoryExperimentClientFake) Archive(ctx context.Context, params *experimentparams.ArchiveExperimentParams) error {
+	return c.setStorageState("Archive", params.ID, experimentmodel.V1ExperimentStorageStateSTORAGESTATEARCHIVED)
+}
+
+func (c *InMemoryExperimentClientFake) Unarchive(ctx context.Context, params *experimentparams.UnarchiveExperimentParams) error {
+	return c.setStorageState("Unarchive", params.ID, experimentmodel.V1ExperimentStorageStateSTORAGESTATEAVAILABLE)
+}
+
+func (c *InMemoryExperimentClientFake) setStorageState(method string, id string,
+	state experimentmodel.V1ExperimentStorageState) error {
+	if err := c.failure(method); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, experiment, err := c.getLocked(id)
+	if err != nil {
+		return err
+	}
+	experiment.StorageState = state
+	return nil
+}
+
+func (c *InMemoryExperimentClientFake) getLocked(id string) (int, *experimentmodel.V1Experiment, error) {
+	for i, experiment := range c.experiments {
+		if experiment.ID == id {
+			return i, experiment, nil
+		}
+	}
+	return 0, nil, NewFakeStatusError(codes.NotFound, "Experiment %s not found", id)
+}
@@ -0,0 +1,57 @@
+package api_server
+
+import (
+	"net/http"
+)
+
+// WithUserAgent sets the User-Agent of the requests of a client.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithDefaultHeaders adds headers to the requests of a client, e.g. to route
+// them by tenant in a gateway or to propagate a trace. The headers set by the
+// requests themselves take precedence.
+func WithDefaultHeaders(headers map[string]string) ClientOption {
+	return func(o *clientOptions) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		for name, value := range headers {
+			o.headers.Set(name, value)
+		}
+	}
+}
+
+// headerTransport sets the User-Agent and the default headers of the requests.
+type headerTransport struct {
+	transport http.RoundTripper
+	userAgent string
+	headers   http.Header
+}
+
+func newHeaderTransport(transport http.RoundTripper, userAgent string, headers http.Header) http.RoundTripper {
+	if userAgent == "" && len(headers) == 0 {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &headerTransport{transport: transport, userAgent: userAgent, headers: headers}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	for name, values := range t.headers {
+		if _, ok := req.Header[name]; !ok {
+			req.Header[name] = values
+		}
+	}
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,46 @@
+package api_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaders(t *testing.T) {
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Running"))
+	}))
+	defer server.Close()
+
+	client := newTestRunClient(t, server,
+		WithUserAgent("kfp-ci/1.0"),
+		WithDefaultHeaders(map[string]string{"X-Tenant-Id": "team-a", "Authorization": "Bearer default"}),
+		WithDefaultHeaders(map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}),
+		WithTokenProvider(StaticTokenProvider("t0k3n")))
+	_, _, err := client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "kfp-ci/1.0", headers.Get("User-Agent"))
+	assert.Equal(t, "team-a", headers.Get("X-Tenant-Id"))
+	assert.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", headers.Get("Traceparent"))
+	// The headers of the requests take precedence.
+	assert.Equal(t, "Bearer t0k3n", headers.Get("Authorization"))
+}
+
+func TestHeaderTransport_DoesNotModifyRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.Nil(t, err)
+	resp, err := newHeaderTransport(http.DefaultTransport, "kfp-ci/1.0",
+		http.Header{"X-Tenant-Id": []string{"team-a"}}).RoundTrip(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Empty(t, req.Header)
+}
@@ -0,0 +1,69 @@
+package api_server
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+)
+
+// The in-memory fakes store the resources they are called with, unlike the
+// other fakes which return canned responses, so the code calling the clients
+// can be unit tested without an API server.
+
+// FakeBehaviors configures the failures of an in-memory fake.
+type FakeBehaviors struct {
+	mutex    sync.Mutex
+	failures map[string]error
+}
+
+// FailOn makes the calls of a method of the fake, e.g. "Create", fail with the
+// error, until they are made to succeed again with a nil error.
+func (b *FakeBehaviors) FailOn(method string, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.failures == nil {
+		b.failures = make(map[string]error)
+	}
+	if err == nil {
+		delete(b.failures, method)
+		return
+	}
+	b.failures[method] = err
+}
+
+func (b *FakeBehaviors) failure(method string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.failures[method]
+}
+
+// NewFakeStatusError creates the error of a client for an error status of the
+// API server, to make the fakes fail with.
+func NewFakeStatusError(code codes.Code, format string, a ...interface{}) error {
+	message := fmt.Sprintf(format, a...)
+	return util.NewUserErrorWithSingleMessage(CreateErrorFromAPIStatus(message, int32(code)), message)
+}
+
+// fakePage returns the bounds of the page of a list request among the items,
+// and the token of the next page, which is the index of its first item.
+func fakePage(size int, pageSize *int32, pageToken *string) (int, int, string, error) {
+	start := 0
+	if pageToken != nil && *pageToken != "" {
+		var err error
+		if start, err = strconv.Atoi(*pageToken); err != nil || start < 0 || start > size {
+			return 0, 0, "", NewFakeStatusError(codes.InvalidArgument, "Invalid page token '%s'", *pageToken)
+		}
+	}
+	end := size
+	if pageSize != nil && *pageSize > 0 && start+int(*pageSize) < size {
+		end = start + int(*pageSize)
+	}
+	nextPageToken := ""
+	if end < size {
+		nextPageToken = strconv.Itoa(end)
+	}
+	return start, end, nextPageToken, nil
+}
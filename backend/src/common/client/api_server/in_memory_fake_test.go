@@ -0,0 +1,134 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+
+	jobparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_client/job_service"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	uploadparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	runparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// fakeStatusCode returns the code of the status of an error of a fake.
+func fakeStatusCode(err error) codes.Code {
+	var statusErr *APIStatusError
+	if !errors.As(err, &statusErr) {
+		return codes.Unknown
+	}
+	return codes.Code(statusErr.Code)
+}
+
+func testRunReference(experimentID string) []*runmodel.V1ResourceReference {
+	return []*runmodel.V1ResourceReference{{
+		Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypeEXPERIMENT, ID: experimentID},
+		Relationship: runmodel.V1RelationshipOWNER,
+	}}
+}
+
+func TestInMemoryRunClientFake(t *testing.T) {
+	ctx := context.Background()
+	client := NewInMemoryRunClientFake()
+	for _, experimentID := range []string{"exp1", "exp2", "exp1"} {
+		_, _, err := client.Create(ctx, &runparams.CreateRunParams{Body: &runmodel.V1Run{
+			Name: "run", ResourceReferences: testRunReference(experimentID)}})
+		assert.Nil(t, err)
+	}
+
+	assert.Nil(t, client.SetRunStatus("run-1", "Running"))
+	runDetail, _, err := client.Get(ctx, &runparams.GetRunParams{RunID: "run-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", runDetail.Run.Status)
+	assert.Equal(t, runmodel.V1RunStorageStateSTORAGESTATEAVAILABLE, runDetail.Run.StorageState)
+	// The runs returned are copies.
+	runDetail.Run.Status = "Succeeded"
+	runDetail, _, err = client.Get(ctx, &runparams.GetRunParams{RunID: "run-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", runDetail.Run.Status)
+
+	pageSize := int32(1)
+	runs, total, nextPageToken, err := client.List(ctx, &runparams.ListRunsParams{PageSize: &pageSize,
+		ResourceReferenceKeyType: util.StringPointer(string(runmodel.V1ResourceTypeEXPERIMENT)),
+		ResourceReferenceKeyID:   util.StringPointer("exp1")})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, "run-1", runs[0].ID)
+	assert.Equal(t, "1", nextPageToken)
+	runs, err = client.ListAll(ctx, &runparams.ListRunsParams{PageSize: &pageSize}, 10)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 3)
+	_, _, _, err = client.List(ctx, &runparams.ListRunsParams{PageToken: util.StringPointer("next")})
+	assert.Equal(t, codes.InvalidArgument, fakeStatusCode(err))
+
+	assert.Nil(t, client.Terminate(ctx, &runparams.TerminateRunParams{RunID: "run-2"}))
+	runDetail, _, err = client.Get(ctx, &runparams.GetRunParams{RunID: "run-2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Cancelled", runDetail.Run.Status)
+	assert.Nil(t, client.Archive(ctx, &runparams.ArchiveRunParams{ID: "run-2"}))
+	runDetail, _, err = client.Get(ctx, &runparams.GetRunParams{RunID: "run-2"})
+	assert.Nil(t, err)
+	assert.Equal(t, runmodel.V1RunStorageStateSTORAGESTATEARCHIVED, runDetail.Run.StorageState)
+
+	assert.Nil(t, client.Delete(ctx, &runparams.DeleteRunParams{ID: "run-2"}))
+	_, _, err = client.Get(ctx, &runparams.GetRunParams{RunID: "run-2"})
+	assert.Equal(t, codes.NotFound, fakeStatusCode(err))
+}
+
+func TestInMemoryRunClientFake_FailOn(t *testing.T) {
+	ctx := context.Background()
+	client := NewInMemoryRunClientFake()
+	client.FailOn("Create", NewFakeStatusError(codes.Unavailable, "API server unavailable"))
+	_, _, err := client.Create(ctx, &runparams.CreateRunParams{Body: &runmodel.V1Run{Name: "run"}})
+	assert.Equal(t, codes.Unavailable, fakeStatusCode(err))
+	assert.Contains(t, err.Error(), "API server unavailable (code: 14)")
+
+	client.FailOn("Create", nil)
+	runDetail, _, err := client.Create(ctx, &runparams.CreateRunParams{Body: &runmodel.V1Run{Name: "run"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "run-1", runDetail.Run.ID)
+}
+
+func TestInMemoryJobClientFake(t *testing.T) {
+	ctx := context.Background()
+	client := NewInMemoryJobClientFake()
+	job, err := client.Create(ctx, &jobparams.CreateJobParams{Body: &jobmodel.V1Job{Name: "job", Enabled: true}})
+	assert.Nil(t, err)
+	assert.Nil(t, client.Disable(ctx, &jobparams.DisableJobParams{ID: job.ID}))
+	job, err = client.Get(ctx, &jobparams.GetJobParams{ID: job.ID})
+	assert.Nil(t, err)
+	assert.False(t, job.Enabled)
+	err = client.Enable(ctx, &jobparams.EnableJobParams{ID: "job-2"})
+	assert.Equal(t, codes.NotFound, fakeStatusCode(err))
+}
+
+func TestInMemoryPipelineUploadClientFake(t *testing.T) {
+	ctx := context.Background()
+	path, cleanup := writeTestFile(t, "apiVersion: tekton.dev/v1")
+	defer cleanup()
+	client := NewInMemoryPipelineUploadClientFake()
+
+	// The pipelines are named after their files by default.
+	pipeline, err := client.UploadFile(ctx, path, &uploadparams.UploadPipelineParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, "model", pipeline.Name)
+	_, err = client.UploadFile(ctx, path, &uploadparams.UploadPipelineParams{})
+	assert.Equal(t, codes.AlreadyExists, fakeStatusCode(err))
+	_, err = client.UploadFile(ctx, path+".missing", &uploadparams.UploadPipelineParams{})
+	assert.Equal(t, codes.InvalidArgument, fakeStatusCode(err))
+
+	version, err := client.UploadPipelineVersion(ctx, path, &uploadparams.UploadPipelineVersionParams{
+		Name: util.StringPointer("v2"), Pipelineid: util.StringPointer(pipeline.ID)})
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", version.Name)
+	assert.Equal(t, pipeline.ID, version.ResourceReferences[0].Key.ID)
+	_, err = client.UploadPipelineVersion(ctx, path, &uploadparams.UploadPipelineVersionParams{
+		Pipelineid: util.StringPointer("missing")})
+	assert.Equal(t, codes.NotFound, fakeStatusCode(err))
+	assert.Len(t, client.Pipelines(), 1)
+	assert.Len(t, client.PipelineVersions(), 1)
+}
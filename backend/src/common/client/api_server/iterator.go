@@ -0,0 +1,43 @@
+package api_server
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Done is returned by the iterators once all the items were walked.
+var Done = errors.New("no more items in iterator")
+
+// pageIterator walks the items of a list request, fetching the pages lazily so
+// only one page is held in memory at a time.
+type pageIterator struct {
+	// fetch lists the page of the token, stores its items and returns their
+	// number and the token of the next page.
+	fetch     func(pageToken string) (int, string, error)
+	pageToken string
+	size      int
+	index     int
+	started   bool
+	err       error
+}
+
+// next advances to the next item and returns its index in the page last fetched.
+func (it *pageIterator) next() (int, error) {
+	if it.err != nil {
+		return 0, it.err
+	}
+	for it.index >= it.size {
+		if it.started && it.pageToken == "" {
+			it.err = Done
+			return 0, it.err
+		}
+		size, pageToken, err := it.fetch(it.pageToken)
+		if err != nil {
+			it.err = err
+			return 0, it.err
+		}
+		it.started = true
+		it.size, it.index, it.pageToken = size, 0, pageToken
+	}
+	it.index++
+	return it.index - 1, nil
+}
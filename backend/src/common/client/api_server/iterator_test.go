@@ -0,0 +1,101 @@
+package api_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRunListServer serves the runs run0 to run<count-1>, in pages of two,
+// failing the requests of the page starting at failAt if it isn't negative.
+func newTestRunListServer(t *testing.T, count int, failAt int, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		assert.Equal(t, "/apis/v1/runs", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("page_size"))
+		start := 0
+		if token := r.URL.Query().Get("page_token"); token != "" {
+			start, _ = strconv.Atoi(token)
+		}
+		if start == failAt {
+			writeTestJSON(t, w, http.StatusInternalServerError, &runmodel.V1Status{Error: "database is down", Code: 13})
+			return
+		}
+		response := &runmodel.V1ListRunsResponse{TotalSize: int32(count)}
+		for i := start; i < count && i < start+2; i++ {
+			response.Runs = append(response.Runs, &runmodel.V1Run{ID: "run" + strconv.Itoa(i)})
+		}
+		if start+2 < count {
+			response.NextPageToken = strconv.Itoa(start + 2)
+		}
+		writeTestJSON(t, w, http.StatusOK, response)
+	}))
+}
+
+func TestRunIterator(t *testing.T) {
+	var requests int32
+	server := newTestRunListServer(t, 5, -1, &requests)
+	defer server.Close()
+	client := newTestRunClient(t, server, WithRetryPolicy(NoRetryPolicy))
+
+	pageSize := int32(2)
+	it := NewRunIterator(context.Background(), client, &params.ListRunsParams{PageSize: &pageSize})
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+	var ids []string
+	for {
+		run, err := it.Next()
+		if err == Done {
+			break
+		}
+		assert.Nil(t, err)
+		ids = append(ids, run.ID)
+		// The pages are fetched as they are walked.
+		assert.Equal(t, int32(len(ids)+1)/2, atomic.LoadInt32(&requests))
+	}
+	assert.Equal(t, []string{"run0", "run1", "run2", "run3", "run4"}, ids)
+
+	_, err := it.Next()
+	assert.Equal(t, Done, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestRunIterator_Error(t *testing.T) {
+	var requests int32
+	server := newTestRunListServer(t, 5, 2, &requests)
+	defer server.Close()
+	client := newTestRunClient(t, server, WithRetryPolicy(NoRetryPolicy))
+
+	pageSize := int32(2)
+	it := NewRunIterator(context.Background(), client, &params.ListRunsParams{PageSize: &pageSize})
+	for i := 0; i < 2; i++ {
+		_, err := it.Next()
+		assert.Nil(t, err)
+	}
+	_, err := it.Next()
+	assert.Contains(t, err.Error(), "database is down")
+	// The error sticks, the page isn't requested again.
+	_, err = it.Next()
+	assert.Contains(t, err.Error(), "database is down")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestRunClient_ListAll(t *testing.T) {
+	var requests int32
+	server := newTestRunListServer(t, 5, -1, &requests)
+	defer server.Close()
+	client := newTestRunClient(t, server, WithRetryPolicy(NoRetryPolicy))
+
+	pageSize := int32(2)
+	runs, err := client.ListAll(context.Background(), &params.ListRunsParams{PageSize: &pageSize}, 3)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 3)
+	assert.Equal(t, "run2", runs[2].ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
@@ -3,6 +3,7 @@ package api_server
 import (
 	"fmt"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	apiclient "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_client"
 	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_client/job_service"
@@ -14,44 +15,42 @@ import (
 )
 
 type JobInterface interface {
-	Create(params *params.CreateJobParams) (*model.V1Job, error)
-	Get(params *params.GetJobParams) (*model.V1Job, error)
-	Delete(params *params.DeleteJobParams) error
-	Enable(params *params.EnableJobParams) error
-	Disable(params *params.DisableJobParams) error
-	List(params *params.ListJobsParams) ([]*model.V1Job, int, string, error)
-	ListAll(params *params.ListJobsParams, maxResultSize int) ([]*model.V1Job, error)
+	Create(ctx context.Context, params *params.CreateJobParams) (*model.V1Job, error)
+	Get(ctx context.Context, params *params.GetJobParams) (*model.V1Job, error)
+	Delete(ctx context.Context, params *params.DeleteJobParams) error
+	Enable(ctx context.Context, params *params.EnableJobParams) error
+	Disable(ctx context.Context, params *params.DisableJobParams) error
+	List(ctx context.Context, params *params.ListJobsParams) ([]*model.V1Job, int, string, error)
+	ListAll(ctx context.Context, params *params.ListJobsParams, maxResultSize int) ([]*model.V1Job, error)
 }
 
 type JobClient struct {
 	apiClient *apiclient.Job
+	authInfo  runtime.ClientAuthInfoWriter
 }
 
-func NewJobClient(clientConfig clientcmd.ClientConfig, debug bool) (
+func NewJobClient(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
 	*JobClient, error) {
 
-	runtime, err := NewHTTPRuntime(clientConfig, debug)
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	apiClient := apiclient.New(runtime, strfmt.Default)
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
 
 	// Creating upload client
 	return &JobClient{
 		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
 	}, nil
 }
 
-func (c *JobClient) Create(parameters *params.CreateJobParams) (*model.V1Job,
+func (c *JobClient) Create(ctx context.Context, parameters *params.CreateJobParams) (*model.V1Job,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.JobService.CreateJob(parameters, PassThroughAuth)
+	response, err := c.apiClient.JobService.CreateJob(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.CreateJobDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -67,15 +66,11 @@ func (c *JobClient) Create(parameters *params.CreateJobParams) (*model.V1Job,
 	return response.Payload, nil
 }
 
-func (c *JobClient) Get(parameters *params.GetJobParams) (*model.V1Job,
+func (c *JobClient) Get(ctx context.Context, parameters *params.GetJobParams) (*model.V1Job,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.JobService.GetJob(parameters, PassThroughAuth)
+	response, err := c.apiClient.JobService.GetJob(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetJobDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -91,14 +86,10 @@ func (c *JobClient) Get(parameters *params.GetJobParams) (*model.V1Job,
 	return response.Payload, nil
 }
 
-func (c *JobClient) Delete(parameters *params.DeleteJobParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *JobClient) Delete(ctx context.Context, parameters *params.DeleteJobParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.JobService.DeleteJob(parameters, PassThroughAuth)
+	_, err := c.apiClient.JobService.DeleteJob(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.DeleteJobDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -114,14 +105,10 @@ func (c *JobClient) Delete(parameters *params.DeleteJobParams) error {
 	return nil
 }
 
-func (c *JobClient) Enable(parameters *params.EnableJobParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *JobClient) Enable(ctx context.Context, parameters *params.EnableJobParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.JobService.EnableJob(parameters, PassThroughAuth)
+	_, err := c.apiClient.JobService.EnableJob(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.EnableJobDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -137,14 +124,10 @@ func (c *JobClient) Enable(parameters *params.EnableJobParams) error {
 	return nil
 }
 
-func (c *JobClient) Disable(parameters *params.DisableJobParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *JobClient) Disable(ctx context.Context, parameters *params.DisableJobParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.JobService.DisableJob(parameters, PassThroughAuth)
+	_, err := c.apiClient.JobService.DisableJob(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.DisableJobDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -160,15 +143,11 @@ func (c *JobClient) Disable(parameters *params.DisableJobParams) error {
 	return nil
 }
 
-func (c *JobClient) List(parameters *params.ListJobsParams) (
+func (c *JobClient) List(ctx context.Context, parameters *params.ListJobsParams) (
 	[]*model.V1Job, int, string, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.JobService.ListJobs(parameters, PassThroughAuth)
+	response, err := c.apiClient.JobService.ListJobs(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.ListJobsDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -184,32 +163,63 @@ func (c *JobClient) List(parameters *params.ListJobsParams) (
 	return response.Payload.Jobs, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
 }
 
-func (c *JobClient) ListAll(parameters *params.ListJobsParams, maxResultSize int) (
+func (c *JobClient) ListAll(ctx context.Context, parameters *params.ListJobsParams, maxResultSize int) (
 	[]*model.V1Job, error) {
-	return listAllForJob(c, parameters, maxResultSize)
+	return listAllForJob(ctx, c, parameters, maxResultSize)
 }
 
-func listAllForJob(client JobInterface, parameters *params.ListJobsParams,
+func listAllForJob(ctx context.Context, client JobInterface, parameters *params.ListJobsParams,
 	maxResultSize int) ([]*model.V1Job, error) {
 	if maxResultSize < 0 {
 		maxResultSize = 0
 	}
 
 	allResults := make([]*model.V1Job, 0)
-	firstCall := true
-	for (firstCall || (parameters.PageToken != nil && *parameters.PageToken != "")) &&
-		(len(allResults) < maxResultSize) {
-		results, _, pageToken, err := client.List(parameters)
+	it := NewJobIterator(ctx, client, parameters)
+	for len(allResults) < maxResultSize {
+		result, err := it.Next()
+		if err == Done {
+			break
+		}
 		if err != nil {
 			return nil, err
 		}
-		allResults = append(allResults, results...)
-		parameters.PageToken = util.StringPointer(pageToken)
-		firstCall = false
-	}
-	if len(allResults) > maxResultSize {
-		allResults = allResults[0:maxResultSize]
+		allResults = append(allResults, result)
 	}
 
 	return allResults, nil
 }
+
+// JobIterator walks the jobs of a list request, fetching the pages lazily.
+type JobIterator struct {
+	pageIterator
+	page []*model.V1Job
+}
+
+// NewJobIterator creates an iterator over the jobs matching the parameters,
+// starting from their page token.
+func NewJobIterator(ctx context.Context, client JobInterface, parameters *params.ListJobsParams) *JobIterator {
+	it := &JobIterator{}
+	if parameters.PageToken != nil {
+		it.pageToken = *parameters.PageToken
+	}
+	it.fetch = func(pageToken string) (int, string, error) {
+		parameters.PageToken = util.StringPointer(pageToken)
+		results, _, nextPageToken, err := client.List(ctx, parameters)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = results
+		return len(results), nextPageToken, nil
+	}
+	return it
+}
+
+// Next returns the next job, or Done once all were walked.
+func (it *JobIterator) Next() (*model.V1Job, error) {
+	index, err := it.next()
+	if err != nil {
+		return nil, err
+	}
+	return it.page[index], nil
+}
@@ -1,6 +1,7 @@
 package api_server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -28,7 +29,7 @@ func NewJobClientFake() *JobClientFake {
 	return &JobClientFake{}
 }
 
-func (c *JobClientFake) Create(params *jobparams.CreateJobParams) (
+func (c *JobClientFake) Create(ctx context.Context, params *jobparams.CreateJobParams) (
 	*jobmodel.V1Job, error) {
 	switch params.Body.Name {
 	case JobForClientErrorTest:
@@ -38,7 +39,7 @@ func (c *JobClientFake) Create(params *jobparams.CreateJobParams) (
 	}
 }
 
-func (c *JobClientFake) Get(params *jobparams.GetJobParams) (
+func (c *JobClientFake) Get(ctx context.Context, params *jobparams.GetJobParams) (
 	*jobmodel.V1Job, error) {
 	switch params.ID {
 	case JobForClientErrorTest:
@@ -48,7 +49,7 @@ func (c *JobClientFake) Get(params *jobparams.GetJobParams) (
 	}
 }
 
-func (c *JobClientFake) Delete(params *jobparams.DeleteJobParams) error {
+func (c *JobClientFake) Delete(ctx context.Context, params *jobparams.DeleteJobParams) error {
 	switch params.ID {
 	case JobForClientErrorTest:
 		return fmt.Errorf(ClientErrorString)
@@ -57,7 +58,7 @@ func (c *JobClientFake) Delete(params *jobparams.DeleteJobParams) error {
 	}
 }
 
-func (c *JobClientFake) Enable(params *jobparams.EnableJobParams) error {
+func (c *JobClientFake) Enable(ctx context.Context, params *jobparams.EnableJobParams) error {
 	switch params.ID {
 	case JobForClientErrorTest:
 		return fmt.Errorf(ClientErrorString)
@@ -66,7 +67,7 @@ func (c *JobClientFake) Enable(params *jobparams.EnableJobParams) error {
 	}
 }
 
-func (c *JobClientFake) Disable(params *jobparams.DisableJobParams) error {
+func (c *JobClientFake) Disable(ctx context.Context, params *jobparams.DisableJobParams) error {
 	switch params.ID {
 	case JobForClientErrorTest:
 		return fmt.Errorf(ClientErrorString)
@@ -75,7 +76,7 @@ func (c *JobClientFake) Disable(params *jobparams.DisableJobParams) error {
 	}
 }
 
-func (c *JobClientFake) List(params *jobparams.ListJobsParams) (
+func (c *JobClientFake) List(ctx context.Context, params *jobparams.ListJobsParams) (
 	[]*jobmodel.V1Job, int, string, error) {
 	const (
 		FirstToken  = ""
@@ -103,7 +104,7 @@ func (c *JobClientFake) List(params *jobparams.ListJobsParams) (
 	}
 }
 
-func (c *JobClientFake) ListAll(params *jobparams.ListJobsParams,
+func (c *JobClientFake) ListAll(ctx context.Context, params *jobparams.ListJobsParams,
 	maxResultSize int) ([]*jobmodel.V1Job, error) {
-	return listAllForJob(c, params, maxResultSize)
+	return listAllForJob(ctx, c, params, maxResultSize)
 }
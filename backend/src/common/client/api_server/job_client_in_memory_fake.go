@@ -0,0 +1,136 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	jobparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_client/job_service"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_model"
+	"google.golang.org/grpc/codes"
+)
+
+// InMemoryJobClientFake is a JobInterface storing the jobs in memory.
+type InMemoryJobClientFake struct {
+	FakeBehaviors
+	mutex  sync.Mutex
+	jobs   []*jobmodel.V1Job
+	nextID int
+}
+
+func NewInMemoryJobClientFake() *InMemoryJobClientFake {
+	return &InMemoryJobClientFake{}
+}
+
+func (c *InMemoryJobClientFake) Create(ctx context.Context, params *jobparams.CreateJobParams) (*jobmodel.V1Job, error) {
+	if err := c.failure("Create"); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.nextID++
+	job := *params.Body
+	job.ID = fmt.Sprintf("job-%d", c.nextID)
+	job.CreatedAt = strfmt.NewDateTime()
+	job.UpdatedAt = job.CreatedAt
+	c.jobs = append(c.jobs, &job)
+	result := job
+	return &result, nil
+}
+
+func (c *InMemoryJobClientFake) Get(ctx context.Context, params *jobparams.GetJobParams) (*jobmodel.V1Job, error) {
+	if err := c.failure("Get"); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, job, err := c.getLocked(params.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := *job
+	return &result, nil
+}
+
+func (c *InMemoryJobClientFake) Delete(ctx context.Context, params *jobparams.DeleteJobParams) error {
+	if err := c.failure("Delete"); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i, _, err := c.getLocked(params.ID)
+	if err != nil {
+		return err
+	}
+	c.jobs = append(c.jobs[:i], c.jobs[i+1:]...)
+	return nil
+}
+
+func (c *InMemoryJobClientFake) Enable(ctx context.Context, params *jobparams.EnableJobParams) error {
+	return c.setEnabled("Enable", params.ID, true)
+}
+
+func (c *InMemoryJobClientFake) Disable(ctx context.Context, params *jobparams.DisableJobParams) error {
+	return c.setEnabled("Disable", params.ID, false)
+}
+
+func (c *InMemoryJobClientFake) List(ctx context.Context, params *jobparams.ListJobsParams) (
+	[]*jobmodel.V1Job, int, string, error) {
+	if err := c.failure("List"); err != nil {
+		return nil, 0, "", err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var jobs []*jobmodel.V1Job
+	for _, job := range c.jobs {
+		if params.ResourceReferenceKeyID == nil || hasJobReference(job, params.ResourceReferenceKeyType, *params.ResourceReferenceKeyID) {
+			result := *job
+			jobs = append(jobs, &result)
+		}
+	}
+	start, end, nextPageToken, err := fakePage(len(jobs), params.PageSize, params.PageToken)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return jobs[start:end], len(jobs), nextPageToken, nil
+}
+
+func (c *InMemoryJobClientFake) ListAll(ctx context.Context, params *jobparams.ListJobsParams, maxResultSize int) (
+	[]*jobmodel.V1Job, error) {
+	return listAllForJob(ctx, c, params, maxResultSize)
+}
+
+func (c *InMemoryJobClientFake) setEnabled(method string, id string, enabled bool) error {
+	if err := c.failure(method); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, job, err := c.getLocked(id)
+	if err != nil {
+		return err
+	}
+	job.Enabled = enabled
+	job.UpdatedAt = strfmt.NewDateTime()
+	return nil
+}
+
+func (c *InMemoryJobClientFake) getLocked(id string) (int, *jobmodel.V1Job, error) {
+	for i, job := range c.jobs {
+		if job.ID == id {
+			return i, job, nil
+		}
+	}
+	return 0, nil, NewFakeStatusError(codes.NotFound, "Job %s not found", id)
+}
+
+func hasJobReference(job *jobmodel.V1Job, keyType *string, keyID string) bool {
+	for _, reference := range job.ResourceReferences {
+		if reference.Key != nil && reference.Key.ID == keyID &&
+			(keyType == nil || string(reference.Key.Type) == *keyType) {
+			return true
+		}
+	}
+	return false
+}
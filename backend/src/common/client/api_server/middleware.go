@@ -0,0 +1,118 @@
+package api_server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+)
+
+// Middleware hooks into the requests of the clients, e.g. to export metrics or
+// log the API calls. Either hook may be nil. The hooks are called synchronously
+// with the requests, so they should be quick.
+type Middleware struct {
+	// OnRequest is called before a request is sent.
+	OnRequest func(ctx context.Context, request *RequestInfo)
+	// OnResponse is called once a request returned, or failed without a response.
+	OnResponse func(ctx context.Context, response *ResponseInfo)
+}
+
+// RequestInfo describes a request of a client.
+type RequestInfo struct {
+	// OperationID is the id of the API operation, such as "GetRun".
+	OperationID string
+	Method      string
+	Path        string
+}
+
+// ResponseInfo describes the outcome of a request of a client.
+type ResponseInfo struct {
+	RequestInfo
+	// StatusCode is the HTTP status of the response, 0 if there was none.
+	StatusCode int
+	Latency    time.Duration
+	// Err is the error of a request that failed without a response.
+	Err error
+}
+
+// WithMiddleware adds middlewares to a client. The OnRequest hooks are called in
+// the order the middlewares are added, the OnResponse hooks in reverse order.
+// The requests retried are observed once, the latency including the retries.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(o *clientOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+type operationIDKey struct{}
+
+func withOperationID(ctx context.Context, operationID string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+func operationIDFromContext(ctx context.Context) string {
+	operationID, _ := ctx.Value(operationIDKey{}).(string)
+	return operationID
+}
+
+// operationTransport passes the ids of the operations of the generated clients
+// to the middlewares, through the contexts of their requests.
+type operationTransport struct {
+	transport runtime.ClientTransport
+}
+
+func withOperationIDs(transport runtime.ClientTransport) runtime.ClientTransport {
+	return &operationTransport{transport: transport}
+}
+
+func (t *operationTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	operation.Context = withOperationID(operation.Context, operation.ID)
+	return t.transport.Submit(operation)
+}
+
+// middlewareTransport calls the hooks of the middlewares around the requests.
+type middlewareTransport struct {
+	transport   http.RoundTripper
+	middlewares []Middleware
+}
+
+func newMiddlewareTransport(transport http.RoundTripper, middlewares []Middleware) http.RoundTripper {
+	if len(middlewares) == 0 {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &middlewareTransport{transport: transport, middlewares: middlewares}
+}
+
+func (t *middlewareTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	request := RequestInfo{
+		OperationID: operationIDFromContext(ctx),
+		Method:      req.Method,
+		Path:        req.URL.Path,
+	}
+	for _, middleware := range t.middlewares {
+		if middleware.OnRequest != nil {
+			middleware.OnRequest(ctx, &request)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	response := ResponseInfo{RequestInfo: request, Latency: time.Since(start), Err: err}
+	if resp != nil {
+		response.StatusCode = resp.StatusCode
+	}
+	for i := len(t.middlewares) - 1; i >= 0; i-- {
+		if t.middlewares[i].OnResponse != nil {
+			t.middlewares[i].OnResponse(ctx, &response)
+		}
+	}
+	return resp, err
+}
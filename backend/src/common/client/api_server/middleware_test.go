@@ -0,0 +1,74 @@
+package api_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Running"))
+	}))
+	defer server.Close()
+
+	var calls []string
+	var responses []*ResponseInfo
+	recorder := func(name string) Middleware {
+		return Middleware{
+			OnRequest: func(ctx context.Context, request *RequestInfo) {
+				calls = append(calls, name+" request "+request.OperationID)
+			},
+			OnResponse: func(ctx context.Context, response *ResponseInfo) {
+				calls = append(calls, name+" response "+response.OperationID)
+				responses = append(responses, response)
+			},
+		}
+	}
+	client := newTestRunClient(t, server, WithRetryPolicy(testRetryPolicy),
+		WithMiddleware(recorder("metrics")), WithMiddleware(recorder("logs"), Middleware{}))
+
+	_, _, err := client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"metrics request GetRun",
+		"logs request GetRun",
+		"logs response GetRun",
+		"metrics response GetRun",
+	}, calls)
+	// The retried requests are observed once.
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	response := responses[0]
+	assert.Equal(t, http.MethodGet, response.Method)
+	assert.Equal(t, "/apis/v1/runs/run1", response.Path)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.True(t, response.Latency > 0)
+	assert.Nil(t, response.Err)
+}
+
+func TestMiddleware_RequestFailure(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	var response *ResponseInfo
+	client := newTestRunClient(t, server, WithRetryPolicy(NoRetryPolicy), WithMiddleware(Middleware{
+		OnResponse: func(ctx context.Context, info *ResponseInfo) {
+			response = info
+		},
+	}))
+	_, _, err := client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "GetRun", response.OperationID)
+	assert.Equal(t, 0, response.StatusCode)
+	assert.NotNil(t, response.Err)
+}
@@ -3,6 +3,7 @@ package api_server
 import (
 	"fmt"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	apiclient "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_client"
 	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_client/pipeline_service"
@@ -15,27 +16,25 @@ import (
 )
 
 type PipelineInterface interface {
-	Create(params *params.CreatePipelineParams) (*model.V1Pipeline, error)
-	Get(params *params.GetPipelineParams) (*model.V1Pipeline, error)
-	Delete(params *params.DeletePipelineParams) error
-	GetTemplate(params *params.GetTemplateParams) (template.Template, error)
-	List(params *params.ListPipelinesParams) ([]*model.V1Pipeline, int, string, error)
-	ListAll(params *params.ListPipelinesParams, maxResultSize int) (
+	Create(ctx context.Context, params *params.CreatePipelineParams) (*model.V1Pipeline, error)
+	Get(ctx context.Context, params *params.GetPipelineParams) (*model.V1Pipeline, error)
+	Delete(ctx context.Context, params *params.DeletePipelineParams) error
+	GetTemplate(ctx context.Context, params *params.GetTemplateParams) (template.Template, error)
+	List(ctx context.Context, params *params.ListPipelinesParams) ([]*model.V1Pipeline, int, string, error)
+	ListAll(ctx context.Context, params *params.ListPipelinesParams, maxResultSize int) (
 		[]*model.V1Pipeline, error)
-	UpdateDefaultVersion(params *params.UpdatePipelineDefaultVersionParams) error
+	UpdateDefaultVersion(ctx context.Context, params *params.UpdatePipelineDefaultVersionParams) error
 }
 
 type PipelineClient struct {
 	apiClient *apiclient.Pipeline
+	authInfo  runtime.ClientAuthInfoWriter
 }
 
-func (c *PipelineClient) UpdateDefaultVersion(parameters *params.UpdatePipelineDefaultVersionParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
+func (c *PipelineClient) UpdateDefaultVersion(ctx context.Context, parameters *params.UpdatePipelineDefaultVersionParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.PipelineService.UpdatePipelineDefaultVersion(parameters, PassThroughAuth)
+	_, err := c.apiClient.PipelineService.UpdatePipelineDefaultVersion(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetPipelineDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -51,30 +50,27 @@ func (c *PipelineClient) UpdateDefaultVersion(parameters *params.UpdatePipelineD
 	return nil
 }
 
-func NewPipelineClient(clientConfig clientcmd.ClientConfig, debug bool) (
+func NewPipelineClient(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
 	*PipelineClient, error) {
 
-	runtime, err := NewHTTPRuntime(clientConfig, debug)
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	apiClient := apiclient.New(runtime, strfmt.Default)
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
 
 	// Creating upload client
 	return &PipelineClient{
 		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
 	}, nil
 }
 
-func (c *PipelineClient) Create(parameters *params.CreatePipelineParams) (*model.V1Pipeline,
+func (c *PipelineClient) Create(ctx context.Context, parameters *params.CreatePipelineParams) (*model.V1Pipeline,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.CreatePipeline(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.CreatePipeline(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.CreatePipelineDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -90,15 +86,11 @@ func (c *PipelineClient) Create(parameters *params.CreatePipelineParams) (*model
 	return response.Payload, nil
 }
 
-func (c *PipelineClient) Get(parameters *params.GetPipelineParams) (*model.V1Pipeline,
+func (c *PipelineClient) Get(ctx context.Context, parameters *params.GetPipelineParams) (*model.V1Pipeline,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.GetPipeline(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.GetPipeline(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetPipelineDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -114,14 +106,10 @@ func (c *PipelineClient) Get(parameters *params.GetPipelineParams) (*model.V1Pip
 	return response.Payload, nil
 }
 
-func (c *PipelineClient) Delete(parameters *params.DeletePipelineParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *PipelineClient) Delete(ctx context.Context, parameters *params.DeletePipelineParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.PipelineService.DeletePipeline(parameters, PassThroughAuth)
+	_, err := c.apiClient.PipelineService.DeletePipeline(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.DeletePipelineDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -137,14 +125,10 @@ func (c *PipelineClient) Delete(parameters *params.DeletePipelineParams) error {
 	return nil
 }
 
-func (c *PipelineClient) GetTemplate(parameters *params.GetTemplateParams) (template.Template, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *PipelineClient) GetTemplate(ctx context.Context, parameters *params.GetTemplateParams) (template.Template, error) {
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.GetTemplate(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.GetTemplate(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetTemplateDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -161,15 +145,11 @@ func (c *PipelineClient) GetTemplate(parameters *params.GetTemplateParams) (temp
 	return template.New([]byte(response.Payload.Template))
 }
 
-func (c *PipelineClient) List(parameters *params.ListPipelinesParams) (
+func (c *PipelineClient) List(ctx context.Context, parameters *params.ListPipelinesParams) (
 	[]*model.V1Pipeline, int, string, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.ListPipelines(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.ListPipelines(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.ListPipelinesDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -185,44 +165,71 @@ func (c *PipelineClient) List(parameters *params.ListPipelinesParams) (
 	return response.Payload.Pipelines, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
 }
 
-func (c *PipelineClient) ListAll(parameters *params.ListPipelinesParams, maxResultSize int) (
+func (c *PipelineClient) ListAll(ctx context.Context, parameters *params.ListPipelinesParams, maxResultSize int) (
 	[]*model.V1Pipeline, error) {
-	return listAllForPipeline(c, parameters, maxResultSize)
+	return listAllForPipeline(ctx, c, parameters, maxResultSize)
 }
 
-func listAllForPipeline(client PipelineInterface, parameters *params.ListPipelinesParams,
+func listAllForPipeline(ctx context.Context, client PipelineInterface, parameters *params.ListPipelinesParams,
 	maxResultSize int) ([]*model.V1Pipeline, error) {
 	if maxResultSize < 0 {
 		maxResultSize = 0
 	}
 
 	allResults := make([]*model.V1Pipeline, 0)
-	firstCall := true
-	for (firstCall || (parameters.PageToken != nil && *parameters.PageToken != "")) &&
-		(len(allResults) < maxResultSize) {
-		results, _, pageToken, err := client.List(parameters)
+	it := NewPipelineIterator(ctx, client, parameters)
+	for len(allResults) < maxResultSize {
+		result, err := it.Next()
+		if err == Done {
+			break
+		}
 		if err != nil {
 			return nil, err
 		}
-		allResults = append(allResults, results...)
-		parameters.PageToken = util.StringPointer(pageToken)
-		firstCall = false
-	}
-	if len(allResults) > maxResultSize {
-		allResults = allResults[0:maxResultSize]
+		allResults = append(allResults, result)
 	}
 
 	return allResults, nil
 }
 
-func (c *PipelineClient) CreatePipelineVersion(parameters *params.CreatePipelineVersionParams) (*model.V1PipelineVersion,
-	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
+// PipelineIterator walks the pipelines of a list request, fetching the pages lazily.
+type PipelineIterator struct {
+	pageIterator
+	page []*model.V1Pipeline
+}
+
+// NewPipelineIterator creates an iterator over the pipelines matching the parameters,
+// starting from their page token.
+func NewPipelineIterator(ctx context.Context, client PipelineInterface, parameters *params.ListPipelinesParams) *PipelineIterator {
+	it := &PipelineIterator{}
+	if parameters.PageToken != nil {
+		it.pageToken = *parameters.PageToken
+	}
+	it.fetch = func(pageToken string) (int, string, error) {
+		parameters.PageToken = util.StringPointer(pageToken)
+		results, _, nextPageToken, err := client.List(ctx, parameters)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = results
+		return len(results), nextPageToken, nil
+	}
+	return it
+}
 
+// Next returns the next pipeline, or Done once all were walked.
+func (it *PipelineIterator) Next() (*model.V1Pipeline, error) {
+	index, err := it.next()
+	if err != nil {
+		return nil, err
+	}
+	return it.page[index], nil
+}
+
+func (c *PipelineClient) CreatePipelineVersion(ctx context.Context, parameters *params.CreatePipelineVersionParams) (*model.V1PipelineVersion,
+	error) {
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.CreatePipelineVersion(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.CreatePipelineVersion(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.CreatePipelineVersionDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -238,15 +245,11 @@ func (c *PipelineClient) CreatePipelineVersion(parameters *params.CreatePipeline
 	return response.Payload, nil
 }
 
-func (c *PipelineClient) ListPipelineVersions(parameters *params.ListPipelineVersionsParams) (
+func (c *PipelineClient) ListPipelineVersions(ctx context.Context, parameters *params.ListPipelineVersionsParams) (
 	[]*model.V1PipelineVersion, int, string, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.ListPipelineVersions(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.ListPipelineVersions(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.ListPipelineVersionsDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -262,15 +265,11 @@ func (c *PipelineClient) ListPipelineVersions(parameters *params.ListPipelineVer
 	return response.Payload.Versions, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
 }
 
-func (c *PipelineClient) GetPipelineVersion(parameters *params.GetPipelineVersionParams) (*model.V1PipelineVersion,
+func (c *PipelineClient) GetPipelineVersion(ctx context.Context, parameters *params.GetPipelineVersionParams) (*model.V1PipelineVersion,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.GetPipelineVersion(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.GetPipelineVersion(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetPipelineVersionDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -286,15 +285,11 @@ func (c *PipelineClient) GetPipelineVersion(parameters *params.GetPipelineVersio
 	return response.Payload, nil
 }
 
-func (c *PipelineClient) GetPipelineVersionTemplate(parameters *params.GetPipelineVersionTemplateParams) (
+func (c *PipelineClient) GetPipelineVersionTemplate(ctx context.Context, parameters *params.GetPipelineVersionTemplateParams) (
 	template.Template, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineService.GetPipelineVersionTemplate(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineService.GetPipelineVersionTemplate(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetPipelineVersionTemplateDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
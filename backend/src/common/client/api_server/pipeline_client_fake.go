@@ -1,6 +1,7 @@
 package api_server
 
 import (
+	"context"
 	"fmt"
 
 	"path"
@@ -64,7 +65,7 @@ func NewPipelineClientFake() *PipelineClientFake {
 	return &PipelineClientFake{}
 }
 
-func (c *PipelineClientFake) Create(params *pipelineparams.CreatePipelineParams) (
+func (c *PipelineClientFake) Create(ctx context.Context, params *pipelineparams.CreatePipelineParams) (
 	*pipelinemodel.V1Pipeline, error) {
 	switch params.Body.URL.PipelineURL {
 	case PipelineInvalidURL:
@@ -74,7 +75,7 @@ func (c *PipelineClientFake) Create(params *pipelineparams.CreatePipelineParams)
 	}
 }
 
-func (c *PipelineClientFake) Get(params *pipelineparams.GetPipelineParams) (
+func (c *PipelineClientFake) Get(ctx context.Context, params *pipelineparams.GetPipelineParams) (
 	*pipelinemodel.V1Pipeline, error) {
 	switch params.ID {
 	case PipelineForClientErrorTest:
@@ -84,7 +85,7 @@ func (c *PipelineClientFake) Get(params *pipelineparams.GetPipelineParams) (
 	}
 }
 
-func (c *PipelineClientFake) Delete(params *pipelineparams.DeletePipelineParams) error {
+func (c *PipelineClientFake) Delete(ctx context.Context, params *pipelineparams.DeletePipelineParams) error {
 	switch params.ID {
 	case PipelineForClientErrorTest:
 		return fmt.Errorf(ClientErrorString)
@@ -93,7 +94,7 @@ func (c *PipelineClientFake) Delete(params *pipelineparams.DeletePipelineParams)
 	}
 }
 
-func (c *PipelineClientFake) GetTemplate(params *pipelineparams.GetTemplateParams) (
+func (c *PipelineClientFake) GetTemplate(ctx context.Context, params *pipelineparams.GetTemplateParams) (
 	template.Template, error) {
 	switch params.ID {
 	case PipelineForClientErrorTest:
@@ -103,7 +104,7 @@ func (c *PipelineClientFake) GetTemplate(params *pipelineparams.GetTemplateParam
 	}
 }
 
-func (c *PipelineClientFake) List(params *pipelineparams.ListPipelinesParams) (
+func (c *PipelineClientFake) List(ctx context.Context, params *pipelineparams.ListPipelinesParams) (
 	[]*pipelinemodel.V1Pipeline, int, string, error) {
 
 	const (
@@ -132,12 +133,12 @@ func (c *PipelineClientFake) List(params *pipelineparams.ListPipelinesParams) (
 	}
 }
 
-func (c *PipelineClientFake) ListAll(params *pipelineparams.ListPipelinesParams,
+func (c *PipelineClientFake) ListAll(ctx context.Context, params *pipelineparams.ListPipelinesParams,
 	maxResultSize int) ([]*pipelinemodel.V1Pipeline, error) {
-	return listAllForPipeline(c, params, maxResultSize)
+	return listAllForPipeline(ctx, c, params, maxResultSize)
 }
 
-func (c *PipelineClientFake) UpdateDefaultVersion(params *params.UpdatePipelineDefaultVersionParams) error {
+func (c *PipelineClientFake) UpdateDefaultVersion(ctx context.Context, params *params.UpdatePipelineDefaultVersionParams) error {
 	switch params.PipelineID {
 	case PipelineForClientErrorTest:
 		return fmt.Errorf(ClientErrorString)
@@ -0,0 +1,274 @@
+package api_server
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	apiclient "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/pipeline_client"
+	params "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/pipeline_client/pipeline_service"
+	model "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/pipeline_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"golang.org/x/net/context"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// PipelineInterfaceV2beta1 is the client of the v2beta1 PipelineService, whose
+// pipelines and versions have display names.
+type PipelineInterfaceV2beta1 interface {
+	Create(ctx context.Context, params *params.CreatePipelineAndVersionParams) (*model.V2beta1Pipeline, error)
+	Get(ctx context.Context, params *params.GetPipelineParams) (*model.V2beta1Pipeline, error)
+	Delete(ctx context.Context, params *params.DeletePipelineParams) error
+	List(ctx context.Context, params *params.ListPipelinesParams) ([]*model.V2beta1Pipeline, int, string, error)
+	ListAll(ctx context.Context, params *params.ListPipelinesParams, maxResultSize int) (
+		[]*model.V2beta1Pipeline, error)
+	CreatePipelineVersion(ctx context.Context, params *params.CreatePipelineVersionParams) (
+		*model.V2beta1PipelineVersion, error)
+	GetPipelineVersion(ctx context.Context, params *params.GetPipelineVersionParams) (
+		*model.V2beta1PipelineVersion, error)
+	ListPipelineVersions(ctx context.Context, params *params.ListPipelineVersionsParams) (
+		[]*model.V2beta1PipelineVersion, int, string, error)
+	DeletePipelineVersion(ctx context.Context, params *params.DeletePipelineVersionParams) error
+}
+
+type PipelineClientV2beta1 struct {
+	apiClient *apiclient.Pipeline
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+func NewPipelineClientV2beta1(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
+	*PipelineClientV2beta1, error) {
+
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
+
+	return &PipelineClientV2beta1{
+		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
+	}, nil
+}
+
+// Create creates a pipeline along with its first version.
+func (c *PipelineClientV2beta1) Create(ctx context.Context, parameters *params.CreatePipelineAndVersionParams) (
+	*model.V2beta1Pipeline, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.CreatePipelineAndVersion(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.CreatePipelineAndVersionDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to create pipeline. Params: '%+v'. Body: '%+v'", parameters, parameters.Body),
+			fmt.Sprintf("Failed to create pipeline"))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *PipelineClientV2beta1) Get(ctx context.Context, parameters *params.GetPipelineParams) (
+	*model.V2beta1Pipeline, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.GetPipeline(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.GetPipelineDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to get pipeline. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to get pipeline '%v'", parameters.PipelineID))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *PipelineClientV2beta1) Delete(ctx context.Context, parameters *params.DeletePipelineParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.PipelineService.DeletePipeline(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.DeletePipelineDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to delete pipeline. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to delete pipeline '%v'", parameters.PipelineID))
+	}
+
+	return nil
+}
+
+func (c *PipelineClientV2beta1) List(ctx context.Context, parameters *params.ListPipelinesParams) (
+	[]*model.V2beta1Pipeline, int, string, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.ListPipelines(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.ListPipelinesDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, 0, "", util.NewUserError(err,
+			fmt.Sprintf("Failed to list pipelines. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to list pipelines"))
+	}
+
+	return response.Payload.Pipelines, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
+}
+
+func (c *PipelineClientV2beta1) ListAll(ctx context.Context, parameters *params.ListPipelinesParams,
+	maxResultSize int) ([]*model.V2beta1Pipeline, error) {
+	if maxResultSize < 0 {
+		maxResultSize = 0
+	}
+
+	allResults := make([]*model.V2beta1Pipeline, 0)
+	it := NewPipelineIteratorV2beta1(ctx, c, parameters)
+	for len(allResults) < maxResultSize {
+		result, err := it.Next()
+		if err == Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		allResults = append(allResults, result)
+	}
+
+	return allResults, nil
+}
+
+// PipelineIteratorV2beta1 walks the pipelines of a v2beta1 list request, fetching
+// the pages lazily.
+type PipelineIteratorV2beta1 struct {
+	pageIterator
+	page []*model.V2beta1Pipeline
+}
+
+// NewPipelineIteratorV2beta1 creates an iterator over the pipelines matching the
+// parameters, starting from their page token.
+func NewPipelineIteratorV2beta1(ctx context.Context, client PipelineInterfaceV2beta1,
+	parameters *params.ListPipelinesParams) *PipelineIteratorV2beta1 {
+	it := &PipelineIteratorV2beta1{}
+	if parameters.PageToken != nil {
+		it.pageToken = *parameters.PageToken
+	}
+	it.fetch = func(pageToken string) (int, string, error) {
+		parameters.PageToken = util.StringPointer(pageToken)
+		results, _, nextPageToken, err := client.List(ctx, parameters)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = results
+		return len(results), nextPageToken, nil
+	}
+	return it
+}
+
+// Next returns the next pipeline, or Done once all were walked.
+func (it *PipelineIteratorV2beta1) Next() (*model.V2beta1Pipeline, error) {
+	index, err := it.next()
+	if err != nil {
+		return nil, err
+	}
+	return it.page[index], nil
+}
+
+func (c *PipelineClientV2beta1) CreatePipelineVersion(ctx context.Context,
+	parameters *params.CreatePipelineVersionParams) (*model.V2beta1PipelineVersion, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.CreatePipelineVersion(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.CreatePipelineVersionDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to create pipeline version. Params: '%+v'. Body: '%+v'", parameters, parameters.Body),
+			fmt.Sprintf("Failed to create pipeline version '%v'", parameters.Body.DisplayName))
+	}
+
+	return response.Payload, nil
+}
+
+// GetPipelineVersion returns a version of a pipeline, along with its pipeline spec
+// if it's a v2 pipeline.
+func (c *PipelineClientV2beta1) GetPipelineVersion(ctx context.Context,
+	parameters *params.GetPipelineVersionParams) (*model.V2beta1PipelineVersion, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.GetPipelineVersion(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.GetPipelineVersionDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to get pipeline version. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to get pipeline version '%v'", parameters.PipelineVersionID))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *PipelineClientV2beta1) ListPipelineVersions(ctx context.Context,
+	parameters *params.ListPipelineVersionsParams) ([]*model.V2beta1PipelineVersion, int, string, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.ListPipelineVersions(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.ListPipelineVersionsDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, 0, "", util.NewUserError(err,
+			fmt.Sprintf("Failed to list pipeline versions. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to list pipeline versions"))
+	}
+
+	return response.Payload.PipelineVersions, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
+}
+
+func (c *PipelineClientV2beta1) DeletePipelineVersion(ctx context.Context,
+	parameters *params.DeletePipelineVersionParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.PipelineService.DeletePipelineVersion(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.DeletePipelineVersionDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to delete pipeline version. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to delete pipeline version '%v'", parameters.PipelineVersionID))
+	}
+
+	return nil
+}
@@ -3,7 +3,14 @@ package api_server
 import (
 	"context"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -24,31 +31,62 @@ const (
 )
 
 type PipelineUploadInterface interface {
-	UploadFile(filePath string, parameters *params.UploadPipelineParams) (*model.V1Pipeline, error)
+	UploadFile(ctx context.Context, filePath string, parameters *params.UploadPipelineParams) (*model.V1Pipeline, error)
 }
 
 type PipelineUploadClient struct {
-	apiClient *apiclient.PipelineUpload
+	apiClient    *apiclient.PipelineUpload
+	uploadClient *ResumableUploadClient
+	authInfo     runtime.ClientAuthInfoWriter
 }
 
-func NewPipelineUploadClient(clientConfig clientcmd.ClientConfig, debug bool) (
+func NewPipelineUploadClient(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
 	*PipelineUploadClient, error) {
 
-	runtime, err := NewHTTPRuntime(clientConfig, debug)
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	apiClient := apiclient.New(runtime, strfmt.Default)
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
+	uploadClient, err := NewResumableUploadClient(clientConfig, opts...)
+	if err != nil {
+		return nil, err
+	}
 
 	// Creating upload client
 	return &PipelineUploadClient{
-		apiClient: apiClient,
+		apiClient:    apiClient,
+		uploadClient: uploadClient,
+		authInfo:     newClientOptions(opts).authInfo(),
 	}, nil
 }
 
-func (c *PipelineUploadClient) UploadFile(filePath string, parameters *params.UploadPipelineParams) (
+func (c *PipelineUploadClient) UploadFile(ctx context.Context, filePath string, parameters *params.UploadPipelineParams) (
 	*model.V1Pipeline, error) {
+	return c.UploadFileWithProgress(ctx, filePath, parameters, nil)
+}
+
+// UploadFileWithProgress uploads a pipeline from a local file. The files larger
+// than ResumableUploadThreshold are uploaded in resumable chunks, reporting the
+// progress after each chunk.
+func (c *PipelineUploadClient) UploadFileWithProgress(ctx context.Context, filePath string, parameters *params.UploadPipelineParams,
+	progress UploadProgressFunc) (*model.V1Pipeline, error) {
+	if large, err := isLargeFile(filePath); err != nil {
+		return nil, err
+	} else if large {
+		query := url.Values{}
+		setOptionalQuery(query, "name", parameters.Name)
+		setOptionalQuery(query, "description", parameters.Description)
+		var pipeline model.V1Pipeline
+		if err := c.uploadPipelineInChunks(ctx, filePath, "UploadPipeline", pipelineUploadPath, query, progress, &pipeline); err != nil {
+			return nil, util.NewUserError(err,
+				fmt.Sprintf("Failed to upload pipeline. Params: '%v'", parameters),
+				fmt.Sprintf("Failed to upload pipeline"))
+		}
+		return &pipeline, nil
+	}
+
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, util.NewUserErrorWithSingleMessage(err,
@@ -57,18 +95,14 @@ func (c *PipelineUploadClient) UploadFile(filePath string, parameters *params.Up
 	defer file.Close()
 
 	parameters.Uploadfile = runtime.NamedReader(filePath, file)
-	return c.Upload(parameters)
+	return c.Upload(ctx, parameters)
 }
 
-func (c *PipelineUploadClient) Upload(parameters *params.UploadPipelineParams) (*model.V1Pipeline,
+func (c *PipelineUploadClient) Upload(ctx context.Context, parameters *params.UploadPipelineParams) (*model.V1Pipeline,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineUploadService.UploadPipeline(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineUploadService.UploadPipeline(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.UploadPipelineDefault); ok {
@@ -85,9 +119,50 @@ func (c *PipelineUploadClient) Upload(parameters *params.UploadPipelineParams) (
 	return response.Payload, nil
 }
 
+// UploadReader uploads a pipeline read from a stream, e.g. a spec compiled in
+// memory, as a file of the name and content type. The content type defaults to
+// application/octet-stream, and the name of the pipeline to the name of the file.
+func (c *PipelineUploadClient) UploadReader(ctx context.Context, reader io.Reader, fileName string, contentType string,
+	parameters *params.UploadPipelineParams) (*model.V1Pipeline, error) {
+	query := url.Values{}
+	setOptionalQuery(query, "name", parameters.Name)
+	setOptionalQuery(query, "description", parameters.Description)
+	var pipeline model.V1Pipeline
+	err := c.uploadReader(ctx, "UploadPipeline", pipelineUploadPath, query, reader, fileName, contentType, &pipeline)
+	if err != nil {
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to upload pipeline. Params: '%v'", parameters),
+			fmt.Sprintf("Failed to upload pipeline"))
+	}
+	return &pipeline, nil
+}
+
 // UploadPipelineVersion uploads pipeline version from local file.
-func (c *PipelineUploadClient) UploadPipelineVersion(filePath string, parameters *params.UploadPipelineVersionParams) (*model.V1PipelineVersion,
+func (c *PipelineUploadClient) UploadPipelineVersion(ctx context.Context, filePath string, parameters *params.UploadPipelineVersionParams) (*model.V1PipelineVersion,
 	error) {
+	return c.UploadPipelineVersionWithProgress(ctx, filePath, parameters, nil)
+}
+
+// UploadPipelineVersionWithProgress uploads pipeline version from local file,
+// in resumable chunks if it is larger than ResumableUploadThreshold.
+func (c *PipelineUploadClient) UploadPipelineVersionWithProgress(ctx context.Context, filePath string,
+	parameters *params.UploadPipelineVersionParams, progress UploadProgressFunc) (*model.V1PipelineVersion, error) {
+	if large, err := isLargeFile(filePath); err != nil {
+		return nil, err
+	} else if large {
+		query := url.Values{}
+		setOptionalQuery(query, "name", parameters.Name)
+		setOptionalQuery(query, "description", parameters.Description)
+		setOptionalQuery(query, "pipelineid", parameters.Pipelineid)
+		var version model.V1PipelineVersion
+		if err := c.uploadPipelineInChunks(ctx, filePath, "UploadPipelineVersion", pipelineVersionUploadPath, query, progress, &version); err != nil {
+			return nil, util.NewUserError(err,
+				fmt.Sprintf("Failed to upload pipeline version. Params: '%v'", parameters),
+				fmt.Sprintf("Failed to upload pipeline version"))
+		}
+		return &version, nil
+	}
+
 	// Get file
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -97,13 +172,9 @@ func (c *PipelineUploadClient) UploadPipelineVersion(filePath string, parameters
 	defer file.Close()
 	parameters.Uploadfile = runtime.NamedReader(filePath, file)
 
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.PipelineUploadService.UploadPipelineVersion(parameters, PassThroughAuth)
+	response, err := c.apiClient.PipelineUploadService.UploadPipelineVersion(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.UploadPipelineVersionDefault); ok {
@@ -119,3 +190,78 @@ func (c *PipelineUploadClient) UploadPipelineVersion(filePath string, parameters
 
 	return response.Payload, nil
 }
+
+// uploadPipelineInChunks uploads a file in resumable chunks, then creates the
+// pipeline or pipeline version from the complete upload.
+func (c *PipelineUploadClient) uploadPipelineInChunks(ctx context.Context, filePath string, operationID string, path string, query url.Values,
+	progress UploadProgressFunc, result interface{}) error {
+	uploadID, err := c.uploadClient.Upload(ctx, filePath, "", "", progress)
+	if err != nil {
+		return err
+	}
+	query.Set("upload_id", uploadID)
+	return c.uploadClient.do(ctx, operationID, http.MethodPost, "apis/v1/"+path, query, nil, http.StatusOK, result)
+}
+
+// UploadPipelineVersionReader uploads a pipeline version read from a stream, as
+// a file of the name and content type.
+func (c *PipelineUploadClient) UploadPipelineVersionReader(ctx context.Context, reader io.Reader, fileName string,
+	contentType string, parameters *params.UploadPipelineVersionParams) (*model.V1PipelineVersion, error) {
+	query := url.Values{}
+	setOptionalQuery(query, "name", parameters.Name)
+	setOptionalQuery(query, "description", parameters.Description)
+	setOptionalQuery(query, "pipelineid", parameters.Pipelineid)
+	var version model.V1PipelineVersion
+	err := c.uploadReader(ctx, "UploadPipelineVersion", pipelineVersionUploadPath, query, reader, fileName, contentType, &version)
+	if err != nil {
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to upload pipeline version. Params: '%v'", parameters),
+			fmt.Sprintf("Failed to upload pipeline version"))
+	}
+	return &version, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// uploadReader streams a reader as the file of the multipart form of an upload.
+// The generated client can't, as it sniffs the content type of the files.
+func (c *PipelineUploadClient) uploadReader(ctx context.Context, operationID string, path string, query url.Values,
+	reader io.Reader, fileName string, contentType string, result interface{}) error {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	body, bodyWriter := io.Pipe()
+	form := multipart.NewWriter(bodyWriter)
+	go func() {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			pipelineUploadFieldName, quoteEscaper.Replace(filepath.Base(fileName))))
+		header.Set(pipelineUploadContentTypeKey, contentType)
+		part, err := form.CreatePart(header)
+		if err == nil {
+			_, err = io.Copy(part, reader)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		// The transport closes the body when the request fails, which stops the copy.
+		bodyWriter.CloseWithError(err)
+	}()
+	return c.uploadClient.doWithContentType(ctx, operationID, http.MethodPost, "apis/v1/"+path, query, body,
+		form.FormDataContentType(), http.StatusOK, result)
+}
+
+func isLargeFile(filePath string) (bool, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return false, util.NewUserErrorWithSingleMessage(err,
+			fmt.Sprintf("Failed to open file '%s'", filePath))
+	}
+	return info.Size() > ResumableUploadThreshold, nil
+}
+
+func setOptionalQuery(query url.Values, key string, value *string) {
+	if value != nil {
+		query.Set(key, *value)
+	}
+}
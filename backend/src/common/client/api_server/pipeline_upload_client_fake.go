@@ -1,6 +1,7 @@
 package api_server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -35,7 +36,7 @@ func NewPipelineUploadClientFake() *PipelineUploadClientFake {
 	return &PipelineUploadClientFake{}
 }
 
-func (c *PipelineUploadClientFake) UploadFile(filePath string,
+func (c *PipelineUploadClientFake) UploadFile(ctx context.Context, filePath string,
 	parameters *params.UploadPipelineParams) (*model.V1Pipeline, error) {
 	switch filePath {
 	case FileForClientErrorTest:
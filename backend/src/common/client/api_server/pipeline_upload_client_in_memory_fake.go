@@ -0,0 +1,123 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	model "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_model"
+	"google.golang.org/grpc/codes"
+)
+
+// InMemoryPipelineUploadClientFake is a PipelineUploadInterface storing the
+// pipelines and pipeline versions uploaded in memory. Like the API server, it
+// names the pipelines after their files by default, and rejects the names taken.
+type InMemoryPipelineUploadClientFake struct {
+	FakeBehaviors
+	mutex     sync.Mutex
+	pipelines []*model.V1Pipeline
+	versions  []*model.V1PipelineVersion
+	nextID    int
+}
+
+func NewInMemoryPipelineUploadClientFake() *InMemoryPipelineUploadClientFake {
+	return &InMemoryPipelineUploadClientFake{}
+}
+
+func (c *InMemoryPipelineUploadClientFake) UploadFile(ctx context.Context, filePath string,
+	parameters *params.UploadPipelineParams) (*model.V1Pipeline, error) {
+	if err := c.failure("UploadFile"); err != nil {
+		return nil, err
+	}
+	name, err := uploadedName(filePath, parameters.Name)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, pipeline := range c.pipelines {
+		if pipeline.Name == name {
+			return nil, NewFakeStatusError(codes.AlreadyExists, "Pipeline %s already exists", name)
+		}
+	}
+	c.nextID++
+	pipeline := &model.V1Pipeline{
+		ID:        fmt.Sprintf("pipeline-%d", c.nextID),
+		CreatedAt: strfmt.NewDateTime(),
+		Name:      name,
+	}
+	if parameters.Description != nil {
+		pipeline.Description = *parameters.Description
+	}
+	c.pipelines = append(c.pipelines, pipeline)
+	result := *pipeline
+	return &result, nil
+}
+
+func (c *InMemoryPipelineUploadClientFake) UploadPipelineVersion(ctx context.Context, filePath string,
+	parameters *params.UploadPipelineVersionParams) (*model.V1PipelineVersion, error) {
+	if err := c.failure("UploadPipelineVersion"); err != nil {
+		return nil, err
+	}
+	name, err := uploadedName(filePath, parameters.Name)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	pipelineID := ""
+	if parameters.Pipelineid != nil {
+		pipelineID = *parameters.Pipelineid
+	}
+	found := false
+	for _, pipeline := range c.pipelines {
+		found = found || pipeline.ID == pipelineID
+	}
+	if !found {
+		return nil, NewFakeStatusError(codes.NotFound, "Pipeline %s not found", pipelineID)
+	}
+	c.nextID++
+	version := &model.V1PipelineVersion{
+		ID:        fmt.Sprintf("pipeline-version-%d", c.nextID),
+		CreatedAt: strfmt.NewDateTime(),
+		Name:      name,
+		ResourceReferences: []*model.V1ResourceReference{{
+			Key:          &model.V1ResourceKey{Type: model.V1ResourceTypePIPELINE, ID: pipelineID},
+			Relationship: model.V1RelationshipOWNER,
+		}},
+	}
+	c.versions = append(c.versions, version)
+	result := *version
+	return &result, nil
+}
+
+// Pipelines returns the pipelines uploaded.
+func (c *InMemoryPipelineUploadClientFake) Pipelines() []*model.V1Pipeline {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]*model.V1Pipeline(nil), c.pipelines...)
+}
+
+// PipelineVersions returns the pipeline versions uploaded.
+func (c *InMemoryPipelineUploadClientFake) PipelineVersions() []*model.V1PipelineVersion {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]*model.V1PipelineVersion(nil), c.versions...)
+}
+
+// uploadedName returns the name of an upload, the name of its file by default.
+// The file must exist, as it does for the real client.
+func uploadedName(filePath string, name *string) (string, error) {
+	if _, err := os.Stat(filePath); err != nil {
+		return "", NewFakeStatusError(codes.InvalidArgument, "Failed to open file '%s'", filePath)
+	}
+	if name != nil && *name != "" {
+		return *name, nil
+	}
+	return strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath)), nil
+}
@@ -0,0 +1,82 @@
+package api_server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	model "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPipelineUploadClient(t *testing.T, server *httptest.Server) *PipelineUploadClient {
+	client, err := NewPipelineUploadClient(nil, false, WithEndpoint(server.URL))
+	require.Nil(t, err)
+	return client
+}
+
+func TestPipelineUploadClient_UploadReader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/apis/v1/pipelines/upload", r.URL.Path)
+		assert.Equal(t, "hello-world", r.URL.Query().Get("name"))
+		file, header, err := r.FormFile(pipelineUploadFieldName)
+		if !assert.Nil(t, err) {
+			return
+		}
+		defer file.Close()
+		content, _ := ioutil.ReadAll(file)
+		assert.Equal(t, "pipeline.yaml", header.Filename)
+		assert.Equal(t, "application/yaml", header.Header.Get("Content-Type"))
+		assert.Equal(t, "apiVersion: tekton.dev/v1", string(content))
+		writeTestJSON(t, w, http.StatusOK, &model.V1Pipeline{ID: "pipeline1", Name: "hello-world"})
+	}))
+	defer server.Close()
+
+	client := newTestPipelineUploadClient(t, server)
+	pipeline, err := client.UploadReader(context.Background(), strings.NewReader("apiVersion: tekton.dev/v1"),
+		"build/pipeline.yaml", "application/yaml", &params.UploadPipelineParams{Name: util.StringPointer("hello-world")})
+	assert.Nil(t, err)
+	assert.Equal(t, "pipeline1", pipeline.ID)
+	assert.Equal(t, "hello-world", pipeline.Name)
+}
+
+func TestPipelineUploadClient_UploadPipelineVersionReader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apis/v1/pipelines/upload_version", r.URL.Path)
+		assert.Equal(t, "pipeline1", r.URL.Query().Get("pipelineid"))
+		file, header, err := r.FormFile(pipelineUploadFieldName)
+		if !assert.Nil(t, err) {
+			return
+		}
+		file.Close()
+		// The content type defaults to a stream of bytes.
+		assert.Equal(t, "application/octet-stream", header.Header.Get("Content-Type"))
+		writeTestJSON(t, w, http.StatusOK, &model.V1PipelineVersion{ID: "version1"})
+	}))
+	defer server.Close()
+
+	client := newTestPipelineUploadClient(t, server)
+	version, err := client.UploadPipelineVersionReader(context.Background(), strings.NewReader("{}"), "pipeline.json", "",
+		&params.UploadPipelineVersionParams{Pipelineid: util.StringPointer("pipeline1")})
+	assert.Nil(t, err)
+	assert.Equal(t, "version1", version.ID)
+}
+
+func TestPipelineUploadClient_UploadReaderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(t, w, http.StatusBadRequest, &uploadError{ErrorMessage: "Invalid pipeline spec"})
+	}))
+	defer server.Close()
+
+	client := newTestPipelineUploadClient(t, server)
+	_, err := client.UploadReader(context.Background(), strings.NewReader("not yaml"), "pipeline.yaml", "",
+		&params.UploadPipelineParams{})
+	assert.Contains(t, err.Error(), "Invalid pipeline spec")
+}
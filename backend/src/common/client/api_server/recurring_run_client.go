@@ -0,0 +1,224 @@
+package api_server
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	apiclient "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/recurring_run_client"
+	params "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/recurring_run_client/recurring_run_service"
+	model "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/recurring_run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"golang.org/x/net/context"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// RecurringRunInterface is the client of the v2beta1 RecurringRunService, which
+// replaces the jobs of the v1 API.
+type RecurringRunInterface interface {
+	Create(ctx context.Context, params *params.CreateRecurringRunParams) (*model.V2beta1RecurringRun, error)
+	Get(ctx context.Context, params *params.GetRecurringRunParams) (*model.V2beta1RecurringRun, error)
+	Delete(ctx context.Context, params *params.DeleteRecurringRunParams) error
+	Enable(ctx context.Context, params *params.EnableRecurringRunParams) error
+	Disable(ctx context.Context, params *params.DisableRecurringRunParams) error
+	List(ctx context.Context, params *params.ListRecurringRunsParams) ([]*model.V2beta1RecurringRun, int, string, error)
+	ListAll(ctx context.Context, params *params.ListRecurringRunsParams, maxResultSize int) (
+		[]*model.V2beta1RecurringRun, error)
+}
+
+type RecurringRunClient struct {
+	apiClient *apiclient.RecurringRun
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+func NewRecurringRunClient(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
+	*RecurringRunClient, error) {
+
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
+
+	return &RecurringRunClient{
+		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
+	}, nil
+}
+
+func (c *RecurringRunClient) Create(ctx context.Context, parameters *params.CreateRecurringRunParams) (
+	*model.V2beta1RecurringRun, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RecurringRunService.CreateRecurringRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.CreateRecurringRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to create recurring run. Params: '%+v'. Body: '%+v'", parameters, parameters.Body),
+			fmt.Sprintf("Failed to create recurring run '%v'", parameters.Body.DisplayName))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *RecurringRunClient) Get(ctx context.Context, parameters *params.GetRecurringRunParams) (
+	*model.V2beta1RecurringRun, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RecurringRunService.GetRecurringRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.GetRecurringRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to get recurring run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to get recurring run '%v'", parameters.RecurringRunID))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *RecurringRunClient) Delete(ctx context.Context, parameters *params.DeleteRecurringRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RecurringRunService.DeleteRecurringRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.DeleteRecurringRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to delete recurring run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to delete recurring run '%v'", parameters.RecurringRunID))
+	}
+
+	return nil
+}
+
+func (c *RecurringRunClient) Enable(ctx context.Context, parameters *params.EnableRecurringRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RecurringRunService.EnableRecurringRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.EnableRecurringRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to enable recurring run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to enable recurring run '%v'", parameters.RecurringRunID))
+	}
+
+	return nil
+}
+
+func (c *RecurringRunClient) Disable(ctx context.Context, parameters *params.DisableRecurringRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RecurringRunService.DisableRecurringRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.DisableRecurringRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to disable recurring run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to disable recurring run '%v'", parameters.RecurringRunID))
+	}
+
+	return nil
+}
+
+func (c *RecurringRunClient) List(ctx context.Context, parameters *params.ListRecurringRunsParams) (
+	[]*model.V2beta1RecurringRun, int, string, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RecurringRunService.ListRecurringRuns(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.ListRecurringRunsDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, 0, "", util.NewUserError(err,
+			fmt.Sprintf("Failed to list recurring runs. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to list recurring runs"))
+	}
+
+	return response.Payload.RecurringRuns, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
+}
+
+func (c *RecurringRunClient) ListAll(ctx context.Context, parameters *params.ListRecurringRunsParams,
+	maxResultSize int) ([]*model.V2beta1RecurringRun, error) {
+	if maxResultSize < 0 {
+		maxResultSize = 0
+	}
+
+	allResults := make([]*model.V2beta1RecurringRun, 0)
+	it := NewRecurringRunIterator(ctx, c, parameters)
+	for len(allResults) < maxResultSize {
+		result, err := it.Next()
+		if err == Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		allResults = append(allResults, result)
+	}
+
+	return allResults, nil
+}
+
+// RecurringRunIterator walks the recurring runs of a list request, fetching the
+// pages lazily.
+type RecurringRunIterator struct {
+	pageIterator
+	page []*model.V2beta1RecurringRun
+}
+
+// NewRecurringRunIterator creates an iterator over the recurring runs matching
+// the parameters, starting from their page token.
+func NewRecurringRunIterator(ctx context.Context, client RecurringRunInterface,
+	parameters *params.ListRecurringRunsParams) *RecurringRunIterator {
+	it := &RecurringRunIterator{}
+	if parameters.PageToken != nil {
+		it.pageToken = *parameters.PageToken
+	}
+	it.fetch = func(pageToken string) (int, string, error) {
+		parameters.PageToken = util.StringPointer(pageToken)
+		results, _, nextPageToken, err := client.List(ctx, parameters)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = results
+		return len(results), nextPageToken, nil
+	}
+	return it
+}
+
+// Next returns the next recurring run, or Done once all were walked.
+func (it *RecurringRunIterator) Next() (*model.V2beta1RecurringRun, error) {
+	index, err := it.next()
+	if err != nil {
+		return nil, err
+	}
+	return it.page[index], nil
+}
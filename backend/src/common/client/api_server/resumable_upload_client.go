@@ -0,0 +1,311 @@
+package api_server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// Files larger than the threshold are uploaded in resumable chunks.
+	ResumableUploadThreshold int64 = 32 << 20
+
+	resumableUploadPath          = "apis/v1/uploads"
+	resumableUploadChunkSize     = 16 << 20
+	resumableUploadMaxRetries    = 5
+	resumableUploadRetryInterval = time.Second
+	artifactUploadPath           = "apis/v1/runs/%s/nodes/%s/artifacts/%s/upload"
+)
+
+// UploadProgressFunc is called after each chunk of an upload is stored, with the
+// number of bytes uploaded so far and the size of the file.
+type UploadProgressFunc func(uploaded int64, total int64)
+
+// resumableUpload is the JSON of the Upload message, whose int64 fields are
+// strings.
+type resumableUpload struct {
+	UploadID     string `json:"id,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	RunID        string `json:"run_id,omitempty"`
+	FileName     string `json:"file_name"`
+	Size         int64  `json:"size,string"`
+	UploadedSize int64  `json:"uploaded_size,omitempty,string"`
+}
+
+// uploadError is the error response of the chunk uploads, or of the gRPC gateway
+// for the other requests.
+type uploadError struct {
+	ErrorMessage string `json:"error_message"`
+	Message      string `json:"message"`
+	Retryable    bool   `json:"retryable"`
+}
+
+// uploadStatusError is an error response of the API server.
+type uploadStatusError struct {
+	code      int
+	message   string
+	retryable bool
+}
+
+func (e *uploadStatusError) Error() string {
+	return CreateErrorFromAPIStatus(e.message, int32(e.code)).Error()
+}
+
+// isRetryableUploadError returns whether a request may succeed when sent again,
+// which is the case of the connection errors and the transient server errors.
+func isRetryableUploadError(err error) bool {
+	statusErr, ok := errors.Cause(err).(*uploadStatusError)
+	if !ok {
+		return true
+	}
+	return statusErr.retryable || statusErr.code >= http.StatusInternalServerError ||
+		statusErr.code == http.StatusTooManyRequests || statusErr.code == http.StatusRequestTimeout
+}
+
+// ResumableUploadClient uploads large pipeline packages and artifacts in chunks.
+// A chunk that fails is sent again from the size the API server got, so a flaky
+// connection doesn't restart the upload.
+type ResumableUploadClient struct {
+	httpClient *http.Client
+	baseURL    string
+	chunkSize  int64
+	maxRetries int
+	retryWait  time.Duration
+	// tokenProvider authenticates the requests, if set.
+	tokenProvider TokenProvider
+}
+
+func NewResumableUploadClient(clientConfig clientcmd.ClientConfig, opts ...ClientOption) (*ResumableUploadClient, error) {
+	options := newClientOptions(opts)
+	httpClient, endpoint, err := newHTTPClient(clientConfig, options)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = newMiddlewareTransport(httpClient.Transport, options.middlewares)
+	return &ResumableUploadClient{
+		httpClient:    httpClient,
+		baseURL:       endpoint.baseURL,
+		chunkSize:     resumableUploadChunkSize,
+		maxRetries:    resumableUploadMaxRetries,
+		retryWait:     resumableUploadRetryInterval,
+		tokenProvider: options.tokenProvider,
+	}, nil
+}
+
+// Upload uploads a pipeline package of the namespace, or an artifact of the run
+// if runID is set, and returns the id of the complete upload.
+func (c *ResumableUploadClient) Upload(ctx context.Context, filePath string, namespace string, runID string,
+	progress UploadProgressFunc) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", util.NewUserErrorWithSingleMessage(err, fmt.Sprintf("Failed to open file '%s'", filePath))
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", util.NewUserErrorWithSingleMessage(err, fmt.Sprintf("Failed to read file '%s'", filePath))
+	}
+
+	request, err := json.Marshal(&resumableUpload{
+		Namespace: namespace,
+		RunID:     runID,
+		FileName:  filepath.Base(filePath),
+		Size:      info.Size(),
+	})
+	if err != nil {
+		return "", util.NewUserErrorWithSingleMessage(err, fmt.Sprintf("Failed to start the upload of file '%s'", filePath))
+	}
+	var upload resumableUpload
+	err = c.doWithRetry(ctx, func() error {
+		return c.doWithContentType(ctx, "CreateUpload", http.MethodPost, resumableUploadPath, nil, bytes.NewReader(request),
+			"application/json", http.StatusOK, &upload)
+	})
+	if err != nil {
+		return "", util.NewUserErrorWithSingleMessage(err, fmt.Sprintf("Failed to start the upload of file '%s'", filePath))
+	}
+	if err := c.resume(ctx, &upload, file, progress); err != nil {
+		return "", err
+	}
+	return upload.UploadID, nil
+}
+
+// Resume continues an upload of a file that failed, from the size the API server
+// got, and returns the id of the complete upload.
+func (c *ResumableUploadClient) Resume(ctx context.Context, uploadID string, filePath string, progress UploadProgressFunc) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", util.NewUserErrorWithSingleMessage(err, fmt.Sprintf("Failed to open file '%s'", filePath))
+	}
+	defer file.Close()
+	upload, err := c.getUpload(ctx, uploadID)
+	if err != nil {
+		return "", util.NewUserErrorWithSingleMessage(err, fmt.Sprintf("Failed to get upload '%s'", uploadID))
+	}
+	if err := c.resume(ctx, upload, file, progress); err != nil {
+		return "", err
+	}
+	return upload.UploadID, nil
+}
+
+// UploadArtifact uploads a file as an artifact of a run, replacing its content.
+func (c *ResumableUploadClient) UploadArtifact(ctx context.Context, filePath string, runID string, nodeID string, artifactName string,
+	progress UploadProgressFunc) error {
+	uploadID, err := c.Upload(ctx, filePath, "", runID, progress)
+	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	query.Set("upload_id", uploadID)
+	path := fmt.Sprintf(artifactUploadPath, url.PathEscape(runID), url.PathEscape(nodeID), url.PathEscape(artifactName))
+	err = c.doWithRetry(ctx, func() error {
+		return c.do(ctx, "CompleteArtifactUpload", http.MethodPost, path, query, nil, http.StatusOK, nil)
+	})
+	if err != nil {
+		return util.NewUserErrorWithSingleMessage(err,
+			fmt.Sprintf("Failed to store upload '%s' as artifact '%s' of run '%s'", uploadID, artifactName, runID))
+	}
+	return nil
+}
+
+// DeleteUpload abandons an upload. The uploads that aren't deleted expire.
+func (c *ResumableUploadClient) DeleteUpload(ctx context.Context, uploadID string) error {
+	err := c.do(ctx, "DeleteUpload", http.MethodDelete, resumableUploadPath+"/"+url.PathEscape(uploadID), nil, nil, http.StatusOK, nil)
+	if err != nil {
+		return util.NewUserErrorWithSingleMessage(err, fmt.Sprintf("Failed to delete upload '%s'", uploadID))
+	}
+	return nil
+}
+
+func (c *ResumableUploadClient) resume(ctx context.Context, upload *resumableUpload, file *os.File, progress UploadProgressFunc) error {
+	retries := 0
+	for upload.UploadedSize < upload.Size {
+		size := c.chunkSize
+		if remaining := upload.Size - upload.UploadedSize; remaining < size {
+			size = remaining
+		}
+		query := url.Values{}
+		query.Set("offset", strconv.FormatInt(upload.UploadedSize, 10))
+		chunk := io.NewSectionReader(file, upload.UploadedSize, size)
+		var next resumableUpload
+		err := c.do(ctx, "UploadChunk", http.MethodPut, resumableUploadPath+"/"+url.PathEscape(upload.UploadID), query, chunk,
+			http.StatusOK, &next)
+		if err != nil {
+			if !isRetryableUploadError(err) || retries >= c.maxRetries {
+				return util.NewUserErrorWithSingleMessage(err, fmt.Sprintf(
+					"Failed to upload file '%s' at offset %v, resume upload '%s' later", file.Name(), upload.UploadedSize, upload.UploadID))
+			}
+			retries++
+			select {
+			case <-time.After(c.retryWait * time.Duration(retries)):
+			case <-ctx.Done():
+				return util.NewUserErrorWithSingleMessage(ctx.Err(), fmt.Sprintf(
+					"Failed to upload file '%s' at offset %v, resume upload '%s' later", file.Name(), upload.UploadedSize, upload.UploadID))
+			}
+			// The chunk may have been stored though its response was lost.
+			if synced, err := c.getUpload(ctx, upload.UploadID); err == nil {
+				*upload = *synced
+			}
+			continue
+		}
+		retries = 0
+		*upload = next
+		if progress != nil {
+			progress(upload.UploadedSize, upload.Size)
+		}
+	}
+	return nil
+}
+
+func (c *ResumableUploadClient) getUpload(ctx context.Context, uploadID string) (*resumableUpload, error) {
+	var upload resumableUpload
+	err := c.doWithRetry(ctx, func() error {
+		return c.do(ctx, "GetUpload", http.MethodGet, resumableUploadPath+"/"+url.PathEscape(uploadID), nil, nil, http.StatusOK, &upload)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+func (c *ResumableUploadClient) doWithRetry(ctx context.Context, request func() error) error {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = c.retryWait
+	return backoff.Retry(func() error {
+		err := request()
+		if err != nil && !isRetryableUploadError(err) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, backoff.WithContext(backoff.WithMaxRetries(b, uint64(c.maxRetries)), ctx))
+}
+
+func (c *ResumableUploadClient) do(ctx context.Context, operationID string, method string, path string, query url.Values, body io.Reader,
+	expectedCode int, result interface{}) error {
+	contentType := ""
+	if body != nil {
+		contentType = "application/octet-stream"
+	}
+	return c.doWithContentType(ctx, operationID, method, path, query, body, contentType, expectedCode, result)
+}
+
+func (c *ResumableUploadClient) doWithContentType(ctx context.Context, operationID string, method string, path string,
+	query url.Values, body io.Reader, contentType string, expectedCode int, result interface{}) error {
+	requestURL := c.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, requestURL, body)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create the request")
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.tokenProvider != nil {
+		token, err := c.tokenProvider.Token()
+		if err != nil {
+			return errors.Wrapf(err, "Failed to get a token")
+		}
+		req.Header.Set(authorizationHeader, bearerTokenPrefix+token)
+	}
+	resp, err := c.httpClient.Do(req.WithContext(withOperationID(ctx, operationID)))
+	if err != nil {
+		return CreateErrorCouldNotRecoverAPIStatus(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != expectedCode {
+		statusErr := &uploadStatusError{code: resp.StatusCode, message: resp.Status}
+		var apiErr uploadError
+		if content, err := ioutil.ReadAll(resp.Body); err == nil && json.Unmarshal(content, &apiErr) == nil {
+			if apiErr.ErrorMessage != "" {
+				statusErr.message = apiErr.ErrorMessage
+			} else if apiErr.Message != "" {
+				statusErr.message = apiErr.Message
+			}
+			statusErr.retryable = apiErr.Retryable
+		}
+		return statusErr
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrapf(err, "Failed to parse the response")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package api_server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testUploadServer stores an upload sent in chunks, losing the response of the
+// chunk at lostChunkOffset once.
+type testUploadServer struct {
+	t               *testing.T
+	mutex           sync.Mutex
+	upload          resumableUpload
+	content         []byte
+	lostChunkOffset int64
+	requests        []string
+}
+
+func (s *testUploadServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/apis/v1/uploads":
+		assert.Nil(s.t, json.NewDecoder(r.Body).Decode(&s.upload))
+		s.upload.UploadID = "upload1"
+		writeTestJSON(s.t, w, http.StatusOK, &s.upload)
+	case r.Method == http.MethodGet && r.URL.Path == "/apis/v1/uploads/upload1":
+		writeTestJSON(s.t, w, http.StatusOK, &s.upload)
+	case r.Method == http.MethodPut && r.URL.Path == "/apis/v1/uploads/upload1":
+		offset, _ := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+		if offset != s.upload.UploadedSize {
+			writeTestJSON(s.t, w, http.StatusConflict, &uploadError{ErrorMessage: "Unexpected offset"})
+			return
+		}
+		chunk, _ := ioutil.ReadAll(r.Body)
+		s.content = append(s.content, chunk...)
+		s.upload.UploadedSize += int64(len(chunk))
+		if offset == s.lostChunkOffset {
+			s.lostChunkOffset = -1
+			writeTestJSON(s.t, w, http.StatusBadGateway, &uploadError{ErrorMessage: "upstream reset"})
+			return
+		}
+		writeTestJSON(s.t, w, http.StatusOK, &s.upload)
+	case r.Method == http.MethodPost && r.URL.Path == "/apis/v1/runs/run1/nodes/node1/artifacts/model/upload":
+		assert.Equal(s.t, "upload1", r.URL.Query().Get("upload_id"))
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeTestJSON(s.t, w, http.StatusNotFound, &uploadError{ErrorMessage: "Not found"})
+	}
+}
+
+func newTestResumableUploadClient(t *testing.T, server *httptest.Server) *ResumableUploadClient {
+	client, err := NewResumableUploadClient(nil, WithEndpoint(server.URL))
+	require.Nil(t, err)
+	client.chunkSize = 4
+	client.retryWait = time.Millisecond
+	return client
+}
+
+func writeTestFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "upload")
+	require.Nil(t, err)
+	path := filepath.Join(dir, "model.bin")
+	require.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestResumableUploadClient_UploadArtifact(t *testing.T) {
+	uploadServer := &testUploadServer{t: t, lostChunkOffset: 4}
+	server := httptest.NewServer(uploadServer)
+	defer server.Close()
+	path, cleanup := writeTestFile(t, "0123456789")
+	defer cleanup()
+
+	var progress []int64
+	client := newTestResumableUploadClient(t, server)
+	err := client.UploadArtifact(context.Background(), path, "run1", "node1", "model", func(uploaded int64, total int64) {
+		assert.Equal(t, int64(10), total)
+		progress = append(progress, uploaded)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "0123456789", string(uploadServer.content))
+	assert.Equal(t, resumableUpload{UploadID: "upload1", RunID: "run1", FileName: "model.bin", Size: 10, UploadedSize: 10},
+		uploadServer.upload)
+	// The chunk whose response was lost isn't sent again, the upload continues
+	// from the size the server got.
+	assert.Equal(t, []string{
+		"POST /apis/v1/uploads",
+		"PUT /apis/v1/uploads/upload1?offset=0",
+		"PUT /apis/v1/uploads/upload1?offset=4",
+		"GET /apis/v1/uploads/upload1",
+		"PUT /apis/v1/uploads/upload1?offset=8",
+		"POST /apis/v1/runs/run1/nodes/node1/artifacts/model/upload?upload_id=upload1",
+	}, uploadServer.requests)
+	assert.Equal(t, []int64{4, 10}, progress)
+}
+
+func TestResumableUploadClient_Resume(t *testing.T) {
+	uploadServer := &testUploadServer{t: t, lostChunkOffset: -1}
+	uploadServer.upload = resumableUpload{UploadID: "upload1", FileName: "model.bin", Size: 10, UploadedSize: 4}
+	uploadServer.content = []byte("0123")
+	server := httptest.NewServer(uploadServer)
+	defer server.Close()
+	path, cleanup := writeTestFile(t, "0123456789")
+	defer cleanup()
+
+	client := newTestResumableUploadClient(t, server)
+	uploadID, err := client.Resume(context.Background(), "upload1", path, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "upload1", uploadID)
+	assert.Equal(t, "0123456789", string(uploadServer.content))
+
+	_, err = client.Resume(context.Background(), "upload2", path, nil)
+	assert.Contains(t, err.Error(), "Not found")
+}
+
+func TestResumableUploadClient_UploadFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeTestJSON(t, w, http.StatusForbidden, &uploadError{ErrorMessage: "Permission denied"})
+	}))
+	defer server.Close()
+	path, cleanup := writeTestFile(t, "0123456789")
+	defer cleanup()
+
+	// The errors which aren't transient fail the upload without retries.
+	client := newTestResumableUploadClient(t, server)
+	_, err := client.Upload(context.Background(), path, "ns1", "", nil)
+	assert.Contains(t, err.Error(), "Permission denied")
+	assert.False(t, isRetryableUploadError(&uploadStatusError{code: http.StatusForbidden}))
+	assert.True(t, isRetryableUploadError(&uploadStatusError{code: http.StatusServiceUnavailable}))
+	assert.True(t, isRetryableUploadError(&uploadStatusError{code: http.StatusConflict, retryable: true}))
+}
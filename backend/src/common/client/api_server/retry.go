@@ -0,0 +1,152 @@
+package api_server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff"
+)
+
+// RetryPolicy configures how the clients retry the requests that fail with a
+// transient error, such as the 502 and 503 returned by an ingress while the API
+// server restarts. Only the idempotent requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried. Zero disables the retries.
+	MaxRetries int
+	// InitialInterval is the wait before the first retry, doubled before each next one.
+	InitialInterval time.Duration
+	// MaxInterval caps the wait between two retries, including a Retry-After.
+	MaxInterval time.Duration
+	// Jitter randomizes the waits by up to this fraction of their length, so
+	// the clients that failed together don't retry together.
+	Jitter float64
+	// RetryableStatusCodes are the response statuses retried.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy is the retry policy of the clients created without one.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:      4,
+	InitialInterval: 500 * time.Millisecond,
+	MaxInterval:     10 * time.Second,
+	Jitter:          0.5,
+	RetryableStatusCodes: []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+// NoRetryPolicy disables the retries.
+var NoRetryPolicy = RetryPolicy{}
+
+// WithRetryPolicy sets the retry policy of a client.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// retryTransport retries the idempotent requests failing with a retryable status
+// or a connection error, with an exponential backoff honoring Retry-After.
+type retryTransport struct {
+	transport http.RoundTripper
+	policy    RetryPolicy
+}
+
+func newRetryTransport(transport http.RoundTripper, policy RetryPolicy) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if policy.MaxRetries <= 0 {
+		return transport
+	}
+	return &retryTransport{transport: transport, policy: policy}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotentRequest(req) {
+		return t.transport.RoundTrip(req)
+	}
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = t.policy.InitialInterval
+	b.MaxInterval = t.policy.MaxInterval
+	b.RandomizationFactor = t.policy.Jitter
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	for retries := 0; ; retries++ {
+		resp, err := t.transport.RoundTrip(req)
+		if retries >= t.policy.MaxRetries || !t.isRetryable(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+		wait := b.NextBackOff()
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				wait = retryAfter
+			}
+			if wait > t.policy.MaxInterval {
+				wait = t.policy.MaxInterval
+			}
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func (t *retryTransport) isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	for _, code := range t.policy.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdempotentRequest returns whether a request can be sent again without side
+// effects, and whether its body, if any, can be read again.
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// parseRetryAfter parses a Retry-After header, in seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,144 @@
+package api_server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxRetries:           3,
+	InitialInterval:      time.Millisecond,
+	MaxInterval:          10 * time.Millisecond,
+	RetryableStatusCodes: []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+}
+
+func TestRetryTransport_RetriesTransientErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			// The wait is capped by the max interval of the policy.
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Running"))
+	}))
+	defer server.Close()
+
+	client := newTestRunClient(t, server, WithRetryPolicy(testRetryPolicy))
+	start := time.Now()
+	runDetail, _, err := client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "run1", runDetail.Run.ID)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	assert.True(t, time.Since(start) < 10*time.Second)
+}
+
+func TestRetryTransport_GivesUp(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestRunClient(t, server, WithRetryPolicy(testRetryPolicy))
+	_, _, err := client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(testRetryPolicy.MaxRetries+1), atomic.LoadInt32(&attempts))
+
+	// The requests aren't retried without a retry policy.
+	atomic.StoreInt32(&attempts, 0)
+	client = newTestRunClient(t, server, WithRetryPolicy(NoRetryPolicy))
+	_, _, err = client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryTransport_DoesNotRetryCreations(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestRunClient(t, server, WithRetryPolicy(testRetryPolicy))
+	_, _, err := client.Create(context.Background(), &params.CreateRunParams{Body: &runmodel.V1Run{Name: "run1"}})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryTransport_ReplaysBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, bytes.NewReader([]byte(`{"name":"p1"}`)))
+	assert.Nil(t, err)
+	resp, err := newRetryTransport(http.DefaultTransport, testRetryPolicy).RoundTrip(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{`{"name":"p1"}`, `{"name":"p1"}`}, bodies)
+}
+
+func TestRetryTransport_StopsWhenCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	policy := testRetryPolicy
+	policy.MaxRetries = 100
+	policy.InitialInterval, policy.MaxInterval = time.Hour, time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.Nil(t, err)
+	_, err = newRetryTransport(http.DefaultTransport, policy).RoundTrip(req.WithContext(ctx))
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestIsIdempotentRequest(t *testing.T) {
+	get, _ := http.NewRequest(http.MethodGet, "http://kfp", nil)
+	assert.True(t, isIdempotentRequest(get))
+	put, _ := http.NewRequest(http.MethodPut, "http://kfp", bytes.NewReader([]byte("{}")))
+	assert.True(t, isIdempotentRequest(put))
+	// The bodies which can't be read again can't be sent again.
+	put, _ = http.NewRequest(http.MethodPut, "http://kfp", ioutil.NopCloser(bytes.NewReader([]byte("{}"))))
+	assert.False(t, isIdempotentRequest(put))
+	post, _ := http.NewRequest(http.MethodPost, "http://kfp", nil)
+	assert.False(t, isIdempotentRequest(post))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("2")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, wait)
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
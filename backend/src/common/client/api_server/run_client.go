@@ -2,7 +2,9 @@ package api_server
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	apiclient "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client"
 	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
@@ -16,43 +18,41 @@ import (
 )
 
 type RunInterface interface {
-	Archive(params *params.ArchiveRunParams) error
-	Get(params *params.GetRunParams) (*model.V1RunDetail, *workflowapi.PipelineRun, error)
-	List(params *params.ListRunsParams) ([]*model.V1Run, int, string, error)
-	ListAll(params *params.ListRunsParams, maxResultSize int) ([]*model.V1Run, error)
-	Unarchive(params *params.UnarchiveRunParams) error
-	Terminate(params *params.TerminateRunParams) error
+	Archive(ctx context.Context, params *params.ArchiveRunParams) error
+	Get(ctx context.Context, params *params.GetRunParams) (*model.V1RunDetail, *workflowapi.PipelineRun, error)
+	List(ctx context.Context, params *params.ListRunsParams) ([]*model.V1Run, int, string, error)
+	ListAll(ctx context.Context, params *params.ListRunsParams, maxResultSize int) ([]*model.V1Run, error)
+	Unarchive(ctx context.Context, params *params.UnarchiveRunParams) error
+	Terminate(ctx context.Context, params *params.TerminateRunParams) error
 }
 
 type RunClient struct {
 	apiClient *apiclient.Run
+	authInfo  runtime.ClientAuthInfoWriter
 }
 
-func NewRunClient(clientConfig clientcmd.ClientConfig, debug bool) (
+func NewRunClient(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
 	*RunClient, error) {
 
-	runtime, err := NewHTTPRuntime(clientConfig, debug)
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	apiClient := apiclient.New(runtime, strfmt.Default)
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
 
 	// Creating upload client
 	return &RunClient{
 		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
 	}, nil
 }
 
-func (c *RunClient) Create(parameters *params.CreateRunParams) (*model.V1RunDetail,
+func (c *RunClient) Create(ctx context.Context, parameters *params.CreateRunParams) (*model.V1RunDetail,
 	*workflowapi.PipelineRun, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.RunService.CreateRun(parameters, PassThroughAuth)
+	response, err := c.apiClient.RunService.CreateRun(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetRunDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -78,15 +78,11 @@ func (c *RunClient) Create(parameters *params.CreateRunParams) (*model.V1RunDeta
 	return response.Payload, &workflow, nil
 }
 
-func (c *RunClient) Get(parameters *params.GetRunParams) (*model.V1RunDetail,
+func (c *RunClient) Get(ctx context.Context, parameters *params.GetRunParams) (*model.V1RunDetail,
 	*workflowapi.PipelineRun, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.RunService.GetRun(parameters, PassThroughAuth)
+	response, err := c.apiClient.RunService.GetRun(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.GetRunDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -112,14 +108,10 @@ func (c *RunClient) Get(parameters *params.GetRunParams) (*model.V1RunDetail,
 	return response.Payload, &workflow, nil
 }
 
-func (c *RunClient) Archive(parameters *params.ArchiveRunParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *RunClient) Archive(ctx context.Context, parameters *params.ArchiveRunParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.RunService.ArchiveRun(parameters, PassThroughAuth)
+	_, err := c.apiClient.RunService.ArchiveRun(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.ListRunsDefault); ok {
@@ -136,14 +128,10 @@ func (c *RunClient) Archive(parameters *params.ArchiveRunParams) error {
 	return nil
 }
 
-func (c *RunClient) Unarchive(parameters *params.UnarchiveRunParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *RunClient) Unarchive(ctx context.Context, parameters *params.UnarchiveRunParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.RunService.UnarchiveRun(parameters, PassThroughAuth)
+	_, err := c.apiClient.RunService.UnarchiveRun(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.ListRunsDefault); ok {
@@ -160,14 +148,10 @@ func (c *RunClient) Unarchive(parameters *params.UnarchiveRunParams) error {
 	return nil
 }
 
-func (c *RunClient) Delete(parameters *params.DeleteRunParams) error {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
+func (c *RunClient) Delete(ctx context.Context, parameters *params.DeleteRunParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.RunService.DeleteRun(parameters, PassThroughAuth)
+	_, err := c.apiClient.RunService.DeleteRun(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.ListRunsDefault); ok {
@@ -184,15 +168,11 @@ func (c *RunClient) Delete(parameters *params.DeleteRunParams) error {
 	return nil
 }
 
-func (c *RunClient) List(parameters *params.ListRunsParams) (
+func (c *RunClient) List(ctx context.Context, parameters *params.ListRunsParams) (
 	[]*model.V1Run, int, string, error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.RunService.ListRuns(parameters, PassThroughAuth)
+	response, err := c.apiClient.RunService.ListRuns(parameters, c.authInfo)
 
 	if err != nil {
 		if defaultError, ok := err.(*params.ListRunsDefault); ok {
@@ -209,43 +189,71 @@ func (c *RunClient) List(parameters *params.ListRunsParams) (
 	return response.Payload.Runs, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
 }
 
-func (c *RunClient) ListAll(parameters *params.ListRunsParams, maxResultSize int) (
+func (c *RunClient) ListAll(ctx context.Context, parameters *params.ListRunsParams, maxResultSize int) (
 	[]*model.V1Run, error) {
-	return listAllForRun(c, parameters, maxResultSize)
+	return listAllForRun(ctx, c, parameters, maxResultSize)
 }
 
-func listAllForRun(client RunInterface, parameters *params.ListRunsParams, maxResultSize int) (
-	[]*model.V1Run, error) {
+func listAllForRun(ctx context.Context, client RunInterface, parameters *params.ListRunsParams,
+	maxResultSize int) ([]*model.V1Run, error) {
 	if maxResultSize < 0 {
 		maxResultSize = 0
 	}
 
 	allResults := make([]*model.V1Run, 0)
-	firstCall := true
-	for (firstCall || (parameters.PageToken != nil && *parameters.PageToken != "")) &&
-		(len(allResults) < maxResultSize) {
-		results, _, pageToken, err := client.List(parameters)
+	it := NewRunIterator(ctx, client, parameters)
+	for len(allResults) < maxResultSize {
+		result, err := it.Next()
+		if err == Done {
+			break
+		}
 		if err != nil {
 			return nil, err
 		}
-		allResults = append(allResults, results...)
-		parameters.PageToken = util.StringPointer(pageToken)
-		firstCall = false
-	}
-	if len(allResults) > maxResultSize {
-		allResults = allResults[0:maxResultSize]
+		allResults = append(allResults, result)
 	}
 
 	return allResults, nil
 }
 
-func (c *RunClient) Terminate(parameters *params.TerminateRunParams) error {
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
+// RunIterator walks the runs of a list request, fetching the pages lazily.
+type RunIterator struct {
+	pageIterator
+	page []*model.V1Run
+}
 
+// NewRunIterator creates an iterator over the runs matching the parameters,
+// starting from their page token.
+func NewRunIterator(ctx context.Context, client RunInterface, parameters *params.ListRunsParams) *RunIterator {
+	it := &RunIterator{}
+	if parameters.PageToken != nil {
+		it.pageToken = *parameters.PageToken
+	}
+	it.fetch = func(pageToken string) (int, string, error) {
+		parameters.PageToken = util.StringPointer(pageToken)
+		results, _, nextPageToken, err := client.List(ctx, parameters)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = results
+		return len(results), nextPageToken, nil
+	}
+	return it
+}
+
+// Next returns the next run, or Done once all were walked.
+func (it *RunIterator) Next() (*model.V1Run, error) {
+	index, err := it.next()
+	if err != nil {
+		return nil, err
+	}
+	return it.page[index], nil
+}
+
+func (c *RunClient) Terminate(ctx context.Context, parameters *params.TerminateRunParams) error {
 	// Make service call
 	parameters.Context = ctx
-	_, err := c.apiClient.RunService.TerminateRun(parameters, PassThroughAuth)
+	_, err := c.apiClient.RunService.TerminateRun(parameters, c.authInfo)
 	if err != nil {
 		return util.NewUserError(err,
 			fmt.Sprintf("Failed to terminate run. Params: %+v", parameters),
@@ -253,3 +261,88 @@ func (c *RunClient) Terminate(parameters *params.TerminateRunParams) error {
 	}
 	return nil
 }
+
+// DefaultRunPollInterval is the interval the runs are polled at while waiting for them.
+const DefaultRunPollInterval = 5 * time.Second
+
+// WaitOption configures how WaitForRunCompletion waits for a run.
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	pollInterval time.Duration
+	timeout      time.Duration
+	progress     func(*model.V1RunDetail)
+}
+
+// WithPollInterval sets the interval the run is polled at, DefaultRunPollInterval
+// by default.
+func WithPollInterval(interval time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.pollInterval = interval
+	}
+}
+
+// WithWaitTimeout stops the wait after the timeout, in addition to the deadline
+// of the context.
+func WithWaitTimeout(timeout time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithProgress calls the function with the run every time it is polled, e.g. to
+// report its status.
+func WithProgress(progress func(*model.V1RunDetail)) WaitOption {
+	return func(o *waitOptions) {
+		o.progress = progress
+	}
+}
+
+// WaitForRunCompletion polls a run until it reaches a final state, and returns
+// it. It fails if the run can't be got, or if the wait times out.
+func (c *RunClient) WaitForRunCompletion(ctx context.Context, runID string, opts ...WaitOption) (*model.V1RunDetail,
+	*workflowapi.PipelineRun, error) {
+	options := &waitOptions{pollInterval: DefaultRunPollInterval}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.pollInterval <= 0 {
+		options.pollInterval = DefaultRunPollInterval
+	}
+	if options.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(options.pollInterval)
+	defer ticker.Stop()
+	for {
+		runDetail, workflow, err := c.Get(ctx, &params.GetRunParams{RunID: runID})
+		if err != nil {
+			return nil, nil, err
+		}
+		if options.progress != nil {
+			options.progress(runDetail)
+		}
+		if util.IsFinalCondition(runDetail.Run.Status) || util.NewWorkflow(workflow).IsInFinalState() {
+			return runDetail, workflow, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, nil, util.NewUserErrorWithSingleMessage(ctx.Err(),
+				fmt.Sprintf("Failed to wait for run '%v' to complete", runID))
+		}
+	}
+}
+
+// CreateRunAndWait creates a run and waits for it to reach a final state.
+func (c *RunClient) CreateRunAndWait(ctx context.Context, parameters *params.CreateRunParams, opts ...WaitOption) (
+	*model.V1RunDetail, *workflowapi.PipelineRun, error) {
+	runDetail, _, err := c.Create(ctx, parameters)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.WaitForRunCompletion(ctx, runDetail.Run.ID, opts...)
+}
@@ -1,6 +1,7 @@
 package api_server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -34,7 +35,7 @@ func NewRunClientFake() *RunClientFake {
 	return &RunClientFake{}
 }
 
-func (c *RunClientFake) Get(params *runparams.GetRunParams) (*runmodel.V1RunDetail,
+func (c *RunClientFake) Get(ctx context.Context, params *runparams.GetRunParams) (*runmodel.V1RunDetail,
 	*workflowapi.PipelineRun, error) {
 	switch params.RunID {
 	case RunForClientErrorTest:
@@ -44,7 +45,7 @@ func (c *RunClientFake) Get(params *runparams.GetRunParams) (*runmodel.V1RunDeta
 	}
 }
 
-func (c *RunClientFake) List(params *runparams.ListRunsParams) (
+func (c *RunClientFake) List(ctx context.Context, params *runparams.ListRunsParams) (
 	[]*runmodel.V1Run, int, string, error) {
 	const (
 		FirstToken  = ""
@@ -72,20 +73,20 @@ func (c *RunClientFake) List(params *runparams.ListRunsParams) (
 	}
 }
 
-func (c *RunClientFake) ListAll(params *runparams.ListRunsParams, maxResultSize int) (
+func (c *RunClientFake) ListAll(ctx context.Context, params *runparams.ListRunsParams, maxResultSize int) (
 	[]*runmodel.V1Run, error) {
-	return listAllForRun(c, params, maxResultSize)
+	return listAllForRun(ctx, c, params, maxResultSize)
 }
 
-func (c *RunClientFake) Archive(params *runparams.ArchiveRunParams) error {
+func (c *RunClientFake) Archive(ctx context.Context, params *runparams.ArchiveRunParams) error {
 	return nil
 }
 
-func (c *RunClientFake) Unarchive(params *runparams.UnarchiveRunParams) error {
+func (c *RunClientFake) Unarchive(ctx context.Context, params *runparams.UnarchiveRunParams) error {
 	return nil
 }
 
-func (c *RunClientFake) Terminate(params *runparams.TerminateRunParams) error {
+func (c *RunClientFake) Terminate(ctx context.Context, params *runparams.TerminateRunParams) error {
 	switch params.RunID {
 	case RunForClientErrorTest:
 		return fmt.Errorf(ClientErrorString)
@@ -0,0 +1,162 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	runparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// InMemoryRunClientFake is a RunInterface storing the runs in memory. The runs
+// stay pending until their status is set with SetRunStatus.
+type InMemoryRunClientFake struct {
+	FakeBehaviors
+	mutex  sync.Mutex
+	runs   []*runmodel.V1RunDetail
+	nextID int
+}
+
+func NewInMemoryRunClientFake() *InMemoryRunClientFake {
+	return &InMemoryRunClientFake{}
+}
+
+func (c *InMemoryRunClientFake) Create(ctx context.Context, params *runparams.CreateRunParams) (*runmodel.V1RunDetail,
+	*workflowapi.PipelineRun, error) {
+	if err := c.failure("Create"); err != nil {
+		return nil, nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.nextID++
+	run := *params.Body
+	run.ID = fmt.Sprintf("run-%d", c.nextID)
+	run.CreatedAt = strfmt.NewDateTime()
+	run.StorageState = runmodel.V1RunStorageStateSTORAGESTATEAVAILABLE
+	runDetail := &runmodel.V1RunDetail{Run: &run, PipelineRuntime: &runmodel.V1PipelineRuntime{}}
+	c.runs = append(c.runs, runDetail)
+	return copyRunDetail(runDetail), &workflowapi.PipelineRun{}, nil
+}
+
+func (c *InMemoryRunClientFake) Get(ctx context.Context, params *runparams.GetRunParams) (*runmodel.V1RunDetail,
+	*workflowapi.PipelineRun, error) {
+	if err := c.failure("Get"); err != nil {
+		return nil, nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	run, err := c.getLocked(params.RunID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return copyRunDetail(run), &workflowapi.PipelineRun{}, nil
+}
+
+func (c *InMemoryRunClientFake) List(ctx context.Context, params *runparams.ListRunsParams) (
+	[]*runmodel.V1Run, int, string, error) {
+	if err := c.failure("List"); err != nil {
+		return nil, 0, "", err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var runs []*runmodel.V1Run
+	for _, run := range c.runs {
+		if params.ResourceReferenceKeyID == nil || hasRunReference(run.Run, params.ResourceReferenceKeyType, *params.ResourceReferenceKeyID) {
+			runs = append(runs, copyRunDetail(run).Run)
+		}
+	}
+	start, end, nextPageToken, err := fakePage(len(runs), params.PageSize, params.PageToken)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return runs[start:end], len(runs), nextPageToken, nil
+}
+
+func (c *InMemoryRunClientFake) ListAll(ctx context.Context, params *runparams.ListRunsParams, maxResultSize int) (
+	[]*runmodel.V1Run, error) {
+	return listAllForRun(ctx, c, params, maxResultSize)
+}
+
+func (c *InMemoryRunClientFake) Delete(ctx context.Context, params *runparams.DeleteRunParams) error {
+	if err := c.failure("Delete"); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i, run := range c.runs {
+		if run.Run.ID == params.ID {
+			c.runs = append(c.runs[:i], c.runs[i+1:]...)
+			return nil
+		}
+	}
+	return NewFakeStatusError(codes.NotFound, "Run %s not found", params.ID)
+}
+
+func (c *InMemoryRunClientFake) Archive(ctx context.Context, params *runparams.ArchiveRunParams) error {
+	return c.update("Archive", params.ID, func(run *runmodel.V1Run) {
+		run.StorageState = runmodel.V1RunStorageStateSTORAGESTATEARCHIVED
+	})
+}
+
+func (c *InMemoryRunClientFake) Unarchive(ctx context.Context, params *runparams.UnarchiveRunParams) error {
+	return c.update("Unarchive", params.ID, func(run *runmodel.V1Run) {
+		run.StorageState = runmodel.V1RunStorageStateSTORAGESTATEAVAILABLE
+	})
+}
+
+func (c *InMemoryRunClientFake) Terminate(ctx context.Context, params *runparams.TerminateRunParams) error {
+	return c.update("Terminate", params.RunID, func(run *runmodel.V1Run) {
+		run.Status = "Cancelled"
+		run.FinishedAt = strfmt.NewDateTime()
+	})
+}
+
+// SetRunStatus sets the status of a run, e.g. Running or Succeeded.
+func (c *InMemoryRunClientFake) SetRunStatus(id string, status string) error {
+	return c.update("", id, func(run *runmodel.V1Run) {
+		run.Status = status
+	})
+}
+
+func (c *InMemoryRunClientFake) update(method string, id string, update func(run *runmodel.V1Run)) error {
+	if err := c.failure(method); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	run, err := c.getLocked(id)
+	if err != nil {
+		return err
+	}
+	update(run.Run)
+	return nil
+}
+
+func (c *InMemoryRunClientFake) getLocked(id string) (*runmodel.V1RunDetail, error) {
+	for _, run := range c.runs {
+		if run.Run.ID == id {
+			return run, nil
+		}
+	}
+	return nil, NewFakeStatusError(codes.NotFound, "Run %s not found", id)
+}
+
+// copyRunDetail copies a run, so the runs returned aren't updated concurrently.
+func copyRunDetail(runDetail *runmodel.V1RunDetail) *runmodel.V1RunDetail {
+	run := *runDetail.Run
+	return &runmodel.V1RunDetail{Run: &run, PipelineRuntime: runDetail.PipelineRuntime}
+}
+
+func hasRunReference(run *runmodel.V1Run, keyType *string, keyID string) bool {
+	for _, reference := range run.ResourceReferences {
+		if reference.Key != nil && reference.Key.ID == keyID &&
+			(keyType == nil || string(reference.Key.Type) == *keyType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package api_server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRunStatusServer serves a run which succeeds after the number of gets,
+// or never if it is negative.
+func newTestRunStatusServer(t *testing.T, runningGets int32) *httptest.Server {
+	var gets int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/apis/v1/runs":
+			writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", ""))
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/v1/runs/run1":
+			if n := atomic.AddInt32(&gets, 1); runningGets < 0 || n <= runningGets {
+				writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Running"))
+				return
+			}
+			writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Succeeded"))
+		default:
+			writeTestJSON(t, w, http.StatusNotFound, &runmodel.V1Status{Error: "Run not found", Code: 5})
+		}
+	}))
+}
+
+func TestRunClient_WaitForRunCompletion(t *testing.T) {
+	server := newTestRunStatusServer(t, 2)
+	defer server.Close()
+	client := newTestRunClient(t, server)
+
+	var statuses []string
+	runDetail, _, err := client.WaitForRunCompletion(context.Background(), "run1", WithPollInterval(time.Millisecond),
+		WithProgress(func(runDetail *runmodel.V1RunDetail) {
+			statuses = append(statuses, runDetail.Run.Status)
+		}))
+	assert.Nil(t, err)
+	assert.Equal(t, "Succeeded", runDetail.Run.Status)
+	assert.Equal(t, []string{"Running", "Running", "Succeeded"}, statuses)
+}
+
+func TestRunClient_WaitForRunCompletion_Timeout(t *testing.T) {
+	server := newTestRunStatusServer(t, -1)
+	defer server.Close()
+	client := newTestRunClient(t, server)
+
+	_, _, err := client.WaitForRunCompletion(context.Background(), "run1", WithPollInterval(time.Millisecond),
+		WithWaitTimeout(50*time.Millisecond))
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+
+	// The wait also stops when the context is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = client.WaitForRunCompletion(ctx, "run1")
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+}
+
+func TestRunClient_WaitForRunCompletion_NotFound(t *testing.T) {
+	server := newTestRunStatusServer(t, 0)
+	defer server.Close()
+	client := newTestRunClient(t, server)
+
+	_, _, err := client.WaitForRunCompletion(context.Background(), "run2", WithPollInterval(time.Millisecond))
+	assert.Contains(t, err.Error(), "Run not found")
+}
+
+func TestRunClient_CreateRunAndWait(t *testing.T) {
+	server := newTestRunStatusServer(t, 1)
+	defer server.Close()
+	client := newTestRunClient(t, server)
+
+	runDetail, _, err := client.CreateRunAndWait(context.Background(),
+		&params.CreateRunParams{Body: &runmodel.V1Run{Name: "run1"}}, WithPollInterval(time.Millisecond))
+	assert.Nil(t, err)
+	assert.Equal(t, "run1", runDetail.Run.ID)
+	assert.Equal(t, "Succeeded", runDetail.Run.Status)
+}
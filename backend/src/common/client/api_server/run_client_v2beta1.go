@@ -0,0 +1,262 @@
+package api_server
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	apiclient "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/run_client"
+	params "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/run_client/run_service"
+	model "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"golang.org/x/net/context"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// RunInterfaceV2beta1 is the client of the v2beta1 RunService, which takes the
+// runtime config of the KFP v2 SDK.
+type RunInterfaceV2beta1 interface {
+	Create(ctx context.Context, params *params.CreateRunParams) (*model.V2beta1Run, error)
+	Get(ctx context.Context, params *params.GetRunParams) (*model.V2beta1Run, error)
+	List(ctx context.Context, params *params.ListRunsParams) ([]*model.V2beta1Run, int, string, error)
+	ListAll(ctx context.Context, params *params.ListRunsParams, maxResultSize int) ([]*model.V2beta1Run, error)
+	Archive(ctx context.Context, params *params.ArchiveRunParams) error
+	Unarchive(ctx context.Context, params *params.UnarchiveRunParams) error
+	Delete(ctx context.Context, params *params.DeleteRunParams) error
+	Terminate(ctx context.Context, params *params.TerminateRunParams) error
+	Retry(ctx context.Context, params *params.RetryRunParams) error
+}
+
+type RunClientV2beta1 struct {
+	apiClient *apiclient.Run
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+func NewRunClientV2beta1(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
+	*RunClientV2beta1, error) {
+
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
+
+	return &RunClientV2beta1{
+		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
+	}, nil
+}
+
+func (c *RunClientV2beta1) Create(ctx context.Context, parameters *params.CreateRunParams) (*model.V2beta1Run,
+	error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RunService.CreateRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.CreateRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to create run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to create run '%v'", parameters.Body.DisplayName))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *RunClientV2beta1) Get(ctx context.Context, parameters *params.GetRunParams) (*model.V2beta1Run,
+	error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RunService.GetRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.GetRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to get run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to get run '%v'", parameters.RunID))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *RunClientV2beta1) Archive(ctx context.Context, parameters *params.ArchiveRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RunService.ArchiveRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.ArchiveRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to archive run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to archive run '%v'", parameters.RunID))
+	}
+
+	return nil
+}
+
+func (c *RunClientV2beta1) Unarchive(ctx context.Context, parameters *params.UnarchiveRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RunService.UnarchiveRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.UnarchiveRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to unarchive run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to unarchive run '%v'", parameters.RunID))
+	}
+
+	return nil
+}
+
+func (c *RunClientV2beta1) Delete(ctx context.Context, parameters *params.DeleteRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RunService.DeleteRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.DeleteRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to delete run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to delete run '%v'", parameters.RunID))
+	}
+
+	return nil
+}
+
+func (c *RunClientV2beta1) Terminate(ctx context.Context, parameters *params.TerminateRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RunService.TerminateRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.TerminateRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to terminate run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to terminate run '%v'", parameters.RunID))
+	}
+
+	return nil
+}
+
+func (c *RunClientV2beta1) Retry(ctx context.Context, parameters *params.RetryRunParams) error {
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.RunService.RetryRun(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.RetryRunDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to retry run. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to retry run '%v'", parameters.RunID))
+	}
+
+	return nil
+}
+
+func (c *RunClientV2beta1) List(ctx context.Context, parameters *params.ListRunsParams) (
+	[]*model.V2beta1Run, int, string, error) {
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.RunService.ListRuns(parameters, c.authInfo)
+	if err != nil {
+		if defaultError, ok := err.(*params.ListRunsDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Message, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, 0, "", util.NewUserError(err,
+			fmt.Sprintf("Failed to list runs. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to list runs"))
+	}
+
+	return response.Payload.Runs, int(response.Payload.TotalSize), response.Payload.NextPageToken, nil
+}
+
+func (c *RunClientV2beta1) ListAll(ctx context.Context, parameters *params.ListRunsParams, maxResultSize int) (
+	[]*model.V2beta1Run, error) {
+	if maxResultSize < 0 {
+		maxResultSize = 0
+	}
+
+	allResults := make([]*model.V2beta1Run, 0)
+	it := NewRunIteratorV2beta1(ctx, c, parameters)
+	for len(allResults) < maxResultSize {
+		result, err := it.Next()
+		if err == Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		allResults = append(allResults, result)
+	}
+
+	return allResults, nil
+}
+
+// RunIteratorV2beta1 walks the runs of a v2beta1 list request, fetching the pages
+// lazily.
+type RunIteratorV2beta1 struct {
+	pageIterator
+	page []*model.V2beta1Run
+}
+
+// NewRunIteratorV2beta1 creates an iterator over the runs matching the
+// parameters, starting from their page token.
+func NewRunIteratorV2beta1(ctx context.Context, client RunInterfaceV2beta1, parameters *params.ListRunsParams) *RunIteratorV2beta1 {
+	it := &RunIteratorV2beta1{}
+	if parameters.PageToken != nil {
+		it.pageToken = *parameters.PageToken
+	}
+	it.fetch = func(pageToken string) (int, string, error) {
+		parameters.PageToken = util.StringPointer(pageToken)
+		results, _, nextPageToken, err := client.List(ctx, parameters)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = results
+		return len(results), nextPageToken, nil
+	}
+	return it
+}
+
+// Next returns the next run, or Done once all were walked.
+func (it *RunIteratorV2beta1) Next() (*model.V2beta1Run, error) {
+	index, err := it.next()
+	if err != nil {
+		return nil, err
+	}
+	return it.page[index], nil
+}
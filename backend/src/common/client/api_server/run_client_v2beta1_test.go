@@ -0,0 +1,93 @@
+package api_server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	params "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/run_client/run_service"
+	model "github.com/kubeflow/pipelines/backend/api/v2beta1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// newTestRunServerV2beta1 creates the runs it is sent as run1, and serves the runs
+// run0 to run<count-1> in pages of two.
+func newTestRunServerV2beta1(t *testing.T, count int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/apis/v2beta1/runs":
+			var run model.V2beta1Run
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&run))
+			run.RunID = "run1"
+			run.State = model.V2beta1RuntimeStatePENDING
+			writeTestJSON(t, w, http.StatusOK, &run)
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/v2beta1/runs":
+			start := 0
+			if token := r.URL.Query().Get("page_token"); token != "" {
+				start, _ = strconv.Atoi(token)
+			}
+			response := &model.V2beta1ListRunsResponse{TotalSize: int32(count)}
+			for i := start; i < count && i < start+2; i++ {
+				response.Runs = append(response.Runs, &model.V2beta1Run{RunID: "run" + strconv.Itoa(i)})
+			}
+			if start+2 < count {
+				response.NextPageToken = strconv.Itoa(start + 2)
+			}
+			writeTestJSON(t, w, http.StatusOK, response)
+		default:
+			writeTestJSON(t, w, http.StatusNotFound, &model.GooglerpcStatus{Message: "Run not found", Code: int32(codes.NotFound)})
+		}
+	}))
+}
+
+func newTestRunClientV2beta1(t *testing.T, server *httptest.Server) *RunClientV2beta1 {
+	client, err := NewRunClientV2beta1(nil, false, WithEndpoint(server.URL), WithRetryPolicy(NoRetryPolicy))
+	require.Nil(t, err)
+	return client
+}
+
+func TestRunClientV2beta1_Create(t *testing.T) {
+	server := newTestRunServerV2beta1(t, 0)
+	defer server.Close()
+	client := newTestRunClientV2beta1(t, server)
+
+	run, err := client.Create(context.Background(), &params.CreateRunParams{Body: &model.V2beta1Run{
+		DisplayName:   "run",
+		PipelineSpec:  map[string]interface{}{"pipelineInfo": map[string]interface{}{"name": "hello-world"}},
+		RuntimeConfig: &model.V2beta1RuntimeConfig{Parameters: map[string]interface{}{"text": "hello", "count": 3}},
+	}})
+	require.Nil(t, err)
+	assert.Equal(t, "run1", run.RunID)
+	assert.Equal(t, model.V2beta1RuntimeStatePENDING, run.State)
+	assert.Equal(t, map[string]interface{}{"text": "hello", "count": float64(3)}, run.RuntimeConfig.Parameters)
+	assert.Equal(t, map[string]interface{}{"pipelineInfo": map[string]interface{}{"name": "hello-world"}}, run.PipelineSpec)
+}
+
+func TestRunClientV2beta1_ListAll(t *testing.T) {
+	server := newTestRunServerV2beta1(t, 5)
+	defer server.Close()
+	client := newTestRunClientV2beta1(t, server)
+
+	runs, err := client.ListAll(context.Background(), &params.ListRunsParams{}, 4)
+	require.Nil(t, err)
+	var ids []string
+	for _, run := range runs {
+		ids = append(ids, run.RunID)
+	}
+	assert.Equal(t, []string{"run0", "run1", "run2", "run3"}, ids)
+}
+
+func TestRunClientV2beta1_Get_NotFound(t *testing.T) {
+	server := newTestRunServerV2beta1(t, 0)
+	defer server.Close()
+	client := newTestRunClientV2beta1(t, server)
+
+	_, err := client.Get(context.Background(), &params.GetRunParams{RunID: "run2"})
+	assert.Equal(t, codes.NotFound, fakeStatusCode(err))
+	assert.Contains(t, err.Error(), "Run not found")
+}
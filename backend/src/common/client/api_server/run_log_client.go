@@ -0,0 +1,78 @@
+package api_server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const runLogPath = "apis/v1/runs/%s/nodes/%s/log"
+
+// RunLogClient reads the logs of the nodes of the runs, which aren't served by
+// the generated clients.
+type RunLogClient struct {
+	httpClient    *http.Client
+	baseURL       string
+	tokenProvider TokenProvider
+}
+
+func NewRunLogClient(clientConfig clientcmd.ClientConfig, opts ...ClientOption) (*RunLogClient, error) {
+	options := newClientOptions(opts)
+	httpClient, endpoint, err := newHTTPClient(clientConfig, options)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = newMiddlewareTransport(newRetryTransport(httpClient.Transport, options.retryPolicy),
+		options.middlewares)
+	return &RunLogClient{
+		httpClient:    httpClient,
+		baseURL:       endpoint.baseURL,
+		tokenProvider: options.tokenProvider,
+	}, nil
+}
+
+// ReadLog copies the log of a node of a run to the writer.
+func (c *RunLogClient) ReadLog(ctx context.Context, runID string, nodeID string, w io.Writer) error {
+	path := fmt.Sprintf(runLogPath, url.PathEscape(runID), url.PathEscape(nodeID))
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create the request")
+	}
+	if c.tokenProvider != nil {
+		token, err := c.tokenProvider.Token()
+		if err != nil {
+			return errors.Wrapf(err, "Failed to get a token")
+		}
+		req.Header.Set(authorizationHeader, bearerTokenPrefix+token)
+	}
+	resp, err := c.httpClient.Do(req.WithContext(withOperationID(ctx, "ReadRunLog")))
+	if err != nil {
+		return util.NewUserErrorWithSingleMessage(CreateErrorCouldNotRecoverAPIStatus(err),
+			fmt.Sprintf("Failed to read the log of node '%s' of run '%s'", nodeID, runID))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		message := resp.Status
+		var apiErr uploadError
+		if content, err := ioutil.ReadAll(resp.Body); err == nil && json.Unmarshal(content, &apiErr) == nil &&
+			apiErr.ErrorMessage != "" {
+			message = apiErr.ErrorMessage
+		}
+		return util.NewUserErrorWithSingleMessage(CreateErrorFromAPIStatus(message, int32(codeFromHTTPStatus(resp.StatusCode))),
+			fmt.Sprintf("Failed to read the log of node '%s' of run '%s'", nodeID, runID))
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return util.NewUserErrorWithSingleMessage(err,
+			fmt.Sprintf("Failed to read the log of node '%s' of run '%s'", nodeID, runID))
+	}
+	return nil
+}
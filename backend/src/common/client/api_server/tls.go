@@ -0,0 +1,54 @@
+package api_server
+
+import (
+	"net/http"
+	"net/url"
+
+	"k8s.io/client-go/rest"
+)
+
+// TLSOptions configures the TLS connections of the clients, overriding the
+// settings of the kubeconfig. The empty fields keep them.
+type TLSOptions struct {
+	// CertFile and KeyFile are the client certificate and its key, for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// CAFile is the bundle of the certificate authorities trusted to sign the
+	// certificate of the server.
+	CAFile string
+	// InsecureSkipVerify disables the verification of the certificate of the
+	// server. It should only be used for testing.
+	InsecureSkipVerify bool
+}
+
+// WithTLS configures the TLS connections of a client.
+func WithTLS(tls TLSOptions) ClientOption {
+	return func(o *clientOptions) {
+		o.tls = &tls
+	}
+}
+
+// WithProxy sets the proxy of the requests of a client, e.g. http.ProxyURL(u)
+// or http.ProxyFromEnvironment, which is used by default.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(o *clientOptions) {
+		o.proxy = proxy
+	}
+}
+
+func (t *TLSOptions) apply(config *rest.Config) {
+	if t.CertFile != "" || t.KeyFile != "" {
+		config.CertFile, config.KeyFile = t.CertFile, t.KeyFile
+		config.CertData, config.KeyData = nil, nil
+	}
+	if t.CAFile != "" {
+		config.CAFile = t.CAFile
+		config.CAData = nil
+	}
+	// The certificate authorities can't be set with the insecure flag.
+	if t.InsecureSkipVerify {
+		config.Insecure = true
+		config.CAFile = ""
+		config.CAData = nil
+	}
+}
@@ -0,0 +1,102 @@
+package api_server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+// writeTestPEM writes the PEM block of the DER bytes to a file of the directory.
+func writeTestPEM(t *testing.T, dir string, name string, blockType string, der []byte) string {
+	path := filepath.Join(dir, name)
+	require.Nil(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return path
+}
+
+func TestTLS(t *testing.T) {
+	var clientCertificates int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCertificates = len(r.TLS.PeerCertificates)
+		writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Running"))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "tls")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	caFile := writeTestPEM(t, dir, "ca.crt", "CERTIFICATE", server.Certificate().Raw)
+	// The certificate of the server is also the certificate of the client.
+	key, err := x509.MarshalPKCS8PrivateKey(server.TLS.Certificates[0].PrivateKey)
+	require.Nil(t, err)
+	certFile := writeTestPEM(t, dir, "tls.crt", "CERTIFICATE", server.Certificate().Raw)
+	keyFile := writeTestPEM(t, dir, "tls.key", "PRIVATE KEY", key)
+
+	// The certificate of the server isn't trusted by default.
+	client := newTestRunClient(t, server, WithRetryPolicy(NoRetryPolicy))
+	_, _, err = client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Contains(t, err.Error(), "certificate")
+
+	client = newTestRunClient(t, server, WithTLS(TLSOptions{CAFile: caFile}))
+	_, _, err = client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, clientCertificates)
+
+	client = newTestRunClient(t, server, WithTLS(TLSOptions{CAFile: caFile, CertFile: certFile, KeyFile: keyFile}))
+	_, _, err = client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, clientCertificates)
+
+	client = newTestRunClient(t, server, WithTLS(TLSOptions{InsecureSkipVerify: true}))
+	_, _, err = client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+}
+
+func TestTLSOptions_Apply(t *testing.T) {
+	config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{
+		CertData: []byte("cert"),
+		KeyData:  []byte("key"),
+		CAData:   []byte("ca"),
+	}}
+	(&TLSOptions{CertFile: "tls.crt", KeyFile: "tls.key", CAFile: "ca.crt"}).apply(config)
+	assert.Equal(t, rest.TLSClientConfig{CertFile: "tls.crt", KeyFile: "tls.key", CAFile: "ca.crt"}, config.TLSClientConfig)
+
+	// The empty options keep the settings of the kubeconfig.
+	(&TLSOptions{}).apply(config)
+	assert.Equal(t, rest.TLSClientConfig{CertFile: "tls.crt", KeyFile: "tls.key", CAFile: "ca.crt"}, config.TLSClientConfig)
+
+	(&TLSOptions{InsecureSkipVerify: true}).apply(config)
+	assert.Equal(t, rest.TLSClientConfig{CertFile: "tls.crt", KeyFile: "tls.key", Insecure: true}, config.TLSClientConfig)
+}
+
+func TestProxy(t *testing.T) {
+	var host string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Running"))
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	require.Nil(t, err)
+
+	client, err := NewRunClient(nil, false, WithEndpoint("http://ml-pipeline.kubeflow.svc:8888"),
+		WithProxy(http.ProxyURL(proxyURL)), WithRetryPolicy(NoRetryPolicy))
+	require.Nil(t, err)
+	_, _, err = client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "ml-pipeline.kubeflow.svc:8888", host)
+}
@@ -0,0 +1,180 @@
+package api_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerTokenPrefix   = "Bearer "
+
+	// DefaultServiceAccountTokenPath is where the token of the service account
+	// of a pod is mounted.
+	DefaultServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// The kubelet rotates the projected tokens well before they expire, so
+	// reading them again every minute keeps them valid.
+	serviceAccountTokenReadInterval = time.Minute
+	// The OIDC tokens are refreshed once they expire within the delta, so they
+	// don't expire while the requests are in flight.
+	oidcTokenExpiryDelta = time.Minute
+	// The lifetime assumed for the OIDC tokens refreshed without an expiry.
+	oidcTokenDefaultLifetime = 5 * time.Minute
+	oidcTokenTimeout         = 30 * time.Second
+)
+
+// TokenProvider provides the bearer tokens authenticating the requests of the
+// clients. The providers refresh the tokens before they expire, so long running
+// clients keep working.
+type TokenProvider interface {
+	Token() (string, error)
+}
+
+// WithTokenProvider authenticates the requests of a client with the tokens of
+// the provider.
+func WithTokenProvider(provider TokenProvider) ClientOption {
+	return func(o *clientOptions) {
+		o.tokenProvider = provider
+	}
+}
+
+// tokenAuth sets the token of the provider as the bearer token of the requests.
+func tokenAuth(provider TokenProvider) runtime.ClientAuthInfoWriter {
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		token, err := provider.Token()
+		if err != nil {
+			return errors.Wrapf(err, "Failed to get a token")
+		}
+		return r.SetHeaderParam(authorizationHeader, bearerTokenPrefix+token)
+	})
+}
+
+// StaticTokenProvider provides a token which never changes.
+type StaticTokenProvider string
+
+func (p StaticTokenProvider) Token() (string, error) {
+	return string(p), nil
+}
+
+// ServiceAccountTokenProvider provides the Kubernetes service account token
+// projected in a file, read again periodically as the kubelet rotates it.
+type ServiceAccountTokenProvider struct {
+	path   string
+	mutex  sync.Mutex
+	token  string
+	readAt time.Time
+}
+
+func NewServiceAccountTokenProvider(path string) *ServiceAccountTokenProvider {
+	if path == "" {
+		path = DefaultServiceAccountTokenPath
+	}
+	return &ServiceAccountTokenProvider{path: path}
+}
+
+func (p *ServiceAccountTokenProvider) Token() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.token != "" && time.Since(p.readAt) < serviceAccountTokenReadInterval {
+		return p.token, nil
+	}
+	content, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to read the service account token '%s'", p.path)
+	}
+	p.token = strings.TrimSpace(string(content))
+	p.readAt = time.Now()
+	return p.token, nil
+}
+
+// OIDCTokenProvider provides the ID tokens of an OIDC client, refreshed with
+// its refresh token before they expire.
+type OIDCTokenProvider struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	httpClient   *http.Client
+
+	mutex        sync.Mutex
+	refreshToken string
+	token        string
+	expiry       time.Time
+}
+
+func NewOIDCTokenProvider(tokenURL string, clientID string, clientSecret string, refreshToken string) *OIDCTokenProvider {
+	return &OIDCTokenProvider{
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		refreshToken: refreshToken,
+		httpClient:   &http.Client{Timeout: oidcTokenTimeout},
+	}
+}
+
+type oidcTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+func (p *OIDCTokenProvider) Token() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.token != "" && time.Now().Add(oidcTokenExpiryDelta).Before(p.expiry) {
+		return p.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", p.refreshToken)
+	form.Set("client_id", p.clientID)
+	if p.clientSecret != "" {
+		form.Set("client_secret", p.clientSecret)
+	}
+	resp, err := p.httpClient.PostForm(p.tokenURL, form)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to refresh the OIDC token")
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to read the OIDC token")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to refresh the OIDC token: %s: %s", resp.Status, content)
+	}
+	var token oidcTokenResponse
+	if err := json.Unmarshal(content, &token); err != nil {
+		return "", errors.Wrapf(err, "Failed to parse the OIDC token")
+	}
+
+	// The API server authenticates the ID tokens, the access tokens of the
+	// providers that don't issue ID tokens on refresh.
+	p.token = token.IDToken
+	if p.token == "" {
+		p.token = token.AccessToken
+	}
+	if p.token == "" {
+		return "", fmt.Errorf("Failed to refresh the OIDC token: no token in the response")
+	}
+	if token.RefreshToken != "" {
+		p.refreshToken = token.RefreshToken
+	}
+	lifetime := time.Duration(token.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = oidcTokenDefaultLifetime
+	}
+	p.expiry = time.Now().Add(lifetime)
+	return p.token, nil
+}
@@ -0,0 +1,108 @@
+package api_server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenProvider_AuthenticatesRequests(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		writeTestJSON(t, w, http.StatusOK, testRunDetail("run1", "Running"))
+	}))
+	defer server.Close()
+
+	client := newTestRunClient(t, server, WithTokenProvider(StaticTokenProvider("t0k3n")))
+	_, _, err := client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer t0k3n", authorization)
+
+	// The requests aren't sent without a token.
+	authorization = ""
+	client = newTestRunClient(t, server, WithTokenProvider(NewServiceAccountTokenProvider("/missing/token")))
+	_, _, err = client.Get(context.Background(), &params.GetRunParams{RunID: "run1"})
+	assert.Contains(t, err.Error(), "Failed to get a token")
+	assert.Empty(t, authorization)
+}
+
+func TestServiceAccountTokenProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+	require.Nil(t, ioutil.WriteFile(path, []byte("token1\n"), 0600))
+
+	provider := NewServiceAccountTokenProvider(path)
+	token, err := provider.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "token1", token)
+
+	// The rotated token is read again after the read interval.
+	require.Nil(t, ioutil.WriteFile(path, []byte("token2\n"), 0600))
+	token, err = provider.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "token1", token)
+	provider.readAt = time.Now().Add(-serviceAccountTokenReadInterval)
+	token, err = provider.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "token2", token)
+
+	assert.Equal(t, DefaultServiceAccountTokenPath, NewServiceAccountTokenProvider("").path)
+}
+
+func TestOIDCTokenProvider(t *testing.T) {
+	var refreshes int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "kfp-cli", r.PostForm.Get("client_id"))
+		assert.Equal(t, "s3cr3t", r.PostForm.Get("client_secret"))
+		switch atomic.AddInt32(&refreshes, 1) {
+		case 1:
+			assert.Equal(t, "refresh1", r.PostForm.Get("refresh_token"))
+			writeTestJSON(t, w, http.StatusOK, &oidcTokenResponse{IDToken: "id1", AccessToken: "access1",
+				RefreshToken: "refresh2", ExpiresIn: 3600})
+		case 2:
+			// The refresh token rotated by the provider is used.
+			assert.Equal(t, "refresh2", r.PostForm.Get("refresh_token"))
+			writeTestJSON(t, w, http.StatusOK, &oidcTokenResponse{AccessToken: "access2"})
+		default:
+			writeTestJSON(t, w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		}
+	}))
+	defer server.Close()
+
+	provider := NewOIDCTokenProvider(server.URL, "kfp-cli", "s3cr3t", "refresh1")
+	token, err := provider.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "id1", token)
+	token, err = provider.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "id1", token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshes))
+
+	// The token is refreshed before it expires, the access token if there is
+	// no ID token.
+	provider.expiry = time.Now().Add(oidcTokenExpiryDelta / 2)
+	token, err = provider.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "access2", token)
+	assert.WithinDuration(t, time.Now().Add(oidcTokenDefaultLifetime), provider.expiry, time.Minute)
+
+	provider.expiry = time.Now()
+	_, err = provider.Token()
+	assert.Contains(t, err.Error(), "Failed to refresh the OIDC token: 400 Bad Request")
+	assert.Contains(t, err.Error(), "invalid_grant")
+}
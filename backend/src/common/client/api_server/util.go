@@ -2,22 +2,49 @@ package api_server
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
-	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
-const (
-	apiServerBasePath       = "/api/v1/namespaces/%s/services/ml-pipeline:8888/proxy/"
-	apiServerDefaultTimeout = 35 * time.Second
-)
+// ClientOption configures the clients created by the constructors of this package.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	retryPolicy   RetryPolicy
+	tokenProvider TokenProvider
+	middlewares   []Middleware
+	tls           *TLSOptions
+	proxy         func(*http.Request) (*url.URL, error)
+	userAgent     string
+	headers       http.Header
+	endpoint      string
+}
+
+func newClientOptions(opts []ClientOption) *clientOptions {
+	options := &clientOptions{retryPolicy: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// authInfo returns the writer authenticating the requests of a client.
+func (o *clientOptions) authInfo() runtime.ClientAuthInfoWriter {
+	if o.tokenProvider == nil {
+		return PassThroughAuth
+	}
+	return tokenAuth(o.tokenProvider)
+}
 
 // PassThroughAuth never manipulates the request
 var PassThroughAuth runtime.ClientAuthInfoWriter = runtime.ClientAuthInfoWriterFunc(
@@ -42,19 +69,19 @@ func toDateTimeTestOnly(timeInSec int64) strfmt.DateTime {
 // 	return &result
 // }
 
-func NewHTTPRuntime(clientConfig clientcmd.ClientConfig, debug bool) (
+func NewHTTPRuntime(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
 	*httptransport.Runtime, error) {
-	// Creating k8 client
-	k8Client, config, namespace, err := util.GetKubernetesClientFromClientConfig(clientConfig)
+	options := newClientOptions(opts)
+
+	httpClient, endpoint, err := newHTTPClient(clientConfig, options)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Error while creating K8 client")
+		return nil, err
 	}
 
 	// Create API client
-	httpClient := k8Client.RESTClient().(*rest.RESTClient).Client
-	masterIPAndPort := util.ExtractMasterIPAndPort(config)
-	runtime := httptransport.NewWithClient(masterIPAndPort, fmt.Sprintf(apiServerBasePath, namespace),
-		nil, httpClient)
+	httpClient.Transport = newMiddlewareTransport(newRetryTransport(httpClient.Transport, options.retryPolicy),
+		options.middlewares)
+	runtime := httptransport.NewWithClient(endpoint.host, endpoint.basePath, endpoint.schemes, httpClient)
 
 	if debug {
 		runtime.SetDebug(true)
@@ -63,8 +90,66 @@ func NewHTTPRuntime(clientConfig clientcmd.ClientConfig, debug bool) (
 	return runtime, err
 }
 
+// newHTTPClient returns the HTTP client of the requests to the API server,
+// proxied by the Kubernetes API server of the client config unless the options
+// set its endpoint, along with the endpoint.
+func newHTTPClient(clientConfig clientcmd.ClientConfig, options *clientOptions) (*http.Client, *apiEndpoint,
+	error) {
+	endpoint, config, err := newEndpoint(clientConfig, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	if options.tls != nil {
+		options.tls.apply(config)
+	}
+	if options.proxy != nil {
+		config.Proxy = options.proxy
+	}
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Failed to create the HTTP client")
+	}
+	httpClient.Transport = newHeaderTransport(httpClient.Transport, options.userAgent, options.headers)
+	return httpClient, endpoint, nil
+}
+
+// APIStatusError is an error status returned by the API server, with its gRPC code.
+type APIStatusError struct {
+	Message string
+	Code    int32
+}
+
+func (e *APIStatusError) Error() string {
+	return fmt.Sprintf("%v (code: %v)", e.Message, e.Code)
+}
+
 func CreateErrorFromAPIStatus(error string, code int32) error {
-	return fmt.Errorf("%v (code: %v)", error, code)
+	return &APIStatusError{Message: error, Code: code}
+}
+
+// codeFromHTTPStatus returns the gRPC code of the HTTP status of a response of
+// the endpoints served without the gRPC gateway.
+func codeFromHTTPStatus(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
 }
 
 func CreateErrorCouldNotRecoverAPIStatus(err error) error {
@@ -0,0 +1,55 @@
+package api_server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// newTestRunClient returns a run client connected to the test server directly.
+func newTestRunClient(t *testing.T, server *httptest.Server, opts ...ClientOption) *RunClient {
+	client, err := NewRunClient(nil, false, append([]ClientOption{WithEndpoint(server.URL)}, opts...)...)
+	require.Nil(t, err)
+	return client
+}
+
+func writeTestJSON(t *testing.T, w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	assert.Nil(t, json.NewEncoder(w).Encode(body))
+}
+
+// testRunDetail returns the response of the API server getting a run.
+func testRunDetail(id string, status string) *runmodel.V1RunDetail {
+	return &runmodel.V1RunDetail{
+		Run:             &runmodel.V1Run{ID: id, Status: status},
+		PipelineRuntime: &runmodel.V1PipelineRuntime{WorkflowManifest: "{}"},
+	}
+}
+
+func TestNewEndpoint(t *testing.T) {
+	endpoint, config, err := newEndpoint(nil, &clientOptions{endpoint: "https://kfp.example.com/pipeline/"})
+	assert.Nil(t, err)
+	assert.Equal(t, &apiEndpoint{
+		baseURL:  "https://kfp.example.com/pipeline/",
+		host:     "kfp.example.com",
+		basePath: "/pipeline/",
+		schemes:  []string{"https"},
+	}, endpoint)
+	assert.Equal(t, "https://kfp.example.com", config.Host)
+
+	_, _, err = newEndpoint(nil, &clientOptions{endpoint: "kfp.example.com"})
+	assert.Contains(t, err.Error(), "Invalid endpoint 'kfp.example.com'")
+}
+
+func TestCodeFromHTTPStatus(t *testing.T) {
+	assert.Equal(t, codes.NotFound, codeFromHTTPStatus(http.StatusNotFound))
+	assert.Equal(t, codes.Unavailable, codeFromHTTPStatus(http.StatusServiceUnavailable))
+	assert.Equal(t, codes.Internal, codeFromHTTPStatus(http.StatusTeapot))
+}
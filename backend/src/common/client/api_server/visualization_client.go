@@ -3,6 +3,7 @@ package api_server
 import (
 	"fmt"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	apiclient "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/visualization_client"
 	params "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/visualization_client/visualization_service"
@@ -14,38 +15,36 @@ import (
 )
 
 type VisualizationInterface interface {
-	Create(params *params.CreateVisualizationParams) (*model.V1Visualization, error)
+	Create(ctx context.Context, params *params.CreateVisualizationParams) (*model.V1Visualization, error)
 }
 
 type VisualizationClient struct {
 	apiClient *apiclient.Visualization
+	authInfo  runtime.ClientAuthInfoWriter
 }
 
-func NewVisualizationClient(clientConfig clientcmd.ClientConfig, debug bool) (
+func NewVisualizationClient(clientConfig clientcmd.ClientConfig, debug bool, opts ...ClientOption) (
 	*VisualizationClient, error) {
 
-	runtime, err := NewHTTPRuntime(clientConfig, debug)
+	runtime, err := NewHTTPRuntime(clientConfig, debug, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	apiClient := apiclient.New(runtime, strfmt.Default)
+	apiClient := apiclient.New(withOperationIDs(runtime), strfmt.Default)
 
 	// Creating upload client
 	return &VisualizationClient{
 		apiClient: apiClient,
+		authInfo:  newClientOptions(opts).authInfo(),
 	}, nil
 }
 
-func (c *VisualizationClient) Create(parameters *params.CreateVisualizationParams) (*model.V1Visualization,
+func (c *VisualizationClient) Create(ctx context.Context, parameters *params.CreateVisualizationParams) (*model.V1Visualization,
 	error) {
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
-	defer cancel()
-
 	// Make service call
 	parameters.Context = ctx
-	response, err := c.apiClient.VisualizationService.CreateVisualization(parameters, PassThroughAuth)
+	response, err := c.apiClient.VisualizationService.CreateVisualization(parameters, c.authInfo)
 	if err != nil {
 		if defaultError, ok := err.(*params.CreateVisualizationDefault); ok {
 			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
@@ -1,6 +1,7 @@
 package api_server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -18,7 +19,7 @@ func NewVisualizationClientFake() *VisualizationClientFake {
 	return &VisualizationClientFake{}
 }
 
-func (c *VisualizationClientFake) Create(params *params.CreateVisualizationParams) (
+func (c *VisualizationClientFake) Create(ctx context.Context, params *params.CreateVisualizationParams) (
 	*model.V1Visualization, error) {
 	var arguments VisualizationArguments
 	err := json.Unmarshal([]byte(params.Body.Arguments), &arguments)
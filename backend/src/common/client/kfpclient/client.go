@@ -0,0 +1,290 @@
+// Package kfpclient is a high level client of the Kubeflow Pipelines API server,
+// wrapping the generated clients of its services.
+package kfpclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	experimentparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_client/experiment_service"
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_model"
+	jobparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/job_client/job_service"
+	pipelineparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_client/pipeline_service"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_model"
+	uploadparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	uploadmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/pipeline_upload_model"
+	runparams "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_client/run_service"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// DefaultPollInterval is the interval the runs are polled at while waiting for them.
+const DefaultPollInterval = api_server.DefaultRunPollInterval
+
+// Client wraps the clients of the services of the API server. Its methods
+// return *Error errors.
+type Client struct {
+	pipelines       *api_server.PipelineClient
+	pipelineUploads *api_server.PipelineUploadClient
+	experiments     *api_server.ExperimentClient
+	runs            *api_server.RunClient
+	jobs            *api_server.JobClient
+	runLogs         *api_server.RunLogClient
+
+	// The clients of the v2beta1 API, which takes the pipeline specs and the
+	// runtime configs of the KFP v2 SDK.
+	pipelinesV2beta1 *api_server.PipelineClientV2beta1
+	runsV2beta1      *api_server.RunClientV2beta1
+	recurringRuns    *api_server.RecurringRunClient
+}
+
+func New(clientConfig clientcmd.ClientConfig, opts ...api_server.ClientOption) (*Client, error) {
+	pipelines, err := api_server.NewPipelineClient(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	pipelineUploads, err := api_server.NewPipelineUploadClient(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	experiments, err := api_server.NewExperimentClient(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	runs, err := api_server.NewRunClient(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := api_server.NewJobClient(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	runLogs, err := api_server.NewRunLogClient(clientConfig, opts...)
+	if err != nil {
+		return nil, err
+	}
+	pipelinesV2beta1, err := api_server.NewPipelineClientV2beta1(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	runsV2beta1, err := api_server.NewRunClientV2beta1(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	recurringRuns, err := api_server.NewRecurringRunClient(clientConfig, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		pipelines:       pipelines,
+		pipelineUploads: pipelineUploads,
+		experiments:     experiments,
+		runs:            runs,
+		jobs:            jobs,
+		runLogs:         runLogs,
+
+		pipelinesV2beta1: pipelinesV2beta1,
+		runsV2beta1:      runsV2beta1,
+		recurringRuns:    recurringRuns,
+	}, nil
+}
+
+// NewInCluster returns a client of the API server deployed in the namespace,
+// connecting to its service directly with the token of the service account of
+// the pod, for the automation running in the cluster.
+func NewInCluster(namespace string, opts ...api_server.ClientOption) (*Client, error) {
+	opts = append([]api_server.ClientOption{
+		api_server.WithEndpoint(api_server.InClusterEndpoint(namespace)),
+		api_server.WithTokenProvider(api_server.NewServiceAccountTokenProvider("")),
+	}, opts...)
+	return New(nil, opts...)
+}
+
+// Pipelines returns the client of the pipeline service, for the requests the
+// Client doesn't wrap. The same goes for the other services.
+func (c *Client) Pipelines() *api_server.PipelineClient {
+	return c.pipelines
+}
+
+func (c *Client) PipelineUploads() *api_server.PipelineUploadClient {
+	return c.pipelineUploads
+}
+
+func (c *Client) Experiments() *api_server.ExperimentClient {
+	return c.experiments
+}
+
+func (c *Client) Runs() *api_server.RunClient {
+	return c.runs
+}
+
+func (c *Client) Jobs() *api_server.JobClient {
+	return c.jobs
+}
+
+// PipelinesV2beta1 returns the client of the v2beta1 pipeline service. The v1
+// models can be sent to the v2beta1 services after converting them with
+// api_server.ToV2beta1Pipeline and the like.
+func (c *Client) PipelinesV2beta1() *api_server.PipelineClientV2beta1 {
+	return c.pipelinesV2beta1
+}
+
+func (c *Client) RunsV2beta1() *api_server.RunClientV2beta1 {
+	return c.runsV2beta1
+}
+
+func (c *Client) RecurringRuns() *api_server.RecurringRunClient {
+	return c.recurringRuns
+}
+
+// UploadPipelineFromFile uploads a pipeline from a local file. The name defaults
+// to the name of the file.
+func (c *Client) UploadPipelineFromFile(ctx context.Context, filePath string, name string) (*uploadmodel.V1Pipeline, error) {
+	parameters := uploadparams.NewUploadPipelineParams()
+	if name != "" {
+		parameters.Name = util.StringPointer(name)
+	}
+	pipeline, err := c.pipelineUploads.UploadFile(ctx, filePath, parameters)
+	return pipeline, newError("UploadPipelineFromFile", err)
+}
+
+// UploadPipelineFromReader uploads a pipeline read from a stream, e.g. a spec
+// compiled in memory, as a file of the name. The name of the pipeline defaults
+// to the name of the file.
+func (c *Client) UploadPipelineFromReader(ctx context.Context, reader io.Reader, fileName string, contentType string,
+	name string) (*uploadmodel.V1Pipeline, error) {
+	parameters := uploadparams.NewUploadPipelineParams()
+	if name != "" {
+		parameters.Name = util.StringPointer(name)
+	}
+	pipeline, err := c.pipelineUploads.UploadReader(ctx, reader, fileName, contentType, parameters)
+	return pipeline, newError("UploadPipelineFromReader", err)
+}
+
+func (c *Client) GetPipeline(ctx context.Context, id string) (*pipelinemodel.V1Pipeline, error) {
+	pipeline, err := c.pipelines.Get(ctx, &pipelineparams.GetPipelineParams{ID: id})
+	return pipeline, newError("GetPipeline", err)
+}
+
+func (c *Client) DeletePipeline(ctx context.Context, id string) error {
+	return newError("DeletePipeline", c.pipelines.Delete(ctx, &pipelineparams.DeletePipelineParams{ID: id}))
+}
+
+// CreateExperiment creates an experiment, in the namespace if it is set.
+func (c *Client) CreateExperiment(ctx context.Context, name string, namespace string) (*experimentmodel.V1Experiment, error) {
+	experiment := &experimentmodel.V1Experiment{Name: name}
+	if namespace != "" {
+		experiment.ResourceReferences = []*experimentmodel.V1ResourceReference{{
+			Key:          &experimentmodel.V1ResourceKey{Type: experimentmodel.V1ResourceTypeNAMESPACE, ID: namespace},
+			Relationship: experimentmodel.V1RelationshipOWNER,
+		}}
+	}
+	experiment, err := c.experiments.Create(ctx, &experimentparams.CreateExperimentParams{Body: experiment})
+	return experiment, newError("CreateExperiment", err)
+}
+
+// RunRequest describes a run of a pipeline version in an experiment.
+type RunRequest struct {
+	Name              string
+	Description       string
+	ExperimentID      string
+	PipelineVersionID string
+	Parameters        map[string]string
+	ServiceAccount    string
+	// DisableCache executes all the steps again, instead of reusing the cached
+	// executions.
+	DisableCache bool
+}
+
+// toRun returns the run of the request. The experiment and the pipeline version
+// are only referenced when set: the API server rejects the references without
+// an ID, and creates the runs without an experiment in the default one.
+func (r *RunRequest) toRun() *runmodel.V1Run {
+	run := &runmodel.V1Run{
+		Name:           r.Name,
+		Description:    r.Description,
+		ServiceAccount: r.ServiceAccount,
+		DisableCache:   r.DisableCache,
+		PipelineSpec:   &runmodel.V1PipelineSpec{},
+	}
+	if r.ExperimentID != "" {
+		run.ResourceReferences = append(run.ResourceReferences, &runmodel.V1ResourceReference{
+			Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypeEXPERIMENT, ID: r.ExperimentID},
+			Relationship: runmodel.V1RelationshipOWNER,
+		})
+	}
+	if r.PipelineVersionID != "" {
+		run.ResourceReferences = append(run.ResourceReferences, &runmodel.V1ResourceReference{
+			Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypePIPELINEVERSION, ID: r.PipelineVersionID},
+			Relationship: runmodel.V1RelationshipCREATOR,
+		})
+	}
+	for name, value := range r.Parameters {
+		run.PipelineSpec.Parameters = append(run.PipelineSpec.Parameters, &runmodel.V1Parameter{Name: name, Value: value})
+	}
+	return run
+}
+
+func (c *Client) CreateRun(ctx context.Context, request *RunRequest) (*runmodel.V1RunDetail, error) {
+	run, _, err := c.runs.Create(ctx, &runparams.CreateRunParams{Body: request.toRun()})
+	return run, newError("CreateRun", err)
+}
+
+func (c *Client) GetRun(ctx context.Context, id string) (*runmodel.V1RunDetail, error) {
+	run, _, err := c.runs.Get(ctx, &runparams.GetRunParams{RunID: id})
+	return run, newError("GetRun", err)
+}
+
+func (c *Client) TerminateRun(ctx context.Context, id string) error {
+	return newError("TerminateRun", c.runs.Terminate(ctx, &runparams.TerminateRunParams{RunID: id}))
+}
+
+// WaitForRun polls a run every interval until it finishes, and returns it. It
+// fails if the run doesn't finish before the deadline of the context.
+func (c *Client) WaitForRun(ctx context.Context, id string, interval time.Duration) (*runmodel.V1RunDetail, error) {
+	run, _, err := c.runs.WaitForRunCompletion(ctx, id, api_server.WithPollInterval(interval))
+	return run, newError("WaitForRun", err)
+}
+
+// CreateRunAndWait creates a run and waits for it to finish, polling it every
+// interval.
+func (c *Client) CreateRunAndWait(ctx context.Context, request *RunRequest, interval time.Duration) (*runmodel.V1RunDetail, error) {
+	run, err := c.CreateRun(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForRun(ctx, run.Run.ID, interval)
+}
+
+// GetRunLogs returns the log of a node of a run.
+func (c *Client) GetRunLogs(ctx context.Context, runID string, nodeID string) (string, error) {
+	var log bytes.Buffer
+	if err := c.runLogs.ReadLog(ctx, runID, nodeID, &log); err != nil {
+		return "", newError("GetRunLogs", err)
+	}
+	return log.String(), nil
+}
+
+// ListRuns returns an iterator over the runs, in the experiment if it is set.
+func (c *Client) ListRuns(ctx context.Context, experimentID string) *api_server.RunIterator {
+	parameters := &runparams.ListRunsParams{}
+	if experimentID != "" {
+		parameters.ResourceReferenceKeyType = util.StringPointer(string(runmodel.V1ResourceTypeEXPERIMENT))
+		parameters.ResourceReferenceKeyID = util.StringPointer(experimentID)
+	}
+	return api_server.NewRunIterator(ctx, c.runs, parameters)
+}
+
+// ListPipelines returns an iterator over the pipelines.
+func (c *Client) ListPipelines(ctx context.Context) *api_server.PipelineIterator {
+	return api_server.NewPipelineIterator(ctx, c.pipelines, &pipelineparams.ListPipelinesParams{})
+}
+
+// ListJobs returns an iterator over the jobs.
+func (c *Client) ListJobs(ctx context.Context) *api_server.JobIterator {
+	return api_server.NewJobIterator(ctx, c.jobs, &jobparams.ListJobsParams{})
+}
@@ -0,0 +1,163 @@
+package kfpclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/experiment_model"
+	runmodel "github.com/kubeflow/pipelines/backend/api/v1/go_http_client/run_model"
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func writeJSON(t *testing.T, w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	assert.Nil(t, json.NewEncoder(w).Encode(body))
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, func()) {
+	server := httptest.NewServer(handler)
+	client, err := New(nil, api_server.WithEndpoint(server.URL), api_server.WithRetryPolicy(api_server.NoRetryPolicy))
+	require.Nil(t, err)
+	return client, server.Close
+}
+
+func TestClient_Errors(t *testing.T) {
+	client, closeServer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, &runmodel.V1Status{Error: "Run run1 not found", Code: int32(codes.NotFound)})
+	})
+
+	_, err := client.GetRun(context.Background(), "run1")
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsAlreadyExists(err))
+	assert.Equal(t, "GetRun", err.(*Error).Op)
+	assert.Contains(t, err.Error(), "Run run1 not found")
+
+	// The requests failing without a response have no code.
+	closeServer()
+	_, err = client.GetRun(context.Background(), "run1")
+	assert.Equal(t, codes.Unknown, Code(err))
+	assert.Equal(t, codes.Unknown, Code(io.EOF))
+}
+
+func TestClient_CreateExperiment(t *testing.T) {
+	client, closeServer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apis/v1/experiments", r.URL.Path)
+		var experiment experimentmodel.V1Experiment
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&experiment))
+		assert.Equal(t, "exp", experiment.Name)
+		assert.Equal(t, []*experimentmodel.V1ResourceReference{{
+			Key:          &experimentmodel.V1ResourceKey{Type: experimentmodel.V1ResourceTypeNAMESPACE, ID: "ns1"},
+			Relationship: experimentmodel.V1RelationshipOWNER,
+		}}, experiment.ResourceReferences)
+		writeJSON(t, w, http.StatusOK, &experimentmodel.V1Experiment{ID: "exp1", Name: "exp"})
+	})
+	defer closeServer()
+
+	experiment, err := client.CreateExperiment(context.Background(), "exp", "ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, "exp1", experiment.ID)
+}
+
+func TestClient_CreateRunAndWait(t *testing.T) {
+	var gets int
+	client, closeServer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		status := ""
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/apis/v1/runs":
+			var run runmodel.V1Run
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&run))
+			assert.Equal(t, []*runmodel.V1Parameter{{Name: "epochs", Value: "3"}}, run.PipelineSpec.Parameters)
+			assert.Len(t, run.ResourceReferences, 2)
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/v1/runs/run1":
+			if gets++; gets > 1 {
+				status = "Failed"
+			}
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		writeJSON(t, w, http.StatusOK, &runmodel.V1RunDetail{
+			Run:             &runmodel.V1Run{ID: "run1", Status: status},
+			PipelineRuntime: &runmodel.V1PipelineRuntime{WorkflowManifest: "{}"},
+		})
+	})
+	defer closeServer()
+
+	run, err := client.CreateRunAndWait(context.Background(), &RunRequest{
+		Name:              "run1",
+		ExperimentID:      "exp1",
+		PipelineVersionID: "version1",
+		Parameters:        map[string]string{"epochs": "3"},
+	}, time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, "Failed", run.Run.Status)
+	assert.Equal(t, 2, gets)
+}
+
+func TestRunRequest_ToRun(t *testing.T) {
+	run := (&RunRequest{Name: "run1", PipelineVersionID: "version1"}).toRun()
+	assert.Equal(t, []*runmodel.V1ResourceReference{{
+		Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypePIPELINEVERSION, ID: "version1"},
+		Relationship: runmodel.V1RelationshipCREATOR,
+	}}, run.ResourceReferences)
+
+	run = (&RunRequest{Name: "run1", ExperimentID: "exp1"}).toRun()
+	assert.Equal(t, []*runmodel.V1ResourceReference{{
+		Key:          &runmodel.V1ResourceKey{Type: runmodel.V1ResourceTypeEXPERIMENT, ID: "exp1"},
+		Relationship: runmodel.V1RelationshipOWNER,
+	}}, run.ResourceReferences)
+
+	assert.Empty(t, (&RunRequest{Name: "run1"}).toRun().ResourceReferences)
+}
+
+func TestClient_GetRunLogs(t *testing.T) {
+	client, closeServer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/v1/runs/run1/nodes/node1/log" {
+			writeJSON(t, w, http.StatusNotFound, map[string]string{"error_message": "Node not found"})
+			return
+		}
+		w.Write([]byte("step 1\nstep 2\n"))
+	})
+	defer closeServer()
+
+	log, err := client.GetRunLogs(context.Background(), "run1", "node1")
+	assert.Nil(t, err)
+	assert.Equal(t, "step 1\nstep 2\n", log)
+
+	_, err = client.GetRunLogs(context.Background(), "run1", "node2")
+	assert.True(t, IsNotFound(err))
+	assert.Contains(t, err.Error(), "Node not found")
+}
+
+func TestClient_ListRuns(t *testing.T) {
+	client, closeServer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "exp1", r.URL.Query().Get("resource_reference_key.id"))
+		assert.Equal(t, "EXPERIMENT", r.URL.Query().Get("resource_reference_key.type"))
+		response := &runmodel.V1ListRunsResponse{Runs: []*runmodel.V1Run{{ID: "run1"}}, NextPageToken: "page2"}
+		if r.URL.Query().Get("page_token") == "page2" {
+			response = &runmodel.V1ListRunsResponse{Runs: []*runmodel.V1Run{{ID: "run2"}}}
+		}
+		writeJSON(t, w, http.StatusOK, response)
+	})
+	defer closeServer()
+
+	it := client.ListRuns(context.Background(), "exp1")
+	var ids []string
+	for {
+		run, err := it.Next()
+		if err == api_server.Done {
+			break
+		}
+		require.Nil(t, err)
+		ids = append(ids, run.ID)
+	}
+	assert.Equal(t, []string{"run1", "run2"}, ids)
+}
@@ -0,0 +1,63 @@
+package kfpclient
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// Error is the error of the methods of the Client. Its code is the gRPC code of
+// the status returned by the API server, or codes.Unknown if the request failed
+// without a response, e.g. when the API server couldn't be reached.
+type Error struct {
+	// Op is the method of the Client which failed, e.g. CreateRun.
+	Op   string
+	Code codes.Code
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.Op, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func newError(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*Error); ok {
+		return err
+	}
+	code := codes.Unknown
+	var statusErr *api_server.APIStatusError
+	if errors.As(err, &statusErr) {
+		code = codes.Code(statusErr.Code)
+	}
+	return &Error{Op: op, Code: code, Err: err}
+}
+
+// Code returns the code of an error of the Client, or codes.Unknown.
+func Code(err error) codes.Code {
+	var clientErr *Error
+	if errors.As(err, &clientErr) {
+		return clientErr.Code
+	}
+	return codes.Unknown
+}
+
+// IsNotFound returns whether an error of the Client is caused by a resource
+// which doesn't exist.
+func IsNotFound(err error) bool {
+	return Code(err) == codes.NotFound
+}
+
+// IsAlreadyExists returns whether an error of the Client is caused by a
+// resource which already exists.
+func IsAlreadyExists(err error) bool {
+	return Code(err) == codes.AlreadyExists
+}
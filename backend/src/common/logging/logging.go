@@ -0,0 +1,198 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logging sets up the structured logs of the backend components, and
+// carries the fields of a request, like its ID, in its context, so that the logs
+// of a request can be correlated across the components.
+package logging
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RequestIDHeader is the HTTP header carrying the ID of a request. It is
+	// read from the requests, or generated, and returned in the responses.
+	RequestIDHeader = "X-Request-Id"
+	// RequestIDMetadataKey is the gRPC metadata key carrying the ID of a request.
+	RequestIDMetadataKey = "x-request-id"
+
+	// The format of the logs, json or text, the default.
+	formatEnvVar = "LOG_FORMAT"
+	// The level of the logs, one of the logrus levels, info by default.
+	levelEnvVar = "LOG_LEVEL"
+)
+
+// The names of the fields of the logs.
+const (
+	FieldComponent = "component"
+	FieldRequestID = "request_id"
+	FieldMethod    = "method"
+	FieldCode      = "code"
+	FieldLatency   = "latency_ms"
+	FieldUser      = "user"
+	FieldNamespace = "namespace"
+	FieldResource  = "resource"
+)
+
+// Init sets up the standard logger of the component, with JSON logs when
+// LOG_FORMAT is json so they can be indexed, and the level of LOG_LEVEL. Every
+// log has the name of the component.
+func Init(component string) error {
+	switch format := strings.ToLower(os.Getenv(formatEnvVar)); format {
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{
+			TimestampFormat: time.RFC3339Nano,
+			FieldMap:        log.FieldMap{log.FieldKeyMsg: "message"},
+		})
+	case "", "text":
+		log.SetFormatter(&log.TextFormatter{FullTimestamp: true})
+	default:
+		return errors.Errorf("Invalid %s '%s', expected json or text", formatEnvVar, format)
+	}
+	if value := os.Getenv(levelEnvVar); value != "" {
+		level, err := log.ParseLevel(value)
+		if err != nil {
+			return errors.Wrapf(err, "Invalid %s", levelEnvVar)
+		}
+		log.SetLevel(level)
+	}
+	log.AddHook(&componentHook{component: component})
+	return nil
+}
+
+// componentHook adds the name of the component to every log.
+type componentHook struct {
+	component string
+}
+
+func (h *componentHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h *componentHook) Fire(entry *log.Entry) error {
+	if _, ok := entry.Data[FieldComponent]; !ok {
+		entry.Data[FieldComponent] = h.component
+	}
+	return nil
+}
+
+type fieldsKey struct{}
+
+// WithFields returns a context carrying the fields, in addition to the fields
+// it already carries, for the logs of FromContext.
+func WithFields(ctx context.Context, fields log.Fields) context.Context {
+	merged := log.Fields{}
+	for key, value := range contextFields(ctx) {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// FromContext returns a logger adding the fields carried by the context, e.g.
+// the request ID, to the logs.
+func FromContext(ctx context.Context) *log.Entry {
+	return log.WithFields(contextFields(ctx))
+}
+
+// RequestID returns the ID of the request of the context, or an empty string.
+func RequestID(ctx context.Context) string {
+	id, _ := contextFields(ctx)[FieldRequestID].(string)
+	return id
+}
+
+// NewRequestID returns a new, unique request ID.
+func NewRequestID() string {
+	return uuid.New().String()
+}
+
+func contextFields(ctx context.Context) log.Fields {
+	fields, _ := ctx.Value(fieldsKey{}).(log.Fields)
+	return fields
+}
+
+func withRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		id = NewRequestID()
+	}
+	return WithFields(ctx, log.Fields{FieldRequestID: id})
+}
+
+// UnaryServerInterceptor carries the ID of the request of every gRPC call in its
+// context, read from the metadata of the caller or generated, and returns it in
+// the response headers.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		ctx = withRequestID(ctx, id)
+		// The header can't be sent for the calls made in-process, without a stream.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, RequestID(ctx)))
+		return handler(ctx, req)
+	}
+}
+
+// UnaryClientInterceptor sends the ID of the request of the context with every
+// gRPC call made, or a new one, and logs the failed calls with it.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if RequestID(ctx) == "" {
+			ctx = withRequestID(ctx, "")
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, RequestID(ctx))
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			FromContext(ctx).WithFields(log.Fields{
+				FieldMethod:  method,
+				FieldCode:    status.Code(err).String(),
+				FieldLatency: time.Since(start).Milliseconds(),
+			}).Warnf("gRPC call failed: %v", err)
+		}
+		return err
+	}
+}
+
+// HTTPHandler carries the ID of every HTTP request in its context, read from its
+// X-Request-Id header or generated, and returns it in the response. The header
+// of the request is set too, so that the HTTP gateway forwards the ID to the
+// gRPC server.
+func HTTPHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := withRequestID(r.Context(), r.Header.Get(RequestIDHeader))
+		r = r.WithContext(ctx)
+		r.Header.Set(RequestIDHeader, RequestID(ctx))
+		w.Header().Set(RequestIDHeader, RequestID(ctx))
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithFields(t *testing.T) {
+	ctx := WithFields(context.Background(), log.Fields{FieldRequestID: "request1", FieldUser: "user1"})
+	ctx = WithFields(ctx, log.Fields{FieldUser: "user2", FieldNamespace: "ns1"})
+
+	assert.Equal(t, "request1", RequestID(ctx))
+	assert.Equal(t, log.Fields{FieldRequestID: "request1", FieldUser: "user2", FieldNamespace: "ns1"},
+		FromContext(ctx).Data)
+	assert.Equal(t, "", RequestID(context.Background()))
+	assert.Empty(t, FromContext(context.Background()).Data)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	var handled context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = ctx
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.RunService/GetRun"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "request1"))
+	_, err := UnaryServerInterceptor()(ctx, nil, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "request1", RequestID(handled))
+
+	_, err = UnaryServerInterceptor()(context.Background(), nil, info, handler)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, RequestID(handled))
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	var sent []string
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		sent = md.Get(RequestIDMetadataKey)
+		return nil
+	}
+
+	ctx := WithFields(context.Background(), log.Fields{FieldRequestID: "request1"})
+	err := UnaryClientInterceptor()(ctx, "/api.ReportService/ReportWorkflow", nil, nil, nil, invoker)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"request1"}, sent)
+
+	err = UnaryClientInterceptor()(context.Background(), "/api.ReportService/ReportWorkflow", nil, nil, nil, invoker)
+	assert.Nil(t, err)
+	assert.Len(t, sent, 1)
+	assert.NotEmpty(t, sent[0])
+}
+
+func TestHTTPHandler(t *testing.T) {
+	var handledID, forwardedID string
+	handler := HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handledID = RequestID(r.Context())
+		forwardedID = r.Header.Get(RequestIDHeader)
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/apis/v1beta1/runs", nil)
+	request.Header.Set(RequestIDHeader, "request1")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, "request1", handledID)
+	assert.Equal(t, "request1", forwardedID)
+	assert.Equal(t, "request1", recorder.Header().Get(RequestIDHeader))
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/apis/v1beta1/runs", nil))
+	assert.NotEmpty(t, handledID)
+	assert.Equal(t, handledID, forwardedID)
+	assert.Equal(t, handledID, recorder.Header().Get(RequestIDHeader))
+}
@@ -42,13 +42,65 @@ const (
 
 	LabelKeyWorkflowRunId               = "pipeline/runid"
 	LabelKeyWorkflowPersistedFinalState = "pipeline/persistedFinalState"
+	// LabelKeyWorkflowReadyForGC marks a workflow whose final state is persisted
+	// and whose TTL has passed, so that an external process can delete it.
+	LabelKeyWorkflowReadyForGC = "pipeline/readyForGC"
 
 	LabelOriginalPipelineRunName = "custom.tekton.dev/originalPipelineRun"
 
+	// AnnotationKeyTaskRunStatuses and AnnotationKeyCustomRunStatuses are
+	// Workflow annotation keys. The persistence agent stores there the statuses
+	// of the TaskRuns and CustomRuns of a run, keyed by their names.
+	AnnotationKeyTaskRunStatuses   = "taskrunStatuses"
+	AnnotationKeyCustomRunStatuses = "customRunStatuses"
+
+	// AnnotationKeyStatusSequence is a Workflow annotation key.
+	// It captures the sequence number of a status reported by the persistence agent.
+	AnnotationKeyStatusSequence = "pipelines.kubeflow.org/status_sequence"
+
+	// AnnotationKeyStatusDelta is a Workflow annotation key.
+	// It marks a reported status that only holds the TaskRun and CustomRun
+	// statuses that changed since the report with the previous sequence number.
+	AnnotationKeyStatusDelta = "pipelines.kubeflow.org/status_delta"
+
+	// AnnotationKeyArchivedLogs is a Workflow annotation key.
+	// It captures the object store keys of the archived TaskRun logs, keyed by pod name.
+	AnnotationKeyArchivedLogs = "pipelines.kubeflow.org/archived_logs"
+
 	// LabelKeyWorkflowEpoch is a Workflow annotation key.
 	// It captures the the name of the Run.
 	AnnotationKeyRunName = "pipelines.kubeflow.org/run_name"
 
+	// AnnotationKeyTemplateWarnings is a Workflow annotation key.
+	// It captures the static analysis warnings of the template the run was created from.
+	AnnotationKeyTemplateWarnings = "pipelines.kubeflow.org/template_warnings"
+
+	// AnnotationKeyBudgetWarning is a Workflow annotation key.
+	// It captures why the run exceeds the budget of its experiment, when the budget only warns.
+	AnnotationKeyBudgetWarning = "pipelines.kubeflow.org/budget_warning"
+
+	// AnnotationKeyStalledAt is a Workflow annotation key.
+	// It captures when the status of a stalled workflow last changed, in epoch seconds,
+	// so that the workflow is no longer stalled once its status changes again.
+	AnnotationKeyStalledAt = "pipelines.kubeflow.org/stalled_at"
+
+	// AnnotationKeyResubmissions is a Workflow annotation key.
+	// It captures how many times the workflow was resubmitted because it stalled.
+	AnnotationKeyResubmissions = "pipelines.kubeflow.org/resubmissions"
+
+	// AnnotationKeyOutputArtifacts is a Workflow annotation key.
+	// It captures the output artifacts of the tasks, keyed by task name.
+	AnnotationKeyOutputArtifacts = "tekton.dev/output_artifacts"
+
+	// AnnotationKeyComponentSpecDigest is a Task annotation key.
+	// It captures the name and type of the outputs of the component of the task.
+	AnnotationKeyComponentSpecDigest = "pipelines.kubeflow.org/component_spec_digest"
+
+	// AnnotationKeyDisableCache is a Workflow annotation key.
+	// It marks a run whose steps are all executed instead of reused from the cache.
+	// Tekton propagates it to the pods of the steps, for the cache server.
+	AnnotationKeyDisableCache = "pipelines.kubeflow.org/disable_cache"
+
 	AnnotationKeyIstioSidecarInject           = "sidecar.istio.io/inject"
 	AnnotationValueIstioSidecarInjectEnabled  = "true"
 	AnnotationValueIstioSidecarInjectDisabled = "false"
@@ -57,4 +109,19 @@ const (
 	// It captures whether this step will be selected by cache service.
 	// To disable/enable cache for a single run, this label needs to be added in every step under a run.
 	LabelKeyCacheEnabled = "pipelines.kubeflow.org/cache_enabled"
+
+	// LabelKeyReusedFromCache is a pod label key.
+	// It marks the pods of the steps whose outputs the cache server reused.
+	LabelKeyReusedFromCache = "pipelines.kubeflow.org/reused_from_cache"
+)
+
+// The reasons of the Events recorded on the PipelineRuns through the lifecycle
+// of their runs.
+const (
+	EventReasonRunCreated     = "RunCreated"
+	EventReasonRunTerminated  = "RunTerminated"
+	EventReasonTasksCached    = "TasksCached"
+	EventReasonRunPersisted   = "RunPersisted"
+	EventReasonRunStalled     = "RunStalled"
+	EventReasonRunResubmitted = "RunResubmitted"
 )
@@ -0,0 +1,90 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// Context variables that can be used anywhere in a PipelineRun template. They are
+// substituted by the apiserver when a run is created and by the scheduled workflow
+// controller when a recurring run is triggered.
+const (
+	// The run ID. "{{workflow.uid}}" is kept for templates compiled for Argo.
+	ContextVariableRunIdArgo   = "{{workflow.uid}}"
+	ContextVariableRunIdTekton = "$(context.pipelineRun.uid)"
+	ContextVariableRunId       = "{{kfp.run.id}}"
+	// The run name set by the user, or the generated name for recurring runs.
+	ContextVariableRunName = "{{kfp.run.name}}"
+	// The name of the PipelineRun created for the run.
+	ContextVariablePipelineRunName = "$ORIG_PR_NAME"
+	// The namespace the run is created in.
+	ContextVariableNamespace = "{{kfp.namespace}}"
+	// The ID of the experiment the run belongs to.
+	ContextVariableExperimentId = "{{kfp.experiment.id}}"
+	// The time the recurring run was scheduled at, in RFC 3339 format.
+	ContextVariableScheduledTime = "{{kfp.scheduledTime}}"
+)
+
+// ContextVariables holds the values of the context variables for a run. Variables
+// whose value is empty, e.g. the scheduled time of a one-off run, are left as is.
+type ContextVariables struct {
+	RunId           string
+	RunName         string
+	PipelineRunName string
+	Namespace       string
+	ExperimentId    string
+	ScheduledAt     int64
+}
+
+func (v ContextVariables) replacer() *strings.Replacer {
+	var pairs []string
+	add := func(value string, placeholders ...string) {
+		if value == "" {
+			return
+		}
+		// Values are substituted into the JSON serialized PipelineRun, so they are
+		// escaped to keep names with quotes or backslashes from breaking it.
+		escaped, _ := json.Marshal(value)
+		for _, placeholder := range placeholders {
+			pairs = append(pairs, placeholder, string(escaped[1:len(escaped)-1]))
+		}
+	}
+	add(v.RunId, ContextVariableRunIdArgo, ContextVariableRunIdTekton, ContextVariableRunId)
+	add(v.RunName, ContextVariableRunName)
+	add(v.PipelineRunName, ContextVariablePipelineRunName)
+	add(v.Namespace, ContextVariableNamespace)
+	add(v.ExperimentId, ContextVariableExperimentId)
+	if v.ScheduledAt > 0 {
+		add(time.Unix(v.ScheduledAt, 0).UTC().Format(time.RFC3339), ContextVariableScheduledTime)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// ReplaceContextVariables substitutes the context variables throughout the workflow.
+func (w *Workflow) ReplaceContextVariables(variables ContextVariables) error {
+	newWorkflowString := variables.replacer().Replace(w.ToStringForStore())
+	var workflow *workflowapi.PipelineRun
+	if err := json.Unmarshal([]byte(newWorkflowString), &workflow); err != nil {
+		return NewInternalServerError(err,
+			"Failed to unmarshal workflow spec manifest. Workflow: %s", w.ToStringForStore())
+	}
+	w.PipelineRun = workflow
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReplaceContextVariables(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "run",
+			Annotations: map[string]string{
+				"ids":        "{{workflow.uid}} $(context.pipelineRun.uid) {{kfp.run.id}}",
+				"name":       "{{kfp.run.name}}",
+				"pr":         "$ORIG_PR_NAME",
+				"namespace":  "{{kfp.namespace}}",
+				"experiment": "{{kfp.experiment.id}}",
+				"scheduled":  "{{kfp.scheduledTime}}",
+			},
+		},
+	})
+
+	err := workflow.ReplaceContextVariables(ContextVariables{
+		RunId:           "123",
+		RunName:         `my "run"`,
+		PipelineRunName: "run-12345",
+		Namespace:       "ns",
+		ExperimentId:    "exp",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"ids":        "123 123 123",
+		"name":       `my "run"`,
+		"pr":         "run-12345",
+		"namespace":  "ns",
+		"experiment": "exp",
+		"scheduled":  "{{kfp.scheduledTime}}",
+	}, workflow.Annotations)
+
+	err = workflow.ReplaceContextVariables(ContextVariables{ScheduledAt: 1700000000})
+	assert.Nil(t, err)
+	assert.Equal(t, "2023-11-14T22:13:20Z", workflow.Annotations["scheduled"])
+}
@@ -16,11 +16,13 @@ package util
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
 
 	"github.com/go-openapi/runtime"
-	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
@@ -34,6 +36,9 @@ const (
 	CUSTOM_CODE_PERMANENT CustomCode = 1
 	CUSTOM_CODE_NOT_FOUND CustomCode = 2
 	CUSTOM_CODE_GENERIC   CustomCode = 3
+	// CUSTOM_CODE_OUT_OF_SYNC means the receiver cannot apply an incremental
+	// update and needs the full resource.
+	CUSTOM_CODE_OUT_OF_SYNC CustomCode = 4
 )
 
 type APICode int
@@ -79,6 +84,22 @@ func HasCustomCode(err error, code CustomCode) bool {
 	}
 }
 
+// Reasons returned to the clients with the errors, so they can tell errors of the
+// same status code apart without parsing the messages. Errors without a specific
+// reason use the name of their status code, e.g. NOT_FOUND.
+const (
+	ReasonExperimentNameExists      = "EXPERIMENT_NAME_EXISTS"
+	ReasonPipelineNameExists        = "PIPELINE_NAME_EXISTS"
+	ReasonPipelineVersionNameExists = "PIPELINE_VERSION_NAME_EXISTS"
+	ReasonRunMetricExists           = "RUN_METRIC_EXISTS"
+	ReasonInvalidPipelineSpec       = "INVALID_PIPELINE_SPEC"
+	ReasonRateLimited               = "RATE_LIMITED"
+	ReasonConcurrentRunLimit        = "CONCURRENT_RUN_LIMIT"
+	ReasonShuttingDown              = "SHUTTING_DOWN"
+	ReasonStatusSequenceMismatch    = "STATUS_SEQUENCE_MISMATCH"
+	ReasonExperimentBudgetExceeded  = "EXPERIMENT_BUDGET_EXCEEDED"
+)
+
 type UserError struct {
 	// Error for internal debugging.
 	internalError error
@@ -86,6 +107,10 @@ type UserError struct {
 	externalMessage string
 	// Status code for the external client.
 	externalStatusCode codes.Code
+	// Machine-readable reason for the external client.
+	reason string
+	// Invalid fields of the request.
+	fieldViolations []*api.FieldViolation
 }
 
 func newUserError(internalError error, externalMessage string,
@@ -210,10 +235,63 @@ func NewPermissionDeniedError(err error, externalFormat string, a ...interface{}
 		codes.PermissionDenied)
 }
 
+func NewResourceExhaustedError(err error, externalFormat string, a ...interface{}) *UserError {
+	externalMessage := fmt.Sprintf(externalFormat, a...)
+	return newUserError(
+		errors.Wrapf(err, fmt.Sprintf("ResourceExhausted: %v", externalMessage)),
+		externalMessage,
+		codes.ResourceExhausted)
+}
+
+func NewFailedPreconditionError(err error, externalFormat string, a ...interface{}) *UserError {
+	externalMessage := fmt.Sprintf(externalFormat, a...)
+	return newUserError(
+		errors.Wrapf(err, fmt.Sprintf("FailedPrecondition: %v", externalMessage)),
+		externalMessage,
+		codes.FailedPrecondition)
+}
+
+func NewUnavailableError(err error, externalFormat string, a ...interface{}) *UserError {
+	externalMessage := fmt.Sprintf(externalFormat, a...)
+	return newUserError(
+		errors.Wrapf(err, fmt.Sprintf("Unavailable: %v", externalMessage)),
+		externalMessage,
+		codes.Unavailable)
+}
+
+// WithReason sets the machine-readable reason of the error.
+func (e *UserError) WithReason(reason string) *UserError {
+	e.reason = reason
+	return e
+}
+
+// WithFieldViolation records a field of the request that failed validation.
+func (e *UserError) WithFieldViolation(field string, description string) *UserError {
+	e.fieldViolations = append(e.fieldViolations, &api.FieldViolation{Field: field, Description: description})
+	return e
+}
+
 func (e *UserError) ExternalMessage() string {
 	return e.externalMessage
 }
 
+func (e *UserError) Reason() string {
+	if e.reason != "" {
+		return e.reason
+	}
+	return codeReason(e.externalStatusCode)
+}
+
+// Retryable reports whether the request may succeed if it's retried unchanged.
+func (e *UserError) Retryable() bool {
+	switch e.externalStatusCode {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
 func (e *UserError) ExternalStatusCode() codes.Code {
 	return e.externalStatusCode
 }
@@ -236,21 +314,23 @@ func (e *UserError) Unwrap() error {
 }
 
 func (e *UserError) wrapf(format string, args ...interface{}) *UserError {
-	return newUserError(errors.Wrapf(e.internalError, format, args...),
-		e.externalMessage, e.externalStatusCode)
+	wrapped := *e
+	wrapped.internalError = errors.Wrapf(e.internalError, format, args...)
+	return &wrapped
 }
 
 func (e *UserError) wrap(message string) *UserError {
-	return newUserError(errors.Wrap(e.internalError, message),
-		e.externalMessage, e.externalStatusCode)
+	wrapped := *e
+	wrapped.internalError = errors.Wrap(e.internalError, message)
+	return &wrapped
 }
 
 func (e *UserError) Log() {
 	switch e.externalStatusCode {
 	case codes.Aborted, codes.InvalidArgument, codes.NotFound, codes.Internal:
-		glog.Infof("%+v", e.internalError)
+		log.Infof("%+v", e.internalError)
 	default:
-		glog.Errorf("%+v", e.internalError)
+		log.Errorf("%+v", e.internalError)
 	}
 }
 
@@ -286,7 +366,30 @@ func LogError(err error) {
 		err.(*UserError).Log()
 	default:
 		// We log all the details.
-		glog.Errorf("InternalError: %+v", err)
+		log.Errorf("InternalError: %+v", err)
+	}
+}
+
+// ToAPIError converts the error to the structured error returned to the clients,
+// both as the details of gRPC errors and in the body of HTTP errors.
+func ToAPIError(err error) *api.Error {
+	switch err.(type) {
+	case *UserError:
+		userError := err.(*UserError)
+		return &api.Error{
+			ErrorMessage:    userError.externalMessage,
+			ErrorDetails:    userError.internalError.Error(),
+			Reason:          userError.Reason(),
+			Retryable:       userError.Retryable(),
+			FieldViolations: userError.fieldViolations,
+		}
+	default:
+		externalMessage := fmt.Sprintf("Internal error: %+v", err)
+		return &api.Error{
+			ErrorMessage: externalMessage,
+			ErrorDetails: externalMessage,
+			Reason:       codeReason(codes.Internal),
+		}
 	}
 }
 
@@ -295,27 +398,23 @@ func ToGRPCError(err error) error {
 	case *UserError:
 		userError := err.(*UserError)
 		stat := status.New(userError.externalStatusCode, userError.internalError.Error())
-		statWithDetail, statErr := stat.
-			WithDetails(&api.Error{
-				ErrorMessage: userError.externalMessage,
-				ErrorDetails: userError.internalError.Error(),
-			})
+		statWithDetail, statErr := stat.WithDetails(ToAPIError(userError))
 
 		if statErr != nil {
 			// Failed to stream error message as proto.
-			glog.Errorf("Failed to stream gRpc error. Error to be streamed: %v Error: %v",
+			log.Errorf("Failed to stream gRpc error. Error to be streamed: %v Error: %v",
 				userError.String(), statErr)
 			return stat.Err()
 		}
 		return statWithDetail.Err()
 	default:
-		externalMessage := fmt.Sprintf("Internal error: %+v", err)
+		apiError := ToAPIError(err)
+		externalMessage := apiError.ErrorMessage
 		stat := status.New(codes.Internal, externalMessage)
-		statWithDetail, statErr := stat.
-			WithDetails(&api.Error{ErrorMessage: externalMessage, ErrorDetails: externalMessage})
+		statWithDetail, statErr := stat.WithDetails(apiError)
 		if statErr != nil {
 			// Failed to stream error message as proto.
-			glog.Errorf("Failed to stream gRpc error. Error to be streamed: %v Error: %v",
+			log.Errorf("Failed to stream gRpc error. Error to be streamed: %v Error: %v",
 				externalMessage, statErr)
 			return stat.Err()
 		}
@@ -323,10 +422,29 @@ func ToGRPCError(err error) error {
 	}
 }
 
+// codeReason returns the name of the status code in upper snake case, e.g. NOT_FOUND
+// for codes.NotFound.
+func codeReason(code codes.Code) string {
+	if code > codes.Unauthenticated {
+		// Not a gRPC code, e.g. an HTTP status code of another service.
+		return codeReason(codes.Unknown)
+	}
+	var reason strings.Builder
+	previous := ' '
+	for _, r := range code.String() {
+		if unicode.IsUpper(r) && unicode.IsLower(previous) {
+			reason.WriteRune('_')
+		}
+		reason.WriteRune(unicode.ToUpper(r))
+		previous = r
+	}
+	return reason.String()
+}
+
 // TerminateIfError Check if error is nil. Terminate if not.
 func TerminateIfError(err error) {
 	if err != nil {
-		glog.Fatalf("%v", err)
+		log.Fatalf("%v", err)
 	}
 }
 
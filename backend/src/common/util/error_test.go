@@ -15,9 +15,13 @@
 package util
 
 import (
+	"fmt"
 	"testing"
 
+	api "github.com/kubeflow/pipelines/backend/api/v1/go_client"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -26,3 +30,36 @@ func TestIsNotFound(t *testing.T) {
 	assert.Equal(t, true, IsNotFound(errors.NewNotFound(schema.GroupResource{}, "NAME")))
 	assert.Equal(t, false, IsNotFound(errors.NewAlreadyExists(schema.GroupResource{}, "NAME")))
 }
+
+func TestToAPIError(t *testing.T) {
+	err := NewAlreadyExistError("The name %v already exists.", "pipeline1").WithReason(ReasonPipelineNameExists)
+	apiError := ToAPIError(Wrap(err, "Failed to create pipeline"))
+	assert.Equal(t, "The name pipeline1 already exists.", apiError.ErrorMessage)
+	assert.Contains(t, apiError.ErrorDetails, "Failed to create pipeline")
+	assert.Equal(t, ReasonPipelineNameExists, apiError.Reason)
+	assert.False(t, apiError.Retryable)
+
+	apiError = ToAPIError(NewInvalidInputError("The run name is empty.").WithFieldViolation("run.name", "must not be empty"))
+	assert.Equal(t, "INVALID_ARGUMENT", apiError.Reason)
+	assert.Equal(t, []*api.FieldViolation{{Field: "run.name", Description: "must not be empty"}}, apiError.FieldViolations)
+
+	apiError = ToAPIError(NewResourceExhaustedError(fmt.Errorf("limit"), "Too many requests"))
+	assert.Equal(t, "RESOURCE_EXHAUSTED", apiError.Reason)
+	assert.True(t, apiError.Retryable)
+
+	apiError = ToAPIError(fmt.Errorf("unexpected"))
+	assert.Equal(t, "INTERNAL", apiError.Reason)
+	assert.False(t, apiError.Retryable)
+}
+
+func TestToGRPCError_Details(t *testing.T) {
+	err := ToGRPCError(NewNotFoundError(fmt.Errorf("not found"), "Run run1 not found."))
+	stat, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, stat.Code())
+	assert.Len(t, stat.Details(), 1)
+	apiError, ok := stat.Details()[0].(*api.Error)
+	assert.True(t, ok)
+	assert.Equal(t, "NOT_FOUND", apiError.Reason)
+	assert.Equal(t, "Run run1 not found.", apiError.ErrorMessage)
+}
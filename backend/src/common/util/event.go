@@ -0,0 +1,38 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"github.com/pkg/errors"
+	workflowscheme "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/scheme"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// NewRunEventRecorder returns a recorder of the Events of the runs, recorded on
+// their PipelineRuns by the component, so that kubectl describe pipelinerun tells
+// the story of the run.
+func NewRunEventRecorder(clientSet kubernetes.Interface, component string) (record.EventRecorder, error) {
+	eventScheme := runtime.NewScheme()
+	if err := workflowscheme.AddToScheme(eventScheme); err != nil {
+		return nil, errors.Wrap(err, "Failed to register the PipelineRun types of the Events")
+	}
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientSet.CoreV1().Events("")})
+	return broadcaster.NewRecorder(eventScheme, corev1.EventSource{Component: component}), nil
+}
@@ -19,7 +19,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/golang/glog"
+	log "github.com/sirupsen/logrus"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 )
 
@@ -33,7 +33,7 @@ func NewWorkflowFormatter(uuid UUIDGeneratorInterface, scheduledAtInSec int64,
 	nowInSec int64) *WorkflowFormatter {
 
 	if uuid == nil {
-		glog.Fatalf("A UUID generator must be specified.") // Should never happen.
+		log.Fatalf("A UUID generator must be specified.") // Should never happen.
 	}
 
 	return &WorkflowFormatter{
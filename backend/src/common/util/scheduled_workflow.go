@@ -15,8 +15,8 @@
 package util
 
 import (
-	"github.com/golang/glog"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	log "github.com/sirupsen/logrus"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"k8s.io/apimachinery/pkg/util/json"
 )
@@ -134,7 +134,7 @@ func (s *ScheduledWorkflow) ParametersAsString() (string, error) {
 func (s *ScheduledWorkflow) ToStringForStore() string {
 	swf, err := json.Marshal(s.ScheduledWorkflow)
 	if err != nil {
-		glog.Errorf("Could not marshal the scheduled workflow: %v", s.ScheduledWorkflow)
+		log.Errorf("Could not marshal the scheduled workflow: %v", s.ScheduledWorkflow)
 		return ""
 	}
 	return string(swf)
@@ -0,0 +1,238 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// SecretParameterPrefix marks a parameter value referencing the key of a
+	// Kubernetes Secret in the namespace of the run, e.g. "secretKeyRef:db/password".
+	SecretParameterPrefix = "secretKeyRef:"
+	// SecretParameterEnvPrefix prefixes the environment variables holding the
+	// values of the secret parameters.
+	SecretParameterEnvPrefix = "KFP_SECRET_PARAM_"
+)
+
+// IsSecretParameter returns whether a parameter value references a secret.
+func IsSecretParameter(value string) bool {
+	return strings.HasPrefix(value, SecretParameterPrefix)
+}
+
+// ParseSecretParameter parses a parameter value of the form
+// "secretKeyRef:<secret name>/<key>".
+func ParseSecretParameter(value string) (*corev1.SecretKeySelector, error) {
+	ref := strings.TrimPrefix(value, SecretParameterPrefix)
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, NewInvalidInputError("Secret parameter %q must have the form %s<secret name>/<key>.", value, SecretParameterPrefix)
+	}
+	name, key := parts[0], parts[1]
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return nil, NewInvalidInputError("Secret parameter %q has an invalid secret name: %s.", value, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		return nil, NewInvalidInputError("Secret parameter %q has an invalid key: %s.", value, strings.Join(errs, ", "))
+	}
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}, nil
+}
+
+// secretParameterEnvName returns the environment variable holding the value of
+// a secret parameter.
+func secretParameterEnvName(parameter string) string {
+	return SecretParameterEnvPrefix + strings.ToUpper(strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, parameter))
+}
+
+// ReferenceSecretParameters replaces the value of the secret parameters of a
+// PipelineRun with a reference to an environment variable, which is set from the
+// secret in the pod template of every task. Kubernetes expands the reference in
+// the command, args and env of the steps, so the secret value is never part of
+// the PipelineRun. The secret parameters used anywhere else in an inline pipeline
+// spec are rejected, see checkSecretParameterUses. It returns the new parameter
+// values by parameter name.
+func ReferenceSecretParameters(spec *workflowapi.PipelineRunSpec) (map[string]string, error) {
+	secretParams := map[string]string{}
+	for _, param := range spec.Params {
+		if param.Value.Type == workflowapi.ParamTypeString && IsSecretParameter(param.Value.StringVal) {
+			secretParams[param.Name] = param.Name
+		}
+	}
+	if err := checkSecretParameterUses(spec.PipelineSpec, secretParams); err != nil {
+		return nil, err
+	}
+	var envs []corev1.EnvVar
+	references := map[string]string{}
+	params := map[string]string{}
+	for i, param := range spec.Params {
+		if param.Value.Type != workflowapi.ParamTypeString || !IsSecretParameter(param.Value.StringVal) {
+			continue
+		}
+		selector, err := ParseSecretParameter(param.Value.StringVal)
+		if err != nil {
+			return nil, err
+		}
+		envName := secretParameterEnvName(param.Name)
+		if other, ok := params[envName]; ok {
+			return nil, NewInvalidInputError("Secret parameters %s and %s map to the same environment variable %s.", other, param.Name, envName)
+		}
+		params[envName] = param.Name
+		envs = append(envs, corev1.EnvVar{
+			Name:      envName,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: selector},
+		})
+		reference := fmt.Sprintf("$(%s)", envName)
+		spec.Params[i].Value = *workflowapi.NewStructuredValues(reference)
+		references[param.Name] = reference
+	}
+	if len(envs) == 0 {
+		return references, nil
+	}
+	if spec.TaskRunTemplate.PodTemplate == nil {
+		spec.TaskRunTemplate.PodTemplate = &pod.PodTemplate{}
+	}
+	setEnv(spec.TaskRunTemplate.PodTemplate, envs)
+	// A task with its own pod template doesn't get the env of the PipelineRun one
+	// with every Tekton version, so it's set there too.
+	for _, taskRunSpec := range spec.TaskRunSpecs {
+		if taskRunSpec.PodTemplate != nil {
+			setEnv(taskRunSpec.PodTemplate, envs)
+		}
+	}
+	return references, nil
+}
+
+// checkSecretParameterUses rejects the uses of the secret parameters of an inline
+// pipeline spec where their environment variable reference isn't expanded: the
+// when expressions, the params of the custom tasks, and the scripts, images and
+// working directories of the steps and sidecars. The tasks referenced by name
+// aren't part of the spec, so they aren't checked.
+func checkSecretParameterUses(spec *workflowapi.PipelineSpec, secretParams map[string]string) error {
+	if spec == nil || len(secretParams) == 0 {
+		return nil
+	}
+	for _, task := range append(append([]workflowapi.PipelineTask{}, spec.Tasks...), spec.Finally...) {
+		if name := referencedParameter(secretParams, task.When); name != "" {
+			return NewInvalidInputError("Secret parameter %s can't be used in the when expressions of task %s.", name, task.Name)
+		}
+		if isCustomTask(&task) {
+			if name := referencedParameter(secretParams, task.Params); name != "" {
+				return NewInvalidInputError("Secret parameter %s can't be passed to custom task %s.", name, task.Name)
+			}
+			continue
+		}
+		if task.TaskSpec == nil {
+			continue
+		}
+		// The params of the task taking the value of a secret parameter, mapped to
+		// the secret parameter.
+		taskSecretParams := map[string]string{}
+		for _, param := range task.Params {
+			if name := referencedParameter(secretParams, param.Value); name != "" {
+				taskSecretParams[param.Name] = name
+			}
+		}
+		var fields []interface{}
+		for _, step := range task.TaskSpec.Steps {
+			fields = append(fields, step.Script, step.Image, step.WorkingDir)
+		}
+		for _, sidecar := range task.TaskSpec.Sidecars {
+			fields = append(fields, sidecar.Script, sidecar.Image, sidecar.WorkingDir)
+		}
+		if stepTemplate := task.TaskSpec.StepTemplate; stepTemplate != nil {
+			fields = append(fields, stepTemplate.Image, stepTemplate.WorkingDir)
+		}
+		if name := referencedParameter(taskSecretParams, fields...); name != "" {
+			return NewInvalidInputError("Secret parameter %s can't be used in a script, image or working directory of task %s, "+
+				"only in the command, args and env of its steps.", name, task.Name)
+		}
+	}
+	return nil
+}
+
+// isCustomTask returns whether a pipeline task is run by a custom task
+// controller, which gets the params as they are.
+func isCustomTask(task *workflowapi.PipelineTask) bool {
+	if task.TaskSpec != nil {
+		return task.TaskSpec.Kind != ""
+	}
+	return task.TaskRef != nil && task.TaskRef.APIVersion != ""
+}
+
+// referencedParameter returns the value of the first parameter of params, by
+// name, referenced in the fields, or an empty string if there's none.
+func referencedParameter(params map[string]string, fields ...interface{}) string {
+	if len(params) == 0 {
+		return ""
+	}
+	bytes, err := json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+	serialized := string(bytes)
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, reference := range []string{
+			"$(params." + name + ")",
+			"$(params." + name + "[",
+			"$(params['" + name + "']",
+			`$(params[\"` + name + `\"]`,
+			"$(inputs.params." + name + ")",
+		} {
+			if strings.Contains(serialized, reference) {
+				return params[name]
+			}
+		}
+	}
+	return ""
+}
+
+// setEnv sets environment variables in a pod template, replacing the ones with
+// the same name.
+func setEnv(podTemplate *pod.PodTemplate, envs []corev1.EnvVar) {
+	for _, env := range envs {
+		found := false
+		for i, existing := range podTemplate.Env {
+			if existing.Name == env.Name {
+				podTemplate.Env[i] = env
+				found = true
+				break
+			}
+		}
+		if !found {
+			podTemplate.Env = append(podTemplate.Env, env)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+func TestParseSecretParameter(t *testing.T) {
+	selector, err := ParseSecretParameter("secretKeyRef:db-credentials/password")
+	assert.Nil(t, err)
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"},
+		Key:                  "password",
+	}, selector)
+
+	for _, value := range []string{
+		"secretKeyRef:db-credentials",
+		"secretKeyRef:/password",
+		"secretKeyRef:db-credentials/",
+		"secretKeyRef:DB/password",
+		"secretKeyRef:db-credentials/pass/word",
+	} {
+		_, err = ParseSecretParameter(value)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func TestReferenceSecretParameters(t *testing.T) {
+	spec := &workflowapi.PipelineRunSpec{
+		Params: []workflowapi.Param{
+			{Name: "db-password", Value: *workflowapi.NewStructuredValues("secretKeyRef:db-credentials/password")},
+			{Name: "epochs", Value: *workflowapi.NewStructuredValues("10")},
+		},
+		TaskRunSpecs: []workflowapi.PipelineTaskRunSpec{
+			{PipelineTaskName: "train", PodTemplate: &pod.PodTemplate{NodeSelector: map[string]string{"pool": "gpu"}}},
+			{PipelineTaskName: "evaluate"},
+		},
+	}
+	references, err := ReferenceSecretParameters(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"db-password": "$(KFP_SECRET_PARAM_DB_PASSWORD)"}, references)
+	assert.Equal(t, map[string]string{"db-password": "$(KFP_SECRET_PARAM_DB_PASSWORD)", "epochs": "10"},
+		NewWorkflow(&workflowapi.PipelineRun{Spec: *spec}).GetWorkflowParametersAsMap())
+	expectedEnv := []corev1.EnvVar{{
+		Name: "KFP_SECRET_PARAM_DB_PASSWORD",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "db-credentials"},
+			Key:                  "password",
+		}},
+	}}
+	assert.Equal(t, expectedEnv, spec.TaskRunTemplate.PodTemplate.Env)
+	assert.Equal(t, expectedEnv, spec.TaskRunSpecs[0].PodTemplate.Env)
+	assert.Nil(t, spec.TaskRunSpecs[1].PodTemplate)
+
+	// Referencing again doesn't duplicate the env.
+	spec.Params[0].Value = *workflowapi.NewStructuredValues("secretKeyRef:db-credentials/password")
+	_, err = ReferenceSecretParameters(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEnv, spec.TaskRunTemplate.PodTemplate.Env)
+
+	spec = &workflowapi.PipelineRunSpec{
+		Params: []workflowapi.Param{
+			{Name: "db-password", Value: *workflowapi.NewStructuredValues("secretKeyRef:db-credentials/password")},
+			{Name: "db_password", Value: *workflowapi.NewStructuredValues("secretKeyRef:db-credentials/password")},
+		},
+	}
+	_, err = ReferenceSecretParameters(spec)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "same environment variable")
+}
+
+func TestReferenceSecretParameters_Uses(t *testing.T) {
+	newSpec := func(task workflowapi.PipelineTask) *workflowapi.PipelineRunSpec {
+		return &workflowapi.PipelineRunSpec{
+			Params: []workflowapi.Param{
+				{Name: "db-password", Value: *workflowapi.NewStructuredValues("secretKeyRef:db-credentials/password")},
+			},
+			PipelineSpec: &workflowapi.PipelineSpec{Tasks: []workflowapi.PipelineTask{task}},
+		}
+	}
+	taskParams := workflowapi.Params{{Name: "password", Value: *workflowapi.NewStructuredValues("$(params.db-password)")}}
+
+	// The command, args and env of the steps expand the reference.
+	_, err := ReferenceSecretParameters(newSpec(workflowapi.PipelineTask{
+		Name:   "ingest",
+		Params: taskParams,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: workflowapi.TaskSpec{Steps: []workflowapi.Step{{
+			Name:    "main",
+			Image:   "python:3.9",
+			Command: []string{"ingest", "--password", "$(params.password)"},
+		}}}},
+	}))
+	assert.Nil(t, err)
+
+	_, err = ReferenceSecretParameters(newSpec(workflowapi.PipelineTask{
+		Name:   "ingest",
+		Params: taskParams,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: workflowapi.TaskSpec{Steps: []workflowapi.Step{{
+			Name:   "main",
+			Image:  "python:3.9",
+			Script: "ingest --password '$(params.password)'",
+		}}}},
+	}))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "script, image or working directory of task ingest")
+
+	_, err = ReferenceSecretParameters(newSpec(workflowapi.PipelineTask{
+		Name:     "notify",
+		Params:   taskParams,
+		TaskSpec: &workflowapi.EmbeddedTask{TypeMeta: runtime.TypeMeta{APIVersion: "custom.tekton.dev/v1alpha1", Kind: "Notify"}},
+	}))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "custom task notify")
+
+	_, err = ReferenceSecretParameters(newSpec(workflowapi.PipelineTask{
+		Name:    "notify",
+		Params:  taskParams,
+		TaskRef: &workflowapi.TaskRef{APIVersion: "custom.tekton.dev/v1alpha1", Kind: "Notify"},
+	}))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "custom task notify")
+
+	_, err = ReferenceSecretParameters(newSpec(workflowapi.PipelineTask{
+		Name:    "ingest",
+		TaskRef: &workflowapi.TaskRef{Name: "ingest"},
+		When:    workflowapi.WhenExpressions{{Input: "$(params.db-password)", Operator: selection.NotIn, Values: []string{""}}},
+	}))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "when expressions of task ingest")
+}
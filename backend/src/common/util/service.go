@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
+	"github.com/kubeflow/pipelines/backend/src/common/logging"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"k8s.io/client-go/kubernetes"
@@ -74,7 +76,8 @@ func GetKubernetesClientFromClientConfig(clientConfig clientcmd.ClientConfig) (
 }
 
 func GetRpcConnection(address string) (*grpc.ClientConn, error) {
-	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithChainUnaryInterceptor(
+		tracing.UnaryClientInterceptor(), logging.UnaryClientInterceptor()))
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to create gRPC connection")
 	}
@@ -0,0 +1,180 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// DefaultTektonNamespace is the namespace Tekton Pipelines is installed in.
+	DefaultTektonNamespace = "tekton-pipelines"
+	// MinTektonVersion is the oldest Tekton Pipelines release that KFP runs on.
+	MinTektonVersion = "v0.50.0"
+
+	tektonPipelinesInfoConfigMap = "pipelines-info"
+	tektonFeatureFlagsConfigMap  = "feature-flags"
+
+	tektonFlagEnableCustomTasks = "enable-custom-tasks"
+	tektonFlagEnableAPIFields   = "enable-api-fields"
+	tektonFlagResultsFrom       = "results-from"
+	tektonFlagMaxResultSize     = "max-result-size"
+)
+
+// The modes of the Tekton compatibility check run at startup.
+const (
+	// TektonCompatibilityCheckFail stops the component when a required feature
+	// is missing.
+	TektonCompatibilityCheckFail = "fail"
+	// TektonCompatibilityCheckWarn only logs the missing features.
+	TektonCompatibilityCheckWarn = "warn"
+	// TektonCompatibilityCheckOff skips the check.
+	TektonCompatibilityCheckOff = "off"
+)
+
+// TektonInstallation is the version and the feature flags of the installed
+// Tekton Pipelines.
+type TektonInstallation struct {
+	// Version is empty when the pipelines-info ConfigMap can't be read.
+	Version string
+	// FeatureFlags is nil when the feature-flags ConfigMap can't be read, e.g.
+	// for lack of RBAC, and empty when the defaults of Tekton are used.
+	FeatureFlags map[string]string
+}
+
+// TektonCompatibilityIssue is a feature of Tekton Pipelines that KFP needs or
+// relies on and the installation lacks.
+type TektonCompatibilityIssue struct {
+	Message string
+	// Required issues break the runs, the others only degrade some features.
+	Required bool
+}
+
+// GetTektonInstallation reads the version and the feature flags of Tekton
+// Pipelines from the ConfigMaps of its namespace. The ConfigMaps that can't be
+// read are logged and left out.
+func GetTektonInstallation(ctx context.Context, configMaps corev1client.ConfigMapInterface) *TektonInstallation {
+	installation := &TektonInstallation{}
+	info, err := configMaps.Get(ctx, tektonPipelinesInfoConfigMap, metav1.GetOptions{})
+	if err == nil {
+		installation.Version = info.Data["version"]
+	} else if !IsNotFound(err) {
+		log.Warnf("Failed to get the Tekton ConfigMap %s. Error: %v", tektonPipelinesInfoConfigMap, err)
+	}
+	flags, err := configMaps.Get(ctx, tektonFeatureFlagsConfigMap, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		installation.FeatureFlags = map[string]string{}
+		for key, value := range flags.Data {
+			installation.FeatureFlags[key] = strings.TrimSpace(value)
+		}
+	case IsNotFound(err):
+		installation.FeatureFlags = map[string]string{}
+	default:
+		log.Warnf("Failed to get the Tekton ConfigMap %s, the feature flags are not checked. Error: %v",
+			tektonFeatureFlagsConfigMap, err)
+	}
+	return installation
+}
+
+// CompatibilityIssues lists the features that KFP needs and the installation
+// lacks.
+func (t *TektonInstallation) CompatibilityIssues() []TektonCompatibilityIssue {
+	var issues []TektonCompatibilityIssue
+	if t.Version == "" {
+		issues = append(issues, TektonCompatibilityIssue{
+			Message: fmt.Sprintf("The Tekton Pipelines version is unknown, KFP requires %s or later", MinTektonVersion),
+		})
+	} else if installed, err := version.ParseSemantic(t.Version); err != nil {
+		issues = append(issues, TektonCompatibilityIssue{
+			Message: fmt.Sprintf("The Tekton Pipelines version %q can't be parsed, KFP requires %s or later", t.Version, MinTektonVersion),
+		})
+	} else if installed.LessThan(version.MustParseSemantic(MinTektonVersion)) {
+		issues = append(issues, TektonCompatibilityIssue{
+			Message:  fmt.Sprintf("Tekton Pipelines %s is installed, KFP requires %s or later", t.Version, MinTektonVersion),
+			Required: true,
+		})
+	}
+	if t.FeatureFlags == nil {
+		return issues
+	}
+	// Tekton releases before custom tasks were always enabled have the flag.
+	if strings.EqualFold(t.FeatureFlags[tektonFlagEnableCustomTasks], "false") {
+		issues = append(issues, TektonCompatibilityIssue{
+			Message: fmt.Sprintf("The Tekton feature flag %s is false, the runs of pipelines with custom tasks such as loops "+
+				"stay running until they time out", tektonFlagEnableCustomTasks),
+			Required: true,
+		})
+	}
+	if strings.EqualFold(t.FeatureFlags[tektonFlagEnableAPIFields], "stable") {
+		issues = append(issues, TektonCompatibilityIssue{
+			Message: fmt.Sprintf("The Tekton feature flag %s is stable, the pipelines using beta features such as "+
+				"array results are rejected by Tekton", tektonFlagEnableAPIFields),
+		})
+	}
+	switch resultsFrom := t.FeatureFlags[tektonFlagResultsFrom]; resultsFrom {
+	case "", "termination-message":
+		issues = append(issues, TektonCompatibilityIssue{
+			Message: fmt.Sprintf("The Tekton feature flag %s is termination-message, the results of a task are limited "+
+				"to about 4KB in total", tektonFlagResultsFrom),
+		})
+	case "sidecar-logs":
+		if size := t.FeatureFlags[tektonFlagMaxResultSize]; size != "" {
+			log.Infof("The results of a Tekton task are limited to %s bytes each", size)
+		}
+	default:
+		issues = append(issues, TektonCompatibilityIssue{
+			Message: fmt.Sprintf("The Tekton feature flag %s has the unknown value %q", tektonFlagResultsFrom, resultsFrom),
+		})
+	}
+	return issues
+}
+
+// CheckTektonCompatibility checks, at startup, that the installed Tekton
+// Pipelines has the features KFP needs. Every issue is logged, and an error is
+// returned for the required ones in fail mode. What can't be read from the
+// ConfigMaps of Tekton, e.g. for lack of RBAC, is only logged.
+func CheckTektonCompatibility(ctx context.Context, configMaps corev1client.ConfigMapInterface, mode string) error {
+	switch mode {
+	case TektonCompatibilityCheckOff:
+		return nil
+	case TektonCompatibilityCheckFail, TektonCompatibilityCheckWarn:
+	default:
+		return fmt.Errorf("invalid Tekton compatibility check mode %q, expected %s, %s or %s", mode,
+			TektonCompatibilityCheckFail, TektonCompatibilityCheckWarn, TektonCompatibilityCheckOff)
+	}
+	installation := GetTektonInstallation(ctx, configMaps)
+	log.Infof("Tekton Pipelines %s installed with the feature flags %v", installation.Version, installation.FeatureFlags)
+	var required []string
+	for _, issue := range installation.CompatibilityIssues() {
+		if issue.Required {
+			log.Errorf("Tekton compatibility: %s", issue.Message)
+			required = append(required, issue.Message)
+		} else {
+			log.Warnf("Tekton compatibility: %s", issue.Message)
+		}
+	}
+	if len(required) > 0 && mode == TektonCompatibilityCheckFail {
+		return fmt.Errorf("the Tekton Pipelines installation lacks features required by KFP: %s", strings.Join(required, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTektonConfigMaps(version string, flags map[string]string) *fake.Clientset {
+	return fake.NewSimpleClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipelines-info", Namespace: DefaultTektonNamespace},
+			Data:       map[string]string{"version": version},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "feature-flags", Namespace: DefaultTektonNamespace},
+			Data:       flags,
+		},
+	)
+}
+
+func TestGetTektonInstallation(t *testing.T) {
+	clientset := newTektonConfigMaps("v0.50.0", map[string]string{"enable-api-fields": " beta\n"})
+	installation := GetTektonInstallation(context.Background(), clientset.CoreV1().ConfigMaps(DefaultTektonNamespace))
+	assert.Equal(t, &TektonInstallation{Version: "v0.50.0", FeatureFlags: map[string]string{"enable-api-fields": "beta"}}, installation)
+
+	installation = GetTektonInstallation(context.Background(), clientset.CoreV1().ConfigMaps("other"))
+	assert.Equal(t, &TektonInstallation{FeatureFlags: map[string]string{}}, installation)
+}
+
+func TestTektonInstallation_CompatibilityIssues(t *testing.T) {
+	tests := []struct {
+		name         string
+		installation TektonInstallation
+		messages     []string
+		required     []bool
+	}{
+		{
+			name: "compatible",
+			installation: TektonInstallation{Version: "v0.50.1",
+				FeatureFlags: map[string]string{"enable-api-fields": "beta", "results-from": "sidecar-logs", "max-result-size": "8192"}},
+		},
+		{
+			name:         "old version",
+			installation: TektonInstallation{Version: "v0.47.3", FeatureFlags: map[string]string{"results-from": "sidecar-logs"}},
+			messages:     []string{"Tekton Pipelines v0.47.3 is installed, KFP requires v0.50.0 or later"},
+			required:     []bool{true},
+		},
+		{
+			name:         "unknown feature flags",
+			installation: TektonInstallation{Version: "v0.50.0"},
+		},
+		{
+			name:         "unknown version",
+			installation: TektonInstallation{FeatureFlags: map[string]string{"results-from": "sidecar-logs"}},
+			messages:     []string{"The Tekton Pipelines version is unknown, KFP requires v0.50.0 or later"},
+			required:     []bool{false},
+		},
+		{
+			name: "missing features",
+			installation: TektonInstallation{Version: "v0.50.0",
+				FeatureFlags: map[string]string{"enable-custom-tasks": "false", "enable-api-fields": "stable", "results-from": "termination-message"}},
+			messages: []string{
+				"The Tekton feature flag enable-custom-tasks is false, the runs of pipelines with custom tasks such as loops stay running until they time out",
+				"The Tekton feature flag enable-api-fields is stable, the pipelines using beta features such as array results are rejected by Tekton",
+				"The Tekton feature flag results-from is termination-message, the results of a task are limited to about 4KB in total",
+			},
+			required: []bool{true, false, false},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var messages []string
+			var required []bool
+			for _, issue := range test.installation.CompatibilityIssues() {
+				messages = append(messages, issue.Message)
+				required = append(required, issue.Required)
+			}
+			assert.Equal(t, test.messages, messages)
+			assert.Equal(t, test.required, required)
+		})
+	}
+}
+
+func TestCheckTektonCompatibility(t *testing.T) {
+	configMaps := newTektonConfigMaps("v0.44.0", map[string]string{"results-from": "termination-message"}).CoreV1().ConfigMaps(DefaultTektonNamespace)
+	err := CheckTektonCompatibility(context.Background(), configMaps, TektonCompatibilityCheckFail)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Tekton Pipelines v0.44.0 is installed")
+
+	assert.Nil(t, CheckTektonCompatibility(context.Background(), configMaps, TektonCompatibilityCheckWarn))
+	assert.Nil(t, CheckTektonCompatibility(context.Background(), configMaps, TektonCompatibilityCheckOff))
+	assert.NotNil(t, CheckTektonCompatibility(context.Background(), configMaps, "strict"))
+
+	configMaps = newTektonConfigMaps("v0.50.0", map[string]string{"results-from": "termination-message"}).CoreV1().ConfigMaps(DefaultTektonNamespace)
+	assert.Nil(t, CheckTektonCompatibility(context.Background(), configMaps, TektonCompatibilityCheckFail))
+}
@@ -18,7 +18,7 @@ import (
 	"math"
 	"time"
 
-	"github.com/golang/glog"
+	log "github.com/sirupsen/logrus"
 )
 
 type TimeInterface interface {
@@ -60,7 +60,7 @@ func (f *FakeTime) Now() time.Time {
 func ParseTimeOrFatal(value string) time.Time {
 	result, err := time.Parse(time.RFC3339, value)
 	if err != nil {
-		glog.Fatalf("Could not parse time: %+v", err)
+		log.Fatalf("Could not parse time: %+v", err)
 	}
 	return result.UTC()
 }
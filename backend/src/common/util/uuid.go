@@ -15,8 +15,8 @@
 package util
 
 import (
-	"github.com/golang/glog"
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 )
 
 type UUIDGeneratorInterface interface {
@@ -48,7 +48,7 @@ type FakeUUIDGenerator struct {
 func NewFakeUUIDGeneratorOrFatal(uuidStringToReturn string, errToReturn error) UUIDGeneratorInterface {
 	uuidToReturn, err := uuid.Parse(uuidStringToReturn)
 	if err != nil {
-		glog.Fatalf("Could not parse the UUID %v: %+v", uuidStringToReturn, err)
+		log.Fatalf("Could not parse the UUID %v: %+v", uuidStringToReturn, err)
 	}
 	return &FakeUUIDGenerator{
 		uuidToReturn: uuidToReturn,
@@ -15,11 +15,13 @@
 package util
 
 import (
-	"strings"
+	"strconv"
+	"time"
 
-	"github.com/golang/glog"
 	swfregister "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	log "github.com/sirupsen/logrus"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -52,6 +54,77 @@ func (w *Workflow) SetServiceAccount(serviceAccount string) {
 	w.Spec.TaskRunTemplate.ServiceAccountName = serviceAccount
 }
 
+// MergeDefaultPodTemplate adds the settings of the default pod template to the
+// pod template of a PipelineRun. The settings the PipelineRun already has win.
+func MergeDefaultPodTemplate(spec *workflowapi.PipelineRunSpec, defaults *pod.PodTemplate) {
+	if defaults == nil {
+		return
+	}
+	if spec.TaskRunTemplate.PodTemplate == nil {
+		spec.TaskRunTemplate.PodTemplate = &pod.PodTemplate{}
+	}
+	podTemplate := spec.TaskRunTemplate.PodTemplate
+	for key, value := range defaults.NodeSelector {
+		if podTemplate.NodeSelector == nil {
+			podTemplate.NodeSelector = map[string]string{}
+		}
+		if _, ok := podTemplate.NodeSelector[key]; !ok {
+			podTemplate.NodeSelector[key] = value
+		}
+	}
+	for _, env := range defaults.Env {
+		found := false
+		for _, existing := range podTemplate.Env {
+			if existing.Name == env.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			podTemplate.Env = append(podTemplate.Env, env)
+		}
+	}
+	for _, toleration := range defaults.Tolerations {
+		found := false
+		for _, existing := range podTemplate.Tolerations {
+			if existing.MatchToleration(&toleration) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			podTemplate.Tolerations = append(podTemplate.Tolerations, toleration)
+		}
+	}
+	for _, secret := range defaults.ImagePullSecrets {
+		found := false
+		for _, existing := range podTemplate.ImagePullSecrets {
+			if existing.Name == secret.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			podTemplate.ImagePullSecrets = append(podTemplate.ImagePullSecrets, secret)
+		}
+	}
+	if podTemplate.Affinity == nil {
+		podTemplate.Affinity = defaults.Affinity
+	}
+	if podTemplate.SecurityContext == nil {
+		podTemplate.SecurityContext = defaults.SecurityContext
+	}
+	if podTemplate.PriorityClassName == nil {
+		podTemplate.PriorityClassName = defaults.PriorityClassName
+	}
+	if podTemplate.RuntimeClassName == nil {
+		podTemplate.RuntimeClassName = defaults.RuntimeClassName
+	}
+	if podTemplate.SchedulerName == "" {
+		podTemplate.SchedulerName = defaults.SchedulerName
+	}
+}
+
 // OverrideParameters overrides some of the parameters of a Workflow.
 func (w *Workflow) OverrideParameters(desiredParams map[string]string) {
 	desiredSlice := make([]workflowapi.Param, 0)
@@ -81,7 +154,7 @@ func (w *Workflow) VerifyParameters(desiredParams map[string]string) error {
 	for k := range desiredParams {
 		_, ok := templateParamsMap[k]
 		if !ok {
-			glog.Warningf("Unrecognized input parameter: %v", k)
+			log.Warningf("Unrecognized input parameter: %v", k)
 		}
 	}
 	return nil
@@ -144,7 +217,7 @@ func (w *Workflow) ScheduledAtInSecOr0() int64 {
 		if key == LabelKeyWorkflowEpoch {
 			result, err := RetrieveInt64FromLabel(value)
 			if err != nil {
-				glog.Errorf("Could not retrieve scheduled epoch from label key (%v) and label value (%v).", key, value)
+				log.Errorf("Could not retrieve scheduled epoch from label key (%v) and label value (%v).", key, value)
 				return 0
 			}
 			return result
@@ -173,7 +246,7 @@ func (w *Workflow) Condition() string {
 func (w *Workflow) ToStringForStore() string {
 	workflow, err := json.Marshal(w.PipelineRun)
 	if err != nil {
-		glog.Errorf("Could not marshal the workflow: %v", w.PipelineRun)
+		log.Errorf("Could not marshal the workflow: %v", w.PipelineRun)
 		return ""
 	}
 	return string(workflow)
@@ -241,27 +314,14 @@ func (w *Workflow) SetAnnotations(key string, value string) {
 	w.Annotations[key] = value
 }
 
+// ReplaceUID replaces the run ID context variables with the given ID.
 func (w *Workflow) ReplaceUID(id string) error {
-	newWorkflowString := strings.Replace(w.ToStringForStore(), "{{workflow.uid}}", id, -1)
-	newWorkflowString = strings.Replace(newWorkflowString, "$(context.pipelineRun.uid)", id, -1)
-	var workflow *workflowapi.PipelineRun
-	if err := json.Unmarshal([]byte(newWorkflowString), &workflow); err != nil {
-		return NewInternalServerError(err,
-			"Failed to unmarshal workflow spec manifest. Workflow: %s", w.ToStringForStore())
-	}
-	w.PipelineRun = workflow
-	return nil
+	return w.ReplaceContextVariables(ContextVariables{RunId: id})
 }
 
+// ReplaceOrignalPipelineRunName replaces the PipelineRun name context variable with the given name.
 func (w *Workflow) ReplaceOrignalPipelineRunName(name string) error {
-	newWorkflowString := strings.Replace(w.ToStringForStore(), "$ORIG_PR_NAME", name, -1)
-	var workflow *workflowapi.PipelineRun
-	if err := json.Unmarshal([]byte(newWorkflowString), &workflow); err != nil {
-		return NewInternalServerError(err,
-			"Failed to unmarshal workflow spec manifest. Workflow: %s", w.ToStringForStore())
-	}
-	w.PipelineRun = workflow
-	return nil
+	return w.ReplaceContextVariables(ContextVariables{PipelineRunName: name})
 }
 
 func (w *Workflow) SetCannonicalLabels(name string, nextScheduledEpoch int64, index int64) {
@@ -283,27 +343,78 @@ func (w *Workflow) FindObjectStoreArtifactKeyOrEmpty(nodeID string, artifactName
 	return "artifacts/" + w.ObjectMeta.Name + "/" + nodeID + "/" + artifactName + ".tgz"
 }
 
+// finalConditions are the reasons of the workflow conditions which are final.
+var finalConditions = map[string]int{
+	"Succeeded":                  1,
+	"Failed":                     1,
+	"Completed":                  1,
+	"PipelineRunCancelled":       1, // remove this when Tekton move to v1 API
+	"PipelineRunCouldntCancel":   1,
+	"PipelineRunTimeout":         1,
+	"Cancelled":                  1,
+	"StoppedRunFinally":          1,
+	"CancelledRunFinally":        1,
+	"InvalidTaskResultReference": 1,
+}
+
+// IsFinalCondition whether the reason of a workflow condition, which is also the
+// status of its run, is final.
+func IsFinalCondition(reason string) bool {
+	_, ok := finalConditions[reason]
+	return ok
+}
+
+// StalledCondition is the status of the runs whose workflows didn't progress for
+// longer than the stalled run timeout. Unlike the other statuses, it isn't the
+// reason of a workflow condition.
+const StalledCondition = "Stalled"
+
+// LastProgressAt returns when the status of the workflow last changed: the last
+// transition of its conditions, its start, or else its creation.
+func (w *Workflow) LastProgressAt() time.Time {
+	last := w.CreationTimestamp.Time
+	if startTime := w.Status.PipelineRunStatusFields.StartTime; startTime != nil && startTime.After(last) {
+		last = startTime.Time
+	}
+	for _, condition := range w.Status.Status.Conditions {
+		if condition.LastTransitionTime.Inner.After(last) {
+			last = condition.LastTransitionTime.Inner.Time
+		}
+	}
+	return last
+}
+
+// IsStalled whether the workflow was marked stalled and its status didn't change
+// since.
+func (w *Workflow) IsStalled() bool {
+	value, ok := w.GetAnnotations()[AnnotationKeyStalledAt]
+	if !ok || w.IsInFinalState() {
+		return false
+	}
+	stalledAt, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.Warningf("Could not parse the %v annotation %q of workflow %v.", AnnotationKeyStalledAt, value, w.Name)
+		return false
+	}
+	return w.LastProgressAt().Unix() <= stalledAt
+}
+
+// Resubmissions returns how many times the workflow was resubmitted because it
+// stalled.
+func (w *Workflow) Resubmissions() int {
+	resubmissions, err := strconv.Atoi(w.GetAnnotations()[AnnotationKeyResubmissions])
+	if err != nil {
+		return 0
+	}
+	return resubmissions
+}
+
 // IsInFinalState whether the workflow is in a final state.
 func (w *Workflow) IsInFinalState() bool {
 	// Workflows in the statuses other than pending or running are considered final.
 
 	if len(w.Status.Status.Conditions) > 0 {
-		finalConditions := map[string]int{
-			"Succeeded":                  1,
-			"Failed":                     1,
-			"Completed":                  1,
-			"PipelineRunCancelled":       1, // remove this when Tekton move to v1 API
-			"PipelineRunCouldntCancel":   1,
-			"PipelineRunTimeout":         1,
-			"Cancelled":                  1,
-			"StoppedRunFinally":          1,
-			"CancelledRunFinally":        1,
-			"InvalidTaskResultReference": 1,
-		}
-		phase := w.Status.Status.Conditions[0].Reason
-		if _, ok := finalConditions[phase]; ok {
-			return true
-		}
+		return IsFinalCondition(w.Status.Status.Conditions[0].Reason)
 	}
 	return false
 }
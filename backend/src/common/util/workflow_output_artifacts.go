@@ -0,0 +1,115 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+)
+
+// OutputArtifact is an artifact a task of a Workflow outputs to the object store.
+type OutputArtifact struct {
+	TaskName   string
+	Name       string
+	Key        string
+	Type       string
+	Properties map[string]string
+}
+
+type outputArtifactItem struct {
+	Key        string            `json:"key"`
+	Type       string            `json:"type,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type componentSpecDigest struct {
+	Outputs []struct {
+		Name string          `json:"name"`
+		Type json.RawMessage `json:"type,omitempty"`
+	} `json:"outputs"`
+}
+
+// OutputArtifacts returns the output artifacts of the tasks, sorted by task and
+// artifact name. The object store keys are resolved for the Workflow name. The
+// type of an artifact defaults to the type of the component output.
+func (w *Workflow) OutputArtifacts() ([]*OutputArtifact, error) {
+	value, ok := w.GetAnnotations()[AnnotationKeyOutputArtifacts]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var items map[string][]outputArtifactItem
+	if err := json.Unmarshal([]byte(value), &items); err != nil {
+		return nil, NewInvalidInputErrorWithDetails(err, "Failed to parse the output artifacts of the workflow")
+	}
+	outputTypes := w.componentOutputTypes()
+
+	var artifacts []*OutputArtifact
+	for taskName, taskItems := range items {
+		for _, item := range taskItems {
+			if item.Key == "" {
+				continue
+			}
+			name := strings.TrimSuffix(path.Base(item.Key), ".tgz")
+			artifactType := item.Type
+			if artifactType == "" {
+				artifactType = outputTypes[taskName][name]
+			}
+			artifacts = append(artifacts, &OutputArtifact{
+				TaskName:   taskName,
+				Name:       name,
+				Key:        strings.Replace(item.Key, "$PIPELINERUN", w.Name, -1),
+				Type:       artifactType,
+				Properties: item.Properties,
+			})
+		}
+	}
+	sort.Slice(artifacts, func(i, j int) bool {
+		if artifacts[i].TaskName != artifacts[j].TaskName {
+			return artifacts[i].TaskName < artifacts[j].TaskName
+		}
+		return artifacts[i].Name < artifacts[j].Name
+	})
+	return artifacts, nil
+}
+
+// componentOutputTypes returns the types of the component outputs, keyed by task
+// and output name. The types that aren't strings, such as the types with
+// properties, are kept as JSON.
+func (w *Workflow) componentOutputTypes() map[string]map[string]string {
+	types := map[string]map[string]string{}
+	if w.Spec.PipelineSpec == nil {
+		return types
+	}
+	for _, task := range w.Spec.PipelineSpec.Tasks {
+		if task.TaskSpec == nil {
+			continue
+		}
+		var digest componentSpecDigest
+		if err := json.Unmarshal([]byte(task.TaskSpec.Metadata.Annotations[AnnotationKeyComponentSpecDigest]), &digest); err != nil {
+			continue
+		}
+		types[task.Name] = map[string]string{}
+		for _, output := range digest.Outputs {
+			var outputType string
+			if err := json.Unmarshal(output.Type, &outputType); err != nil {
+				outputType = string(output.Type)
+			}
+			types[task.Name][output.Name] = outputType
+		}
+	}
+	return types
+}
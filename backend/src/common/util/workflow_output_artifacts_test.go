@@ -0,0 +1,75 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOutputArtifacts(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "run-1",
+			Annotations: map[string]string{AnnotationKeyOutputArtifacts: `{
+				"train": [
+					{"key": "artifacts/$PIPELINERUN/train/model.tgz", "name": "train-model", "path": "/tmp/outputs/model/data",
+					 "properties": {"framework": "tensorflow"}},
+					{"key": "artifacts/$PIPELINERUN/train/metrics.tgz", "name": "train-metrics", "path": "/tmp/outputs/metrics/data",
+					 "type": "Metrics"}
+				],
+				"flip-coin": [{"key": "artifacts/$PIPELINERUN/flip-coin/Output.tgz", "name": "flip-coin-Output", "path": "/tmp/outputs/Output/data"}]
+			}`},
+		},
+		Spec: workflowapi.PipelineRunSpec{
+			PipelineSpec: &workflowapi.PipelineSpec{
+				Tasks: []workflowapi.PipelineTask{{
+					Name: "train",
+					TaskSpec: &workflowapi.EmbeddedTask{Metadata: workflowapi.PipelineTaskMetadata{
+						Annotations: map[string]string{AnnotationKeyComponentSpecDigest: `{"name": "Train",
+							"outputs": [{"name": "model", "type": "Model"}, {"name": "metrics", "type": "String"}]}`},
+					}},
+				}},
+			},
+		},
+	})
+
+	artifacts, err := workflow.OutputArtifacts()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*OutputArtifact{
+		{TaskName: "flip-coin", Name: "Output", Key: "artifacts/run-1/flip-coin/Output.tgz"},
+		{TaskName: "train", Name: "metrics", Key: "artifacts/run-1/train/metrics.tgz", Type: "Metrics"},
+		{TaskName: "train", Name: "model", Key: "artifacts/run-1/train/model.tgz", Type: "Model",
+			Properties: map[string]string{"framework": "tensorflow"}},
+	}, artifacts)
+}
+
+func TestOutputArtifacts_Invalid(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "run-1",
+			Annotations: map[string]string{AnnotationKeyOutputArtifacts: `{"train": "model"}`},
+		},
+	})
+
+	_, err := workflow.OutputArtifacts()
+
+	assert.Equal(t, codes.InvalidArgument, err.(*UserError).ExternalStatusCode())
+}
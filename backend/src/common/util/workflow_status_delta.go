@@ -0,0 +1,128 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// statusAnnotationKeys are the annotations that hold the statuses of the
+// children of a Workflow, which a status delta only carries in part.
+var statusAnnotationKeys = []string{AnnotationKeyTaskRunStatuses, AnnotationKeyCustomRunStatuses}
+
+// StatusSequence returns the sequence number of the reported status, or 0 when
+// the Workflow was reported without one.
+func (w *Workflow) StatusSequence() int64 {
+	sequence, err := strconv.ParseInt(w.GetAnnotations()[AnnotationKeyStatusSequence], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return sequence
+}
+
+// IsStatusDelta returns whether the Workflow only holds the children statuses
+// that changed since the previous report.
+func (w *Workflow) IsStatusDelta() bool {
+	return w.GetAnnotations()[AnnotationKeyStatusDelta] == "true"
+}
+
+// ChildStatuses returns the raw statuses of the children of the Workflow held by
+// one of the status annotations, keyed by child name.
+func (w *Workflow) ChildStatuses(annotationKey string) (map[string]json.RawMessage, error) {
+	statuses := map[string]json.RawMessage{}
+	value, ok := w.GetAnnotations()[annotationKey]
+	if !ok || value == "" {
+		return statuses, nil
+	}
+	if err := json.Unmarshal([]byte(value), &statuses); err != nil {
+		return nil, NewInvalidInputError("Failed to parse the %s annotation of workflow %s: %v", annotationKey, w.Name, err)
+	}
+	return statuses, nil
+}
+
+// SetChildStatuses stores the raw statuses of the children of the Workflow in
+// one of the status annotations.
+func (w *Workflow) SetChildStatuses(annotationKey string, statuses map[string]json.RawMessage) error {
+	value, err := json.Marshal(statuses)
+	if err != nil {
+		return NewInternalServerError(err, "Failed to marshal the %s annotation of workflow %s", annotationKey, w.Name)
+	}
+	w.SetAnnotations(annotationKey, string(value))
+	return nil
+}
+
+// MergeStatusDelta turns a status delta into a full status, by adding to it the
+// children statuses of base, the last status stored for the same run. The delta
+// must directly follow base, otherwise a FailedPrecondition error asks the
+// reporter for the full status.
+func MergeStatusDelta(base *Workflow, delta *Workflow) error {
+	if base == nil || delta.StatusSequence() != base.StatusSequence()+1 {
+		baseSequence := int64(0)
+		if base != nil {
+			baseSequence = base.StatusSequence()
+		}
+		return NewFailedPreconditionError(fmt.Errorf("status sequence mismatch"),
+			"Status delta %d of workflow %s does not follow the stored status %d", delta.StatusSequence(), delta.Name, baseSequence).
+			WithReason(ReasonStatusSequenceMismatch)
+	}
+	for _, key := range statusAnnotationKeys {
+		statuses, err := base.ChildStatuses(key)
+		if err != nil {
+			return err
+		}
+		changed, err := delta.ChildStatuses(key)
+		if err != nil {
+			return err
+		}
+		if len(statuses) == 0 && len(changed) == 0 {
+			continue
+		}
+		for name, status := range changed {
+			statuses[name] = status
+		}
+		if err := delta.SetChildStatuses(key, statuses); err != nil {
+			return err
+		}
+	}
+	delete(delta.Annotations, AnnotationKeyStatusDelta)
+	return nil
+}
+
+// RetainChildStatuses adds to workflow the children statuses of base, the last
+// status stored for the same run, that workflow no longer holds. It keeps the
+// statuses of the children that were garbage collected while the run went on.
+func RetainChildStatuses(base *Workflow, workflow *Workflow, annotationKey string) error {
+	retained, err := base.ChildStatuses(annotationKey)
+	if err != nil {
+		return err
+	}
+	statuses, err := workflow.ChildStatuses(annotationKey)
+	if err != nil {
+		return err
+	}
+	missing := false
+	for name, status := range retained {
+		if _, ok := statuses[name]; !ok {
+			statuses[name] = status
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
+	return workflow.SetChildStatuses(annotationKey, statuses)
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newStatusWorkflow(annotations map[string]string) *Workflow {
+	return NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "WORKFLOW_NAME",
+			Annotations: annotations,
+		},
+	})
+}
+
+func TestMergeStatusDelta(t *testing.T) {
+	base := newStatusWorkflow(map[string]string{
+		AnnotationKeyStatusSequence:    "3",
+		AnnotationKeyTaskRunStatuses:   `{"task-a":{"status":"done"},"task-b":{"status":"running"}}`,
+		AnnotationKeyCustomRunStatuses: `{"loop":{"status":"running"}}`,
+	})
+	delta := newStatusWorkflow(map[string]string{
+		AnnotationKeyStatusSequence:  "4",
+		AnnotationKeyStatusDelta:     "true",
+		AnnotationKeyTaskRunStatuses: `{"task-b":{"status":"done"},"task-c":{"status":"running"}}`,
+	})
+
+	err := MergeStatusDelta(base, delta)
+
+	assert.Nil(t, err)
+	assert.False(t, delta.IsStatusDelta())
+	assert.Equal(t, int64(4), delta.StatusSequence())
+	assert.JSONEq(t,
+		`{"task-a":{"status":"done"},"task-b":{"status":"done"},"task-c":{"status":"running"}}`,
+		delta.Annotations[AnnotationKeyTaskRunStatuses])
+	assert.JSONEq(t, `{"loop":{"status":"running"}}`, delta.Annotations[AnnotationKeyCustomRunStatuses])
+}
+
+func TestMergeStatusDelta_SequenceGap(t *testing.T) {
+	base := newStatusWorkflow(map[string]string{AnnotationKeyStatusSequence: "3"})
+	delta := newStatusWorkflow(map[string]string{
+		AnnotationKeyStatusSequence: "5",
+		AnnotationKeyStatusDelta:    "true",
+	})
+
+	err := MergeStatusDelta(base, delta)
+
+	assert.True(t, IsUserErrorCodeMatch(err, codes.FailedPrecondition))
+	assert.True(t, delta.IsStatusDelta())
+}
+
+func TestMergeStatusDelta_NoBase(t *testing.T) {
+	delta := newStatusWorkflow(map[string]string{
+		AnnotationKeyStatusSequence: "1",
+		AnnotationKeyStatusDelta:    "true",
+	})
+
+	err := MergeStatusDelta(nil, delta)
+
+	assert.True(t, IsUserErrorCodeMatch(err, codes.FailedPrecondition))
+}
+
+func TestRetainChildStatuses(t *testing.T) {
+	base := newStatusWorkflow(map[string]string{
+		AnnotationKeyCustomRunStatuses: `{"loop-1":{"status":"done"},"loop-2":{"status":"running"}}`,
+	})
+	workflow := newStatusWorkflow(map[string]string{
+		AnnotationKeyCustomRunStatuses: `{"loop-2":{"status":"done"}}`,
+	})
+
+	err := RetainChildStatuses(base, workflow, AnnotationKeyCustomRunStatuses)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"loop-1":{"status":"done"},"loop-2":{"status":"done"}}`,
+		workflow.Annotations[AnnotationKeyCustomRunStatuses])
+}
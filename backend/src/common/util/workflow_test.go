@@ -19,7 +19,9 @@ import (
 
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -223,3 +225,55 @@ func TestSetLabels(t *testing.T) {
 }
 
 // removed tests (check top page comment)
+
+func TestMergeDefaultPodTemplate(t *testing.T) {
+	spec := &workflowapi.PipelineRunSpec{}
+	spec.TaskRunTemplate.PodTemplate = &pod.PodTemplate{
+		NodeSelector:     map[string]string{"pool": "gpu"},
+		Env:              []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "pipeline"}},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+	}
+	priorityClass := "batch"
+	MergeDefaultPodTemplate(spec, &pod.PodTemplate{
+		NodeSelector:      map[string]string{"pool": "cpu", "zone": "a"},
+		Env:               []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "experiment"}, {Name: "TEAM", Value: "a"}},
+		Tolerations:       []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "a"}},
+		ImagePullSecrets:  []corev1.LocalObjectReference{{Name: "registry"}, {Name: "team-registry"}},
+		PriorityClassName: &priorityClass,
+	})
+
+	podTemplate := spec.TaskRunTemplate.PodTemplate
+	// The settings of the PipelineRun win.
+	assert.Equal(t, map[string]string{"pool": "gpu", "zone": "a"}, podTemplate.NodeSelector)
+	assert.Equal(t, []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "pipeline"}, {Name: "TEAM", Value: "a"}}, podTemplate.Env)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "registry"}, {Name: "team-registry"}}, podTemplate.ImagePullSecrets)
+	assert.Len(t, podTemplate.Tolerations, 1)
+	assert.Equal(t, "batch", *podTemplate.PriorityClassName)
+
+	// A PipelineRun without a pod template gets the defaults.
+	spec = &workflowapi.PipelineRunSpec{}
+	MergeDefaultPodTemplate(spec, &pod.PodTemplate{SchedulerName: "volcano"})
+	assert.Equal(t, "volcano", spec.TaskRunTemplate.PodTemplate.SchedulerName)
+	MergeDefaultPodTemplate(spec, nil)
+	assert.Equal(t, "volcano", spec.TaskRunTemplate.PodTemplate.SchedulerName)
+}
+
+func TestWorkflow_IsStalled(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "workflow", CreationTimestamp: metav1.Unix(10, 0)},
+	})
+	assert.Equal(t, int64(10), workflow.LastProgressAt().Unix())
+	assert.False(t, workflow.IsStalled())
+	assert.Equal(t, 0, workflow.Resubmissions())
+
+	workflow.SetAnnotations(AnnotationKeyStalledAt, "10")
+	workflow.SetAnnotations(AnnotationKeyResubmissions, "2")
+	assert.True(t, workflow.IsStalled())
+	assert.Equal(t, 2, workflow.Resubmissions())
+
+	// The workflow is no longer stalled once it progresses.
+	startTime := metav1.Unix(20, 0)
+	workflow.Status.PipelineRunStatusFields.StartTime = &startTime
+	assert.Equal(t, int64(20), workflow.LastProgressAt().Unix())
+	assert.False(t, workflow.IsStalled())
+}
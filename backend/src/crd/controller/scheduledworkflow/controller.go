@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/client"
 	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
@@ -32,6 +33,7 @@ import (
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	workflowclientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	workflowinformers "github.com/tektoncd/pipeline/pkg/client/informers/externalversions"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -327,8 +329,9 @@ func (c *Controller) processNextWorkItem() bool {
 
 		// Run the syncHandler, passing it the namespace/name string of the
 		// ScheduledWorkflow to be synced.
-		ctx := context.Background()
+		ctx, span := tracing.Start(context.Background(), "ScheduledWorkflow.Sync", attribute.String("key", key))
 		syncAgain, retryOnError, swf, err := c.syncHandler(ctx, key)
+		tracing.End(span, err)
 		if err != nil && retryOnError {
 			// Transient failure. We will retry.
 			c.workqueue.AddRateLimited(obj) // Exponential backoff.
@@ -15,10 +15,13 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"strings"
 	"time"
 
+	"github.com/kubeflow/pipelines/backend/src/common/logging"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	swfclientset "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
@@ -40,6 +43,9 @@ var (
 	location    *time.Location
 	clientQPS   float64
 	clientBurst int
+
+	tektonNamespace          string
+	tektonCompatibilityCheck string
 )
 
 func initEnv() {
@@ -52,21 +58,35 @@ func initEnv() {
 
 func main() {
 	flag.Parse()
+	if err := logging.Init("ml-pipeline-scheduledworkflow"); err != nil {
+		log.Fatalf("Error initializing logging: %s", err.Error())
+	}
 
 	// set up signals so we handle the first shutdown signal gracefully
 	stopCh := signals.SetupSignalHandler()
 
+	shutdownTracing, err := tracing.Init(context.Background(), "ml-pipeline-scheduledworkflow")
+	if err != nil {
+		log.Fatalf("Error initializing tracing: %s", err.Error())
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg, err := clientcmd.BuildConfigFromFlags(masterURL, kubeconfig)
 	if err != nil {
 		log.Fatalf("Error building kubeconfig: %s", err.Error())
 	}
 	cfg.QPS = float32(clientQPS)
 	cfg.Burst = clientBurst
+	cfg.Wrap(tracing.WrapTransport)
 
 	kubeClient, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		log.Fatalf("Error building kubernetes clientset: %s", err.Error())
 	}
+	err = commonutil.CheckTektonCompatibility(context.Background(), kubeClient.CoreV1().ConfigMaps(tektonNamespace), tektonCompatibilityCheck)
+	if err != nil {
+		log.Fatalf("Error checking the Tekton installation: %s", err.Error())
+	}
 
 	scheduleClient, err := swfclientset.NewForConfig(cfg)
 	if err != nil {
@@ -115,6 +135,9 @@ func init() {
 	// k8s.io/client-go/rest/config.go#RESTClientFor
 	flag.Float64Var(&clientQPS, "clientQPS", 5, "The maximum QPS to the master from this client.")
 	flag.IntVar(&clientBurst, "clientBurst", 10, "Maximum burst for throttle from this client.")
+	flag.StringVar(&tektonNamespace, "tektonNamespace", commonutil.DefaultTektonNamespace, "The namespace Tekton Pipelines is installed in.")
+	flag.StringVar(&tektonCompatibilityCheck, "tektonCompatibilityCheck", commonutil.TektonCompatibilityCheckFail,
+		"Whether to fail, only warn or skip the check when the Tekton installation lacks a required feature: fail, warn or off.")
 	var err error
 	location, err = util.GetLocation()
 	if err != nil {
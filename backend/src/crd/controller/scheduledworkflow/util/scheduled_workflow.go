@@ -187,8 +187,13 @@ func (s *ScheduledWorkflow) NewWorkflow(
 	result.SetCannonicalLabels(s.Name, nextScheduledEpoch, s.nextIndex())
 	// Pod pipeline/runid label is used by v2 compatible mode.
 	result.SetLabels(commonutil.LabelKeyWorkflowRunId, uuid.String())
-	// Replace {{workflow.uid}} with runId
-	err = result.ReplaceUID(uuid.String())
+	err = result.ReplaceContextVariables(commonutil.ContextVariables{
+		RunId:           uuid.String(),
+		RunName:         result.Name,
+		PipelineRunName: result.Name,
+		Namespace:       s.Namespace,
+		ScheduledAt:     nextScheduledEpoch,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -19,10 +19,12 @@ package main
 import (
 	"context"
 	"flag"
-	"log"
 
-	"github.com/golang/glog"
+	// Keeps the -logtostderr flag of the image registered.
+	_ "github.com/golang/glog"
+	log "github.com/sirupsen/logrus"
 
+	"github.com/kubeflow/pipelines/backend/src/common/logging"
 	"github.com/kubeflow/pipelines/backend/src/crd/controller/viewer/reconciler"
 
 	viewerV1beta1 "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/viewer/v1beta1"
@@ -54,6 +56,9 @@ var (
 
 func main() {
 	flag.Parse()
+	if err := logging.Init("ml-pipeline-viewer-controller"); err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
 
 	cfg, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubecfg)
 	if err != nil {
@@ -93,7 +98,7 @@ func main() {
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	glog.Info("Starting controller for the Viewer CRD")
+	log.Info("Starting controller for the Viewer CRD")
 	if err := mgr.Start(ctx); err != nil {
 		log.Fatalf("Failed to start controller: %v", err)
 	}
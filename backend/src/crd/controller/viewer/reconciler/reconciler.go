@@ -26,8 +26,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/golang/glog"
 	viewerV1beta1 "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/viewer/v1beta1"
+	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -72,7 +72,7 @@ func New(cli client.Client, scheme *runtime.Scheme, opts *Options) (*Reconciler,
 // corresponding deployment and service allowing users to access the view under
 // a specific path.
 func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-	glog.Infof("Reconcile request: %+v", req)
+	log.Infof("Reconcile request: %+v", req)
 
 	view := &viewerV1beta1.Viewer{}
 	if err := r.Get(context.Background(), req.NamespacedName, view); err != nil {
@@ -84,11 +84,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
-	glog.Infof("Got instance: %+v", view)
+	log.Infof("Got instance: %+v", view)
 
 	// Ignore other viewer types for now.
 	if view.Spec.Type != viewerV1beta1.ViewerTypeTensorboard {
-		glog.Infof("Unsupported spec type: %q", view.Spec.Type)
+		log.Infof("Unsupported spec type: %q", view.Spec.Type)
 		// Return nil to indicate nothing more to do here.
 		return reconcile.Result{}, nil
 	}
@@ -134,7 +134,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			return reconcile.Result{}, err
 		}
 	}
-	glog.Infof("Created new deployment with spec: %+v", dpl)
+	log.Infof("Created new deployment with spec: %+v", dpl)
 
 	// Set up a service for the deployment above.
 	svc := serviceFrom(view, dpl.Name)
@@ -160,7 +160,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			return reconcile.Result{}, err
 		}
 	}
-	glog.Infof("Created new service with spec: %+v", svc)
+	log.Infof("Created new service with spec: %+v", svc)
 
 	return reconcile.Result{}, nil
 }
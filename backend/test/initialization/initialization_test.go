@@ -1,6 +1,7 @@
 package initialization
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/glog"
@@ -40,7 +41,7 @@ func (s *InitializationTest) TestInitialization() {
 	t := s.T()
 
 	/* ---------- Verify that only the default experiment exists ---------- */
-	experiments, totalSize, _, err := s.experimentClient.List(&params.ListExperimentParams{})
+	experiments, totalSize, _, err := s.experimentClient.List(context.Background(), &params.ListExperimentParams{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, totalSize)
 	assert.True(t, len(experiments) == 1)
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
 	"time"
@@ -74,14 +75,14 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	t := s.T()
 
 	/* ---------- Verify no experiment exist ---------- */
-	experiments, totalSize, _, err := s.experimentClient.List(&params.ListExperimentParams{})
+	experiments, totalSize, _, err := s.experimentClient.List(context.Background(), &params.ListExperimentParams{})
 	assert.Nil(t, err)
 	assert.Equal(t, 0, totalSize)
 	assert.True(t, len(experiments) == 0)
 
 	/* ---------- Create a new experiment ---------- */
 	experiment := &experiment_model.V1Experiment{Name: "training", Description: "my first experiment"}
-	trainingExperiment, err := s.experimentClient.Create(&params.CreateExperimentParams{
+	trainingExperiment, err := s.experimentClient.Create(context.Background(), &params.CreateExperimentParams{
 		Body: experiment,
 	})
 	assert.Nil(t, err)
@@ -91,7 +92,7 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	assert.Equal(t, expectedTrainingExperiment, trainingExperiment)
 
 	/* ---------- Create an experiment with same name. Should fail due to name uniqueness ---------- */
-	_, err = s.experimentClient.Create(&params.CreateExperimentParams{Body: experiment})
+	_, err = s.experimentClient.Create(context.Background(), &params.CreateExperimentParams{Body: experiment})
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Please specify a new name")
 
@@ -99,18 +100,18 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	// 1 second interval. This ensures they can be sorted by create time in expected order.
 	time.Sleep(1 * time.Second)
 	experiment = &experiment_model.V1Experiment{Name: "prediction", Description: "my second experiment"}
-	_, err = s.experimentClient.Create(&params.CreateExperimentParams{
+	_, err = s.experimentClient.Create(context.Background(), &params.CreateExperimentParams{
 		Body: experiment,
 	})
 	time.Sleep(1 * time.Second)
 	experiment = &experiment_model.V1Experiment{Name: "moonshot", Description: "my second experiment"}
-	_, err = s.experimentClient.Create(&params.CreateExperimentParams{
+	_, err = s.experimentClient.Create(context.Background(), &params.CreateExperimentParams{
 		Body: experiment,
 	})
 	assert.Nil(t, err)
 
 	/* ---------- Verify list experiments works ---------- */
-	experiments, totalSize, nextPageToken, err := s.experimentClient.List(&params.ListExperimentParams{})
+	experiments, totalSize, nextPageToken, err := s.experimentClient.List(context.Background(), &params.ListExperimentParams{})
 	assert.Nil(t, err)
 	assert.Equal(t, 3, totalSize)
 	assert.Equal(t, 3, len(experiments))
@@ -122,7 +123,7 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	}
 
 	/* ---------- Verify list experiments sorted by names ---------- */
-	experiments, totalSize, nextPageToken, err = s.experimentClient.List(&params.ListExperimentParams{
+	experiments, totalSize, nextPageToken, err = s.experimentClient.List(context.Background(), &params.ListExperimentParams{
 		PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("name")})
 	assert.Nil(t, err)
 	assert.Equal(t, 3, totalSize)
@@ -131,7 +132,7 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	assert.Equal(t, "prediction", experiments[1].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	experiments, totalSize, nextPageToken, err = s.experimentClient.List(&params.ListExperimentParams{
+	experiments, totalSize, nextPageToken, err = s.experimentClient.List(context.Background(), &params.ListExperimentParams{
 		PageToken: util.StringPointer(nextPageToken), PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("name")})
 	assert.Nil(t, err)
 	assert.Equal(t, 3, totalSize)
@@ -140,7 +141,7 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- Verify list experiments sorted by creation time ---------- */
-	experiments, totalSize, nextPageToken, err = s.experimentClient.List(&params.ListExperimentParams{
+	experiments, totalSize, nextPageToken, err = s.experimentClient.List(context.Background(), &params.ListExperimentParams{
 		PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("created_at")})
 	assert.Nil(t, err)
 	assert.Equal(t, 3, totalSize)
@@ -149,7 +150,7 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	assert.Equal(t, "prediction", experiments[1].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	experiments, totalSize, nextPageToken, err = s.experimentClient.List(&params.ListExperimentParams{
+	experiments, totalSize, nextPageToken, err = s.experimentClient.List(context.Background(), &params.ListExperimentParams{
 		PageToken: util.StringPointer(nextPageToken), PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("created_at")})
 	assert.Nil(t, err)
 	assert.Equal(t, 3, totalSize)
@@ -158,12 +159,12 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- List experiments sort by unsupported field. Should fail. ---------- */
-	_, _, _, err = s.experimentClient.List(&params.ListExperimentParams{
+	_, _, _, err = s.experimentClient.List(context.Background(), &params.ListExperimentParams{
 		PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("unknownfield")})
 	assert.NotNil(t, err)
 
 	/* ---------- List experiments sorted by names descend order ---------- */
-	experiments, totalSize, nextPageToken, err = s.experimentClient.List(&params.ListExperimentParams{
+	experiments, totalSize, nextPageToken, err = s.experimentClient.List(context.Background(), &params.ListExperimentParams{
 		PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("name desc")})
 	assert.Nil(t, err)
 	assert.Equal(t, 3, totalSize)
@@ -172,7 +173,7 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	assert.Equal(t, "prediction", experiments[1].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	experiments, totalSize, nextPageToken, err = s.experimentClient.List(&params.ListExperimentParams{
+	experiments, totalSize, nextPageToken, err = s.experimentClient.List(context.Background(), &params.ListExperimentParams{
 		PageToken: util.StringPointer(nextPageToken), PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("name desc")})
 	assert.Nil(t, err)
 	assert.Equal(t, 3, totalSize)
@@ -181,15 +182,15 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- Verify get experiment works ---------- */
-	experiment, err = s.experimentClient.Get(&params.GetExperimentParams{ID: trainingExperiment.ID})
+	experiment, err = s.experimentClient.Get(context.Background(), &params.GetExperimentParams{ID: trainingExperiment.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, expectedTrainingExperiment, experiment)
 
 	/* ---------- Create a pipeline version and two runs and two jobs -------------- */
-	pipeline, err := s.pipelineUploadClient.UploadFile("../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
+	pipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
 	assert.Nil(t, err)
 	time.Sleep(1 * time.Second)
-	pipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(
+	pipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(context.Background(),
 		"../resources/hello-world.yaml", &uploadParams.UploadPipelineVersionParams{
 			Name:       util.StringPointer("hello-world-version"),
 			Pipelineid: util.StringPointer(pipeline.ID),
@@ -205,9 +206,9 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 				Relationship: run_model.V1RelationshipCREATOR},
 		},
 	}}
-	run1, _, err := s.runClient.Create(createRunRequest)
+	run1, _, err := s.runClient.Create(context.Background(), createRunRequest)
 	assert.Nil(t, err)
-	run2, _, err := s.runClient.Create(createRunRequest)
+	run2, _, err := s.runClient.Create(context.Background(), createRunRequest)
 	assert.Nil(t, err)
 	/* ---------- Create a new hello world job by specifying pipeline ID ---------- */
 	createJobRequest := &jobParams.CreateJobParams{Body: &job_model.V1Job{
@@ -222,48 +223,48 @@ func (s *ExperimentApiTest) TestExperimentAPI() {
 		MaxConcurrency: 10,
 		Enabled:        true,
 	}}
-	job1, err := s.jobClient.Create(createJobRequest)
+	job1, err := s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
-	job2, err := s.jobClient.Create(createJobRequest)
+	job2, err := s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
 
 	/* ---------- Archive an experiment -----------------*/
-	err = s.experimentClient.Archive(&params.ArchiveExperimentParams{ID: trainingExperiment.ID})
+	err = s.experimentClient.Archive(context.Background(), &params.ArchiveExperimentParams{ID: trainingExperiment.ID})
 
 	/* ---------- Verify experiment and its runs ------- */
-	experiment, err = s.experimentClient.Get(&params.GetExperimentParams{ID: trainingExperiment.ID})
+	experiment, err = s.experimentClient.Get(context.Background(), &params.GetExperimentParams{ID: trainingExperiment.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, experiment_model.V1ExperimentStorageState("STORAGESTATE_ARCHIVED"), experiment.StorageState)
-	retrievedRun1, _, err := s.runClient.Get(&runParams.GetRunParams{RunID: run1.Run.ID})
+	retrievedRun1, _, err := s.runClient.Get(context.Background(), &runParams.GetRunParams{RunID: run1.Run.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, run_model.V1RunStorageState("STORAGESTATE_ARCHIVED"), retrievedRun1.Run.StorageState)
-	retrievedRun2, _, err := s.runClient.Get(&runParams.GetRunParams{RunID: run2.Run.ID})
+	retrievedRun2, _, err := s.runClient.Get(context.Background(), &runParams.GetRunParams{RunID: run2.Run.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, run_model.V1RunStorageState("STORAGESTATE_ARCHIVED"), retrievedRun2.Run.StorageState)
-	retrievedJob1, err := s.jobClient.Get(&jobParams.GetJobParams{ID: job1.ID})
+	retrievedJob1, err := s.jobClient.Get(context.Background(), &jobParams.GetJobParams{ID: job1.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, false, retrievedJob1.Enabled)
-	retrievedJob2, err := s.jobClient.Get(&jobParams.GetJobParams{ID: job2.ID})
+	retrievedJob2, err := s.jobClient.Get(context.Background(), &jobParams.GetJobParams{ID: job2.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, false, retrievedJob2.Enabled)
 
 	/* ---------- Unarchive an experiment -----------------*/
-	err = s.experimentClient.Unarchive(&params.UnarchiveExperimentParams{ID: trainingExperiment.ID})
+	err = s.experimentClient.Unarchive(context.Background(), &params.UnarchiveExperimentParams{ID: trainingExperiment.ID})
 
 	/* ---------- Verify experiment and its runs and jobs --------- */
-	experiment, err = s.experimentClient.Get(&params.GetExperimentParams{ID: trainingExperiment.ID})
+	experiment, err = s.experimentClient.Get(context.Background(), &params.GetExperimentParams{ID: trainingExperiment.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, experiment_model.V1ExperimentStorageState("STORAGESTATE_AVAILABLE"), experiment.StorageState)
-	retrievedRun1, _, err = s.runClient.Get(&runParams.GetRunParams{RunID: run1.Run.ID})
+	retrievedRun1, _, err = s.runClient.Get(context.Background(), &runParams.GetRunParams{RunID: run1.Run.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, run_model.V1RunStorageState("STORAGESTATE_ARCHIVED"), retrievedRun1.Run.StorageState)
-	retrievedRun2, _, err = s.runClient.Get(&runParams.GetRunParams{RunID: run2.Run.ID})
+	retrievedRun2, _, err = s.runClient.Get(context.Background(), &runParams.GetRunParams{RunID: run2.Run.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, run_model.V1RunStorageState("STORAGESTATE_ARCHIVED"), retrievedRun2.Run.StorageState)
-	retrievedJob1, err = s.jobClient.Get(&jobParams.GetJobParams{ID: job1.ID})
+	retrievedJob1, err = s.jobClient.Get(context.Background(), &jobParams.GetJobParams{ID: job1.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, false, retrievedJob1.Enabled)
-	retrievedJob2, err = s.jobClient.Get(&jobParams.GetJobParams{ID: job2.ID})
+	retrievedJob2, err = s.jobClient.Get(context.Background(), &jobParams.GetJobParams{ID: job2.ID})
 	assert.Nil(t, err)
 	assert.Equal(t, false, retrievedJob2.Enabled)
 }
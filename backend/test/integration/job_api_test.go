@@ -101,12 +101,12 @@ func (s *JobApiTestSuite) TestJobApis() {
 	t := s.T()
 
 	/* ---------- Upload pipelines YAML ---------- */
-	helloWorldPipeline, err := s.pipelineUploadClient.UploadFile("../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
+	helloWorldPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
 	assert.Nil(t, err)
 
 	/* ---------- Upload pipeline version YAML ---------- */
 	time.Sleep(1 * time.Second)
-	helloWorldPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(
+	helloWorldPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(context.Background(),
 		"../resources/hello-world.yaml", &uploadParams.UploadPipelineVersionParams{
 			Name:       util.StringPointer("hello-world-version"),
 			Pipelineid: util.StringPointer(helloWorldPipeline.ID),
@@ -115,7 +115,7 @@ func (s *JobApiTestSuite) TestJobApis() {
 
 	/* ---------- Create a new hello world experiment ---------- */
 	experiment := &experiment_model.V1Experiment{Name: "hello world experiment"}
-	helloWorldExperiment, err := s.experimentClient.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+	helloWorldExperiment, err := s.experimentClient.Create(context.Background(), &experimentparams.CreateExperimentParams{Body: experiment})
 	assert.Nil(t, err)
 
 	/* ---------- Create a new hello world job by specifying pipeline ID ---------- */
@@ -131,18 +131,18 @@ func (s *JobApiTestSuite) TestJobApis() {
 		MaxConcurrency: 10,
 		Enabled:        true,
 	}}
-	helloWorldJob, err := s.jobClient.Create(createJobRequest)
+	helloWorldJob, err := s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
 	s.checkHelloWorldJob(t, helloWorldJob, helloWorldExperiment.ID, helloWorldExperiment.Name, helloWorldPipelineVersion.ID, helloWorldPipelineVersion.Name)
 
 	/* ---------- Get hello world job ---------- */
-	helloWorldJob, err = s.jobClient.Get(&jobparams.GetJobParams{ID: helloWorldJob.ID})
+	helloWorldJob, err = s.jobClient.Get(context.Background(), &jobparams.GetJobParams{ID: helloWorldJob.ID})
 	assert.Nil(t, err)
 	s.checkHelloWorldJob(t, helloWorldJob, helloWorldExperiment.ID, helloWorldExperiment.Name, helloWorldPipelineVersion.ID, helloWorldPipelineVersion.Name)
 
 	/* ---------- Create a new argument parameter experiment ---------- */
 	experiment = &experiment_model.V1Experiment{Name: "argument parameter experiment"}
-	argParamsExperiment, err := s.experimentClient.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+	argParamsExperiment, err := s.experimentClient.Create(context.Background(), &experimentparams.CreateExperimentParams{Body: experiment})
 	assert.Nil(t, err)
 
 	/* ---------- Create a new argument parameter job by uploading workflow manifest ---------- */
@@ -170,24 +170,24 @@ func (s *JobApiTestSuite) TestJobApis() {
 		MaxConcurrency: 10,
 		Enabled:        true,
 	}}
-	argParamsJob, err := s.jobClient.Create(createJobRequest)
+	argParamsJob, err := s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
 	s.checkArgParamsJob(t, argParamsJob, argParamsExperiment.ID, argParamsExperiment.Name)
 
 	/* ---------- List all the jobs. Both jobs should be returned ---------- */
-	jobs, totalSize, _, err := s.jobClient.List(&jobparams.ListJobsParams{})
+	jobs, totalSize, _, err := s.jobClient.List(context.Background(), &jobparams.ListJobsParams{})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, totalSize)
 	assert.Equal(t, 2, len(jobs))
 
 	/* ---------- List the jobs, paginated, sort by creation time ---------- */
-	jobs, totalSize, nextPageToken, err := s.jobClient.List(
+	jobs, totalSize, nextPageToken, err := s.jobClient.List(context.Background(),
 		&jobparams.ListJobsParams{PageSize: util.Int32Pointer(1), SortBy: util.StringPointer("created_at")})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(jobs))
 	assert.Equal(t, 2, totalSize)
 	assert.Equal(t, "hello world", jobs[0].Name)
-	jobs, totalSize, _, err = s.jobClient.List(&jobparams.ListJobsParams{
+	jobs, totalSize, _, err = s.jobClient.List(context.Background(), &jobparams.ListJobsParams{
 		PageSize: util.Int32Pointer(1), PageToken: util.StringPointer(nextPageToken)})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(jobs))
@@ -195,13 +195,13 @@ func (s *JobApiTestSuite) TestJobApis() {
 	assert.Equal(t, "argument parameter", jobs[0].Name)
 
 	/* ---------- List the jobs, paginated, sort by name ---------- */
-	jobs, totalSize, nextPageToken, err = s.jobClient.List(&jobparams.ListJobsParams{
+	jobs, totalSize, nextPageToken, err = s.jobClient.List(context.Background(), &jobparams.ListJobsParams{
 		PageSize: util.Int32Pointer(1), SortBy: util.StringPointer("name")})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, totalSize)
 	assert.Equal(t, 1, len(jobs))
 	assert.Equal(t, "argument parameter", jobs[0].Name)
-	jobs, totalSize, _, err = s.jobClient.List(&jobparams.ListJobsParams{
+	jobs, totalSize, _, err = s.jobClient.List(context.Background(), &jobparams.ListJobsParams{
 		PageSize: util.Int32Pointer(1), SortBy: util.StringPointer("name"), PageToken: util.StringPointer(nextPageToken)})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, totalSize)
@@ -209,13 +209,13 @@ func (s *JobApiTestSuite) TestJobApis() {
 	assert.Equal(t, "hello world", jobs[0].Name)
 
 	/* ---------- List the jobs, sort by unsupported field ---------- */
-	jobs, _, _, err = s.jobClient.List(&jobparams.ListJobsParams{
+	jobs, _, _, err = s.jobClient.List(context.Background(), &jobparams.ListJobsParams{
 		PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("unknown")})
 	assert.NotNil(t, err)
 	assert.Equal(t, len(jobs), 0)
 
 	/* ---------- List jobs for hello world experiment. One job should be returned ---------- */
-	jobs, totalSize, _, err = s.jobClient.List(&jobparams.ListJobsParams{
+	jobs, totalSize, _, err = s.jobClient.List(context.Background(), &jobparams.ListJobsParams{
 		ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 		ResourceReferenceKeyID:   util.StringPointer(helloWorldExperiment.ID)})
 	assert.Nil(t, err)
@@ -228,7 +228,7 @@ func (s *JobApiTestSuite) TestJobApis() {
 
 	/* ---------- Check run for hello world job ---------- */
 	if err := retrier.New(retrier.ConstantBackoff(8, 5*time.Second), nil).Run(func() error {
-		runs, totalSize, _, err := s.runClient.List(&runParams.ListRunsParams{
+		runs, totalSize, _, err := s.runClient.List(context.Background(), &runParams.ListRunsParams{
 			ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 			ResourceReferenceKeyID:   util.StringPointer(helloWorldExperiment.ID)})
 		if err != nil {
@@ -248,7 +248,7 @@ func (s *JobApiTestSuite) TestJobApis() {
 
 	/* ---------- Check run for argument parameter job ---------- */
 	if err := retrier.New(retrier.ConstantBackoff(8, 5*time.Second), nil).Run(func() error {
-		runs, totalSize, _, err := s.runClient.List(&runParams.ListRunsParams{
+		runs, totalSize, _, err := s.runClient.List(context.Background(), &runParams.ListRunsParams{
 			ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 			ResourceReferenceKeyID:   util.StringPointer(argParamsExperiment.ID)})
 		if err != nil {
@@ -272,12 +272,12 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 	t := s.T()
 
 	/* ---------- Upload pipelines YAML ---------- */
-	pipeline, err := s.pipelineUploadClient.UploadFile("../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
+	pipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
 	assert.Nil(t, err)
 
 	/* ---------- Upload pipeline version YAML ---------- */
 	time.Sleep(1 * time.Second)
-	helloWorldPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(
+	helloWorldPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(context.Background(),
 		"../resources/hello-world.yaml", &uploadParams.UploadPipelineVersionParams{
 			Name:       util.StringPointer("hello-world-version"),
 			Pipelineid: util.StringPointer(pipeline.ID),
@@ -286,7 +286,7 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 
 	/* ---------- Create a periodic job with start and end date in the past and catchup = true ---------- */
 	experiment := &experiment_model.V1Experiment{Name: "periodic catchup true"}
-	periodicCatchupTrueExperiment, err := s.experimentClient.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+	periodicCatchupTrueExperiment, err := s.experimentClient.Create(context.Background(), &experimentparams.CreateExperimentParams{Body: experiment})
 	assert.Nil(t, err)
 
 	job := jobInThePastForTwoMinutes(jobOptions{
@@ -298,12 +298,12 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 	job.Description = "A job with NoCatchup=false will backfill each past interval when behind schedule."
 	job.NoCatchup = false // This is the key difference.
 	createJobRequest := &jobparams.CreateJobParams{Body: job}
-	_, err = s.jobClient.Create(createJobRequest)
+	_, err = s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
 
 	/* -------- Create another periodic job with start and end date in the past but catchup = false ------ */
 	experiment = &experiment_model.V1Experiment{Name: "periodic catchup false"}
-	periodicCatchupFalseExperiment, err := s.experimentClient.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+	periodicCatchupFalseExperiment, err := s.experimentClient.Create(context.Background(), &experimentparams.CreateExperimentParams{Body: experiment})
 	assert.Nil(t, err)
 
 	job = jobInThePastForTwoMinutes(jobOptions{
@@ -315,12 +315,12 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 	job.Description = "A job with NoCatchup=true only schedules the last interval when behind schedule."
 	job.NoCatchup = true // This is the key difference.
 	createJobRequest = &jobparams.CreateJobParams{Body: job}
-	_, err = s.jobClient.Create(createJobRequest)
+	_, err = s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
 
 	/* ---------- Create a cron job with start and end date in the past and catchup = true ---------- */
 	experiment = &experiment_model.V1Experiment{Name: "cron catchup true"}
-	cronCatchupTrueExperiment, err := s.experimentClient.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+	cronCatchupTrueExperiment, err := s.experimentClient.Create(context.Background(), &experimentparams.CreateExperimentParams{Body: experiment})
 	assert.Nil(t, err)
 
 	job = jobInThePastForTwoMinutes(jobOptions{
@@ -332,12 +332,12 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 	job.Description = "A job with NoCatchup=false will backfill each past interval when behind schedule."
 	job.NoCatchup = false // This is the key difference.
 	createJobRequest = &jobparams.CreateJobParams{Body: job}
-	_, err = s.jobClient.Create(createJobRequest)
+	_, err = s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
 
 	/* -------- Create another cron job with start and end date in the past but catchup = false ------ */
 	experiment = &experiment_model.V1Experiment{Name: "cron catchup false"}
-	cronCatchupFalseExperiment, err := s.experimentClient.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+	cronCatchupFalseExperiment, err := s.experimentClient.Create(context.Background(), &experimentparams.CreateExperimentParams{Body: experiment})
 	assert.Nil(t, err)
 
 	job = jobInThePastForTwoMinutes(jobOptions{
@@ -349,7 +349,7 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 	job.Description = "A job with NoCatchup=true only schedules the last interval when behind schedule."
 	job.NoCatchup = true // This is the key difference.
 	createJobRequest = &jobparams.CreateJobParams{Body: job}
-	_, err = s.jobClient.Create(createJobRequest)
+	_, err = s.jobClient.Create(context.Background(), createJobRequest)
 	assert.Nil(t, err)
 
 	// The scheduledWorkflow CRD would create the run and it synced to the DB by persistent agent.
@@ -357,7 +357,7 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 
 	/* ---------- Assert number of runs when catchup = true ---------- */
 	if err := retrier.New(retrier.ConstantBackoff(8, 5*time.Second), nil).Run(func() error {
-		_, runsWhenCatchupTrue, _, err := s.runClient.List(&runParams.ListRunsParams{
+		_, runsWhenCatchupTrue, _, err := s.runClient.List(context.Background(), &runParams.ListRunsParams{
 			ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 			ResourceReferenceKeyID:   util.StringPointer(periodicCatchupTrueExperiment.ID)})
 		if err != nil {
@@ -367,7 +367,7 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 			return fmt.Errorf("expected runsWhenCatchupTrue to be 1, got: %v", runsWhenCatchupTrue)
 		}
 
-		_, runsWhenCatchupTrue, _, err = s.runClient.List(&runParams.ListRunsParams{
+		_, runsWhenCatchupTrue, _, err = s.runClient.List(context.Background(), &runParams.ListRunsParams{
 			ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 			ResourceReferenceKeyID:   util.StringPointer(cronCatchupTrueExperiment.ID)})
 		if err != nil {
@@ -383,7 +383,7 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 
 	/* ---------- Assert number of runs when catchup = false ---------- */
 	if err := retrier.New(retrier.ConstantBackoff(8, 5*time.Second), nil).Run(func() error {
-		_, runsWhenCatchupFalse, _, err := s.runClient.List(&runParams.ListRunsParams{
+		_, runsWhenCatchupFalse, _, err := s.runClient.List(context.Background(), &runParams.ListRunsParams{
 			ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 			ResourceReferenceKeyID:   util.StringPointer(periodicCatchupFalseExperiment.ID)})
 		if err != nil {
@@ -393,7 +393,7 @@ func (s *JobApiTestSuite) TestJobApis_noCatchupOption() {
 			return fmt.Errorf("expected runsWhenCatchupFalse to be 1, got: %v", runsWhenCatchupFalse)
 		}
 
-		_, runsWhenCatchupFalse, _, err = s.runClient.List(&runParams.ListRunsParams{
+		_, runsWhenCatchupFalse, _, err = s.runClient.List(context.Background(), &runParams.ListRunsParams{
 			ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 			ResourceReferenceKeyID:   util.StringPointer(cronCatchupFalseExperiment.ID)})
 		if err != nil {
@@ -476,7 +476,7 @@ func (s *JobApiTestSuite) TestJobApis_SwfNotFound() {
 	t := s.T()
 
 	/* ---------- Upload pipelines YAML ---------- */
-	pipeline, err := s.pipelineUploadClient.UploadFile("../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
+	pipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
 	require.Nil(t, err)
 
 	/* ---------- Create a new hello world job by specifying pipeline ID ---------- */
@@ -488,7 +488,7 @@ func (s *JobApiTestSuite) TestJobApis_SwfNotFound() {
 		MaxConcurrency: 10,
 		Enabled:        false,
 	}}
-	job, err := s.jobClient.Create(createJobRequest)
+	job, err := s.jobClient.Create(context.Background(), createJobRequest)
 	require.Nil(t, err)
 
 	// Delete all ScheduledWorkflow custom resources to simulate the situation
@@ -497,11 +497,11 @@ func (s *JobApiTestSuite) TestJobApis_SwfNotFound() {
 	err = s.swfClient.ScheduledWorkflow(s.namespace).DeleteCollection(context.Background(), v1.DeleteOptions{}, v1.ListOptions{})
 	require.Nil(t, err)
 
-	err = s.jobClient.Delete(&jobparams.DeleteJobParams{ID: job.ID})
+	err = s.jobClient.Delete(context.Background(), &jobparams.DeleteJobParams{ID: job.ID})
 	require.Nil(t, err)
 
 	/* ---------- Get job ---------- */
-	_, err = s.jobClient.Get(&jobparams.GetJobParams{ID: job.ID})
+	_, err = s.jobClient.Get(context.Background(), &jobparams.GetJobParams{ID: job.ID})
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "not found")
 }
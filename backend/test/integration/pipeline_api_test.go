@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -67,24 +68,24 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	test.DeleteAllPipelines(s.pipelineClient, t)
 
 	/* ------ Upload v2 pipeline spec JSON --------*/
-	v2HelloPipeline, err := s.pipelineUploadClient.UploadFile("../resources/v2-hello-world.json", uploadParams.NewUploadPipelineParams())
+	v2HelloPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/v2-hello-world.json", uploadParams.NewUploadPipelineParams())
 	require.Nil(t, err)
 	assert.Equal(t, "v2-hello-world.json", v2HelloPipeline.Name)
 
 	/* ---------- Upload pipelines YAML ---------- */
 	time.Sleep(1 * time.Second)
-	argumentYAMLPipeline, err := s.pipelineUploadClient.UploadFile("../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineParams())
+	argumentYAMLPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineParams())
 	require.Nil(t, err)
 	assert.Equal(t, "arguments-parameters.yaml", argumentYAMLPipeline.Name)
 
 	/* ---------- Upload the same pipeline again. Should fail due to name uniqueness ---------- */
-	_, err = s.pipelineUploadClient.UploadFile("../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineParams())
+	_, err = s.pipelineUploadClient.UploadFile(context.Background(), "../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineParams())
 	require.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Failed to upload pipeline.")
 
 	/* ---------- Import pipeline YAML by URL ---------- */
 	time.Sleep(1 * time.Second)
-	sequentialPipeline, err := s.pipelineClient.Create(&params.CreatePipelineParams{
+	sequentialPipeline, err := s.pipelineClient.Create(context.Background(), &params.CreatePipelineParams{
 		Body: &model.V1Pipeline{Name: "sequential", URL: &model.V1URL{
 			PipelineURL: "https://storage.googleapis.com/ml-pipeline-dataset/sequential.yaml"}}})
 	require.Nil(t, err)
@@ -92,21 +93,21 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 
 	/* ---------- Upload pipelines zip ---------- */
 	time.Sleep(1 * time.Second)
-	argumentUploadPipeline, err := s.pipelineUploadClient.UploadFile(
+	argumentUploadPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(),
 		"../resources/arguments.pipeline.zip", &uploadParams.UploadPipelineParams{Name: util.StringPointer("zip-arguments-parameters")})
 	require.Nil(t, err)
 	assert.Equal(t, "zip-arguments-parameters", argumentUploadPipeline.Name)
 
 	/* ---------- Import pipeline tarball by URL ---------- */
 	time.Sleep(1 * time.Second)
-	argumentUrlPipeline, err := s.pipelineClient.Create(&params.CreatePipelineParams{
+	argumentUrlPipeline, err := s.pipelineClient.Create(context.Background(), &params.CreatePipelineParams{
 		Body: &model.V1Pipeline{URL: &model.V1URL{
 			PipelineURL: "https://storage.googleapis.com/ml-pipeline-dataset/arguments.pipeline.zip"}}})
 	require.Nil(t, err)
 	assert.Equal(t, "arguments.pipeline.zip", argumentUrlPipeline.Name)
 
 	/* ---------- Verify list pipeline works ---------- */
-	pipelines, totalSize, _, err := s.pipelineClient.List(&params.ListPipelinesParams{})
+	pipelines, totalSize, _, err := s.pipelineClient.List(context.Background(), &params.ListPipelinesParams{})
 	require.Nil(t, err)
 	assert.Equal(t, 5, len(pipelines))
 	assert.Equal(t, 5, totalSize)
@@ -118,7 +119,7 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	}
 
 	/* ---------- Verify list pipeline sorted by names ---------- */
-	listFirstPagePipelines, totalSize, nextPageToken, err := s.pipelineClient.List(
+	listFirstPagePipelines, totalSize, nextPageToken, err := s.pipelineClient.List(context.Background(),
 		&params.ListPipelinesParams{PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("name")})
 	require.Nil(t, err)
 	assert.Equal(t, 2, len(listFirstPagePipelines))
@@ -127,7 +128,7 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	assert.Equal(t, "arguments.pipeline.zip", listFirstPagePipelines[1].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	listSecondPagePipelines, totalSize, nextPageToken, err := s.pipelineClient.List(
+	listSecondPagePipelines, totalSize, nextPageToken, err := s.pipelineClient.List(context.Background(),
 		&params.ListPipelinesParams{PageToken: util.StringPointer(nextPageToken), PageSize: util.Int32Pointer(3), SortBy: util.StringPointer("name")})
 	require.Nil(t, err)
 	assert.Equal(t, 3, len(listSecondPagePipelines))
@@ -138,7 +139,7 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- Verify list pipeline sorted by creation time ---------- */
-	listFirstPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(
+	listFirstPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(context.Background(),
 		&params.ListPipelinesParams{PageSize: util.Int32Pointer(3), SortBy: util.StringPointer("created_at")})
 	require.Nil(t, err)
 	assert.Equal(t, 3, len(listFirstPagePipelines))
@@ -148,7 +149,7 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	assert.Equal(t, "sequential", listFirstPagePipelines[2].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	listSecondPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(
+	listSecondPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(context.Background(),
 		&params.ListPipelinesParams{PageToken: util.StringPointer(nextPageToken), PageSize: util.Int32Pointer(3), SortBy: util.StringPointer("created_at")})
 	require.Nil(t, err)
 	assert.Equal(t, 2, len(listSecondPagePipelines))
@@ -158,12 +159,12 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- List pipelines sort by unsupported description field. Should fail. ---------- */
-	_, _, _, err = s.pipelineClient.List(&params.ListPipelinesParams{
+	_, _, _, err = s.pipelineClient.List(context.Background(), &params.ListPipelinesParams{
 		PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("unknownfield")})
 	assert.NotNil(t, err)
 
 	/* ---------- List pipelines sorted by names descend order ---------- */
-	listFirstPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(
+	listFirstPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(context.Background(),
 		&params.ListPipelinesParams{PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("name desc")})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(listFirstPagePipelines))
@@ -172,7 +173,7 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	assert.Equal(t, "sequential", listFirstPagePipelines[1].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	listSecondPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(&params.ListPipelinesParams{
+	listSecondPagePipelines, totalSize, nextPageToken, err = s.pipelineClient.List(context.Background(), &params.ListPipelinesParams{
 		PageToken: util.StringPointer(nextPageToken), PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("name desc")})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(listSecondPagePipelines))
@@ -182,12 +183,12 @@ func (s *PipelineApiTest) TestPipelineAPI() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- Verify get pipeline works ---------- */
-	pipeline, err := s.pipelineClient.Get(&params.GetPipelineParams{ID: argumentYAMLPipeline.ID})
+	pipeline, err := s.pipelineClient.Get(context.Background(), &params.GetPipelineParams{ID: argumentYAMLPipeline.ID})
 	assert.Nil(t, err)
 	verifyPipeline(t, pipeline)
 
 	/* ---------- Verify get template works ---------- */
-	template, err := s.pipelineClient.GetTemplate(&params.GetTemplateParams{ID: argumentYAMLPipeline.ID})
+	template, err := s.pipelineClient.GetTemplate(context.Background(), &params.GetTemplateParams{ID: argumentYAMLPipeline.ID})
 	assert.Nil(t, err)
 	expected, err := ioutil.ReadFile("../resources/arguments-parameters.yaml")
 	assert.Nil(t, err)
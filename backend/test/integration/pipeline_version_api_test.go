@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -66,12 +67,12 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	pipelineParams := uploadParams.NewUploadPipelineParams()
 	pipelineName := "test_pipeline"
 	pipelineParams.SetName(&pipelineName)
-	pipeline, err := s.pipelineUploadClient.UploadFile("../resources/arguments-parameters.yaml", pipelineParams)
+	pipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/arguments-parameters.yaml", pipelineParams)
 	require.Nil(t, err)
 	assert.Equal(t, "test_pipeline", pipeline.Name)
 
 	/* ---------- Get pipeline id ---------- */
-	pipelines, totalSize, _, err := s.pipelineClient.List(&params.ListPipelinesParams{})
+	pipelines, totalSize, _, err := s.pipelineClient.List(context.Background(), &params.ListPipelinesParams{})
 	require.Nil(t, err)
 	assert.Equal(t, 1, len(pipelines))
 	assert.Equal(t, 1, totalSize)
@@ -81,34 +82,34 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	time.Sleep(1 * time.Second)
 	pipelineVersionParams := uploadParams.NewUploadPipelineVersionParams()
 	pipelineVersionParams.SetPipelineid(&pipelineId)
-	argumentYAMLPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion("../resources/arguments-parameters.yaml", pipelineVersionParams)
+	argumentYAMLPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(context.Background(), "../resources/arguments-parameters.yaml", pipelineVersionParams)
 	require.Nil(t, err)
 	assert.Equal(t, "arguments-parameters.yaml", argumentYAMLPipelineVersion.Name)
 
 	/* ---------- Update pipeline default version ---------- */
 	time.Sleep(1 * time.Second)
 	sortBy := "created_at"
-	versions, _, _, err := s.pipelineClient.ListPipelineVersions(&params.ListPipelineVersionsParams{ResourceKeyID: &pipelineId, SortBy: &sortBy})
+	versions, _, _, err := s.pipelineClient.ListPipelineVersions(context.Background(), &params.ListPipelineVersionsParams{ResourceKeyID: &pipelineId, SortBy: &sortBy})
 	require.Nil(t, err)
 
-	err = s.pipelineClient.UpdateDefaultVersion(&params.UpdatePipelineDefaultVersionParams{PipelineID: pipelineId,
+	err = s.pipelineClient.UpdateDefaultVersion(context.Background(), &params.UpdatePipelineDefaultVersionParams{PipelineID: pipelineId,
 		VersionID: versions[0].ID})
 	require.Nil(t, err)
 
 	time.Sleep(1 * time.Second)
-	pipelineSelected, err := s.pipelineClient.Get(&params.GetPipelineParams{ID: pipelineId})
+	pipelineSelected, err := s.pipelineClient.Get(context.Background(), &params.GetPipelineParams{ID: pipelineId})
 	require.Nil(t, err)
 	assert.Equal(t, pipelineSelected.DefaultVersion.ID, versions[0].ID)
 
 	/* ---------- Upload the same pipeline version again. Should fail due to name uniqueness ---------- */
 	time.Sleep(1 * time.Second)
-	_, err = s.pipelineUploadClient.UploadPipelineVersion("../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineVersionParams())
+	_, err = s.pipelineUploadClient.UploadPipelineVersion(context.Background(), "../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineVersionParams())
 	require.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Failed to upload pipeline version.")
 
 	/* ---------- Import pipeline version YAML by URL ---------- */
 	time.Sleep(1 * time.Second)
-	sequentialPipelineVersion, err := s.pipelineClient.CreatePipelineVersion(&params.CreatePipelineVersionParams{
+	sequentialPipelineVersion, err := s.pipelineClient.CreatePipelineVersion(context.Background(), &params.CreatePipelineVersionParams{
 		Body: &pipeline_model.V1PipelineVersion{
 			Name: "sequential",
 			PackageURL: &pipeline_model.V1URL{
@@ -126,7 +127,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 
 	/* ---------- Upload pipeline version zip ---------- */
 	time.Sleep(1 * time.Second)
-	argumentUploadPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(
+	argumentUploadPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(context.Background(),
 		"../resources/arguments.pipeline.zip", &uploadParams.UploadPipelineVersionParams{
 			Name:       util.StringPointer("zip-arguments-parameters"),
 			Pipelineid: util.StringPointer(pipelineId),
@@ -136,7 +137,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 
 	/* ---------- Import pipeline tarball by URL ---------- */
 	time.Sleep(1 * time.Second)
-	argumentUrlPipelineVersion, err := s.pipelineClient.CreatePipelineVersion(&params.CreatePipelineVersionParams{
+	argumentUrlPipelineVersion, err := s.pipelineClient.CreatePipelineVersion(context.Background(), &params.CreatePipelineVersionParams{
 		Body: &pipeline_model.V1PipelineVersion{
 			Name: "arguments",
 			PackageURL: &pipeline_model.V1URL{
@@ -153,7 +154,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.Equal(t, "arguments", argumentUrlPipelineVersion.Name)
 
 	/* ---------- Verify list pipeline version works ---------- */
-	pipelineVersions, totalSize, _, err := s.pipelineClient.ListPipelineVersions(&params.ListPipelineVersionsParams{
+	pipelineVersions, totalSize, _, err := s.pipelineClient.ListPipelineVersions(context.Background(), &params.ListPipelineVersionsParams{
 		ResourceKeyID:   util.StringPointer(pipelineId),
 		ResourceKeyType: util.StringPointer("PIPELINE"),
 	})
@@ -175,7 +176,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	}
 
 	/* ---------- Verify list pipeline sorted by names ---------- */
-	listFirstPagePipelineVersions, totalSize, nextPageToken, err := s.pipelineClient.ListPipelineVersions(
+	listFirstPagePipelineVersions, totalSize, nextPageToken, err := s.pipelineClient.ListPipelineVersions(context.Background(),
 		&params.ListPipelineVersionsParams{
 			PageSize:        util.Int32Pointer(3),
 			SortBy:          util.StringPointer("name"),
@@ -190,7 +191,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.Equal(t, "sequential", listFirstPagePipelineVersions[2].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	listSecondPagePipelineVersions, totalSize, nextPageToken, err := s.pipelineClient.ListPipelineVersions(
+	listSecondPagePipelineVersions, totalSize, nextPageToken, err := s.pipelineClient.ListPipelineVersions(context.Background(),
 		&params.ListPipelineVersionsParams{
 			PageToken:       util.StringPointer(nextPageToken),
 			PageSize:        util.Int32Pointer(3),
@@ -206,7 +207,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- Verify list pipeline version sorted by creation time ---------- */
-	listFirstPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(
+	listFirstPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(context.Background(),
 		&params.ListPipelineVersionsParams{
 			PageSize:        util.Int32Pointer(3),
 			SortBy:          util.StringPointer("created_at"),
@@ -221,7 +222,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.Equal(t, "sequential", listFirstPagePipelineVersions[2].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	listSecondPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(
+	listSecondPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(context.Background(),
 		&params.ListPipelineVersionsParams{
 			PageToken:       util.StringPointer(nextPageToken),
 			PageSize:        util.Int32Pointer(3),
@@ -237,7 +238,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- List pipeline versions sort by unsupported description field. Should fail. ---------- */
-	_, _, _, err = s.pipelineClient.ListPipelineVersions(&params.ListPipelineVersionsParams{
+	_, _, _, err = s.pipelineClient.ListPipelineVersions(context.Background(), &params.ListPipelineVersionsParams{
 		PageSize:        util.Int32Pointer(2),
 		SortBy:          util.StringPointer("unknownfield"),
 		ResourceKeyID:   util.StringPointer(pipelineId),
@@ -246,7 +247,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.NotNil(t, err)
 
 	/* ---------- List pipeline versions sorted by names descend order ---------- */
-	listFirstPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(
+	listFirstPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(context.Background(),
 		&params.ListPipelineVersionsParams{
 			PageSize:        util.Int32Pointer(3),
 			SortBy:          util.StringPointer("name desc"),
@@ -261,7 +262,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.Equal(t, "sequential", listFirstPagePipelineVersions[2].Name)
 	assert.NotEmpty(t, nextPageToken)
 
-	listSecondPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(
+	listSecondPagePipelineVersions, totalSize, nextPageToken, err = s.pipelineClient.ListPipelineVersions(context.Background(),
 		&params.ListPipelineVersionsParams{
 			PageToken:       util.StringPointer(nextPageToken),
 			PageSize:        util.Int32Pointer(3),
@@ -277,7 +278,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 	assert.Empty(t, nextPageToken)
 
 	/* ---------- Verify get pipeline version works ---------- */
-	pipelineVersion, err := s.pipelineClient.GetPipelineVersion(&params.GetPipelineVersionParams{VersionID: argumentUrlPipelineVersion.ID})
+	pipelineVersion, err := s.pipelineClient.GetPipelineVersion(context.Background(), &params.GetPipelineVersionParams{VersionID: argumentUrlPipelineVersion.ID})
 	require.Nil(t, err)
 	assert.Equal(t, pipelineVersion.Name, "arguments")
 	assert.NotNil(t, pipelineVersion.CreatedAt)
@@ -288,7 +289,7 @@ func (s *PipelineVersionApiTest) TestArgoSpec() {
 		})
 
 	/* ---------- Verify get template works ---------- */
-	template, err := s.pipelineClient.GetPipelineVersionTemplate(&params.GetPipelineVersionTemplateParams{VersionID: argumentYAMLPipelineVersion.ID})
+	template, err := s.pipelineClient.GetPipelineVersionTemplate(context.Background(), &params.GetPipelineVersionTemplateParams{VersionID: argumentYAMLPipelineVersion.ID})
 	assert.Nil(t, err)
 	expected, err := ioutil.ReadFile("../resources/arguments-parameters.yaml")
 	assert.Nil(t, err)
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"io/ioutil"
 	"sort"
 	"testing"
@@ -77,12 +78,12 @@ func (s *RunApiTestSuite) TestRunApis() {
 	t := s.T()
 
 	/* ---------- Upload pipelines YAML ---------- */
-	helloWorldPipeline, err := s.pipelineUploadClient.UploadFile("../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
+	helloWorldPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
 	assert.Nil(t, err)
 
 	/* ---------- Upload a pipeline version YAML under helloWorldPipeline ---------- */
 	time.Sleep(1 * time.Second)
-	helloWorldPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(
+	helloWorldPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(context.Background(),
 		"../resources/hello-world.yaml", &uploadParams.UploadPipelineVersionParams{
 			Name:       util.StringPointer("hello-world-version"),
 			Pipelineid: util.StringPointer(helloWorldPipeline.ID),
@@ -91,7 +92,7 @@ func (s *RunApiTestSuite) TestRunApis() {
 
 	/* ---------- Create a new hello world experiment ---------- */
 	experiment := &experiment_model.V1Experiment{Name: "hello world experiment"}
-	helloWorldExperiment, err := s.experimentClient.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+	helloWorldExperiment, err := s.experimentClient.Create(context.Background(), &experimentparams.CreateExperimentParams{Body: experiment})
 	assert.Nil(t, err)
 
 	/* ---------- Create a new hello world run by specifying pipeline version ID ---------- */
@@ -105,18 +106,18 @@ func (s *RunApiTestSuite) TestRunApis() {
 				Relationship: run_model.V1RelationshipCREATOR},
 		},
 	}}
-	helloWorldRunDetail, _, err := s.runClient.Create(createRunRequest)
+	helloWorldRunDetail, _, err := s.runClient.Create(context.Background(), createRunRequest)
 	assert.Nil(t, err)
 	s.checkHelloWorldRunDetail(t, helloWorldRunDetail, helloWorldExperiment.ID, helloWorldExperiment.Name, helloWorldPipelineVersion.ID, helloWorldPipelineVersion.Name)
 
 	/* ---------- Get hello world run ---------- */
-	helloWorldRunDetail, _, err = s.runClient.Get(&runparams.GetRunParams{RunID: helloWorldRunDetail.Run.ID})
+	helloWorldRunDetail, _, err = s.runClient.Get(context.Background(), &runparams.GetRunParams{RunID: helloWorldRunDetail.Run.ID})
 	assert.Nil(t, err)
 	s.checkHelloWorldRunDetail(t, helloWorldRunDetail, helloWorldExperiment.ID, helloWorldExperiment.Name, helloWorldPipelineVersion.ID, helloWorldPipelineVersion.Name)
 
 	/* ---------- Create a new argument parameter experiment ---------- */
 	createExperimentRequest := &experimentparams.CreateExperimentParams{Body: &experiment_model.V1Experiment{Name: "argument parameter experiment"}}
-	argParamsExperiment, err := s.experimentClient.Create(createExperimentRequest)
+	argParamsExperiment, err := s.experimentClient.Create(context.Background(), createExperimentRequest)
 	assert.Nil(t, err)
 
 	/* ---------- Create a new argument parameter run by uploading workflow manifest ---------- */
@@ -139,25 +140,25 @@ func (s *RunApiTestSuite) TestRunApis() {
 				Relationship: run_model.V1RelationshipOWNER},
 		},
 	}}
-	argParamsRunDetail, _, err := s.runClient.Create(createRunRequest)
+	argParamsRunDetail, _, err := s.runClient.Create(context.Background(), createRunRequest)
 	assert.Nil(t, err)
 	s.checkArgParamsRunDetail(t, argParamsRunDetail, argParamsExperiment.ID, argParamsExperiment.Name)
 
 	/* ---------- List all the runs. Both runs should be returned ---------- */
-	runs, totalSize, _, err := s.runClient.List(&runparams.ListRunsParams{})
+	runs, totalSize, _, err := s.runClient.List(context.Background(), &runparams.ListRunsParams{})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(runs))
 	assert.Equal(t, 2, totalSize)
 
 	/* ---------- List the runs, paginated, sorted by creation time ---------- */
-	runs, totalSize, nextPageToken, err := s.runClient.List(
+	runs, totalSize, nextPageToken, err := s.runClient.List(context.Background(),
 		&runparams.ListRunsParams{PageSize: util.Int32Pointer(1), SortBy: util.StringPointer("created_at")})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(runs))
 	assert.Equal(t, 2, totalSize)
 	/* TODO(issues/1762): fix the following flaky assertion. */
 	/* assert.Equal(t, "hello world", runs[0].Name) */
-	runs, totalSize, _, err = s.runClient.List(&runparams.ListRunsParams{
+	runs, totalSize, _, err = s.runClient.List(context.Background(), &runparams.ListRunsParams{
 		PageSize: util.Int32Pointer(1), PageToken: util.StringPointer(nextPageToken)})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(runs))
@@ -166,13 +167,13 @@ func (s *RunApiTestSuite) TestRunApis() {
 	/* assert.Equal(t, "argument parameter", runs[0].Name) */
 
 	/* ---------- List the runs, paginated, sort by name ---------- */
-	runs, totalSize, nextPageToken, err = s.runClient.List(&runparams.ListRunsParams{
+	runs, totalSize, nextPageToken, err = s.runClient.List(context.Background(), &runparams.ListRunsParams{
 		PageSize: util.Int32Pointer(1), SortBy: util.StringPointer("name")})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(runs))
 	assert.Equal(t, 2, totalSize)
 	assert.Equal(t, "argument parameter", runs[0].Name)
-	runs, totalSize, _, err = s.runClient.List(&runparams.ListRunsParams{
+	runs, totalSize, _, err = s.runClient.List(context.Background(), &runparams.ListRunsParams{
 		PageSize: util.Int32Pointer(1), SortBy: util.StringPointer("name"), PageToken: util.StringPointer(nextPageToken)})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(runs))
@@ -180,12 +181,12 @@ func (s *RunApiTestSuite) TestRunApis() {
 	assert.Equal(t, "hello world", runs[0].Name)
 
 	/* ---------- List the runs, sort by unsupported field ---------- */
-	_, _, _, err = s.runClient.List(&runparams.ListRunsParams{
+	_, _, _, err = s.runClient.List(context.Background(), &runparams.ListRunsParams{
 		PageSize: util.Int32Pointer(2), SortBy: util.StringPointer("unknownfield")})
 	assert.NotNil(t, err)
 
 	/* ---------- List runs for hello world experiment. One run should be returned ---------- */
-	runs, totalSize, _, err = s.runClient.List(&runparams.ListRunsParams{
+	runs, totalSize, _, err = s.runClient.List(context.Background(), &runparams.ListRunsParams{
 		ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 		ResourceReferenceKeyID:   util.StringPointer(helloWorldExperiment.ID)})
 	assert.Nil(t, err)
@@ -194,13 +195,13 @@ func (s *RunApiTestSuite) TestRunApis() {
 	assert.Equal(t, "hello world", runs[0].Name)
 
 	/* ---------- Archive a run ------------*/
-	err = s.runClient.Archive(&runparams.ArchiveRunParams{
+	err = s.runClient.Archive(context.Background(), &runparams.ArchiveRunParams{
 		ID: helloWorldRunDetail.Run.ID,
 	})
 	assert.Nil(t, err)
 
 	/* ---------- List runs for hello world experiment. The same run should still be returned, but should be archived ---------- */
-	runs, totalSize, _, err = s.runClient.List(&runparams.ListRunsParams{
+	runs, totalSize, _, err = s.runClient.List(context.Background(), &runparams.ListRunsParams{
 		ResourceReferenceKeyType: util.StringPointer(string(run_model.V1ResourceTypeEXPERIMENT)),
 		ResourceReferenceKeyID:   util.StringPointer(helloWorldExperiment.ID)})
 	assert.Nil(t, err)
@@ -210,12 +211,12 @@ func (s *RunApiTestSuite) TestRunApis() {
 	assert.Equal(t, string(runs[0].StorageState), api.Run_STORAGESTATE_ARCHIVED.String())
 
 	/* ---------- Upload long-running pipeline YAML ---------- */
-	longRunningPipeline, err := s.pipelineUploadClient.UploadFile("../resources/long-running.yaml", uploadParams.NewUploadPipelineParamsWithTimeout(350))
+	longRunningPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/long-running.yaml", uploadParams.NewUploadPipelineParamsWithTimeout(350))
 	assert.Nil(t, err)
 
 	/* ---------- Upload a long-running pipeline version YAML under longRunningPipeline ---------- */
 	time.Sleep(1 * time.Second)
-	longRunningPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion("../resources/long-running.yaml", &uploadParams.UploadPipelineVersionParams{
+	longRunningPipelineVersion, err := s.pipelineUploadClient.UploadPipelineVersion(context.Background(), "../resources/long-running.yaml", &uploadParams.UploadPipelineVersionParams{
 		Name:       util.StringPointer("long-running-version"),
 		Pipelineid: util.StringPointer(longRunningPipeline.ID),
 	})
@@ -232,17 +233,17 @@ func (s *RunApiTestSuite) TestRunApis() {
 				Relationship: run_model.V1RelationshipCREATOR},
 		},
 	}}
-	longRunningRunDetail, _, err := s.runClient.Create(createLongRunningRunRequest)
+	longRunningRunDetail, _, err := s.runClient.Create(context.Background(), createLongRunningRunRequest)
 	assert.Nil(t, err)
 
 	/* ---------- Terminate the long-running run ------------*/
-	err = s.runClient.Terminate(&runparams.TerminateRunParams{
+	err = s.runClient.Terminate(context.Background(), &runparams.TerminateRunParams{
 		RunID: longRunningRunDetail.Run.ID,
 	})
 	assert.Nil(t, err)
 
 	/* ---------- Get long-running run ---------- */
-	longRunningRunDetail, _, err = s.runClient.Get(&runparams.GetRunParams{RunID: longRunningRunDetail.Run.ID})
+	longRunningRunDetail, _, err = s.runClient.Get(context.Background(), &runparams.GetRunParams{RunID: longRunningRunDetail.Run.ID})
 	assert.Nil(t, err)
 	s.checkTerminatedRunDetail(t, longRunningRunDetail, helloWorldExperiment.ID, helloWorldExperiment.Name, longRunningPipelineVersion.ID, longRunningPipelineVersion.Name)
 }
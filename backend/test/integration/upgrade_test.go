@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"io/ioutil"
 	"sort"
 	"testing"
@@ -126,7 +127,7 @@ func (s *UpgradeTests) PrepareExperiments() {
 
 	/* ---------- Create a new experiment ---------- */
 	experiment := &experiment_model.V1Experiment{Name: "training", Description: "my first experiment"}
-	_, err := s.experimentClient.Create(&experimentParams.CreateExperimentParams{
+	_, err := s.experimentClient.Create(context.Background(), &experimentParams.CreateExperimentParams{
 		Body: experiment,
 	})
 	require.Nil(t, err)
@@ -135,14 +136,14 @@ func (s *UpgradeTests) PrepareExperiments() {
 	// This ensures they can be sorted by create time in expected order.
 	time.Sleep(1 * time.Second)
 	experiment = &experiment_model.V1Experiment{Name: "prediction", Description: "my second experiment"}
-	_, err = s.experimentClient.Create(&experimentParams.CreateExperimentParams{
+	_, err = s.experimentClient.Create(context.Background(), &experimentParams.CreateExperimentParams{
 		Body: experiment,
 	})
 	require.Nil(t, err)
 
 	time.Sleep(1 * time.Second)
 	experiment = &experiment_model.V1Experiment{Name: "moonshot", Description: "my third experiment"}
-	_, err = s.experimentClient.Create(&experimentParams.CreateExperimentParams{
+	_, err = s.experimentClient.Create(context.Background(), &experimentParams.CreateExperimentParams{
 		Body: experiment,
 	})
 	require.Nil(t, err)
@@ -152,7 +153,7 @@ func (s *UpgradeTests) VerifyExperiments() {
 	t := s.T()
 
 	/* ---------- Verify list experiments sorted by creation time ---------- */
-	experiments, _, _, err := s.experimentClient.List(
+	experiments, _, _, err := s.experimentClient.List(context.Background(),
 		&experimentParams.ListExperimentParams{SortBy: util.StringPointer("created_at")})
 	require.Nil(t, err)
 	// after upgrade, default experiment may be inserted, but the oldest 3
@@ -182,13 +183,13 @@ func (s *UpgradeTests) PreparePipelines() {
 	test.DeleteAllPipelines(s.pipelineClient, t)
 
 	/* ---------- Upload pipelines YAML ---------- */
-	argumentYAMLPipeline, err := s.pipelineUploadClient.UploadFile("../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineParams())
+	argumentYAMLPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/arguments-parameters.yaml", uploadParams.NewUploadPipelineParams())
 	require.Nil(t, err)
 	assert.Equal(t, "arguments-parameters.yaml", argumentYAMLPipeline.Name)
 
 	/* ---------- Import pipeline YAML by URL ---------- */
 	time.Sleep(1 * time.Second)
-	sequentialPipeline, err := s.pipelineClient.Create(&pipelineParams.CreatePipelineParams{
+	sequentialPipeline, err := s.pipelineClient.Create(context.Background(), &pipelineParams.CreatePipelineParams{
 		Body: &pipeline_model.V1Pipeline{Name: "sequential", URL: &pipeline_model.V1URL{
 			PipelineURL: "https://storage.googleapis.com/ml-pipeline-dataset/sequential.yaml"}}})
 	require.Nil(t, err)
@@ -196,14 +197,14 @@ func (s *UpgradeTests) PreparePipelines() {
 
 	/* ---------- Upload pipelines zip ---------- */
 	time.Sleep(1 * time.Second)
-	argumentUploadPipeline, err := s.pipelineUploadClient.UploadFile(
+	argumentUploadPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(),
 		"../resources/arguments.pipeline.zip", &uploadParams.UploadPipelineParams{Name: util.StringPointer("zip-arguments-parameters")})
 	require.Nil(t, err)
 	assert.Equal(t, "zip-arguments-parameters", argumentUploadPipeline.Name)
 
 	/* ---------- Import pipeline tarball by URL ---------- */
 	time.Sleep(1 * time.Second)
-	argumentUrlPipeline, err := s.pipelineClient.Create(&pipelineParams.CreatePipelineParams{
+	argumentUrlPipeline, err := s.pipelineClient.Create(context.Background(), &pipelineParams.CreatePipelineParams{
 		Body: &pipeline_model.V1Pipeline{URL: &pipeline_model.V1URL{
 			PipelineURL: "https://storage.googleapis.com/ml-pipeline-dataset/arguments.pipeline.zip"}}})
 	require.Nil(t, err)
@@ -216,7 +217,7 @@ func (s *UpgradeTests) VerifyPipelines() {
 	t := s.T()
 
 	/* ---------- Verify list pipeline sorted by creation time ---------- */
-	pipelines, _, _, err := s.pipelineClient.List(
+	pipelines, _, _, err := s.pipelineClient.List(context.Background(),
 		&pipelineParams.ListPipelinesParams{SortBy: util.StringPointer("created_at")})
 	require.Nil(t, err)
 	// During upgrade, default pipelines may be installed, so we only verify the
@@ -230,7 +231,7 @@ func (s *UpgradeTests) VerifyPipelines() {
 	verifyPipeline(t, pipelines[0])
 
 	/* ---------- Verify get template works ---------- */
-	template, err := s.pipelineClient.GetTemplate(&pipelineParams.GetTemplateParams{ID: pipelines[0].ID})
+	template, err := s.pipelineClient.GetTemplate(context.Background(), &pipelineParams.GetTemplateParams{ID: pipelines[0].ID})
 	require.Nil(t, err)
 	expected, err := ioutil.ReadFile("../resources/arguments-parameters.yaml")
 	require.Nil(t, err)
@@ -263,7 +264,7 @@ func (s *UpgradeTests) PrepareRuns() {
 				Name: helloWorldExperiment.Name, Relationship: run_model.V1RelationshipOWNER},
 		},
 	}}
-	_, _, err := s.runClient.Create(createRunRequest)
+	_, _, err := s.runClient.Create(context.Background(), createRunRequest)
 	require.Nil(t, err)
 }
 
@@ -271,14 +272,14 @@ func (s *UpgradeTests) VerifyRuns() {
 	t := s.T()
 
 	/* ---------- List the runs, sorted by creation time ---------- */
-	runs, _, _, err := s.runClient.List(
+	runs, _, _, err := s.runClient.List(context.Background(),
 		&runParams.ListRunsParams{SortBy: util.StringPointer("created_at")})
 	require.Nil(t, err)
 	require.True(t, len(runs) >= 1)
 	require.Equal(t, "hello world", runs[0].Name)
 
 	/* ---------- Get hello world run ---------- */
-	helloWorldRunDetail, _, err := s.runClient.Get(&runParams.GetRunParams{RunID: runs[0].ID})
+	helloWorldRunDetail, _, err := s.runClient.Get(context.Background(), &runParams.GetRunParams{RunID: runs[0].ID})
 	require.Nil(t, err)
 	checkHelloWorldRunDetail(t, helloWorldRunDetail)
 }
@@ -304,7 +305,7 @@ func (s *UpgradeTests) PrepareJobs() {
 		Enabled:        true,
 		NoCatchup:      true,
 	}}
-	_, err := s.jobClient.Create(createJobRequest)
+	_, err := s.jobClient.Create(context.Background(), createJobRequest)
 	require.Nil(t, err)
 }
 
@@ -315,7 +316,7 @@ func (s *UpgradeTests) VerifyJobs() {
 	experiment := s.getHelloWorldExperiment(false)
 
 	/* ---------- Get hello world job ---------- */
-	jobs, _, _, err := s.jobClient.List(&jobparams.ListJobsParams{})
+	jobs, _, _, err := s.jobClient.List(context.Background(), &jobparams.ListJobsParams{})
 	require.Nil(t, err)
 	require.Len(t, jobs, 1)
 	job := jobs[0]
@@ -395,7 +396,7 @@ func (s *UpgradeTests) createHelloWorldExperiment() *experiment_model.V1Experime
 	t := s.T()
 
 	experiment := &experiment_model.V1Experiment{Name: "hello world experiment"}
-	helloWorldExperiment, err := s.experimentClient.Create(&experimentParams.CreateExperimentParams{Body: experiment})
+	helloWorldExperiment, err := s.experimentClient.Create(context.Background(), &experimentParams.CreateExperimentParams{Body: experiment})
 	require.Nil(t, err)
 
 	return helloWorldExperiment
@@ -404,7 +405,7 @@ func (s *UpgradeTests) createHelloWorldExperiment() *experiment_model.V1Experime
 func (s *UpgradeTests) getHelloWorldExperiment(createIfNotExist bool) *experiment_model.V1Experiment {
 	t := s.T()
 
-	experiments, err := s.experimentClient.ListAll(&experimentParams.ListExperimentParams{}, 1000)
+	experiments, err := s.experimentClient.ListAll(context.Background(), &experimentParams.ListExperimentParams{}, 1000)
 	require.Nil(t, err)
 	var helloWorldExperiment *experiment_model.V1Experiment
 	for _, experiment := range experiments {
@@ -423,7 +424,7 @@ func (s *UpgradeTests) getHelloWorldExperiment(createIfNotExist bool) *experimen
 func (s *UpgradeTests) getHelloWorldPipeline(createIfNotExist bool) *pipeline_model.V1Pipeline {
 	t := s.T()
 
-	pipelines, err := s.pipelineClient.ListAll(&pipelineParams.ListPipelinesParams{}, 1000)
+	pipelines, err := s.pipelineClient.ListAll(context.Background(), &pipelineParams.ListPipelinesParams{}, 1000)
 	require.Nil(t, err)
 	var helloWorldPipeline *pipeline_model.V1Pipeline
 	for _, pipeline := range pipelines {
@@ -443,10 +444,10 @@ func (s *UpgradeTests) createHelloWorldPipeline() *pipeline_model.V1Pipeline {
 	t := s.T()
 
 	/* ---------- Upload pipelines YAML ---------- */
-	uploadedPipeline, err := s.pipelineUploadClient.UploadFile("../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
+	uploadedPipeline, err := s.pipelineUploadClient.UploadFile(context.Background(), "../resources/hello-world.yaml", uploadParams.NewUploadPipelineParams())
 	require.Nil(t, err)
 
-	helloWorldPipeline, err := s.pipelineClient.Get(&pipelineParams.GetPipelineParams{ID: uploadedPipeline.ID})
+	helloWorldPipeline, err := s.pipelineClient.Get(context.Background(), &pipelineParams.GetPipelineParams{ID: uploadedPipeline.ID})
 	require.Nil(t, err)
 
 	return helloWorldPipeline
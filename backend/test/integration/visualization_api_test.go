@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -49,7 +50,7 @@ func (s *VisualizationApiTest) TestVisualizationAPI() {
 		Arguments: `{"code": ["print(2)"]}`,
 		Type:      visualization_model.V1VisualizationTypeCUSTOM,
 	}
-	customVisualization, err := s.visualizationClient.Create(&params.CreateVisualizationParams{
+	customVisualization, err := s.visualizationClient.Create(context.Background(), &params.CreateVisualizationParams{
 		Body: visualization,
 	})
 	assert.Nil(t, err)
@@ -15,6 +15,7 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
@@ -67,34 +68,34 @@ func GetClientConfig(namespace string) clientcmd.ClientConfig {
 }
 
 func DeleteAllPipelines(client *api_server.PipelineClient, t *testing.T) {
-	pipelines, _, _, err := client.List(&pipelineparams.ListPipelinesParams{})
+	pipelines, _, _, err := client.List(context.Background(), &pipelineparams.ListPipelinesParams{})
 	assert.Nil(t, err)
 	for _, p := range pipelines {
-		assert.Nil(t, client.Delete(&pipelineparams.DeletePipelineParams{ID: p.ID}))
+		assert.Nil(t, client.Delete(context.Background(), &pipelineparams.DeletePipelineParams{ID: p.ID}))
 	}
 }
 
 func DeleteAllExperiments(client *api_server.ExperimentClient, t *testing.T) {
-	experiments, _, _, err := client.List(&experimentparams.ListExperimentParams{})
+	experiments, _, _, err := client.List(context.Background(), &experimentparams.ListExperimentParams{})
 	assert.Nil(t, err)
 	for _, e := range experiments {
-		assert.Nil(t, client.Delete(&experimentparams.DeleteExperimentParams{ID: e.ID}))
+		assert.Nil(t, client.Delete(context.Background(), &experimentparams.DeleteExperimentParams{ID: e.ID}))
 	}
 }
 
 func DeleteAllRuns(client *api_server.RunClient, t *testing.T) {
-	runs, _, _, err := client.List(&runparams.ListRunsParams{})
+	runs, _, _, err := client.List(context.Background(), &runparams.ListRunsParams{})
 	assert.Nil(t, err)
 	for _, r := range runs {
-		assert.Nil(t, client.Delete(&runparams.DeleteRunParams{ID: r.ID}))
+		assert.Nil(t, client.Delete(context.Background(), &runparams.DeleteRunParams{ID: r.ID}))
 	}
 }
 
 func DeleteAllJobs(client *api_server.JobClient, t *testing.T) {
-	jobs, _, _, err := client.List(&jobparams.ListJobsParams{})
+	jobs, _, _, err := client.List(context.Background(), &jobparams.ListJobsParams{})
 	assert.Nil(t, err)
 	for _, j := range jobs {
-		assert.Nil(t, client.Delete(&jobparams.DeleteJobParams{ID: j.ID}))
+		assert.Nil(t, client.Delete(context.Background(), &jobparams.DeleteJobParams{ID: j.ID}))
 	}
 }
 